@@ -193,6 +193,30 @@ func (s *Server) ListenAndServe() error {
 						s.logger.Info("Couldn't delete account", zap.Error(err))
 						ok = true
 					}
+				case internaldomain.EVENT_ACCOUNT_BLOCKED:
+					var res = evt.Value.(internaldomain.Account)
+					if err := s.events.AccountBlocked(res); err != nil {
+						s.logger.Info("Couldn't block account", zap.Error(err))
+						ok = true
+					}
+				case internaldomain.EVENT_ACCOUNT_UNBLOCKED:
+					var res = evt.Value.(internaldomain.Account)
+					if err := s.events.AccountUnblocked(res); err != nil {
+						s.logger.Info("Couldn't unblock account", zap.Error(err))
+						ok = true
+					}
+				case internaldomain.EVENT_ACCOUNT_UPDATED:
+					var res = evt.Value.(internaldomain.Account)
+					if err := s.events.AccountChanged(res); err != nil {
+						s.logger.Info("Couldn't index account", zap.Error(err))
+						ok = true
+					}
+				case internaldomain.EVENT_ACCOUNT_LOCKED:
+					var res = evt.Value.(internaldomain.Lockout)
+					if err := s.events.AccountLocked(res); err != nil {
+						s.logger.Info("Couldn't handle lockout", zap.Error(err))
+						ok = true
+					}
 				case internaldomain.EVENT_ROLE_CREATED:
 					var role = evt.Value.(internaldomain.Roles)
 					if err := s.events.RoleCreated(role); err != nil {
@@ -247,6 +271,36 @@ func (s *Server) ListenAndServe() error {
 						s.logger.Info("Couldn't delete accountrole", zap.Error(err))
 						ok = true
 					}
+				case internaldomain.EVENT_GROUPMEMBER_ADDED:
+					var member = evt.Value.(internaldomain.GroupMember)
+					if err := s.events.GroupMemberAdded(member); err != nil {
+						s.logger.Info("Couldn't index groupmember", zap.Error(err))
+						ok = true
+					}
+				case internaldomain.EVENT_GROUPMEMBER_REMOVED:
+					var id = evt.Value.(string)
+					if err := s.events.GroupMemberRemoved(id); err != nil {
+						s.logger.Info("Couldn't delete groupmember", zap.Error(err))
+						ok = true
+					}
+				case internaldomain.EVENT_ACCESSREQUEST_CREATED:
+					var request = evt.Value.(internaldomain.AccessRequest)
+					if err := s.events.AccessRequestCreated(request); err != nil {
+						s.logger.Info("Couldn't handle accessrequest", zap.Error(err))
+						ok = true
+					}
+				case internaldomain.EVENT_ACCESSREQUEST_DECIDED:
+					var request = evt.Value.(internaldomain.AccessRequest)
+					if err := s.events.AccessRequestDecided(request); err != nil {
+						s.logger.Info("Couldn't handle accessrequest", zap.Error(err))
+						ok = true
+					}
+				case internaldomain.EVENT_BREAKGLASS_ACTIVATED:
+					var activation = evt.Value.(internaldomain.BreakGlassActivation)
+					if err := s.events.BreakGlassActivated(activation); err != nil {
+						s.logger.Info("Couldn't handle breakglass", zap.Error(err))
+						ok = true
+					}
 				case internaldomain.EVENT_ROLETASK_CREATED:
 					var roleTask = evt.Value.(internaldomain.RoleTasks)
 					if err := s.events.RoleTaskCreated(roleTask); err != nil {
@@ -219,6 +219,46 @@ func (s *Server) ListenAndServe() error {
 					s.logger.Info("Couldn't delete account", zap.Error(err))
 					nack = true
 				}
+			case internaldomain.EVENT_ACCOUNT_BLOCKED:
+				var res internaldomain.Account
+				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&res); err != nil {
+					nack = true
+					return
+				}
+				if err := s.events.AccountBlocked(res); err != nil {
+					s.logger.Info("Couldn't block account", zap.Error(err))
+					nack = true
+				}
+			case internaldomain.EVENT_ACCOUNT_UNBLOCKED:
+				var res internaldomain.Account
+				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&res); err != nil {
+					nack = true
+					return
+				}
+				if err := s.events.AccountUnblocked(res); err != nil {
+					s.logger.Info("Couldn't unblock account", zap.Error(err))
+					nack = true
+				}
+			case internaldomain.EVENT_ACCOUNT_UPDATED:
+				var res internaldomain.Account
+				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&res); err != nil {
+					nack = true
+					return
+				}
+				if err := s.events.AccountChanged(res); err != nil {
+					s.logger.Info("Couldn't index account", zap.Error(err))
+					nack = true
+				}
+			case internaldomain.EVENT_ACCOUNT_LOCKED:
+				var res internaldomain.Lockout
+				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&res); err != nil {
+					nack = true
+					return
+				}
+				if err := s.events.AccountLocked(res); err != nil {
+					s.logger.Info("Couldn't handle lockout", zap.Error(err))
+					nack = true
+				}
 			case internaldomain.EVENT_ROLE_CREATED:
 				var role internaldomain.Roles
 				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&role); err != nil {
@@ -309,6 +349,56 @@ func (s *Server) ListenAndServe() error {
 					s.logger.Info("Couldn't delete accountrole", zap.Error(err))
 					nack = true
 				}
+			case internaldomain.EVENT_GROUPMEMBER_ADDED:
+				var member internaldomain.GroupMember
+				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&member); err != nil {
+					nack = true
+					return
+				}
+				if err := s.events.GroupMemberAdded(member); err != nil {
+					s.logger.Info("Couldn't index groupmember", zap.Error(err))
+					nack = true
+				}
+			case internaldomain.EVENT_GROUPMEMBER_REMOVED:
+				var id string
+				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&id); err != nil {
+					nack = true
+					return
+				}
+				if err := s.events.GroupMemberRemoved(id); err != nil {
+					s.logger.Info("Couldn't delete groupmember", zap.Error(err))
+					nack = true
+				}
+			case internaldomain.EVENT_ACCESSREQUEST_CREATED:
+				var request internaldomain.AccessRequest
+				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&request); err != nil {
+					nack = true
+					return
+				}
+				if err := s.events.AccessRequestCreated(request); err != nil {
+					s.logger.Info("Couldn't handle accessrequest", zap.Error(err))
+					nack = true
+				}
+			case internaldomain.EVENT_ACCESSREQUEST_DECIDED:
+				var request internaldomain.AccessRequest
+				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&request); err != nil {
+					nack = true
+					return
+				}
+				if err := s.events.AccessRequestDecided(request); err != nil {
+					s.logger.Info("Couldn't handle accessrequest", zap.Error(err))
+					nack = true
+				}
+			case internaldomain.EVENT_BREAKGLASS_ACTIVATED:
+				var activation internaldomain.BreakGlassActivation
+				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&activation); err != nil {
+					nack = true
+					return
+				}
+				if err := s.events.BreakGlassActivated(activation); err != nil {
+					s.logger.Info("Couldn't handle breakglass", zap.Error(err))
+					nack = true
+				}
 			case internaldomain.EVENT_ROLETASK_CREATED:
 				var roleTask internaldomain.RoleTasks
 				if err := gob.NewDecoder(bytes.NewReader(msg.Body)).Decode(&roleTask); err != nil {
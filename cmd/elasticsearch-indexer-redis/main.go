@@ -186,6 +186,42 @@ func (s *Server) ListenAndServe() error {
 				if err := s.events.AccountDeleted(id); err != nil {
 					s.logger.Info("Couldn't delete account", zap.Error(err))
 				}
+			case internaldomain.EVENT_ACCOUNT_BLOCKED:
+				var res internaldomain.Account
+				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&res); err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					continue
+				}
+				if err := s.events.AccountBlocked(res); err != nil {
+					s.logger.Info("Couldn't block account", zap.Error(err))
+				}
+			case internaldomain.EVENT_ACCOUNT_UNBLOCKED:
+				var res internaldomain.Account
+				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&res); err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					continue
+				}
+				if err := s.events.AccountUnblocked(res); err != nil {
+					s.logger.Info("Couldn't unblock account", zap.Error(err))
+				}
+			case internaldomain.EVENT_ACCOUNT_UPDATED:
+				var res internaldomain.Account
+				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&res); err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					continue
+				}
+				if err := s.events.AccountChanged(res); err != nil {
+					s.logger.Info("Couldn't index account", zap.Error(err))
+				}
+			case internaldomain.EVENT_ACCOUNT_LOCKED:
+				var res internaldomain.Lockout
+				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&res); err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					continue
+				}
+				if err := s.events.AccountLocked(res); err != nil {
+					s.logger.Info("Couldn't handle lockout", zap.Error(err))
+				}
 			case internaldomain.EVENT_ROLE_CREATED:
 				var role internaldomain.Roles
 				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&role); err != nil {
@@ -267,6 +303,51 @@ func (s *Server) ListenAndServe() error {
 				if err := s.events.AccountRoleDeleted(id); err != nil {
 					s.logger.Info("Couldn't delete accountrole", zap.Error(err))
 				}
+			case internaldomain.EVENT_GROUPMEMBER_ADDED:
+				var member internaldomain.GroupMember
+				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&member); err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					continue
+				}
+				if err := s.events.GroupMemberAdded(member); err != nil {
+					s.logger.Info("Couldn't index groupmember", zap.Error(err))
+				}
+			case internaldomain.EVENT_GROUPMEMBER_REMOVED:
+				var id string
+				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&id); err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					continue
+				}
+				if err := s.events.GroupMemberRemoved(id); err != nil {
+					s.logger.Info("Couldn't delete groupmember", zap.Error(err))
+				}
+			case internaldomain.EVENT_ACCESSREQUEST_CREATED:
+				var request internaldomain.AccessRequest
+				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&request); err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					continue
+				}
+				if err := s.events.AccessRequestCreated(request); err != nil {
+					s.logger.Info("Couldn't handle accessrequest", zap.Error(err))
+				}
+			case internaldomain.EVENT_ACCESSREQUEST_DECIDED:
+				var request internaldomain.AccessRequest
+				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&request); err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					continue
+				}
+				if err := s.events.AccessRequestDecided(request); err != nil {
+					s.logger.Info("Couldn't handle accessrequest", zap.Error(err))
+				}
+			case internaldomain.EVENT_BREAKGLASS_ACTIVATED:
+				var activation internaldomain.BreakGlassActivation
+				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&activation); err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					continue
+				}
+				if err := s.events.BreakGlassActivated(activation); err != nil {
+					s.logger.Info("Couldn't handle breakglass", zap.Error(err))
+				}
 			case internaldomain.EVENT_ROLETASK_CREATED:
 				var roleTask internaldomain.RoleTasks
 				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&roleTask); err != nil {
@@ -0,0 +1,14 @@
+package events
+
+import (
+	"rbac/internal"
+)
+
+func (r *RBACEvents) AccessRequestCreated(request internal.AccessRequest) error {
+	// nothing is indexed for access requests, the events are meant for notifying approvers
+	return nil
+}
+func (r *RBACEvents) AccessRequestDecided(request internal.AccessRequest) error {
+	// nothing is indexed for access requests, the events are meant for notifying requesters
+	return nil
+}
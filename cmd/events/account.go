@@ -23,3 +23,27 @@ func (r *RBACEvents) AccountUpdated(profile internal.Profile) error {
 	}
 	return nil
 }
+func (r *RBACEvents) AccountBlocked(account internal.Account) error {
+	if err := r.cache.BlockAccount(context.Background(), account); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *RBACEvents) AccountUnblocked(account internal.Account) error {
+	if err := r.cache.IndexAccount(context.Background(), account); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AccountChanged indexes the account again, e.g. after it joined or left a tenant.
+func (r *RBACEvents) AccountChanged(account internal.Account) error {
+	if err := r.cache.IndexAccount(context.Background(), account); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *RBACEvents) AccountLocked(lockout internal.Lockout) error {
+	// nothing is indexed for lockouts, they are kept by the login attempts repository
+	return nil
+}
@@ -0,0 +1,10 @@
+package events
+
+import (
+	"rbac/internal"
+)
+
+func (r *RBACEvents) BreakGlassActivated(activation internal.BreakGlassActivation) error {
+	// nothing is indexed for break glass activations, the events are meant for alerting
+	return nil
+}
@@ -0,0 +1,19 @@
+package events
+
+import (
+	"context"
+	"rbac/internal"
+)
+
+func (r *RBACEvents) GroupMemberAdded(member internal.GroupMember) error {
+	if err := r.cache.IndexGroupMember(context.Background(), member); err != nil {
+		return err
+	}
+	return nil
+}
+func (r *RBACEvents) GroupMemberRemoved(id string) error {
+	if err := r.cache.DeleteGroupMember(context.Background(), id); err != nil {
+		return err
+	}
+	return nil
+}
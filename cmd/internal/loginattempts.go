@@ -0,0 +1,24 @@
+package internal
+
+import (
+	"fmt"
+	"rbac/internal/envvar"
+	"rbac/internal/memory"
+	"rbac/internal/redis"
+	"rbac/internal/service"
+
+	rv8 "github.com/go-redis/redis/v8"
+)
+
+// NewLoginAttempts instantiates the repository used for counting failed logins, "MEMORY" or a missing Redis
+// client keeps the counters in the process.
+func NewLoginAttempts(conf *envvar.Configuration, rdb *rv8.Client) (service.RBACLoginAttemptRepository, error) {
+	store, err := conf.Get("LOGIN_ATTEMPT_STORE")
+	if err != nil {
+		return nil, fmt.Errorf("conf.Get LOGIN_ATTEMPT_STORE %w", err)
+	}
+	if store == "MEMORY" || rdb == nil {
+		return memory.NewLoginAttempts(), nil
+	}
+	return redis.NewLoginAttempts(rdb), nil
+}
@@ -0,0 +1,27 @@
+package internal
+
+import (
+	"fmt"
+	"rbac/internal/envvar"
+	"rbac/internal/notifier"
+	"rbac/internal/service"
+
+	"go.uber.org/zap"
+)
+
+// NewNotifier instantiates the notifier used for delivering password reset tokens, "FILE" appends them to
+// the file defined by NOTIFIER_FILE and anything else writes them to the logs.
+func NewNotifier(conf *envvar.Configuration, logger *zap.Logger) (service.RBACNotifier, error) {
+	kind, err := conf.Get("NOTIFIER")
+	if err != nil {
+		return nil, fmt.Errorf("conf.Get NOTIFIER %w", err)
+	}
+	if kind == "FILE" {
+		path, err := conf.Get("NOTIFIER_FILE")
+		if err != nil {
+			return nil, fmt.Errorf("conf.Get NOTIFIER_FILE %w", err)
+		}
+		return notifier.NewFile(path), nil
+	}
+	return notifier.NewLog(logger), nil
+}
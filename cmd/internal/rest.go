@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"fmt"
+	"rbac/internal/envvar"
+	"rbac/internal/rest"
+	"strconv"
+)
+
+// NewRESTConfig instantiates the configuration of the REST handlers using values defined in environment variables.
+func NewRESTConfig(conf *envvar.Configuration) (rest.Config, error) {
+	precedence, err := conf.Get("AUTH_TOKEN_PRECEDENCE")
+	if err != nil {
+		return rest.Config{}, fmt.Errorf("conf.Get AUTH_TOKEN_PRECEDENCE %w", err)
+	}
+	if precedence == "" {
+		precedence = rest.TokenPrecedenceHeader
+	}
+	if precedence != rest.TokenPrecedenceHeader && precedence != rest.TokenPrecedenceCookie {
+		return rest.Config{}, fmt.Errorf("invalid auth token precedence: %s", precedence)
+	}
+
+	csrfProtection, err := conf.Get("CSRF_PROTECTION")
+	if err != nil {
+		return rest.Config{}, fmt.Errorf("conf.Get CSRF_PROTECTION %w", err)
+	}
+	csrf, err := strconv.ParseBool(csrfProtection)
+	if err != nil {
+		return rest.Config{}, fmt.Errorf("invalid csrf protection: %s", err)
+	}
+
+	tokenInBody, err := conf.Get("TOKEN_IN_BODY")
+	if err != nil {
+		return rest.Config{}, fmt.Errorf("conf.Get TOKEN_IN_BODY %w", err)
+	}
+	inBody, err := strconv.ParseBool(tokenInBody)
+	if err != nil {
+		return rest.Config{}, fmt.Errorf("invalid token in body: %s", err)
+	}
+
+	return rest.Config{
+		TokenPrecedence: precedence,
+		CSRFProtection:  csrf,
+		TokenInBody:     inBody,
+	}, nil
+}
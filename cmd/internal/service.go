@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"fmt"
+	"rbac/internal/envvar"
+	"rbac/internal/service"
+	"strconv"
+	"time"
+)
+
+// NewServiceConfig instantiates the RBAC service configuration using values defined in environment variables.
+func NewServiceConfig(conf *envvar.Configuration) (service.Config, error) {
+	refreshTokenExpiration, err := conf.Get("REFRESH_TOKEN_EXPIRATION")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get REFRESH_TOKEN_EXPIRATION %w", err)
+	}
+	refreshDuration, err := strconv.Atoi(refreshTokenExpiration)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid refresh token durration: %s", err)
+	}
+
+	loginMaxAttempts, err := conf.Get("LOGIN_MAX_ATTEMPTS")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get LOGIN_MAX_ATTEMPTS %w", err)
+	}
+	maxAttempts, err := strconv.Atoi(loginMaxAttempts)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid login max attempts: %s", err)
+	}
+
+	loginAttemptWindow, err := conf.Get("LOGIN_ATTEMPT_WINDOW")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get LOGIN_ATTEMPT_WINDOW %w", err)
+	}
+	attemptWindow, err := strconv.Atoi(loginAttemptWindow)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid login attempt window: %s", err)
+	}
+
+	loginBackoff, err := conf.Get("LOGIN_BACKOFF")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get LOGIN_BACKOFF %w", err)
+	}
+	backoff, err := strconv.Atoi(loginBackoff)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid login backoff: %s", err)
+	}
+
+	loginLockoutDuration, err := conf.Get("LOGIN_LOCKOUT_DURATION")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get LOGIN_LOCKOUT_DURATION %w", err)
+	}
+	lockoutDuration, err := strconv.Atoi(loginLockoutDuration)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid login lockout duration: %s", err)
+	}
+
+	passwordResetExpiration, err := conf.Get("PASSWORD_RESET_EXPIRATION")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get PASSWORD_RESET_EXPIRATION %w", err)
+	}
+	resetDuration, err := strconv.Atoi(passwordResetExpiration)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid password reset durration: %s", err)
+	}
+
+	mfaChallengeExpiration, err := conf.Get("MFA_CHALLENGE_EXPIRATION")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get MFA_CHALLENGE_EXPIRATION %w", err)
+	}
+	challengeDuration, err := strconv.Atoi(mfaChallengeExpiration)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid mfa challenge durration: %s", err)
+	}
+
+	mfaIssuer, err := conf.Get("MFA_ISSUER")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get MFA_ISSUER %w", err)
+	}
+
+	tokenPermissions, err := conf.Get("TOKEN_PERMISSIONS")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get TOKEN_PERMISSIONS %w", err)
+	}
+	permissionClaims, err := strconv.ParseBool(tokenPermissions)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid token permissions: %s", err)
+	}
+
+	accountRoleSweepInterval, err := conf.Get("ACCOUNT_ROLE_SWEEP_INTERVAL")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get ACCOUNT_ROLE_SWEEP_INTERVAL %w", err)
+	}
+	sweepInterval, err := strconv.Atoi(accountRoleSweepInterval)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid account role sweep interval: %s", err)
+	}
+
+	accessRequestExpiration, err := conf.Get("ACCESS_REQUEST_EXPIRATION")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get ACCESS_REQUEST_EXPIRATION %w", err)
+	}
+	requestDuration, err := strconv.Atoi(accessRequestExpiration)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid access request expiration: %s", err)
+	}
+
+	breakGlassDuration, err := conf.Get("BREAK_GLASS_DURATION")
+	if err != nil {
+		return service.Config{}, fmt.Errorf("conf.Get BREAK_GLASS_DURATION %w", err)
+	}
+	emergencyDuration, err := strconv.Atoi(breakGlassDuration)
+	if err != nil {
+		return service.Config{}, fmt.Errorf("invalid break glass duration: %s", err)
+	}
+
+	return service.Config{
+		RefreshTokenExpiration:  time.Duration(refreshDuration) * time.Minute,
+		LoginMaxAttempts:        maxAttempts,
+		LoginAttemptWindow:      time.Duration(attemptWindow) * time.Minute,
+		LoginBackoff:            time.Duration(backoff) * time.Second,
+		LoginLockoutDuration:    time.Duration(lockoutDuration) * time.Minute,
+		PasswordResetExpiration: time.Duration(resetDuration) * time.Minute,
+		MFAChallengeExpiration:  time.Duration(challengeDuration) * time.Minute,
+		MFAIssuer:               mfaIssuer,
+		TokenPermissions:        permissionClaims,
+
+		AccountRoleSweepInterval: time.Duration(sweepInterval) * time.Second,
+		AccessRequestExpiration:  time.Duration(requestDuration) * time.Minute,
+		BreakGlassDuration:       time.Duration(emergencyDuration) * time.Minute,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package internal
+
+import (
+	"fmt"
+	"rbac/internal/envvar"
+	"rbac/internal/memory"
+	"rbac/internal/redis"
+	"rbac/internal/service"
+
+	rv8 "github.com/go-redis/redis/v8"
+)
+
+// NewSessions instantiates the repository used for revoking tokens, "MEMORY" keeps revocations in the
+// process and is only meant for local development.
+func NewSessions(conf *envvar.Configuration, rdb *rv8.Client) (service.RBACSessionRepository, error) {
+	store, err := conf.Get("SESSION_STORE")
+	if err != nil {
+		return nil, fmt.Errorf("conf.Get SESSION_STORE %w", err)
+	}
+	if store == "MEMORY" {
+		return memory.NewSessions(), nil
+	}
+	return redis.NewSessions(rdb), nil
+}
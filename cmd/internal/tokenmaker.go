@@ -1,8 +1,11 @@
 package internal
 
 import (
+	"crypto"
+	"encoding/json"
 	"fmt"
 	"log"
+	"os"
 	"rbac/internal/envvar"
 	"rbac/internal/tokenmaker"
 	"rbac/internal/tokenmaker/jwtmaker"
@@ -11,7 +14,22 @@ import (
 	"time"
 )
 
-func NewTokenMaker(conf *envvar.Configuration) (tokenmaker.TokenMaker, error) {
+// tokenKey is an entry of TOKEN_KEYS, Key is the symmetric key or the PEM encoded private key depending
+// on TOKEN_MAKER.
+type tokenKey struct {
+	Kid    string               `json:"kid"`
+	Status tokenmaker.KeyStatus `json:"status"`
+	Key    string               `json:"key"`
+}
+
+// NewTokenMaker instantiates the access token maker, "PASETO" (v2.local) and "JWT" (HS256) use symmetric
+// keys, "JWT_PUBLIC", "PASETO_V2_PUBLIC" and "PASETO_V4_PUBLIC" sign with private keys whose public keys
+// are published in the JWKS.
+//
+// Keys are read from TOKEN_KEYS, a JSON array of {"kid", "status", "key"} usually stored in vault, falling
+// back to the single TOKEN_SYMMETRIC_KEY or TOKEN_PRIVATE_KEY_FILE key. The returned key ring reads them
+// again when reloaded. JWTs carry TOKEN_ISSUER and TOKEN_AUDIENCE as their iss and aud claims.
+func NewTokenMaker(conf *envvar.Configuration) (*tokenmaker.KeyRing, error) {
 	get := func(v string) string {
 		res, err := conf.Get(v)
 		if err != nil {
@@ -20,27 +38,94 @@ func NewTokenMaker(conf *envvar.Configuration) (tokenmaker.TokenMaker, error) {
 
 		return res
 	}
-	tokenSymmetricKey := get("TOKEN_SYMMETRIC_KEY")
 	tokenExpiration := get("TOKEN_EXPIRATION")
 	tokenMaker := get("TOKEN_MAKER")
-	var token_maker tokenmaker.TokenMaker
 	duration, err := strconv.Atoi(tokenExpiration)
 	if err != nil {
 		return nil, fmt.Errorf("invalid durration: %s", err)
 	}
-	maker, err := pasetomaker.NewPasetoMaker(tokenSymmetricKey, time.Duration(duration)*time.Minute)
-	if err != nil {
-		return nil, fmt.Errorf("couldn't create token maker: %s", err)
+	expiration := time.Duration(duration) * time.Minute
+	registered := jwtmaker.RegisteredClaims{
+		Issuer:   get("TOKEN_ISSUER"),
+		Audience: get("TOKEN_AUDIENCE"),
 	}
-	token_maker = maker
 
-	if tokenMaker == "JWT" {
-		maker, err := jwtmaker.NewJWTMaker(tokenSymmetricKey, time.Duration(duration)*time.Minute)
+	load := func() ([]tokenmaker.Key, error) {
+		conf.Refresh()
+		tokenKeys, err := conf.Get("TOKEN_KEYS")
 		if err != nil {
-			return nil, fmt.Errorf("couldn't create token maker: %s", err)
+			return nil, fmt.Errorf("conf.Get TOKEN_KEYS %w", err)
+		}
+		var entries []tokenKey
+		if tokenKeys != "" {
+			if err := json.Unmarshal([]byte(tokenKeys), &entries); err != nil {
+				return nil, fmt.Errorf("invalid token keys: %s", err)
+			}
+		} else {
+			key, err := legacyTokenKey(conf, tokenMaker)
+			if err != nil {
+				return nil, err
+			}
+			entries = []tokenKey{{Status: tokenmaker.KeyStatusActive, Key: key}}
+		}
+		keys := make([]tokenmaker.Key, len(entries))
+		for i, e := range entries {
+			maker, err := newKeyMaker(tokenMaker, e.Kid, e.Key, registered, expiration)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't create token maker for key %q: %s", e.Kid, err)
+			}
+			kid := e.Kid
+			if provider, ok := maker.(tokenmaker.PublicKeyProvider); ok && kid == "" {
+				// without a kid the tokens are signed using the thumbprint of the public key
+				kid = provider.PublicKeys()[0].Kid
+			}
+			keys[i] = tokenmaker.Key{ID: kid, Status: e.Status, Maker: maker}
 		}
-		token_maker = maker
+		return keys, nil
 	}
-	return token_maker, nil
+	return tokenmaker.NewKeyRing(load)
+}
 
+// legacyTokenKey returns the single key configured before TOKEN_KEYS existed.
+func legacyTokenKey(conf *envvar.Configuration, tokenMaker string) (string, error) {
+	switch tokenMaker {
+	case "JWT_PUBLIC", "PASETO_V2_PUBLIC", "PASETO_V4_PUBLIC":
+		file, err := conf.Get("TOKEN_PRIVATE_KEY_FILE")
+		if err != nil {
+			return "", fmt.Errorf("conf.Get TOKEN_PRIVATE_KEY_FILE %w", err)
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("couldn't read private key: %s", err)
+		}
+		return string(data), nil
+	}
+	key, err := conf.Get("TOKEN_SYMMETRIC_KEY")
+	if err != nil {
+		return "", fmt.Errorf("conf.Get TOKEN_SYMMETRIC_KEY %w", err)
+	}
+	return key, nil
+}
+
+func newKeyMaker(tokenMaker string, kid string, key string, registered jwtmaker.RegisteredClaims, expiration time.Duration) (tokenmaker.TokenMaker, error) {
+	var privateKey crypto.Signer
+	switch tokenMaker {
+	case "JWT_PUBLIC", "PASETO_V2_PUBLIC", "PASETO_V4_PUBLIC":
+		var err error
+		privateKey, err = tokenmaker.ParsePrivateKey([]byte(key))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse private key: %s", err)
+		}
+	}
+	switch tokenMaker {
+	case "JWT":
+		return jwtmaker.NewJWTMakerWithKeyID(kid, key, registered, expiration)
+	case "JWT_PUBLIC":
+		return jwtmaker.NewAsymmetricJWTMaker(kid, privateKey, registered, expiration)
+	case "PASETO_V2_PUBLIC":
+		return pasetomaker.NewPublicPasetoMaker(pasetomaker.V2Public, kid, privateKey, expiration)
+	case "PASETO_V4_PUBLIC":
+		return pasetomaker.NewPublicPasetoMaker(pasetomaker.V4Public, kid, privateKey, expiration)
+	}
+	return pasetomaker.NewPasetoMakerWithKeyID(kid, key, expiration)
 }
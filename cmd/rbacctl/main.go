@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"rbac/cmd/internal"
+	internaldomain "rbac/internal"
+	"rbac/internal/elasticsearch"
+	"rbac/internal/envvar"
+	"rbac/internal/memcached"
+	"rbac/internal/postgresql"
+	"rbac/internal/redis"
+	"rbac/internal/service"
+
+	"go.uber.org/zap"
+)
+
+const usage = `usage: rbacctl [-env file] [-tenant id] <command> [flags]
+
+commands:
+  apply -f policy.yaml [-dry-run] [-prune] [-prune-tasks]   make the tasks and roles match the policy
+  export [-f policy.yaml] [-o yaml|json]                    write the tasks and roles as a policy
+`
+
+func main() {
+	var env, tenant string
+
+	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.StringVar(&tenant, "tenant", "", "Id of the tenant of the roles, the default tenant when empty")
+	flag.Usage = func() { fmt.Fprint(flag.CommandLine.Output(), usage) }
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var run func(ctx context.Context, svc *service.RBAC) error
+	args := flag.Args()[1:]
+	switch flag.Arg(0) {
+	case "apply":
+		run = applyCommand(args)
+	case "export":
+		run = exportCommand(args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	svc, err := newService(env)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := context.Background()
+	if tenant != "" {
+		ctx = internaldomain.NewTenantContext(ctx, tenant)
+	}
+	if err := run(ctx, svc); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func applyCommand(args []string) func(ctx context.Context, svc *service.RBAC) error {
+	var filename string
+	var opts internaldomain.PolicyOptions
+
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+	fs.StringVar(&filename, "f", "", "Policy filename, YAML or JSON by extension")
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "Print the changes without applying them")
+	fs.BoolVar(&opts.Prune, "prune", false, "Delete the roles missing from the policy")
+	fs.BoolVar(&opts.PruneTasks, "prune-tasks", false, "Delete the tasks missing from the policy, only in the default tenant")
+	_ = fs.Parse(args)
+
+	if filename == "" {
+		log.Fatalln("apply: -f is required")
+	}
+
+	return func(ctx context.Context, svc *service.RBAC) error {
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("read policy %w", err)
+		}
+		policy, err := decodePolicy(data, policyFormat(filename))
+		if err != nil {
+			return err
+		}
+		changes, err := svc.ApplyPolicy(ctx, policy, opts)
+		for _, change := range changes {
+			fmt.Println(change)
+		}
+		if err != nil {
+			return fmt.Errorf("apply policy %w", err)
+		}
+		switch {
+		case len(changes) == 0:
+			fmt.Println("policy is up to date")
+		case opts.DryRun:
+			fmt.Printf("%d changes to apply (dry run)\n", len(changes))
+		default:
+			fmt.Printf("%d changes applied\n", len(changes))
+		}
+		return nil
+	}
+}
+
+func exportCommand(args []string) func(ctx context.Context, svc *service.RBAC) error {
+	var filename, format string
+
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	fs.StringVar(&filename, "f", "", "Policy filename, standard output when empty")
+	fs.StringVar(&format, "o", "", "Output format, yaml or json, defaults to the extension of the file")
+	_ = fs.Parse(args)
+
+	if format == "" {
+		format = policyFormat(filename)
+	}
+	if format != "yaml" && format != "json" {
+		log.Fatalln("export: -o must be yaml or json")
+	}
+
+	return func(ctx context.Context, svc *service.RBAC) error {
+		policy, err := svc.ExportPolicy(ctx)
+		if err != nil {
+			return fmt.Errorf("export policy %w", err)
+		}
+		data, err := encodePolicy(policy, format)
+		if err != nil {
+			return fmt.Errorf("encode policy %w", err)
+		}
+		if filename == "" {
+			_, err = os.Stdout.Write(data)
+			return err
+		}
+		return ioutil.WriteFile(filename, data, 0644)
+	}
+}
+
+func newService(env string) (*service.RBAC, error) {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return nil, fmt.Errorf("new logger %w", err)
+	}
+	if err := envvar.Load(env); err != nil {
+		return nil, fmt.Errorf("envvar.Load %w", err)
+	}
+	vault, err := internal.NewVaultProvider()
+	if err != nil {
+		return nil, fmt.Errorf("newVaultProvider %w", err)
+	}
+	conf := envvar.New(vault)
+	db, err := internal.NewPostgreSQL(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newDB %w", err)
+	}
+
+	es, err := internal.NewElasticSearch(conf)
+	if err != nil {
+		return nil, fmt.Errorf("new ElasticSearch %w", err)
+	}
+	m, err := internal.NewMemcached(conf)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewMemcached %w", err)
+	}
+
+	token, err := internal.NewTokenMaker(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newTokenMaker %w", err)
+	}
+
+	rdb, err := internal.NewRedis(conf)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewRedis %w", err)
+	}
+
+	sessions, err := internal.NewSessions(conf, rdb)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewSessions %w", err)
+	}
+
+	attempts, err := internal.NewLoginAttempts(conf, rdb)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewLoginAttempts %w", err)
+	}
+
+	notifier, err := internal.NewNotifier(conf, logger)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewNotifier %w", err)
+	}
+
+	svcConf, err := internal.NewServiceConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewServiceConfig %w", err)
+	}
+
+	msgBroker := redis.NewAccount(rdb)
+	repo := postgresql.NewRBAC(db)
+	search := elasticsearch.NewRBAC(es, 100)
+	mclient := memcached.NewRBAC(m, search, logger)
+	return service.NewRBAC(repo, mclient, token, msgBroker, sessions, attempts, notifier, svcConf), nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	internaldomain "rbac/internal"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// policyFile is the YAML or JSON policy format read by apply and written by export.
+type policyFile struct {
+	Tasks []policyFileTask `json:"tasks" yaml:"tasks"`
+	Roles []policyFileRole `json:"roles" yaml:"roles"`
+}
+
+type policyFileTask struct {
+	Name        string   `json:"name" yaml:"name"`
+	HelpText    string   `json:"helptext,omitempty" yaml:"helptext,omitempty"`
+	Menus       []string `json:"menus,omitempty" yaml:"menus,omitempty"`
+	Navigations []string `json:"navigations,omitempty" yaml:"navigations,omitempty"`
+}
+
+type policyFileRole struct {
+	Name   string            `json:"name" yaml:"name"`
+	Grants []policyFileGrant `json:"grants,omitempty" yaml:"grants,omitempty"`
+}
+
+type policyFileGrant struct {
+	Task         string `json:"task" yaml:"task"`
+	Effect       string `json:"effect,omitempty" yaml:"effect,omitempty"`
+	ResourceType string `json:"resource_type,omitempty" yaml:"resource_type,omitempty"`
+	ResourceId   string `json:"resource_id,omitempty" yaml:"resource_id,omitempty"`
+	Condition    string `json:"condition,omitempty" yaml:"condition,omitempty"`
+}
+
+// policyFormat returns the format of the file from its extension, YAML unless it is a .json file.
+func policyFormat(filename string) string {
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		return "json"
+	}
+	return "yaml"
+}
+
+func decodePolicy(data []byte, format string) (internaldomain.Policy, error) {
+	var file policyFile
+	var err error
+	if format == "json" {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&file)
+	} else {
+		err = yaml.UnmarshalStrict(data, &file)
+	}
+	if err != nil {
+		return internaldomain.Policy{}, fmt.Errorf("decode %s policy: %w", format, err)
+	}
+	var policy internaldomain.Policy
+	for _, task := range file.Tasks {
+		policy.Tasks = append(policy.Tasks, internaldomain.PolicyTask{
+			Task:        task.Name,
+			HelpText:    task.HelpText,
+			Menus:       task.Menus,
+			Navigations: task.Navigations,
+		})
+	}
+	for _, role := range file.Roles {
+		r := internaldomain.PolicyRole{Role: role.Name}
+		for _, grant := range role.Grants {
+			r.Grants = append(r.Grants, internaldomain.PolicyGrant{
+				Task:      grant.Task,
+				Effect:    grant.Effect,
+				Scope:     internaldomain.Scope{ResourceType: grant.ResourceType, ResourceId: grant.ResourceId},
+				Condition: grant.Condition,
+			})
+		}
+		policy.Roles = append(policy.Roles, r)
+	}
+	return policy, nil
+}
+
+func encodePolicy(policy internaldomain.Policy, format string) ([]byte, error) {
+	file := policyFile{Tasks: []policyFileTask{}, Roles: []policyFileRole{}}
+	for _, task := range policy.Tasks {
+		file.Tasks = append(file.Tasks, policyFileTask{
+			Name:        task.Task,
+			HelpText:    task.HelpText,
+			Menus:       task.Menus,
+			Navigations: task.Navigations,
+		})
+	}
+	for _, role := range policy.Roles {
+		r := policyFileRole{Name: role.Role}
+		for _, grant := range role.Grants {
+			g := policyFileGrant{
+				Task:         grant.Task,
+				ResourceType: grant.Scope.ResourceType,
+				ResourceId:   grant.Scope.ResourceId,
+				Condition:    grant.Condition,
+			}
+			// allow is the default, only denies are spelled out
+			if grant.Effect != internaldomain.EFFECT_ALLOW {
+				g.Effect = grant.Effect
+			}
+			r.Grants = append(r.Grants, g)
+		}
+		file.Roles = append(file.Roles, r)
+	}
+	if format == "json" {
+		return json.MarshalIndent(&file, "", "  ")
+	}
+	return yaml.Marshal(&file)
+}
@@ -103,6 +103,30 @@ func run(env, address string) (<-chan error, error) {
 	if err != nil {
 		return nil, fmt.Errorf("internal.NewRabbitMQ %w", err)
 	}
+
+	sessions, err := internal.NewSessions(conf, rdb)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewSessions %w", err)
+	}
+
+	attempts, err := internal.NewLoginAttempts(conf, rdb)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewLoginAttempts %w", err)
+	}
+
+	notifier, err := internal.NewNotifier(conf, logger)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewNotifier %w", err)
+	}
+
+	svcConf, err := internal.NewServiceConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewServiceConfig %w", err)
+	}
+	restConf, err := internal.NewRESTConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("internal.NewRESTConfig %w", err)
+	}
 	// rmq, err := internal.NewRabbitMQ(conf)
 	// if err != nil {
 	// 	return nil, fmt.Errorf("newRabbitMq %w", err)
@@ -112,7 +136,13 @@ func run(env, address string) (<-chan error, error) {
 	// if err != nil {
 	// 	return nil, fmt.Errorf("newRabbitMq %w", err)
 	// }
-	srv, err := newServer(serverConfig{
+	errC := make(chan error, 1)
+	ctx, stop := signal.NotifyContext(context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+
+	srv, err := newServer(ctx, serverConfig{
 		Address:       address,
 		Db:            db,
 		Token:         token,
@@ -122,17 +152,18 @@ func run(env, address string) (<-chan error, error) {
 		Logger:        logger,
 		Memcached:     memcached,
 		Redis:         rdb,
+		Sessions:      sessions,
+		LoginAttempts: attempts,
+		Notifier:      notifier,
+		Service:       svcConf,
+		REST:          restConf,
 		// RabbitMQ:      rmq,
 		// Kafka: kafka,
 	})
 	if err != nil {
+		stop()
 		return nil, fmt.Errorf("newServer %w", err)
 	}
-	errC := make(chan error, 1)
-	ctx, stop := signal.NotifyContext(context.Background(),
-		os.Interrupt,
-		syscall.SIGTERM,
-		syscall.SIGQUIT)
 
 	// XXX: When using Go 1.15 or older
 	// sc := make(chan os.Signal, 1)
@@ -189,11 +220,17 @@ type serverConfig struct {
 	Logger        *zap.Logger
 	Memcached     *memcache.Client
 	Redis         *rv8.Client
+	Sessions      service.RBACSessionRepository
+	LoginAttempts service.RBACLoginAttemptRepository
+	Notifier      service.RBACNotifier
+	Service       service.Config
+	REST          rest.Config
 	// RabbitMQ      *internal.RabbitMQ
 	// Kafka         *internal.KafkaProducer
 }
 
-func newServer(conf serverConfig) (*http.Server, error) {
+// newServer returns the server, background jobs like the account role sweeper run until ctx is done.
+func newServer(ctx context.Context, conf serverConfig) (*http.Server, error) {
 	r := mux.NewRouter()
 	for _, mw := range conf.Middlewares {
 		r.Use(mw)
@@ -210,10 +247,13 @@ func newServer(conf serverConfig) (*http.Server, error) {
 	// }
 	// msgBroker := kafka.NewRBAC(conf.Kafka.Producer, conf.Kafka.Topic)
 
-	svc := service.NewRBAC(repo, mclient, conf.Token, msgBroker)
+	svc := service.NewRBAC(repo, mclient, conf.Token, msgBroker, conf.Sessions, conf.LoginAttempts, conf.Notifier, conf.Service)
+	if conf.Service.AccountRoleSweepInterval > 0 {
+		go sweepAccountRoles(ctx, svc, conf.Service.AccountRoleSweepInterval, conf.Logger)
+	}
 
 	rest.RegisterOpenAPI(r)
-	rest.NewRBACHandler(svc).Register(r)
+	rest.NewRBACHandler(svc, conf.REST).Register(r)
 
 	fsys, _ := fs.Sub(content, "static")
 	r.PathPrefix("/static/").Handler(http.StripPrefix("/static/", http.FileServer(http.FS(fsys))))
@@ -227,3 +267,23 @@ func newServer(conf serverConfig) (*http.Server, error) {
 		IdleTimeout:       1 * time.Second,
 	}, nil
 }
+
+// sweepAccountRoles deletes expired account roles every interval until ctx is done.
+func sweepAccountRoles(ctx context.Context, svc *service.RBAC, interval time.Duration, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	since := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			next, err := svc.SweepAccountRoles(ctx, since)
+			if err != nil {
+				logger.Error("account role sweep failed", zap.Error(err))
+				continue
+			}
+			since = next
+		}
+	}
+}
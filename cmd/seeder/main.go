@@ -66,6 +66,51 @@ func newTask() []string {
 	tasks = append(tasks, internaldomain.DELETE_ROLE_TASK)
 	tasks = append(tasks, internaldomain.LIST_ROLE_TASK)
 
+	tasks = append(tasks, internaldomain.REVOKE_SESSION)
+
+	tasks = append(tasks, internaldomain.BLOCK_ACCOUNT)
+	tasks = append(tasks, internaldomain.UNBLOCK_ACCOUNT)
+
+	tasks = append(tasks, internaldomain.GET_LOCKOUT)
+	tasks = append(tasks, internaldomain.CLEAR_LOCKOUT)
+
+	tasks = append(tasks, internaldomain.DISABLE_MFA)
+	tasks = append(tasks, internaldomain.CREATE_SERVICE_ACCOUNT)
+	tasks = append(tasks, internaldomain.MANAGE_API_KEY)
+
+	tasks = append(tasks, internaldomain.GET_TOKEN_KEY)
+	tasks = append(tasks, internaldomain.ROTATE_TOKEN_KEY)
+
+	tasks = append(tasks, internaldomain.CREATE_TENANT)
+	tasks = append(tasks, internaldomain.LIST_TENANT)
+	tasks = append(tasks, internaldomain.ADD_TENANT_ACCOUNT)
+	tasks = append(tasks, internaldomain.REMOVE_TENANT_ACCOUNT)
+
+	tasks = append(tasks, internaldomain.CREATE_GROUP)
+	tasks = append(tasks, internaldomain.GET_GROUP)
+	tasks = append(tasks, internaldomain.UPDATE_GROUP)
+	tasks = append(tasks, internaldomain.DELETE_GROUP)
+	tasks = append(tasks, internaldomain.LIST_GROUP)
+	tasks = append(tasks, internaldomain.MANAGE_GROUP_MEMBER)
+	tasks = append(tasks, internaldomain.MANAGE_GROUP_ROLE)
+
+	tasks = append(tasks, internaldomain.CHECK_PERMISSION)
+	tasks = append(tasks, internaldomain.EXPLAIN_PERMISSION)
+
+	tasks = append(tasks, internaldomain.CREATE_EXCLUSIVE_ROLE_SET)
+	tasks = append(tasks, internaldomain.GET_EXCLUSIVE_ROLE_SET)
+	tasks = append(tasks, internaldomain.UPDATE_EXCLUSIVE_ROLE_SET)
+	tasks = append(tasks, internaldomain.DELETE_EXCLUSIVE_ROLE_SET)
+	tasks = append(tasks, internaldomain.LIST_EXCLUSIVE_ROLE_SET)
+	tasks = append(tasks, internaldomain.LIST_ROLE_CONFLICT)
+
+	tasks = append(tasks, internaldomain.LIST_ACCESS_REQUEST)
+	tasks = append(tasks, internaldomain.MANAGE_ROLE_APPROVER)
+
+	tasks = append(tasks, internaldomain.BREAK_GLASS)
+	tasks = append(tasks, internaldomain.MANAGE_EMERGENCY_ROLE)
+	tasks = append(tasks, internaldomain.LIST_BREAK_GLASS)
+
 	return tasks
 }
 
@@ -111,11 +156,31 @@ func main() {
 		log.Fatal(fmt.Errorf("internal.NewRabbitMQ %w", err))
 	}
 
+	sessions, err := internal.NewSessions(conf, rdb)
+	if err != nil {
+		log.Fatal(fmt.Errorf("internal.NewSessions %w", err))
+	}
+
+	attempts, err := internal.NewLoginAttempts(conf, rdb)
+	if err != nil {
+		log.Fatal(fmt.Errorf("internal.NewLoginAttempts %w", err))
+	}
+
+	notifier, err := internal.NewNotifier(conf, logger)
+	if err != nil {
+		log.Fatal(fmt.Errorf("internal.NewNotifier %w", err))
+	}
+
+	svcConf, err := internal.NewServiceConfig(conf)
+	if err != nil {
+		log.Fatal(fmt.Errorf("internal.NewServiceConfig %w", err))
+	}
+
 	msgBroker := redis.NewAccount(rdb)
 	repo := postgresql.NewRBAC(db)
 	search := elasticsearch.NewRBAC(es, 100)
 	mclient := memcached.NewRBAC(m, search, logger)
-	svc := service.NewRBAC(repo, mclient, token, msgBroker)
+	svc := service.NewRBAC(repo, mclient, token, msgBroker, sessions, attempts, notifier, svcConf)
 
 	//create new user
 	ctx := context.Background()
@@ -130,6 +195,12 @@ func main() {
 		log.Fatal(fmt.Errorf("new role %w", err))
 	}
 
+	//admins have to enroll mfa on their first login
+	err = svc.SetRoleMFARequired(ctx, rid, true)
+	if err != nil {
+		log.Fatal(fmt.Errorf("role mfa policy %w", err))
+	}
+
 	//create new accountrole
 	// err = svc.CreateAccountRole(ctx, accId, rid)
 	err = svc.CreateAccountRole(ctx, internaldomain.AccountRoles{
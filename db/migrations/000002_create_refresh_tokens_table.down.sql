@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS "refresh_tokens" DROP CONSTRAINT IF EXISTS "refresh_tokens_account_id_fkey";
+DROP TABLE IF EXISTS "refresh_tokens";
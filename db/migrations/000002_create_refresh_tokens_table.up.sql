@@ -0,0 +1,13 @@
+CREATE TABLE "refresh_tokens" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "account_id" uuid NOT NULL,
+  "family_id" uuid NOT NULL,
+  "token_hash" varchar UNIQUE NOT NULL,
+  "expires_at" timestamp NOT NULL,
+  "revoked_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "refresh_tokens" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "refresh_tokens" ("family_id");
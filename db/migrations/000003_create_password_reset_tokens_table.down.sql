@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS "password_reset_tokens" DROP CONSTRAINT IF EXISTS "password_reset_tokens_account_id_fkey";
+DROP TABLE IF EXISTS "password_reset_tokens";
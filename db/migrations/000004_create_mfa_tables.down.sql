@@ -0,0 +1,6 @@
+ALTER TABLE IF EXISTS "role_mfa_policies" DROP CONSTRAINT IF EXISTS "role_mfa_policies_role_id_fkey";
+ALTER TABLE IF EXISTS "mfa_recovery_codes" DROP CONSTRAINT IF EXISTS "mfa_recovery_codes_account_id_fkey";
+ALTER TABLE IF EXISTS "account_mfa" DROP CONSTRAINT IF EXISTS "account_mfa_account_id_fkey";
+DROP TABLE IF EXISTS "role_mfa_policies";
+DROP TABLE IF EXISTS "mfa_recovery_codes";
+DROP TABLE IF EXISTS "account_mfa";
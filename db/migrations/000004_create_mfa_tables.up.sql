@@ -0,0 +1,28 @@
+CREATE TABLE "account_mfa" (
+  "account_id" uuid PRIMARY KEY,
+  "secret" varchar NOT NULL,
+  "last_used_step" bigint NOT NULL DEFAULT 0,
+  "confirmed_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "mfa_recovery_codes" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "account_id" uuid NOT NULL,
+  "code_hash" varchar NOT NULL,
+  "used_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "role_mfa_policies" (
+  "role_id" uuid PRIMARY KEY,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "account_mfa" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "mfa_recovery_codes" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "role_mfa_policies" ADD FOREIGN KEY ("role_id") REFERENCES "roles" ("id");
+
+CREATE INDEX ON "mfa_recovery_codes" ("account_id");
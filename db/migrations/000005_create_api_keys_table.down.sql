@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS "api_keys" DROP CONSTRAINT IF EXISTS "api_keys_account_id_fkey";
+DROP TABLE IF EXISTS "api_keys";
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "is_service_account";
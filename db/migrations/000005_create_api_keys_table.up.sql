@@ -0,0 +1,17 @@
+ALTER TABLE "accounts" ADD COLUMN "is_service_account" boolean NOT NULL DEFAULT false;
+
+CREATE TABLE "api_keys" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "account_id" uuid NOT NULL,
+  "name" varchar NOT NULL,
+  "prefix" varchar NOT NULL,
+  "key_hash" varchar UNIQUE NOT NULL,
+  "expires_at" timestamp,
+  "last_used_at" timestamp,
+  "revoked_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "api_keys" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "api_keys" ("account_id");
@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS "role_inheritance" DROP CONSTRAINT IF EXISTS "role_inheritance_role_id_fkey";
+ALTER TABLE IF EXISTS "role_inheritance" DROP CONSTRAINT IF EXISTS "role_inheritance_inherited_role_id_fkey";
+DROP TABLE IF EXISTS "role_inheritance";
@@ -0,0 +1,15 @@
+CREATE TABLE "role_inheritance" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "role_id" uuid NOT NULL,
+  "inherited_role_id" uuid NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  CHECK ("role_id" <> "inherited_role_id")
+);
+
+ALTER TABLE "role_inheritance" ADD FOREIGN KEY ("role_id") REFERENCES "roles" ("id");
+
+ALTER TABLE "role_inheritance" ADD FOREIGN KEY ("inherited_role_id") REFERENCES "roles" ("id");
+
+CREATE UNIQUE INDEX ON "role_inheritance" ("role_id", "inherited_role_id");
+
+CREATE INDEX ON "role_inheritance" ("inherited_role_id");
@@ -0,0 +1,13 @@
+DELETE FROM "account_roles" WHERE "resource_type" <> '';
+DELETE FROM "role_tasks" WHERE "resource_type" <> '';
+
+DROP INDEX IF EXISTS "account_roles_account_id_role_id_resource_type_resource_id_idx";
+CREATE UNIQUE INDEX ON "account_roles" ("account_id", "role_id");
+
+DROP INDEX IF EXISTS "role_tasks_task_id_role_id_resource_type_resource_id_idx";
+CREATE UNIQUE INDEX ON "role_tasks" ("task_id", "role_id");
+
+ALTER TABLE IF EXISTS "account_roles" DROP COLUMN IF EXISTS "resource_type";
+ALTER TABLE IF EXISTS "account_roles" DROP COLUMN IF EXISTS "resource_id";
+ALTER TABLE IF EXISTS "role_tasks" DROP COLUMN IF EXISTS "resource_type";
+ALTER TABLE IF EXISTS "role_tasks" DROP COLUMN IF EXISTS "resource_id";
@@ -0,0 +1,11 @@
+ALTER TABLE "account_roles" ADD COLUMN "resource_type" varchar NOT NULL DEFAULT '';
+ALTER TABLE "account_roles" ADD COLUMN "resource_id" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "role_tasks" ADD COLUMN "resource_type" varchar NOT NULL DEFAULT '';
+ALTER TABLE "role_tasks" ADD COLUMN "resource_id" varchar NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS "account_roles_account_id_role_id_idx";
+CREATE UNIQUE INDEX ON "account_roles" ("account_id", "role_id", "resource_type", "resource_id");
+
+DROP INDEX IF EXISTS "role_tasks_task_id_role_id_idx";
+CREATE UNIQUE INDEX ON "role_tasks" ("task_id", "role_id", "resource_type", "resource_id");
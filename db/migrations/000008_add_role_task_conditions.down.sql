@@ -0,0 +1,2 @@
+DELETE FROM "role_tasks" WHERE "condition" <> '';
+ALTER TABLE IF EXISTS "role_tasks" DROP COLUMN IF EXISTS "condition";
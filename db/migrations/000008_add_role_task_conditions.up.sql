@@ -0,0 +1 @@
+ALTER TABLE "role_tasks" ADD COLUMN "condition" varchar NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE "role_tasks" ADD COLUMN "effect" varchar NOT NULL DEFAULT 'allow' CHECK ("effect" IN ('allow', 'deny'));
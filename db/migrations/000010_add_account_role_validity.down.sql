@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS "account_roles_valid_until_idx";
+DROP INDEX IF EXISTS "account_roles_valid_from_idx";
+
+ALTER TABLE IF EXISTS "account_roles" DROP CONSTRAINT IF EXISTS "account_roles_validity_check";
+ALTER TABLE IF EXISTS "account_roles" DROP COLUMN IF EXISTS "valid_from";
+ALTER TABLE IF EXISTS "account_roles" DROP COLUMN IF EXISTS "valid_until";
@@ -0,0 +1,6 @@
+ALTER TABLE "account_roles" ADD COLUMN "valid_from" timestamptz;
+ALTER TABLE "account_roles" ADD COLUMN "valid_until" timestamptz;
+ALTER TABLE "account_roles" ADD CONSTRAINT "account_roles_validity_check" CHECK ("valid_from" IS NULL OR "valid_until" IS NULL OR "valid_from" < "valid_until");
+
+CREATE INDEX ON "account_roles" ("valid_until") WHERE "valid_until" IS NOT NULL;
+CREATE INDEX ON "account_roles" ("valid_from") WHERE "valid_from" IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS "roles_tenant_id_role_idx";
+ALTER TABLE IF EXISTS "roles" DROP COLUMN IF EXISTS "tenant_id";
+ALTER TABLE IF EXISTS "roles" ADD CONSTRAINT "roles_role_key" UNIQUE ("role");
+
+DROP TABLE IF EXISTS "account_tenants";
+DROP TABLE IF EXISTS "tenants";
@@ -0,0 +1,33 @@
+CREATE TABLE "tenants" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "name" varchar UNIQUE NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "account_tenants" (
+  "account_id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "is_active" boolean NOT NULL DEFAULT false,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "tenant_id")
+);
+
+ALTER TABLE "account_tenants" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_tenants" ADD FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id");
+
+-- existing accounts and roles move to the default tenant, documents indexed for search before this
+-- migration carry no tenant and have to be indexed again
+INSERT INTO "tenants" ("name") VALUES ('default');
+
+INSERT INTO "account_tenants" ("account_id", "tenant_id", "is_active")
+SELECT "accounts"."id", "tenants"."id", true FROM "accounts", "tenants" WHERE "tenants"."name" = 'default';
+
+ALTER TABLE "roles" ADD COLUMN "tenant_id" uuid;
+UPDATE "roles" SET "tenant_id" = (SELECT "id" FROM "tenants" WHERE "name" = 'default');
+ALTER TABLE "roles" ALTER COLUMN "tenant_id" SET NOT NULL;
+
+ALTER TABLE "roles" ADD FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id");
+
+ALTER TABLE "roles" DROP CONSTRAINT IF EXISTS "roles_role_key";
+CREATE UNIQUE INDEX ON "roles" ("tenant_id", "role");
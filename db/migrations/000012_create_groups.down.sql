@@ -0,0 +1,11 @@
+ALTER TABLE IF EXISTS "group_roles" DROP CONSTRAINT IF EXISTS "group_roles_group_id_fkey";
+ALTER TABLE IF EXISTS "group_roles" DROP CONSTRAINT IF EXISTS "group_roles_role_id_fkey";
+DROP TABLE IF EXISTS "group_roles";
+ALTER TABLE IF EXISTS "group_nesting" DROP CONSTRAINT IF EXISTS "group_nesting_group_id_fkey";
+ALTER TABLE IF EXISTS "group_nesting" DROP CONSTRAINT IF EXISTS "group_nesting_member_group_id_fkey";
+DROP TABLE IF EXISTS "group_nesting";
+ALTER TABLE IF EXISTS "group_members" DROP CONSTRAINT IF EXISTS "group_members_group_id_fkey";
+ALTER TABLE IF EXISTS "group_members" DROP CONSTRAINT IF EXISTS "group_members_account_id_fkey";
+DROP TABLE IF EXISTS "group_members";
+ALTER TABLE IF EXISTS "groups" DROP CONSTRAINT IF EXISTS "groups_tenant_id_fkey";
+DROP TABLE IF EXISTS "groups";
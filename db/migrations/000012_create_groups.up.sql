@@ -0,0 +1,57 @@
+CREATE TABLE "groups" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "tenant_id" uuid NOT NULL,
+  "name" varchar NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "groups" ADD FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id");
+
+CREATE UNIQUE INDEX ON "groups" ("tenant_id", "name");
+
+CREATE TABLE "group_members" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "group_id" uuid NOT NULL,
+  "account_id" uuid NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "group_members" ADD FOREIGN KEY ("group_id") REFERENCES "groups" ("id");
+
+ALTER TABLE "group_members" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE UNIQUE INDEX ON "group_members" ("group_id", "account_id");
+
+CREATE INDEX ON "group_members" ("account_id");
+
+-- the members of member_group_id are members of group_id as well
+CREATE TABLE "group_nesting" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "group_id" uuid NOT NULL,
+  "member_group_id" uuid NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  CHECK ("group_id" <> "member_group_id")
+);
+
+ALTER TABLE "group_nesting" ADD FOREIGN KEY ("group_id") REFERENCES "groups" ("id");
+
+ALTER TABLE "group_nesting" ADD FOREIGN KEY ("member_group_id") REFERENCES "groups" ("id");
+
+CREATE UNIQUE INDEX ON "group_nesting" ("group_id", "member_group_id");
+
+CREATE INDEX ON "group_nesting" ("member_group_id");
+
+CREATE TABLE "group_roles" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "group_id" uuid NOT NULL,
+  "role_id" uuid NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "group_roles" ADD FOREIGN KEY ("group_id") REFERENCES "groups" ("id");
+
+ALTER TABLE "group_roles" ADD FOREIGN KEY ("role_id") REFERENCES "roles" ("id");
+
+CREATE UNIQUE INDEX ON "group_roles" ("group_id", "role_id");
+
+CREATE INDEX ON "group_roles" ("role_id");
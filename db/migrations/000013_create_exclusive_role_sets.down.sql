@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS "exclusive_role_set_roles" DROP CONSTRAINT IF EXISTS "exclusive_role_set_roles_set_id_fkey";
+ALTER TABLE IF EXISTS "exclusive_role_set_roles" DROP CONSTRAINT IF EXISTS "exclusive_role_set_roles_role_id_fkey";
+DROP TABLE IF EXISTS "exclusive_role_set_roles";
+ALTER TABLE IF EXISTS "exclusive_role_sets" DROP CONSTRAINT IF EXISTS "exclusive_role_sets_tenant_id_fkey";
+DROP TABLE IF EXISTS "exclusive_role_sets";
@@ -0,0 +1,26 @@
+-- an account may hold at most one of the roles of an exclusive role set
+CREATE TABLE "exclusive_role_sets" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "tenant_id" uuid NOT NULL,
+  "name" varchar NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "exclusive_role_sets" ADD FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id");
+
+CREATE UNIQUE INDEX ON "exclusive_role_sets" ("tenant_id", "name");
+
+CREATE TABLE "exclusive_role_set_roles" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "set_id" uuid NOT NULL,
+  "role_id" uuid NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "exclusive_role_set_roles" ADD FOREIGN KEY ("set_id") REFERENCES "exclusive_role_sets" ("id");
+
+ALTER TABLE "exclusive_role_set_roles" ADD FOREIGN KEY ("role_id") REFERENCES "roles" ("id");
+
+CREATE UNIQUE INDEX ON "exclusive_role_set_roles" ("set_id", "role_id");
+
+CREATE INDEX ON "exclusive_role_set_roles" ("role_id");
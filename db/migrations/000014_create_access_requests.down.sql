@@ -0,0 +1,7 @@
+ALTER TABLE IF EXISTS "access_requests" DROP CONSTRAINT IF EXISTS "access_requests_account_id_fkey";
+ALTER TABLE IF EXISTS "access_requests" DROP CONSTRAINT IF EXISTS "access_requests_role_id_fkey";
+ALTER TABLE IF EXISTS "access_requests" DROP CONSTRAINT IF EXISTS "access_requests_decided_by_fkey";
+DROP TABLE IF EXISTS "access_requests";
+ALTER TABLE IF EXISTS "role_approvers" DROP CONSTRAINT IF EXISTS "role_approvers_role_id_fkey";
+ALTER TABLE IF EXISTS "role_approvers" DROP CONSTRAINT IF EXISTS "role_approvers_approver_role_id_fkey";
+DROP TABLE IF EXISTS "role_approvers";
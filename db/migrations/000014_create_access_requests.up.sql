@@ -0,0 +1,39 @@
+-- the holders of approver_role_id decide the access requests for role_id
+CREATE TABLE "role_approvers" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "role_id" uuid NOT NULL,
+  "approver_role_id" uuid NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "role_approvers" ADD FOREIGN KEY ("role_id") REFERENCES "roles" ("id");
+
+ALTER TABLE "role_approvers" ADD FOREIGN KEY ("approver_role_id") REFERENCES "roles" ("id");
+
+CREATE UNIQUE INDEX ON "role_approvers" ("role_id", "approver_role_id");
+
+CREATE INDEX ON "role_approvers" ("approver_role_id");
+
+CREATE TABLE "access_requests" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "account_id" uuid NOT NULL,
+  "role_id" uuid NOT NULL,
+  "justification" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT ('pending'),
+  "decided_by" uuid,
+  "comment" varchar NOT NULL DEFAULT (''),
+  "expires_at" timestamp NOT NULL,
+  "decided_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "access_requests" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "access_requests" ADD FOREIGN KEY ("role_id") REFERENCES "roles" ("id");
+
+ALTER TABLE "access_requests" ADD FOREIGN KEY ("decided_by") REFERENCES "accounts" ("id");
+
+-- an account has at most one pending request per role
+CREATE UNIQUE INDEX ON "access_requests" ("account_id", "role_id") WHERE "status" = 'pending';
+
+CREATE INDEX ON "access_requests" ("role_id", "status");
@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS "break_glass_activations" DROP CONSTRAINT IF EXISTS "break_glass_activations_account_id_fkey";
+ALTER TABLE IF EXISTS "break_glass_activations" DROP CONSTRAINT IF EXISTS "break_glass_activations_role_id_fkey";
+DROP TABLE IF EXISTS "break_glass_activations";
+ALTER TABLE IF EXISTS "emergency_roles" DROP CONSTRAINT IF EXISTS "emergency_roles_role_id_fkey";
+DROP TABLE IF EXISTS "emergency_roles";
@@ -0,0 +1,22 @@
+-- accounts allowed to break glass can self-elevate to emergency roles for a limited time
+CREATE TABLE "emergency_roles" (
+  "role_id" uuid PRIMARY KEY,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "emergency_roles" ADD FOREIGN KEY ("role_id") REFERENCES "roles" ("id");
+
+CREATE TABLE "break_glass_activations" (
+  "id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4()),
+  "account_id" uuid NOT NULL,
+  "role_id" uuid NOT NULL,
+  "reason" varchar NOT NULL,
+  "expires_at" timestamp NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "break_glass_activations" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "break_glass_activations" ADD FOREIGN KEY ("role_id") REFERENCES "roles" ("id");
+
+CREATE INDEX ON "break_glass_activations" ("role_id", "created_at");
@@ -0,0 +1,31 @@
+-- the role tasks of every tenant are pointed back to the tasks of the default tenant before the copies are
+-- dropped
+UPDATE "role_tasks" SET "task_id" = "originals"."id"
+FROM "tasks", "tasks" AS "originals", "tenants"
+WHERE
+  "tasks"."id" = "role_tasks"."task_id" AND "originals"."task" = "tasks"."task"
+  AND "originals"."tenant_id" = "tenants"."id" AND "tenants"."name" = 'default'
+  AND "tasks"."tenant_id" <> "tenants"."id";
+
+DELETE FROM "helptext" USING "tenants" WHERE "helptext"."tenant_id" = "tenants"."id" AND "tenants"."name" <> 'default';
+DELETE FROM "menu" USING "tenants" WHERE "menu"."tenant_id" = "tenants"."id" AND "tenants"."name" <> 'default';
+DELETE FROM "navigation" USING "tenants" WHERE "navigation"."tenant_id" = "tenants"."id" AND "tenants"."name" <> 'default';
+DELETE FROM "role_tasks" USING "tasks", "tenants"
+WHERE "role_tasks"."task_id" = "tasks"."id" AND "tasks"."tenant_id" = "tenants"."id" AND "tenants"."name" <> 'default';
+DELETE FROM "tasks" USING "tenants" WHERE "tasks"."tenant_id" = "tenants"."id" AND "tenants"."name" <> 'default';
+
+DROP INDEX IF EXISTS "navigation_tenant_id_name_idx";
+ALTER TABLE IF EXISTS "navigation" DROP COLUMN IF EXISTS "tenant_id";
+ALTER TABLE IF EXISTS "navigation" ADD CONSTRAINT "navigation_name_key" UNIQUE ("name");
+
+DROP INDEX IF EXISTS "menu_tenant_id_name_idx";
+ALTER TABLE IF EXISTS "menu" DROP COLUMN IF EXISTS "tenant_id";
+ALTER TABLE IF EXISTS "menu" ADD CONSTRAINT "menu_name_key" UNIQUE ("name");
+
+DROP INDEX IF EXISTS "helptext_tenant_id_helptext_idx";
+ALTER TABLE IF EXISTS "helptext" DROP COLUMN IF EXISTS "tenant_id";
+ALTER TABLE IF EXISTS "helptext" ADD CONSTRAINT "helptext_helptext_key" UNIQUE ("helptext");
+
+DROP INDEX IF EXISTS "tasks_tenant_id_task_idx";
+ALTER TABLE IF EXISTS "tasks" DROP COLUMN IF EXISTS "tenant_id";
+ALTER TABLE IF EXISTS "tasks" ADD CONSTRAINT "tasks_task_key" UNIQUE ("task");
@@ -0,0 +1,71 @@
+-- tasks, their help texts, menus and navigations belong to a tenant like roles. The existing ones move to the
+-- default tenant and every other tenant gets its own copy, which the role tasks of its roles are pointed to.
+-- Documents indexed for search before this migration carry no tenant and have to be indexed again.
+ALTER TABLE "tasks" ADD COLUMN "tenant_id" uuid;
+UPDATE "tasks" SET "tenant_id" = (SELECT "id" FROM "tenants" WHERE "name" = 'default');
+ALTER TABLE "tasks" ALTER COLUMN "tenant_id" SET NOT NULL;
+
+ALTER TABLE "tasks" ADD FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id");
+
+ALTER TABLE "tasks" DROP CONSTRAINT IF EXISTS "tasks_task_key";
+CREATE UNIQUE INDEX ON "tasks" ("tenant_id", "task");
+
+ALTER TABLE "helptext" ADD COLUMN "tenant_id" uuid;
+UPDATE "helptext" SET "tenant_id" = "tasks"."tenant_id" FROM "tasks" WHERE "tasks"."id" = "helptext"."task_id";
+ALTER TABLE "helptext" ALTER COLUMN "tenant_id" SET NOT NULL;
+
+ALTER TABLE "helptext" ADD FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id");
+
+ALTER TABLE "helptext" DROP CONSTRAINT IF EXISTS "helptext_helptext_key";
+CREATE UNIQUE INDEX ON "helptext" ("tenant_id", "helptext");
+
+ALTER TABLE "menu" ADD COLUMN "tenant_id" uuid;
+UPDATE "menu" SET "tenant_id" = "tasks"."tenant_id" FROM "tasks" WHERE "tasks"."id" = "menu"."task_id";
+ALTER TABLE "menu" ALTER COLUMN "tenant_id" SET NOT NULL;
+
+ALTER TABLE "menu" ADD FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id");
+
+ALTER TABLE "menu" DROP CONSTRAINT IF EXISTS "menu_name_key";
+CREATE UNIQUE INDEX ON "menu" ("tenant_id", "name");
+
+ALTER TABLE "navigation" ADD COLUMN "tenant_id" uuid;
+UPDATE "navigation" SET "tenant_id" = "tasks"."tenant_id" FROM "tasks" WHERE "tasks"."id" = "navigation"."task_id";
+ALTER TABLE "navigation" ALTER COLUMN "tenant_id" SET NOT NULL;
+
+ALTER TABLE "navigation" ADD FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id");
+
+ALTER TABLE "navigation" DROP CONSTRAINT IF EXISTS "navigation_name_key";
+CREATE UNIQUE INDEX ON "navigation" ("tenant_id", "name");
+
+INSERT INTO "tasks" ("task", "tenant_id", "created_at")
+SELECT "tasks"."task", "tenants"."id", "tasks"."created_at"
+FROM "tasks", "tenants"
+WHERE "tenants"."id" <> "tasks"."tenant_id";
+
+INSERT INTO "helptext" ("task_id", "helptext", "tenant_id", "created_at")
+SELECT "copies"."id", "helptext"."helptext", "copies"."tenant_id", "helptext"."created_at"
+FROM
+  "helptext"
+  INNER JOIN "tasks" ON "tasks"."id" = "helptext"."task_id"
+  INNER JOIN "tasks" AS "copies" ON "copies"."task" = "tasks"."task" AND "copies"."tenant_id" <> "tasks"."tenant_id";
+
+INSERT INTO "menu" ("task_id", "name", "tenant_id", "created_at")
+SELECT "copies"."id", "menu"."name", "copies"."tenant_id", "menu"."created_at"
+FROM
+  "menu"
+  INNER JOIN "tasks" ON "tasks"."id" = "menu"."task_id"
+  INNER JOIN "tasks" AS "copies" ON "copies"."task" = "tasks"."task" AND "copies"."tenant_id" <> "tasks"."tenant_id";
+
+INSERT INTO "navigation" ("task_id", "name", "tenant_id", "created_at")
+SELECT "copies"."id", "navigation"."name", "copies"."tenant_id", "navigation"."created_at"
+FROM
+  "navigation"
+  INNER JOIN "tasks" ON "tasks"."id" = "navigation"."task_id"
+  INNER JOIN "tasks" AS "copies" ON "copies"."task" = "tasks"."task" AND "copies"."tenant_id" <> "tasks"."tenant_id";
+
+UPDATE "role_tasks" SET "task_id" = "copies"."id"
+FROM "roles", "tasks", "tasks" AS "copies"
+WHERE
+  "roles"."id" = "role_tasks"."role_id" AND "tasks"."id" = "role_tasks"."task_id"
+  AND "copies"."task" = "tasks"."task" AND "copies"."tenant_id" = "roles"."tenant_id"
+  AND "tasks"."tenant_id" <> "roles"."tenant_id";
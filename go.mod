@@ -22,7 +22,7 @@ require (
 	github.com/hashicorp/vault/api v1.1.1
 	github.com/jackc/pgx/v4 v4.10.1
 	github.com/joho/godotenv v1.3.0
-	github.com/lib/pq v1.10.2 // indirect
+	github.com/lib/pq v1.10.2
 	github.com/mattn/go-colorable v0.1.8 // indirect
 	github.com/o1egl/paseto v1.0.0
 	github.com/opencontainers/runc v1.0.1 // indirect
@@ -0,0 +1,44 @@
+package internal
+
+import "time"
+
+const (
+	ACCESS_REQUEST_PENDING  = "pending"
+	ACCESS_REQUEST_APPROVED = "approved"
+	ACCESS_REQUEST_REJECTED = "rejected"
+	ACCESS_REQUEST_EXPIRED  = "expired"
+)
+
+// AccessRequest is an account asking for a role, the holders of the approver roles of the role approve or
+// reject it before it expires.
+type AccessRequest struct {
+	Id            string
+	Account       Account
+	Role          Roles
+	Justification string
+	Status        string
+	// DecidedBy is the username of the account that approved or rejected the request.
+	DecidedBy string
+	Comment   string
+	ExpiresAt time.Time
+	DecidedAt time.Time
+	CreatedAt time.Time
+}
+
+func (a *AccessRequest) Validate() error {
+	if a.Role.Id == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "role id is required")
+	}
+	if a.Justification == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "justification is required")
+	}
+	return nil
+}
+
+// StatusAt returns the status of the request at the time, pending requests past their expiry are expired.
+func (a AccessRequest) StatusAt(now time.Time) string {
+	if a.Status == ACCESS_REQUEST_PENDING && !now.Before(a.ExpiresAt) {
+		return ACCESS_REQUEST_EXPIRED
+	}
+	return a.Status
+}
@@ -0,0 +1,25 @@
+package internal_test
+
+import (
+	"rbac/internal"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessRequest_Validate(t *testing.T) {
+	require.NoError(t, (&internal.AccessRequest{Role: internal.Roles{Id: "r1"}, Justification: "on call"}).Validate())
+	require.Error(t, (&internal.AccessRequest{Justification: "on call"}).Validate())
+	require.Error(t, (&internal.AccessRequest{Role: internal.Roles{Id: "r1"}}).Validate())
+}
+
+func TestAccessRequest_StatusAt(t *testing.T) {
+	now := time.Now()
+	pending := internal.AccessRequest{Status: internal.ACCESS_REQUEST_PENDING, ExpiresAt: now.Add(time.Hour)}
+	require.Equal(t, internal.ACCESS_REQUEST_PENDING, pending.StatusAt(now))
+	require.Equal(t, internal.ACCESS_REQUEST_EXPIRED, pending.StatusAt(now.Add(time.Hour)))
+
+	approved := internal.AccessRequest{Status: internal.ACCESS_REQUEST_APPROVED, ExpiresAt: now.Add(-time.Hour)}
+	require.Equal(t, internal.ACCESS_REQUEST_APPROVED, approved.StatusAt(now))
+}
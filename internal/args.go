@@ -50,6 +50,8 @@ type AccountRoleByRoleResult struct {
 type RoleTaskByRole struct {
 	Role  Roles
 	Tasks []Tasks
+	// DeniedTasks are the tasks of the role with the EFFECT_DENY effect.
+	DeniedTasks []Tasks
 }
 
 type RoleTaskByTask struct {
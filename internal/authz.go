@@ -0,0 +1,38 @@
+package internal
+
+import "time"
+
+// MAX_PERMISSION_CHECKS bounds the number of checks decided in a single batch.
+const MAX_PERMISSION_CHECKS = 100
+
+// PermissionCheck asks whether the subject, a username, may perform the task, on the resource when one is
+// given. The conditions of the grants are evaluated against the IP and the Time of the subject, the time of
+// the check when Time is zero, conditions on the ip of a subject without IP don't hold.
+type PermissionCheck struct {
+	Subject  string
+	Task     string
+	Resource *Resource
+	IP       string
+	Time     time.Time
+}
+
+func (c *PermissionCheck) Validate() error {
+	if c.Subject == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "subject is required")
+	}
+	if c.Task == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "task is required")
+	}
+	if c.Resource != nil && (c.Resource.Type == "" || c.Resource.Id == "") {
+		return NewErrorf(ErrorCodeInvalidArgument, "resource type and id are required")
+	}
+	return nil
+}
+
+// PermissionDecision is the answer to a PermissionCheck, a check that couldn't be decided is refused with
+// the Err that prevented it.
+type PermissionDecision struct {
+	PermissionCheck
+	Allowed bool
+	Err     error
+}
@@ -0,0 +1,24 @@
+package internal_test
+
+import (
+	"rbac/internal"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionCheck_Validate(t *testing.T) {
+	require.NoError(t, (&internal.PermissionCheck{Subject: "alice", Task: internal.GET_ACCOUNT}).Validate())
+	require.NoError(t, (&internal.PermissionCheck{
+		Subject:  "alice",
+		Task:     internal.GET_ACCOUNT,
+		Resource: &internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: "bob"},
+	}).Validate())
+	require.Error(t, (&internal.PermissionCheck{Task: internal.GET_ACCOUNT}).Validate())
+	require.Error(t, (&internal.PermissionCheck{Subject: "alice"}).Validate())
+	require.Error(t, (&internal.PermissionCheck{
+		Subject:  "alice",
+		Task:     internal.GET_ACCOUNT,
+		Resource: &internal.Resource{Type: internal.RESOURCE_ACCOUNT},
+	}).Validate())
+}
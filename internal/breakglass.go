@@ -0,0 +1,29 @@
+package internal
+
+import "time"
+
+// BreakGlassActivation is an account elevating itself to an emergency role in an emergency, the role is
+// granted until ExpiresAt and every activation is reported.
+type BreakGlassActivation struct {
+	Id        string
+	Account   Account
+	Role      Roles
+	Reason    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (b *BreakGlassActivation) Validate() error {
+	if b.Role.Id == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "role id is required")
+	}
+	if b.Reason == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "reason is required")
+	}
+	return nil
+}
+
+// IsActiveAt returns true when the emergency role is still granted at the time.
+func (b BreakGlassActivation) IsActiveAt(now time.Time) bool {
+	return now.Before(b.ExpiresAt)
+}
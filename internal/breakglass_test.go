@@ -0,0 +1,22 @@
+package internal_test
+
+import (
+	"rbac/internal"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreakGlassActivation_Validate(t *testing.T) {
+	require.NoError(t, (&internal.BreakGlassActivation{Role: internal.Roles{Id: "r1"}, Reason: "database down"}).Validate())
+	require.Error(t, (&internal.BreakGlassActivation{Reason: "database down"}).Validate())
+	require.Error(t, (&internal.BreakGlassActivation{Role: internal.Roles{Id: "r1"}}).Validate())
+}
+
+func TestBreakGlassActivation_IsActiveAt(t *testing.T) {
+	now := time.Now()
+	activation := internal.BreakGlassActivation{ExpiresAt: now.Add(time.Hour)}
+	require.True(t, activation.IsActiveAt(now))
+	require.False(t, activation.IsActiveAt(now.Add(time.Hour)))
+}
@@ -0,0 +1,208 @@
+// Package condition implements the small expression language restricting role task grants to some requests,
+// for example:
+//
+//	time >= "09:00" && time < "18:00" && weekday in ["Mon", "Tue", "Wed", "Thu", "Fri"]
+//	ip in ["10.0.0.0/8", "192.168.1.0/24"]
+//	email endsWith "@example.com" || username == "admin"
+//
+// Comparisons are made of an attribute, an operator and a string or a list of strings. Attributes are time
+// ("15:04" in UTC), weekday ("Mon" to "Sun"), ip and username, along with email, mobile, first_name and
+// last_name taken from the profile of the account. Operators are ==, !=, <, <=, >, >=, in, startsWith and
+// endsWith, where in matches CIDR blocks when used with ip. Comparisons are combined with &&, || and !
+// and grouped with parentheses. A condition referring to an attribute missing from the request never holds,
+// whatever the operators, so that negating a comparison can't grant on missing data.
+package condition
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	AttrTime      = "time"
+	AttrWeekday   = "weekday"
+	AttrIP        = "ip"
+	AttrUsername  = "username"
+	AttrEmail     = "email"
+	AttrMobile    = "mobile"
+	AttrFirstName = "first_name"
+	AttrLastName  = "last_name"
+)
+
+// maxLength bounds the size of an expression, conditions are meant to stay small.
+const maxLength = 1024
+
+var attributes = map[string]bool{
+	AttrTime:      true,
+	AttrWeekday:   true,
+	AttrIP:        true,
+	AttrUsername:  true,
+	AttrEmail:     true,
+	AttrMobile:    true,
+	AttrFirstName: true,
+	AttrLastName:  true,
+}
+
+var weekdays = map[string]bool{"Mon": true, "Tue": true, "Wed": true, "Thu": true, "Fri": true, "Sat": true, "Sun": true}
+
+// Env holds the values the attributes of a condition are evaluated against.
+type Env struct {
+	Time time.Time
+	IP   string
+	// Attributes holds username and the profile attributes.
+	Attributes map[string]string
+}
+
+func (e Env) value(attr string) string {
+	switch attr {
+	case AttrTime:
+		if e.Time.IsZero() {
+			return ""
+		}
+		return e.Time.UTC().Format("15:04")
+	case AttrWeekday:
+		if e.Time.IsZero() {
+			return ""
+		}
+		return e.Time.UTC().Weekday().String()[:3]
+	case AttrIP:
+		return e.IP
+	}
+	return e.Attributes[attr]
+}
+
+// Condition is a parsed expression.
+type Condition struct {
+	expr string
+	root node
+}
+
+// Parse parses and validates the expression.
+func Parse(expr string) (*Condition, error) {
+	if len(expr) > maxLength {
+		return nil, fmt.Errorf("condition is longer than %d characters", maxLength)
+	}
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at %d", p.peek().text, p.peek().pos)
+	}
+	return &Condition{expr: expr, root: root}, nil
+}
+
+// Eval returns true when the condition holds in env, it never holds when one of its attributes is missing
+// from env.
+func (c *Condition) Eval(env Env) bool {
+	missing := false
+	c.root.walk(func(cmp *comparison) {
+		missing = missing || env.value(cmp.attr) == ""
+	})
+	if missing {
+		return false
+	}
+	return c.root.eval(env)
+}
+
+// String returns the expression the condition was parsed from.
+func (c *Condition) String() string {
+	return c.expr
+}
+
+// Attributes returns the attributes the condition refers to.
+func (c *Condition) Attributes() []string {
+	seen := map[string]bool{}
+	var res []string
+	c.root.walk(func(cmp *comparison) {
+		if !seen[cmp.attr] {
+			seen[cmp.attr] = true
+			res = append(res, cmp.attr)
+		}
+	})
+	return res
+}
+
+type node interface {
+	eval(env Env) bool
+	walk(fn func(*comparison))
+}
+
+type and struct{ left, right node }
+
+func (n *and) eval(env Env) bool { return n.left.eval(env) && n.right.eval(env) }
+func (n *and) walk(fn func(*comparison)) {
+	n.left.walk(fn)
+	n.right.walk(fn)
+}
+
+type or struct{ left, right node }
+
+func (n *or) eval(env Env) bool { return n.left.eval(env) || n.right.eval(env) }
+func (n *or) walk(fn func(*comparison)) {
+	n.left.walk(fn)
+	n.right.walk(fn)
+}
+
+type not struct{ operand node }
+
+func (n *not) eval(env Env) bool         { return !n.operand.eval(env) }
+func (n *not) walk(fn func(*comparison)) { n.operand.walk(fn) }
+
+type comparison struct {
+	attr   string
+	op     string
+	values []string
+	nets   []*net.IPNet
+}
+
+func (n *comparison) walk(fn func(*comparison)) { fn(n) }
+
+func (n *comparison) eval(env Env) bool {
+	v := env.value(n.attr)
+	switch n.op {
+	case "==":
+		return v == n.values[0]
+	case "!=":
+		return v != n.values[0]
+	case "<":
+		return v < n.values[0]
+	case "<=":
+		return v <= n.values[0]
+	case ">":
+		return v > n.values[0]
+	case ">=":
+		return v >= n.values[0]
+	case "startsWith":
+		return strings.HasPrefix(v, n.values[0])
+	case "endsWith":
+		return strings.HasSuffix(v, n.values[0])
+	case "in":
+		if n.nets != nil {
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return false
+			}
+			for _, cidr := range n.nets {
+				if cidr.Contains(ip) {
+					return true
+				}
+			}
+			return false
+		}
+		for _, value := range n.values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
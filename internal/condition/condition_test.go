@@ -0,0 +1,90 @@
+package condition_test
+
+import (
+	"rbac/internal/condition"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition_Eval(t *testing.T) {
+	// a Tuesday
+	at := time.Date(2021, 6, 15, 10, 30, 0, 0, time.UTC)
+	env := condition.Env{
+		Time: at,
+		IP:   "10.1.2.3",
+		Attributes: map[string]string{
+			condition.AttrUsername: "alice",
+			condition.AttrEmail:    "alice@example.com",
+		},
+	}
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`time >= "09:00" && time < "18:00"`, true},
+		{`time >= "11:00"`, false},
+		{`weekday in ["Mon", "Tue"]`, true},
+		{`weekday == "Sun"`, false},
+		{`ip in "10.0.0.0/8"`, true},
+		{`ip in ["192.168.0.0/16", "172.16.0.0/12"]`, false},
+		{`ip == "10.1.2.3"`, true},
+		{`email endsWith "@example.com"`, true},
+		{`email endsWith "@example.org" || username == "alice"`, true},
+		{`!(username startsWith "al")`, false},
+		{`mobile != "123"`, false},
+	}
+	for _, tt := range tests {
+		c, err := condition.Parse(tt.expr)
+		require.NoError(t, err, tt.expr)
+		require.Equal(t, tt.want, c.Eval(env), tt.expr)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		``,
+		`time >= "9:00"`,
+		`weekday == "Monday"`,
+		`ip in "10.0.0.0"`,
+		`ip startsWith "10."`,
+		`department == "x"`,
+		`email ~= "x"`,
+		`email == "x" &&`,
+		`(email == "x"`,
+		`email == "x`,
+		`email in []`,
+	} {
+		_, err := condition.Parse(expr)
+		require.Error(t, err, expr)
+	}
+}
+
+func TestCondition_Attributes(t *testing.T) {
+	c, err := condition.Parse(`email endsWith "@example.com" && (ip in "10.0.0.0/8" || email == "a@b.c")`)
+	require.NoError(t, err)
+	require.Equal(t, []string{condition.AttrEmail, condition.AttrIP}, c.Attributes())
+}
+
+func TestCondition_EvalMissingAttribute(t *testing.T) {
+	env := condition.Env{
+		Time:       time.Date(2021, 6, 15, 10, 30, 0, 0, time.UTC),
+		Attributes: map[string]string{condition.AttrUsername: "alice"},
+	}
+	for _, expr := range []string{
+		`!(email == "alice@example.com")`,
+		`!(ip in "10.0.0.0/8")`,
+		`mobile != "123"`,
+		`username == "alice" || !(email endsWith "@example.com")`,
+		`!(username == "bob") && !(first_name == "Bob")`,
+	} {
+		c, err := condition.Parse(expr)
+		require.NoError(t, err, expr)
+		require.False(t, c.Eval(env), expr)
+	}
+
+	c, err := condition.Parse(`time >= "00:00"`)
+	require.NoError(t, err)
+	require.False(t, c.Eval(condition.Env{}))
+}
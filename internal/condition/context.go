@@ -0,0 +1,28 @@
+package condition
+
+import (
+	"context"
+	"time"
+)
+
+// Request describes the request being authorized.
+type Request struct {
+	IP   string
+	Time time.Time
+}
+
+type requestKey struct{}
+
+// NewContext returns a copy of ctx carrying the request.
+func NewContext(ctx context.Context, req Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, req)
+}
+
+// FromContext returns the request, its Time defaults to now when missing.
+func FromContext(ctx context.Context) Request {
+	req, _ := ctx.Value(requestKey{}).(Request)
+	if req.Time.IsZero() {
+		req.Time = time.Now()
+	}
+	return req
+}
@@ -0,0 +1,248 @@
+package condition
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func lex(expr string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(expr) && expr[j] != '"'; j++ {
+				if expr[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			s, err := strconv.Unquote(expr[i : j+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at %d: %w", i, err)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: s, pos: i})
+			i = j + 1
+		case unicode.IsLetter(c) || c == '_':
+			j := i
+			for j < len(expr) && (unicode.IsLetter(rune(expr[j])) || expr[j] == '_') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: expr[i:j], pos: i})
+			i = j
+		default:
+			op := ""
+			if i+1 < len(expr) {
+				switch expr[i : i+2] {
+				case "==", "!=", "<=", ">=", "&&", "||":
+					op = expr[i : i+2]
+				}
+			}
+			if op == "" {
+				switch c {
+				case '<', '>', '!', '(', ')', '[', ']', ',':
+					op = string(c)
+				default:
+					return nil, fmt.Errorf("unexpected %q at %d", c, i)
+				}
+			}
+			tokens = append(tokens, token{kind: tokenOp, text: op, pos: i})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, text: "end of condition", pos: len(expr)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(op string) error {
+	t := p.next()
+	if t.kind != tokenOp || t.text != op {
+		return fmt.Errorf("expected %q at %d, got %q", op, t.pos, t.text)
+	}
+	return nil
+}
+
+func (p *parser) isOp(op string) bool {
+	t := p.peek()
+	return t.kind == tokenOp && t.text == op
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("||") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &or{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("&&") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &and{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOp("!") {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &not{operand: operand}, nil
+	}
+	if p.isOp("(") {
+		p.next()
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return n, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	t := p.next()
+	if t.kind != tokenIdent || !attributes[t.text] {
+		return nil, fmt.Errorf("unknown attribute %q at %d", t.text, t.pos)
+	}
+	cmp := &comparison{attr: t.text}
+	op := p.next()
+	switch {
+	case op.kind == tokenOp && (op.text == "==" || op.text == "!=" || op.text == "<" || op.text == "<=" || op.text == ">" || op.text == ">="):
+	case op.kind == tokenIdent && (op.text == "in" || op.text == "startsWith" || op.text == "endsWith"):
+	default:
+		return nil, fmt.Errorf("unknown operator %q at %d", op.text, op.pos)
+	}
+	cmp.op = op.text
+	if cmp.op == "in" && p.isOp("[") {
+		p.next()
+		for {
+			v := p.next()
+			if v.kind != tokenString {
+				return nil, fmt.Errorf("expected a string at %d, got %q", v.pos, v.text)
+			}
+			cmp.values = append(cmp.values, v.text)
+			if !p.isOp(",") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+	} else {
+		v := p.next()
+		if v.kind != tokenString {
+			return nil, fmt.Errorf("expected a string at %d, got %q", v.pos, v.text)
+		}
+		cmp.values = []string{v.text}
+	}
+	if err := cmp.validate(op.pos); err != nil {
+		return nil, err
+	}
+	return cmp, nil
+}
+
+// validate checks the values make sense for the attribute, catching typos like "9:00" when the grant is
+// created rather than silently never matching.
+func (n *comparison) validate(pos int) error {
+	switch n.attr {
+	case AttrTime:
+		if n.op == "startsWith" || n.op == "endsWith" {
+			return fmt.Errorf("%s can't be used with time at %d", n.op, pos)
+		}
+		for _, v := range n.values {
+			if _, err := time.Parse("15:04", v); err != nil || len(v) != 5 {
+				return fmt.Errorf("invalid time %q at %d, use HH:MM", v, pos)
+			}
+		}
+	case AttrWeekday:
+		if n.op != "==" && n.op != "!=" && n.op != "in" {
+			return fmt.Errorf("%s can't be used with weekday at %d", n.op, pos)
+		}
+		for _, v := range n.values {
+			if !weekdays[v] {
+				return fmt.Errorf("invalid weekday %q at %d, use Mon to Sun", v, pos)
+			}
+		}
+	case AttrIP:
+		switch n.op {
+		case "in":
+			for _, v := range n.values {
+				_, cidr, err := net.ParseCIDR(v)
+				if err != nil {
+					return fmt.Errorf("invalid CIDR %q at %d", v, pos)
+				}
+				n.nets = append(n.nets, cidr)
+			}
+		case "==", "!=":
+			if net.ParseIP(n.values[0]) == nil {
+				return fmt.Errorf("invalid ip %q at %d", n.values[0], pos)
+			}
+		default:
+			return fmt.Errorf("%s can't be used with ip at %d", n.op, pos)
+		}
+	}
+	return nil
+}
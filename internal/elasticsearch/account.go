@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"rbac/internal"
-	"strings"
 	"time"
 
 	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
@@ -16,22 +15,26 @@ import (
 )
 
 type indexedAccount struct {
-	ID        string    `json:"id"`
-	Username  string    `json:"username"`
-	ProfileId string    `json:"profileId"`
-	IsBlocked bool      `json:"is_blocked"`
-	CreatedAt time.Time `json:"createdat"`
+	ID               string    `json:"id"`
+	Username         string    `json:"username"`
+	ProfileId        string    `json:"profileId"`
+	IsBlocked        bool      `json:"is_blocked"`
+	IsServiceAccount bool      `json:"is_service_account"`
+	Tenants          []string  `json:"tenants"`
+	CreatedAt        time.Time `json:"createdat"`
 }
 
 func (a *RBAC) IndexAccount(ctx context.Context, account internal.Account) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Index")
 	defer span.End()
 	body := indexedAccount{
-		ID:        account.Id,
-		Username:  account.UserName,
-		ProfileId: account.Profile.Id,
-		IsBlocked: account.IsBlocked,
-		CreatedAt: account.CreatedAt,
+		ID:               account.Id,
+		Username:         account.UserName,
+		ProfileId:        account.Profile.Id,
+		IsBlocked:        account.IsBlocked,
+		IsServiceAccount: account.IsServiceAccount,
+		Tenants:          account.Tenants,
+		CreatedAt:        account.CreatedAt,
 	}
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(body); err != nil {
@@ -112,15 +115,20 @@ func (a *RBAC) GetAccount(ctx context.Context, username string) (internal.Accoun
 		fmt.Println("Error here", err)
 		return internal.Account{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
 	}
+	if !inTenant(ctx, hits.Source.Tenants...) {
+		return internal.Account{}, errTenantNotFound("GetRequest.Do")
+	}
 	profile := internal.Profile{
 		Id: hits.Source.ProfileId,
 	}
 	return internal.Account{
-		Id:        hits.Source.ID,
-		UserName:  hits.Source.Username,
-		Profile:   profile,
-		IsBlocked: hits.Source.IsBlocked,
-		CreatedAt: hits.Source.CreatedAt,
+		Id:               hits.Source.ID,
+		UserName:         hits.Source.Username,
+		Profile:          profile,
+		IsBlocked:        hits.Source.IsBlocked,
+		IsServiceAccount: hits.Source.IsServiceAccount,
+		Tenants:          hits.Source.Tenants,
+		CreatedAt:        hits.Source.CreatedAt,
 	}, err
 }
 
@@ -155,6 +163,7 @@ func (a *RBAC) GetAccountById(ctx context.Context, id *string) (internal.Account
 		}
 	}
 
+	query["query"] = tenantQuery(ctx, "tenants", query["query"])
 	query["sort"] = []interface{}{
 		map[string]interface{}{"_doc": "asc"},
 	}
@@ -203,8 +212,13 @@ func (a *RBAC) GetAccountById(ctx context.Context, id *string) (internal.Account
 		res[i].Id = hit.Source.ID
 		res[i].UserName = hit.Source.Username
 		res[i].Profile = profile
+		res[i].Tenants = hit.Source.Tenants
 		res[i].CreatedAt = hit.Source.CreatedAt
 	}
+	// accounts of other tenants are filtered out
+	if len(res) == 0 {
+		return internal.Account{}, errTenantNotFound("SearchRequest.Do")
+	}
 
 	return res[0], nil
 }
@@ -212,9 +226,13 @@ func (a *RBAC) ListAccount(ctx context.Context, args internal.ListArgs) (interna
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.List")
 	defer span.End()
 
+	body, err := listBody(ctx, "tenants")
+	if err != nil {
+		return internal.ListAccount{}, err
+	}
 	req := esv7api.SearchRequest{
 		Index: []string{INDEX_ACCOUNT},
-		Body:  strings.NewReader(`{"query":{"match_all": {}}}`),
+		Body:  body,
 		From:  args.From,
 		Size:  args.Size,
 	}
@@ -251,6 +269,8 @@ func (a *RBAC) ListAccount(ctx context.Context, args internal.ListArgs) (interna
 		res[i].UserName = hit.Source.Username
 		res[i].Profile.Id = hit.Source.ProfileId
 		res[i].IsBlocked = hit.Source.IsBlocked
+		res[i].IsServiceAccount = hit.Source.IsServiceAccount
+		res[i].Tenants = hit.Source.Tenants
 		res[i].CreatedAt = hit.Source.CreatedAt
 	}
 
@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"rbac/internal"
-	"strings"
 	"time"
 
 	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
@@ -19,9 +18,19 @@ type indexedAccountRoles struct {
 	Id              string    `json:"id"`
 	AccountUsername string    `json:"account"`
 	RoleId          string    `json:"role"`
+	Tenant          string    `json:"tenant"`
+	ValidFrom       time.Time `json:"validfrom"`
+	ValidUntil      time.Time `json:"validuntil"`
 	CreatedAt       time.Time `json:"createdat"`
 }
 
+func (ar indexedAccountRoles) validity() internal.Validity {
+	return internal.Validity{
+		From:  ar.ValidFrom,
+		Until: ar.ValidUntil,
+	}
+}
+
 func (a *RBAC) IndexAccountRole(ctx context.Context, accRole internal.AccountRoles) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.Index")
 	defer span.End()
@@ -29,6 +38,9 @@ func (a *RBAC) IndexAccountRole(ctx context.Context, accRole internal.AccountRol
 		Id:              accRole.Id,
 		AccountUsername: accRole.Account.UserName,
 		RoleId:          accRole.Role.Id,
+		Tenant:          accRole.Role.TenantId,
+		ValidFrom:       accRole.Validity.From,
+		ValidUntil:      accRole.Validity.Until,
 		CreatedAt:       accRole.CreatedAt,
 	}
 	var buf bytes.Buffer
@@ -110,16 +122,21 @@ func (a *RBAC) GetAccountRole(ctx context.Context, accRoleId string) (internal.A
 		fmt.Println("Error here", err)
 		return internal.AccountRoles{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
 	}
+	if !inTenant(ctx, hits.Source.Tenant) {
+		return internal.AccountRoles{}, errTenantNotFound("GetRequest.Do")
+	}
 	account := internal.Account{
 		UserName: hits.Source.AccountUsername,
 	}
 	role := internal.Roles{
-		Id: hits.Source.RoleId,
+		Id:       hits.Source.RoleId,
+		TenantId: hits.Source.Tenant,
 	}
 	return internal.AccountRoles{
 		Id:        hits.Source.Id,
 		Account:   account,
 		Role:      role,
+		Validity:  hits.Source.validity(),
 		CreatedAt: hits.Source.CreatedAt,
 	}, err
 }
@@ -155,6 +172,7 @@ func (a *RBAC) AccountRoleByAccount(ctx context.Context, username *string) (inte
 		}
 	}
 
+	query["query"] = tenantQuery(ctx, "tenant", query["query"])
 	fmt.Println(query)
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
@@ -195,11 +213,15 @@ func (a *RBAC) AccountRoleByAccount(ctx context.Context, username *string) (inte
 	account := internal.Account{
 		UserName: *username,
 	}
-	res := make([]internal.Roles, len(hits.Hits.Hits))
-
-	for i, hit := range hits.Hits.Hits {
+	res := make([]internal.Roles, 0, len(hits.Hits.Hits))
+	now := time.Now()
 
-		res[i].Id = hit.Source.RoleId
+	for _, hit := range hits.Hits.Hits {
+		// assignments not started yet or expired but not swept yet grant nothing
+		if !hit.Source.validity().IsActive(now) {
+			continue
+		}
+		res = append(res, internal.Roles{Id: hit.Source.RoleId, TenantId: hit.Source.Tenant})
 	}
 
 	return internal.AccountRoleByAccountResult{
@@ -240,6 +262,7 @@ func (a *RBAC) AccountRoleByRole(ctx context.Context, roleId *string) (internal.
 		}
 	}
 
+	query["query"] = tenantQuery(ctx, "tenant", query["query"])
 	fmt.Println(query)
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
@@ -297,9 +320,13 @@ func (a *RBAC) ListAccountRole(ctx context.Context, args internal.ListArgs) (int
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.List")
 	defer span.End()
 
+	body, err := listBody(ctx, "tenant")
+	if err != nil {
+		return internal.ListAccountRole{}, err
+	}
 	req := esv7api.SearchRequest{
 		Index: []string{INDEX_ACCOUNT_ROLE},
-		Body:  strings.NewReader(`{"query":{"match_all": {}}}`),
+		Body:  body,
 		From:  args.From,
 		Size:  args.Size,
 	}
@@ -337,10 +364,12 @@ func (a *RBAC) ListAccountRole(ctx context.Context, args internal.ListArgs) (int
 			UserName: hit.Source.AccountUsername,
 		}
 		role := internal.Roles{
-			Id: hit.Source.RoleId,
+			Id:       hit.Source.RoleId,
+			TenantId: hit.Source.Tenant,
 		}
 		res[i].Account = account
 		res[i].Role = role
+		res[i].Validity = hit.Source.validity()
 		res[i].CreatedAt = hit.Source.CreatedAt
 	}
 
@@ -0,0 +1,81 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"rbac/internal"
+	"time"
+
+	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type indexedGroupMember struct {
+	Id              string    `json:"id"`
+	GroupId         string    `json:"group"`
+	AccountUsername string    `json:"account"`
+	Tenant          string    `json:"tenant"`
+	CreatedAt       time.Time `json:"createdat"`
+}
+
+func (a *RBAC) IndexGroupMember(ctx context.Context, member internal.GroupMember) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "GroupMember.Index")
+	defer span.End()
+	body := indexedGroupMember{
+		Id:              member.Id,
+		GroupId:         member.Group.Id,
+		AccountUsername: member.Account.UserName,
+		Tenant:          member.Group.TenantId,
+		CreatedAt:       member.CreatedAt,
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+	}
+	req := esv7api.IndexRequest{
+		Index:      INDEX_GROUP_MEMBER,
+		Body:       &buf,
+		DocumentID: member.Id,
+		Refresh:    "true",
+	}
+	resp, err := req.Do(ctx, a.client)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "IndexRequest.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return internal.NewErrorf(internal.ErrorCodeUnknown, "IndexRequest.Do %d", resp.StatusCode)
+	}
+
+	io.Copy(ioutil.Discard, resp.Body)
+
+	return nil
+}
+
+func (a *RBAC) DeleteGroupMember(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "GroupMember.Delete")
+	defer span.End()
+
+	req := esv7api.DeleteRequest{
+		Index:      INDEX_GROUP_MEMBER,
+		DocumentID: id,
+	}
+
+	resp, err := req.Do(ctx, a.client)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "DeleteRequest.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return internal.NewErrorf(internal.ErrorCodeUnknown, "DeleteRequest.Do %d", resp.StatusCode)
+	}
+
+	io.Copy(ioutil.Discard, resp.Body)
+
+	return nil
+}
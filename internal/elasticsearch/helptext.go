@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"rbac/internal"
-	"strings"
 	"time"
 
 	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
@@ -19,6 +18,7 @@ type indexedHelpText struct {
 	Id        string    `json:"id"`
 	HelpText  string    `json:"helptext"`
 	TaskId    string    `json:"taskid"`
+	Tenant    string    `json:"tenant"`
 	CreatedAt time.Time `json:"createdat"`
 }
 
@@ -29,6 +29,7 @@ func (a *RBAC) IndexHelpText(ctx context.Context, helptext internal.HelpText) er
 		Id:        helptext.Id,
 		HelpText:  helptext.HelpText,
 		TaskId:    helptext.Task_id,
+		Tenant:    helptext.TenantId,
 		CreatedAt: helptext.CreatedAt,
 	}
 	var buf bytes.Buffer
@@ -110,10 +111,14 @@ func (a *RBAC) GetHelpText(ctx context.Context, helptextId string) (internal.Hel
 		fmt.Println("Error here", err)
 		return internal.HelpText{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
 	}
+	if !inTenant(ctx, hits.Source.Tenant) {
+		return internal.HelpText{}, errTenantNotFound("GetRequest.Do")
+	}
 	return internal.HelpText{
 		Id:        hits.Source.Id,
 		HelpText:  hits.Source.HelpText,
 		Task_id:   hits.Source.TaskId,
+		TenantId:  hits.Source.Tenant,
 		CreatedAt: hits.Source.CreatedAt,
 	}, err
 }
@@ -203,9 +208,13 @@ func (a *RBAC) ListHelpText(ctx context.Context, args internal.ListArgs) (intern
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "HelpText.List")
 	defer span.End()
 
+	body, err := listBody(ctx, "tenant")
+	if err != nil {
+		return internal.ListHelpText{}, err
+	}
 	req := esv7api.SearchRequest{
 		Index: []string{INDEX_HELPTEXT},
-		Body:  strings.NewReader(`{"query":{"match_all": {}}}`),
+		Body:  body,
 		From:  args.From,
 		Size:  args.Size,
 	}
@@ -239,6 +248,7 @@ func (a *RBAC) ListHelpText(ctx context.Context, args internal.ListArgs) (intern
 
 	for i, hit := range hits.Hits.Hits {
 		res[i].Id = hit.Source.Id
+		res[i].TenantId = hit.Source.Tenant
 		res[i].HelpText = hit.Source.HelpText
 		res[i].Task_id = hit.Source.TaskId
 		res[i].CreatedAt = hit.Source.CreatedAt
@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"rbac/internal"
-	"strings"
 	"time"
 
 	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
@@ -19,6 +18,7 @@ type indexedMenu struct {
 	Id        string    `json:"id"`
 	Name      string    `json:"menu"`
 	TaskId    string    `json:"taskid"`
+	Tenant    string    `json:"tenant"`
 	CreatedAt time.Time `json:"createdat"`
 }
 
@@ -29,6 +29,7 @@ func (a *RBAC) IndexMenu(ctx context.Context, menu internal.Menu) error {
 		Id:        menu.Id,
 		Name:      menu.Name,
 		TaskId:    menu.Task_id,
+		Tenant:    menu.TenantId,
 		CreatedAt: menu.CreatedAt,
 	}
 	var buf bytes.Buffer
@@ -110,10 +111,14 @@ func (a *RBAC) GetMenu(ctx context.Context, menuId string) (internal.Menu, error
 		fmt.Println("Error here", err)
 		return internal.Menu{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
 	}
+	if !inTenant(ctx, hits.Source.Tenant) {
+		return internal.Menu{}, errTenantNotFound("GetRequest.Do")
+	}
 	return internal.Menu{
 		Id:        hits.Source.Id,
 		Name:      hits.Source.Name,
 		Task_id:   hits.Source.TaskId,
+		TenantId:  hits.Source.Tenant,
 		CreatedAt: hits.Source.CreatedAt,
 	}, err
 }
@@ -201,9 +206,13 @@ func (a *RBAC) ListMenu(ctx context.Context, args internal.ListArgs) (internal.L
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Menu.List")
 	defer span.End()
 
+	body, err := listBody(ctx, "tenant")
+	if err != nil {
+		return internal.ListMenu{}, err
+	}
 	req := esv7api.SearchRequest{
 		Index: []string{INDEX_MENU},
-		Body:  strings.NewReader(`{"query":{"match_all": {}}}`),
+		Body:  body,
 		From:  args.From,
 		Size:  args.Size,
 	}
@@ -237,6 +246,7 @@ func (a *RBAC) ListMenu(ctx context.Context, args internal.ListArgs) (internal.L
 
 	for i, hit := range hits.Hits.Hits {
 		res[i].Id = hit.Source.Id
+		res[i].TenantId = hit.Source.Tenant
 		res[i].Name = hit.Source.Name
 		res[i].Task_id = hit.Source.TaskId
 		res[i].CreatedAt = hit.Source.CreatedAt
@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"rbac/internal"
-	"strings"
 	"time"
 
 	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
@@ -19,6 +18,7 @@ type indexedNavigation struct {
 	Id        string    `json:"id"`
 	Name      string    `json:"navigation"`
 	TaskId    string    `json:"taskid"`
+	Tenant    string    `json:"tenant"`
 	CreatedAt time.Time `json:"createdat"`
 }
 
@@ -29,6 +29,7 @@ func (a *RBAC) IndexNavigation(ctx context.Context, navigation internal.Navigati
 		Id:        navigation.Id,
 		Name:      navigation.Name,
 		TaskId:    navigation.Task_id,
+		Tenant:    navigation.TenantId,
 		CreatedAt: navigation.CreatedAt,
 	}
 	var buf bytes.Buffer
@@ -110,10 +111,14 @@ func (a *RBAC) GetNavigation(ctx context.Context, navigationId string) (internal
 		fmt.Println("Error here", err)
 		return internal.Navigation{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
 	}
+	if !inTenant(ctx, hits.Source.Tenant) {
+		return internal.Navigation{}, errTenantNotFound("GetRequest.Do")
+	}
 	return internal.Navigation{
 		Id:        hits.Source.Id,
 		Name:      hits.Source.Name,
 		Task_id:   hits.Source.TaskId,
+		TenantId:  hits.Source.Tenant,
 		CreatedAt: hits.Source.CreatedAt,
 	}, err
 }
@@ -201,9 +206,13 @@ func (a *RBAC) ListNavigation(ctx context.Context, args internal.ListArgs) (inte
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Navigation.List")
 	defer span.End()
 
+	body, err := listBody(ctx, "tenant")
+	if err != nil {
+		return internal.ListNavigation{}, err
+	}
 	req := esv7api.SearchRequest{
 		Index: []string{INDEX_NAVIGATION},
-		Body:  strings.NewReader(`{"query":{"match_all": {}}}`),
+		Body:  body,
 		From:  args.From,
 		Size:  args.Size,
 	}
@@ -237,6 +246,7 @@ func (a *RBAC) ListNavigation(ctx context.Context, args internal.ListArgs) (inte
 
 	for i, hit := range hits.Hits.Hits {
 		res[i].Id = hit.Source.Id
+		res[i].TenantId = hit.Source.Tenant
 		res[i].Name = hit.Source.Name
 		res[i].Task_id = hit.Source.TaskId
 		res[i].CreatedAt = hit.Source.CreatedAt
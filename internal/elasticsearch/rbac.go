@@ -1,6 +1,13 @@
 package elasticsearch
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"rbac/internal"
+
 	esv7 "github.com/elastic/go-elasticsearch/v7"
 )
 
@@ -26,4 +33,55 @@ const (
 	INDEX_HELPTEXT     = "rbachelptext"
 	INDEX_MENU         = "rbacmenu"
 	INDEX_NAVIGATION   = "rbacnavigation"
+	INDEX_GROUP_MEMBER = "rbacgroupmember"
 )
+
+// tenantQuery restricts the query to the documents of the tenant the request acts in, requests acting in no
+// tenant search every document.
+func tenantQuery(ctx context.Context, field string, query interface{}) interface{} {
+	tenantId := internal.TenantFromContext(ctx)
+	if tenantId == "" {
+		return query
+	}
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": query,
+			"filter": map[string]interface{}{
+				"term": map[string]interface{}{
+					field + ".keyword": tenantId,
+				},
+			},
+		},
+	}
+}
+
+// inTenant returns true when the request acts in one of the tenants or in none.
+func inTenant(ctx context.Context, tenants ...string) bool {
+	tenantId := internal.TenantFromContext(ctx)
+	if tenantId == "" {
+		return true
+	}
+	for _, value := range tenants {
+		if value == tenantId {
+			return true
+		}
+	}
+	return false
+}
+
+// errTenantNotFound is returned for documents of other tenants, like missing documents.
+func errTenantNotFound(op string) error {
+	return internal.NewErrorf(internal.ErrorCodeNotFound, "%s %d", op, http.StatusNotFound)
+}
+
+// listBody encodes a match all query restricted to the tenant the request acts in.
+func listBody(ctx context.Context, field string) (io.Reader, error) {
+	query := map[string]interface{}{
+		"query": tenantQuery(ctx, field, map[string]interface{}{"match_all": map[string]interface{}{}}),
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+	}
+	return &buf, nil
+}
@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"rbac/internal"
-	"strings"
 	"time"
 
 	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
@@ -18,6 +17,7 @@ import (
 type indexedRole struct {
 	Id        string    `json:"id"`
 	Role      string    `json:"role"`
+	Tenant    string    `json:"tenant"`
 	CreatedAt time.Time `json:"createdat"`
 }
 
@@ -27,6 +27,7 @@ func (a *RBAC) IndexRole(ctx context.Context, role internal.Roles) error {
 	body := indexedRole{
 		Id:        role.Id,
 		Role:      role.Role,
+		Tenant:    role.TenantId,
 		CreatedAt: role.CreatedAt,
 	}
 	var buf bytes.Buffer
@@ -108,9 +109,13 @@ func (a *RBAC) GetRole(ctx context.Context, roleId string) (internal.Roles, erro
 		fmt.Println("Error here", err)
 		return internal.Roles{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
 	}
+	if !inTenant(ctx, hits.Source.Tenant) {
+		return internal.Roles{}, errTenantNotFound("GetRequest.Do")
+	}
 	return internal.Roles{
 		Id:        hits.Source.Id,
 		Role:      hits.Source.Role,
+		TenantId:  hits.Source.Tenant,
 		CreatedAt: hits.Source.CreatedAt,
 	}, err
 }
@@ -119,9 +124,13 @@ func (a *RBAC) ListRole(ctx context.Context, args internal.ListArgs) (internal.L
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Role.List")
 	defer span.End()
 
+	body, err := listBody(ctx, "tenant")
+	if err != nil {
+		return internal.ListRole{}, err
+	}
 	req := esv7api.SearchRequest{
 		Index: []string{INDEX_ROLE},
-		Body:  strings.NewReader(`{"query":{"match_all": {}}}`),
+		Body:  body,
 		From:  args.From,
 		Size:  args.Size,
 	}
@@ -156,6 +165,7 @@ func (a *RBAC) ListRole(ctx context.Context, args internal.ListArgs) (internal.L
 	for i, hit := range hits.Hits.Hits {
 		res[i].Id = hit.Source.Id
 		res[i].Role = hit.Source.Role
+		res[i].TenantId = hit.Source.Tenant
 		res[i].CreatedAt = hit.Source.CreatedAt
 	}
 
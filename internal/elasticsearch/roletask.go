@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"rbac/internal"
-	"strings"
 	"time"
 
 	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
@@ -19,9 +18,19 @@ type indexedRoleTask struct {
 	Id        string    `json:"id"`
 	TaskId    string    `json:"taskid"`
 	RoleId    string    `json:"roleid"`
+	Tenant    string    `json:"tenant"`
+	Effect    string    `json:"effect"`
 	CreatedAt time.Time `json:"createdat"`
 }
 
+// effect returns the effect of the role task, documents indexed before deny role tasks existed have none.
+func (rt indexedRoleTask) effect() string {
+	if rt.Effect == "" {
+		return internal.EFFECT_ALLOW
+	}
+	return rt.Effect
+}
+
 func (a *RBAC) IndexRoleTask(ctx context.Context, roletask internal.RoleTasks) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleTask.Index")
 	defer span.End()
@@ -29,6 +38,8 @@ func (a *RBAC) IndexRoleTask(ctx context.Context, roletask internal.RoleTasks) e
 		Id:        roletask.Id,
 		TaskId:    roletask.Task.Id,
 		RoleId:    roletask.Role.Id,
+		Tenant:    roletask.Role.TenantId,
+		Effect:    roletask.Effect,
 		CreatedAt: roletask.CreatedAt,
 	}
 	var buf bytes.Buffer
@@ -110,16 +121,21 @@ func (a *RBAC) GetRoleTask(ctx context.Context, roletaskId string) (internal.Rol
 		fmt.Println("Error here", err)
 		return internal.RoleTasks{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
 	}
+	if !inTenant(ctx, hits.Source.Tenant) {
+		return internal.RoleTasks{}, errTenantNotFound("GetRequest.Do")
+	}
 	task := internal.Tasks{
 		Id: hits.Source.TaskId,
 	}
 	role := internal.Roles{
-		Id: hits.Source.RoleId,
+		Id:       hits.Source.RoleId,
+		TenantId: hits.Source.Tenant,
 	}
 	return internal.RoleTasks{
 		Id:        hits.Source.Id,
 		Task:      task,
 		Role:      role,
+		Effect:    hits.Source.effect(),
 		CreatedAt: hits.Source.CreatedAt,
 	}, err
 }
@@ -154,6 +170,7 @@ func (a *RBAC) RoleTaskByRole(ctx context.Context, roleId string) (internal.Role
 		}
 	}
 
+	query["query"] = tenantQuery(ctx, "tenant", query["query"])
 	fmt.Println(query)
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
@@ -194,16 +211,21 @@ func (a *RBAC) RoleTaskByRole(ctx context.Context, roleId string) (internal.Role
 	role := internal.Roles{
 		Id: roleId,
 	}
-	res := make([]internal.Tasks, len(hits.Hits.Hits))
+	res := make([]internal.Tasks, 0, len(hits.Hits.Hits))
+	var denied []internal.Tasks
 
-	for i, hit := range hits.Hits.Hits {
-
-		res[i].Id = hit.Source.TaskId
+	for _, hit := range hits.Hits.Hits {
+		if hit.Source.effect() == internal.EFFECT_DENY {
+			denied = append(denied, internal.Tasks{Id: hit.Source.TaskId})
+			continue
+		}
+		res = append(res, internal.Tasks{Id: hit.Source.TaskId})
 	}
 
 	return internal.RoleTaskByRole{
-		Role:  role,
-		Tasks: res,
+		Role:        role,
+		Tasks:       res,
+		DeniedTasks: denied,
 	}, nil
 }
 
@@ -237,6 +259,7 @@ func (a *RBAC) RoleTaskByTask(ctx context.Context, taskId string) (internal.Role
 		}
 	}
 
+	query["query"] = tenantQuery(ctx, "tenant", query["query"])
 	fmt.Println(query)
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
@@ -277,11 +300,14 @@ func (a *RBAC) RoleTaskByTask(ctx context.Context, taskId string) (internal.Role
 	task := internal.Tasks{
 		Id: taskId,
 	}
-	res := make([]internal.Roles, len(hits.Hits.Hits))
-
-	for i, hit := range hits.Hits.Hits {
+	res := make([]internal.Roles, 0, len(hits.Hits.Hits))
 
-		res[i].Id = hit.Source.TaskId
+	for _, hit := range hits.Hits.Hits {
+		// only the roles granting the task are listed
+		if hit.Source.effect() == internal.EFFECT_DENY {
+			continue
+		}
+		res = append(res, internal.Roles{Id: hit.Source.RoleId})
 	}
 
 	return internal.RoleTaskByTask{
@@ -294,9 +320,13 @@ func (a *RBAC) ListRoleTask(ctx context.Context, args internal.ListArgs) (intern
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleTask.List")
 	defer span.End()
 
+	body, err := listBody(ctx, "tenant")
+	if err != nil {
+		return internal.ListRoleTask{}, err
+	}
 	req := esv7api.SearchRequest{
 		Index: []string{INDEX_ROLE_TASK},
-		Body:  strings.NewReader(`{"query":{"match_all": {}}}`),
+		Body:  body,
 		From:  args.From,
 		Size:  args.Size,
 	}
@@ -333,11 +363,13 @@ func (a *RBAC) ListRoleTask(ctx context.Context, args internal.ListArgs) (intern
 			Id: hit.Source.TaskId,
 		}
 		role := internal.Roles{
-			Id: hit.Source.RoleId,
+			Id:       hit.Source.RoleId,
+			TenantId: hit.Source.Tenant,
 		}
 		res[i].Id = hit.Source.Id
 		res[i].Task = task
 		res[i].Role = role
+		res[i].Effect = hit.Source.effect()
 		res[i].CreatedAt = hit.Source.CreatedAt
 	}
 
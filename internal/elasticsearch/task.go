@@ -8,7 +8,6 @@ import (
 	"io"
 	"io/ioutil"
 	"rbac/internal"
-	"strings"
 	"time"
 
 	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
@@ -18,6 +17,7 @@ import (
 type indexedTask struct {
 	Id        string    `json:"id"`
 	Task      string    `json:"task"`
+	Tenant    string    `json:"tenant"`
 	CreatedAt time.Time `json:"createdat"`
 }
 
@@ -27,6 +27,7 @@ func (a *RBAC) IndexTask(ctx context.Context, task internal.Tasks) error {
 	body := indexedTask{
 		Id:        task.Id,
 		Task:      task.Task,
+		Tenant:    task.TenantId,
 		CreatedAt: task.CreatedAt,
 	}
 	var buf bytes.Buffer
@@ -108,9 +109,13 @@ func (a *RBAC) GetTask(ctx context.Context, taskId string) (internal.Tasks, erro
 		fmt.Println("Error here", err)
 		return internal.Tasks{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
 	}
+	if !inTenant(ctx, hits.Source.Tenant) {
+		return internal.Tasks{}, errTenantNotFound("GetRequest.Do")
+	}
 	return internal.Tasks{
 		Id:        hits.Source.Id,
 		Task:      hits.Source.Task,
+		TenantId:  hits.Source.Tenant,
 		CreatedAt: hits.Source.CreatedAt,
 	}, err
 }
@@ -119,9 +124,13 @@ func (a *RBAC) ListTask(ctx context.Context, args internal.ListArgs) (internal.L
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.List")
 	defer span.End()
 
+	body, err := listBody(ctx, "tenant")
+	if err != nil {
+		return internal.ListTask{}, err
+	}
 	req := esv7api.SearchRequest{
 		Index: []string{INDEX_TASK},
-		Body:  strings.NewReader(`{"query":{"match_all": {}}}`),
+		Body:  body,
 		From:  args.From,
 		Size:  args.Size,
 	}
@@ -155,6 +164,7 @@ func (a *RBAC) ListTask(ctx context.Context, args internal.ListArgs) (internal.L
 
 	for i, hit := range hits.Hits.Hits {
 		res[i].Id = hit.Source.Id
+		res[i].TenantId = hit.Source.Tenant
 		res[i].Task = hit.Source.Task
 		res[i].CreatedAt = hit.Source.CreatedAt
 	}
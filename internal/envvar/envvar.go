@@ -11,6 +11,11 @@ type Provider interface {
 	Get(key string) (string, error)
 }
 
+// Refresher is implemented by providers caching values.
+type Refresher interface {
+	Refresh()
+}
+
 type Configuration struct {
 	provider Provider
 }
@@ -45,3 +50,10 @@ func (c *Configuration) Get(key string) (string, error) {
 
 	return res, nil
 }
+
+// Refresh makes the provider read values again instead of using the ones it cached.
+func (c *Configuration) Refresh() {
+	if r, ok := c.provider.(Refresher); ok {
+		r.Refresh()
+	}
+}
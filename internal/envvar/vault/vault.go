@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/vault/api"
 )
@@ -11,6 +12,7 @@ import (
 type Provider struct {
 	path    string
 	client  *api.Logical
+	mu      sync.Mutex
 	results map[string]map[string]string
 }
 
@@ -44,6 +46,9 @@ func (p *Provider) Get(v string) (string, error) {
 	pathSecret := split[0]
 	key := split[1]
 
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	res, ok := p.results[pathSecret]
 	if ok {
 		val, ok := res[key]
@@ -88,3 +93,10 @@ func (p *Provider) Get(v string) (string, error) {
 
 	return val, nil
 }
+
+// Refresh forgets the cached secrets, the next calls to Get read them from vault again.
+func (p *Provider) Refresh() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.results = make(map[string]map[string]string)
+}
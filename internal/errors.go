@@ -17,6 +17,9 @@ const (
 	ErrorCodeUnknown ErrorCode = iota
 	ErrorCodeNotFound
 	ErrorCodeInvalidArgument
+	ErrorCodeUnauthorized
+	ErrorCodeTooManyRequests
+	ErrorCodeForbidden
 )
 
 // WrapErrorf returns a wrapped error.
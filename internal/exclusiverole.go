@@ -0,0 +1,65 @@
+package internal
+
+import "time"
+
+// ExclusiveRoleSet is a set of conflicting roles of a tenant, like the ones creating and approving payments.
+// An account may hold at most one of them, whether assigned to it, held through groups or inherited.
+type ExclusiveRoleSet struct {
+	Id        string
+	Name      string
+	TenantId  string
+	Roles     []Roles
+	CreatedAt time.Time
+}
+
+func (s *ExclusiveRoleSet) Validate() error {
+	if s.Name == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "exclusive role set name is required")
+	}
+	ids := map[string]bool{}
+	for _, value := range s.Roles {
+		if value.Id == "" {
+			return NewErrorf(ErrorCodeInvalidArgument, "role id is required")
+		}
+		ids[value.Id] = true
+	}
+	if len(ids) < 2 {
+		return NewErrorf(ErrorCodeInvalidArgument, "an exclusive role set needs at least two roles")
+	}
+	return nil
+}
+
+// RoleConflict is an account holding more than one role of an exclusive role set.
+type RoleConflict struct {
+	Username string
+	Set      ExclusiveRoleSet
+	Roles    []Roles
+}
+
+// MergeRoleConflicts merges the exclusive roles held by accounts, one role per item, and returns the
+// accounts holding more than one role of the same set.
+func MergeRoleConflicts(held []RoleConflict) []RoleConflict {
+	type key struct {
+		username string
+		set      string
+	}
+	var merged []RoleConflict
+	index := map[key]int{}
+	for _, value := range held {
+		k := key{username: value.Username, set: value.Set.Id}
+		i, ok := index[k]
+		if !ok {
+			i = len(merged)
+			index[k] = i
+			merged = append(merged, RoleConflict{Username: value.Username, Set: value.Set})
+		}
+		merged[i].Roles = append(merged[i].Roles, value.Roles...)
+	}
+	res := []RoleConflict{}
+	for _, value := range merged {
+		if len(value.Roles) > 1 {
+			res = append(res, value)
+		}
+	}
+	return res
+}
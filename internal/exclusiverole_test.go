@@ -0,0 +1,38 @@
+package internal_test
+
+import (
+	"rbac/internal"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExclusiveRoleSet_Validate(t *testing.T) {
+	creator := internal.Roles{Id: "r1"}
+	approver := internal.Roles{Id: "r2"}
+
+	require.NoError(t, (&internal.ExclusiveRoleSet{Name: "payments", Roles: []internal.Roles{creator, approver}}).Validate())
+	require.Error(t, (&internal.ExclusiveRoleSet{Roles: []internal.Roles{creator, approver}}).Validate())
+	require.Error(t, (&internal.ExclusiveRoleSet{Name: "payments", Roles: []internal.Roles{creator, creator}}).Validate())
+	require.Error(t, (&internal.ExclusiveRoleSet{Name: "payments", Roles: []internal.Roles{creator, {}}}).Validate())
+}
+
+func TestMergeRoleConflicts(t *testing.T) {
+	payments := internal.ExclusiveRoleSet{Id: "s1", Name: "payments"}
+	audit := internal.ExclusiveRoleSet{Id: "s2", Name: "audit"}
+	creator := internal.Roles{Id: "r1", Role: "payment creator"}
+	approver := internal.Roles{Id: "r2", Role: "payment approver"}
+	auditor := internal.Roles{Id: "r3", Role: "auditor"}
+
+	res := internal.MergeRoleConflicts([]internal.RoleConflict{
+		{Username: "alice", Set: payments, Roles: []internal.Roles{creator}},
+		{Username: "alice", Set: audit, Roles: []internal.Roles{auditor}},
+		{Username: "alice", Set: payments, Roles: []internal.Roles{approver}},
+		{Username: "bob", Set: payments, Roles: []internal.Roles{creator}},
+	})
+
+	require.Equal(t, []internal.RoleConflict{
+		{Username: "alice", Set: payments, Roles: []internal.Roles{creator, approver}},
+	}, res)
+	require.Empty(t, internal.MergeRoleConflicts(nil))
+}
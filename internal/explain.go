@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// sources answering the lookups made while deciding
+	SOURCE_CACHE    = "cache"
+	SOURCE_SEARCH   = "search"
+	SOURCE_DATABASE = "database"
+
+	// how a role considered for a decision is held
+	ROLE_VIA_ACCOUNT     = "account"
+	ROLE_VIA_GROUP       = "group"
+	ROLE_VIA_INHERITANCE = "inheritance"
+)
+
+// Lookup is a lookup made while deciding and the source that answered it.
+type Lookup struct {
+	Name   string
+	Key    string
+	Source string
+}
+
+// Lookups collects the lookups made with a context returned by NewLookupContext.
+type Lookups struct {
+	mu      sync.Mutex
+	lookups []Lookup
+}
+
+// All returns the lookups in the order they were made.
+func (l *Lookups) All() []Lookup {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Lookup{}, l.lookups...)
+}
+
+type lookupsKey struct{}
+
+// NewLookupContext returns a copy of ctx recording the lookups made with it.
+func NewLookupContext(ctx context.Context) (context.Context, *Lookups) {
+	l := &Lookups{}
+	return context.WithValue(ctx, lookupsKey{}, l), l
+}
+
+// RecordLookup records the lookup when ctx records lookups, it does nothing otherwise.
+func RecordLookup(ctx context.Context, name string, key string, source string) {
+	l, ok := ctx.Value(lookupsKey{}).(*Lookups)
+	if !ok {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lookups = append(l.lookups, Lookup{Name: name, Key: key, Source: source})
+}
+
+// ExplainedRole is a role considered for a decision, Via tells how the account holds it and InheritedFrom
+// the role it is inherited from when held through inheritance.
+type ExplainedRole struct {
+	Role          Roles
+	Via           string
+	InheritedFrom string
+	Grants        bool
+	Denies        bool
+}
+
+// ExplainedGrant is a scoped or conditional grant of the task, Covers tells whether its scopes cover the
+// resource and Holds whether its condition held for the request.
+type ExplainedGrant struct {
+	Grant  RestrictedGrant
+	Covers bool
+	Holds  bool
+}
+
+// Explanation is a permission decision along with the roles, grants and lookups it was derived from.
+type Explanation struct {
+	Username string
+	Task     string
+	Resource *Resource
+	Allowed  bool
+	Reason   string
+	Roles    []ExplainedRole
+	Grants   []ExplainedGrant
+	Lookups  []Lookup
+}
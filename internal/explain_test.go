@@ -0,0 +1,22 @@
+package internal_test
+
+import (
+	"context"
+	"rbac/internal"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupContext(t *testing.T) {
+	// contexts not recording lookups ignore them
+	internal.RecordLookup(context.Background(), "RoleTaskByRole", "role", internal.SOURCE_CACHE)
+
+	ctx, lookups := internal.NewLookupContext(context.Background())
+	internal.RecordLookup(ctx, "AccountRoleByAccount", "alice", internal.SOURCE_SEARCH)
+	internal.RecordLookup(ctx, "RoleTaskByRole", "role", internal.SOURCE_CACHE)
+	require.Equal(t, []internal.Lookup{
+		{Name: "AccountRoleByAccount", Key: "alice", Source: internal.SOURCE_SEARCH},
+		{Name: "RoleTaskByRole", Key: "role", Source: internal.SOURCE_CACHE},
+	}, lookups.All())
+}
@@ -0,0 +1,27 @@
+package internal
+
+import "time"
+
+// Group gathers accounts of a tenant, the roles assigned to a group are held by its members and by the
+// members of the groups nested in it.
+type Group struct {
+	Id        string
+	Name      string
+	TenantId  string
+	CreatedAt time.Time
+}
+
+func (g *Group) Validate() error {
+	if g.Name == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "group name is required")
+	}
+	return nil
+}
+
+// GroupMember is the membership of an account in a group.
+type GroupMember struct {
+	Id        string
+	Group     Group
+	Account   Account
+	CreatedAt time.Time
+}
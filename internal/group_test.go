@@ -0,0 +1,13 @@
+package internal_test
+
+import (
+	"rbac/internal"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup_Validate(t *testing.T) {
+	require.NoError(t, (&internal.Group{Name: "operators"}).Validate())
+	require.Error(t, (&internal.Group{}).Validate())
+}
@@ -0,0 +1,15 @@
+package kafka
+
+import (
+	"context"
+	"rbac/internal"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// Activated publishes a high priority message indicating an account broke glass.
+func (t *RBAC) BreakGlassActivated(ctx context.Context, activation internal.BreakGlassActivation) error {
+	return t.publishWithHeaders(ctx, "BreakGlass.Activated", internal.EVENT_BREAKGLASS_ACTIVATED, activation, []kafka.Header{
+		{Key: "priority", Value: []byte("high")},
+	})
+}
@@ -0,0 +1,16 @@
+package kafka
+
+import (
+	"context"
+	"rbac/internal"
+)
+
+// Added publishes a message indicating an account was added to a group.
+func (t *RBAC) GroupMemberAdded(ctx context.Context, member internal.GroupMember) error {
+	return t.publish(ctx, "GroupMember.Added", internal.EVENT_GROUPMEMBER_ADDED, member)
+}
+
+// Removed publishes a message indicating an account was removed from a group.
+func (t *RBAC) GroupMemberRemoved(ctx context.Context, id string) error {
+	return t.publish(ctx, "GroupMember.Removed", internal.EVENT_GROUPMEMBER_REMOVED, id)
+}
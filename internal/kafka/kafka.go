@@ -32,6 +32,11 @@ func NewRBAC(producer *kafka.Producer, topicName string) *RBAC {
 }
 
 func (t *RBAC) publish(ctx context.Context, spanName, msgType string, e interface{}) error {
+	return t.publishWithHeaders(ctx, spanName, msgType, e, nil)
+}
+
+// publishWithHeaders publishes the message with the headers, e.g. a priority consumers can route on.
+func (t *RBAC) publishWithHeaders(ctx context.Context, spanName, msgType string, e interface{}, headers []kafka.Header) error {
 	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, spanName)
 	defer span.End()
 
@@ -60,7 +65,8 @@ func (t *RBAC) publish(ctx context.Context, spanName, msgType string, e interfac
 			Topic:     &t.topicName,
 			Partition: kafka.PartitionAny,
 		},
-		Value: b.Bytes(),
+		Value:   b.Bytes(),
+		Headers: headers,
 	}, nil); err != nil {
 		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "product.Producer")
 	}
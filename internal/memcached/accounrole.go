@@ -17,7 +17,7 @@ func (t *RBAC) IndexAccountRole(ctx context.Context, accRole internal.AccountRol
 }
 
 func (t *RBAC) GetAccountRole(ctx context.Context, accRoleId string) (internal.AccountRoles, error) {
-	key := "accountrole_" + accRoleId
+	key := tenantKey(ctx, "accountrole_"+accRoleId)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -75,11 +75,12 @@ func (t *RBAC) UpdateAccountRole(ctx context.Context, accountRole internal.Accou
 }
 
 func (t *RBAC) GetAccountRoleByAccount(ctx context.Context, username string) (internal.AccountRoleByAccountResult, error) {
-	key := "accountrolebyaccount_" + username
+	key := tenantKey(ctx, "accountrolebyaccount_"+username)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
 			t.logger.Info("values NOT found", zap.String("key", string(key)))
+			internal.RecordLookup(ctx, "AccountRoleByAccount", username, internal.SOURCE_SEARCH)
 			res, err := t.orig.AccountRoleByAccount(ctx, &username)
 			if err != nil {
 				return internal.AccountRoleByAccountResult{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.AccountRoleByAccount")
@@ -118,6 +119,7 @@ func (t *RBAC) GetAccountRoleByAccount(ctx context.Context, username string) (in
 		return internal.AccountRoleByAccountResult{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Get")
 	}
 	t.logger.Info("values found", zap.String("key", string(key)))
+	internal.RecordLookup(ctx, "AccountRoleByAccount", username, internal.SOURCE_CACHE)
 	var res internal.AccountRoleByAccountResult
 	if err := gob.NewDecoder(bytes.NewReader(item.Value)).Decode(&res); err != nil {
 		return internal.AccountRoleByAccountResult{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "gob.NewDecoder")
@@ -126,7 +128,7 @@ func (t *RBAC) GetAccountRoleByAccount(ctx context.Context, username string) (in
 }
 
 func (t *RBAC) GetAccountRoleByRole(ctx context.Context, roleid string) (internal.AccountRoleByRoleResult, error) {
-	key := "accountrolebyrole_" + roleid
+	key := tenantKey(ctx, "accountrolebyrole_"+roleid)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -177,7 +179,7 @@ func (t *RBAC) GetAccountRoleByRole(ctx context.Context, roleid string) (interna
 }
 
 func (t *RBAC) ListAccountRole(ctx context.Context, args internal.ListArgs) (internal.ListAccountRole, error) {
-	key := newKey("listaccountrole", args)
+	key := tenantKey(ctx, newKey("listaccountrole", args))
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
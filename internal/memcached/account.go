@@ -21,7 +21,7 @@ func (t *RBAC) IndexAccount(ctx context.Context, account internal.Account) error
 }
 
 func (t *RBAC) GetAccount(ctx context.Context, username string) (internal.Account, error) {
-	key := "account_" + username
+	key := tenantKey(ctx, "account_"+username)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -59,7 +59,7 @@ func (t *RBAC) GetAccount(ctx context.Context, username string) (internal.Accoun
 }
 
 func (t *RBAC) GetAccountById(ctx context.Context, id string) (internal.Account, error) {
-	key := "account_" + id
+	key := tenantKey(ctx, "account_"+id)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -122,8 +122,38 @@ func (t *RBAC) DeleteAccount(ctx context.Context, username string) error {
 	return nil
 }
 
+// BlockAccount indexes the blocked account and drops its account roles, the cached values are
+// removed so the account loses its permissions right away.
+func (t *RBAC) BlockAccount(ctx context.Context, account internal.Account) error {
+	if err := t.IndexAccount(ctx, account); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "IndexAccount")
+	}
+	ar, err := t.orig.AccountRoleByAccountReturnId(ctx, account.UserName)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.AccountRoleByAccountReturnId")
+	}
+	for _, value := range ar {
+		if err := t.orig.DeleteAccountRole(ctx, value); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.DeleteAccountRole")
+		}
+	}
+	// the values are cached per tenant, drop them in every tenant of the account
+	ctxs := []context.Context{internal.NewTenantContext(ctx, "")}
+	for _, tenantId := range account.Tenants {
+		ctxs = append(ctxs, internal.NewTenantContext(ctx, tenantId))
+	}
+	for _, tctx := range ctxs {
+		for _, key := range []string{"account_" + account.UserName, "account_" + account.Id, "accountrolebyaccount_" + account.UserName} {
+			if err := t.client.Delete(tenantKey(tctx, key)); err != nil && err != memcache.ErrCacheMiss {
+				return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Delete")
+			}
+		}
+	}
+	return nil
+}
+
 func (t *RBAC) ListAccount(ctx context.Context, args internal.ListArgs) (internal.ListAccount, error) {
-	key := newKey("listaccount", args)
+	key := tenantKey(ctx, newKey("listaccount", args))
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
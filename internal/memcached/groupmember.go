@@ -0,0 +1,14 @@
+package memcached
+
+import (
+	"context"
+	"rbac/internal"
+)
+
+func (t *RBAC) IndexGroupMember(ctx context.Context, member internal.GroupMember) error {
+	return t.orig.IndexGroupMember(ctx, member)
+}
+
+func (t *RBAC) DeleteGroupMember(ctx context.Context, id string) error {
+	return t.orig.DeleteGroupMember(ctx, id)
+}
@@ -17,7 +17,7 @@ func (t *RBAC) IndexHelpText(ctx context.Context, helptext internal.HelpText) er
 }
 
 func (t *RBAC) GetHelpText(ctx context.Context, helptextId string) (internal.HelpText, error) {
-	key := "helptext_" + helptextId
+	key := tenantKey(ctx, "helptext_"+helptextId)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -53,7 +53,7 @@ func (t *RBAC) DeleteHelpText(ctx context.Context, roleId string) error {
 }
 
 func (t *RBAC) GetHelpTextByTask(ctx context.Context, taskid string) (internal.HelpText, error) {
-	key := "helptextbytask_" + taskid
+	key := tenantKey(ctx, "helptextbytask_"+taskid)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -87,7 +87,7 @@ func (t *RBAC) GetHelpTextByTask(ctx context.Context, taskid string) (internal.H
 }
 
 func (t *RBAC) ListHelpText(ctx context.Context, args internal.ListArgs) (internal.ListHelpText, error) {
-	key := newKey("listhelptext", args)
+	key := tenantKey(ctx, newKey("listhelptext", args))
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -1,6 +1,7 @@
 package memcached
 
 import (
+	"context"
 	"fmt"
 	"rbac/internal"
 )
@@ -24,3 +25,12 @@ func newKey(key string, args internal.ListArgs) string {
 
 	return fmt.Sprintf("%s_%d_%d", key, from, size)
 }
+
+// tenantKey prefixes the key with the tenant the request acts in, values read in a tenant are not shared
+// with the others.
+func tenantKey(ctx context.Context, key string) string {
+	if tenantId := internal.TenantFromContext(ctx); tenantId != "" {
+		return "tenant_" + tenantId + "_" + key
+	}
+	return key
+}
@@ -17,7 +17,7 @@ func (t *RBAC) IndexMenu(ctx context.Context, menu internal.Menu) error {
 }
 
 func (t *RBAC) GetMenu(ctx context.Context, menuId string) (internal.Menu, error) {
-	key := "menu_" + menuId
+	key := tenantKey(ctx, "menu_"+menuId)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -53,7 +53,7 @@ func (t *RBAC) DeleteMenu(ctx context.Context, menuId string) error {
 }
 
 func (t *RBAC) GetMenuByTask(ctx context.Context, taskid string) ([]internal.Menu, error) {
-	key := "menubytask_" + taskid
+	key := tenantKey(ctx, "menubytask_"+taskid)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -86,7 +86,7 @@ func (t *RBAC) GetMenuByTask(ctx context.Context, taskid string) ([]internal.Men
 }
 
 func (t *RBAC) ListMenu(ctx context.Context, args internal.ListArgs) (internal.ListMenu, error) {
-	key := newKey("listmenu", args)
+	key := tenantKey(ctx, newKey("listmenu", args))
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
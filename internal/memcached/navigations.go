@@ -17,7 +17,7 @@ func (t *RBAC) IndexNavigation(ctx context.Context, menu internal.Navigation) er
 }
 
 func (t *RBAC) GetNavigation(ctx context.Context, navigationId string) (internal.Navigation, error) {
-	key := "navigation_" + navigationId
+	key := tenantKey(ctx, "navigation_"+navigationId)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -53,7 +53,7 @@ func (t *RBAC) DeleteNavigation(ctx context.Context, roleId string) error {
 }
 
 func (t *RBAC) GetNavigationByTask(ctx context.Context, taskid string) ([]internal.Navigation, error) {
-	key := "navigationsbytask_" + taskid
+	key := tenantKey(ctx, "navigationsbytask_"+taskid)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -86,7 +86,7 @@ func (t *RBAC) GetNavigationByTask(ctx context.Context, taskid string) ([]intern
 }
 
 func (t *RBAC) ListNavigation(ctx context.Context, args internal.ListArgs) (internal.ListNavigation, error) {
-	key := newKey("listnavigation", args)
+	key := tenantKey(ctx, newKey("listnavigation", args))
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
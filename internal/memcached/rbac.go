@@ -33,6 +33,9 @@ type Datastore interface {
 	AccountRoleByRoleReturnId(ctx context.Context, roleId string) ([]string, error)
 	AccountRoleByAccountReturnId(ctx context.Context, username string) ([]string, error)
 
+	IndexGroupMember(ctx context.Context, member internal.GroupMember) error
+	DeleteGroupMember(ctx context.Context, id string) error
+
 	IndexTask(ctx context.Context, task internal.Tasks) error
 	DeleteTask(ctx context.Context, taskId string) error
 	GetTask(ctx context.Context, taskId string) (internal.Tasks, error)
@@ -17,7 +17,7 @@ func (t *RBAC) IndexRole(ctx context.Context, role internal.Roles) error {
 }
 
 func (t *RBAC) GetRole(ctx context.Context, roleId string) (internal.Roles, error) {
-	key := "role_" + roleId
+	key := tenantKey(ctx, "role_"+roleId)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -80,7 +80,7 @@ func (t *RBAC) DeleteRole(ctx context.Context, roleId string) error {
 }
 
 func (t *RBAC) ListRole(ctx context.Context, args internal.ListArgs) (internal.ListRole, error) {
-	key := newKey("listrole", args)
+	key := tenantKey(ctx, newKey("listrole", args))
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
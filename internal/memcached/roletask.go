@@ -17,7 +17,7 @@ func (t *RBAC) IndexRoleTask(ctx context.Context, roletask internal.RoleTasks) e
 }
 
 func (t *RBAC) GetRoleTask(ctx context.Context, roletaskId string) (internal.RoleTasks, error) {
-	key := "roletask_" + roletaskId
+	key := tenantKey(ctx, "roletask_"+roletaskId)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -60,11 +60,12 @@ func (t *RBAC) UpdateRoleTask(ctx context.Context, roleTask internal.RoleTasks)
 }
 
 func (t *RBAC) GetRoleTaskByRole(ctx context.Context, roleid string) (internal.RoleTaskByRole, error) {
-	key := "roletaskbyrole_" + roleid
+	key := tenantKey(ctx, "roletaskbyrole_"+roleid)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
 			t.logger.Info("values NOT found", zap.String("key", string(key)))
+			internal.RecordLookup(ctx, "RoleTaskByRole", roleid, internal.SOURCE_SEARCH)
 			res, err := t.orig.RoleTaskByRole(ctx, roleid)
 			if err != nil {
 				return internal.RoleTaskByRole{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.RoleTaskByRole")
@@ -83,6 +84,15 @@ func (t *RBAC) GetRoleTaskByRole(ctx context.Context, roleid string) (internal.R
 				tasks = append(tasks, task)
 			}
 			res.Tasks = tasks
+			var denied []internal.Tasks
+			for _, value := range res.DeniedTasks {
+				task, err := t.orig.GetTask(ctx, value.Id)
+				if err != nil {
+					return internal.RoleTaskByRole{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.GetTask")
+				}
+				denied = append(denied, task)
+			}
+			res.DeniedTasks = denied
 			var b bytes.Buffer
 			if err := gob.NewEncoder(&b).Encode(&res); err == nil {
 				t.logger.Info("settin value")
@@ -99,6 +109,7 @@ func (t *RBAC) GetRoleTaskByRole(ctx context.Context, roleid string) (internal.R
 		return internal.RoleTaskByRole{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Get")
 	}
 	t.logger.Info("values found", zap.String("key", string(key)))
+	internal.RecordLookup(ctx, "RoleTaskByRole", roleid, internal.SOURCE_CACHE)
 	var res internal.RoleTaskByRole
 	if err := gob.NewDecoder(bytes.NewReader(item.Value)).Decode(&res); err != nil {
 		return internal.RoleTaskByRole{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "gob.NewDecoder")
@@ -107,7 +118,7 @@ func (t *RBAC) GetRoleTaskByRole(ctx context.Context, roleid string) (internal.R
 }
 
 func (t *RBAC) GetRoleTaskByTask(ctx context.Context, taskid string) (internal.RoleTaskByTask, error) {
-	key := "roletaskbytask_" + taskid
+	key := tenantKey(ctx, "roletaskbytask_"+taskid)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -154,7 +165,7 @@ func (t *RBAC) GetRoleTaskByTask(ctx context.Context, taskid string) (internal.R
 }
 
 func (t *RBAC) ListRoleTask(ctx context.Context, args internal.ListArgs) (internal.ListRoleTask, error) {
-	key := newKey("listaccountrole", args)
+	key := tenantKey(ctx, newKey("listaccountrole", args))
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -18,7 +18,7 @@ func (t *RBAC) IndexTask(ctx context.Context, task internal.Tasks) error {
 
 func (t *RBAC) GetTask(ctx context.Context, taskId string) (internal.Tasks, error) {
 
-	key := "task_" + taskId
+	key := tenantKey(ctx, "task_"+taskId)
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -97,7 +97,7 @@ func (t *RBAC) DeleteTask(ctx context.Context, taskId string) error {
 }
 
 func (t *RBAC) ListTask(ctx context.Context, args internal.ListArgs) (internal.ListTask, error) {
-	key := newKey("listtask", args)
+	key := tenantKey(ctx, newKey("listtask", args))
 	item, err := t.client.Get(key)
 	if err != nil {
 		if err == memcache.ErrCacheMiss {
@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"rbac/internal"
+	"sync"
+	"time"
+)
+
+type loginFailures struct {
+	count     int64
+	expiresAt time.Time
+}
+
+// LoginAttempts counts failed logins in memory.
+type LoginAttempts struct {
+	mu       sync.Mutex
+	failures map[string]loginFailures
+	locks    map[string]time.Time
+}
+
+// NewLoginAttempts instantiates the LoginAttempts repository.
+func NewLoginAttempts() *LoginAttempts {
+	return &LoginAttempts{
+		failures: make(map[string]loginFailures),
+		locks:    make(map[string]time.Time),
+	}
+}
+
+// AddFailure records a failed login for the key, failures are forgotten once window passes without new ones.
+func (l *LoginAttempts) AddFailure(_ context.Context, key string, window time.Duration) (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	for k, v := range l.failures {
+		if now.After(v.expiresAt) {
+			delete(l.failures, k)
+		}
+	}
+	f := l.failures[key]
+	f.count++
+	f.expiresAt = now.Add(window)
+	l.failures[key] = f
+	return f.count, nil
+}
+
+// Lock refuses logins for the key until the given time.
+func (l *LoginAttempts) Lock(_ context.Context, key string, until time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	for k, v := range l.locks {
+		if now.After(v) {
+			delete(l.locks, k)
+		}
+	}
+	if now.Before(until) {
+		l.locks[key] = until
+	}
+	return nil
+}
+
+// Lockout returns the failures and the lock currently recorded for the key.
+func (l *LoginAttempts) Lockout(_ context.Context, key string) (internal.Lockout, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	lockout := internal.Lockout{Key: key}
+	if f, ok := l.failures[key]; ok && now.Before(f.expiresAt) {
+		lockout.Failures = f.count
+	}
+	if until, ok := l.locks[key]; ok && now.Before(until) {
+		lockout.LockedUntil = until
+	}
+	return lockout, nil
+}
+
+// Clear forgets the failures and lifts the lock of the key.
+func (l *LoginAttempts) Clear(_ context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, key)
+	delete(l.locks, key)
+	return nil
+}
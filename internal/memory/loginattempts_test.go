@@ -0,0 +1,34 @@
+package memory_test
+
+import (
+	"context"
+	"rbac/internal/memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginAttempts(t *testing.T) {
+	ctx := context.Background()
+	l := memory.NewLoginAttempts()
+
+	n, err := l.AddFailure(ctx, "username:admin", time.Minute)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
+	n, err = l.AddFailure(ctx, "username:admin", time.Minute)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+
+	require.NoError(t, l.Lock(ctx, "username:admin", time.Now().Add(time.Minute)))
+	lockout, err := l.Lockout(ctx, "username:admin")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, lockout.Failures)
+	require.True(t, lockout.IsLocked())
+
+	require.NoError(t, l.Clear(ctx, "username:admin"))
+	lockout, err = l.Lockout(ctx, "username:admin")
+	require.NoError(t, err)
+	require.Zero(t, lockout.Failures)
+	require.False(t, lockout.IsLocked())
+}
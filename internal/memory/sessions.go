@@ -0,0 +1,155 @@
+// Package memory implements in-memory repositories, meant to be used for tests and local development
+// where running Redis is not wanted.
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Sessions keeps track of revoked tokens in memory.
+type Sessions struct {
+	mu         sync.Mutex
+	tokens     map[string]time.Time
+	accounts   map[string]time.Time
+	challenges map[string]mfaChallenge
+	version    int64
+	roleIDs    map[string]cachedRoleIDs
+}
+
+type cachedRoleIDs struct {
+	ids       []string
+	expiresAt time.Time
+}
+
+type mfaChallenge struct {
+	username  string
+	expiresAt time.Time
+}
+
+// NewSessions instantiates the Sessions repository.
+func NewSessions() *Sessions {
+	return &Sessions{
+		tokens:     make(map[string]time.Time),
+		accounts:   make(map[string]time.Time),
+		challenges: make(map[string]mfaChallenge),
+		roleIDs:    make(map[string]cachedRoleIDs),
+	}
+}
+
+// RevokeToken marks the token as revoked until it expires.
+func (s *Sessions) RevokeToken(_ context.Context, id string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for k, v := range s.tokens {
+		if now.After(v) {
+			delete(s.tokens, k)
+		}
+	}
+	if now.Before(expiresAt) {
+		s.tokens[id] = expiresAt
+	}
+	return nil
+}
+
+// IsTokenRevoked returns true when the token was revoked.
+func (s *Sessions) IsTokenRevoked(_ context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiresAt, ok := s.tokens[id]
+	return ok && time.Now().Before(expiresAt), nil
+}
+
+// RevokeAccountTokens revokes every token of the account issued before at.
+func (s *Sessions) RevokeAccountTokens(_ context.Context, username string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts[username] = at
+	return nil
+}
+
+// AccountTokensRevokedAt returns the last time every token of the account was revoked, the zero value
+// is returned when that never happened.
+func (s *Sessions) AccountTokensRevokedAt(_ context.Context, username string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.accounts[username], nil
+}
+
+// CreateMFAChallenge stores the pending second factor of a login until it expires.
+func (s *Sessions) CreateMFAChallenge(_ context.Context, id string, username string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for k, v := range s.challenges {
+		if now.After(v.expiresAt) {
+			delete(s.challenges, k)
+		}
+	}
+	if now.Before(expiresAt) {
+		s.challenges[id] = mfaChallenge{username: username, expiresAt: expiresAt}
+	}
+	return nil
+}
+
+// MFAChallenge returns the username of the pending login, an empty string is returned when the challenge
+// does not exist or expired.
+func (s *Sessions) MFAChallenge(_ context.Context, id string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.challenges[id]
+	if !ok || time.Now().After(c.expiresAt) {
+		return "", nil
+	}
+	return c.username, nil
+}
+
+// DeleteMFAChallenge removes the pending login.
+func (s *Sessions) DeleteMFAChallenge(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.challenges, id)
+	return nil
+}
+
+// PermissionVersion returns the current permission version stamp, zero when permissions never changed.
+func (s *Sessions) PermissionVersion(_ context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.version, nil
+}
+
+// IncrementPermissionVersion bumps the permission version stamp, returning the new one.
+func (s *Sessions) IncrementPermissionVersion(_ context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.version++
+	return s.version, nil
+}
+
+// CachedRoleIDs returns the role ids cached under the key, ok is false when none are.
+func (s *Sessions) CachedRoleIDs(_ context.Context, key string) ([]string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.roleIDs[key]
+	if !ok || time.Now().After(c.expiresAt) {
+		return nil, false, nil
+	}
+	return append([]string{}, c.ids...), true, nil
+}
+
+// CacheRoleIDs caches the role ids under the key until expiration elapses.
+func (s *Sessions) CacheRoleIDs(_ context.Context, key string, ids []string, expiration time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for k, v := range s.roleIDs {
+		if now.After(v.expiresAt) {
+			delete(s.roleIDs, k)
+		}
+	}
+	s.roleIDs[key] = cachedRoleIDs{ids: append([]string{}, ids...), expiresAt: now.Add(expiration)}
+	return nil
+}
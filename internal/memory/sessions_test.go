@@ -0,0 +1,81 @@
+package memory_test
+
+import (
+	"context"
+	"rbac/internal/memory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessions_RevokeToken(t *testing.T) {
+	ctx := context.Background()
+	s := memory.NewSessions()
+
+	revoked, err := s.IsTokenRevoked(ctx, "token")
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, s.RevokeToken(ctx, "token", time.Now().Add(time.Minute)))
+	revoked, err = s.IsTokenRevoked(ctx, "token")
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	require.NoError(t, s.RevokeToken(ctx, "expired", time.Now().Add(-time.Minute)))
+	revoked, err = s.IsTokenRevoked(ctx, "expired")
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
+
+func TestSessions_RevokeAccountTokens(t *testing.T) {
+	ctx := context.Background()
+	s := memory.NewSessions()
+
+	at, err := s.AccountTokensRevokedAt(ctx, "admin")
+	require.NoError(t, err)
+	require.True(t, at.IsZero())
+
+	now := time.Now()
+	require.NoError(t, s.RevokeAccountTokens(ctx, "admin", now))
+	at, err = s.AccountTokensRevokedAt(ctx, "admin")
+	require.NoError(t, err)
+	require.True(t, now.Equal(at))
+}
+
+func TestSessions_PermissionVersion(t *testing.T) {
+	ctx := context.Background()
+	s := memory.NewSessions()
+
+	version, err := s.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Zero(t, version)
+
+	version, err = s.IncrementPermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+
+	version, err = s.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+}
+
+func TestSessions_CachedRoleIDs(t *testing.T) {
+	ctx := context.Background()
+	s := memory.NewSessions()
+
+	_, ok, err := s.CachedRoleIDs(ctx, "key")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, s.CacheRoleIDs(ctx, "key", []string{"role1", "role2"}, time.Minute))
+	ids, ok, err := s.CachedRoleIDs(ctx, "key")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []string{"role1", "role2"}, ids)
+
+	require.NoError(t, s.CacheRoleIDs(ctx, "expired", []string{"role1"}, -time.Minute))
+	_, ok, err = s.CachedRoleIDs(ctx, "expired")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,81 @@
+// Package notifier implements the notifiers used for delivering messages to account owners, they are
+// meant for local development where sending emails is not wanted.
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"rbac/internal"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Log writes the notifications to the logger.
+type Log struct {
+	logger *zap.Logger
+}
+
+// NewLog instantiates the Log notifier.
+func NewLog(logger *zap.Logger) *Log {
+	return &Log{
+		logger: logger,
+	}
+}
+
+// PasswordReset delivers the password reset token of the account.
+func (l *Log) PasswordReset(_ context.Context, account internal.Account, token string) error {
+	l.logger.Info("password reset requested",
+		zap.String("username", account.UserName),
+		zap.String("email", account.Profile.Email),
+		zap.String("token", token))
+	return nil
+}
+
+type notification struct {
+	Type      string    `json:"type"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// File appends the notifications to a file, one JSON document per line.
+type File struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFile instantiates the File notifier.
+func NewFile(path string) *File {
+	return &File{
+		path: path,
+	}
+}
+
+// PasswordReset delivers the password reset token of the account.
+func (f *File) PasswordReset(_ context.Context, account internal.Account, token string) error {
+	return f.write(notification{
+		Type:      "password_reset",
+		Username:  account.UserName,
+		Email:     account.Profile.Email,
+		Token:     token,
+		CreatedAt: time.Now(),
+	})
+}
+
+func (f *File) write(n notification) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "os.OpenFile")
+	}
+	defer file.Close()
+	if err := json.NewEncoder(file).Encode(n); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Encode")
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"rbac/internal"
+	"rbac/internal/notifier"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_PasswordReset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+	n := notifier.NewFile(path)
+
+	account := internal.Account{UserName: "admin", Profile: internal.Profile{Email: "admin@example.com"}}
+	require.NoError(t, n.PasswordReset(context.Background(), account, "token"))
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &res))
+	require.Equal(t, "password_reset", res["type"])
+	require.Equal(t, "admin", res["username"])
+	require.Equal(t, "admin@example.com", res["email"])
+	require.Equal(t, "token", res["token"])
+}
@@ -0,0 +1,74 @@
+package internal
+
+// EffectiveTask is a task along with the roles of the account granting or denying it, held directly,
+// through groups or inherited.
+type EffectiveTask struct {
+	Task  Tasks
+	Roles []Roles
+}
+
+// EffectivePermissions are the tasks an account may perform without scope nor condition, denied tasks are
+// left out of Tasks.
+type EffectivePermissions struct {
+	Username    string
+	Tasks       []EffectiveTask
+	DeniedTasks []EffectiveTask
+}
+
+// NewEffectivePermissions merges the tasks of the roles of the account, each task is listed once with all
+// the roles granting or denying it.
+func NewEffectivePermissions(username string, roles []RoleTaskByRole) EffectivePermissions {
+	merge := func(res []EffectiveTask, index map[string]int, role Roles, tasks []Tasks) []EffectiveTask {
+		for _, task := range tasks {
+			i, ok := index[task.Id]
+			if !ok {
+				i = len(res)
+				index[task.Id] = i
+				res = append(res, EffectiveTask{Task: task})
+			}
+			res[i].Roles = append(res[i].Roles, role)
+		}
+		return res
+	}
+	var granted, denied []EffectiveTask
+	grantedIndex := map[string]int{}
+	deniedIndex := map[string]int{}
+	for _, rt := range roles {
+		granted = merge(granted, grantedIndex, rt.Role, rt.Tasks)
+		denied = merge(denied, deniedIndex, rt.Role, rt.DeniedTasks)
+	}
+	res := EffectivePermissions{
+		Username:    username,
+		Tasks:       []EffectiveTask{},
+		DeniedTasks: []EffectiveTask{},
+	}
+	for _, task := range granted {
+		if _, ok := deniedIndex[task.Task.Id]; !ok {
+			res.Tasks = append(res.Tasks, task)
+		}
+	}
+	res.DeniedTasks = append(res.DeniedTasks, denied...)
+	return res
+}
+
+// Task returns the granted task with the id.
+func (p EffectivePermissions) Task(id string) (EffectiveTask, bool) {
+	for _, task := range p.Tasks {
+		if task.Task.Id == id {
+			return task, true
+		}
+	}
+	return EffectiveTask{}, false
+}
+
+// TaskHolder is an account granted a task along with the roles granting it.
+type TaskHolder struct {
+	Username string
+	Roles    []Roles
+}
+
+// TaskHolders are the accounts granted a task without scope nor condition.
+type TaskHolders struct {
+	Task     Tasks
+	Accounts []TaskHolder
+}
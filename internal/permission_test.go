@@ -0,0 +1,37 @@
+package internal_test
+
+import (
+	"rbac/internal"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEffectivePermissions(t *testing.T) {
+	admin := internal.Roles{Id: "r1", Role: "admin"}
+	auditor := internal.Roles{Id: "r2", Role: "auditor"}
+	read := internal.Tasks{Id: "t1", Task: "read"}
+	write := internal.Tasks{Id: "t2", Task: "write"}
+
+	p := internal.NewEffectivePermissions("bob", []internal.RoleTaskByRole{
+		{Role: admin, Tasks: []internal.Tasks{read, write}},
+		{Role: auditor, Tasks: []internal.Tasks{read}, DeniedTasks: []internal.Tasks{write}},
+	})
+
+	require.Equal(t, "bob", p.Username)
+	require.Equal(t, []internal.EffectiveTask{{Task: read, Roles: []internal.Roles{admin, auditor}}}, p.Tasks)
+	require.Equal(t, []internal.EffectiveTask{{Task: write, Roles: []internal.Roles{auditor}}}, p.DeniedTasks)
+
+	task, ok := p.Task("t1")
+	require.True(t, ok)
+	require.Equal(t, read, task.Task)
+	_, ok = p.Task("t2")
+	require.False(t, ok)
+}
+
+func TestNewEffectivePermissions_Empty(t *testing.T) {
+	p := internal.NewEffectivePermissions("bob", nil)
+	require.Empty(t, p.Tasks)
+	require.NotNil(t, p.Tasks)
+	require.NotNil(t, p.DeniedTasks)
+}
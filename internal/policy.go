@@ -0,0 +1,272 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	POLICY_CREATE = "create"
+	POLICY_UPDATE = "update"
+	POLICY_DELETE = "delete"
+
+	POLICY_TASK       = "task"
+	POLICY_HELPTEXT   = "helptext"
+	POLICY_MENU       = "menu"
+	POLICY_NAVIGATION = "navigation"
+	POLICY_ROLE       = "role"
+	POLICY_ROLE_TASK  = "role task"
+)
+
+// Policy declares the tasks and the roles of a tenant. Applying it makes the declared tasks and roles match
+// it exactly, the undeclared ones are only deleted when pruning.
+type Policy struct {
+	Tasks []PolicyTask
+	Roles []PolicyRole
+}
+
+// PolicyOptions tell how a policy is applied. Prune deletes the roles of the tenant missing from the policy
+// and PruneTasks the tasks missing from it, which is only allowed in the default tenant. A DryRun only
+// returns the changes to make.
+type PolicyOptions struct {
+	Prune      bool
+	PruneTasks bool
+	DryRun     bool
+}
+
+// PolicyTask is a task along with its help text, menus and navigations.
+type PolicyTask struct {
+	Task        string
+	HelpText    string
+	Menus       []string
+	Navigations []string
+}
+
+// PolicyRole is a role along with the tasks it grants or denies.
+type PolicyRole struct {
+	Role   string
+	Grants []PolicyGrant
+}
+
+// PolicyGrant is a role task of a policy role, an empty Effect is EFFECT_ALLOW.
+type PolicyGrant struct {
+	Task      string
+	Effect    string
+	Scope     Scope
+	Condition string
+}
+
+// key identifies the grant within its role, grants differing in anything are different role tasks.
+func (g PolicyGrant) key() string {
+	effect := g.Effect
+	if effect == "" {
+		effect = EFFECT_ALLOW
+	}
+	return strings.Join([]string{g.Task, effect, g.Scope.ResourceType, g.Scope.ResourceId, g.Condition}, "\x00")
+}
+
+// String describes the grant, e.g. "create account" or "delete account (deny on account dept-x-*)".
+func (g PolicyGrant) String() string {
+	var details []string
+	if g.Effect == EFFECT_DENY {
+		details = append(details, EFFECT_DENY)
+	}
+	if !g.Scope.IsGlobal() {
+		details = append(details, "on "+g.Scope.ResourceType+" "+g.Scope.ResourceId)
+	}
+	if g.Condition != "" {
+		details = append(details, "if "+g.Condition)
+	}
+	if len(details) == 0 {
+		return g.Task
+	}
+	return fmt.Sprintf("%s (%s)", g.Task, strings.Join(details, " "))
+}
+
+// Validate checks the names are given and unique and that the grants refer to declared tasks.
+func (p *Policy) Validate() error {
+	tasks := map[string]bool{}
+	for _, task := range p.Tasks {
+		if task.Task == "" {
+			return NewErrorf(ErrorCodeInvalidArgument, "task name is required")
+		}
+		if tasks[task.Task] {
+			return NewErrorf(ErrorCodeInvalidArgument, "task %s is declared more than once", task.Task)
+		}
+		tasks[task.Task] = true
+	}
+	roles := map[string]bool{}
+	for _, role := range p.Roles {
+		if role.Role == "" {
+			return NewErrorf(ErrorCodeInvalidArgument, "role name is required")
+		}
+		if roles[role.Role] {
+			return NewErrorf(ErrorCodeInvalidArgument, "role %s is declared more than once", role.Role)
+		}
+		roles[role.Role] = true
+		grants := map[string]bool{}
+		for _, grant := range role.Grants {
+			if !tasks[grant.Task] {
+				return NewErrorf(ErrorCodeInvalidArgument, "role %s grants the undeclared task %q", role.Role, grant.Task)
+			}
+			if grants[grant.key()] {
+				return NewErrorf(ErrorCodeInvalidArgument, "role %s declares %s more than once", role.Role, grant)
+			}
+			grants[grant.key()] = true
+		}
+	}
+	return nil
+}
+
+// PolicyChange is a change making the repository match a policy. Name is the help text, menu or navigation
+// for those kinds, Id is the id of the changed record once applied.
+type PolicyChange struct {
+	Action string
+	Kind   string
+	Task   string
+	Role   string
+	Name   string
+	Grant  PolicyGrant
+	Id     string
+}
+
+func (c PolicyChange) String() string {
+	switch c.Kind {
+	case POLICY_TASK:
+		return fmt.Sprintf("%s task %s", c.Action, c.Task)
+	case POLICY_ROLE:
+		return fmt.Sprintf("%s role %s", c.Action, c.Role)
+	case POLICY_ROLE_TASK:
+		return fmt.Sprintf("%s role task %s: %s", c.Action, c.Role, c.Grant)
+	default:
+		return fmt.Sprintf("%s %s %q of task %s", c.Action, c.Kind, c.Name, c.Task)
+	}
+}
+
+// DiffPolicy returns the changes turning current into desired. Deletions come first so that names freed by
+// a task or role can be taken by another one, roles missing from desired are only deleted with Prune and
+// tasks with PruneTasks.
+func DiffPolicy(current Policy, desired Policy, opts PolicyOptions) []PolicyChange {
+	var deletes, creates []PolicyChange
+
+	currentTasks := map[string]PolicyTask{}
+	for _, task := range current.Tasks {
+		currentTasks[task.Task] = task
+	}
+	desiredTasks := map[string]bool{}
+	for _, task := range desired.Tasks {
+		desiredTasks[task.Task] = true
+		cur, found := currentTasks[task.Task]
+		if !found {
+			creates = append(creates, PolicyChange{Action: POLICY_CREATE, Kind: POLICY_TASK, Task: task.Task})
+		}
+		switch {
+		case task.HelpText == cur.HelpText:
+		case cur.HelpText == "":
+			creates = append(creates, PolicyChange{Action: POLICY_CREATE, Kind: POLICY_HELPTEXT, Task: task.Task, Name: task.HelpText})
+		case task.HelpText == "":
+			deletes = append(deletes, PolicyChange{Action: POLICY_DELETE, Kind: POLICY_HELPTEXT, Task: task.Task, Name: cur.HelpText})
+		default:
+			creates = append(creates, PolicyChange{Action: POLICY_UPDATE, Kind: POLICY_HELPTEXT, Task: task.Task, Name: task.HelpText})
+		}
+		deleted, created := diffNames(cur.Menus, task.Menus)
+		for _, name := range deleted {
+			deletes = append(deletes, PolicyChange{Action: POLICY_DELETE, Kind: POLICY_MENU, Task: task.Task, Name: name})
+		}
+		for _, name := range created {
+			creates = append(creates, PolicyChange{Action: POLICY_CREATE, Kind: POLICY_MENU, Task: task.Task, Name: name})
+		}
+		deleted, created = diffNames(cur.Navigations, task.Navigations)
+		for _, name := range deleted {
+			deletes = append(deletes, PolicyChange{Action: POLICY_DELETE, Kind: POLICY_NAVIGATION, Task: task.Task, Name: name})
+		}
+		for _, name := range created {
+			creates = append(creates, PolicyChange{Action: POLICY_CREATE, Kind: POLICY_NAVIGATION, Task: task.Task, Name: name})
+		}
+	}
+
+	currentRoles := map[string]PolicyRole{}
+	for _, role := range current.Roles {
+		currentRoles[role.Role] = role
+	}
+	desiredRoles := map[string]bool{}
+	for _, role := range desired.Roles {
+		desiredRoles[role.Role] = true
+		cur, found := currentRoles[role.Role]
+		if !found {
+			creates = append(creates, PolicyChange{Action: POLICY_CREATE, Kind: POLICY_ROLE, Role: role.Role})
+		}
+		grants := map[string]bool{}
+		for _, grant := range role.Grants {
+			grants[grant.key()] = true
+		}
+		held := map[string]bool{}
+		for _, grant := range cur.Grants {
+			held[grant.key()] = true
+			if !grants[grant.key()] {
+				deletes = append(deletes, PolicyChange{Action: POLICY_DELETE, Kind: POLICY_ROLE_TASK, Role: role.Role, Task: grant.Task, Grant: grant})
+			}
+		}
+		for _, grant := range role.Grants {
+			if !held[grant.key()] {
+				creates = append(creates, PolicyChange{Action: POLICY_CREATE, Kind: POLICY_ROLE_TASK, Role: role.Role, Task: grant.Task, Grant: grant})
+			}
+		}
+	}
+
+	if opts.Prune {
+		for _, role := range current.Roles {
+			if !desiredRoles[role.Role] {
+				deletes = append(deletes, PolicyChange{Action: POLICY_DELETE, Kind: POLICY_ROLE, Role: role.Role})
+			}
+		}
+	}
+	if opts.PruneTasks {
+		for _, task := range current.Tasks {
+			if !desiredTasks[task.Task] {
+				deletes = append(deletes, PolicyChange{Action: POLICY_DELETE, Kind: POLICY_TASK, Task: task.Task})
+			}
+		}
+	}
+
+	// tasks and roles are created before what refers to them
+	sort.SliceStable(creates, func(i, j int) bool {
+		return policyKindOrder[creates[i].Kind] < policyKindOrder[creates[j].Kind]
+	})
+	return append(deletes, creates...)
+}
+
+var policyKindOrder = map[string]int{
+	POLICY_TASK:       0,
+	POLICY_ROLE:       0,
+	POLICY_HELPTEXT:   1,
+	POLICY_MENU:       1,
+	POLICY_NAVIGATION: 1,
+	POLICY_ROLE_TASK:  1,
+}
+
+// diffNames returns the names of current missing from desired and the ones of desired missing from current.
+func diffNames(current []string, desired []string) ([]string, []string) {
+	in := func(names []string) map[string]bool {
+		set := map[string]bool{}
+		for _, name := range names {
+			set[name] = true
+		}
+		return set
+	}
+	currentSet, desiredSet := in(current), in(desired)
+	var deleted, created []string
+	for _, name := range current {
+		if !desiredSet[name] {
+			deleted = append(deleted, name)
+		}
+	}
+	for _, name := range desired {
+		if !currentSet[name] {
+			created = append(created, name)
+			currentSet[name] = true
+		}
+	}
+	return deleted, created
+}
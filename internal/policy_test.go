@@ -0,0 +1,79 @@
+package internal_test
+
+import (
+	"rbac/internal"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_Validate(t *testing.T) {
+	tasks := []internal.PolicyTask{{Task: "create account"}, {Task: "list account"}}
+
+	require.NoError(t, (&internal.Policy{Tasks: tasks, Roles: []internal.PolicyRole{
+		{Role: "admin", Grants: []internal.PolicyGrant{{Task: "create account"}, {Task: "create account", Effect: internal.EFFECT_DENY}}},
+	}}).Validate())
+	require.Error(t, (&internal.Policy{Tasks: []internal.PolicyTask{{}}}).Validate())
+	require.Error(t, (&internal.Policy{Tasks: append(tasks, internal.PolicyTask{Task: "list account"})}).Validate())
+	require.Error(t, (&internal.Policy{Tasks: tasks, Roles: []internal.PolicyRole{{Role: "admin"}, {Role: "admin"}}}).Validate())
+	require.Error(t, (&internal.Policy{Tasks: tasks, Roles: []internal.PolicyRole{
+		{Role: "admin", Grants: []internal.PolicyGrant{{Task: "delete account"}}},
+	}}).Validate())
+	require.Error(t, (&internal.Policy{Tasks: tasks, Roles: []internal.PolicyRole{
+		{Role: "admin", Grants: []internal.PolicyGrant{{Task: "list account"}, {Task: "list account", Effect: internal.EFFECT_ALLOW}}},
+	}}).Validate())
+}
+
+func TestDiffPolicy(t *testing.T) {
+	current := internal.Policy{
+		Tasks: []internal.PolicyTask{
+			{Task: "create account", HelpText: "Creates an account", Menus: []string{"accounts"}},
+			{Task: "list account", Navigations: []string{"accounts"}},
+			{Task: "legacy"},
+		},
+		Roles: []internal.PolicyRole{
+			{Role: "admin", Grants: []internal.PolicyGrant{{Task: "create account"}, {Task: "list account", Effect: internal.EFFECT_ALLOW}}},
+			{Role: "guest"},
+		},
+	}
+	desired := internal.Policy{
+		Tasks: []internal.PolicyTask{
+			{Task: "create account", HelpText: "Creates a new account", Menus: []string{"accounts", "new account"}},
+			{Task: "list account"},
+			{Task: "delete account", HelpText: "Deletes an account"},
+		},
+		Roles: []internal.PolicyRole{
+			{Role: "admin", Grants: []internal.PolicyGrant{
+				{Task: "list account"},
+				{Task: "delete account", Scope: internal.Scope{ResourceType: "account", ResourceId: "dept-x-*"}},
+			}},
+		},
+	}
+
+	changes := internal.DiffPolicy(current, desired, internal.PolicyOptions{})
+	require.Equal(t, []string{
+		`delete navigation "accounts" of task list account`,
+		"delete role task admin: create account",
+		"create task delete account",
+		`update helptext "Creates a new account" of task create account`,
+		`create menu "new account" of task create account`,
+		`create helptext "Deletes an account" of task delete account`,
+		"create role task admin: delete account (on account dept-x-*)",
+	}, policyChanges(changes))
+
+	changes = internal.DiffPolicy(current, desired, internal.PolicyOptions{Prune: true})
+	require.Equal(t, []string{"delete role guest", "create task delete account"}, policyChanges(changes)[2:4])
+
+	changes = internal.DiffPolicy(current, desired, internal.PolicyOptions{Prune: true, PruneTasks: true})
+	require.Equal(t, []string{"delete role guest", "delete task legacy"}, policyChanges(changes)[2:4])
+
+	require.Empty(t, internal.DiffPolicy(desired, desired, internal.PolicyOptions{Prune: true, PruneTasks: true}))
+}
+
+func policyChanges(changes []internal.PolicyChange) []string {
+	res := make([]string, len(changes))
+	for i, change := range changes {
+		res[i] = change.String()
+	}
+	return res
+}
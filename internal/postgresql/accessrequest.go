@@ -0,0 +1,359 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tenantAccessRequest returns the access request, requests for roles of another tenant than the one of the
+// request are not found.
+func tenantAccessRequest(ctx context.Context, q *Queries, id string) (SelectAccessRequestRow, error) {
+	aid, err := uuid.Parse(id)
+	if err != nil {
+		return SelectAccessRequestRow{}, handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+	}
+	ar, err := q.SelectAccessRequest(ctx, aid)
+	if err != nil {
+		return SelectAccessRequestRow{}, handleError(err, "get access request", internal.ErrorCodeUnknown, "access request not found")
+	}
+	if !inTenant(ctx, ar.TenantID) {
+		return SelectAccessRequestRow{}, internal.NewErrorf(internal.ErrorCodeNotFound, "access request not found")
+	}
+	return ar, nil
+}
+
+// AddRoleApprover lets the holders of the approver role approve the access requests for the role, both roles
+// must belong to the same tenant.
+func (s *Store) AddRoleApprover(ctx context.Context, roleId string, approverRoleId string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.AddRoleApprover")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var id string
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse role id", internal.ErrorCodeInvalidArgument, "")
+		}
+		arid, err := uuid.Parse(approverRoleId)
+		if err != nil {
+			return handleError(err, "parse approver role id", internal.ErrorCodeInvalidArgument, "")
+		}
+		role, err := tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		approver, err := tenantRole(ctx, q, arid, "approver role not found")
+		if err != nil {
+			return err
+		}
+		if role.TenantID != approver.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "a role can't be approved by a role of another tenant")
+		}
+		raid, err := q.InsertRoleApprover(ctx, InsertRoleApproverParams{
+			RoleID:         rid,
+			ApproverRoleID: arid,
+		})
+		if err != nil {
+			return handleError(err, "add role approver", internal.ErrorCodeUnknown, "")
+		}
+		id = raid.String()
+		return nil
+	})
+	return id, err
+}
+
+func (s *Store) RemoveRoleApprover(ctx context.Context, roleId string, approverRoleId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.RemoveRoleApprover")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse role id", internal.ErrorCodeInvalidArgument, "")
+		}
+		arid, err := uuid.Parse(approverRoleId)
+		if err != nil {
+			return handleError(err, "parse approver role id", internal.ErrorCodeInvalidArgument, "")
+		}
+		_, err = tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		n, err := q.DeleteRoleApprover(ctx, DeleteRoleApproverParams{
+			RoleID:         rid,
+			ApproverRoleID: arid,
+		})
+		if err != nil {
+			return handleError(err, "remove role approver", internal.ErrorCodeUnknown, "")
+		}
+		if n == 0 {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "role approver not found")
+		}
+		return nil
+	})
+	return err
+}
+
+// RoleApprovers returns the roles whose holders approve the access requests for the role.
+func (s *Store) RoleApprovers(ctx context.Context, roleId string) ([]internal.Roles, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.RoleApprovers")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	roles := []internal.Roles{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse role id", internal.ErrorCodeInvalidArgument, "")
+		}
+		role, err := tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectRoleApprovers(ctx, rid)
+		if err != nil {
+			return handleError(err, "get role approvers", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			roles = append(roles, internal.Roles{
+				Id:        value.ID.String(),
+				Role:      value.Role,
+				TenantId:  role.TenantID.String(),
+				CreatedAt: value.CreatedAt,
+			})
+		}
+		return nil
+	})
+	return roles, err
+}
+
+// CreateAccessRequest records a pending request of the account for the role, an account has at most one
+// pending request per role.
+func (s *Store) CreateAccessRequest(ctx context.Context, username string, roleId string, justification string, expiresAt time.Time) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var id string
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse role id", internal.ErrorCodeInvalidArgument, "")
+		}
+		role, err := tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		tenants, err := q.SelectAccountTenants(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "get account tenants", internal.ErrorCodeUnknown, "")
+		}
+		inRoleTenant := false
+		for _, value := range tenants {
+			inRoleTenant = inRoleTenant || value.ID == role.TenantID
+		}
+		if !inRoleTenant {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "role not found")
+		}
+		err = q.ExpireAccessRequests(ctx, ExpireAccessRequestsParams{
+			AccountID: acc.ID,
+			RoleID:    rid,
+		})
+		if err != nil {
+			return handleError(err, "expire access requests", internal.ErrorCodeUnknown, "")
+		}
+		rows, err := q.SelectAccessRequestsByAccount(ctx, SelectAccessRequestsByAccountParams{
+			Username: acc.Username,
+			TenantID: role.TenantID,
+		})
+		if err != nil {
+			return handleError(err, "get account access requests", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			if value.RoleID == rid && value.Status == internal.ACCESS_REQUEST_PENDING {
+				return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "an access request for the role is already pending")
+			}
+		}
+		aid, err := q.InsertAccessRequest(ctx, InsertAccessRequestParams{
+			AccountID:     acc.ID,
+			RoleID:        rid,
+			Justification: justification,
+			ExpiresAt:     expiresAt,
+		})
+		if err != nil {
+			return handleError(err, "create access request", internal.ErrorCodeUnknown, "")
+		}
+		id = aid.String()
+		return nil
+	})
+	return id, err
+}
+
+func (s *Store) AccessRequest(ctx context.Context, id string) (internal.AccessRequest, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.AccessRequest")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res := internal.AccessRequest{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		ar, err := tenantAccessRequest(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		res = convertAccessRequest(ar)
+		return nil
+	})
+	return res, err
+}
+
+// AccessRequests returns the access requests for the roles of the tenant of the request, newest first.
+func (s *Store) AccessRequests(ctx context.Context) ([]internal.AccessRequest, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.AccessRequests")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res := []internal.AccessRequest{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectAccessRequests(ctx, tid)
+		if err != nil {
+			return handleError(err, "get access requests", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			res = append(res, convertAccessRequest(SelectAccessRequestRow(value)))
+		}
+		return nil
+	})
+	return res, err
+}
+
+// AccountAccessRequests returns the access requests of the account in the tenant of the request, newest first.
+func (s *Store) AccountAccessRequests(ctx context.Context, username string) ([]internal.AccessRequest, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.AccountAccessRequests")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res := []internal.AccessRequest{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectAccessRequestsByAccount(ctx, SelectAccessRequestsByAccountParams{
+			Username: username,
+			TenantID: tid,
+		})
+		if err != nil {
+			return handleError(err, "get account access requests", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			res = append(res, convertAccessRequest(SelectAccessRequestRow(value)))
+		}
+		return nil
+	})
+	return res, err
+}
+
+// DecideAccessRequest approves or rejects the access request, only pending requests that didn't expire can
+// be decided.
+func (s *Store) DecideAccessRequest(ctx context.Context, id string, status string, decidedBy string, comment string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.Decide")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		ar, err := tenantAccessRequest(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		acc, err := q.SelectAccounts(ctx, decidedBy)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		n, err := q.DecideAccessRequest(ctx, DecideAccessRequestParams{
+			Status:    status,
+			DecidedBy: acc.ID,
+			Comment:   comment,
+			ID:        ar.ID,
+		})
+		if err != nil {
+			return handleError(err, "decide access request", internal.ErrorCodeUnknown, "")
+		}
+		if n == 0 {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "access request is not pending")
+		}
+		return nil
+	})
+	return err
+}
+
+// ApproveAccessRequest approves the access request and assigns its role to the requester in a single
+// transaction, the request stays pending when the role can't be assigned. It returns the id of the account
+// role.
+func (s *Store) ApproveAccessRequest(ctx context.Context, id string, decidedBy string, comment string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.Approve")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var arid string
+	err := s.execTx(ctx, func(q *Queries) error {
+		ar, err := tenantAccessRequest(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		acc, err := q.SelectAccounts(ctx, decidedBy)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		n, err := q.DecideAccessRequest(ctx, DecideAccessRequestParams{
+			Status:    internal.ACCESS_REQUEST_APPROVED,
+			DecidedBy: acc.ID,
+			Comment:   comment,
+			ID:        ar.ID,
+		})
+		if err != nil {
+			return handleError(err, "decide access request", internal.ErrorCodeUnknown, "")
+		}
+		if n == 0 {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "access request is not pending")
+		}
+		rid, err := assignRole(ctx, q, ar.AccountID, ar.RoleID, internal.Scope{}, internal.Validity{})
+		if err != nil {
+			return err
+		}
+		arid = rid.String()
+		return nil
+	})
+	return arid, err
+}
+
+func convertAccessRequest(ar SelectAccessRequestRow) internal.AccessRequest {
+	res := internal.AccessRequest{
+		Id: ar.ID.String(),
+		Account: internal.Account{
+			Id:       ar.AccountID.String(),
+			UserName: ar.Username,
+		},
+		Role: internal.Roles{
+			Id:       ar.RoleID.String(),
+			Role:     ar.Role,
+			TenantId: ar.TenantID.String(),
+		},
+		Justification: ar.Justification,
+		Status:        ar.Status,
+		DecidedBy:     ar.DecidedBy,
+		Comment:       ar.Comment,
+		ExpiresAt:     ar.ExpiresAt,
+		CreatedAt:     ar.CreatedAt,
+	}
+	if ar.DecidedAt.Valid {
+		res.DecidedAt = ar.DecidedAt.Time
+	}
+	res.Status = res.StatusAt(time.Now())
+	return res
+}
@@ -16,10 +16,16 @@ func (a *Store) Login(ctx context.Context, username string, password string) err
 		if err != nil {
 			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
 		}
+		if acc.IsServiceAccount {
+			return internal.NewErrorf(internal.ErrorCodeUnauthorized, "service accounts can not login with a password")
+		}
 		err = CheckPassword(password, acc.Hashedpassword)
 		if err != nil {
 			return handleError(err, "check password", internal.ErrorCodeUnknown, "")
 		}
+		if acc.IsBlocked {
+			return internal.NewErrorf(internal.ErrorCodeUnauthorized, "account is blocked")
+		}
 		return nil
 	})
 	return err
@@ -54,12 +60,77 @@ func (s *Store) CreateAccount(ctx context.Context, account internal.Account, pas
 		if err != nil {
 			return handleError(err, "create account", internal.ErrorCodeUnknown, "")
 		}
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		err = q.InsertAccountTenant(ctx, InsertAccountTenantParams{
+			AccountID: aid,
+			TenantID:  tid,
+			IsActive:  true,
+		})
+		if err != nil {
+			return handleError(err, "add account tenant", internal.ErrorCodeUnknown, "")
+		}
 		accId = aid.String()
 		return nil
 	})
 	return accId, err
 }
 
+// CreateServiceAccount creates an account without a password, an empty profile is created so service
+// accounts can be read like any other account.
+func (s *Store) CreateServiceAccount(ctx context.Context, username string) (internal.Account, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.CreateServiceAccount")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	account := internal.Account{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		profileId, err := q.InsertProfile(ctx, InsertProfileParams{})
+		if err != nil {
+			return handleError(err, "create profile", internal.ErrorCodeUnknown, "")
+		}
+		aid, err := q.InsertServiceAccount(ctx, InsertServiceAccountParams{
+			Username: username,
+			Profile:  profileId,
+		})
+		if err != nil {
+			return handleError(err, "create service account", internal.ErrorCodeUnknown, "")
+		}
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		err = q.InsertAccountTenant(ctx, InsertAccountTenantParams{
+			AccountID: aid,
+			TenantID:  tid,
+			IsActive:  true,
+		})
+		if err != nil {
+			return handleError(err, "add account tenant", internal.ErrorCodeUnknown, "")
+		}
+		acc, err := q.SelectAccountsById(ctx, aid)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		prof, err := q.SelectProfile(ctx, profileId)
+		if err != nil {
+			return handleError(err, "get profile", internal.ErrorCodeUnknown, "profile not found")
+		}
+		account.Id = acc.ID.String()
+		account.UserName = acc.Username
+		account.IsServiceAccount = acc.IsServiceAccount
+		account.Tenants = []string{tid.String()}
+		account.CreatedAt = acc.CreatedAt
+		account.Profile = internal.Profile{
+			Id:        prof.ID.String(),
+			CreatedAt: prof.CreatedAt,
+		}
+		return nil
+	})
+	return account, err
+}
+
 func (s *Store) Account(ctx context.Context, username string) (internal.Account, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Account")
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
@@ -74,7 +145,12 @@ func (s *Store) Account(ctx context.Context, username string) (internal.Account,
 		account.UserName = acc.Username
 		// account.HashedPassword = acc.Hashedpassword
 		account.IsBlocked = acc.IsBlocked
+		account.IsServiceAccount = acc.IsServiceAccount
 		account.CreatedAt = acc.CreatedAt
+		account.Tenants, err = accountTenants(ctx, q, acc.ID)
+		if err != nil {
+			return err
+		}
 		prof, err := q.SelectProfile(ctx, acc.Profile)
 		if err != nil {
 			return handleError(err, "get profile", internal.ErrorCodeUnknown, "profile not found")
@@ -113,7 +189,12 @@ func (s *Store) AccountByID(ctx context.Context, id string) (internal.Account, e
 		account.UserName = acc.Username
 		// account.HashedPassword = acc.Hashedpassword
 		account.IsBlocked = acc.IsBlocked
+		account.IsServiceAccount = acc.IsServiceAccount
 		account.CreatedAt = acc.CreatedAt
+		account.Tenants, err = accountTenants(ctx, q, acc.ID)
+		if err != nil {
+			return err
+		}
 		prof, err := q.SelectProfile(ctx, acc.Profile)
 		if err != nil {
 			return handleError(err, "get profile", internal.ErrorCodeUnknown, "profile not found")
@@ -160,7 +241,15 @@ func (s *Store) DeleteAccount(ctx context.Context, username string) error {
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
 	defer span.End()
 	err := s.execTx(ctx, func(q *Queries) error {
-		err := q.DeleteAccount(ctx, username)
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		_, err = accountTenants(ctx, q, acc.ID)
+		if err != nil {
+			return err
+		}
+		err = q.DeleteAccount(ctx, username)
 		if err != nil {
 			return handleError(err, "delete account", internal.ErrorCodeUnknown, "")
 		}
@@ -188,3 +277,45 @@ func (s *Store) ChangePassword(ctx context.Context, username string, password st
 	})
 	return err
 }
+func (s *Store) BlockAccount(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Block")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		_, err = accountTenants(ctx, q, acc.ID)
+		if err != nil {
+			return err
+		}
+		err = q.BlockAccount(ctx, username)
+		if err != nil {
+			return handleError(err, "block account", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
+func (s *Store) UnblockAccount(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Unblock")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		_, err = accountTenants(ctx, q, acc.ID)
+		if err != nil {
+			return err
+		}
+		err = q.UnblockAccount(ctx, username)
+		if err != nil {
+			return handleError(err, "unblock account", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
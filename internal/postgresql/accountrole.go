@@ -2,6 +2,7 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
 	"rbac/internal"
 
 	"github.com/google/uuid"
@@ -9,7 +10,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-func (s *Store) CreateAccountRole(ctx context.Context, accountId string, roleId string) (string, error) {
+func (s *Store) CreateAccountRole(ctx context.Context, accountId string, roleId string, scope internal.Scope, validity internal.Validity) (string, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.Create")
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
 	defer span.End()
@@ -23,18 +24,55 @@ func (s *Store) CreateAccountRole(ctx context.Context, accountId string, roleId
 		if err != nil {
 			return handleError(err, "parse role id", internal.ErrorCodeInvalidArgument, "")
 		}
-		id, err := q.InsertAccountRole(ctx, InsertAccountRoleParams{
-			AccountID: aid,
-			RoleID:    rid,
-		})
+		id, err := assignRole(ctx, q, aid, rid, scope, validity)
 		if err != nil {
-			return handleError(err, "create account role", internal.ErrorCodeUnknown, "")
+			return err
 		}
 		arid = id.String()
 		return nil
 	})
 	return arid, err
 }
+
+// assignRole assigns the role to the account, which must belong to the tenant of the role, unless the
+// role is exclusive with another role of the account.
+func assignRole(ctx context.Context, q *Queries, aid uuid.UUID, rid uuid.UUID, scope internal.Scope, validity internal.Validity) (uuid.UUID, error) {
+	role, err := tenantRole(ctx, q, rid, "role not found")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	tenants, err := q.SelectAccountTenants(ctx, aid)
+	if err != nil {
+		return uuid.Nil, handleError(err, "get account tenants", internal.ErrorCodeUnknown, "")
+	}
+	member := false
+	for _, value := range tenants {
+		member = member || value.ID == role.TenantID
+	}
+	if !member {
+		return uuid.Nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "account doesn't belong to the tenant of the role")
+	}
+	err = q.LockAccountRoles(ctx, aid)
+	if err != nil {
+		return uuid.Nil, handleError(err, "lock account roles", internal.ErrorCodeUnknown, "")
+	}
+	id, err := q.InsertAccountRole(ctx, InsertAccountRoleParams{
+		AccountID:    aid,
+		RoleID:       rid,
+		ResourceType: scope.ResourceType,
+		ResourceID:   scope.ResourceId,
+		ValidFrom:    sql.NullTime{Time: validity.From, Valid: !validity.From.IsZero()},
+		ValidUntil:   sql.NullTime{Time: validity.Until, Valid: !validity.Until.IsZero()},
+	})
+	if err != nil {
+		return uuid.Nil, handleError(err, "create account role", internal.ErrorCodeUnknown, "")
+	}
+	err = checkExclusiveRoles(ctx, q, aid, role)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
 func (s *Store) AccountRole(ctx context.Context, accountRoleId string) (internal.AccountRoles, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.AcountRole")
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
@@ -49,7 +87,21 @@ func (s *Store) AccountRole(ctx context.Context, accountRoleId string) (internal
 		if err != nil {
 			return handleError(err, "get account role", internal.ErrorCodeUnknown, "accounr role not found")
 		}
+		rl, err := tenantRole(ctx, q, ar.RoleID, "account role not found")
+		if err != nil {
+			return err
+		}
 		accountrole.Id = ar.ID.String()
+		accountrole.Scope = internal.Scope{
+			ResourceType: ar.ResourceType,
+			ResourceId:   ar.ResourceID,
+		}
+		if ar.ValidFrom.Valid {
+			accountrole.Validity.From = ar.ValidFrom.Time
+		}
+		if ar.ValidUntil.Valid {
+			accountrole.Validity.Until = ar.ValidUntil.Time
+		}
 		accountrole.CreatedAt = ar.CreatedAt
 
 		acc, err := q.SelectAccountsById(ctx, ar.AccountID)
@@ -79,13 +131,10 @@ func (s *Store) AccountRole(ctx context.Context, accountRoleId string) (internal
 		}
 		accountrole.Account = account
 
-		rl, err := q.SelectRole(ctx, ar.RoleID)
-		if err != nil {
-			return handleError(err, "get role", internal.ErrorCodeUnknown, "role not found")
-		}
 		role := internal.Roles{
 			Id:        rl.ID.String(),
 			Role:      rl.Role,
+			TenantId:  rl.TenantID.String(),
 			CreatedAt: rl.CreatedAt,
 		}
 		accountrole.Role = role
@@ -106,15 +155,27 @@ func (s *Store) UpdateAccountRole(ctx context.Context, accountId string, roleId
 		if err != nil {
 			return handleError(err, "parse role id", internal.ErrorCodeInvalidArgument, "")
 		}
+		arid, err := uuid.Parse(id)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		role, err := tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		err = q.LockAccountRoles(ctx, acid)
+		if err != nil {
+			return handleError(err, "lock account roles", internal.ErrorCodeUnknown, "")
+		}
 		err = q.UpdateAccountRole(ctx, UpdateAccountRoleParams{
 			AccountID: acid,
 			RoleID:    rid,
-			ID:        acid,
+			ID:        arid,
 		})
 		if err != nil {
 			return handleError(err, "update account role", internal.ErrorCodeUnknown, "")
 		}
-		return nil
+		return checkExclusiveRoles(ctx, q, acid, role)
 	})
 	return err
 }
@@ -128,6 +189,14 @@ func (s *Store) DeleteAccountRole(ctx context.Context, id string) error {
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		ar, err := q.SelectAccountRole(ctx, arid)
+		if err != nil {
+			return handleError(err, "get account role", internal.ErrorCodeUnknown, "account role not found")
+		}
+		_, err = tenantRole(ctx, q, ar.RoleID, "account role not found")
+		if err != nil {
+			return err
+		}
 		err = q.DeleteAccountRole(ctx, arid)
 		if err != nil {
 			return handleError(err, "delete accountrole", internal.ErrorCodeUnknown, "")
@@ -136,3 +205,43 @@ func (s *Store) DeleteAccountRole(ctx context.Context, id string) error {
 	})
 	return err
 }
+
+// AccountRolesByAccount returns the account roles assigned to the account in the tenant of the request.
+func (s *Store) AccountRolesByAccount(ctx context.Context, username string) ([]internal.AccountRoles, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.AccountRolesByAccount")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var ids []string
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		ars, err := q.SelectAccountRolesByAccount(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "get account roles", internal.ErrorCodeUnknown, "")
+		}
+		for _, ar := range ars {
+			r, err := q.SelectRole(ctx, ar.RoleID)
+			if err != nil {
+				return handleError(err, "get role", internal.ErrorCodeUnknown, "role not found")
+			}
+			if inTenant(ctx, r.TenantID) {
+				ids = append(ids, ar.ID.String())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	accountRoles := make([]internal.AccountRoles, 0, len(ids))
+	for _, id := range ids {
+		ar, err := s.AccountRole(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		accountRoles = append(accountRoles, ar)
+	}
+	return accountRoles, nil
+}
@@ -0,0 +1,49 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DeleteExpiredAccountRoles deletes the account roles whose validity ended at now and returns their ids.
+func (s *Store) DeleteExpiredAccountRoles(ctx context.Context, now time.Time) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.DeleteExpired")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var ids []string
+	err := s.execTx(ctx, func(q *Queries) error {
+		deleted, err := q.DeleteExpiredAccountRoles(ctx, now)
+		if err != nil {
+			return handleError(err, "delete expired account roles", internal.ErrorCodeUnknown, "")
+		}
+		for _, id := range deleted {
+			ids = append(ids, id.String())
+		}
+		return nil
+	})
+	return ids, err
+}
+
+// CountStartedAccountRoles returns how many account roles became valid after since and until now.
+func (s *Store) CountStartedAccountRoles(ctx context.Context, since time.Time, now time.Time) (int64, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.CountStarted")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var count int64
+	err := s.execTx(ctx, func(q *Queries) error {
+		var err error
+		count, err = q.CountStartedAccountRoles(ctx, CountStartedAccountRolesParams{
+			Since: since,
+			Now:   now,
+		})
+		if err != nil {
+			return handleError(err, "count started account roles", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return count, err
+}
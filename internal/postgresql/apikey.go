@@ -0,0 +1,157 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"rbac/internal"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (s *Store) CreateAPIKey(ctx context.Context, username string, name string, prefix string, keyHash string, expiresAt time.Time) (internal.APIKey, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "APIKey.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	apiKey := internal.APIKey{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		id, err := q.InsertApiKey(ctx, InsertApiKeyParams{
+			AccountID: acc.ID,
+			Name:      name,
+			Prefix:    prefix,
+			KeyHash:   keyHash,
+			ExpiresAt: sql.NullTime{Time: expiresAt, Valid: !expiresAt.IsZero()},
+		})
+		if err != nil {
+			return handleError(err, "create api key", internal.ErrorCodeUnknown, "")
+		}
+		apiKey.Id = id.String()
+		apiKey.AccountId = acc.ID.String()
+		apiKey.Username = acc.Username
+		apiKey.Name = name
+		apiKey.Prefix = prefix
+		apiKey.ExpiresAt = expiresAt
+		apiKey.CreatedAt = time.Now()
+		return nil
+	})
+	return apiKey, err
+}
+
+func (s *Store) APIKeyByHash(ctx context.Context, keyHash string) (internal.APIKey, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "APIKey.APIKeyByHash")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	apiKey := internal.APIKey{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		k, err := q.SelectApiKeyByHash(ctx, keyHash)
+		if err != nil {
+			return handleError(err, "get api key", internal.ErrorCodeUnknown, "api key not found")
+		}
+		apiKey.Id = k.ID.String()
+		apiKey.AccountId = k.AccountID.String()
+		apiKey.Username = k.Username
+		apiKey.Name = k.Name
+		apiKey.Prefix = k.Prefix
+		if k.ExpiresAt.Valid {
+			apiKey.ExpiresAt = k.ExpiresAt.Time
+		}
+		if k.LastUsedAt.Valid {
+			apiKey.LastUsedAt = k.LastUsedAt.Time
+		}
+		if k.RevokedAt.Valid {
+			apiKey.RevokedAt = k.RevokedAt.Time
+		}
+		apiKey.CreatedAt = k.CreatedAt
+		return nil
+	})
+	return apiKey, err
+}
+
+func (s *Store) APIKeysByAccount(ctx context.Context, username string) ([]internal.APIKey, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "APIKey.APIKeysByAccount")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var apiKeys []internal.APIKey
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		keys, err := q.SelectApiKeysByAccount(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "get api keys", internal.ErrorCodeUnknown, "")
+		}
+		apiKeys = make([]internal.APIKey, len(keys))
+		for i, k := range keys {
+			apiKeys[i].Id = k.ID.String()
+			apiKeys[i].AccountId = k.AccountID.String()
+			apiKeys[i].Username = acc.Username
+			apiKeys[i].Name = k.Name
+			apiKeys[i].Prefix = k.Prefix
+			if k.ExpiresAt.Valid {
+				apiKeys[i].ExpiresAt = k.ExpiresAt.Time
+			}
+			if k.LastUsedAt.Valid {
+				apiKeys[i].LastUsedAt = k.LastUsedAt.Time
+			}
+			if k.RevokedAt.Valid {
+				apiKeys[i].RevokedAt = k.RevokedAt.Time
+			}
+			apiKeys[i].CreatedAt = k.CreatedAt
+		}
+		return nil
+	})
+	return apiKeys, err
+}
+
+func (s *Store) TouchAPIKey(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "APIKey.Touch")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		kid, err := uuid.Parse(id)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		err = q.TouchApiKey(ctx, kid)
+		if err != nil {
+			return handleError(err, "touch api key", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
+
+func (s *Store) RevokeAPIKey(ctx context.Context, username string, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "APIKey.Revoke")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		kid, err := uuid.Parse(id)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		n, err := q.RevokeApiKey(ctx, RevokeApiKeyParams{
+			ID:        kid,
+			AccountID: acc.ID,
+		})
+		if err != nil {
+			return handleError(err, "revoke api key", internal.ErrorCodeUnknown, "")
+		}
+		if n == 0 {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "api key not found")
+		}
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,205 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SetEmergencyRole sets whether accounts allowed to break glass can self-elevate to the role.
+func (s *Store) SetEmergencyRole(ctx context.Context, roleId string, emergency bool) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.SetEmergencyRole")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		_, err = tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		if emergency {
+			err = q.InsertEmergencyRole(ctx, rid)
+		} else {
+			err = q.DeleteEmergencyRole(ctx, rid)
+		}
+		if err != nil {
+			return handleError(err, "set emergency role", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
+
+// IsEmergencyRole returns true when the role can be self-elevated to by breaking glass.
+func (s *Store) IsEmergencyRole(ctx context.Context, roleId string) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.IsEmergencyRole")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var emergency bool
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		_, err = tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		emergency, err = q.SelectEmergencyRole(ctx, rid)
+		if err != nil {
+			return handleError(err, "get emergency role", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return emergency, err
+}
+
+// EmergencyRoles returns the emergency roles of the tenant of the request.
+func (s *Store) EmergencyRoles(ctx context.Context) ([]internal.Roles, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.EmergencyRoles")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	roles := []internal.Roles{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectEmergencyRoles(ctx, tid)
+		if err != nil {
+			return handleError(err, "get emergency roles", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			roles = append(roles, internal.Roles{
+				Id:        value.ID.String(),
+				Role:      value.Role,
+				TenantId:  tid.String(),
+				CreatedAt: value.CreatedAt,
+			})
+		}
+		return nil
+	})
+	return roles, err
+}
+
+// CreateBreakGlassActivation grants the emergency role to the account until expiresAt and records the
+// activation in a single transaction. It returns the ids of the activation and of the account role.
+func (s *Store) CreateBreakGlassActivation(ctx context.Context, username string, roleId string, reason string, expiresAt time.Time) (string, string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.CreateActivation")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var id, arid string
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse role id", internal.ErrorCodeInvalidArgument, "")
+		}
+		_, err = tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		emergency, err := q.SelectEmergencyRole(ctx, rid)
+		if err != nil {
+			return handleError(err, "get emergency role", internal.ErrorCodeUnknown, "")
+		}
+		if !emergency {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the role is not an emergency role")
+		}
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		arID, err := assignRole(ctx, q, acc.ID, rid, internal.Scope{}, internal.Validity{Until: expiresAt})
+		if err != nil {
+			return err
+		}
+		aid, err := q.InsertBreakGlassActivation(ctx, InsertBreakGlassActivationParams{
+			AccountID: acc.ID,
+			RoleID:    rid,
+			Reason:    reason,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return handleError(err, "create break glass activation", internal.ErrorCodeUnknown, "")
+		}
+		id = aid.String()
+		arid = arID.String()
+		return nil
+	})
+	return id, arid, err
+}
+
+func (s *Store) BreakGlassActivation(ctx context.Context, id string) (internal.BreakGlassActivation, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.Activation")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res := internal.BreakGlassActivation{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		aid, err := uuid.Parse(id)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		a, err := q.SelectBreakGlassActivation(ctx, aid)
+		if err != nil {
+			return handleError(err, "get break glass activation", internal.ErrorCodeUnknown, "break glass activation not found")
+		}
+		if !inTenant(ctx, a.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "break glass activation not found")
+		}
+		res = convertBreakGlassActivation(a)
+		return nil
+	})
+	return res, err
+}
+
+// BreakGlassActivations returns the activations of emergency roles of the tenant of the request made since
+// the time, newest first.
+func (s *Store) BreakGlassActivations(ctx context.Context, since time.Time) ([]internal.BreakGlassActivation, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.Activations")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res := []internal.BreakGlassActivation{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectBreakGlassActivations(ctx, SelectBreakGlassActivationsParams{
+			TenantID: tid,
+			Since:    since,
+		})
+		if err != nil {
+			return handleError(err, "get break glass activations", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			res = append(res, convertBreakGlassActivation(SelectBreakGlassActivationRow(value)))
+		}
+		return nil
+	})
+	return res, err
+}
+
+func convertBreakGlassActivation(a SelectBreakGlassActivationRow) internal.BreakGlassActivation {
+	return internal.BreakGlassActivation{
+		Id: a.ID.String(),
+		Account: internal.Account{
+			Id:       a.AccountID.String(),
+			UserName: a.Username,
+		},
+		Role: internal.Roles{
+			Id:       a.RoleID.String(),
+			Role:     a.Role,
+			TenantId: a.TenantID.String(),
+		},
+		Reason:    a.Reason,
+		ExpiresAt: a.ExpiresAt,
+		CreatedAt: a.CreatedAt,
+	}
+}
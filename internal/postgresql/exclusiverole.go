@@ -0,0 +1,262 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tenantExclusiveRoleSet returns the exclusive role set, sets of another tenant than the one of the request
+// are not found.
+func tenantExclusiveRoleSet(ctx context.Context, q *Queries, id string, notFoundMsg string) (ExclusiveRoleSets, error) {
+	sid, err := uuid.Parse(id)
+	if err != nil {
+		return ExclusiveRoleSets{}, handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+	}
+	s, err := q.SelectExclusiveRoleSet(ctx, sid)
+	if err != nil {
+		return ExclusiveRoleSets{}, handleError(err, "get exclusive role set", internal.ErrorCodeUnknown, notFoundMsg)
+	}
+	if !inTenant(ctx, s.TenantID) {
+		return ExclusiveRoleSets{}, internal.NewErrorf(internal.ErrorCodeNotFound, notFoundMsg)
+	}
+	return s, nil
+}
+
+// insertExclusiveRoles adds the roles to the set, they must belong to the tenant of the set.
+func insertExclusiveRoles(ctx context.Context, q *Queries, set ExclusiveRoleSets, roles []internal.Roles) error {
+	seen := map[uuid.UUID]bool{}
+	for _, value := range roles {
+		rid, err := uuid.Parse(value.Id)
+		if err != nil {
+			return handleError(err, "parse role id", internal.ErrorCodeInvalidArgument, "")
+		}
+		if seen[rid] {
+			continue
+		}
+		seen[rid] = true
+		role, err := tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		if role.TenantID != set.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "an exclusive role set can't hold a role of another tenant")
+		}
+		_, err = q.InsertExclusiveRoleSetRole(ctx, InsertExclusiveRoleSetRoleParams{
+			SetID:  set.ID,
+			RoleID: rid,
+		})
+		if err != nil {
+			return handleError(err, "add exclusive role", internal.ErrorCodeUnknown, "")
+		}
+	}
+	return nil
+}
+
+// checkExclusiveRoles fails with an invalid argument error when the account holds the role along with another
+// role of one of its exclusive role sets. Callers lock the account first so concurrent assignments are
+// checked one after the other.
+func checkExclusiveRoles(ctx context.Context, q *Queries, accountId uuid.UUID, role Roles) error {
+	rows, err := q.SelectAccountExclusiveRoles(ctx, SelectAccountExclusiveRolesParams{
+		AccountID: accountId,
+		TenantID:  role.TenantID,
+	})
+	if err != nil {
+		return handleError(err, "get account exclusive roles", internal.ErrorCodeUnknown, "")
+	}
+	held := make([]internal.RoleConflict, 0, len(rows))
+	for _, value := range rows {
+		held = append(held, internal.RoleConflict{
+			Set:   internal.ExclusiveRoleSet{Id: value.SetID.String(), Name: value.Name},
+			Roles: []internal.Roles{{Id: value.RoleID.String(), Role: value.Role}},
+		})
+	}
+	for _, conflict := range internal.MergeRoleConflicts(held) {
+		var names []string
+		involved := false
+		for _, value := range conflict.Roles {
+			names = append(names, value.Role)
+			involved = involved || value.Id == role.ID.String()
+		}
+		// conflicts not involving the role come from groups and are left to the conflict report
+		if involved {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the account would hold the exclusive roles %s of %s", strings.Join(names, ", "), conflict.Set.Name)
+		}
+	}
+	return nil
+}
+
+func (s *Store) CreateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var id string
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		sid, err := q.InsertExclusiveRoleSet(ctx, InsertExclusiveRoleSetParams{
+			TenantID: tid,
+			Name:     set.Name,
+		})
+		if err != nil {
+			return handleError(err, "create exclusive role set", internal.ErrorCodeUnknown, "")
+		}
+		err = insertExclusiveRoles(ctx, q, ExclusiveRoleSets{ID: sid, TenantID: tid}, set.Roles)
+		if err != nil {
+			return err
+		}
+		id = sid.String()
+		return nil
+	})
+	return id, err
+}
+
+func (s *Store) ExclusiveRoleSet(ctx context.Context, id string) (internal.ExclusiveRoleSet, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.ExclusiveRoleSet")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	set := internal.ExclusiveRoleSet{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		es, err := tenantExclusiveRoleSet(ctx, q, id, "exclusive role set not found")
+		if err != nil {
+			return err
+		}
+		set, err = convertExclusiveRoleSet(ctx, q, es)
+		return err
+	})
+	return set, err
+}
+
+// ExclusiveRoleSets returns the exclusive role sets of the tenant of the request.
+func (s *Store) ExclusiveRoleSets(ctx context.Context) ([]internal.ExclusiveRoleSet, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.ExclusiveRoleSets")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	sets := []internal.ExclusiveRoleSet{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectExclusiveRoleSets(ctx, tid)
+		if err != nil {
+			return handleError(err, "get exclusive role sets", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			set, err := convertExclusiveRoleSet(ctx, q, value)
+			if err != nil {
+				return err
+			}
+			sets = append(sets, set)
+		}
+		return nil
+	})
+	return sets, err
+}
+
+// UpdateExclusiveRoleSet renames the set and replaces its roles.
+func (s *Store) UpdateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.Update")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		es, err := tenantExclusiveRoleSet(ctx, q, set.Id, "exclusive role set not found")
+		if err != nil {
+			return err
+		}
+		err = q.UpdateExclusiveRoleSet(ctx, UpdateExclusiveRoleSetParams{
+			Name: set.Name,
+			ID:   es.ID,
+		})
+		if err != nil {
+			return handleError(err, "update exclusive role set", internal.ErrorCodeUnknown, "")
+		}
+		err = q.DeleteExclusiveRoleSetRolesBySet(ctx, es.ID)
+		if err != nil {
+			return handleError(err, "delete exclusive roles by set", internal.ErrorCodeUnknown, "")
+		}
+		return insertExclusiveRoles(ctx, q, es, set.Roles)
+	})
+	return err
+}
+
+func (s *Store) DeleteExclusiveRoleSet(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		es, err := tenantExclusiveRoleSet(ctx, q, id, "exclusive role set not found")
+		if err != nil {
+			return err
+		}
+		err = q.DeleteExclusiveRoleSetRolesBySet(ctx, es.ID)
+		if err != nil {
+			return handleError(err, "delete exclusive roles by set", internal.ErrorCodeUnknown, "")
+		}
+		err = q.DeleteExclusiveRoleSet(ctx, es.ID)
+		if err != nil {
+			return handleError(err, "delete exclusive role set", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
+
+// RoleConflicts returns the accounts of the tenant of the request holding more than one role of an
+// exclusive role set, such as through groups or sets created after the roles were assigned.
+func (s *Store) RoleConflicts(ctx context.Context) ([]internal.RoleConflict, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.RoleConflicts")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var conflicts []internal.RoleConflict
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectExclusiveRoles(ctx, tid)
+		if err != nil {
+			return handleError(err, "get exclusive roles", internal.ErrorCodeUnknown, "")
+		}
+		held := make([]internal.RoleConflict, 0, len(rows))
+		for _, value := range rows {
+			held = append(held, internal.RoleConflict{
+				Username: value.Username,
+				Set:      internal.ExclusiveRoleSet{Id: value.SetID.String(), Name: value.Name, TenantId: tid.String()},
+				Roles:    []internal.Roles{{Id: value.RoleID.String(), Role: value.Role}},
+			})
+		}
+		conflicts = internal.MergeRoleConflicts(held)
+		return nil
+	})
+	return conflicts, err
+}
+
+func convertExclusiveRoleSet(ctx context.Context, q *Queries, s ExclusiveRoleSets) (internal.ExclusiveRoleSet, error) {
+	rows, err := q.SelectExclusiveRoleSetRoles(ctx, s.ID)
+	if err != nil {
+		return internal.ExclusiveRoleSet{}, handleError(err, "get exclusive roles", internal.ErrorCodeUnknown, "")
+	}
+	roles := make([]internal.Roles, 0, len(rows))
+	for _, value := range rows {
+		roles = append(roles, internal.Roles{
+			Id:        value.ID.String(),
+			Role:      value.Role,
+			TenantId:  s.TenantID.String(),
+			CreatedAt: value.CreatedAt,
+		})
+	}
+	return internal.ExclusiveRoleSet{
+		Id:        s.ID.String(),
+		Name:      s.Name,
+		TenantId:  s.TenantID.String(),
+		Roles:     roles,
+		CreatedAt: s.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RestrictedGrants returns the ways the tasks are granted or denied to the account through scoped account
+// roles, scoped role tasks or role tasks with a condition, roles held through groups and inherited roles
+// included. Only the roles of the tenant of the request are considered.
+func (s *Store) RestrictedGrants(ctx context.Context, username string, tasks []string) ([]internal.RestrictedGrant, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Grant.RestrictedGrants")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var grants []internal.RestrictedGrant
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectRestrictedGrants(ctx, SelectRestrictedGrantsParams{
+			Username: username,
+			TenantID: tid,
+			Tasks:    tasks,
+		})
+		if err != nil {
+			return handleError(err, "get restricted grants", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			grants = append(grants, internal.RestrictedGrant{
+				Task:   value.Task,
+				RoleId: value.RoleID.String(),
+				RoleScope: internal.Scope{
+					ResourceType: value.RoleResourceType,
+					ResourceId:   value.RoleResourceID,
+				},
+				TaskScope: internal.Scope{
+					ResourceType: value.TaskResourceType,
+					ResourceId:   value.TaskResourceID,
+				},
+				Condition: value.Condition,
+				Effect:    value.Effect,
+			})
+		}
+		return nil
+	})
+	return grants, err
+}
+
+// TaskHolders returns the accounts granted the task without scope nor condition along with the roles granting
+// it to them, the accounts hold the roles directly, through groups or through role inheritance. Accounts
+// holding a role denying the task and blocked accounts are left out.
+func (s *Store) TaskHolders(ctx context.Context, taskId string) (internal.TaskHolders, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Grant.TaskHolders")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	holders := internal.TaskHolders{Accounts: []internal.TaskHolder{}}
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := uuid.Parse(taskId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		t, err := tenantTask(ctx, q, tid, "task not found")
+		if err != nil {
+			return err
+		}
+		holders.Task = internal.Tasks{
+			Id:        t.ID.String(),
+			Task:      t.Task,
+			TenantId:  t.TenantID.String(),
+			CreatedAt: t.CreatedAt,
+		}
+		rows, err := q.SelectTaskHolders(ctx, tid)
+		if err != nil {
+			return handleError(err, "get task holders", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			// rows are ordered by username, the roles of an account follow each other
+			if n := len(holders.Accounts); n == 0 || holders.Accounts[n-1].Username != value.Username {
+				holders.Accounts = append(holders.Accounts, internal.TaskHolder{Username: value.Username})
+			}
+			n := len(holders.Accounts)
+			holders.Accounts[n-1].Roles = append(holders.Accounts[n-1].Roles, internal.Roles{
+				Id:        value.ID.String(),
+				Role:      value.Role,
+				TenantId:  value.TenantID.String(),
+				CreatedAt: value.CreatedAt,
+			})
+		}
+		return nil
+	})
+	return holders, err
+}
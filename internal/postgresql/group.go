@@ -0,0 +1,480 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tenantGroup returns the group, groups of another tenant than the one of the request are not found.
+func tenantGroup(ctx context.Context, q *Queries, id string, notFoundMsg string) (Groups, error) {
+	gid, err := uuid.Parse(id)
+	if err != nil {
+		return Groups{}, handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+	}
+	g, err := q.SelectGroup(ctx, gid)
+	if err != nil {
+		return Groups{}, handleError(err, "get group", internal.ErrorCodeUnknown, notFoundMsg)
+	}
+	if !inTenant(ctx, g.TenantID) {
+		return Groups{}, internal.NewErrorf(internal.ErrorCodeNotFound, notFoundMsg)
+	}
+	return g, nil
+}
+
+func (s *Store) CreateGroup(ctx context.Context, name string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var id string
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		gid, err := q.InsertGroup(ctx, InsertGroupParams{
+			TenantID: tid,
+			Name:     name,
+		})
+		if err != nil {
+			return handleError(err, "create group", internal.ErrorCodeUnknown, "")
+		}
+		id = gid.String()
+		return nil
+	})
+	return id, err
+}
+
+func (s *Store) Group(ctx context.Context, id string) (internal.Group, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Group")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	group := internal.Group{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, id, "group not found")
+		if err != nil {
+			return err
+		}
+		group = convertGroup(g)
+		return nil
+	})
+	return group, err
+}
+
+// Groups returns the groups of the tenant of the request.
+func (s *Store) Groups(ctx context.Context) ([]internal.Group, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Groups")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	groups := []internal.Group{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectGroups(ctx, tid)
+		if err != nil {
+			return handleError(err, "get groups", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			groups = append(groups, convertGroup(value))
+		}
+		return nil
+	})
+	return groups, err
+}
+
+func (s *Store) UpdateGroup(ctx context.Context, id string, name string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Update")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, id, "group not found")
+		if err != nil {
+			return err
+		}
+		err = q.UpdateGroup(ctx, UpdateGroupParams{
+			Name: name,
+			ID:   g.ID,
+		})
+		if err != nil {
+			return handleError(err, "update group", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
+
+// DeleteGroup deletes the group along with its members, nesting and roles, the ids of the deleted
+// memberships are returned.
+func (s *Store) DeleteGroup(ctx context.Context, id string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var ids []string
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, id, "group not found")
+		if err != nil {
+			return err
+		}
+		rows, err := q.DeleteGroupMembersByGroup(ctx, g.ID)
+		if err != nil {
+			return handleError(err, "delete group members by group", internal.ErrorCodeUnknown, "")
+		}
+		err = q.DeleteGroupNestingByGroup(ctx, g.ID)
+		if err != nil {
+			return handleError(err, "delete group nesting by group", internal.ErrorCodeUnknown, "")
+		}
+		err = q.DeleteGroupRolesByGroup(ctx, g.ID)
+		if err != nil {
+			return handleError(err, "delete group roles by group", internal.ErrorCodeUnknown, "")
+		}
+		err = q.DeleteGroup(ctx, g.ID)
+		if err != nil {
+			return handleError(err, "delete group", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			ids = append(ids, value.String())
+		}
+		return nil
+	})
+	return ids, err
+}
+
+// AddGroupMember adds the account to the group, the account must belong to the tenant of the group.
+func (s *Store) AddGroupMember(ctx context.Context, groupId string, username string) (internal.GroupMember, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.AddMember")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	member := internal.GroupMember{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, groupId, "group not found")
+		if err != nil {
+			return err
+		}
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		tenants, err := q.SelectAccountTenants(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "get account tenants", internal.ErrorCodeUnknown, "")
+		}
+		inGroupTenant := false
+		for _, value := range tenants {
+			inGroupTenant = inGroupTenant || value.ID == g.TenantID
+		}
+		if !inGroupTenant {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "account not found")
+		}
+		id, err := q.InsertGroupMember(ctx, InsertGroupMemberParams{
+			GroupID:   g.ID,
+			AccountID: acc.ID,
+		})
+		if err != nil {
+			return handleError(err, "add group member", internal.ErrorCodeUnknown, "")
+		}
+		member.Id = id.String()
+		member.Group = convertGroup(g)
+		member.Account = internal.Account{
+			Id:       acc.ID.String(),
+			UserName: acc.Username,
+		}
+		return nil
+	})
+	return member, err
+}
+
+// RemoveGroupMember removes the account from the group, the id of the deleted membership is returned.
+func (s *Store) RemoveGroupMember(ctx context.Context, groupId string, username string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.RemoveMember")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var id string
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, groupId, "group not found")
+		if err != nil {
+			return err
+		}
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		mid, err := q.DeleteGroupMember(ctx, DeleteGroupMemberParams{
+			GroupID:   g.ID,
+			AccountID: acc.ID,
+		})
+		if err != nil {
+			return handleError(err, "remove group member", internal.ErrorCodeUnknown, "group member not found")
+		}
+		id = mid.String()
+		return nil
+	})
+	return id, err
+}
+
+// GroupMembers returns the accounts directly member of the group.
+func (s *Store) GroupMembers(ctx context.Context, groupId string) ([]internal.GroupMember, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Members")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	members := []internal.GroupMember{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, groupId, "group not found")
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectGroupMembers(ctx, g.ID)
+		if err != nil {
+			return handleError(err, "get group members", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			members = append(members, internal.GroupMember{
+				Id:    value.ID.String(),
+				Group: convertGroup(g),
+				Account: internal.Account{
+					Id:       value.AccountID.String(),
+					UserName: value.Username,
+				},
+				CreatedAt: value.CreatedAt,
+			})
+		}
+		return nil
+	})
+	return members, err
+}
+
+// AddMemberGroup nests the member group in the group, its members become members of the group. Nesting
+// creating a cycle is refused.
+func (s *Store) AddMemberGroup(ctx context.Context, groupId string, memberGroupId string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.AddMemberGroup")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var id string
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, groupId, "group not found")
+		if err != nil {
+			return err
+		}
+		member, err := tenantGroup(ctx, q, memberGroupId, "member group not found")
+		if err != nil {
+			return err
+		}
+		if g.ID == member.ID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "a group can't contain itself")
+		}
+		if g.TenantID != member.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "a group can't contain a group of another tenant")
+		}
+		// concurrent nesting could close a cycle the check below doesn't see
+		err = lockGroupNesting(ctx, q)
+		if err != nil {
+			return handleError(err, "lock group nesting", internal.ErrorCodeUnknown, "")
+		}
+		parents, err := q.SelectParentGroupIds(ctx, g.ID)
+		if err != nil {
+			return handleError(err, "get parent groups", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range parents {
+			if value == member.ID {
+				return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "group nesting would create a cycle")
+			}
+		}
+		nid, err := q.InsertGroupNesting(ctx, InsertGroupNestingParams{
+			GroupID:       g.ID,
+			MemberGroupID: member.ID,
+		})
+		if err != nil {
+			return handleError(err, "add member group", internal.ErrorCodeUnknown, "")
+		}
+		id = nid.String()
+		return nil
+	})
+	return id, err
+}
+
+func (s *Store) RemoveMemberGroup(ctx context.Context, groupId string, memberGroupId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.RemoveMemberGroup")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, groupId, "group not found")
+		if err != nil {
+			return err
+		}
+		mid, err := uuid.Parse(memberGroupId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		n, err := q.DeleteGroupNesting(ctx, DeleteGroupNestingParams{
+			GroupID:       g.ID,
+			MemberGroupID: mid,
+		})
+		if err != nil {
+			return handleError(err, "remove member group", internal.ErrorCodeUnknown, "")
+		}
+		if n == 0 {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "member group not found")
+		}
+		return nil
+	})
+	return err
+}
+
+// MemberGroups returns the groups directly nested in the group.
+func (s *Store) MemberGroups(ctx context.Context, groupId string) ([]internal.Group, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.MemberGroups")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	groups := []internal.Group{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, groupId, "group not found")
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectMemberGroups(ctx, g.ID)
+		if err != nil {
+			return handleError(err, "get member groups", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			groups = append(groups, convertGroup(value))
+		}
+		return nil
+	})
+	return groups, err
+}
+
+// AddGroupRole assigns the role to the group, the role must belong to the tenant of the group.
+func (s *Store) AddGroupRole(ctx context.Context, groupId string, roleId string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.AddRole")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var id string
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, groupId, "group not found")
+		if err != nil {
+			return err
+		}
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		role, err := tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		if role.TenantID != g.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "a group can't be assigned a role of another tenant")
+		}
+		gid, err := q.InsertGroupRole(ctx, InsertGroupRoleParams{
+			GroupID: g.ID,
+			RoleID:  role.ID,
+		})
+		if err != nil {
+			return handleError(err, "add group role", internal.ErrorCodeUnknown, "")
+		}
+		id = gid.String()
+		return nil
+	})
+	return id, err
+}
+
+func (s *Store) RemoveGroupRole(ctx context.Context, groupId string, roleId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.RemoveRole")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, groupId, "group not found")
+		if err != nil {
+			return err
+		}
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		n, err := q.DeleteGroupRole(ctx, DeleteGroupRoleParams{
+			GroupID: g.ID,
+			RoleID:  rid,
+		})
+		if err != nil {
+			return handleError(err, "remove group role", internal.ErrorCodeUnknown, "")
+		}
+		if n == 0 {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "group role not found")
+		}
+		return nil
+	})
+	return err
+}
+
+// GroupRoles returns the roles directly assigned to the group.
+func (s *Store) GroupRoles(ctx context.Context, groupId string) ([]internal.Roles, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Roles")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	roles := []internal.Roles{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		g, err := tenantGroup(ctx, q, groupId, "group not found")
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectGroupRoles(ctx, g.ID)
+		if err != nil {
+			return handleError(err, "get group roles", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			roles = append(roles, internal.Roles{
+				Id:        value.ID.String(),
+				Role:      value.Role,
+				CreatedAt: value.CreatedAt,
+			})
+		}
+		return nil
+	})
+	return roles, err
+}
+
+// GroupRoleIDs returns the ids of the roles of the tenant of the request the account holds through the
+// groups it is a member of, directly or through nested groups. Blocked accounts hold none.
+func (s *Store) GroupRoleIDs(ctx context.Context, username string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.RoleIDs")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var ids []string
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectGroupRoleIdsByAccount(ctx, SelectGroupRoleIdsByAccountParams{
+			Username: username,
+			TenantID: tid,
+		})
+		if err != nil {
+			return handleError(err, "get group roles", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			ids = append(ids, value.String())
+		}
+		return nil
+	})
+	return ids, err
+}
+
+func convertGroup(g Groups) internal.Group {
+	return internal.Group{
+		Id:        g.ID.String(),
+		Name:      g.Name,
+		TenantId:  g.TenantID.String(),
+		CreatedAt: g.CreatedAt,
+	}
+}
+
+// lockGroupNesting serializes the writers of group_nesting, sqlc doesn't parse LOCK statements.
+func lockGroupNesting(ctx context.Context, q *Queries) error {
+	_, err := q.db.ExecContext(ctx, "LOCK TABLE group_nesting IN SHARE ROW EXCLUSIVE MODE")
+	return err
+}
@@ -19,9 +19,14 @@ func (s *Store) CreateHelpText(ctx context.Context, helptext internal.HelpText)
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		t, err := tenantTask(ctx, q, tid, "task not found")
+		if err != nil {
+			return err
+		}
 		id, err := q.InsertHelpText(ctx, InsertHelpTextParams{
 			Helptext: helptext.HelpText,
 			TaskID:   tid,
+			TenantID: t.TenantID,
 		})
 		if err != nil {
 			return handleError(err, "create help text", internal.ErrorCodeUnknown, "")
@@ -45,9 +50,13 @@ func (s *Store) HelpText(ctx context.Context, id string) (internal.HelpText, err
 		if err != nil {
 			return handleError(err, "get helptext", internal.ErrorCodeUnknown, "helptext not found")
 		}
+		if !inTenant(ctx, ht.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "helptext not found")
+		}
 		helptext.Id = ht.ID.String()
 		helptext.HelpText = ht.Helptext
 		helptext.Task_id = ht.TaskID.String()
+		helptext.TenantId = ht.TenantID.String()
 		helptext.CreatedAt = ht.CreatedAt
 		return nil
 	})
@@ -66,6 +75,20 @@ func (s *Store) UpdateHelpText(ctx context.Context, helptext internal.HelpText)
 		if err != nil {
 			return handleError(err, "parse helptext id", internal.ErrorCodeUnknown, "")
 		}
+		current, err := q.SelectHelpText(ctx, id)
+		if err != nil {
+			return handleError(err, "get helptext", internal.ErrorCodeUnknown, "helptext not found")
+		}
+		if !inTenant(ctx, current.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "helptext not found")
+		}
+		t, err := tenantTask(ctx, q, tid, "task not found")
+		if err != nil {
+			return err
+		}
+		if t.TenantID != current.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "task belongs to another tenant")
+		}
 		err = q.UpdateHelpText(ctx, UpdateHelpTextParams{
 			TaskID:   tid,
 			Helptext: helptext.HelpText,
@@ -87,6 +110,13 @@ func (s *Store) DeleteHelpText(ctx context.Context, id string) error {
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		current, err := q.SelectHelpText(ctx, hid)
+		if err != nil {
+			return handleError(err, "get helptext", internal.ErrorCodeUnknown, "helptext not found")
+		}
+		if !inTenant(ctx, current.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "helptext not found")
+		}
 		err = q.DeleteHelpText(ctx, hid)
 		if err != nil {
 			return handleError(err, "delete helptext", internal.ErrorCodeUnknown, "")
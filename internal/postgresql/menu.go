@@ -19,9 +19,14 @@ func (s *Store) CreateMenu(ctx context.Context, menu internal.Menu) (string, err
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		t, err := tenantTask(ctx, q, htId, "task not found")
+		if err != nil {
+			return err
+		}
 		id, err := q.InsertMenu(ctx, InsertMenuParams{
-			Name:   menu.Name,
-			TaskID: htId,
+			Name:     menu.Name,
+			TaskID:   htId,
+			TenantID: t.TenantID,
 		})
 		if err != nil {
 			return handleError(err, "create menu", internal.ErrorCodeUnknown, "")
@@ -45,9 +50,13 @@ func (s *Store) Menu(ctx context.Context, id string) (internal.Menu, error) {
 		if err != nil {
 			return handleError(err, "get menu", internal.ErrorCodeUnknown, "menu not found")
 		}
+		if !inTenant(ctx, ht.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "menu not found")
+		}
 		menu.Id = ht.ID.String()
 		menu.Name = ht.Name
 		menu.Task_id = ht.TaskID.String()
+		menu.TenantId = ht.TenantID.String()
 		menu.CreatedAt = ht.CreatedAt
 		return nil
 	})
@@ -66,6 +75,20 @@ func (s *Store) UpdateMenu(ctx context.Context, menu internal.Menu) error {
 		if err != nil {
 			return handleError(err, "parse menu id", internal.ErrorCodeInvalidArgument, "")
 		}
+		current, err := q.SelectMenu(ctx, id)
+		if err != nil {
+			return handleError(err, "get menu", internal.ErrorCodeUnknown, "menu not found")
+		}
+		if !inTenant(ctx, current.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "menu not found")
+		}
+		t, err := tenantTask(ctx, q, tid, "task not found")
+		if err != nil {
+			return err
+		}
+		if t.TenantID != current.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "task belongs to another tenant")
+		}
 		err = q.UpdateMenu(ctx, UpdateMenuParams{
 			TaskID: tid,
 			Name:   menu.Name,
@@ -87,6 +110,13 @@ func (s *Store) DeleteMenu(ctx context.Context, id string) error {
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		current, err := q.SelectMenu(ctx, hid)
+		if err != nil {
+			return handleError(err, "get menu", internal.ErrorCodeUnknown, "menu not found")
+		}
+		if !inTenant(ctx, current.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "menu not found")
+		}
 		err = q.DeleteMenu(ctx, hid)
 		if err != nil {
 			return handleError(err, "delete menu", internal.ErrorCodeInvalidArgument, "")
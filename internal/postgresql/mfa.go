@@ -0,0 +1,217 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (s *Store) MFA(ctx context.Context, username string) (internal.MFA, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.MFA")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	mfa := internal.MFA{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		am, err := q.SelectAccountMfa(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "get mfa", internal.ErrorCodeUnknown, "mfa not found")
+		}
+		mfa.AccountId = am.AccountID.String()
+		mfa.Secret = am.Secret
+		mfa.LastUsedStep = am.LastUsedStep
+		if am.ConfirmedAt.Valid {
+			mfa.ConfirmedAt = am.ConfirmedAt.Time
+		}
+		mfa.CreatedAt = am.CreatedAt
+		return nil
+	})
+	return mfa, err
+}
+
+// EnrollMFA stores a new unconfirmed secret for the account, replacing any previous enrolment.
+func (s *Store) EnrollMFA(ctx context.Context, username string, secret string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.Enroll")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		err = q.UpsertAccountMfa(ctx, UpsertAccountMfaParams{
+			AccountID: acc.ID,
+			Secret:    secret,
+		})
+		if err != nil {
+			return handleError(err, "enroll mfa", internal.ErrorCodeUnknown, "")
+		}
+		err = q.DeleteMfaRecoveryCodes(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "delete recovery codes", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
+
+// ConfirmMFA enables the enrolment of the account and replaces its recovery codes.
+func (s *Store) ConfirmMFA(ctx context.Context, username string, step int64, recoveryCodeHashes []string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.Confirm")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		err = q.ConfirmAccountMfa(ctx, ConfirmAccountMfaParams{
+			LastUsedStep: step,
+			AccountID:    acc.ID,
+		})
+		if err != nil {
+			return handleError(err, "confirm mfa", internal.ErrorCodeUnknown, "")
+		}
+		err = q.DeleteMfaRecoveryCodes(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "delete recovery codes", internal.ErrorCodeUnknown, "")
+		}
+		for _, hash := range recoveryCodeHashes {
+			err = q.InsertMfaRecoveryCode(ctx, InsertMfaRecoveryCodeParams{
+				AccountID: acc.ID,
+				CodeHash:  hash,
+			})
+			if err != nil {
+				return handleError(err, "create recovery code", internal.ErrorCodeUnknown, "")
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// UseMFAStep records the time step of a verified code, it fails with ErrorCodeUnauthorized when a code of
+// that step or a later one was already used.
+func (s *Store) UseMFAStep(ctx context.Context, username string, step int64) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.UseStep")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		rows, err := q.UseAccountMfaStep(ctx, UseAccountMfaStepParams{
+			LastUsedStep: step,
+			AccountID:    acc.ID,
+		})
+		if err != nil {
+			return handleError(err, "use mfa step", internal.ErrorCodeUnknown, "")
+		}
+		if rows == 0 {
+			return internal.NewErrorf(internal.ErrorCodeUnauthorized, "mfa code already used")
+		}
+		return nil
+	})
+	return err
+}
+
+// UseMFARecoveryCode consumes the recovery code, it fails with ErrorCodeUnauthorized when the code is unknown
+// or was already used.
+func (s *Store) UseMFARecoveryCode(ctx context.Context, username string, codeHash string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.UseRecoveryCode")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		rows, err := q.UseMfaRecoveryCode(ctx, UseMfaRecoveryCodeParams{
+			AccountID: acc.ID,
+			CodeHash:  codeHash,
+		})
+		if err != nil {
+			return handleError(err, "use recovery code", internal.ErrorCodeUnknown, "")
+		}
+		if rows == 0 {
+			return internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid mfa code")
+		}
+		return nil
+	})
+	return err
+}
+
+func (s *Store) DeleteMFA(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		err = q.DeleteMfaRecoveryCodes(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "delete recovery codes", internal.ErrorCodeUnknown, "")
+		}
+		err = q.DeleteAccountMfa(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "delete mfa", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
+
+// IsMFARequired returns true when any role of the account requires MFA.
+func (s *Store) IsMFARequired(ctx context.Context, username string) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.IsRequired")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var required bool
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		required, err = q.SelectMfaRequired(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "get mfa required", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return required, err
+}
+
+func (s *Store) SetRoleMFARequired(ctx context.Context, roleId string, required bool) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.SetRoleRequired")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		_, err = tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		if required {
+			err = q.InsertRoleMfaPolicy(ctx, rid)
+		} else {
+			err = q.DeleteRoleMfaPolicy(ctx, rid)
+		}
+		if err != nil {
+			return handleError(err, "set role mfa policy", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
@@ -3,25 +3,127 @@
 package postgresql
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type AccessRequests struct {
+	ID            uuid.UUID
+	AccountID     uuid.UUID
+	RoleID        uuid.UUID
+	Justification string
+	Status        string
+	DecidedBy     uuid.UUID
+	Comment       string
+	ExpiresAt     time.Time
+	DecidedAt     sql.NullTime
+	CreatedAt     time.Time
+}
+
+type AccountMfa struct {
+	AccountID    uuid.UUID
+	Secret       string
+	LastUsedStep int64
+	ConfirmedAt  sql.NullTime
+	CreatedAt    time.Time
+}
+
 type AccountRoles struct {
+	ID           uuid.UUID
+	AccountID    uuid.UUID
+	RoleID       uuid.UUID
+	CreatedAt    time.Time
+	ResourceType string
+	ResourceID   string
+	ValidFrom    sql.NullTime
+	ValidUntil   sql.NullTime
+}
+
+type AccountTenants struct {
+	AccountID uuid.UUID
+	TenantID  uuid.UUID
+	IsActive  bool
+	CreatedAt time.Time
+}
+
+type Accounts struct {
+	ID               uuid.UUID
+	Username         string
+	Hashedpassword   string
+	Profile          uuid.UUID
+	IsBlocked        bool
+	CreatedAt        time.Time
+	IsServiceAccount bool
+}
+
+type ApiKeys struct {
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	Name       string
+	Prefix     string
+	KeyHash    string
+	ExpiresAt  sql.NullTime
+	LastUsedAt sql.NullTime
+	RevokedAt  sql.NullTime
+	CreatedAt  time.Time
+}
+
+type BreakGlassActivations struct {
 	ID        uuid.UUID
 	AccountID uuid.UUID
 	RoleID    uuid.UUID
+	Reason    string
+	ExpiresAt time.Time
 	CreatedAt time.Time
 }
 
-type Accounts struct {
-	ID             uuid.UUID
-	Username       string
-	Hashedpassword string
-	Profile        uuid.UUID
-	IsBlocked      bool
-	CreatedAt      time.Time
+type EmergencyRoles struct {
+	RoleID    uuid.UUID
+	CreatedAt time.Time
+}
+
+type ExclusiveRoleSetRoles struct {
+	ID        uuid.UUID
+	SetID     uuid.UUID
+	RoleID    uuid.UUID
+	CreatedAt time.Time
+}
+
+type ExclusiveRoleSets struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+type GroupMembers struct {
+	ID        uuid.UUID
+	GroupID   uuid.UUID
+	AccountID uuid.UUID
+	CreatedAt time.Time
+}
+
+type GroupNesting struct {
+	ID            uuid.UUID
+	GroupID       uuid.UUID
+	MemberGroupID uuid.UUID
+	CreatedAt     time.Time
+}
+
+type GroupRoles struct {
+	ID        uuid.UUID
+	GroupID   uuid.UUID
+	RoleID    uuid.UUID
+	CreatedAt time.Time
+}
+
+type Groups struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	Name      string
+	CreatedAt time.Time
 }
 
 type Helptext struct {
@@ -29,6 +131,7 @@ type Helptext struct {
 	TaskID    uuid.UUID
 	Helptext  string
 	CreatedAt time.Time
+	TenantID  uuid.UUID
 }
 
 type Menu struct {
@@ -36,6 +139,15 @@ type Menu struct {
 	Name      string
 	TaskID    uuid.UUID
 	CreatedAt time.Time
+	TenantID  uuid.UUID
+}
+
+type MfaRecoveryCodes struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	CodeHash  string
+	UsedAt    sql.NullTime
+	CreatedAt time.Time
 }
 
 type Navigation struct {
@@ -43,6 +155,16 @@ type Navigation struct {
 	Name      string
 	TaskID    uuid.UUID
 	CreatedAt time.Time
+	TenantID  uuid.UUID
+}
+
+type PasswordResetTokens struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+	CreatedAt time.Time
 }
 
 type Profiles struct {
@@ -56,21 +178,62 @@ type Profiles struct {
 	CreatedAt         time.Time
 }
 
-type RoleTasks struct {
+type RefreshTokens struct {
 	ID        uuid.UUID
-	TaskID    uuid.UUID
+	AccountID uuid.UUID
+	FamilyID  uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt sql.NullTime
+	CreatedAt time.Time
+}
+
+type RoleApprovers struct {
+	ID             uuid.UUID
+	RoleID         uuid.UUID
+	ApproverRoleID uuid.UUID
+	CreatedAt      time.Time
+}
+
+type RoleInheritance struct {
+	ID              uuid.UUID
+	RoleID          uuid.UUID
+	InheritedRoleID uuid.UUID
+	CreatedAt       time.Time
+}
+
+type RoleMfaPolicies struct {
 	RoleID    uuid.UUID
 	CreatedAt time.Time
 }
 
+type RoleTasks struct {
+	ID           uuid.UUID
+	TaskID       uuid.UUID
+	RoleID       uuid.UUID
+	CreatedAt    time.Time
+	ResourceType string
+	ResourceID   string
+	Condition    string
+	Effect       string
+}
+
 type Roles struct {
 	ID        uuid.UUID
 	Role      string
 	CreatedAt time.Time
+	TenantID  uuid.UUID
 }
 
 type Tasks struct {
 	ID        uuid.UUID
 	Task      string
 	CreatedAt time.Time
+	TenantID  uuid.UUID
+}
+
+type Tenants struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
 }
@@ -19,9 +19,14 @@ func (s *Store) CreateNavigation(ctx context.Context, menu internal.Navigation)
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		t, err := tenantTask(ctx, q, htId, "task not found")
+		if err != nil {
+			return err
+		}
 		id, err := q.InsertNavigation(ctx, InsertNavigationParams{
-			Name:   menu.Name,
-			TaskID: htId,
+			Name:     menu.Name,
+			TaskID:   htId,
+			TenantID: t.TenantID,
 		})
 		if err != nil {
 			return handleError(err, "create navigation", internal.ErrorCodeUnknown, "")
@@ -45,9 +50,13 @@ func (s *Store) Navigation(ctx context.Context, id string) (internal.Navigation,
 		if err != nil {
 			return handleError(err, "get navigation", internal.ErrorCodeUnknown, "navigation not found")
 		}
+		if !inTenant(ctx, ht.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "navigation not found")
+		}
 		menu.Id = ht.ID.String()
 		menu.Name = ht.Name
 		menu.Task_id = ht.TaskID.String()
+		menu.TenantId = ht.TenantID.String()
 		menu.CreatedAt = ht.CreatedAt
 		return nil
 	})
@@ -66,6 +75,20 @@ func (s *Store) UpdateNavigation(ctx context.Context, menu internal.Navigation)
 		if err != nil {
 			return handleError(err, "parse menu id", internal.ErrorCodeInvalidArgument, "")
 		}
+		current, err := q.SelectNavigation(ctx, id)
+		if err != nil {
+			return handleError(err, "get navigation", internal.ErrorCodeUnknown, "navigation not found")
+		}
+		if !inTenant(ctx, current.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "navigation not found")
+		}
+		t, err := tenantTask(ctx, q, tid, "task not found")
+		if err != nil {
+			return err
+		}
+		if t.TenantID != current.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "task belongs to another tenant")
+		}
 		err = q.UpdateNavigation(ctx, UpdateNavigationParams{
 			TaskID: tid,
 			Name:   menu.Name,
@@ -87,6 +110,13 @@ func (s *Store) DeleteNavigation(ctx context.Context, id string) error {
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		current, err := q.SelectNavigation(ctx, hid)
+		if err != nil {
+			return handleError(err, "get navigation", internal.ErrorCodeUnknown, "navigation not found")
+		}
+		if !inTenant(ctx, current.TenantID) {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "navigation not found")
+		}
 		err = q.DeleteNavigation(ctx, hid)
 		if err != nil {
 			return handleError(err, "delete navigation", internal.ErrorCodeUnknown, "")
@@ -0,0 +1,85 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"rbac/internal"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (s *Store) CreatePasswordResetToken(ctx context.Context, username string, tokenHash string, expiresAt time.Time) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PasswordReset.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var prid string
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		id, err := q.InsertPasswordResetToken(ctx, InsertPasswordResetTokenParams{
+			AccountID: acc.ID,
+			TokenHash: tokenHash,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return handleError(err, "create password reset token", internal.ErrorCodeUnknown, "")
+		}
+		prid = id.String()
+		return nil
+	})
+	return prid, err
+}
+
+// ResetPassword changes the password of the account the reset token belongs to and returns its username.
+// The token can only be used once, every other pending reset token of the account is invalidated as well.
+func (s *Store) ResetPassword(ctx context.Context, tokenHash string, password string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PasswordReset.ResetPassword")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var username string
+	err := s.execTx(ctx, func(q *Queries) error {
+		prt, err := q.SelectPasswordResetTokenByHash(ctx, tokenHash)
+		if err == sql.ErrNoRows {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnauthorized, "invalid password reset token")
+		}
+		if err != nil {
+			return handleError(err, "get password reset token", internal.ErrorCodeUnknown, "")
+		}
+		if prt.UsedAt.Valid || time.Now().After(prt.ExpiresAt) {
+			return internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid password reset token")
+		}
+		rows, err := q.UsePasswordResetToken(ctx, prt.ID)
+		if err != nil {
+			return handleError(err, "use password reset token", internal.ErrorCodeUnknown, "")
+		}
+		if rows == 0 {
+			return internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid password reset token")
+		}
+		acc, err := q.SelectAccountsById(ctx, prt.AccountID)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		hashedPassword, err := HashPassword(password)
+		if err != nil {
+			return handleError(err, "hash password", internal.ErrorCodeInvalidArgument, "")
+		}
+		err = q.ChangePassword(ctx, ChangePasswordParams{
+			Hashedpassword: hashedPassword,
+			Username:       acc.Username,
+		})
+		if err != nil {
+			return handleError(err, "change password", internal.ErrorCodeUnknown, "")
+		}
+		err = q.UsePasswordResetTokensByAccount(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "use password reset tokens", internal.ErrorCodeUnknown, "")
+		}
+		username = acc.Username
+		return nil
+	})
+	return username, err
+}
@@ -0,0 +1,299 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// policyIds holds the ids of the records of a loaded policy, by name.
+type policyIds struct {
+	tasks       map[string]uuid.UUID
+	roles       map[string]uuid.UUID
+	helpTexts   map[string]uuid.UUID
+	menus       map[[2]string]uuid.UUID
+	navigations map[[2]string]uuid.UUID
+	roleTasks   map[string]map[internal.PolicyGrant]uuid.UUID
+}
+
+// ExportPolicy returns the tasks and the roles of the tenant of the request as a policy.
+func (s *Store) ExportPolicy(ctx context.Context) (internal.Policy, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Policy.Export")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var policy internal.Policy
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		policy, _, err = loadPolicy(ctx, q, tid)
+		return err
+	})
+	return policy, err
+}
+
+// ApplyPolicy makes the tasks and the roles of the tenant of the request match the policy in a single
+// transaction and returns the changes made, with their ids. Only the tasks, roles and role tasks of the
+// tenant are pruned, tasks only in the default tenant. A dry run only returns the changes to make.
+func (s *Store) ApplyPolicy(ctx context.Context, policy internal.Policy, opts internal.PolicyOptions) ([]internal.PolicyChange, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Policy.Apply")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var changes []internal.PolicyChange
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		if opts.PruneTasks {
+			t, err := q.SelectTenantByName(ctx, internal.DEFAULT_TENANT)
+			if err != nil {
+				return handleError(err, "get default tenant", internal.ErrorCodeUnknown, "default tenant not found")
+			}
+			if t.ID != tid {
+				return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "tasks can only be pruned in the default tenant")
+			}
+		}
+		current, ids, err := loadPolicy(ctx, q, tid)
+		if err != nil {
+			return err
+		}
+		changes = internal.DiffPolicy(current, policy, opts)
+		if opts.DryRun {
+			return nil
+		}
+		for i := range changes {
+			id, err := applyPolicyChange(ctx, q, tid, ids, changes[i])
+			if err != nil {
+				return err
+			}
+			changes[i].Id = id.String()
+		}
+		return nil
+	})
+	return changes, err
+}
+
+// loadPolicy returns the tasks and the roles of the tenant as a policy.
+func loadPolicy(ctx context.Context, q *Queries, tenantId uuid.UUID) (internal.Policy, policyIds, error) {
+	ids := policyIds{
+		tasks:       map[string]uuid.UUID{},
+		roles:       map[string]uuid.UUID{},
+		helpTexts:   map[string]uuid.UUID{},
+		menus:       map[[2]string]uuid.UUID{},
+		navigations: map[[2]string]uuid.UUID{},
+		roleTasks:   map[string]map[internal.PolicyGrant]uuid.UUID{},
+	}
+	policy := internal.Policy{Tasks: []internal.PolicyTask{}, Roles: []internal.PolicyRole{}}
+
+	tasks, err := q.SelectTasks(ctx, tenantId)
+	if err != nil {
+		return policy, ids, handleError(err, "get tasks", internal.ErrorCodeUnknown, "")
+	}
+	taskNames := map[uuid.UUID]string{}
+	taskIndex := map[uuid.UUID]int{}
+	for i, value := range tasks {
+		ids.tasks[value.Task] = value.ID
+		taskNames[value.ID] = value.Task
+		taskIndex[value.ID] = i
+		policy.Tasks = append(policy.Tasks, internal.PolicyTask{Task: value.Task})
+	}
+
+	helpTexts, err := q.SelectHelpTexts(ctx, tenantId)
+	if err != nil {
+		return policy, ids, handleError(err, "get helptexts", internal.ErrorCodeUnknown, "")
+	}
+	for _, value := range helpTexts {
+		i, found := taskIndex[value.TaskID]
+		// a task has a single help text in a policy, the oldest one
+		if !found || policy.Tasks[i].HelpText != "" {
+			continue
+		}
+		policy.Tasks[i].HelpText = value.Helptext
+		ids.helpTexts[taskNames[value.TaskID]] = value.ID
+	}
+
+	menus, err := q.SelectMenus(ctx, tenantId)
+	if err != nil {
+		return policy, ids, handleError(err, "get menus", internal.ErrorCodeUnknown, "")
+	}
+	for _, value := range menus {
+		i, found := taskIndex[value.TaskID]
+		key := [2]string{taskNames[value.TaskID], value.Name}
+		if _, duplicate := ids.menus[key]; !found || duplicate {
+			continue
+		}
+		policy.Tasks[i].Menus = append(policy.Tasks[i].Menus, value.Name)
+		ids.menus[key] = value.ID
+	}
+
+	navigations, err := q.SelectNavigations(ctx, tenantId)
+	if err != nil {
+		return policy, ids, handleError(err, "get navigations", internal.ErrorCodeUnknown, "")
+	}
+	for _, value := range navigations {
+		i, found := taskIndex[value.TaskID]
+		key := [2]string{taskNames[value.TaskID], value.Name}
+		if _, duplicate := ids.navigations[key]; !found || duplicate {
+			continue
+		}
+		policy.Tasks[i].Navigations = append(policy.Tasks[i].Navigations, value.Name)
+		ids.navigations[key] = value.ID
+	}
+
+	roles, err := q.SelectRolesByTenant(ctx, tenantId)
+	if err != nil {
+		return policy, ids, handleError(err, "get roles", internal.ErrorCodeUnknown, "")
+	}
+	roleNames := map[uuid.UUID]string{}
+	roleIndex := map[uuid.UUID]int{}
+	for i, value := range roles {
+		ids.roles[value.Role] = value.ID
+		ids.roleTasks[value.Role] = map[internal.PolicyGrant]uuid.UUID{}
+		roleNames[value.ID] = value.Role
+		roleIndex[value.ID] = i
+		policy.Roles = append(policy.Roles, internal.PolicyRole{Role: value.Role})
+	}
+
+	roleTasks, err := q.SelectRoleTasksByTenant(ctx, tenantId)
+	if err != nil {
+		return policy, ids, handleError(err, "get role tasks", internal.ErrorCodeUnknown, "")
+	}
+	for _, value := range roleTasks {
+		grant := policyGrant(internal.PolicyGrant{
+			Task:      taskNames[value.TaskID],
+			Effect:    value.Effect,
+			Scope:     internal.Scope{ResourceType: value.ResourceType, ResourceId: value.ResourceID},
+			Condition: value.Condition,
+		})
+		role := roleNames[value.RoleID]
+		if _, duplicate := ids.roleTasks[role][grant]; duplicate {
+			continue
+		}
+		i := roleIndex[value.RoleID]
+		policy.Roles[i].Grants = append(policy.Roles[i].Grants, grant)
+		ids.roleTasks[role][grant] = value.ID
+	}
+	return policy, ids, nil
+}
+
+// applyPolicyChange makes the change and returns the id of the changed record.
+func applyPolicyChange(ctx context.Context, q *Queries, tenantId uuid.UUID, ids policyIds, change internal.PolicyChange) (uuid.UUID, error) {
+	var id uuid.UUID
+	var err error
+	switch change.Kind + " " + change.Action {
+	case internal.POLICY_TASK + " " + internal.POLICY_CREATE:
+		id, err = q.InsertTask(ctx, InsertTaskParams{
+			Task:     change.Task,
+			TenantID: tenantId,
+		})
+		if err != nil {
+			return id, handleError(err, "create task", internal.ErrorCodeUnknown, "")
+		}
+		ids.tasks[change.Task] = id
+	case internal.POLICY_TASK + " " + internal.POLICY_DELETE:
+		id = ids.tasks[change.Task]
+		err = removeTask(ctx, q, id)
+	case internal.POLICY_ROLE + " " + internal.POLICY_CREATE:
+		id, err = q.InsertRole(ctx, InsertRoleParams{
+			Role:     change.Role,
+			TenantID: tenantId,
+		})
+		if err != nil {
+			return id, handleError(err, "create role", internal.ErrorCodeUnknown, "")
+		}
+		ids.roles[change.Role] = id
+	case internal.POLICY_ROLE + " " + internal.POLICY_DELETE:
+		id = ids.roles[change.Role]
+		err = removeRole(ctx, q, id)
+	case internal.POLICY_HELPTEXT + " " + internal.POLICY_CREATE:
+		id, err = q.InsertHelpText(ctx, InsertHelpTextParams{
+			Helptext: change.Name,
+			TaskID:   ids.tasks[change.Task],
+			TenantID: tenantId,
+		})
+		if err != nil {
+			return id, handleError(err, "create help text", internal.ErrorCodeUnknown, "")
+		}
+	case internal.POLICY_HELPTEXT + " " + internal.POLICY_UPDATE:
+		id = ids.helpTexts[change.Task]
+		err = q.UpdateHelpText(ctx, UpdateHelpTextParams{
+			TaskID:   ids.tasks[change.Task],
+			Helptext: change.Name,
+			ID:       id,
+		})
+		if err != nil {
+			return id, handleError(err, "update helptext", internal.ErrorCodeUnknown, "")
+		}
+	case internal.POLICY_HELPTEXT + " " + internal.POLICY_DELETE:
+		id = ids.helpTexts[change.Task]
+		err = q.DeleteHelpText(ctx, id)
+		if err != nil {
+			return id, handleError(err, "delete helptext", internal.ErrorCodeUnknown, "")
+		}
+	case internal.POLICY_MENU + " " + internal.POLICY_CREATE:
+		id, err = q.InsertMenu(ctx, InsertMenuParams{
+			Name:     change.Name,
+			TaskID:   ids.tasks[change.Task],
+			TenantID: tenantId,
+		})
+		if err != nil {
+			return id, handleError(err, "create menu", internal.ErrorCodeUnknown, "")
+		}
+	case internal.POLICY_MENU + " " + internal.POLICY_DELETE:
+		id = ids.menus[[2]string{change.Task, change.Name}]
+		err = q.DeleteMenu(ctx, id)
+		if err != nil {
+			return id, handleError(err, "delete menu", internal.ErrorCodeUnknown, "")
+		}
+	case internal.POLICY_NAVIGATION + " " + internal.POLICY_CREATE:
+		id, err = q.InsertNavigation(ctx, InsertNavigationParams{
+			Name:     change.Name,
+			TaskID:   ids.tasks[change.Task],
+			TenantID: tenantId,
+		})
+		if err != nil {
+			return id, handleError(err, "create navigation", internal.ErrorCodeUnknown, "")
+		}
+	case internal.POLICY_NAVIGATION + " " + internal.POLICY_DELETE:
+		id = ids.navigations[[2]string{change.Task, change.Name}]
+		err = q.DeleteNavigation(ctx, id)
+		if err != nil {
+			return id, handleError(err, "delete navigation", internal.ErrorCodeUnknown, "")
+		}
+	case internal.POLICY_ROLE_TASK + " " + internal.POLICY_CREATE:
+		grant := policyGrant(change.Grant)
+		id, err = q.InsertRoleTask(ctx, InsertRoleTaskParams{
+			RoleID:       ids.roles[change.Role],
+			TaskID:       ids.tasks[change.Task],
+			ResourceType: grant.Scope.ResourceType,
+			ResourceID:   grant.Scope.ResourceId,
+			Condition:    grant.Condition,
+			Effect:       grant.Effect,
+		})
+		if err != nil {
+			return id, handleError(err, "create role task", internal.ErrorCodeUnknown, "")
+		}
+	case internal.POLICY_ROLE_TASK + " " + internal.POLICY_DELETE:
+		id = ids.roleTasks[change.Role][policyGrant(change.Grant)]
+		err = q.DeleteRoleTask(ctx, id)
+		if err != nil {
+			return id, handleError(err, "delete role task", internal.ErrorCodeUnknown, "")
+		}
+	default:
+		return id, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown policy change %s", change)
+	}
+	return id, err
+}
+
+// policyGrant returns the grant with its default effect, role tasks are stored with one.
+func policyGrant(grant internal.PolicyGrant) internal.PolicyGrant {
+	if grant.Effect == "" {
+		grant.Effect = internal.EFFECT_ALLOW
+	}
+	return grant
+}
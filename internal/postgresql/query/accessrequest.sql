@@ -0,0 +1,134 @@
+-- name: InsertRoleApprover :one
+INSERT INTO role_approvers (
+  role_id,
+  approver_role_id
+)
+VALUES (
+  @role_id,
+  @approver_role_id
+)
+RETURNING id;
+
+-- name: DeleteRoleApprover :execrows
+DELETE FROM role_approvers
+WHERE role_id = @role_id AND approver_role_id = @approver_role_id;
+
+-- name: DeleteRoleApproversByRole :exec
+DELETE FROM role_approvers
+WHERE role_id = @role_id OR approver_role_id = @role_id;
+
+-- name: SelectRoleApprovers :many
+SELECT
+  roles.id,
+  roles.role,
+  roles.created_at
+FROM
+  role_approvers
+  INNER JOIN roles ON roles.id = role_approvers.approver_role_id
+WHERE
+  role_approvers.role_id = @role_id
+ORDER BY roles.role;
+
+-- name: InsertAccessRequest :one
+INSERT INTO access_requests (
+  account_id,
+  role_id,
+  justification,
+  expires_at
+)
+VALUES (
+  @account_id,
+  @role_id,
+  @justification,
+  @expires_at
+)
+RETURNING id;
+
+-- name: ExpireAccessRequests :exec
+UPDATE access_requests SET
+  status = 'expired'
+WHERE account_id = @account_id AND role_id = @role_id AND status = 'pending' AND expires_at <= now();
+
+-- name: DecideAccessRequest :execrows
+UPDATE access_requests SET
+  status     = @status,
+  decided_by = @decided_by,
+  comment    = @comment,
+  decided_at = now()
+WHERE id = @id AND status = 'pending' AND expires_at > now();
+
+-- name: DeleteAccessRequestsByRole :exec
+DELETE FROM access_requests
+WHERE role_id = @role_id;
+
+-- name: SelectAccessRequest :one
+SELECT
+  access_requests.id,
+  accounts.id AS account_id,
+  accounts.username,
+  roles.id AS role_id,
+  roles.role,
+  roles.tenant_id,
+  access_requests.justification,
+  access_requests.status,
+  COALESCE(deciders.username, '') AS decided_by,
+  access_requests.comment,
+  access_requests.expires_at,
+  access_requests.decided_at,
+  access_requests.created_at
+FROM
+  access_requests
+  INNER JOIN accounts ON accounts.id = access_requests.account_id
+  INNER JOIN roles ON roles.id = access_requests.role_id
+  LEFT JOIN accounts AS deciders ON deciders.id = access_requests.decided_by
+WHERE
+  access_requests.id = @id
+LIMIT 1;
+
+-- name: SelectAccessRequests :many
+SELECT
+  access_requests.id,
+  accounts.id AS account_id,
+  accounts.username,
+  roles.id AS role_id,
+  roles.role,
+  roles.tenant_id,
+  access_requests.justification,
+  access_requests.status,
+  COALESCE(deciders.username, '') AS decided_by,
+  access_requests.comment,
+  access_requests.expires_at,
+  access_requests.decided_at,
+  access_requests.created_at
+FROM
+  access_requests
+  INNER JOIN accounts ON accounts.id = access_requests.account_id
+  INNER JOIN roles ON roles.id = access_requests.role_id
+  LEFT JOIN accounts AS deciders ON deciders.id = access_requests.decided_by
+WHERE
+  roles.tenant_id = @tenant_id
+ORDER BY access_requests.created_at DESC;
+
+-- name: SelectAccessRequestsByAccount :many
+SELECT
+  access_requests.id,
+  accounts.id AS account_id,
+  accounts.username,
+  roles.id AS role_id,
+  roles.role,
+  roles.tenant_id,
+  access_requests.justification,
+  access_requests.status,
+  COALESCE(deciders.username, '') AS decided_by,
+  access_requests.comment,
+  access_requests.expires_at,
+  access_requests.decided_at,
+  access_requests.created_at
+FROM
+  access_requests
+  INNER JOIN accounts ON accounts.id = access_requests.account_id
+  INNER JOIN roles ON roles.id = access_requests.role_id
+  LEFT JOIN accounts AS deciders ON deciders.id = access_requests.decided_by
+WHERE
+  accounts.username = @username AND roles.tenant_id = @tenant_id
+ORDER BY access_requests.created_at DESC;
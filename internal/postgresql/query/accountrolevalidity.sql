@@ -0,0 +1,12 @@
+-- name: DeleteExpiredAccountRoles :many
+DELETE FROM account_roles
+WHERE valid_until <= @now::timestamptz
+RETURNING id;
+
+-- name: CountStartedAccountRoles :one
+SELECT
+  count(*)
+FROM
+  account_roles
+WHERE
+  valid_from > @since::timestamptz AND valid_from <= @now::timestamptz;
@@ -0,0 +1,60 @@
+-- name: InsertApiKey :one
+INSERT INTO api_keys (
+  account_id,
+  name,
+  prefix,
+  key_hash,
+  expires_at
+)
+VALUES (
+  @account_id,
+  @name,
+  @prefix,
+  @key_hash,
+  @expires_at
+)
+RETURNING id;
+
+-- name: SelectApiKeyByHash :one
+SELECT
+  api_keys.id,
+  api_keys.account_id,
+  accounts.username,
+  api_keys.name,
+  api_keys.prefix,
+  api_keys.expires_at,
+  api_keys.last_used_at,
+  api_keys.revoked_at,
+  api_keys.created_at
+FROM
+  api_keys
+  INNER JOIN accounts ON accounts.id = api_keys.account_id
+WHERE
+  api_keys.key_hash = @key_hash
+LIMIT 1;
+
+-- name: SelectApiKeysByAccount :many
+SELECT
+  id,
+  account_id,
+  name,
+  prefix,
+  expires_at,
+  last_used_at,
+  revoked_at,
+  created_at
+FROM
+  api_keys
+WHERE
+  account_id = @account_id
+ORDER BY created_at;
+
+-- name: TouchApiKey :exec
+UPDATE api_keys SET
+  last_used_at = now()
+WHERE id = @id;
+
+-- name: RevokeApiKey :execrows
+UPDATE api_keys SET
+  revoked_at = now()
+WHERE id = @id AND account_id = @account_id AND revoked_at IS NULL;
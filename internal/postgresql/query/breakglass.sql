@@ -0,0 +1,88 @@
+-- name: InsertEmergencyRole :exec
+INSERT INTO emergency_roles (
+  role_id
+)
+VALUES (
+  @role_id
+)
+ON CONFLICT (role_id) DO NOTHING;
+
+-- name: DeleteEmergencyRole :exec
+DELETE FROM emergency_roles
+WHERE role_id = @role_id;
+
+-- name: SelectEmergencyRole :one
+SELECT EXISTS (
+  SELECT 1
+  FROM emergency_roles
+  WHERE role_id = @role_id
+);
+
+-- name: SelectEmergencyRoles :many
+SELECT
+  roles.id,
+  roles.role,
+  roles.created_at
+FROM
+  emergency_roles
+  INNER JOIN roles ON roles.id = emergency_roles.role_id
+WHERE
+  roles.tenant_id = @tenant_id
+ORDER BY roles.role;
+
+-- name: InsertBreakGlassActivation :one
+INSERT INTO break_glass_activations (
+  account_id,
+  role_id,
+  reason,
+  expires_at
+)
+VALUES (
+  @account_id,
+  @role_id,
+  @reason,
+  @expires_at
+)
+RETURNING id;
+
+-- name: DeleteBreakGlassActivationsByRole :exec
+DELETE FROM break_glass_activations
+WHERE role_id = @role_id;
+
+-- name: SelectBreakGlassActivation :one
+SELECT
+  break_glass_activations.id,
+  accounts.id AS account_id,
+  accounts.username,
+  roles.id AS role_id,
+  roles.role,
+  roles.tenant_id,
+  break_glass_activations.reason,
+  break_glass_activations.expires_at,
+  break_glass_activations.created_at
+FROM
+  break_glass_activations
+  INNER JOIN accounts ON accounts.id = break_glass_activations.account_id
+  INNER JOIN roles ON roles.id = break_glass_activations.role_id
+WHERE
+  break_glass_activations.id = @id
+LIMIT 1;
+
+-- name: SelectBreakGlassActivations :many
+SELECT
+  break_glass_activations.id,
+  accounts.id AS account_id,
+  accounts.username,
+  roles.id AS role_id,
+  roles.role,
+  roles.tenant_id,
+  break_glass_activations.reason,
+  break_glass_activations.expires_at,
+  break_glass_activations.created_at
+FROM
+  break_glass_activations
+  INNER JOIN accounts ON accounts.id = break_glass_activations.account_id
+  INNER JOIN roles ON roles.id = break_glass_activations.role_id
+WHERE
+  roles.tenant_id = @tenant_id AND break_glass_activations.created_at >= @since
+ORDER BY break_glass_activations.created_at DESC;
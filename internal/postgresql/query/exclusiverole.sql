@@ -0,0 +1,169 @@
+-- name: InsertExclusiveRoleSet :one
+INSERT INTO exclusive_role_sets (
+  tenant_id,
+  name
+)
+VALUES (
+  @tenant_id,
+  @name
+)
+RETURNING id;
+
+-- name: SelectExclusiveRoleSet :one
+SELECT
+  id,
+  tenant_id,
+  name,
+  created_at
+FROM
+  exclusive_role_sets
+WHERE
+  id = @id
+LIMIT 1;
+
+-- name: SelectExclusiveRoleSets :many
+SELECT
+  id,
+  tenant_id,
+  name,
+  created_at
+FROM
+  exclusive_role_sets
+WHERE
+  tenant_id = @tenant_id
+ORDER BY name;
+
+-- name: UpdateExclusiveRoleSet :exec
+UPDATE exclusive_role_sets SET
+  name = @name
+WHERE id = @id;
+
+-- name: DeleteExclusiveRoleSet :exec
+DELETE FROM exclusive_role_sets
+WHERE id = @id;
+
+-- name: InsertExclusiveRoleSetRole :one
+INSERT INTO exclusive_role_set_roles (
+  set_id,
+  role_id
+)
+VALUES (
+  @set_id,
+  @role_id
+)
+RETURNING id;
+
+-- name: DeleteExclusiveRoleSetRolesBySet :exec
+DELETE FROM exclusive_role_set_roles
+WHERE set_id = @set_id;
+
+-- name: DeleteExclusiveRoleSetRolesByRole :exec
+DELETE FROM exclusive_role_set_roles
+WHERE role_id = @role_id;
+
+-- name: SelectExclusiveRoleSetRoles :many
+SELECT
+  roles.id,
+  roles.role,
+  roles.created_at
+FROM
+  exclusive_role_set_roles
+  INNER JOIN roles ON roles.id = exclusive_role_set_roles.role_id
+WHERE
+  exclusive_role_set_roles.set_id = @set_id
+ORDER BY roles.role;
+
+-- name: LockAccountRoles :exec
+SELECT id FROM accounts
+WHERE id = @account_id
+FOR UPDATE;
+
+-- name: SelectAccountExclusiveRoles :many
+WITH RECURSIVE account_groups AS (
+  SELECT group_members.group_id
+  FROM group_members
+  WHERE group_members.account_id = @account_id
+  UNION
+  SELECT group_nesting.group_id
+  FROM
+    group_nesting
+    INNER JOIN account_groups ON group_nesting.member_group_id = account_groups.group_id
+),
+held AS (
+  SELECT account_roles.role_id
+  FROM account_roles
+  WHERE account_roles.account_id = @account_id
+  UNION
+  SELECT group_roles.role_id
+  FROM
+    group_roles
+    INNER JOIN account_groups ON account_groups.group_id = group_roles.group_id
+  UNION
+  SELECT role_inheritance.inherited_role_id
+  FROM
+    role_inheritance
+    INNER JOIN held ON role_inheritance.role_id = held.role_id
+)
+SELECT
+  exclusive_role_sets.id AS set_id,
+  exclusive_role_sets.name,
+  roles.id AS role_id,
+  roles.role
+FROM
+  held
+  INNER JOIN exclusive_role_set_roles ON exclusive_role_set_roles.role_id = held.role_id
+  INNER JOIN exclusive_role_sets ON exclusive_role_sets.id = exclusive_role_set_roles.set_id
+  INNER JOIN roles ON roles.id = held.role_id
+WHERE
+  exclusive_role_sets.tenant_id = @tenant_id
+ORDER BY exclusive_role_sets.name, roles.role;
+
+-- name: SelectExclusiveRoles :many
+WITH RECURSIVE account_groups AS (
+  SELECT
+    group_members.account_id,
+    group_members.group_id
+  FROM group_members
+  UNION
+  SELECT
+    account_groups.account_id,
+    group_nesting.group_id
+  FROM
+    group_nesting
+    INNER JOIN account_groups ON group_nesting.member_group_id = account_groups.group_id
+),
+held AS (
+  SELECT
+    account_roles.account_id,
+    account_roles.role_id
+  FROM account_roles
+  UNION
+  SELECT
+    account_groups.account_id,
+    group_roles.role_id
+  FROM
+    group_roles
+    INNER JOIN account_groups ON account_groups.group_id = group_roles.group_id
+  UNION
+  SELECT
+    held.account_id,
+    role_inheritance.inherited_role_id
+  FROM
+    role_inheritance
+    INNER JOIN held ON role_inheritance.role_id = held.role_id
+)
+SELECT
+  accounts.username,
+  exclusive_role_sets.id AS set_id,
+  exclusive_role_sets.name,
+  roles.id AS role_id,
+  roles.role
+FROM
+  held
+  INNER JOIN accounts ON accounts.id = held.account_id
+  INNER JOIN exclusive_role_set_roles ON exclusive_role_set_roles.role_id = held.role_id
+  INNER JOIN exclusive_role_sets ON exclusive_role_sets.id = exclusive_role_set_roles.set_id
+  INNER JOIN roles ON roles.id = held.role_id
+WHERE
+  exclusive_role_sets.tenant_id = @tenant_id
+ORDER BY accounts.username, exclusive_role_sets.name, roles.role;
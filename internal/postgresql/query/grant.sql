@@ -0,0 +1,132 @@
+-- name: SelectRestrictedGrants :many
+WITH RECURSIVE account_groups AS (
+  SELECT group_members.group_id
+  FROM
+    group_members
+    INNER JOIN accounts ON accounts.id = group_members.account_id
+  WHERE
+    accounts.username = @username AND accounts.is_blocked = false
+  UNION
+  SELECT group_nesting.group_id
+  FROM
+    group_nesting
+    INNER JOIN account_groups ON group_nesting.member_group_id = account_groups.group_id
+),
+granted AS (
+  SELECT
+    account_roles.role_id,
+    account_roles.resource_type,
+    account_roles.resource_id
+  FROM
+    account_roles
+    INNER JOIN accounts ON accounts.id = account_roles.account_id
+    INNER JOIN roles ON roles.id = account_roles.role_id
+  WHERE
+    accounts.username = @username AND accounts.is_blocked = false AND roles.tenant_id = @tenant_id
+    AND (account_roles.valid_from IS NULL OR account_roles.valid_from <= now())
+    AND (account_roles.valid_until IS NULL OR account_roles.valid_until > now())
+  UNION
+  SELECT
+    group_roles.role_id,
+    '' AS resource_type,
+    '' AS resource_id
+  FROM
+    group_roles
+    INNER JOIN account_groups ON account_groups.group_id = group_roles.group_id
+    INNER JOIN roles ON roles.id = group_roles.role_id
+  WHERE
+    roles.tenant_id = @tenant_id
+  UNION
+  SELECT
+    role_inheritance.inherited_role_id,
+    granted.resource_type,
+    granted.resource_id
+  FROM
+    role_inheritance
+    INNER JOIN granted ON role_inheritance.role_id = granted.role_id
+)
+SELECT
+  tasks.task,
+  granted.role_id,
+  granted.resource_type AS role_resource_type,
+  granted.resource_id AS role_resource_id,
+  role_tasks.resource_type AS task_resource_type,
+  role_tasks.resource_id AS task_resource_id,
+  role_tasks.condition,
+  role_tasks.effect
+FROM
+  granted
+  INNER JOIN role_tasks ON role_tasks.role_id = granted.role_id
+  INNER JOIN tasks ON tasks.id = role_tasks.task_id
+WHERE
+  tasks.task = ANY(@tasks::text[]) AND (granted.resource_type <> '' OR role_tasks.resource_type <> '' OR role_tasks.condition <> '');
+
+-- name: SelectTaskHolders :many
+WITH RECURSIVE account_groups AS (
+  SELECT
+    group_members.account_id,
+    group_members.group_id
+  FROM
+    group_members
+  UNION
+  SELECT
+    account_groups.account_id,
+    group_nesting.group_id
+  FROM
+    group_nesting
+    INNER JOIN account_groups ON group_nesting.member_group_id = account_groups.group_id
+),
+held AS (
+  SELECT
+    account_roles.account_id,
+    account_roles.role_id
+  FROM
+    account_roles
+  WHERE
+    account_roles.resource_type = ''
+    AND (account_roles.valid_from IS NULL OR account_roles.valid_from <= now())
+    AND (account_roles.valid_until IS NULL OR account_roles.valid_until > now())
+  UNION
+  SELECT
+    account_groups.account_id,
+    group_roles.role_id
+  FROM
+    group_roles
+    INNER JOIN account_groups ON account_groups.group_id = group_roles.group_id
+  UNION
+  SELECT
+    held.account_id,
+    role_inheritance.inherited_role_id
+  FROM
+    role_inheritance
+    INNER JOIN held ON role_inheritance.role_id = held.role_id
+),
+task_roles AS (
+  SELECT
+    held.account_id,
+    role_tasks.role_id,
+    role_tasks.effect
+  FROM
+    held
+    INNER JOIN role_tasks ON role_tasks.role_id = held.role_id
+  WHERE
+    role_tasks.task_id = @task_id AND role_tasks.resource_type = '' AND role_tasks.condition = ''
+)
+SELECT DISTINCT
+  accounts.username,
+  roles.id,
+  roles.role,
+  roles.tenant_id,
+  roles.created_at
+FROM
+  task_roles
+  INNER JOIN accounts ON accounts.id = task_roles.account_id
+  INNER JOIN roles ON roles.id = task_roles.role_id
+WHERE
+  accounts.is_blocked = false AND task_roles.effect = 'allow'
+  AND NOT EXISTS (
+    SELECT 1
+    FROM task_roles AS denying
+    WHERE denying.account_id = task_roles.account_id AND denying.effect = 'deny'
+  )
+ORDER BY accounts.username, roles.role;
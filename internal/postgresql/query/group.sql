@@ -0,0 +1,179 @@
+-- name: InsertGroup :one
+INSERT INTO groups (
+  tenant_id,
+  name
+)
+VALUES (
+  @tenant_id,
+  @name
+)
+RETURNING id;
+
+-- name: SelectGroup :one
+SELECT
+  id,
+  tenant_id,
+  name,
+  created_at
+FROM
+  groups
+WHERE
+  id = @id
+LIMIT 1;
+
+-- name: SelectGroups :many
+SELECT
+  id,
+  tenant_id,
+  name,
+  created_at
+FROM
+  groups
+WHERE
+  tenant_id = @tenant_id
+ORDER BY name;
+
+-- name: UpdateGroup :exec
+UPDATE groups SET
+  name = @name
+WHERE id = @id;
+
+-- name: DeleteGroup :exec
+DELETE FROM groups
+WHERE id = @id;
+
+-- name: InsertGroupMember :one
+INSERT INTO group_members (
+  group_id,
+  account_id
+)
+VALUES (
+  @group_id,
+  @account_id
+)
+RETURNING id;
+
+-- name: DeleteGroupMember :one
+DELETE FROM group_members
+WHERE group_id = @group_id AND account_id = @account_id
+RETURNING id;
+
+-- name: DeleteGroupMembersByGroup :many
+DELETE FROM group_members
+WHERE group_id = @group_id
+RETURNING id;
+
+-- name: SelectGroupMembers :many
+SELECT
+  group_members.id,
+  accounts.id AS account_id,
+  accounts.username,
+  group_members.created_at
+FROM
+  group_members
+  INNER JOIN accounts ON accounts.id = group_members.account_id
+WHERE
+  group_members.group_id = @group_id
+ORDER BY accounts.username;
+
+-- name: InsertGroupNesting :one
+INSERT INTO group_nesting (
+  group_id,
+  member_group_id
+)
+VALUES (
+  @group_id,
+  @member_group_id
+)
+RETURNING id;
+
+-- name: DeleteGroupNesting :execrows
+DELETE FROM group_nesting
+WHERE group_id = @group_id AND member_group_id = @member_group_id;
+
+-- name: DeleteGroupNestingByGroup :exec
+DELETE FROM group_nesting
+WHERE group_id = @group_id OR member_group_id = @group_id;
+
+-- name: SelectMemberGroups :many
+SELECT
+  groups.id,
+  groups.tenant_id,
+  groups.name,
+  groups.created_at
+FROM
+  group_nesting
+  INNER JOIN groups ON groups.id = group_nesting.member_group_id
+WHERE
+  group_nesting.group_id = @group_id
+ORDER BY groups.name;
+
+-- name: SelectParentGroupIds :many
+WITH RECURSIVE parents AS (
+  SELECT group_nesting.group_id
+  FROM group_nesting
+  WHERE group_nesting.member_group_id = @group_id
+  UNION
+  SELECT group_nesting.group_id
+  FROM
+    group_nesting
+    INNER JOIN parents ON group_nesting.member_group_id = parents.group_id
+)
+SELECT group_id FROM parents;
+
+-- name: InsertGroupRole :one
+INSERT INTO group_roles (
+  group_id,
+  role_id
+)
+VALUES (
+  @group_id,
+  @role_id
+)
+RETURNING id;
+
+-- name: DeleteGroupRole :execrows
+DELETE FROM group_roles
+WHERE group_id = @group_id AND role_id = @role_id;
+
+-- name: DeleteGroupRolesByGroup :exec
+DELETE FROM group_roles
+WHERE group_id = @group_id;
+
+-- name: DeleteGroupRolesByRole :exec
+DELETE FROM group_roles
+WHERE role_id = @role_id;
+
+-- name: SelectGroupRoles :many
+SELECT
+  roles.id,
+  roles.role,
+  roles.created_at
+FROM
+  group_roles
+  INNER JOIN roles ON roles.id = group_roles.role_id
+WHERE
+  group_roles.group_id = @group_id
+ORDER BY roles.role;
+
+-- name: SelectGroupRoleIdsByAccount :many
+WITH RECURSIVE account_groups AS (
+  SELECT group_members.group_id
+  FROM
+    group_members
+    INNER JOIN accounts ON accounts.id = group_members.account_id
+  WHERE
+    accounts.username = @username AND accounts.is_blocked = false
+  UNION
+  SELECT group_nesting.group_id
+  FROM
+    group_nesting
+    INNER JOIN account_groups ON group_nesting.member_group_id = account_groups.group_id
+)
+SELECT DISTINCT group_roles.role_id
+FROM
+  group_roles
+  INNER JOIN account_groups ON account_groups.group_id = group_roles.group_id
+  INNER JOIN roles ON roles.id = group_roles.role_id
+WHERE
+  roles.tenant_id = @tenant_id;
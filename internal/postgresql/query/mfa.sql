@@ -0,0 +1,83 @@
+-- name: SelectAccountMfa :one
+SELECT
+  account_id,
+  secret,
+  last_used_step,
+  confirmed_at,
+  created_at
+FROM
+  account_mfa
+WHERE
+  account_id = @account_id
+LIMIT 1;
+
+-- name: UpsertAccountMfa :exec
+INSERT INTO account_mfa (
+  account_id,
+  secret
+)
+VALUES (
+  @account_id,
+  @secret
+)
+ON CONFLICT (account_id) DO UPDATE SET
+  secret = EXCLUDED.secret,
+  last_used_step = 0,
+  confirmed_at = NULL;
+
+-- name: ConfirmAccountMfa :exec
+UPDATE account_mfa SET
+  confirmed_at = now(),
+  last_used_step = @last_used_step
+WHERE account_id = @account_id;
+
+-- name: UseAccountMfaStep :execrows
+UPDATE account_mfa SET
+  last_used_step = @last_used_step
+WHERE account_id = @account_id AND last_used_step < @last_used_step;
+
+-- name: DeleteAccountMfa :exec
+DELETE FROM account_mfa
+WHERE account_id = @account_id;
+
+-- name: InsertMfaRecoveryCode :exec
+INSERT INTO mfa_recovery_codes (
+  account_id,
+  code_hash
+)
+VALUES (
+  @account_id,
+  @code_hash
+);
+
+-- name: UseMfaRecoveryCode :execrows
+UPDATE mfa_recovery_codes SET
+  used_at = now()
+WHERE account_id = @account_id AND code_hash = @code_hash AND used_at IS NULL;
+
+-- name: DeleteMfaRecoveryCodes :exec
+DELETE FROM mfa_recovery_codes
+WHERE account_id = @account_id;
+
+-- name: InsertRoleMfaPolicy :exec
+INSERT INTO role_mfa_policies (
+  role_id
+)
+VALUES (
+  @role_id
+)
+ON CONFLICT (role_id) DO NOTHING;
+
+-- name: DeleteRoleMfaPolicy :exec
+DELETE FROM role_mfa_policies
+WHERE role_id = @role_id;
+
+-- name: SelectMfaRequired :one
+SELECT EXISTS (
+  SELECT 1
+  FROM
+    account_roles
+    INNER JOIN role_mfa_policies ON role_mfa_policies.role_id = account_roles.role_id
+  WHERE
+    account_roles.account_id = @account_id
+);
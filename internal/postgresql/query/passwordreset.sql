@@ -0,0 +1,36 @@
+-- name: InsertPasswordResetToken :one
+INSERT INTO password_reset_tokens (
+  account_id,
+  token_hash,
+  expires_at
+)
+VALUES (
+  @account_id,
+  @token_hash,
+  @expires_at
+)
+RETURNING id;
+
+-- name: SelectPasswordResetTokenByHash :one
+SELECT
+  id,
+  account_id,
+  token_hash,
+  expires_at,
+  used_at,
+  created_at
+FROM
+  password_reset_tokens
+WHERE
+  token_hash = @token_hash
+LIMIT 1;
+
+-- name: UsePasswordResetToken :execrows
+UPDATE password_reset_tokens SET
+  used_at = now()
+WHERE id = @id AND used_at IS NULL;
+
+-- name: UsePasswordResetTokensByAccount :exec
+UPDATE password_reset_tokens SET
+  used_at = now()
+WHERE account_id = @account_id AND used_at IS NULL;
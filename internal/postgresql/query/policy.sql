@@ -0,0 +1,90 @@
+-- name: SelectTasks :many
+SELECT
+  id,
+  task,
+  created_at,
+  tenant_id
+FROM
+  tasks
+WHERE
+  tenant_id = @tenant_id
+ORDER BY
+  task;
+
+-- name: SelectHelpTexts :many
+SELECT
+  id,
+  task_id,
+  helptext,
+  created_at,
+  tenant_id
+FROM
+  helptext
+WHERE
+  tenant_id = @tenant_id
+ORDER BY
+  created_at;
+
+-- name: SelectMenus :many
+SELECT
+  id,
+  task_id,
+  name,
+  created_at,
+  tenant_id
+FROM
+  menu
+WHERE
+  tenant_id = @tenant_id
+ORDER BY
+  created_at;
+
+-- name: SelectNavigations :many
+SELECT
+  id,
+  task_id,
+  name,
+  created_at,
+  tenant_id
+FROM
+  navigation
+WHERE
+  tenant_id = @tenant_id
+ORDER BY
+  created_at;
+
+-- name: SelectRolesByTenant :many
+SELECT
+  id,
+  role,
+  created_at,
+  tenant_id
+FROM
+  roles
+WHERE
+  tenant_id = @tenant_id
+ORDER BY
+  role;
+
+-- name: SelectRoleTasksByTenant :many
+SELECT
+  id,
+  task_id,
+  role_id,
+  created_at,
+  resource_type,
+  resource_id,
+  condition,
+  effect
+FROM
+  role_tasks
+WHERE
+  role_id IN (
+    SELECT
+      id
+    FROM
+      roles
+    WHERE
+      tenant_id = @tenant_id)
+ORDER BY
+  created_at;
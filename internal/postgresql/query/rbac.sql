@@ -56,6 +56,7 @@ SELECT
   hashedpassword,
   profile,
   is_blocked,
+  is_service_account,
   created_at
 FROM
   accounts
@@ -70,6 +71,7 @@ SELECT
   hashedpassword,
   profile,
   is_blocked,
+  is_service_account,
   created_at
 FROM
   accounts
@@ -92,6 +94,21 @@ VALUES (
 RETURNING id;
 
 
+-- name: InsertServiceAccount :one
+INSERT INTO accounts (
+  username,
+  hashedpassword,
+  profile,
+  is_service_account
+)
+VALUES (
+  @username,
+  '',
+  @profile,
+  true
+)
+RETURNING id;
+
 -- name: ChangePassword :exec
 UPDATE accounts SET
   hashedpassword = @hashedpassword
@@ -102,27 +119,64 @@ UPDATE accounts SET
   is_blocked = true
 WHERE username = @username;
 
+-- name: BlockAccount :exec
+UPDATE accounts SET
+  is_blocked = true
+WHERE username = @username;
+
+-- name: UnblockAccount :exec
+UPDATE accounts SET
+  is_blocked = false
+WHERE username = @username;
+
 
 -- name: SelectAccountRole :one
 SELECT
   id,
   account_id,
   role_id,
-  created_at
+  created_at,
+  resource_type,
+  resource_id,
+  valid_from,
+  valid_until
 FROM
   account_roles
 WHERE
   id = @id
 LIMIT 1;
 
+-- name: SelectAccountRolesByAccount :many
+SELECT
+  id,
+  account_id,
+  role_id,
+  created_at,
+  resource_type,
+  resource_id,
+  valid_from,
+  valid_until
+FROM
+  account_roles
+WHERE
+  account_id = @account_id;
+
 -- name: InsertAccountRole :one
 INSERT INTO account_roles (
     account_id,
-    role_id
+    role_id,
+    resource_type,
+    resource_id,
+    valid_from,
+    valid_until
 )
 VALUES (
   @account_id,
-  @role_id
+  @role_id,
+  @resource_type,
+  @resource_id,
+  @valid_from,
+  @valid_until
 )
 RETURNING id;
 
@@ -146,7 +200,8 @@ SELECT
   id,
   task_id,
   helptext,
-  created_at
+  created_at,
+  tenant_id
 FROM
   helptext
 WHERE
@@ -158,7 +213,8 @@ SELECT
   id,
   task_id,
   helptext,
-  created_at
+  created_at,
+  tenant_id
 FROM
   helptext
 WHERE
@@ -168,11 +224,13 @@ LIMIT 1;
 -- name: InsertHelpText :one
 INSERT INTO helptext (
     task_id,
-    helptext
+    helptext,
+    tenant_id
 )
 VALUES (
   @task_id,
-  @helptext
+  @helptext,
+  @tenant_id
 )
 RETURNING id;
 
@@ -196,7 +254,8 @@ SELECT
   id,
   task_id,
   name,
-  created_at
+  created_at,
+  tenant_id
 FROM
   menu
 WHERE
@@ -208,7 +267,8 @@ SELECT
   id,
   task_id,
   name,
-  created_at
+  created_at,
+  tenant_id
 FROM
   menu
 WHERE
@@ -217,11 +277,13 @@ WHERE
 -- name: InsertMenu :one
 INSERT INTO menu (
     task_id,
-    name
+    name,
+    tenant_id
 )
 VALUES (
   @task_id,
-  @name
+  @name,
+  @tenant_id
 )
 RETURNING id;
 
@@ -245,7 +307,8 @@ SELECT
   id,
   task_id,
   name,
-  created_at
+  created_at,
+  tenant_id
 FROM
   navigation
 WHERE
@@ -257,7 +320,8 @@ SELECT
   id,
   task_id,
   name,
-  created_at
+  created_at,
+  tenant_id
 FROM
   navigation
 WHERE
@@ -266,11 +330,13 @@ WHERE
 -- name: InsertNavigation :one
 INSERT INTO navigation (
     task_id,
-    name
+    name,
+    tenant_id
 )
 VALUES (
   @task_id,
-  @name
+  @name,
+  @tenant_id
 )
 RETURNING id;
 
@@ -293,7 +359,8 @@ WHERE task_id = @task_id;
 SELECT
   id,
   role,
-  created_at
+  created_at,
+  tenant_id
 FROM
   roles
 WHERE
@@ -302,10 +369,12 @@ LIMIT 1;
 
 -- name: InsertRole :one
 INSERT INTO roles (
-    role
+    role,
+    tenant_id
 )
 VALUES (
-  @role
+  @role,
+  @tenant_id
 )
 RETURNING id;
 
@@ -325,7 +394,11 @@ SELECT
   id,
   task_id,
   role_id,
-  created_at
+  created_at,
+  resource_type,
+  resource_id,
+  condition,
+  effect
 FROM
   role_tasks
 WHERE
@@ -335,11 +408,19 @@ LIMIT 1;
 -- name: InsertRoleTask :one
 INSERT INTO role_tasks (
     task_id,
-    role_id
+    role_id,
+    resource_type,
+    resource_id,
+    condition,
+    effect
 )
 VALUES (
   @task_id,
-  @role_id
+  @role_id,
+  @resource_type,
+  @resource_id,
+  @condition,
+  @effect
 )
 RETURNING id;
 
@@ -366,7 +447,8 @@ WHERE role_id = @role_id;
 SELECT
   id,
   task,
-  created_at
+  created_at,
+  tenant_id
 FROM
   tasks
 WHERE
@@ -375,10 +457,12 @@ LIMIT 1;
 
 -- name: InsertTask :one
 INSERT INTO tasks (
-    task
+    task,
+    tenant_id
 )
 VALUES (
-  @task
+  @task,
+  @tenant_id
 )
 RETURNING id;
 
@@ -0,0 +1,56 @@
+-- name: InsertRoleInheritance :one
+INSERT INTO role_inheritance (
+  role_id,
+  inherited_role_id
+)
+VALUES (
+  @role_id,
+  @inherited_role_id
+)
+RETURNING id;
+
+-- name: DeleteRoleInheritance :execrows
+DELETE FROM role_inheritance
+WHERE role_id = @role_id AND inherited_role_id = @inherited_role_id;
+
+-- name: DeleteRoleInheritanceByRole :exec
+DELETE FROM role_inheritance
+WHERE role_id = @role_id OR inherited_role_id = @role_id;
+
+-- name: SelectInheritedRoles :many
+SELECT
+  roles.id,
+  roles.role,
+  roles.created_at
+FROM
+  role_inheritance
+  INNER JOIN roles ON roles.id = role_inheritance.inherited_role_id
+WHERE
+  role_inheritance.role_id = @role_id
+ORDER BY roles.role;
+
+-- name: SelectInheritedRoleIds :many
+WITH RECURSIVE inherited AS (
+  SELECT role_inheritance.inherited_role_id
+  FROM role_inheritance
+  WHERE role_inheritance.role_id = @role_id
+  UNION
+  SELECT role_inheritance.inherited_role_id
+  FROM
+    role_inheritance
+    INNER JOIN inherited ON role_inheritance.role_id = inherited.inherited_role_id
+)
+SELECT inherited_role_id FROM inherited;
+
+-- name: SelectInheritedRoleIdsOfRoles :many
+WITH RECURSIVE inherited AS (
+  SELECT role_inheritance.inherited_role_id
+  FROM role_inheritance
+  WHERE role_inheritance.role_id = ANY(@role_ids::uuid[])
+  UNION
+  SELECT role_inheritance.inherited_role_id
+  FROM
+    role_inheritance
+    INNER JOIN inherited ON role_inheritance.role_id = inherited.inherited_role_id
+)
+SELECT inherited_role_id FROM inherited;
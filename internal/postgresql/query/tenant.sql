@@ -0,0 +1,80 @@
+-- name: InsertTenant :one
+INSERT INTO tenants (
+  name
+)
+VALUES (
+  @name
+)
+RETURNING id;
+
+-- name: SelectTenant :one
+SELECT
+  id,
+  name,
+  created_at
+FROM
+  tenants
+WHERE
+  id = @id
+LIMIT 1;
+
+-- name: SelectTenantByName :one
+SELECT
+  id,
+  name,
+  created_at
+FROM
+  tenants
+WHERE
+  name = @name
+LIMIT 1;
+
+-- name: SelectTenants :many
+SELECT
+  id,
+  name,
+  created_at
+FROM
+  tenants
+ORDER BY name;
+
+-- name: InsertAccountTenant :exec
+INSERT INTO account_tenants (
+  account_id,
+  tenant_id,
+  is_active
+)
+VALUES (
+  @account_id,
+  @tenant_id,
+  @is_active
+)
+ON CONFLICT (account_id, tenant_id) DO NOTHING;
+
+-- name: DeleteAccountTenant :execrows
+DELETE FROM account_tenants
+WHERE account_id = @account_id AND tenant_id = @tenant_id;
+
+-- name: SelectAccountTenants :many
+SELECT
+  tenants.id,
+  tenants.name,
+  tenants.created_at,
+  account_tenants.is_active
+FROM
+  account_tenants
+  INNER JOIN tenants ON tenants.id = account_tenants.tenant_id
+WHERE
+  account_tenants.account_id = @account_id
+ORDER BY account_tenants.is_active DESC, account_tenants.created_at;
+
+-- name: UpdateActiveTenant :exec
+UPDATE account_tenants SET
+  is_active = (tenant_id = @tenant_id)
+WHERE account_id = @account_id;
+
+-- name: DeleteAccountRolesByTenant :many
+DELETE FROM account_roles
+USING roles
+WHERE account_roles.role_id = roles.id AND account_roles.account_id = @account_id AND roles.tenant_id = @tenant_id
+RETURNING account_roles.id;
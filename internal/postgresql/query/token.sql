@@ -0,0 +1,45 @@
+-- name: InsertRefreshToken :one
+INSERT INTO refresh_tokens (
+  account_id,
+  family_id,
+  token_hash,
+  expires_at
+)
+VALUES (
+  @account_id,
+  @family_id,
+  @token_hash,
+  @expires_at
+)
+RETURNING id;
+
+-- name: SelectRefreshTokenByHash :one
+SELECT
+  refresh_tokens.id,
+  refresh_tokens.account_id,
+  accounts.username,
+  refresh_tokens.family_id,
+  refresh_tokens.expires_at,
+  refresh_tokens.revoked_at,
+  refresh_tokens.created_at
+FROM
+  refresh_tokens
+  INNER JOIN accounts ON accounts.id = refresh_tokens.account_id
+WHERE
+  refresh_tokens.token_hash = @token_hash
+LIMIT 1;
+
+-- name: RevokeRefreshToken :execrows
+UPDATE refresh_tokens SET
+  revoked_at = now()
+WHERE id = @id AND revoked_at IS NULL;
+
+-- name: RevokeRefreshTokenFamily :exec
+UPDATE refresh_tokens SET
+  revoked_at = now()
+WHERE family_id = @family_id AND revoked_at IS NULL;
+
+-- name: RevokeRefreshTokensByAccount :exec
+UPDATE refresh_tokens SET
+  revoked_at = now()
+WHERE account_id = @account_id AND revoked_at IS NULL;
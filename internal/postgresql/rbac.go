@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"rbac/internal"
+	"time"
 )
 
 type RBAC interface {
@@ -14,23 +15,83 @@ type RBAC interface {
 	ChangePassword(ctx context.Context, username string, password string) error
 	DeleteAccount(ctx context.Context, username string) error
 	AccountByID(ctx context.Context, id string) (internal.Account, error)
+	BlockAccount(ctx context.Context, username string) error
+	UnblockAccount(ctx context.Context, username string) error
+	CreateServiceAccount(ctx context.Context, username string) (internal.Account, error)
+
+	CreateTenant(ctx context.Context, name string, username string, policy internal.Policy, admin string) (string, []internal.PolicyChange, string, error)
+	Tenant(ctx context.Context, id string) (internal.Tenant, error)
+	Tenants(ctx context.Context) ([]internal.Tenant, error)
+	AccountTenants(ctx context.Context, username string) ([]internal.Tenant, error)
+	AddAccountTenant(ctx context.Context, username string, tenantId string) error
+	RemoveAccountTenant(ctx context.Context, username string, tenantId string) ([]string, error)
+	SetActiveTenant(ctx context.Context, username string, tenantId string) error
 
 	CreateRole(ctx context.Context, rolename string) (string, error)
 	Role(ctx context.Context, id string) (internal.Roles, error)
 	UpdateRole(ctx context.Context, id string, rolename string) error
 	DeleteRole(ctx context.Context, id string) error
+	CreateRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) (string, error)
+	DeleteRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) error
+	InheritedRoles(ctx context.Context, roleId string) ([]internal.Roles, error)
+	InheritedRoleIDs(ctx context.Context, roleId string) ([]string, error)
+	InheritedRoleIDsOf(ctx context.Context, roleIds []string) ([]string, error)
+
+	CreateGroup(ctx context.Context, name string) (string, error)
+	Group(ctx context.Context, id string) (internal.Group, error)
+	Groups(ctx context.Context) ([]internal.Group, error)
+	UpdateGroup(ctx context.Context, id string, name string) error
+	DeleteGroup(ctx context.Context, id string) ([]string, error)
+	AddGroupMember(ctx context.Context, groupId string, username string) (internal.GroupMember, error)
+	RemoveGroupMember(ctx context.Context, groupId string, username string) (string, error)
+	GroupMembers(ctx context.Context, groupId string) ([]internal.GroupMember, error)
+	AddMemberGroup(ctx context.Context, groupId string, memberGroupId string) (string, error)
+	RemoveMemberGroup(ctx context.Context, groupId string, memberGroupId string) error
+	MemberGroups(ctx context.Context, groupId string) ([]internal.Group, error)
+	AddGroupRole(ctx context.Context, groupId string, roleId string) (string, error)
+	RemoveGroupRole(ctx context.Context, groupId string, roleId string) error
+	GroupRoles(ctx context.Context, groupId string) ([]internal.Roles, error)
+	GroupRoleIDs(ctx context.Context, username string) ([]string, error)
+	CreateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) (string, error)
+	ExclusiveRoleSet(ctx context.Context, id string) (internal.ExclusiveRoleSet, error)
+	ExclusiveRoleSets(ctx context.Context) ([]internal.ExclusiveRoleSet, error)
+	UpdateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) error
+	DeleteExclusiveRoleSet(ctx context.Context, id string) error
+	RoleConflicts(ctx context.Context) ([]internal.RoleConflict, error)
+	AddRoleApprover(ctx context.Context, roleId string, approverRoleId string) (string, error)
+	RemoveRoleApprover(ctx context.Context, roleId string, approverRoleId string) error
+	RoleApprovers(ctx context.Context, roleId string) ([]internal.Roles, error)
+	CreateAccessRequest(ctx context.Context, username string, roleId string, justification string, expiresAt time.Time) (string, error)
+	AccessRequest(ctx context.Context, id string) (internal.AccessRequest, error)
+	AccessRequests(ctx context.Context) ([]internal.AccessRequest, error)
+	AccountAccessRequests(ctx context.Context, username string) ([]internal.AccessRequest, error)
+	DecideAccessRequest(ctx context.Context, id string, status string, decidedBy string, comment string) error
+	ApproveAccessRequest(ctx context.Context, id string, decidedBy string, comment string) (string, error)
+	SetEmergencyRole(ctx context.Context, roleId string, emergency bool) error
+	IsEmergencyRole(ctx context.Context, roleId string) (bool, error)
+	EmergencyRoles(ctx context.Context) ([]internal.Roles, error)
+	CreateBreakGlassActivation(ctx context.Context, username string, roleId string, reason string, expiresAt time.Time) (string, string, error)
+	BreakGlassActivation(ctx context.Context, id string) (internal.BreakGlassActivation, error)
+	BreakGlassActivations(ctx context.Context, since time.Time) ([]internal.BreakGlassActivation, error)
+	ExportPolicy(ctx context.Context) (internal.Policy, error)
+	ApplyPolicy(ctx context.Context, policy internal.Policy, opts internal.PolicyOptions) ([]internal.PolicyChange, error)
 
-	CreateAccountRole(ctx context.Context, accountId string, roleId string) (string, error)
+	CreateAccountRole(ctx context.Context, accountId string, roleId string, scope internal.Scope, validity internal.Validity) (string, error)
 	AccountRole(ctx context.Context, accountRoleId string) (internal.AccountRoles, error)
 	UpdateAccountRole(ctx context.Context, accountId string, roleId string, id string) error
 	DeleteAccountRole(ctx context.Context, id string) error
+	AccountRolesByAccount(ctx context.Context, username string) ([]internal.AccountRoles, error)
+	DeleteExpiredAccountRoles(ctx context.Context, now time.Time) ([]string, error)
+	CountStartedAccountRoles(ctx context.Context, since time.Time, now time.Time) (int64, error)
 
 	CreateTask(ctx context.Context, taskname string) (string, error)
 	Task(ctx context.Context, id string) (internal.Tasks, error)
 	UpdateTask(ctx context.Context, id string, taskname string) error
 	DeleteTask(ctx context.Context, id string) error
 
-	CreateRoleTasks(ctx context.Context, taskid string, roleid string) (string, error)
+	CreateRoleTasks(ctx context.Context, taskid string, roleid string, scope internal.Scope, condition string, effect string) (string, error)
+	RestrictedGrants(ctx context.Context, username string, tasks []string) ([]internal.RestrictedGrant, error)
+	TaskHolders(ctx context.Context, taskId string) (internal.TaskHolders, error)
 	RoleTask(ctx context.Context, roleTaskId string) (internal.RoleTasks, error)
 	UpdateRoleTask(ctx context.Context, taskId string, roleId string, id string) error
 	DeleteRoleTask(ctx context.Context, id string) error
@@ -49,6 +110,30 @@ type RBAC interface {
 	Navigation(ctx context.Context, id string) (internal.Navigation, error)
 	UpdateNavigation(ctx context.Context, menu internal.Navigation) error
 	DeleteNavigation(ctx context.Context, id string) error
+
+	CreateRefreshToken(ctx context.Context, username string, tokenHash string, expiresAt time.Time) (string, error)
+	RefreshTokenByHash(ctx context.Context, tokenHash string) (internal.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, refreshToken internal.RefreshToken, tokenHash string, expiresAt time.Time) (string, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyId string) error
+	RevokeAccountRefreshTokens(ctx context.Context, username string) error
+
+	CreatePasswordResetToken(ctx context.Context, username string, tokenHash string, expiresAt time.Time) (string, error)
+	ResetPassword(ctx context.Context, tokenHash string, password string) (string, error)
+
+	MFA(ctx context.Context, username string) (internal.MFA, error)
+	EnrollMFA(ctx context.Context, username string, secret string) error
+	ConfirmMFA(ctx context.Context, username string, step int64, recoveryCodeHashes []string) error
+	UseMFAStep(ctx context.Context, username string, step int64) error
+	UseMFARecoveryCode(ctx context.Context, username string, codeHash string) error
+	DeleteMFA(ctx context.Context, username string) error
+	IsMFARequired(ctx context.Context, username string) (bool, error)
+	SetRoleMFARequired(ctx context.Context, roleId string, required bool) error
+
+	CreateAPIKey(ctx context.Context, username string, name string, prefix string, keyHash string, expiresAt time.Time) (internal.APIKey, error)
+	APIKeyByHash(ctx context.Context, keyHash string) (internal.APIKey, error)
+	APIKeysByAccount(ctx context.Context, username string) ([]internal.APIKey, error)
+	TouchAPIKey(ctx context.Context, id string) error
+	RevokeAPIKey(ctx context.Context, username string, id string) error
 }
 
 func NewRBAC(db *sql.DB) RBAC {
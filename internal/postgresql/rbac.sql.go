@@ -5,11 +5,23 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+const blockAccount = `-- name: BlockAccount :exec
+UPDATE accounts SET
+  is_blocked = true
+WHERE username = $1
+`
+
+func (q *Queries) BlockAccount(ctx context.Context, username string) error {
+	_, err := q.db.ExecContext(ctx, blockAccount, username)
+	return err
+}
+
 const changePassword = `-- name: ChangePassword :exec
 UPDATE accounts SET
   hashedpassword = $1
@@ -180,22 +192,41 @@ func (q *Queries) DeleteTask(ctx context.Context, id uuid.UUID) error {
 const insertAccountRole = `-- name: InsertAccountRole :one
 INSERT INTO account_roles (
     account_id,
-    role_id
+    role_id,
+    resource_type,
+    resource_id,
+    valid_from,
+    valid_until
 )
 VALUES (
   $1,
-  $2
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
 )
 RETURNING id
 `
 
 type InsertAccountRoleParams struct {
-	AccountID uuid.UUID
-	RoleID    uuid.UUID
+	AccountID    uuid.UUID
+	RoleID       uuid.UUID
+	ResourceType string
+	ResourceID   string
+	ValidFrom    sql.NullTime
+	ValidUntil   sql.NullTime
 }
 
 func (q *Queries) InsertAccountRole(ctx context.Context, arg InsertAccountRoleParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, insertAccountRole, arg.AccountID, arg.RoleID)
+	row := q.db.QueryRowContext(ctx, insertAccountRole,
+		arg.AccountID,
+		arg.RoleID,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.ValidFrom,
+		arg.ValidUntil,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -231,11 +262,13 @@ func (q *Queries) InsertAccounts(ctx context.Context, arg InsertAccountsParams)
 const insertHelpText = `-- name: InsertHelpText :one
 INSERT INTO helptext (
     task_id,
-    helptext
+    helptext,
+    tenant_id
 )
 VALUES (
   $1,
-  $2
+  $2,
+  $3
 )
 RETURNING id
 `
@@ -243,10 +276,11 @@ RETURNING id
 type InsertHelpTextParams struct {
 	TaskID   uuid.UUID
 	Helptext string
+	TenantID uuid.UUID
 }
 
 func (q *Queries) InsertHelpText(ctx context.Context, arg InsertHelpTextParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, insertHelpText, arg.TaskID, arg.Helptext)
+	row := q.db.QueryRowContext(ctx, insertHelpText, arg.TaskID, arg.Helptext, arg.TenantID)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -255,22 +289,25 @@ func (q *Queries) InsertHelpText(ctx context.Context, arg InsertHelpTextParams)
 const insertMenu = `-- name: InsertMenu :one
 INSERT INTO menu (
     task_id,
-    name
+    name,
+    tenant_id
 )
 VALUES (
   $1,
-  $2
+  $2,
+  $3
 )
 RETURNING id
 `
 
 type InsertMenuParams struct {
-	TaskID uuid.UUID
-	Name   string
+	TaskID   uuid.UUID
+	Name     string
+	TenantID uuid.UUID
 }
 
 func (q *Queries) InsertMenu(ctx context.Context, arg InsertMenuParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, insertMenu, arg.TaskID, arg.Name)
+	row := q.db.QueryRowContext(ctx, insertMenu, arg.TaskID, arg.Name, arg.TenantID)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -279,22 +316,25 @@ func (q *Queries) InsertMenu(ctx context.Context, arg InsertMenuParams) (uuid.UU
 const insertNavigation = `-- name: InsertNavigation :one
 INSERT INTO navigation (
     task_id,
-    name
+    name,
+    tenant_id
 )
 VALUES (
   $1,
-  $2
+  $2,
+  $3
 )
 RETURNING id
 `
 
 type InsertNavigationParams struct {
-	TaskID uuid.UUID
-	Name   string
+	TaskID   uuid.UUID
+	Name     string
+	TenantID uuid.UUID
 }
 
 func (q *Queries) InsertNavigation(ctx context.Context, arg InsertNavigationParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, insertNavigation, arg.TaskID, arg.Name)
+	row := q.db.QueryRowContext(ctx, insertNavigation, arg.TaskID, arg.Name, arg.TenantID)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -345,16 +385,23 @@ func (q *Queries) InsertProfile(ctx context.Context, arg InsertProfileParams) (u
 
 const insertRole = `-- name: InsertRole :one
 INSERT INTO roles (
-    role
+    role,
+    tenant_id
 )
 VALUES (
-  $1
+  $1,
+  $2
 )
 RETURNING id
 `
 
-func (q *Queries) InsertRole(ctx context.Context, role string) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, insertRole, role)
+type InsertRoleParams struct {
+	Role     string
+	TenantID uuid.UUID
+}
+
+func (q *Queries) InsertRole(ctx context.Context, arg InsertRoleParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, insertRole, arg.Role, arg.TenantID)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -363,22 +410,69 @@ func (q *Queries) InsertRole(ctx context.Context, role string) (uuid.UUID, error
 const insertRoleTask = `-- name: InsertRoleTask :one
 INSERT INTO role_tasks (
     task_id,
-    role_id
+    role_id,
+    resource_type,
+    resource_id,
+    condition,
+    effect
 )
 VALUES (
   $1,
-  $2
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
 )
 RETURNING id
 `
 
 type InsertRoleTaskParams struct {
-	TaskID uuid.UUID
-	RoleID uuid.UUID
+	TaskID       uuid.UUID
+	RoleID       uuid.UUID
+	ResourceType string
+	ResourceID   string
+	Condition    string
+	Effect       string
 }
 
 func (q *Queries) InsertRoleTask(ctx context.Context, arg InsertRoleTaskParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, insertRoleTask, arg.TaskID, arg.RoleID)
+	row := q.db.QueryRowContext(ctx, insertRoleTask,
+		arg.TaskID,
+		arg.RoleID,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.Condition,
+		arg.Effect,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertServiceAccount = `-- name: InsertServiceAccount :one
+INSERT INTO accounts (
+  username,
+  hashedpassword,
+  profile,
+  is_service_account
+)
+VALUES (
+  $1,
+  '',
+  $2,
+  true
+)
+RETURNING id
+`
+
+type InsertServiceAccountParams struct {
+	Username string
+	Profile  uuid.UUID
+}
+
+func (q *Queries) InsertServiceAccount(ctx context.Context, arg InsertServiceAccountParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, insertServiceAccount, arg.Username, arg.Profile)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -386,16 +480,23 @@ func (q *Queries) InsertRoleTask(ctx context.Context, arg InsertRoleTaskParams)
 
 const insertTask = `-- name: InsertTask :one
 INSERT INTO tasks (
-    task
+    task,
+    tenant_id
 )
 VALUES (
-  $1
+  $1,
+  $2
 )
 RETURNING id
 `
 
-func (q *Queries) InsertTask(ctx context.Context, task string) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, insertTask, task)
+type InsertTaskParams struct {
+	Task     string
+	TenantID uuid.UUID
+}
+
+func (q *Queries) InsertTask(ctx context.Context, arg InsertTaskParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, insertTask, arg.Task, arg.TenantID)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -406,7 +507,11 @@ SELECT
   id,
   account_id,
   role_id,
-  created_at
+  created_at,
+  resource_type,
+  resource_id,
+  valid_from,
+  valid_until
 FROM
   account_roles
 WHERE
@@ -422,10 +527,62 @@ func (q *Queries) SelectAccountRole(ctx context.Context, id uuid.UUID) (AccountR
 		&i.AccountID,
 		&i.RoleID,
 		&i.CreatedAt,
+		&i.ResourceType,
+		&i.ResourceID,
+		&i.ValidFrom,
+		&i.ValidUntil,
 	)
 	return i, err
 }
 
+const selectAccountRolesByAccount = `-- name: SelectAccountRolesByAccount :many
+SELECT
+  id,
+  account_id,
+  role_id,
+  created_at,
+  resource_type,
+  resource_id,
+  valid_from,
+  valid_until
+FROM
+  account_roles
+WHERE
+  account_id = $1
+`
+
+func (q *Queries) SelectAccountRolesByAccount(ctx context.Context, accountID uuid.UUID) ([]AccountRoles, error) {
+	rows, err := q.db.QueryContext(ctx, selectAccountRolesByAccount, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AccountRoles{}
+	for rows.Next() {
+		var i AccountRoles
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.RoleID,
+			&i.CreatedAt,
+			&i.ResourceType,
+			&i.ResourceID,
+			&i.ValidFrom,
+			&i.ValidUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectAccounts = `-- name: SelectAccounts :one
 SELECT
   id,
@@ -433,6 +590,7 @@ SELECT
   hashedpassword,
   profile,
   is_blocked,
+  is_service_account,
   created_at
 FROM
   accounts
@@ -441,15 +599,26 @@ WHERE
 LIMIT 1
 `
 
-func (q *Queries) SelectAccounts(ctx context.Context, username string) (Accounts, error) {
+type SelectAccountsRow struct {
+	ID               uuid.UUID
+	Username         string
+	Hashedpassword   string
+	Profile          uuid.UUID
+	IsBlocked        bool
+	IsServiceAccount bool
+	CreatedAt        time.Time
+}
+
+func (q *Queries) SelectAccounts(ctx context.Context, username string) (SelectAccountsRow, error) {
 	row := q.db.QueryRowContext(ctx, selectAccounts, username)
-	var i Accounts
+	var i SelectAccountsRow
 	err := row.Scan(
 		&i.ID,
 		&i.Username,
 		&i.Hashedpassword,
 		&i.Profile,
 		&i.IsBlocked,
+		&i.IsServiceAccount,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -462,6 +631,7 @@ SELECT
   hashedpassword,
   profile,
   is_blocked,
+  is_service_account,
   created_at
 FROM
   accounts
@@ -470,15 +640,26 @@ WHERE
 LIMIT 1
 `
 
-func (q *Queries) SelectAccountsById(ctx context.Context, id uuid.UUID) (Accounts, error) {
+type SelectAccountsByIdRow struct {
+	ID               uuid.UUID
+	Username         string
+	Hashedpassword   string
+	Profile          uuid.UUID
+	IsBlocked        bool
+	IsServiceAccount bool
+	CreatedAt        time.Time
+}
+
+func (q *Queries) SelectAccountsById(ctx context.Context, id uuid.UUID) (SelectAccountsByIdRow, error) {
 	row := q.db.QueryRowContext(ctx, selectAccountsById, id)
-	var i Accounts
+	var i SelectAccountsByIdRow
 	err := row.Scan(
 		&i.ID,
 		&i.Username,
 		&i.Hashedpassword,
 		&i.Profile,
 		&i.IsBlocked,
+		&i.IsServiceAccount,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -489,7 +670,8 @@ SELECT
   id,
   task_id,
   helptext,
-  created_at
+  created_at,
+  tenant_id
 FROM
   helptext
 WHERE
@@ -505,6 +687,7 @@ func (q *Queries) SelectHelpText(ctx context.Context, id uuid.UUID) (Helptext, e
 		&i.TaskID,
 		&i.Helptext,
 		&i.CreatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -514,7 +697,8 @@ SELECT
   id,
   task_id,
   helptext,
-  created_at
+  created_at,
+  tenant_id
 FROM
   helptext
 WHERE
@@ -530,6 +714,7 @@ func (q *Queries) SelectHelpTextByTasks(ctx context.Context, taskID uuid.UUID) (
 		&i.TaskID,
 		&i.Helptext,
 		&i.CreatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -539,7 +724,8 @@ SELECT
   id,
   task_id,
   name,
-  created_at
+  created_at,
+  tenant_id
 FROM
   menu
 WHERE
@@ -552,6 +738,7 @@ type SelectMenuRow struct {
 	TaskID    uuid.UUID
 	Name      string
 	CreatedAt time.Time
+	TenantID  uuid.UUID
 }
 
 func (q *Queries) SelectMenu(ctx context.Context, id uuid.UUID) (SelectMenuRow, error) {
@@ -562,6 +749,7 @@ func (q *Queries) SelectMenu(ctx context.Context, id uuid.UUID) (SelectMenuRow,
 		&i.TaskID,
 		&i.Name,
 		&i.CreatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -571,7 +759,8 @@ SELECT
   id,
   task_id,
   name,
-  created_at
+  created_at,
+  tenant_id
 FROM
   menu
 WHERE
@@ -583,6 +772,7 @@ type SelectMenuByTaskRow struct {
 	TaskID    uuid.UUID
 	Name      string
 	CreatedAt time.Time
+	TenantID  uuid.UUID
 }
 
 func (q *Queries) SelectMenuByTask(ctx context.Context, taskID uuid.UUID) ([]SelectMenuByTaskRow, error) {
@@ -599,6 +789,7 @@ func (q *Queries) SelectMenuByTask(ctx context.Context, taskID uuid.UUID) ([]Sel
 			&i.TaskID,
 			&i.Name,
 			&i.CreatedAt,
+			&i.TenantID,
 		); err != nil {
 			return nil, err
 		}
@@ -618,7 +809,8 @@ SELECT
   id,
   task_id,
   name,
-  created_at
+  created_at,
+  tenant_id
 FROM
   navigation
 WHERE
@@ -631,6 +823,7 @@ type SelectNavigationRow struct {
 	TaskID    uuid.UUID
 	Name      string
 	CreatedAt time.Time
+	TenantID  uuid.UUID
 }
 
 func (q *Queries) SelectNavigation(ctx context.Context, id uuid.UUID) (SelectNavigationRow, error) {
@@ -641,6 +834,7 @@ func (q *Queries) SelectNavigation(ctx context.Context, id uuid.UUID) (SelectNav
 		&i.TaskID,
 		&i.Name,
 		&i.CreatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -650,7 +844,8 @@ SELECT
   id,
   task_id,
   name,
-  created_at
+  created_at,
+  tenant_id
 FROM
   navigation
 WHERE
@@ -662,6 +857,7 @@ type SelectNavigationByTaskRow struct {
 	TaskID    uuid.UUID
 	Name      string
 	CreatedAt time.Time
+	TenantID  uuid.UUID
 }
 
 func (q *Queries) SelectNavigationByTask(ctx context.Context, taskID uuid.UUID) ([]SelectNavigationByTaskRow, error) {
@@ -678,6 +874,7 @@ func (q *Queries) SelectNavigationByTask(ctx context.Context, taskID uuid.UUID)
 			&i.TaskID,
 			&i.Name,
 			&i.CreatedAt,
+			&i.TenantID,
 		); err != nil {
 			return nil, err
 		}
@@ -729,7 +926,8 @@ const selectRole = `-- name: SelectRole :one
 SELECT
   id,
   role,
-  created_at
+  created_at,
+  tenant_id
 FROM
   roles
 WHERE
@@ -740,7 +938,12 @@ LIMIT 1
 func (q *Queries) SelectRole(ctx context.Context, id uuid.UUID) (Roles, error) {
 	row := q.db.QueryRowContext(ctx, selectRole, id)
 	var i Roles
-	err := row.Scan(&i.ID, &i.Role, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.TenantID,
+	)
 	return i, err
 }
 
@@ -749,7 +952,11 @@ SELECT
   id,
   task_id,
   role_id,
-  created_at
+  created_at,
+  resource_type,
+  resource_id,
+  condition,
+  effect
 FROM
   role_tasks
 WHERE
@@ -765,6 +972,10 @@ func (q *Queries) SelectRoleTask(ctx context.Context, id uuid.UUID) (RoleTasks,
 		&i.TaskID,
 		&i.RoleID,
 		&i.CreatedAt,
+		&i.ResourceType,
+		&i.ResourceID,
+		&i.Condition,
+		&i.Effect,
 	)
 	return i, err
 }
@@ -773,7 +984,8 @@ const selectTask = `-- name: SelectTask :one
 SELECT
   id,
   task,
-  created_at
+  created_at,
+  tenant_id
 FROM
   tasks
 WHERE
@@ -784,10 +996,26 @@ LIMIT 1
 func (q *Queries) SelectTask(ctx context.Context, id uuid.UUID) (Tasks, error) {
 	row := q.db.QueryRowContext(ctx, selectTask, id)
 	var i Tasks
-	err := row.Scan(&i.ID, &i.Task, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.Task,
+		&i.CreatedAt,
+		&i.TenantID,
+	)
 	return i, err
 }
 
+const unblockAccount = `-- name: UnblockAccount :exec
+UPDATE accounts SET
+  is_blocked = false
+WHERE username = $1
+`
+
+func (q *Queries) UnblockAccount(ctx context.Context, username string) error {
+	_, err := q.db.ExecContext(ctx, unblockAccount, username)
+	return err
+}
+
 const updateAccountRole = `-- name: UpdateAccountRole :exec
 UPDATE account_roles SET
   account_id = $1,
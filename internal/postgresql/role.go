@@ -15,7 +15,14 @@ func (s *Store) CreateRole(ctx context.Context, rolename string) (string, error)
 	defer span.End()
 	var rid string
 	err := s.execTx(ctx, func(q *Queries) error {
-		id, err := q.InsertRole(ctx, rolename)
+		tid, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		id, err := q.InsertRole(ctx, InsertRoleParams{
+			Role:     rolename,
+			TenantID: tid,
+		})
 		if err != nil {
 			return handleError(err, "create role", internal.ErrorCodeUnknown, "")
 		}
@@ -35,12 +42,13 @@ func (s *Store) Role(ctx context.Context, id string) (internal.Roles, error) {
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
-		r, err := q.SelectRole(ctx, rid)
+		r, err := tenantRole(ctx, q, rid, "role not found")
 		if err != nil {
-			return handleError(err, "get role", internal.ErrorCodeInvalidArgument, "role not found")
+			return err
 		}
 		role.Id = r.ID.String()
 		role.Role = r.Role
+		role.TenantId = r.TenantID.String()
 		role.CreatedAt = r.CreatedAt
 		return nil
 	})
@@ -57,6 +65,10 @@ func (s *Store) UpdateRole(ctx context.Context, id string, rolename string) erro
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		_, err = tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
 		err = q.UpdateRole(ctx, UpdateRoleParams{
 			Role: rolename,
 			ID:   rid,
@@ -78,19 +90,60 @@ func (s *Store) DeleteRole(ctx context.Context, id string) error {
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
-		err = q.DeleteAccountRoleByRole(ctx, rid)
-		if err != nil {
-			return handleError(err, "delete account role by role", internal.ErrorCodeUnknown, "")
-		}
-		err = q.DeleteRoleTaskByRole(ctx, rid)
-		if err != nil {
-			return handleError(err, "delete task by role", internal.ErrorCodeUnknown, "")
-		}
-		err = q.DeleteRole(ctx, rid)
+		_, err = tenantRole(ctx, q, rid, "role not found")
 		if err != nil {
-			return handleError(err, "delete role", internal.ErrorCodeUnknown, "")
+			return err
 		}
-		return nil
+		return removeRole(ctx, q, rid)
 	})
 	return err
 }
+
+// removeRole deletes the role along with everything referring to it.
+func removeRole(ctx context.Context, q *Queries, rid uuid.UUID) error {
+	err := q.DeleteAccountRoleByRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete account role by role", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteRoleTaskByRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete task by role", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteGroupRolesByRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete group roles by role", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteExclusiveRoleSetRolesByRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete exclusive roles by role", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteRoleApproversByRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete role approvers by role", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteAccessRequestsByRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete access requests by role", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteEmergencyRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete emergency role", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteBreakGlassActivationsByRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete break glass activations by role", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteRoleInheritanceByRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete role inheritance by role", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteRoleMfaPolicy(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete role mfa policy", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteRole(ctx, rid)
+	if err != nil {
+		return handleError(err, "delete role", internal.ErrorCodeUnknown, "")
+	}
+	return nil
+}
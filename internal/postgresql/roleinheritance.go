@@ -0,0 +1,194 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CreateRoleInheritance makes the role inherit the tasks of the inherited role, edges creating a cycle are
+// refused.
+func (s *Store) CreateRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleInheritance.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var id string
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		iid, err := uuid.Parse(inheritedRoleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		if rid == iid {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "a role can't inherit itself")
+		}
+		role, err := tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		inherited, err := tenantRole(ctx, q, iid, "inherited role not found")
+		if err != nil {
+			return err
+		}
+		if role.TenantID != inherited.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "a role can't inherit a role of another tenant")
+		}
+		// concurrent edges could close a cycle the check below doesn't see
+		err = lockRoleInheritance(ctx, q)
+		if err != nil {
+			return handleError(err, "lock role inheritance", internal.ErrorCodeUnknown, "")
+		}
+		ancestors, err := q.SelectInheritedRoleIds(ctx, iid)
+		if err != nil {
+			return handleError(err, "get inherited roles", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range ancestors {
+			if value == rid {
+				return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "role inheritance would create a cycle")
+			}
+		}
+		eid, err := q.InsertRoleInheritance(ctx, InsertRoleInheritanceParams{
+			RoleID:          rid,
+			InheritedRoleID: iid,
+		})
+		if err != nil {
+			return handleError(err, "create role inheritance", internal.ErrorCodeUnknown, "")
+		}
+		id = eid.String()
+		return nil
+	})
+	return id, err
+}
+
+func (s *Store) DeleteRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleInheritance.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		iid, err := uuid.Parse(inheritedRoleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		_, err = tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		n, err := q.DeleteRoleInheritance(ctx, DeleteRoleInheritanceParams{
+			RoleID:          rid,
+			InheritedRoleID: iid,
+		})
+		if err != nil {
+			return handleError(err, "delete role inheritance", internal.ErrorCodeUnknown, "")
+		}
+		if n == 0 {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "role inheritance not found")
+		}
+		return nil
+	})
+	return err
+}
+
+// InheritedRoles returns the roles directly inherited by the role.
+func (s *Store) InheritedRoles(ctx context.Context, roleId string) ([]internal.Roles, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleInheritance.InheritedRoles")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	roles := []internal.Roles{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		_, err = tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectInheritedRoles(ctx, rid)
+		if err != nil {
+			return handleError(err, "get inherited roles", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			roles = append(roles, internal.Roles{
+				Id:        value.ID.String(),
+				Role:      value.Role,
+				CreatedAt: value.CreatedAt,
+			})
+		}
+		return nil
+	})
+	return roles, err
+}
+
+// InheritedRoleIDs returns the ids of the roles transitively inherited by the role.
+func (s *Store) InheritedRoleIDs(ctx context.Context, roleId string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleInheritance.InheritedRoleIDs")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var ids []string
+	err := s.execTx(ctx, func(q *Queries) error {
+		rid, err := uuid.Parse(roleId)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		_, err = tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		rows, err := q.SelectInheritedRoleIds(ctx, rid)
+		if err != nil {
+			return handleError(err, "get inherited roles", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			ids = append(ids, value.String())
+		}
+		return nil
+	})
+	return ids, err
+}
+
+// InheritedRoleIDsOf returns the ids of every role transitively inherited by one of the roles, resolved in a
+// single query.
+func (s *Store) InheritedRoleIDsOf(ctx context.Context, roleIds []string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleInheritance.InheritedRoleIDsOf")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	ids := []string{}
+	if len(roleIds) == 0 {
+		return ids, nil
+	}
+	err := s.execTx(ctx, func(q *Queries) error {
+		rids := make([]uuid.UUID, len(roleIds))
+		for i, id := range roleIds {
+			rid, err := uuid.Parse(id)
+			if err != nil {
+				return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+			}
+			rids[i] = rid
+		}
+		rows, err := q.SelectInheritedRoleIdsOfRoles(ctx, rids)
+		if err != nil {
+			return handleError(err, "get inherited roles", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			ids = append(ids, value.String())
+		}
+		return nil
+	})
+	return ids, err
+}
+
+// lockRoleInheritance serializes the writers of role_inheritance, sqlc doesn't parse LOCK statements.
+func lockRoleInheritance(ctx context.Context, q *Queries) error {
+	_, err := q.db.ExecContext(ctx, "LOCK TABLE role_inheritance IN SHARE ROW EXCLUSIVE MODE")
+	return err
+}
@@ -9,7 +9,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-func (s *Store) CreateRoleTasks(ctx context.Context, taskid string, roleid string) (string, error) {
+func (s *Store) CreateRoleTasks(ctx context.Context, taskid string, roleid string, scope internal.Scope, condition string, effect string) (string, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleTask.Create")
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
 	defer span.End()
@@ -23,9 +23,24 @@ func (s *Store) CreateRoleTasks(ctx context.Context, taskid string, roleid strin
 		if err != nil {
 			return handleError(err, "parse role id", internal.ErrorCodeInvalidArgument, "")
 		}
+		r, err := tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		t, err := tenantTask(ctx, q, tid, "task not found")
+		if err != nil {
+			return err
+		}
+		if t.TenantID != r.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "task belongs to another tenant")
+		}
 		id, err := q.InsertRoleTask(ctx, InsertRoleTaskParams{
-			RoleID: rid,
-			TaskID: tid,
+			RoleID:       rid,
+			TaskID:       tid,
+			ResourceType: scope.ResourceType,
+			ResourceID:   scope.ResourceId,
+			Condition:    condition,
+			Effect:       effect,
 		})
 		if err != nil {
 			return handleError(err, "create role task", internal.ErrorCodeUnknown, "")
@@ -49,7 +64,17 @@ func (s *Store) RoleTask(ctx context.Context, roleTaskId string) (internal.RoleT
 		if err != nil {
 			return handleError(err, "get role task", internal.ErrorCodeUnknown, "roletask not found")
 		}
+		r, err := tenantRole(ctx, q, rt.RoleID, "roletask not found")
+		if err != nil {
+			return err
+		}
 		roletask.Id = rt.ID.String()
+		roletask.Scope = internal.Scope{
+			ResourceType: rt.ResourceType,
+			ResourceId:   rt.ResourceID,
+		}
+		roletask.Condition = rt.Condition
+		roletask.Effect = rt.Effect
 
 		t, err := q.SelectTask(ctx, rt.TaskID)
 		if err != nil {
@@ -59,17 +84,15 @@ func (s *Store) RoleTask(ctx context.Context, roleTaskId string) (internal.RoleT
 		task := internal.Tasks{
 			Id:        t.ID.String(),
 			Task:      t.Task,
+			TenantId:  t.TenantID.String(),
 			CreatedAt: t.CreatedAt,
 		}
 		roletask.Task = task
 
-		r, err := q.SelectRole(ctx, rt.RoleID)
-		if err != nil {
-			return handleError(err, "get role", internal.ErrorCodeUnknown, "role not found")
-		}
 		role := internal.Roles{
 			Id:        r.ID.String(),
 			Role:      r.Role,
+			TenantId:  r.TenantID.String(),
 			CreatedAt: r.CreatedAt,
 		}
 		roletask.Role = role
@@ -94,6 +117,17 @@ func (s *Store) UpdateRoleTask(ctx context.Context, taskId string, roleId string
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		r, err := tenantRole(ctx, q, rid, "role not found")
+		if err != nil {
+			return err
+		}
+		t, err := tenantTask(ctx, q, tid, "task not found")
+		if err != nil {
+			return err
+		}
+		if t.TenantID != r.TenantID {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "task belongs to another tenant")
+		}
 		err = q.UpdateRoleTask(ctx, UpdateRoleTaskParams{
 			TaskID: tid,
 			RoleID: rid,
@@ -115,6 +149,14 @@ func (s *Store) DeleteRoleTask(ctx context.Context, id string) error {
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		rt, err := q.SelectRoleTask(ctx, rtId)
+		if err != nil {
+			return handleError(err, "get role task", internal.ErrorCodeUnknown, "roletask not found")
+		}
+		_, err = tenantRole(ctx, q, rt.RoleID, "roletask not found")
+		if err != nil {
+			return err
+		}
 		err = q.DeleteRoleTask(ctx, rtId)
 		if err != nil {
 			return handleError(err, "delete role task", internal.ErrorCodeUnknown, "")
@@ -15,7 +15,14 @@ func (s *Store) CreateTask(ctx context.Context, taskname string) (string, error)
 	defer span.End()
 	var tid string
 	err := s.execTx(ctx, func(q *Queries) error {
-		id, err := q.InsertTask(ctx, taskname)
+		tenantId, err := tenantID(ctx, q)
+		if err != nil {
+			return err
+		}
+		id, err := q.InsertTask(ctx, InsertTaskParams{
+			Task:     taskname,
+			TenantID: tenantId,
+		})
 		if err != nil {
 			return handleError(err, "create task", internal.ErrorCodeUnknown, "")
 		}
@@ -34,12 +41,13 @@ func (s *Store) Task(ctx context.Context, id string) (internal.Tasks, error) {
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
-		t, err := q.SelectTask(ctx, tid)
+		t, err := tenantTask(ctx, q, tid, "task not found")
 		if err != nil {
-			return handleError(err, "get task", internal.ErrorCodeUnknown, "task not found")
+			return err
 		}
 		tasks.Id = t.ID.String()
 		tasks.Task = t.Task
+		tasks.TenantId = t.TenantID.String()
 		tasks.CreatedAt = t.CreatedAt
 		return nil
 	})
@@ -54,6 +62,10 @@ func (s *Store) UpdateTask(ctx context.Context, id string, taskname string) erro
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
+		_, err = tenantTask(ctx, q, tid, "task not found")
+		if err != nil {
+			return err
+		}
 		err = q.UpdateTask(ctx, UpdateTaskParams{
 			Task: taskname,
 			ID:   tid,
@@ -75,27 +87,36 @@ func (s *Store) DeleteTask(ctx context.Context, id string) error {
 		if err != nil {
 			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
 		}
-		err = q.DeleteRoleTaskByTask(ctx, tid)
-		if err != nil {
-			return handleError(err, "delete role by task", internal.ErrorCodeUnknown, "")
-		}
-		err = q.DeleteHelpTextByTask(ctx, tid)
-		if err != nil {
-			return handleError(err, "delete helptext by task", internal.ErrorCodeUnknown, "")
-		}
-		err = q.DeleteMenuByTask(ctx, tid)
-		if err != nil {
-			return handleError(err, "delete menu by task", internal.ErrorCodeUnknown, "")
-		}
-		err = q.DeleteNavigationByTask(ctx, tid)
-		if err != nil {
-			return handleError(err, "delete navigation by task", internal.ErrorCodeUnknown, "")
-		}
-		err = q.DeleteTask(ctx, tid)
+		_, err = tenantTask(ctx, q, tid, "task not found")
 		if err != nil {
-			return handleError(err, "delete task", internal.ErrorCodeUnknown, "")
+			return err
 		}
-		return nil
+		return removeTask(ctx, q, tid)
 	})
 	return err
 }
+
+// removeTask deletes the task along with its role tasks, help text, menus and navigations.
+func removeTask(ctx context.Context, q *Queries, tid uuid.UUID) error {
+	err := q.DeleteRoleTaskByTask(ctx, tid)
+	if err != nil {
+		return handleError(err, "delete role by task", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteHelpTextByTask(ctx, tid)
+	if err != nil {
+		return handleError(err, "delete helptext by task", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteMenuByTask(ctx, tid)
+	if err != nil {
+		return handleError(err, "delete menu by task", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteNavigationByTask(ctx, tid)
+	if err != nil {
+		return handleError(err, "delete navigation by task", internal.ErrorCodeUnknown, "")
+	}
+	err = q.DeleteTask(ctx, tid)
+	if err != nil {
+		return handleError(err, "delete task", internal.ErrorCodeUnknown, "")
+	}
+	return nil
+}
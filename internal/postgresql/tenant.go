@@ -0,0 +1,308 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tenantID returns the tenant of the request, the default tenant when the request has none.
+func tenantID(ctx context.Context, q *Queries) (uuid.UUID, error) {
+	if id := internal.TenantFromContext(ctx); id != "" {
+		tid, err := uuid.Parse(id)
+		if err != nil {
+			return uuid.Nil, handleError(err, "parse tenant id", internal.ErrorCodeInvalidArgument, "")
+		}
+		return tid, nil
+	}
+	t, err := q.SelectTenantByName(ctx, internal.DEFAULT_TENANT)
+	if err != nil {
+		return uuid.Nil, handleError(err, "get default tenant", internal.ErrorCodeUnknown, "default tenant not found")
+	}
+	return t.ID, nil
+}
+
+// inTenant returns true when the request acts in the tenant or in none.
+func inTenant(ctx context.Context, tenantId uuid.UUID) bool {
+	id := internal.TenantFromContext(ctx)
+	return id == "" || id == tenantId.String()
+}
+
+// tenantRole returns the role, roles of another tenant than the one of the request are not found.
+func tenantRole(ctx context.Context, q *Queries, id uuid.UUID, notFoundMsg string) (Roles, error) {
+	r, err := q.SelectRole(ctx, id)
+	if err != nil {
+		return Roles{}, handleError(err, "get role", internal.ErrorCodeUnknown, notFoundMsg)
+	}
+	if !inTenant(ctx, r.TenantID) {
+		return Roles{}, internal.NewErrorf(internal.ErrorCodeNotFound, notFoundMsg)
+	}
+	return r, nil
+}
+
+// tenantTask returns the task, tasks of another tenant than the one of the request are not found.
+func tenantTask(ctx context.Context, q *Queries, id uuid.UUID, notFoundMsg string) (Tasks, error) {
+	t, err := q.SelectTask(ctx, id)
+	if err != nil {
+		return Tasks{}, handleError(err, "get task", internal.ErrorCodeUnknown, notFoundMsg)
+	}
+	if !inTenant(ctx, t.TenantID) {
+		return Tasks{}, internal.NewErrorf(internal.ErrorCodeNotFound, notFoundMsg)
+	}
+	return t, nil
+}
+
+// accountTenants returns the ids of the tenants of the account, the active one first, and fails with a not
+// found error when the account doesn't belong to the tenant of the request.
+func accountTenants(ctx context.Context, q *Queries, accountId uuid.UUID) ([]string, error) {
+	rows, err := q.SelectAccountTenants(ctx, accountId)
+	if err != nil {
+		return nil, handleError(err, "get account tenants", internal.ErrorCodeUnknown, "")
+	}
+	member := internal.TenantFromContext(ctx) == ""
+	ids := make([]string, 0, len(rows))
+	for _, value := range rows {
+		member = member || inTenant(ctx, value.ID)
+		ids = append(ids, value.ID.String())
+	}
+	if !member {
+		return nil, internal.NewErrorf(internal.ErrorCodeNotFound, "account not found")
+	}
+	return ids, nil
+}
+
+// CreateTenant creates the tenant, adds the account to it, applies the policy in it and assigns the role
+// named admin of the policy to the account in a single transaction. It returns the id of the tenant, the
+// applied changes and the id of the account role.
+func (s *Store) CreateTenant(ctx context.Context, name string, username string, policy internal.Policy, admin string) (string, []internal.PolicyChange, string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var tid, arid string
+	var changes []internal.PolicyChange
+	err := s.execTx(ctx, func(q *Queries) error {
+		id, err := q.InsertTenant(ctx, name)
+		if err != nil {
+			return handleError(err, "create tenant", internal.ErrorCodeUnknown, "")
+		}
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		err = q.InsertAccountTenant(ctx, InsertAccountTenantParams{
+			AccountID: acc.ID,
+			TenantID:  id,
+		})
+		if err != nil {
+			return handleError(err, "add account tenant", internal.ErrorCodeUnknown, "")
+		}
+		// the rest is done acting in the new tenant
+		tctx := internal.NewTenantContext(ctx, id.String())
+		current, ids, err := loadPolicy(tctx, q, id)
+		if err != nil {
+			return err
+		}
+		changes = internal.DiffPolicy(current, policy, internal.PolicyOptions{})
+		for i := range changes {
+			cid, err := applyPolicyChange(tctx, q, id, ids, changes[i])
+			if err != nil {
+				return err
+			}
+			changes[i].Id = cid.String()
+		}
+		rid, ok := ids.roles[admin]
+		if !ok {
+			return internal.NewErrorf(internal.ErrorCodeUnknown, "role %s not found in the tenant", admin)
+		}
+		aid, err := assignRole(tctx, q, acc.ID, rid, internal.Scope{}, internal.Validity{})
+		if err != nil {
+			return err
+		}
+		tid = id.String()
+		arid = aid.String()
+		return nil
+	})
+	return tid, changes, arid, err
+}
+
+func (s *Store) Tenant(ctx context.Context, id string) (internal.Tenant, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.Tenant")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	tenant := internal.Tenant{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := uuid.Parse(id)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		t, err := q.SelectTenant(ctx, tid)
+		if err != nil {
+			return handleError(err, "get tenant", internal.ErrorCodeUnknown, "tenant not found")
+		}
+		tenant.Id = t.ID.String()
+		tenant.Name = t.Name
+		tenant.CreatedAt = t.CreatedAt
+		return nil
+	})
+	return tenant, err
+}
+
+func (s *Store) Tenants(ctx context.Context) ([]internal.Tenant, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.Tenants")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	tenants := []internal.Tenant{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		rows, err := q.SelectTenants(ctx)
+		if err != nil {
+			return handleError(err, "get tenants", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			tenants = append(tenants, internal.Tenant{
+				Id:        value.ID.String(),
+				Name:      value.Name,
+				CreatedAt: value.CreatedAt,
+			})
+		}
+		return nil
+	})
+	return tenants, err
+}
+
+// AccountTenants returns the tenants of the account, the one it acts in first. Accounts that never switched
+// tenant act in the one they joined first.
+func (s *Store) AccountTenants(ctx context.Context, username string) ([]internal.Tenant, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.AccountTenants")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	tenants := []internal.Tenant{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		rows, err := q.SelectAccountTenants(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "get account tenants", internal.ErrorCodeUnknown, "")
+		}
+		for _, value := range rows {
+			tenants = append(tenants, internal.Tenant{
+				Id:        value.ID.String(),
+				Name:      value.Name,
+				CreatedAt: value.CreatedAt,
+			})
+		}
+		return nil
+	})
+	return tenants, err
+}
+
+func (s *Store) AddAccountTenant(ctx context.Context, username string, tenantId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.AddAccount")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := uuid.Parse(tenantId)
+		if err != nil {
+			return handleError(err, "parse tenant id", internal.ErrorCodeInvalidArgument, "")
+		}
+		_, err = q.SelectTenant(ctx, tid)
+		if err != nil {
+			return handleError(err, "get tenant", internal.ErrorCodeUnknown, "tenant not found")
+		}
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		err = q.InsertAccountTenant(ctx, InsertAccountTenantParams{
+			AccountID: acc.ID,
+			TenantID:  tid,
+		})
+		if err != nil {
+			return handleError(err, "add account tenant", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
+
+// RemoveAccountTenant removes the account from the tenant along with its account roles in the tenant,
+// the ids of the deleted account roles are returned.
+func (s *Store) RemoveAccountTenant(ctx context.Context, username string, tenantId string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.RemoveAccount")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var ids []string
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := uuid.Parse(tenantId)
+		if err != nil {
+			return handleError(err, "parse tenant id", internal.ErrorCodeInvalidArgument, "")
+		}
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		rows, err := q.DeleteAccountRolesByTenant(ctx, DeleteAccountRolesByTenantParams{
+			AccountID: acc.ID,
+			TenantID:  tid,
+		})
+		if err != nil {
+			return handleError(err, "delete account roles by tenant", internal.ErrorCodeUnknown, "")
+		}
+		n, err := q.DeleteAccountTenant(ctx, DeleteAccountTenantParams{
+			AccountID: acc.ID,
+			TenantID:  tid,
+		})
+		if err != nil {
+			return handleError(err, "remove account tenant", internal.ErrorCodeUnknown, "")
+		}
+		if n == 0 {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "account tenant not found")
+		}
+		for _, value := range rows {
+			ids = append(ids, value.String())
+		}
+		return nil
+	})
+	return ids, err
+}
+
+// SetActiveTenant records the tenant the account acts in, the account must belong to it.
+func (s *Store) SetActiveTenant(ctx context.Context, username string, tenantId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.SetActive")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		tid, err := uuid.Parse(tenantId)
+		if err != nil {
+			return handleError(err, "parse tenant id", internal.ErrorCodeInvalidArgument, "")
+		}
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		rows, err := q.SelectAccountTenants(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "get account tenants", internal.ErrorCodeUnknown, "")
+		}
+		member := false
+		for _, value := range rows {
+			member = member || value.ID == tid
+		}
+		if !member {
+			return internal.NewErrorf(internal.ErrorCodeNotFound, "account tenant not found")
+		}
+		err = q.UpdateActiveTenant(ctx, UpdateActiveTenantParams{
+			TenantID:  tid,
+			AccountID: acc.ID,
+		})
+		if err != nil {
+			return handleError(err, "set active tenant", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
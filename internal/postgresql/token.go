@@ -0,0 +1,138 @@
+package postgresql
+
+import (
+	"context"
+	"rbac/internal"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (s *Store) CreateRefreshToken(ctx context.Context, username string, tokenHash string, expiresAt time.Time) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RefreshToken.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var rtid string
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		id, err := q.InsertRefreshToken(ctx, InsertRefreshTokenParams{
+			AccountID: acc.ID,
+			FamilyID:  uuid.New(),
+			TokenHash: tokenHash,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return handleError(err, "create refresh token", internal.ErrorCodeUnknown, "")
+		}
+		rtid = id.String()
+		return nil
+	})
+	return rtid, err
+}
+
+func (s *Store) RefreshTokenByHash(ctx context.Context, tokenHash string) (internal.RefreshToken, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RefreshToken.RefreshTokenByHash")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	refreshToken := internal.RefreshToken{}
+	err := s.execTx(ctx, func(q *Queries) error {
+		rt, err := q.SelectRefreshTokenByHash(ctx, tokenHash)
+		if err != nil {
+			return handleError(err, "get refresh token", internal.ErrorCodeUnknown, "refresh token not found")
+		}
+		refreshToken.Id = rt.ID.String()
+		refreshToken.AccountId = rt.AccountID.String()
+		refreshToken.Username = rt.Username
+		refreshToken.FamilyId = rt.FamilyID.String()
+		refreshToken.ExpiresAt = rt.ExpiresAt
+		if rt.RevokedAt.Valid {
+			refreshToken.RevokedAt = rt.RevokedAt.Time
+		}
+		refreshToken.CreatedAt = rt.CreatedAt
+		return nil
+	})
+	return refreshToken, err
+}
+
+// RotateRefreshToken revokes the refresh token and issues a new one in the same family. It fails with
+// ErrorCodeUnauthorized when the token was already revoked, meaning it is being reused.
+func (s *Store) RotateRefreshToken(ctx context.Context, refreshToken internal.RefreshToken, tokenHash string, expiresAt time.Time) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RefreshToken.Rotate")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var rtid string
+	err := s.execTx(ctx, func(q *Queries) error {
+		id, err := uuid.Parse(refreshToken.Id)
+		if err != nil {
+			return handleError(err, "parse id", internal.ErrorCodeInvalidArgument, "")
+		}
+		aid, err := uuid.Parse(refreshToken.AccountId)
+		if err != nil {
+			return handleError(err, "parse account id", internal.ErrorCodeInvalidArgument, "")
+		}
+		fid, err := uuid.Parse(refreshToken.FamilyId)
+		if err != nil {
+			return handleError(err, "parse family id", internal.ErrorCodeInvalidArgument, "")
+		}
+		rows, err := q.RevokeRefreshToken(ctx, id)
+		if err != nil {
+			return handleError(err, "revoke refresh token", internal.ErrorCodeUnknown, "")
+		}
+		if rows == 0 {
+			return internal.NewErrorf(internal.ErrorCodeUnauthorized, "refresh token already used")
+		}
+		nid, err := q.InsertRefreshToken(ctx, InsertRefreshTokenParams{
+			AccountID: aid,
+			FamilyID:  fid,
+			TokenHash: tokenHash,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return handleError(err, "create refresh token", internal.ErrorCodeUnknown, "")
+		}
+		rtid = nid.String()
+		return nil
+	})
+	return rtid, err
+}
+
+func (s *Store) RevokeRefreshTokenFamily(ctx context.Context, familyId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RefreshToken.RevokeFamily")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		fid, err := uuid.Parse(familyId)
+		if err != nil {
+			return handleError(err, "parse family id", internal.ErrorCodeInvalidArgument, "")
+		}
+		err = q.RevokeRefreshTokenFamily(ctx, fid)
+		if err != nil {
+			return handleError(err, "revoke refresh token family", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
+
+func (s *Store) RevokeAccountRefreshTokens(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RefreshToken.RevokeAccount")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	err := s.execTx(ctx, func(q *Queries) error {
+		acc, err := q.SelectAccounts(ctx, username)
+		if err != nil {
+			return handleError(err, "get account", internal.ErrorCodeUnknown, "account not found")
+		}
+		err = q.RevokeRefreshTokensByAccount(ctx, acc.ID)
+		if err != nil {
+			return handleError(err, "revoke account refresh tokens", internal.ErrorCodeUnknown, "")
+		}
+		return nil
+	})
+	return err
+}
@@ -19,3 +19,18 @@ func (t *RBAC) AccountDeleted(ctx context.Context, id string) error {
 func (t *RBAC) AccountUpdated(ctx context.Context, profile internal.Account) error {
 	return t.publish(ctx, "Account.Updated", internal.EVENT_ACCOUNT_UPDATED, profile)
 }
+
+// Blocked publishes a message indicating a accounts was blocked.
+func (t *RBAC) AccountBlocked(ctx context.Context, account internal.Account) error {
+	return t.publish(ctx, "Account.Blocked", internal.EVENT_ACCOUNT_BLOCKED, account)
+}
+
+// Unblocked publishes a message indicating a accounts was unblocked.
+func (t *RBAC) AccountUnblocked(ctx context.Context, account internal.Account) error {
+	return t.publish(ctx, "Account.Unblocked", internal.EVENT_ACCOUNT_UNBLOCKED, account)
+}
+
+// Locked publishes a message indicating logins were locked after too many failed attempts.
+func (t *RBAC) AccountLocked(ctx context.Context, lockout internal.Lockout) error {
+	return t.publish(ctx, "Account.Locked", internal.EVENT_ACCOUNT_LOCKED, lockout)
+}
@@ -0,0 +1,11 @@
+package rabbitmq
+
+import (
+	"context"
+	"rbac/internal"
+)
+
+// Activated publishes a high priority message indicating an account broke glass.
+func (t *RBAC) BreakGlassActivated(ctx context.Context, activation internal.BreakGlassActivation) error {
+	return t.publishWithPriority(ctx, "BreakGlass.Activated", internal.EVENT_BREAKGLASS_ACTIVATED, activation, 9)
+}
@@ -26,6 +26,12 @@ func NewRBAC(channel *amqp.Channel) (*RBAC, error) {
 }
 
 func (t *RBAC) publish(ctx context.Context, spanName, routingKey string, e interface{}) error {
+	return t.publishWithPriority(ctx, spanName, routingKey, e, 0)
+}
+
+// publishWithPriority publishes the message with an AMQP priority, it only takes effect on queues declared
+// with a maximum priority.
+func (t *RBAC) publishWithPriority(ctx context.Context, spanName, routingKey string, e interface{}, priority uint8) error {
 	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, spanName)
 	defer span.End()
 	span.SetAttributes(
@@ -57,6 +63,7 @@ func (t *RBAC) publish(ctx context.Context, spanName, routingKey string, e inter
 			ContentType: "application/x-encoding-gob", // XXX: We will revisit this in future episodes
 			Body:        b.Bytes(),
 			Timestamp:   time.Now(),
+			Priority:    priority,
 		})
 	if err != nil {
 		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "ch.Publish")
@@ -34,11 +34,62 @@ const (
 	DELETE_ROLE_TASK = "delete role task"
 	LIST_ROLE_TASK   = "list role task"
 
+	REVOKE_SESSION = "revoke session"
+
+	BLOCK_ACCOUNT   = "block account"
+	UNBLOCK_ACCOUNT = "unblock account"
+
+	GET_LOCKOUT   = "get lockout"
+	CLEAR_LOCKOUT = "clear lockout"
+
+	DISABLE_MFA = "disable mfa"
+
+	CREATE_SERVICE_ACCOUNT = "create service account"
+	MANAGE_API_KEY         = "manage api key"
+
+	GET_TOKEN_KEY    = "get token key"
+	ROTATE_TOKEN_KEY = "rotate token key"
+
+	CREATE_TENANT         = "create tenant"
+	LIST_TENANT           = "list tenant"
+	ADD_TENANT_ACCOUNT    = "add tenant account"
+	REMOVE_TENANT_ACCOUNT = "remove tenant account"
+
+	CREATE_GROUP = "create group"
+	GET_GROUP    = "get group"
+	UPDATE_GROUP = "update group"
+	DELETE_GROUP = "delete group"
+	LIST_GROUP   = "list group"
+
+	MANAGE_GROUP_MEMBER = "manage group member"
+	MANAGE_GROUP_ROLE   = "manage group role"
+
+	CHECK_PERMISSION   = "check permission"
+	EXPLAIN_PERMISSION = "explain permission"
+
+	CREATE_EXCLUSIVE_ROLE_SET = "create exclusive role set"
+	GET_EXCLUSIVE_ROLE_SET    = "get exclusive role set"
+	UPDATE_EXCLUSIVE_ROLE_SET = "update exclusive role set"
+	DELETE_EXCLUSIVE_ROLE_SET = "delete exclusive role set"
+	LIST_EXCLUSIVE_ROLE_SET   = "list exclusive role set"
+	LIST_ROLE_CONFLICT        = "list role conflict"
+
+	LIST_ACCESS_REQUEST  = "list access request"
+	MANAGE_ROLE_APPROVER = "manage role approver"
+
+	BREAK_GLASS           = "break glass"
+	MANAGE_EMERGENCY_ROLE = "manage emergency role"
+	LIST_BREAK_GLASS      = "list break glass"
+
 	//events
 	EVENT_ACCOUNT_CREATED = "rbac.accounts.event.created"
 	EVENT_ACCOUNT_UPDATED = "rbac.accounts.event.updated"
 	EVENT_ACCOUNT_DELETED = "rbac.accounts.event.deleted"
 
+	EVENT_ACCOUNT_BLOCKED   = "rbac.accounts.event.blocked"
+	EVENT_ACCOUNT_UNBLOCKED = "rbac.accounts.event.unblocked"
+	EVENT_ACCOUNT_LOCKED    = "rbac.accounts.event.locked"
+
 	EVENT_PROFILE_CREATED = "rbac.profiles.event.created"
 	EVENT_PROFILE_UPDATED = "rbac.profiles.event.updated"
 	EVENT_PROFILE_DELETED = "rbac.profiles.event.deleted"
@@ -58,6 +109,15 @@ const (
 	EVENT_ROLETASK_CREATED = "rbac.roleTasks.event.created"
 	EVENT_ROLETASK_UPDATED = "rbac.roleTasks.event.updated"
 	EVENT_ROLETASK_DELETED = "rbac.roleTasks.event.deleted"
+
+	EVENT_GROUPMEMBER_ADDED   = "rbac.groupMember.event.added"
+	EVENT_GROUPMEMBER_REMOVED = "rbac.groupMember.event.removed"
+
+	EVENT_ACCESSREQUEST_CREATED = "rbac.accessRequest.event.created"
+	EVENT_ACCESSREQUEST_DECIDED = "rbac.accessRequest.event.decided"
+
+	// EVENT_BREAKGLASS_ACTIVATED is published with a high priority, it is meant for alerting.
+	EVENT_BREAKGLASS_ACTIVATED = "rbac.breakGlass.event.activated"
 )
 
 type Profile struct {
@@ -82,7 +142,12 @@ type Account struct {
 	HashedPassword string
 	Profile        Profile
 	IsBlocked      bool
-	CreatedAt      time.Time
+	// IsServiceAccount marks accounts used for machine to machine calls, they have no password and
+	// authenticate with API keys only.
+	IsServiceAccount bool
+	// Tenants are the ids of the tenants the account belongs to.
+	Tenants   []string
+	CreatedAt time.Time
 }
 
 func (a *Account) Validate() error {
@@ -90,9 +155,95 @@ func (a *Account) Validate() error {
 	return nil
 }
 
+// RefreshToken is a long lived, server side token used for issuing new access tokens. Tokens issued by
+// rotating a previous one share the same FamilyId.
+type RefreshToken struct {
+	Id        string
+	AccountId string
+	Username  string
+	FamilyId  string
+	ExpiresAt time.Time
+	RevokedAt time.Time
+	CreatedAt time.Time
+}
+
+// IsRevoked returns true when the refresh token was already rotated or revoked.
+func (rt *RefreshToken) IsRevoked() bool {
+	return !rt.RevokedAt.IsZero()
+}
+
+// IsExpired returns true when the refresh token can no longer be used.
+func (rt *RefreshToken) IsExpired() bool {
+	return time.Now().After(rt.ExpiresAt)
+}
+
+// APIKey is a long lived credential of an account, only a hash of the key is stored, the Prefix is kept
+// to tell keys apart when listing them.
+type APIKey struct {
+	Id         string
+	AccountId  string
+	Username   string
+	Name       string
+	Prefix     string
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+	CreatedAt  time.Time
+}
+
+// IsRevoked returns true when the API key was revoked.
+func (k *APIKey) IsRevoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+// IsExpired returns true when the API key can no longer be used, keys without an expiry never expire.
+func (k *APIKey) IsExpired() bool {
+	return !k.ExpiresAt.IsZero() && time.Now().After(k.ExpiresAt)
+}
+
+// MFA holds the TOTP enrolment of an account.
+type MFA struct {
+	AccountId    string
+	Secret       string
+	LastUsedStep int64
+	ConfirmedAt  time.Time
+	CreatedAt    time.Time
+}
+
+// IsConfirmed returns true once the enrolment was confirmed with a valid code.
+func (m *MFA) IsConfirmed() bool {
+	return !m.ConfirmedAt.IsZero()
+}
+
+// MFAEnrollment is returned when starting a TOTP enrolment, the provisioning URI is meant to be rendered
+// as a QR code.
+type MFAEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// MFAStatus tells whether an account has MFA enabled and whether its roles require it.
+type MFAStatus struct {
+	Enrolled bool
+	Required bool
+}
+
+// Lockout holds the failed login attempts recorded for a username or an IP address.
+type Lockout struct {
+	Key         string
+	Failures    int64
+	LockedUntil time.Time
+}
+
+// IsLocked returns true when logins are currently refused.
+func (l *Lockout) IsLocked() bool {
+	return time.Now().Before(l.LockedUntil)
+}
+
 type Roles struct {
 	Id        string
 	Role      string
+	TenantId  string
 	CreatedAt time.Time
 }
 
@@ -102,9 +253,13 @@ func (r *Roles) Validate() error {
 }
 
 type AccountRoles struct {
-	Id        string
-	Account   Account
-	Role      Roles
+	Id      string
+	Account Account
+	Role    Roles
+	// Scope restricts the role to some resources, scoped account roles are not indexed for search.
+	Scope Scope
+	// Validity bounds when the role applies, expired account roles are deleted by SweepAccountRoles.
+	Validity  Validity
 	CreatedAt time.Time
 }
 
@@ -119,6 +274,7 @@ type Tasks struct {
 	HelpText   HelpText
 	Menu       []Menu
 	Navigation []Navigation
+	TenantId   string
 	CreatedAt  time.Time
 }
 
@@ -127,13 +283,31 @@ func (t *Tasks) Validate() error {
 	return nil
 }
 
+const (
+	EFFECT_ALLOW = "allow"
+	// EFFECT_DENY role tasks deny the task to the accounts holding the role, overriding any grant of it.
+	EFFECT_DENY = "deny"
+)
+
 type RoleTasks struct {
-	Id        string
-	Task      Tasks
-	Role      Roles
+	Id   string
+	Task Tasks
+	Role Roles
+	// Effect is EFFECT_ALLOW or EFFECT_DENY.
+	Effect string
+	// Scope restricts the task to some resources, scoped role tasks are not indexed for search.
+	Scope Scope
+	// Condition is an expression of the condition package the request must satisfy, conditional role tasks
+	// are not indexed for search either.
+	Condition string
 	CreatedAt time.Time
 }
 
+// IsRestricted returns true when the role task only grants its task to some resources or requests.
+func (rt *RoleTasks) IsRestricted() bool {
+	return !rt.Scope.IsGlobal() || rt.Condition != ""
+}
+
 func (rt *RoleTasks) Validate() error {
 	// Todo
 	return nil
@@ -143,6 +317,7 @@ type HelpText struct {
 	Id        string
 	HelpText  string
 	Task_id   string
+	TenantId  string
 	CreatedAt time.Time
 }
 
@@ -155,6 +330,7 @@ type Menu struct {
 	Id        string
 	Name      string
 	Task_id   string
+	TenantId  string
 	CreatedAt time.Time
 }
 
@@ -167,6 +343,7 @@ type Navigation struct {
 	Id        string
 	Name      string
 	Task_id   string
+	TenantId  string
 	CreatedAt time.Time
 }
 
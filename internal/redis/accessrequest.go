@@ -0,0 +1,16 @@
+package redis
+
+import (
+	"context"
+	"rbac/internal"
+)
+
+// Created publishes a message indicating an access request was created.
+func (t *RBAC) AccessRequestCreated(ctx context.Context, request internal.AccessRequest) error {
+	return t.publish(ctx, "AccessRequest.Created", internal.EVENT_ACCESSREQUEST_CREATED, request)
+}
+
+// Decided publishes a message indicating an access request was approved or rejected.
+func (t *RBAC) AccessRequestDecided(ctx context.Context, request internal.AccessRequest) error {
+	return t.publish(ctx, "AccessRequest.Decided", internal.EVENT_ACCESSREQUEST_DECIDED, request)
+}
@@ -0,0 +1,12 @@
+package redis
+
+import (
+	"context"
+	"rbac/internal"
+)
+
+// Activated publishes a message indicating an account broke glass, pub/sub has no priorities so alerting
+// subscribers listen on its channel.
+func (t *RBAC) BreakGlassActivated(ctx context.Context, activation internal.BreakGlassActivation) error {
+	return t.publish(ctx, "BreakGlass.Activated", internal.EVENT_BREAKGLASS_ACTIVATED, activation)
+}
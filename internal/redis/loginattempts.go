@@ -0,0 +1,95 @@
+package redis
+
+import (
+	"context"
+	"rbac/internal"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LoginAttempts represents the repository used for counting failed logins.
+type LoginAttempts struct {
+	client *redis.Client
+}
+
+// NewLoginAttempts instantiates the LoginAttempts repository.
+func NewLoginAttempts(client *redis.Client) *LoginAttempts {
+	return &LoginAttempts{
+		client: client,
+	}
+}
+
+// AddFailure records a failed login for the key, failures are forgotten once window passes without new ones.
+func (l *LoginAttempts) AddFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "LoginAttempts.AddFailure")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "INCR"))
+
+	pipe := l.client.TxPipeline()
+	incr := pipe.Incr(ctx, "loginfailures_"+key)
+	pipe.Expire(ctx, "loginfailures_"+key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "pipe.Exec")
+	}
+	return incr.Val(), nil
+}
+
+// Lock refuses logins for the key until the given time.
+func (l *LoginAttempts) Lock(ctx context.Context, key string, until time.Time) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "LoginAttempts.Lock")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "SET"))
+
+	ttl := time.Until(until)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := l.client.Set(ctx, "loginlocked_"+key, until.UnixNano(), ttl).Err(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Set")
+	}
+	return nil
+}
+
+// Lockout returns the failures and the lock currently recorded for the key.
+func (l *LoginAttempts) Lockout(ctx context.Context, key string) (internal.Lockout, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "LoginAttempts.Lockout")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "MGET"))
+
+	vals, err := l.client.MGet(ctx, "loginfailures_"+key, "loginlocked_"+key).Result()
+	if err != nil {
+		return internal.Lockout{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.MGet")
+	}
+	lockout := internal.Lockout{Key: key}
+	if val, ok := vals[0].(string); ok {
+		lockout.Failures, err = strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return internal.Lockout{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "strconv.ParseInt")
+		}
+	}
+	if val, ok := vals[1].(string); ok {
+		nsec, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return internal.Lockout{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "strconv.ParseInt")
+		}
+		lockout.LockedUntil = time.Unix(0, nsec)
+	}
+	return lockout, nil
+}
+
+// Clear forgets the failures and lifts the lock of the key.
+func (l *LoginAttempts) Clear(ctx context.Context, key string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "LoginAttempts.Clear")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "DEL"))
+
+	if err := l.client.Del(ctx, "loginfailures_"+key, "loginlocked_"+key).Err(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Del")
+	}
+	return nil
+}
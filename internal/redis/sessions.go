@@ -0,0 +1,193 @@
+package redis
+
+import (
+	"context"
+	"rbac/internal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Sessions represents the repository used for keeping track of revoked tokens.
+type Sessions struct {
+	client *redis.Client
+}
+
+// NewSessions instantiates the Sessions repository.
+func NewSessions(client *redis.Client) *Sessions {
+	return &Sessions{
+		client: client,
+	}
+}
+
+// RevokeToken marks the token as revoked until it expires.
+func (s *Sessions) RevokeToken(ctx context.Context, id string, expiresAt time.Time) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.RevokeToken")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "SET"))
+
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := s.client.Set(ctx, "revokedtoken_"+id, 1, ttl).Err(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Set")
+	}
+	return nil
+}
+
+// IsTokenRevoked returns true when the token was revoked.
+func (s *Sessions) IsTokenRevoked(ctx context.Context, id string) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.IsTokenRevoked")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "EXISTS"))
+
+	n, err := s.client.Exists(ctx, "revokedtoken_"+id).Result()
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Exists")
+	}
+	return n > 0, nil
+}
+
+// RevokeAccountTokens revokes every token of the account issued before at.
+func (s *Sessions) RevokeAccountTokens(ctx context.Context, username string, at time.Time) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.RevokeAccountTokens")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "SET"))
+
+	if err := s.client.Set(ctx, "revokedaccount_"+username, at.UnixNano(), 0).Err(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Set")
+	}
+	return nil
+}
+
+// AccountTokensRevokedAt returns the last time every token of the account was revoked, the zero value
+// is returned when that never happened.
+func (s *Sessions) AccountTokensRevokedAt(ctx context.Context, username string) (time.Time, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.AccountTokensRevokedAt")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "GET"))
+
+	val, err := s.client.Get(ctx, "revokedaccount_"+username).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return time.Time{}, nil
+		}
+		return time.Time{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Get")
+	}
+	nsec, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return time.Time{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "strconv.ParseInt")
+	}
+	return time.Unix(0, nsec), nil
+}
+
+// CreateMFAChallenge stores the pending second factor of a login until it expires.
+func (s *Sessions) CreateMFAChallenge(ctx context.Context, id string, username string, expiresAt time.Time) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.CreateMFAChallenge")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "SET"))
+
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := s.client.Set(ctx, "mfachallenge_"+id, username, ttl).Err(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Set")
+	}
+	return nil
+}
+
+// MFAChallenge returns the username of the pending login, an empty string is returned when the challenge
+// does not exist or expired.
+func (s *Sessions) MFAChallenge(ctx context.Context, id string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.MFAChallenge")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "GET"))
+
+	username, err := s.client.Get(ctx, "mfachallenge_"+id).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return "", nil
+		}
+		return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Get")
+	}
+	return username, nil
+}
+
+// DeleteMFAChallenge removes the pending login.
+func (s *Sessions) DeleteMFAChallenge(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.DeleteMFAChallenge")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "DEL"))
+
+	if err := s.client.Del(ctx, "mfachallenge_"+id).Err(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Del")
+	}
+	return nil
+}
+
+// PermissionVersion returns the current permission version stamp, zero when permissions never changed.
+func (s *Sessions) PermissionVersion(ctx context.Context) (int64, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.PermissionVersion")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "GET"))
+
+	version, err := s.client.Get(ctx, "permissionversion").Int64()
+	if err != nil {
+		if err == redis.Nil {
+			return 0, nil
+		}
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Get")
+	}
+	return version, nil
+}
+
+// IncrementPermissionVersion bumps the permission version stamp, returning the new one.
+func (s *Sessions) IncrementPermissionVersion(ctx context.Context) (int64, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.IncrementPermissionVersion")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "INCR"))
+
+	version, err := s.client.Incr(ctx, "permissionversion").Result()
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Incr")
+	}
+	return version, nil
+}
+
+// CachedRoleIDs returns the role ids cached under the key, ok is false when none are.
+func (s *Sessions) CachedRoleIDs(ctx context.Context, key string) ([]string, bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.CachedRoleIDs")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "GET"))
+
+	value, err := s.client.Get(ctx, "roleids_"+key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, false, nil
+		}
+		return nil, false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Get")
+	}
+	if value == "" {
+		return []string{}, true, nil
+	}
+	return strings.Split(value, ","), true, nil
+}
+
+// CacheRoleIDs caches the role ids under the key until expiration elapses.
+func (s *Sessions) CacheRoleIDs(ctx context.Context, key string, ids []string, expiration time.Duration) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Sessions.CacheRoleIDs")
+	defer span.End()
+	span.SetAttributes(semconv.DBSystemRedis, attribute.String("db.statement", "SET"))
+
+	if err := s.client.Set(ctx, "roleids_"+key, strings.Join(ids, ","), expiration).Err(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Set")
+	}
+	return nil
+}
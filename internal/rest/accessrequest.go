@@ -0,0 +1,314 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type AccessRequest struct {
+	Id            string     `json:"id"`
+	Username      string     `json:"username"`
+	Role          SourceRole `json:"role"`
+	Justification string     `json:"justification"`
+	Status        string     `json:"status"`
+	DecidedBy     string     `json:"decided_by,omitempty"`
+	Comment       string     `json:"comment,omitempty"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	DecidedAt     *time.Time `json:"decided_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type CreateAccessRequestRequest struct {
+	RoleId        string `json:"role_id"`
+	Justification string `json:"justification"`
+}
+
+type CreateAccessRequestResponse struct {
+	Message string `json:"message"`
+	Id      string `json:"id"`
+}
+
+type DecideAccessRequestRequest struct {
+	Comment string `json:"comment"`
+}
+
+type AccessRequestResponse struct {
+	Message string `json:"message"`
+}
+
+type GetAccessRequestResponse struct {
+	AccessRequest AccessRequest `json:"access_request"`
+}
+
+type ListAccessRequestResponse struct {
+	AccessRequests []AccessRequest `json:"access_requests"`
+}
+
+type CreateRoleApproverRequest struct {
+	ApproverRoleId string `json:"approver_role_id"`
+}
+
+type CreateRoleApproverResponse struct {
+	Message string `json:"message"`
+	Id      string `json:"id"`
+}
+
+type RoleApproverResponse struct {
+	Message string `json:"message"`
+}
+
+type RoleApproversResponse struct {
+	Roles []Role `json:"roles"`
+}
+
+// createAccessRequest requests a role for the authenticated account.
+func (rb *RBACHandler) createAccessRequest(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	var req CreateAccessRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	id, err := rb.svc.CreateAccessRequest(r.Context(), internal.AccessRequest{
+		Account:       internal.Account{UserName: authusername},
+		Role:          internal.Roles{Id: req.RoleId},
+		Justification: req.Justification,
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create access request failed", err)
+		return
+	}
+	renderResponse(w, &CreateAccessRequestResponse{
+		Message: "Created Successfully",
+		Id:      id,
+	}, http.StatusCreated)
+}
+
+// accessRequest returns the access request to its requester or to accounts allowed to list access requests.
+func (rb *RBACHandler) accessRequest(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	id := mux.Vars(r)["accessRequestId"]
+	ar, err := rb.svc.AccessRequest(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the access request", err)
+		return
+	}
+	if ar.Account.UserName != authusername {
+		allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.LIST_ACCESS_REQUEST)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+			return
+		}
+		if !allowed {
+			renderErrorResponse(r.Context(), w, "user is not allowed", err)
+			return
+		}
+	}
+	renderResponse(w, &GetAccessRequestResponse{
+		AccessRequest: convertAccessRequest(ar),
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) listAccessRequest(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.LIST_ACCESS_REQUEST)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	requests, err := rb.svc.AccessRequests(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the access requests", err)
+		return
+	}
+	renderResponse(w, &ListAccessRequestResponse{
+		AccessRequests: convertAccessRequests(requests),
+	}, http.StatusOK)
+}
+
+// myAccessRequests returns the access requests of the authenticated account.
+func (rb *RBACHandler) myAccessRequests(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	requests, err := rb.svc.AccountAccessRequests(r.Context(), authusername)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the access requests", err)
+		return
+	}
+	renderResponse(w, &ListAccessRequestResponse{
+		AccessRequests: convertAccessRequests(requests),
+	}, http.StatusOK)
+}
+
+// pendingApprovals returns the pending access requests the authenticated account can decide.
+func (rb *RBACHandler) pendingApprovals(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	requests, err := rb.svc.PendingApprovals(r.Context(), authusername)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the pending approvals", err)
+		return
+	}
+	renderResponse(w, &ListAccessRequestResponse{
+		AccessRequests: convertAccessRequests(requests),
+	}, http.StatusOK)
+}
+
+// approveAccessRequest approves the access request, the service checks the account is an approver of the role.
+func (rb *RBACHandler) approveAccessRequest(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	id := mux.Vars(r)["accessRequestId"]
+	var req DecideAccessRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	err := rb.svc.ApproveAccessRequest(r.Context(), authusername, id, req.Comment)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "approve access request failed", err)
+		return
+	}
+	renderResponse(w, &AccessRequestResponse{
+		Message: "Updated Successfully",
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) rejectAccessRequest(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	id := mux.Vars(r)["accessRequestId"]
+	var req DecideAccessRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	err := rb.svc.RejectAccessRequest(r.Context(), authusername, id, req.Comment)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "reject access request failed", err)
+		return
+	}
+	renderResponse(w, &AccessRequestResponse{
+		Message: "Updated Successfully",
+	}, http.StatusCreated)
+}
+
+// createRoleApprover lets the holders of another role approve the access requests for the role.
+func (rb *RBACHandler) createRoleApprover(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.MANAGE_ROLE_APPROVER, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req CreateRoleApproverRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	id, err := rb.svc.AddRoleApprover(r.Context(), roleId, req.ApproverRoleId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create role approver failed", err)
+		return
+	}
+	renderResponse(w, &CreateRoleApproverResponse{
+		Message: "Created Successfully",
+		Id:      id,
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) roleApprovers(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_ROLE, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	roles, err := rb.svc.RoleApprovers(r.Context(), roleId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting role approvers", err)
+		return
+	}
+	res := make([]Role, len(roles))
+	for i, value := range roles {
+		res[i] = Role{
+			Id:        value.Id,
+			Role:      value.Role,
+			CreatedAt: value.CreatedAt,
+		}
+	}
+	renderResponse(w, &RoleApproversResponse{
+		Roles: res,
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) deleteRoleApprover(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	roleId := mux.Vars(r)["roleId"]
+	approverRoleId := mux.Vars(r)["approverRoleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.MANAGE_ROLE_APPROVER, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.RemoveRoleApprover(r.Context(), roleId, approverRoleId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "delete role approver failed", err)
+		return
+	}
+	renderResponse(w, &RoleApproverResponse{
+		Message: "Deleted Successfully",
+	}, http.StatusOK)
+}
+
+func convertAccessRequests(requests []internal.AccessRequest) []AccessRequest {
+	res := make([]AccessRequest, 0, len(requests))
+	for _, value := range requests {
+		res = append(res, convertAccessRequest(value))
+	}
+	return res
+}
+
+func convertAccessRequest(ar internal.AccessRequest) AccessRequest {
+	res := AccessRequest{
+		Id:       ar.Id,
+		Username: ar.Account.UserName,
+		Role: SourceRole{
+			Id:   ar.Role.Id,
+			Role: ar.Role.Role,
+		},
+		Justification: ar.Justification,
+		Status:        ar.Status,
+		DecidedBy:     ar.DecidedBy,
+		Comment:       ar.Comment,
+		ExpiresAt:     ar.ExpiresAt,
+		CreatedAt:     ar.CreatedAt,
+	}
+	if !ar.DecidedAt.IsZero() {
+		decidedAt := ar.DecidedAt
+		res.DecidedAt = &decidedAt
+	}
+	return res
+}
@@ -0,0 +1,270 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+	"time"
+)
+
+func TestAccessRequest_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateAccessRequestReturns("ar1", nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/accessrequests/", &rest.CreateAccessRequestRequest{RoleId: "r1", Justification: "on call"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.CreateAccessRequestResponse{Message: "Created Successfully", Id: "ar1"},
+			target:         &rest.CreateAccessRequestResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				_, request := s.CreateAccessRequestArgsForCall(0)
+				if request.Account.UserName != "admin" || request.Role.Id != "r1" || request.Justification != "on call" {
+					t.Fatalf("unexpected access request %v", request)
+				}
+			},
+		},
+		{
+			name: "ERR: 400",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateAccessRequestReturns("", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the role has no approvers"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/accessrequests/", &rest.CreateAccessRequestRequest{RoleId: "r2", Justification: "on call"}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "create access request failed"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestAccessRequest_Get(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	decidedAt := createdAt.Add(time.Hour)
+	request := internal.AccessRequest{
+		Id:            "ar1",
+		Account:       internal.Account{UserName: "alice"},
+		Role:          internal.Roles{Id: "r1", Role: "PAYMENTS"},
+		Justification: "on call",
+		Status:        internal.ACCESS_REQUEST_APPROVED,
+		DecidedBy:     "admin",
+		Comment:       "ok",
+		ExpiresAt:     createdAt.Add(24 * time.Hour),
+		DecidedAt:     decidedAt,
+		CreatedAt:     createdAt,
+	}
+	expected := rest.AccessRequest{
+		Id:            "ar1",
+		Username:      "alice",
+		Role:          rest.SourceRole{Id: "r1", Role: "PAYMENTS"},
+		Justification: "on call",
+		Status:        internal.ACCESS_REQUEST_APPROVED,
+		DecidedBy:     "admin",
+		Comment:       "ok",
+		ExpiresAt:     createdAt.Add(24 * time.Hour),
+		DecidedAt:     &decidedAt,
+		CreatedAt:     createdAt,
+	}
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.AccessRequestReturns(request, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/accessrequests/ar1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GetAccessRequestResponse{AccessRequest: expected},
+			target:         &rest.GetAccessRequestResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, _, task := s.IsAllowedArgsForCall(0); task != internal.LIST_ACCESS_REQUEST {
+					t.Fatalf("expected task %q, actual %q", internal.LIST_ACCESS_REQUEST, task)
+				}
+			},
+		},
+		{
+			name: "OK: 200 own",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsAllowedReturns(false, nil)
+				s.AccessRequestReturns(internal.AccessRequest{Id: "ar2", Account: internal.Account{UserName: "admin"}, CreatedAt: createdAt}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/accessrequests/ar2", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GetAccessRequestResponse{AccessRequest: rest.AccessRequest{Id: "ar2", Username: "admin", CreatedAt: createdAt}},
+			target:         &rest.GetAccessRequestResponse{},
+		},
+		{
+			name: "ERR: 500 not allowed",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsAllowedReturns(false, nil)
+				s.AccessRequestReturns(request, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/accessrequests/ar1", nil),
+			expectedStatus: http.StatusInternalServerError,
+			expected:       &errorResponse{Error: "internal error"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "OK: 200 me",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.AccountAccessRequestsReturns([]internal.AccessRequest{request}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/accessrequests/me", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.ListAccessRequestResponse{AccessRequests: []rest.AccessRequest{expected}},
+			target:         &rest.ListAccessRequestResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.AccountAccessRequestsArgsForCall(0); username != "admin" {
+					t.Fatalf("expected username %q, actual %q", "admin", username)
+				}
+			},
+		},
+		{
+			name: "OK: 200 approvals",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.PendingApprovalsReturns([]internal.AccessRequest{}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/accessrequests/approvals", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.ListAccessRequestResponse{AccessRequests: []rest.AccessRequest{}},
+			target:         &rest.ListAccessRequestResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.PendingApprovalsArgsForCall(0); username != "admin" {
+					t.Fatalf("expected username %q, actual %q", "admin", username)
+				}
+			},
+		},
+	})
+}
+
+func TestAccessRequest_Decide(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201 approve",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPut, "/v0/accessrequests/ar1/approve", &rest.DecideAccessRequestRequest{Comment: "ok"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.AccessRequestResponse{Message: "Updated Successfully"},
+			target:         &rest.AccessRequestResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				_, approver, id, comment := s.ApproveAccessRequestArgsForCall(0)
+				if approver != "admin" || id != "ar1" || comment != "ok" {
+					t.Fatalf("unexpected approval of %q by %q with %q", id, approver, comment)
+				}
+			},
+		},
+		{
+			name: "ERR: 403 approve",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.ApproveAccessRequestReturns(internal.NewErrorf(internal.ErrorCodeForbidden, "the account is not an approver of the role"))
+			},
+			req:            newRequest(http.MethodPut, "/v0/accessrequests/ar1/approve", &rest.DecideAccessRequestRequest{}),
+			expectedStatus: http.StatusForbidden,
+			expected:       &errorResponse{Error: "approve access request failed"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "ERR: 400 expired",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.ApproveAccessRequestReturns(internal.NewErrorf(internal.ErrorCodeInvalidArgument, "access request is not pending"))
+			},
+			req:            newRequest(http.MethodPut, "/v0/accessrequests/ar1/approve", &rest.DecideAccessRequestRequest{}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "approve access request failed"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "OK: 201 reject",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPut, "/v0/accessrequests/ar1/reject", &rest.DecideAccessRequestRequest{Comment: "not needed"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.AccessRequestResponse{Message: "Updated Successfully"},
+			target:         &rest.AccessRequestResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				_, approver, id, comment := s.RejectAccessRequestArgsForCall(0)
+				if approver != "admin" || id != "ar1" || comment != "not needed" {
+					t.Fatalf("unexpected rejection of %q by %q with %q", id, approver, comment)
+				}
+				if s.ApproveAccessRequestCallCount() != 0 {
+					t.Fatalf("expected the access request not to be approved")
+				}
+			},
+		},
+	})
+}
+
+func TestRoleApprover(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201 add",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.AddRoleApproverReturns("ra1", nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/roles/approvers/r1", &rest.CreateRoleApproverRequest{ApproverRoleId: "r2"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.CreateRoleApproverResponse{Message: "Created Successfully", Id: "ra1"},
+			target:         &rest.CreateRoleApproverResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, roleId, approverRoleId := s.AddRoleApproverArgsForCall(0); roleId != "r1" || approverRoleId != "r2" {
+					t.Fatalf("unexpected approver %q of %q", approverRoleId, roleId)
+				}
+				_, _, task, resource := s.IsAllowedOnArgsForCall(0)
+				if task != internal.MANAGE_ROLE_APPROVER || resource.Id != "r1" {
+					t.Fatalf("expected task %q on %q, actual %q on %q", internal.MANAGE_ROLE_APPROVER, "r1", task, resource.Id)
+				}
+			},
+		},
+		{
+			name: "OK: 200 get",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.RoleApproversReturns([]internal.Roles{{Id: "r2", Role: "MANAGER", CreatedAt: createdAt}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/roles/approvers/r1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.RoleApproversResponse{Roles: []rest.Role{{Id: "r2", Role: "MANAGER", CreatedAt: createdAt}}},
+			target:         &rest.RoleApproversResponse{},
+		},
+		{
+			name: "OK: 200 remove",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/roles/approvers/r1/r2", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.RoleApproverResponse{Message: "Deleted Successfully"},
+			target:         &rest.RoleApproverResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, roleId, approverRoleId := s.RemoveRoleApproverArgsForCall(0); roleId != "r1" || approverRoleId != "r2" {
+					t.Fatalf("unexpected approver %q removed from %q", approverRoleId, roleId)
+				}
+			},
+		},
+	})
+}
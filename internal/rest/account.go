@@ -2,8 +2,10 @@ package rest
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"rbac/internal"
+	"rbac/internal/tokenmaker"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -14,11 +16,39 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 type LoginResponse struct {
-	Message string `json:"message"`
+	Message               string `json:"message"`
+	MFAToken              string `json:"mfa_token,omitempty"`
+	MFAEnrollmentRequired bool   `json:"mfa_enrollment_required,omitempty"`
+	Token                 string `json:"token,omitempty"`
+	RefreshToken          string `json:"refresh_token,omitempty"`
+}
+
+// LogoutRequest carries the refresh token to revoke when it isn't sent as a cookie.
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
 }
 
 func (a *RBACHandler) logout(w http.ResponseWriter, r *http.Request) {
+	token, _ := a.accessToken(r)
+	var req LogoutRequest
+	if c, err := r.Cookie("refresh_token"); err == nil {
+		req.RefreshToken = c.Value
+	} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		// the body is optional, clients without a refresh token only revoke the access token
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	err := a.svc.Logout(r.Context(), token, req.RefreshToken)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "logout failed", err)
+		return
+	}
 	addCookie(w, "token", "")
+	addCookie(w, "refresh_token", "")
+	if a.conf.CSRFProtection {
+		addCSRFCookie(w, "")
+	}
 	renderResponse(w,
 		&LoginResponse{
 			Message: "Logout Succesfully",
@@ -32,23 +62,81 @@ func (a *RBACHandler) login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	defer r.Body.Close()
-	err := a.svc.Login(r.Context(), req.Username, req.Password)
+	err := a.svc.Login(r.Context(), req.Username, req.Password, clientIP(r))
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "login failed", err)
 		return
 	}
-	cookie, err := a.svc.CreateToken(req.Username)
+	status, err := a.svc.MFAStatus(r.Context(), req.Username)
 	if err != nil {
-		renderErrorResponse(r.Context(), w, "token creation failed", err)
+		renderErrorResponse(r.Context(), w, "login failed", err)
+		return
+	}
+	if status.Enrolled || status.Required {
+		mfaToken, err := a.svc.CreateMFAChallenge(r.Context(), req.Username)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "token creation failed", err)
+			return
+		}
+		renderResponse(w,
+			&LoginResponse{
+				Message:               "MFA Required",
+				MFAToken:              mfaToken,
+				MFAEnrollmentRequired: !status.Enrolled,
+			}, http.StatusAccepted)
+		return
+	}
+	token, refreshToken, ok := a.issueTokens(w, r, req.Username)
+	if !ok {
 		return
 	}
-	addCookie(w, "token", cookie)
 	renderResponse(w,
 		&LoginResponse{
-			Message: "Login Succesfully",
+			Message:      "Login Succesfully",
+			Token:        token,
+			RefreshToken: refreshToken,
 		}, http.StatusCreated)
 }
 
+// issueTokens sets the access and refresh token cookies, along with the CSRF cookie when enabled. The tokens
+// are returned when they must be sent in the body too, false is returned when an error was rendered.
+func (a *RBACHandler) issueTokens(w http.ResponseWriter, r *http.Request, username string) (string, string, bool) {
+	token, err := a.svc.CreateToken(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "token creation failed", err)
+		return "", "", false
+	}
+	refreshToken, err := a.svc.CreateRefreshToken(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "token creation failed", err)
+		return "", "", false
+	}
+	if !a.setTokenCookies(w, r, token, refreshToken) {
+		return "", "", false
+	}
+	if !a.conf.TokenInBody {
+		return "", "", true
+	}
+	return token, refreshToken, true
+}
+
+// setTokenCookies sets the access and refresh token cookies and a new CSRF token when enabled, false is
+// returned when an error was rendered.
+func (a *RBACHandler) setTokenCookies(w http.ResponseWriter, r *http.Request, token string, refreshToken string) bool {
+	addCookie(w, "token", token)
+	addCookie(w, "refresh_token", refreshToken)
+	if !a.conf.CSRFProtection {
+		return true
+	}
+	csrf, err := tokenmaker.NewOpaqueToken()
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "token creation failed", err)
+		return false
+	}
+	addCSRFCookie(w, csrf)
+	return true
+}
+
 func (rb *RBACHandler) me(w http.ResponseWriter, r *http.Request) {
 	username := r.Header.Get("username")
 	account, err := rb.svc.Account(r.Context(), username)
@@ -56,33 +144,10 @@ func (rb *RBACHandler) me(w http.ResponseWriter, r *http.Request) {
 		renderErrorResponse(r.Context(), w, "error getting the account", err)
 		return
 	}
-	acr, err := rb.svc.AccountRoleByAccount(r.Context(), username)
-	if err != nil {
-		renderErrorResponse(r.Context(), w, "error getting the role by account", err)
+	roles, ok := rb.accountRoles(w, r, username)
+	if !ok {
 		return
 	}
-	var roles []Role
-	for _, value := range acr.Roles {
-		rt, err := rb.svc.RoleTaskByRole(r.Context(), value.Id)
-		if err != nil {
-			renderErrorResponse(r.Context(), w, "error getting task by role", err)
-			return
-		}
-		var tasks []Task
-		for _, value := range rt.Tasks {
-			tasks = append(tasks, Task{
-				Id:        value.Id,
-				Task:      value.Task,
-				CreatedAt: value.CreatedAt,
-			})
-		}
-		roles = append(roles, Role{
-			Id:        rt.Role.Id,
-			Role:      rt.Role.Role,
-			Task:      tasks,
-			CreatedAt: rt.Role.CreatedAt,
-		})
-	}
 	profile := Profile{
 		Id:                account.Profile.Id,
 		ProfileBackground: account.Profile.Profile_Background,
@@ -172,7 +237,7 @@ type ReadAccountResponse struct {
 func (rb *RBACHandler) account(w http.ResponseWriter, r *http.Request) {
 	authusername := r.Header.Get("username")
 	username := mux.Vars(r)["username"]
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_ACCOUNT)
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_ACCOUNT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -223,10 +288,11 @@ func (rb *RBACHandler) account(w http.ResponseWriter, r *http.Request) {
 			})
 		}
 		roles = append(roles, Role{
-			Id:        rt.Role.Id,
-			Role:      rt.Role.Role,
-			Task:      tasks,
-			CreatedAt: rt.Role.CreatedAt,
+			Id:         rt.Role.Id,
+			Role:       rt.Role.Role,
+			Task:       tasks,
+			DeniedTask: deniedTasks(rt),
+			CreatedAt:  rt.Role.CreatedAt,
 		})
 	}
 	renderResponse(w, &ReadAccountResponse{
@@ -317,7 +383,7 @@ func (rb *RBACHandler) updateProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	authusername := r.Header.Get("username")
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.UPDATE_ACCOUNT)
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.UPDATE_ACCOUNT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: req.Username})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -357,7 +423,7 @@ func (rb *RBACHandler) changePassword(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	authusername := r.Header.Get("username")
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.UPDATE_ACCOUNT)
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.UPDATE_ACCOUNT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: req.Username})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -384,7 +450,7 @@ type DeleteAccountResponse struct {
 func (rb *RBACHandler) deleteAccount(w http.ResponseWriter, r *http.Request) {
 	authusername := r.Header.Get("username")
 	username := mux.Vars(r)["username"]
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.DELETE_ACCOUNT)
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.DELETE_ACCOUNT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -417,7 +483,7 @@ type AccountRoleByAccount struct {
 func (rb *RBACHandler) getAccountRoleByAccount(w http.ResponseWriter, r *http.Request) {
 	authusername := r.Header.Get("username")
 	username := mux.Vars(r)["username"]
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_ACCOUNT)
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_ACCOUNT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -447,35 +513,90 @@ func (rb *RBACHandler) getAccountRoleByAccount(w http.ResponseWriter, r *http.Re
 		Profile:   prof,
 		CreatedAt: la.Account.CreatedAt,
 	}
-	roles := []Role{}
-	for _, value := range la.Roles {
-		// rl, err := rb.svc.Role(r.Context(), value.Id)
-		// if err != nil {
-		// 	renderErrorResponse(r.Context(), w, "error getting role", err)
-		// 	return
-		// }
-		rt, err := rb.svc.RoleTaskByRole(r.Context(), value.Id)
-		if err != nil {
-			renderErrorResponse(r.Context(), w, "error getting task by role", err)
-			return
-		}
-		var tasks []Task
-		for _, value := range rt.Tasks {
-			tasks = append(tasks, Task{
-				Id:        value.Id,
-				Task:      value.Task,
-				CreatedAt: value.CreatedAt,
-			})
-		}
-		roles = append(roles, Role{
-			Id:        rt.Role.Id,
-			Role:      rt.Role.Role,
-			Task:      tasks,
-			CreatedAt: rt.Role.CreatedAt,
-		})
+	roles, ok := rb.accountRoles(w, r, username)
+	if !ok {
+		return
 	}
 	renderResponse(w, &AccountRoleByAccount{
 		Account: acc,
 		Roles:   roles,
 	}, http.StatusOK)
 }
+
+type RevokeSessionsResponse struct {
+	Message string `json:"message"`
+}
+
+func (rb *RBACHandler) revokeSessions(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.REVOKE_SESSION, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed && authusername != username {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.RevokeSessions(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error revoking sessions", err)
+		return
+	}
+	renderResponse(w, &RevokeSessionsResponse{
+		Message: "Sessions Revoked Successfully",
+	}, http.StatusOK)
+}
+
+type BlockAccountResponse struct {
+	Message string `json:"message"`
+}
+
+func (rb *RBACHandler) blockAccount(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.BLOCK_ACCOUNT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.BlockAccount(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error blocking the account", err)
+		return
+	}
+	renderResponse(w, &BlockAccountResponse{
+		Message: "Blocked Successfully",
+	}, http.StatusOK)
+}
+
+type UnblockAccountResponse struct {
+	Message string `json:"message"`
+}
+
+func (rb *RBACHandler) unblockAccount(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.UNBLOCK_ACCOUNT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.UnblockAccount(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error unblocking the account", err)
+		return
+	}
+	renderResponse(w, &UnblockAccountResponse{
+		Message: "Unblocked Successfully",
+	}, http.StatusOK)
+}
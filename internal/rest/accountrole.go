@@ -10,14 +10,33 @@ import (
 )
 
 type AccountRole struct {
-	Id        string    `json:"id"`
-	Account   Account   `json:"account"`
-	Role      Role      `json:"role"`
-	CreatedAt time.Time `json:"created_at"`
+	Id         string     `json:"id"`
+	Account    Account    `json:"account"`
+	Role       Role       `json:"role"`
+	ValidFrom  *time.Time `json:"valid_from,omitempty"`
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
 }
+
+// setValidity sets the bounds of the validity that are set.
+func (ar *AccountRole) setValidity(v internal.Validity) {
+	if !v.From.IsZero() {
+		ar.ValidFrom = &v.From
+	}
+	if !v.Until.IsZero() {
+		ar.ValidUntil = &v.Until
+	}
+}
+
 type CreateAccountRoleRequest struct {
 	AccountId string `json:"account_id"`
 	RoleId    string `json:"role_id"`
+	// ResourceType and ResourceId restrict the role to matching resources, e.g. "account" and "dept-x-*".
+	ResourceType string `json:"resource_type"`
+	ResourceId   string `json:"resource_id"`
+	// ValidFrom and ValidUntil bound when the role applies, it starts right away and never expires when omitted.
+	ValidFrom  time.Time `json:"valid_from"`
+	ValidUntil time.Time `json:"valid_until"`
 }
 type AccountRoleResponse struct {
 	Message string `json:"message"`
@@ -47,6 +66,14 @@ func (rb *RBACHandler) createAccountRole(w http.ResponseWriter, r *http.Request)
 		Role: internal.Roles{
 			Id: req.RoleId,
 		},
+		Scope: internal.Scope{
+			ResourceType: req.ResourceType,
+			ResourceId:   req.ResourceId,
+		},
+		Validity: internal.Validity{
+			From:  req.ValidFrom,
+			Until: req.ValidUntil,
+		},
 	})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "create accountrole failed", err)
@@ -112,13 +139,15 @@ func (rb *RBACHandler) accountRole(w http.ResponseWriter, r *http.Request) {
 		Role:      rl.Role,
 		CreatedAt: rl.CreatedAt,
 	}
+	res := AccountRole{
+		Id:        accountRole.Id,
+		Account:   account,
+		Role:      role,
+		CreatedAt: accountRole.CreatedAt,
+	}
+	res.setValidity(accountRole.Validity)
 	renderResponse(w, &GetAccountRoleResponse{
-		AccountRole: AccountRole{
-			Id:        accountRole.Id,
-			Account:   account,
-			Role:      role,
-			CreatedAt: accountRole.CreatedAt,
-		},
+		AccountRole: res,
 	}, http.StatusOK)
 }
 
@@ -233,12 +262,14 @@ func (rb *RBACHandler) listAccountRole(w http.ResponseWriter, r *http.Request) {
 			Role:      rl.Role,
 			CreatedAt: rl.CreatedAt,
 		}
-		acRoles = append(acRoles, AccountRole{
+		acRole := AccountRole{
 			Id:        value.Id,
 			Account:   account,
 			Role:      role,
 			CreatedAt: value.CreatedAt,
-		})
+		}
+		acRole.setValidity(value.Validity)
+		acRoles = append(acRoles, acRole)
 	}
 	renderResponse(w, &ListAccountRoleResponse{
 		AccoutRoles: acRoles,
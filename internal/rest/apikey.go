@@ -0,0 +1,177 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type CreateServiceAccountRequest struct {
+	Username string `json:"username"`
+}
+
+type CreateServiceAccountResponse struct {
+	Message string `json:"message"`
+	Id      string `json:"id"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string    `json:"name"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type APIKey struct {
+	Id         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreateAPIKeyResponse struct {
+	Message string `json:"message"`
+	// Key is only returned here, it can't be read again.
+	Key    string `json:"key"`
+	APIKey APIKey `json:"api_key"`
+}
+
+type APIKeysResponse struct {
+	APIKeys []APIKey `json:"api_keys"`
+}
+
+type RevokeAPIKeyResponse struct {
+	Message string `json:"message"`
+}
+
+func (rb *RBACHandler) createServiceAccount(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.CREATE_SERVICE_ACCOUNT)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req CreateServiceAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	acc, err := rb.svc.CreateServiceAccount(r.Context(), req.Username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error creating service account", err)
+		return
+	}
+	renderResponse(w, &CreateServiceAccountResponse{
+		Message: "Service Account Created Successfully",
+		Id:      acc.Id,
+	}, http.StatusCreated)
+}
+
+// createAPIKey issues an API key, accounts can manage their own keys, the MANAGE_API_KEY task is needed
+// for the keys of other accounts.
+func (rb *RBACHandler) createAPIKey(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.MANAGE_API_KEY, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed && authusername != username {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	key, apiKey, err := rb.svc.CreateAPIKey(r.Context(), username, req.Name, req.ExpiresAt)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error creating api key", err)
+		return
+	}
+	renderResponse(w, &CreateAPIKeyResponse{
+		Message: "API Key Created Successfully",
+		Key:     key,
+		APIKey:  convertAPIKey(apiKey),
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) apiKeys(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.MANAGE_API_KEY, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed && authusername != username {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	keys, err := rb.svc.APIKeys(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting api keys", err)
+		return
+	}
+	res := make([]APIKey, len(keys))
+	for i, k := range keys {
+		res[i] = convertAPIKey(k)
+	}
+	renderResponse(w, &APIKeysResponse{
+		APIKeys: res,
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) revokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	id := mux.Vars(r)["apiKeyId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.MANAGE_API_KEY, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed && authusername != username {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.RevokeAPIKey(r.Context(), username, id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error revoking api key", err)
+		return
+	}
+	renderResponse(w, &RevokeAPIKeyResponse{
+		Message: "API Key Revoked Successfully",
+	}, http.StatusOK)
+}
+
+func convertAPIKey(k internal.APIKey) APIKey {
+	res := APIKey{
+		Id:        k.Id,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		CreatedAt: k.CreatedAt,
+	}
+	if !k.ExpiresAt.IsZero() {
+		res.ExpiresAt = &k.ExpiresAt
+	}
+	if !k.LastUsedAt.IsZero() {
+		res.LastUsedAt = &k.LastUsedAt
+	}
+	if !k.RevokedAt.IsZero() {
+		res.RevokedAt = &k.RevokedAt
+	}
+	return res
+}
@@ -0,0 +1,195 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+	"time"
+)
+
+func TestServiceAccount_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateServiceAccountReturns(internal.Account{Id: "a1", UserName: "ci"}, nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/accounts/service", &rest.CreateServiceAccountRequest{Username: "ci"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.CreateServiceAccountResponse{Message: "Service Account Created Successfully", Id: "a1"},
+			target:         &rest.CreateServiceAccountResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, _, task := s.IsAllowedArgsForCall(0); task != internal.CREATE_SERVICE_ACCOUNT {
+					t.Fatalf("expected task %q, actual %q", internal.CREATE_SERVICE_ACCOUNT, task)
+				}
+			},
+		},
+		{
+			name: "ERR: 400",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateServiceAccountReturns(internal.Account{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "username is required"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/accounts/service", &rest.CreateServiceAccountRequest{}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "error creating service account"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestAPIKey_Post(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201 own key",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsAllowedOnReturns(false, nil)
+				s.CreateAPIKeyReturns("rbac_key", internal.APIKey{Id: "k1", Name: "ci", Prefix: "rbac_", ExpiresAt: expiresAt, CreatedAt: createdAt}, nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/accounts/apikeys/admin", &rest.CreateAPIKeyRequest{Name: "ci", ExpiresAt: expiresAt}),
+			expectedStatus: http.StatusCreated,
+			expected: &rest.CreateAPIKeyResponse{
+				Message: "API Key Created Successfully",
+				Key:     "rbac_key",
+				APIKey:  rest.APIKey{Id: "k1", Name: "ci", Prefix: "rbac_", ExpiresAt: &expiresAt, CreatedAt: createdAt},
+			},
+			target: &rest.CreateAPIKeyResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				_, username, name, at := s.CreateAPIKeyArgsForCall(0)
+				if username != "admin" || name != "ci" || !at.Equal(expiresAt) {
+					t.Fatalf("unexpected api key %q of %q expiring at %s", name, username, at)
+				}
+			},
+		},
+		{
+			name: "ERR: 500 key of another account",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsAllowedOnReturns(false, nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/accounts/apikeys/alice", &rest.CreateAPIKeyRequest{Name: "ci"}),
+			expectedStatus: http.StatusInternalServerError,
+			expected:       &errorResponse{Error: "internal error"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.CreateAPIKeyCallCount() != 0 {
+					t.Fatalf("expected no api key to be created")
+				}
+			},
+		},
+	})
+}
+
+func TestAPIKey_Get(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.APIKeysReturns([]internal.APIKey{{Id: "k1", Name: "ci", Prefix: "rbac_", CreatedAt: createdAt}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/accounts/apikeys/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.APIKeysResponse{
+				APIKeys: []rest.APIKey{{Id: "k1", Name: "ci", Prefix: "rbac_", CreatedAt: createdAt}},
+			},
+			target: &rest.APIKeysResponse{},
+		},
+	})
+}
+
+func TestAPIKey_Delete(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/accounts/apikeys/alice/k1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.RevokeAPIKeyResponse{Message: "API Key Revoked Successfully"},
+			target:         &rest.RevokeAPIKeyResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username, id := s.RevokeAPIKeyArgsForCall(0); username != "alice" || id != "k1" {
+					t.Fatalf("unexpected revocation of %q of %q", id, username)
+				}
+			},
+		},
+		{
+			name: "ERR: 404",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.RevokeAPIKeyReturns(internal.NewErrorf(internal.ErrorCodeNotFound, "api key not found"))
+			},
+			req:            newRequest(http.MethodDelete, "/v0/accounts/apikeys/alice/k2", nil),
+			expectedStatus: http.StatusNotFound,
+			expected:       &errorResponse{Error: "error revoking api key"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestAPIKey_Authentication(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.AuthenticateAPIKeyReturns("ci", nil)
+				s.ActiveTenantReturns(internal.Tenant{Id: "t1"}, nil)
+				s.IsAllowedOnReturns(true, nil)
+			},
+			req: func() *http.Request {
+				req := newRequest(http.MethodGet, "/v0/accounts/apikeys/ci", nil)
+				req.Header.Set("Authorization", "ApiKey rbac_key")
+				return req
+			}(),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.APIKeysResponse{APIKeys: []rest.APIKey{}},
+			target:         &rest.APIKeysResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, key := s.AuthenticateAPIKeyArgsForCall(0); key != "rbac_key" {
+					t.Fatalf("expected key %q, actual %q", "rbac_key", key)
+				}
+				if s.VerifyTokenCallCount() != 0 {
+					t.Fatalf("expected the api key not to be verified as a token")
+				}
+				if _, username, _, _ := s.IsAllowedOnArgsForCall(0); username != "ci" {
+					t.Fatalf("expected username %q, actual %q", "ci", username)
+				}
+			},
+		},
+		{
+			name: "ERR: 401",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.AuthenticateAPIKeyReturns("", internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid api key"))
+			},
+			req: func() *http.Request {
+				req := newRequest(http.MethodGet, "/v0/accounts/apikeys/ci", nil)
+				req.Header.Set("Authorization", "ApiKey revoked")
+				return req
+			}(),
+			expectedStatus: http.StatusUnauthorized,
+			expected:       &errorResponse{Error: "invalid api key"},
+			target:         &errorResponse{},
+		},
+	})
+}
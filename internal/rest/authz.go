@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"rbac/internal"
+	"time"
+)
+
+type Resource struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+// PermissionCheck asks about the subject, ip and time are the ones of the subject the conditions of the
+// grants are evaluated against.
+type PermissionCheck struct {
+	Subject  string     `json:"subject"`
+	Task     string     `json:"task"`
+	Resource *Resource  `json:"resource,omitempty"`
+	IP       string     `json:"ip,omitempty"`
+	Time     *time.Time `json:"time,omitempty"`
+}
+
+// PermissionDecision is refused with an error when the check couldn't be decided.
+type PermissionDecision struct {
+	Subject  string    `json:"subject"`
+	Task     string    `json:"task"`
+	Resource *Resource `json:"resource,omitempty"`
+	Allowed  bool      `json:"allowed"`
+	Error    string    `json:"error,omitempty"`
+}
+
+type CheckPermissionsRequest struct {
+	Checks []PermissionCheck `json:"checks"`
+}
+
+type CheckPermissionsResponse struct {
+	Decisions []PermissionDecision `json:"decisions"`
+}
+
+// checkPermissions decides a batch of subject and task checks for other services, the decisions are
+// returned in the order of the checks.
+func (rb *RBACHandler) checkPermissions(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.CHECK_PERMISSION)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req CheckPermissionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	checks := make([]internal.PermissionCheck, len(req.Checks))
+	for i, value := range req.Checks {
+		checks[i] = internal.PermissionCheck{
+			Subject: value.Subject,
+			Task:    value.Task,
+			IP:      value.IP,
+		}
+		if value.Time != nil {
+			checks[i].Time = *value.Time
+		}
+		if value.Resource != nil {
+			checks[i].Resource = &internal.Resource{
+				Type: value.Resource.Type,
+				Id:   value.Resource.Id,
+			}
+		}
+	}
+	decisions, err := rb.svc.CheckPermissions(r.Context(), checks)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "check permissions failed", err)
+		return
+	}
+	res := make([]PermissionDecision, len(decisions))
+	for i, value := range decisions {
+		res[i] = PermissionDecision{
+			Subject:  value.Subject,
+			Task:     value.Task,
+			Resource: req.Checks[i].Resource,
+			Allowed:  value.Allowed,
+			Error:    decisionError(value.Err),
+		}
+	}
+	renderResponse(w, &CheckPermissionsResponse{
+		Decisions: res,
+	}, http.StatusOK)
+}
+
+// decisionError returns the message of the error of a decision, only invalid checks are told why.
+func decisionError(err error) string {
+	if err == nil {
+		return ""
+	}
+	var ierr *internal.Error
+	if errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeInvalidArgument {
+		return ierr.Error()
+	}
+	return "internal error"
+}
@@ -0,0 +1,76 @@
+package rest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+	"time"
+)
+
+func TestCheckPermissions_Post(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CheckPermissionsCalls(func(_ context.Context, checks []internal.PermissionCheck) ([]internal.PermissionDecision, error) {
+					return []internal.PermissionDecision{
+						{PermissionCheck: checks[0], Allowed: true},
+						{PermissionCheck: checks[1]},
+						{PermissionCheck: checks[2], Err: internal.NewErrorf(internal.ErrorCodeInvalidArgument, "task is required")},
+						{PermissionCheck: checks[3], Err: errors.New("search error")},
+					}, nil
+				})
+			},
+			req: newRequest(http.MethodPost, "/v0/authz/check", &rest.CheckPermissionsRequest{
+				Checks: []rest.PermissionCheck{
+					{Subject: "alice", Task: "document.read", Resource: &rest.Resource{Type: "document", Id: "d1"}, IP: "10.0.0.1", Time: &at},
+					{Subject: "alice", Task: "document.write"},
+					{Subject: "bob"},
+					{Subject: "carol", Task: "document.read"},
+				},
+			}),
+			expectedStatus: http.StatusOK,
+			expected: &rest.CheckPermissionsResponse{
+				Decisions: []rest.PermissionDecision{
+					{Subject: "alice", Task: "document.read", Resource: &rest.Resource{Type: "document", Id: "d1"}, Allowed: true},
+					{Subject: "alice", Task: "document.write"},
+					{Subject: "bob", Error: "task is required"},
+					{Subject: "carol", Task: "document.read", Error: "internal error"},
+				},
+			},
+			target: &rest.CheckPermissionsResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				_, checks := s.CheckPermissionsArgsForCall(0)
+				if checks[0].IP != "10.0.0.1" || !checks[0].Time.Equal(at) {
+					t.Fatalf("expected the ip and time of the subject, actual %q at %s", checks[0].IP, checks[0].Time)
+				}
+				if checks[0].Resource == nil || checks[0].Resource.Id != "d1" {
+					t.Fatalf("expected resource %q, actual %v", "d1", checks[0].Resource)
+				}
+				if !checks[1].Time.IsZero() || checks[1].Resource != nil {
+					t.Fatalf("expected neither time nor resource, actual %s and %v", checks[1].Time, checks[1].Resource)
+				}
+			},
+		},
+		{
+			name: "ERR: 400 too many checks",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CheckPermissionsReturns(nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "at most %d checks can be sent at once", internal.MAX_PERMISSION_CHECKS))
+			},
+			req:            newRequest(http.MethodPost, "/v0/authz/check", &rest.CheckPermissionsRequest{}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "check permissions failed"},
+			target:         &errorResponse{},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+)
+
+func TestLogin_Blocked(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "ERR: 401",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.LoginReturns(internal.NewErrorf(internal.ErrorCodeUnauthorized, "account is blocked"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/login", &rest.LoginRequest{Username: "alice", Password: "secret"}),
+			expectedStatus: http.StatusUnauthorized,
+			expected:       &errorResponse{Error: "login failed"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.CreateTokenCallCount() != 0 {
+					t.Fatalf("expected no token to be issued")
+				}
+			},
+		},
+	})
+}
+
+func TestBlockAccount_Put(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPut, "/v0/accounts/block/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.BlockAccountResponse{Message: "Blocked Successfully"},
+			target:         &rest.BlockAccountResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.BlockAccountArgsForCall(0); username != "alice" {
+					t.Fatalf("expected username %q, actual %q", "alice", username)
+				}
+				_, _, task, resource := s.IsAllowedOnArgsForCall(0)
+				if task != internal.BLOCK_ACCOUNT || resource.Id != "alice" {
+					t.Fatalf("expected task %q on %q, actual %q on %q", internal.BLOCK_ACCOUNT, "alice", task, resource.Id)
+				}
+			},
+		},
+		{
+			name: "ERR: 404",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.BlockAccountReturns(internal.NewErrorf(internal.ErrorCodeNotFound, "account not found"))
+			},
+			req:            newRequest(http.MethodPut, "/v0/accounts/block/bob", nil),
+			expectedStatus: http.StatusNotFound,
+			expected:       &errorResponse{Error: "error blocking the account"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "ERR: 500 not allowed",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsAllowedOnReturns(false, nil)
+			},
+			req:            newRequest(http.MethodPut, "/v0/accounts/block/alice", nil),
+			expectedStatus: http.StatusInternalServerError,
+			expected:       &errorResponse{Error: "internal error"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.BlockAccountCallCount() != 0 {
+					t.Fatalf("expected the account not to be blocked")
+				}
+			},
+		},
+	})
+}
+
+func TestUnblockAccount_Put(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPut, "/v0/accounts/unblock/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.UnblockAccountResponse{Message: "Unblocked Successfully"},
+			target:         &rest.UnblockAccountResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.UnblockAccountArgsForCall(0); username != "alice" {
+					t.Fatalf("expected username %q, actual %q", "alice", username)
+				}
+				if _, _, task, _ := s.IsAllowedOnArgsForCall(0); task != internal.UNBLOCK_ACCOUNT {
+					t.Fatalf("expected task %q, actual %q", internal.UNBLOCK_ACCOUNT, task)
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type BreakGlassActivation struct {
+	Id        string     `json:"id"`
+	Username  string     `json:"username"`
+	Role      SourceRole `json:"role"`
+	Reason    string     `json:"reason"`
+	Active    bool       `json:"active"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type BreakGlassRequest struct {
+	RoleId string `json:"role_id"`
+	Reason string `json:"reason"`
+}
+
+type BreakGlassResponse struct {
+	Message    string               `json:"message"`
+	Activation BreakGlassActivation `json:"activation"`
+}
+
+type BreakGlassReportResponse struct {
+	Activations []BreakGlassActivation `json:"activations"`
+}
+
+type EmergencyRolesResponse struct {
+	Roles []Role `json:"roles"`
+}
+
+type EmergencyRoleResponse struct {
+	Message string `json:"message"`
+}
+
+// breakGlass grants an emergency role to the authenticated account for a limited time, new access tokens
+// must be requested for the role to show up in token permissions.
+func (rb *RBACHandler) breakGlass(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.BREAK_GLASS)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req BreakGlassRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	activation, err := rb.svc.BreakGlass(r.Context(), internal.BreakGlassActivation{
+		Account: internal.Account{UserName: authusername},
+		Role:    internal.Roles{Id: req.RoleId},
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "break glass failed", err)
+		return
+	}
+	renderResponse(w, &BreakGlassResponse{
+		Message:    "Created Successfully",
+		Activation: convertBreakGlassActivation(activation, time.Now()),
+	}, http.StatusCreated)
+}
+
+// breakGlassReport lists the break glass activations, the optional since query parameter is an RFC 3339
+// time.
+func (rb *RBACHandler) breakGlassReport(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.LIST_BREAK_GLASS)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var since time.Time
+	if value := r.URL.Query().Get("since"); value != "" {
+		since, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "invalid request", internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid since"))
+			return
+		}
+	}
+	activations, err := rb.svc.BreakGlassActivations(r.Context(), since)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the break glass activations", err)
+		return
+	}
+	now := time.Now()
+	res := make([]BreakGlassActivation, 0, len(activations))
+	for _, value := range activations {
+		res = append(res, convertBreakGlassActivation(value, now))
+	}
+	renderResponse(w, &BreakGlassReportResponse{
+		Activations: res,
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) emergencyRoles(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.BREAK_GLASS)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	roles, err := rb.svc.EmergencyRoles(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting emergency roles", err)
+		return
+	}
+	res := make([]Role, len(roles))
+	for i, value := range roles {
+		res[i] = Role{
+			Id:        value.Id,
+			Role:      value.Role,
+			CreatedAt: value.CreatedAt,
+		}
+	}
+	renderResponse(w, &EmergencyRolesResponse{
+		Roles: res,
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) addEmergencyRole(w http.ResponseWriter, r *http.Request) {
+	rb.setEmergencyRole(w, r, true)
+}
+
+func (rb *RBACHandler) removeEmergencyRole(w http.ResponseWriter, r *http.Request) {
+	rb.setEmergencyRole(w, r, false)
+}
+
+func (rb *RBACHandler) setEmergencyRole(w http.ResponseWriter, r *http.Request, emergency bool) {
+	authusername := r.Header.Get("username")
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.MANAGE_EMERGENCY_ROLE, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.SetEmergencyRole(r.Context(), roleId, emergency)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error updating the emergency role", err)
+		return
+	}
+	renderResponse(w, &EmergencyRoleResponse{
+		Message: "Updated Successfully",
+	}, http.StatusOK)
+}
+
+func convertBreakGlassActivation(activation internal.BreakGlassActivation, now time.Time) BreakGlassActivation {
+	return BreakGlassActivation{
+		Id:       activation.Id,
+		Username: activation.Account.UserName,
+		Role: SourceRole{
+			Id:   activation.Role.Id,
+			Role: activation.Role.Role,
+		},
+		Reason:    activation.Reason,
+		Active:    activation.IsActiveAt(now),
+		ExpiresAt: activation.ExpiresAt,
+		CreatedAt: activation.CreatedAt,
+	}
+}
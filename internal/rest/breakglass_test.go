@@ -0,0 +1,191 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+	"time"
+)
+
+func TestBreakGlass_Post(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	expiresAt := createdAt.Add(time.Hour)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.BreakGlassReturns(internal.BreakGlassActivation{
+					Id:        "bg1",
+					Account:   internal.Account{UserName: "admin"},
+					Role:      internal.Roles{Id: "r1", Role: "EMERGENCY"},
+					Reason:    "incident 42",
+					ExpiresAt: expiresAt,
+					CreatedAt: createdAt,
+				}, nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/breakglass/", &rest.BreakGlassRequest{RoleId: "r1", Reason: "incident 42"}),
+			expectedStatus: http.StatusCreated,
+			expected: &rest.BreakGlassResponse{
+				Message: "Created Successfully",
+				Activation: rest.BreakGlassActivation{
+					Id:        "bg1",
+					Username:  "admin",
+					Role:      rest.SourceRole{Id: "r1", Role: "EMERGENCY"},
+					Reason:    "incident 42",
+					Active:    true,
+					ExpiresAt: expiresAt,
+					CreatedAt: createdAt,
+				},
+			},
+			target: &rest.BreakGlassResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				_, activation := s.BreakGlassArgsForCall(0)
+				if activation.Account.UserName != "admin" || activation.Role.Id != "r1" || activation.Reason != "incident 42" {
+					t.Fatalf("unexpected activation %v", activation)
+				}
+				if _, _, task := s.IsAllowedArgsForCall(0); task != internal.BREAK_GLASS {
+					t.Fatalf("expected task %q, actual %q", internal.BREAK_GLASS, task)
+				}
+			},
+		},
+		{
+			name: "ERR: 400 not emergency",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.BreakGlassReturns(internal.BreakGlassActivation{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the role is not an emergency role"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/breakglass/", &rest.BreakGlassRequest{RoleId: "r2", Reason: "incident 42"}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "break glass failed"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "ERR: 500 not allowed",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsAllowedReturns(false, nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/breakglass/", &rest.BreakGlassRequest{RoleId: "r1", Reason: "incident 42"}),
+			expectedStatus: http.StatusInternalServerError,
+			expected:       &errorResponse{Error: "internal error"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.BreakGlassCallCount() != 0 {
+					t.Fatalf("expected the glass not to be broken")
+				}
+			},
+		},
+	})
+}
+
+func TestBreakGlass_Get(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.BreakGlassActivationsReturns([]internal.BreakGlassActivation{
+					{Id: "bg1", Account: internal.Account{UserName: "alice"}, Role: internal.Roles{Id: "r1", Role: "EMERGENCY"}, Reason: "incident 42", ExpiresAt: now.Add(time.Hour), CreatedAt: now},
+					{Id: "bg2", Account: internal.Account{UserName: "bob"}, Role: internal.Roles{Id: "r1", Role: "EMERGENCY"}, Reason: "incident 41", ExpiresAt: now.Add(-time.Hour), CreatedAt: now.Add(-2 * time.Hour)},
+				}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/breakglass/?since=2020-01-01T00:00:00Z", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.BreakGlassReportResponse{
+				Activations: []rest.BreakGlassActivation{
+					{Id: "bg1", Username: "alice", Role: rest.SourceRole{Id: "r1", Role: "EMERGENCY"}, Reason: "incident 42", Active: true, ExpiresAt: now.Add(time.Hour), CreatedAt: now},
+					{Id: "bg2", Username: "bob", Role: rest.SourceRole{Id: "r1", Role: "EMERGENCY"}, Reason: "incident 41", ExpiresAt: now.Add(-time.Hour), CreatedAt: now.Add(-2 * time.Hour)},
+				},
+			},
+			target: &rest.BreakGlassReportResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, actual := s.BreakGlassActivationsArgsForCall(0); !actual.Equal(since) {
+					t.Fatalf("expected since %s, actual %s", since, actual)
+				}
+				if _, _, task := s.IsAllowedArgsForCall(0); task != internal.LIST_BREAK_GLASS {
+					t.Fatalf("expected task %q, actual %q", internal.LIST_BREAK_GLASS, task)
+				}
+			},
+		},
+		{
+			name: "ERR: 400 since",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodGet, "/v0/breakglass/?since=yesterday", nil),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "invalid request"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.BreakGlassActivationsCallCount() != 0 {
+					t.Fatalf("expected an invalid since not to be searched")
+				}
+			},
+		},
+	})
+}
+
+func TestEmergencyRole(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200 get",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.EmergencyRolesReturns([]internal.Roles{{Id: "r1", Role: "EMERGENCY", CreatedAt: createdAt}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/breakglass/roles", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.EmergencyRolesResponse{Roles: []rest.Role{{Id: "r1", Role: "EMERGENCY", CreatedAt: createdAt}}},
+			target:         &rest.EmergencyRolesResponse{},
+		},
+		{
+			name: "OK: 200 add",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPut, "/v0/roles/emergency/r1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.EmergencyRoleResponse{Message: "Updated Successfully"},
+			target:         &rest.EmergencyRoleResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, roleId, emergency := s.SetEmergencyRoleArgsForCall(0); roleId != "r1" || !emergency {
+					t.Fatalf("expected %q to be an emergency role", roleId)
+				}
+				_, _, task, resource := s.IsAllowedOnArgsForCall(0)
+				if task != internal.MANAGE_EMERGENCY_ROLE || resource.Id != "r1" {
+					t.Fatalf("expected task %q on %q, actual %q on %q", internal.MANAGE_EMERGENCY_ROLE, "r1", task, resource.Id)
+				}
+			},
+		},
+		{
+			name: "OK: 200 remove",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/roles/emergency/r1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.EmergencyRoleResponse{Message: "Updated Successfully"},
+			target:         &rest.EmergencyRoleResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, roleId, emergency := s.SetEmergencyRoleArgsForCall(0); roleId != "r1" || emergency {
+					t.Fatalf("expected %q not to be an emergency role", roleId)
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,232 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type ExclusiveRoleSet struct {
+	Id        string       `json:"id"`
+	Name      string       `json:"name"`
+	Roles     []SourceRole `json:"roles"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type RoleConflict struct {
+	Username string       `json:"username"`
+	SetId    string       `json:"set_id"`
+	SetName  string       `json:"set_name"`
+	Roles    []SourceRole `json:"roles"`
+}
+
+type CreateExclusiveRoleSetRequest struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+type CreateExclusiveRoleSetResponse struct {
+	Message string `json:"message"`
+	Id      string `json:"id"`
+}
+
+type UpdateExclusiveRoleSetRequest struct {
+	ExclusiveRoleSetId string   `json:"exclusiveRoleSetId"`
+	Name               string   `json:"name"`
+	Roles              []string `json:"roles"`
+}
+
+type ExclusiveRoleSetResponse struct {
+	Message string `json:"message"`
+}
+
+type GetExclusiveRoleSetResponse struct {
+	ExclusiveRoleSet ExclusiveRoleSet `json:"exclusive_role_set"`
+}
+
+type ListExclusiveRoleSetResponse struct {
+	ExclusiveRoleSets []ExclusiveRoleSet `json:"exclusive_role_sets"`
+}
+
+type RoleConflictsResponse struct {
+	Conflicts []RoleConflict `json:"conflicts"`
+}
+
+func (rb *RBACHandler) createExclusiveRoleSet(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.CREATE_EXCLUSIVE_ROLE_SET)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req CreateExclusiveRoleSetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	id, err := rb.svc.CreateExclusiveRoleSet(r.Context(), internal.ExclusiveRoleSet{
+		Name:  req.Name,
+		Roles: roleIds(req.Roles),
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create exclusive role set failed", err)
+		return
+	}
+	renderResponse(w, &CreateExclusiveRoleSetResponse{
+		Message: "Created Successfully",
+		Id:      id,
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) exclusiveRoleSet(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	id := mux.Vars(r)["exclusiveRoleSetId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_EXCLUSIVE_ROLE_SET)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	set, err := rb.svc.ExclusiveRoleSet(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the exclusive role set", err)
+		return
+	}
+	renderResponse(w, &GetExclusiveRoleSetResponse{
+		ExclusiveRoleSet: convertExclusiveRoleSet(set),
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) listExclusiveRoleSet(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.LIST_EXCLUSIVE_ROLE_SET)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	sets, err := rb.svc.ExclusiveRoleSets(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the exclusive role sets", err)
+		return
+	}
+	res := make([]ExclusiveRoleSet, 0, len(sets))
+	for _, value := range sets {
+		res = append(res, convertExclusiveRoleSet(value))
+	}
+	renderResponse(w, &ListExclusiveRoleSetResponse{
+		ExclusiveRoleSets: res,
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) updateExclusiveRoleSet(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.UPDATE_EXCLUSIVE_ROLE_SET)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req UpdateExclusiveRoleSetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	err = rb.svc.UpdateExclusiveRoleSet(r.Context(), internal.ExclusiveRoleSet{
+		Id:    req.ExclusiveRoleSetId,
+		Name:  req.Name,
+		Roles: roleIds(req.Roles),
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error updating exclusive role set", err)
+		return
+	}
+	renderResponse(w, &ExclusiveRoleSetResponse{
+		Message: "Updated Successfully",
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) deleteExclusiveRoleSet(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	id := mux.Vars(r)["exclusiveRoleSetId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.DELETE_EXCLUSIVE_ROLE_SET)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.DeleteExclusiveRoleSet(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error deleting exclusive role set", err)
+		return
+	}
+	renderResponse(w, &ExclusiveRoleSetResponse{
+		Message: "Deleted Successfully",
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) roleConflicts(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.LIST_ROLE_CONFLICT)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	conflicts, err := rb.svc.RoleConflicts(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the role conflicts", err)
+		return
+	}
+	res := make([]RoleConflict, 0, len(conflicts))
+	for _, value := range conflicts {
+		res = append(res, RoleConflict{
+			Username: value.Username,
+			SetId:    value.Set.Id,
+			SetName:  value.Set.Name,
+			Roles:    sourceRoles(value.Roles),
+		})
+	}
+	renderResponse(w, &RoleConflictsResponse{
+		Conflicts: res,
+	}, http.StatusOK)
+}
+
+func roleIds(ids []string) []internal.Roles {
+	roles := make([]internal.Roles, 0, len(ids))
+	for _, id := range ids {
+		roles = append(roles, internal.Roles{Id: id})
+	}
+	return roles
+}
+
+func convertExclusiveRoleSet(set internal.ExclusiveRoleSet) ExclusiveRoleSet {
+	return ExclusiveRoleSet{
+		Id:        set.Id,
+		Name:      set.Name,
+		Roles:     sourceRoles(set.Roles),
+		CreatedAt: set.CreatedAt,
+	}
+}
@@ -0,0 +1,212 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+	"time"
+)
+
+func TestExclusiveRoleSet_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateExclusiveRoleSetReturns("s1", nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/exclusiverolesets/", &rest.CreateExclusiveRoleSetRequest{Name: "payments", Roles: []string{"requester", "approver"}}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.CreateExclusiveRoleSetResponse{Message: "Created Successfully", Id: "s1"},
+			target:         &rest.CreateExclusiveRoleSetResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				_, set := s.CreateExclusiveRoleSetArgsForCall(0)
+				if set.Name != "payments" || len(set.Roles) != 2 || set.Roles[0].Id != "requester" || set.Roles[1].Id != "approver" {
+					t.Fatalf("unexpected exclusive role set %v", set)
+				}
+			},
+		},
+		{
+			name: "ERR: 400",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateExclusiveRoleSetReturns("", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "an exclusive role set needs at least two roles"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/exclusiverolesets/", &rest.CreateExclusiveRoleSetRequest{Name: "payments", Roles: []string{"requester"}}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "create exclusive role set failed"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestExclusiveRoleSet_Get(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	set := internal.ExclusiveRoleSet{
+		Id:        "s1",
+		Name:      "payments",
+		Roles:     []internal.Roles{{Id: "r1", Role: "REQUESTER"}, {Id: "r2", Role: "APPROVER"}},
+		CreatedAt: createdAt,
+	}
+	expected := rest.ExclusiveRoleSet{
+		Id:        "s1",
+		Name:      "payments",
+		Roles:     []rest.SourceRole{{Id: "r1", Role: "REQUESTER"}, {Id: "r2", Role: "APPROVER"}},
+		CreatedAt: createdAt,
+	}
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.ExclusiveRoleSetReturns(set, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/exclusiverolesets/s1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GetExclusiveRoleSetResponse{ExclusiveRoleSet: expected},
+			target:         &rest.GetExclusiveRoleSetResponse{},
+		},
+		{
+			name: "OK: 200 list",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.ExclusiveRoleSetsReturns([]internal.ExclusiveRoleSet{set}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/exclusiverolesets/", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.ListExclusiveRoleSetResponse{ExclusiveRoleSets: []rest.ExclusiveRoleSet{expected}},
+			target:         &rest.ListExclusiveRoleSetResponse{},
+		},
+		{
+			name: "ERR: 404",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.ExclusiveRoleSetReturns(internal.ExclusiveRoleSet{}, internal.NewErrorf(internal.ErrorCodeNotFound, "exclusive role set not found"))
+			},
+			req:            newRequest(http.MethodGet, "/v0/exclusiverolesets/s2", nil),
+			expectedStatus: http.StatusNotFound,
+			expected:       &errorResponse{Error: "error getting the exclusive role set"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestExclusiveRoleSet_Put(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req: newRequest(http.MethodPut, "/v0/exclusiverolesets/", &rest.UpdateExclusiveRoleSetRequest{
+				ExclusiveRoleSetId: "s1",
+				Name:               "payments",
+				Roles:              []string{"requester", "approver", "auditor"},
+			}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.ExclusiveRoleSetResponse{Message: "Updated Successfully"},
+			target:         &rest.ExclusiveRoleSetResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, set := s.UpdateExclusiveRoleSetArgsForCall(0); set.Id != "s1" || len(set.Roles) != 3 {
+					t.Fatalf("unexpected exclusive role set %v", set)
+				}
+			},
+		},
+	})
+}
+
+func TestExclusiveRoleSet_Delete(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/exclusiverolesets/s1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.ExclusiveRoleSetResponse{Message: "Deleted Successfully"},
+			target:         &rest.ExclusiveRoleSetResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, id := s.DeleteExclusiveRoleSetArgsForCall(0); id != "s1" {
+					t.Fatalf("expected exclusive role set %q, actual %q", "s1", id)
+				}
+			},
+		},
+	})
+}
+
+func TestRoleConflicts_Get(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.RoleConflictsReturns([]internal.RoleConflict{{
+					Username: "alice",
+					Set:      internal.ExclusiveRoleSet{Id: "s1", Name: "payments"},
+					Roles:    []internal.Roles{{Id: "r1", Role: "REQUESTER"}, {Id: "r2", Role: "APPROVER"}},
+				}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/exclusiverolesets/conflicts", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.RoleConflictsResponse{
+				Conflicts: []rest.RoleConflict{{
+					Username: "alice",
+					SetId:    "s1",
+					SetName:  "payments",
+					Roles:    []rest.SourceRole{{Id: "r1", Role: "REQUESTER"}, {Id: "r2", Role: "APPROVER"}},
+				}},
+			},
+			target: &rest.RoleConflictsResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.ExclusiveRoleSetCallCount() != 0 {
+					t.Fatalf("expected conflicts not to be routed as an exclusive role set id")
+				}
+			},
+		},
+	})
+}
+
+func TestAccountRole_ExclusiveRoles(t *testing.T) {
+	t.Parallel()
+
+	exclusive := internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the account would hold the exclusive roles REQUESTER, APPROVER of payments")
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "ERR: 400 create",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateAccountRoleReturns(exclusive)
+			},
+			req:            newRequest(http.MethodPost, "/v0/accountroles/", &rest.CreateAccountRoleRequest{AccountId: "a1", RoleId: "r2"}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "create accountrole failed"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "ERR: 400 update",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.UpdateAccountRoleReturns(exclusive)
+			},
+			req:            newRequest(http.MethodPut, "/v0/accountroles/", &rest.UpdateAccountRoleRequest{Id: "ar1", AccountId: "a1", RoleId: "r2"}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "error updating role"},
+			target:         &errorResponse{},
+		},
+	})
+}
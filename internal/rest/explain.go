@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+)
+
+type ExplainPermissionRequest struct {
+	Username string    `json:"username"`
+	Task     string    `json:"task"`
+	Resource *Resource `json:"resource,omitempty"`
+}
+
+type ExplainedRole struct {
+	Id            string `json:"id"`
+	Role          string `json:"role"`
+	Via           string `json:"via"`
+	InheritedFrom string `json:"inherited_from,omitempty"`
+	Grants        bool   `json:"grants"`
+	Denies        bool   `json:"denies"`
+}
+
+type ExplainedGrant struct {
+	RoleId    string    `json:"role_id"`
+	RoleScope *Resource `json:"role_scope,omitempty"`
+	TaskScope *Resource `json:"task_scope,omitempty"`
+	Condition string    `json:"condition,omitempty"`
+	Effect    string    `json:"effect"`
+	Covers    bool      `json:"covers"`
+	Holds     bool      `json:"holds"`
+}
+
+type Lookup struct {
+	Name   string `json:"name"`
+	Key    string `json:"key"`
+	Source string `json:"source"`
+}
+
+type ExplainPermissionResponse struct {
+	Username string           `json:"username"`
+	Task     string           `json:"task"`
+	Resource *Resource        `json:"resource,omitempty"`
+	Allowed  bool             `json:"allowed"`
+	Reason   string           `json:"reason"`
+	Roles    []ExplainedRole  `json:"roles"`
+	Grants   []ExplainedGrant `json:"grants"`
+	Lookups  []Lookup         `json:"lookups"`
+}
+
+// explainPermission returns the decision for a username and task along with the roles, grants and lookups
+// it was derived from.
+func (rb *RBACHandler) explainPermission(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.EXPLAIN_PERMISSION)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req ExplainPermissionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	check := internal.PermissionCheck{
+		Subject: req.Username,
+		Task:    req.Task,
+	}
+	if req.Resource != nil {
+		check.Resource = &internal.Resource{
+			Type: req.Resource.Type,
+			Id:   req.Resource.Id,
+		}
+	}
+	if err := check.Validate(); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	exp, err := rb.svc.ExplainPermission(r.Context(), check.Subject, check.Task, check.Resource)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "explain permission failed", err)
+		return
+	}
+	res := &ExplainPermissionResponse{
+		Username: exp.Username,
+		Task:     exp.Task,
+		Resource: req.Resource,
+		Allowed:  exp.Allowed,
+		Reason:   exp.Reason,
+		Roles:    make([]ExplainedRole, len(exp.Roles)),
+		Grants:   make([]ExplainedGrant, len(exp.Grants)),
+		Lookups:  make([]Lookup, len(exp.Lookups)),
+	}
+	for i, value := range exp.Roles {
+		res.Roles[i] = ExplainedRole{
+			Id:            value.Role.Id,
+			Role:          value.Role.Role,
+			Via:           value.Via,
+			InheritedFrom: value.InheritedFrom,
+			Grants:        value.Grants,
+			Denies:        value.Denies,
+		}
+	}
+	for i, value := range exp.Grants {
+		res.Grants[i] = ExplainedGrant{
+			RoleId:    value.Grant.RoleId,
+			RoleScope: convertScope(value.Grant.RoleScope),
+			TaskScope: convertScope(value.Grant.TaskScope),
+			Condition: value.Grant.Condition,
+			Effect:    value.Grant.Effect,
+			Covers:    value.Covers,
+			Holds:     value.Holds,
+		}
+	}
+	for i, value := range exp.Lookups {
+		res.Lookups[i] = Lookup{
+			Name:   value.Name,
+			Key:    value.Key,
+			Source: value.Source,
+		}
+	}
+	renderResponse(w, res, http.StatusOK)
+}
+
+// convertScope returns nil for global scopes.
+func convertScope(scope internal.Scope) *Resource {
+	if scope.IsGlobal() {
+		return nil
+	}
+	return &Resource{
+		Type: scope.ResourceType,
+		Id:   scope.ResourceId,
+	}
+}
@@ -0,0 +1,88 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+)
+
+func TestExplainPermission_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.ExplainPermissionReturns(internal.Explanation{
+					Username: "alice",
+					Task:     "document.write",
+					Reason:   "denied on the resource by role AUDITOR",
+					Roles: []internal.ExplainedRole{
+						{Role: internal.Roles{Id: "r1", Role: "EDITOR"}, Via: internal.ROLE_VIA_ACCOUNT, Grants: true},
+						{Role: internal.Roles{Id: "r2", Role: "AUDITOR"}, Via: internal.ROLE_VIA_INHERITANCE, InheritedFrom: "r1"},
+					},
+					Grants: []internal.ExplainedGrant{{
+						Grant: internal.RestrictedGrant{
+							Task:      "document.write",
+							RoleId:    "r2",
+							TaskScope: internal.Scope{ResourceType: "document", ResourceId: "secret-*"},
+							Effect:    internal.EFFECT_DENY,
+						},
+						Covers: true,
+						Holds:  true,
+					}},
+					Lookups: []internal.Lookup{{Name: "RestrictedGrants", Key: "alice", Source: internal.SOURCE_DATABASE}},
+				}, nil)
+			},
+			req: newRequest(http.MethodPost, "/v0/authz/explain", &rest.ExplainPermissionRequest{
+				Username: "alice",
+				Task:     "document.write",
+				Resource: &rest.Resource{Type: "document", Id: "secret-1"},
+			}),
+			expectedStatus: http.StatusOK,
+			expected: &rest.ExplainPermissionResponse{
+				Username: "alice",
+				Task:     "document.write",
+				Resource: &rest.Resource{Type: "document", Id: "secret-1"},
+				Reason:   "denied on the resource by role AUDITOR",
+				Roles: []rest.ExplainedRole{
+					{Id: "r1", Role: "EDITOR", Via: internal.ROLE_VIA_ACCOUNT, Grants: true},
+					{Id: "r2", Role: "AUDITOR", Via: internal.ROLE_VIA_INHERITANCE, InheritedFrom: "r1"},
+				},
+				Grants: []rest.ExplainedGrant{{
+					RoleId:    "r2",
+					TaskScope: &rest.Resource{Type: "document", Id: "secret-*"},
+					Effect:    internal.EFFECT_DENY,
+					Covers:    true,
+					Holds:     true,
+				}},
+				Lookups: []rest.Lookup{{Name: "RestrictedGrants", Key: "alice", Source: internal.SOURCE_DATABASE}},
+			},
+			target: &rest.ExplainPermissionResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				_, username, task, resource := s.ExplainPermissionArgsForCall(0)
+				if username != "alice" || task != "document.write" || resource == nil || resource.Id != "secret-1" {
+					t.Fatalf("unexpected explanation of %q for %q on %v", task, username, resource)
+				}
+			},
+		},
+		{
+			name: "ERR: 400",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPost, "/v0/authz/explain", &rest.ExplainPermissionRequest{Username: "alice"}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "invalid request"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.ExplainPermissionCallCount() != 0 {
+					t.Fatalf("expected an invalid check not to be explained")
+				}
+			},
+		},
+	})
+}
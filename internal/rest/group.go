@@ -0,0 +1,415 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type Group struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type GroupMember struct {
+	Id        string    `json:"id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CreateGroupRequest struct {
+	Name string `json:"name"`
+}
+
+type CreateGroupResponse struct {
+	Message string `json:"message"`
+	Id      string `json:"id"`
+}
+
+type UpdateGroupRequest struct {
+	GroupId string `json:"groupId"`
+	Name    string `json:"name"`
+}
+
+type GroupResponse struct {
+	Message string `json:"message"`
+}
+
+type GetGroupResponse struct {
+	Group Group `json:"group"`
+}
+
+type ListGroupResponse struct {
+	Groups []Group `json:"groups"`
+}
+
+type GroupMembersResponse struct {
+	Members []GroupMember `json:"members"`
+}
+
+type GroupRolesResponse struct {
+	Roles []Role `json:"roles"`
+}
+
+func (rb *RBACHandler) createGroup(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.CREATE_GROUP)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req CreateGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	id, err := rb.svc.CreateGroup(r.Context(), internal.Group{Name: req.Name})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create group failed", err)
+		return
+	}
+	renderResponse(w, &CreateGroupResponse{
+		Message: "Created Successfully",
+		Id:      id,
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) group(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_GROUP)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	group, err := rb.svc.Group(r.Context(), groupId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the group", err)
+		return
+	}
+	renderResponse(w, &GetGroupResponse{
+		Group: Group{
+			Id:        group.Id,
+			Name:      group.Name,
+			CreatedAt: group.CreatedAt,
+		},
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) listGroup(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.LIST_GROUP)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	groups, err := rb.svc.Groups(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the groups", err)
+		return
+	}
+	renderResponse(w, &ListGroupResponse{
+		Groups: convertGroups(groups),
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) updateGroup(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.UPDATE_GROUP)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req UpdateGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	err = rb.svc.UpdateGroup(r.Context(), internal.Group{
+		Id:   req.GroupId,
+		Name: req.Name,
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error updating group", err)
+		return
+	}
+	renderResponse(w, &GroupResponse{
+		Message: "Updated Successfully",
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.DELETE_GROUP)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.DeleteGroup(r.Context(), groupId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error deleting group", err)
+		return
+	}
+	renderResponse(w, &GroupResponse{
+		Message: "Deleted Successfully",
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) groupMembers(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_GROUP)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	members, err := rb.svc.GroupMembers(r.Context(), groupId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the group members", err)
+		return
+	}
+	res := make([]GroupMember, len(members))
+	for i, value := range members {
+		res[i] = GroupMember{
+			Id:        value.Id,
+			Username:  value.Account.UserName,
+			CreatedAt: value.CreatedAt,
+		}
+	}
+	renderResponse(w, &GroupMembersResponse{
+		Members: res,
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) addGroupMember(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.MANAGE_GROUP_MEMBER)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.AddGroupMember(r.Context(), groupId, username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "add group member failed", err)
+		return
+	}
+	renderResponse(w, &GroupResponse{
+		Message: "Added Successfully",
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) removeGroupMember(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.MANAGE_GROUP_MEMBER)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.RemoveGroupMember(r.Context(), groupId, username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "remove group member failed", err)
+		return
+	}
+	renderResponse(w, &GroupResponse{
+		Message: "Removed Successfully",
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) memberGroups(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_GROUP)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	groups, err := rb.svc.MemberGroups(r.Context(), groupId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the member groups", err)
+		return
+	}
+	renderResponse(w, &ListGroupResponse{
+		Groups: convertGroups(groups),
+	}, http.StatusOK)
+}
+
+// addMemberGroup nests a group in the group, the members of the nested group hold the roles of the
+// group as well.
+func (rb *RBACHandler) addMemberGroup(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	memberGroupId := mux.Vars(r)["memberGroupId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.MANAGE_GROUP_MEMBER)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.AddMemberGroup(r.Context(), groupId, memberGroupId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "add member group failed", err)
+		return
+	}
+	renderResponse(w, &GroupResponse{
+		Message: "Added Successfully",
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) removeMemberGroup(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	memberGroupId := mux.Vars(r)["memberGroupId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.MANAGE_GROUP_MEMBER)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.RemoveMemberGroup(r.Context(), groupId, memberGroupId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "remove member group failed", err)
+		return
+	}
+	renderResponse(w, &GroupResponse{
+		Message: "Removed Successfully",
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) groupRoles(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_GROUP)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	roles, err := rb.svc.GroupRoles(r.Context(), groupId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the group roles", err)
+		return
+	}
+	res := make([]Role, len(roles))
+	for i, value := range roles {
+		res[i] = Role{
+			Id:        value.Id,
+			Role:      value.Role,
+			CreatedAt: value.CreatedAt,
+		}
+	}
+	renderResponse(w, &GroupRolesResponse{
+		Roles: res,
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) addGroupRole(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.MANAGE_GROUP_ROLE)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.AddGroupRole(r.Context(), groupId, roleId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "add group role failed", err)
+		return
+	}
+	renderResponse(w, &GroupResponse{
+		Message: "Added Successfully",
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) removeGroupRole(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	groupId := mux.Vars(r)["groupId"]
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.MANAGE_GROUP_ROLE)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.RemoveGroupRole(r.Context(), groupId, roleId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "remove group role failed", err)
+		return
+	}
+	renderResponse(w, &GroupResponse{
+		Message: "Removed Successfully",
+	}, http.StatusOK)
+}
+
+func convertGroups(groups []internal.Group) []Group {
+	res := make([]Group, len(groups))
+	for i, g := range groups {
+		res[i] = Group{
+			Id:        g.Id,
+			Name:      g.Name,
+			CreatedAt: g.CreatedAt,
+		}
+	}
+	return res
+}
@@ -0,0 +1,290 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+	"time"
+)
+
+func TestGroup_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateGroupReturns("g1", nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/groups/", &rest.CreateGroupRequest{Name: "engineering"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.CreateGroupResponse{Message: "Created Successfully", Id: "g1"},
+			target:         &rest.CreateGroupResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, group := s.CreateGroupArgsForCall(0); group.Name != "engineering" {
+					t.Fatalf("expected group %q, actual %q", "engineering", group.Name)
+				}
+			},
+		},
+		{
+			name: "ERR: 400",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateGroupReturns("", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "group name is required"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/groups/", &rest.CreateGroupRequest{}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "create group failed"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestGroup_Get(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.GroupReturns(internal.Group{Id: "g1", Name: "engineering", CreatedAt: createdAt}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/groups/g1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GetGroupResponse{Group: rest.Group{Id: "g1", Name: "engineering", CreatedAt: createdAt}},
+			target:         &rest.GetGroupResponse{},
+		},
+		{
+			name: "ERR: 404",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.GroupReturns(internal.Group{}, internal.NewErrorf(internal.ErrorCodeNotFound, "group not found"))
+			},
+			req:            newRequest(http.MethodGet, "/v0/groups/g2", nil),
+			expectedStatus: http.StatusNotFound,
+			expected:       &errorResponse{Error: "error getting the group"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "OK: 200 list",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.GroupsReturns([]internal.Group{{Id: "g1", Name: "engineering", CreatedAt: createdAt}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/groups/", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.ListGroupResponse{Groups: []rest.Group{{Id: "g1", Name: "engineering", CreatedAt: createdAt}}},
+			target:         &rest.ListGroupResponse{},
+		},
+	})
+}
+
+func TestGroup_Put(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPut, "/v0/groups/", &rest.UpdateGroupRequest{GroupId: "g1", Name: "platform"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.GroupResponse{Message: "Updated Successfully"},
+			target:         &rest.GroupResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, group := s.UpdateGroupArgsForCall(0); group.Id != "g1" || group.Name != "platform" {
+					t.Fatalf("unexpected update of %q to %q", group.Id, group.Name)
+				}
+			},
+		},
+	})
+}
+
+func TestGroup_Delete(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/groups/g1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GroupResponse{Message: "Deleted Successfully"},
+			target:         &rest.GroupResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, id := s.DeleteGroupArgsForCall(0); id != "g1" {
+					t.Fatalf("expected group %q, actual %q", "g1", id)
+				}
+			},
+		},
+	})
+}
+
+func TestGroupMember(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200 get",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.GroupMembersReturns([]internal.GroupMember{{Id: "m1", Account: internal.Account{UserName: "alice"}, CreatedAt: createdAt}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/groups/g1/members", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GroupMembersResponse{Members: []rest.GroupMember{{Id: "m1", Username: "alice", CreatedAt: createdAt}}},
+			target:         &rest.GroupMembersResponse{},
+		},
+		{
+			name: "OK: 201 add",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPost, "/v0/groups/g1/members/alice", nil),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.GroupResponse{Message: "Added Successfully"},
+			target:         &rest.GroupResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, groupId, username := s.AddGroupMemberArgsForCall(0); groupId != "g1" || username != "alice" {
+					t.Fatalf("unexpected member %q added to %q", username, groupId)
+				}
+				if _, _, task := s.IsAllowedArgsForCall(0); task != internal.MANAGE_GROUP_MEMBER {
+					t.Fatalf("expected task %q, actual %q", internal.MANAGE_GROUP_MEMBER, task)
+				}
+			},
+		},
+		{
+			name: "OK: 200 remove",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/groups/g1/members/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GroupResponse{Message: "Removed Successfully"},
+			target:         &rest.GroupResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, groupId, username := s.RemoveGroupMemberArgsForCall(0); groupId != "g1" || username != "alice" {
+					t.Fatalf("unexpected member %q removed from %q", username, groupId)
+				}
+			},
+		},
+	})
+}
+
+func TestMemberGroup(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200 get",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.MemberGroupsReturns([]internal.Group{{Id: "g2", Name: "backend"}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/groups/g1/groups", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.ListGroupResponse{Groups: []rest.Group{{Id: "g2", Name: "backend"}}},
+			target:         &rest.ListGroupResponse{},
+		},
+		{
+			name: "OK: 201 add",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPost, "/v0/groups/g1/groups/g2", nil),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.GroupResponse{Message: "Added Successfully"},
+			target:         &rest.GroupResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, groupId, memberGroupId := s.AddMemberGroupArgsForCall(0); groupId != "g1" || memberGroupId != "g2" {
+					t.Fatalf("unexpected group %q nested in %q", memberGroupId, groupId)
+				}
+			},
+		},
+		{
+			name: "ERR: 400 cycle",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.AddMemberGroupReturns(internal.NewErrorf(internal.ErrorCodeInvalidArgument, "nesting the group would create a cycle"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/groups/g2/groups/g1", nil),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "add member group failed"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "OK: 200 remove",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/groups/g1/groups/g2", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GroupResponse{Message: "Removed Successfully"},
+			target:         &rest.GroupResponse{},
+		},
+	})
+}
+
+func TestGroupRole(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200 get",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.GroupRolesReturns([]internal.Roles{{Id: "r1", Role: "EDITOR", CreatedAt: createdAt}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/groups/g1/roles", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GroupRolesResponse{Roles: []rest.Role{{Id: "r1", Role: "EDITOR", CreatedAt: createdAt}}},
+			target:         &rest.GroupRolesResponse{},
+		},
+		{
+			name: "OK: 201 add",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPost, "/v0/groups/g1/roles/r1", nil),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.GroupResponse{Message: "Added Successfully"},
+			target:         &rest.GroupResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, groupId, roleId := s.AddGroupRoleArgsForCall(0); groupId != "g1" || roleId != "r1" {
+					t.Fatalf("unexpected role %q added to %q", roleId, groupId)
+				}
+				if _, _, task := s.IsAllowedArgsForCall(0); task != internal.MANAGE_GROUP_ROLE {
+					t.Fatalf("expected task %q, actual %q", internal.MANAGE_GROUP_ROLE, task)
+				}
+			},
+		},
+		{
+			name: "OK: 200 remove",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/groups/g1/roles/r1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.GroupResponse{Message: "Removed Successfully"},
+			target:         &rest.GroupResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, groupId, roleId := s.RemoveGroupRoleArgsForCall(0); groupId != "g1" || roleId != "r1" {
+					t.Fatalf("unexpected role %q removed from %q", roleId, groupId)
+				}
+			},
+		},
+	})
+}
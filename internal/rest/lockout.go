@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"net/http"
+	"rbac/internal"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type Lockout struct {
+	Key         string    `json:"key"`
+	Failures    int64     `json:"failures"`
+	Locked      bool      `json:"locked"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+type LockoutResponse struct {
+	Lockout Lockout `json:"lockout"`
+}
+
+type ClearLockoutResponse struct {
+	Message string `json:"message"`
+}
+
+func (rb *RBACHandler) accountLockout(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_LOCKOUT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	lockout, err := rb.svc.AccountLockout(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the lockout", err)
+		return
+	}
+	renderResponse(w, &LockoutResponse{
+		Lockout: convertLockout(lockout),
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) clearAccountLockout(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.CLEAR_LOCKOUT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.ClearAccountLockout(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error clearing the lockout", err)
+		return
+	}
+	renderResponse(w, &ClearLockoutResponse{
+		Message: "Lockout Cleared Successfully",
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) ipLockout(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	ip := mux.Vars(r)["ip"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_LOCKOUT)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	lockout, err := rb.svc.IPLockout(r.Context(), ip)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the lockout", err)
+		return
+	}
+	renderResponse(w, &LockoutResponse{
+		Lockout: convertLockout(lockout),
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) clearIPLockout(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	ip := mux.Vars(r)["ip"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.CLEAR_LOCKOUT)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.ClearIPLockout(r.Context(), ip)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error clearing the lockout", err)
+		return
+	}
+	renderResponse(w, &ClearLockoutResponse{
+		Message: "Lockout Cleared Successfully",
+	}, http.StatusOK)
+}
+
+func convertLockout(lockout internal.Lockout) Lockout {
+	return Lockout{
+		Key:         lockout.Key,
+		Failures:    lockout.Failures,
+		Locked:      lockout.IsLocked(),
+		LockedUntil: lockout.LockedUntil,
+	}
+}
@@ -0,0 +1,154 @@
+package rest_test
+
+import (
+	"errors"
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+	"time"
+)
+
+func TestLogin_Locked(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "ERR: 429",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.LoginReturns(internal.NewErrorf(internal.ErrorCodeTooManyRequests, "too many failed logins, try again later"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/login", &rest.LoginRequest{Username: "admin", Password: "wrong"}),
+			expectedStatus: http.StatusTooManyRequests,
+			expected:       &errorResponse{Error: "login failed"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, _, _, ip := s.LoginArgsForCall(0); ip != "192.0.2.1" {
+					t.Fatalf("expected ip %q, actual %q", "192.0.2.1", ip)
+				}
+				if s.CreateTokenCallCount() != 0 {
+					t.Fatalf("expected no token to be issued")
+				}
+			},
+		},
+	})
+}
+
+func TestAccountLockout_Get(t *testing.T) {
+	t.Parallel()
+
+	until := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.AccountLockoutReturns(internal.Lockout{Key: "account:alice", Failures: 5, LockedUntil: until}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/lockouts/accounts/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.LockoutResponse{
+				Lockout: rest.Lockout{Key: "account:alice", Failures: 5, Locked: true, LockedUntil: until},
+			},
+			target: &rest.LockoutResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				_, _, task, resource := s.IsAllowedOnArgsForCall(0)
+				if task != internal.GET_LOCKOUT || resource.Id != "alice" {
+					t.Fatalf("unexpected authorization of %s on %v", task, resource)
+				}
+			},
+		},
+		{
+			name: "ERR: 401",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.VerifyTokenReturns(nil, internal.NewErrorf(internal.ErrorCodeUnauthorized, "token has expired"))
+			},
+			req:            newRequest(http.MethodGet, "/v0/lockouts/accounts/alice", nil),
+			expectedStatus: http.StatusUnauthorized,
+			expected:       &errorResponse{Error: "invalid token"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.AccountLockoutCallCount() != 0 {
+					t.Fatalf("expected the lockout not to be read")
+				}
+			},
+		},
+	})
+}
+
+func TestAccountLockout_Delete(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/lockouts/accounts/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.ClearLockoutResponse{Message: "Lockout Cleared Successfully"},
+			target:         &rest.ClearLockoutResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.ClearAccountLockoutArgsForCall(0); username != "alice" {
+					t.Fatalf("expected username %q, actual %q", "alice", username)
+				}
+			},
+		},
+		{
+			name: "ERR: 500",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.ClearAccountLockoutReturns(errors.New("attempts error"))
+			},
+			req:            newRequest(http.MethodDelete, "/v0/lockouts/accounts/alice", nil),
+			expectedStatus: http.StatusInternalServerError,
+			expected:       &errorResponse{Error: "internal error"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestIPLockout_Get(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IPLockoutReturns(internal.Lockout{Key: "ip:192.0.2.1", Failures: 2}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/lockouts/ips/192.0.2.1", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.LockoutResponse{
+				Lockout: rest.Lockout{Key: "ip:192.0.2.1", Failures: 2},
+			},
+			target: &rest.LockoutResponse{},
+		},
+	})
+}
+
+func TestIPLockout_Delete(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/lockouts/ips/192.0.2.1", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.ClearLockoutResponse{Message: "Lockout Cleared Successfully"},
+			target:         &rest.ClearLockoutResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, ip := s.ClearIPLockoutArgsForCall(0); ip != "192.0.2.1" {
+					t.Fatalf("expected ip %q, actual %q", "192.0.2.1", ip)
+				}
+			},
+		},
+	})
+}
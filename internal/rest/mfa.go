@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+
+	"github.com/gorilla/mux"
+)
+
+type MFAEnrollRequest struct {
+	MFAToken string `json:"mfa_token"`
+}
+
+type MFAEnrollResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type MFAVerifyRequest struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+}
+
+type MFAConfirmRequest struct {
+	Code string `json:"code"`
+}
+
+type MFADisableRequest struct {
+	Code string `json:"code"`
+}
+
+type MFAResponse struct {
+	Message       string   `json:"message"`
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+	Token         string   `json:"token,omitempty"`
+	RefreshToken  string   `json:"refresh_token,omitempty"`
+}
+
+// enrollMFAChallenge starts the enrolment of an account required to use MFA, during its login.
+func (rb *RBACHandler) enrollMFAChallenge(w http.ResponseWriter, r *http.Request) {
+	var req MFAEnrollRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	enrollment, err := rb.svc.EnrollMFAWithChallenge(r.Context(), req.MFAToken)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error enrolling mfa", err)
+		return
+	}
+	renderResponse(w, &MFAEnrollResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	}, http.StatusCreated)
+}
+
+// verifyMFA completes a login waiting for its second factor.
+func (rb *RBACHandler) verifyMFA(w http.ResponseWriter, r *http.Request) {
+	var req MFAVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	username, recoveryCodes, err := rb.svc.VerifyMFA(r.Context(), req.MFAToken, req.Code, clientIP(r))
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "mfa verification failed", err)
+		return
+	}
+	token, refreshToken, ok := rb.issueTokens(w, r, username)
+	if !ok {
+		return
+	}
+	renderResponse(w, &MFAResponse{
+		Message:       "Login Succesfully",
+		RecoveryCodes: recoveryCodes,
+		Token:         token,
+		RefreshToken:  refreshToken,
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) enrollMFA(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	enrollment, err := rb.svc.EnrollMFA(r.Context(), authusername)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error enrolling mfa", err)
+		return
+	}
+	renderResponse(w, &MFAEnrollResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) confirmMFA(w http.ResponseWriter, r *http.Request) {
+	var req MFAConfirmRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	authusername := r.Header.Get("username")
+	recoveryCodes, err := rb.svc.ConfirmMFA(r.Context(), authusername, req.Code)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error confirming mfa", err)
+		return
+	}
+	renderResponse(w, &MFAResponse{
+		Message:       "MFA Enabled Successfully",
+		RecoveryCodes: recoveryCodes,
+	}, http.StatusOK)
+}
+
+// disableMFA removes the MFA enrolment of an account, accounts disabling their own MFA must send a current
+// TOTP code.
+func (rb *RBACHandler) disableMFA(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	if authusername == username {
+		var req MFADisableRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			renderErrorResponse(r.Context(), w, "invalid request", err)
+			return
+		}
+		defer r.Body.Close()
+		err := rb.svc.DisableOwnMFA(r.Context(), username, req.Code)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "error disabling mfa", err)
+			return
+		}
+		renderResponse(w, &MFAResponse{
+			Message: "MFA Disabled Successfully",
+		}, http.StatusOK)
+		return
+	}
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.DISABLE_MFA, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.DisableMFA(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error disabling mfa", err)
+		return
+	}
+	renderResponse(w, &MFAResponse{
+		Message: "MFA Disabled Successfully",
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) requireRoleMFA(w http.ResponseWriter, r *http.Request) {
+	rb.setRoleMFARequired(w, r, true)
+}
+
+func (rb *RBACHandler) unrequireRoleMFA(w http.ResponseWriter, r *http.Request) {
+	rb.setRoleMFARequired(w, r, false)
+}
+
+func (rb *RBACHandler) setRoleMFARequired(w http.ResponseWriter, r *http.Request, required bool) {
+	authusername := r.Header.Get("username")
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.UPDATE_ROLE, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.SetRoleMFARequired(r.Context(), roleId, required)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error updating the role mfa policy", err)
+		return
+	}
+	renderResponse(w, &MFAResponse{
+		Message: "Updated Successfully",
+	}, http.StatusOK)
+}
@@ -0,0 +1,181 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+)
+
+func TestLogin_MFARequired(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 202",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.MFAStatusReturns(internal.MFAStatus{Enrolled: true}, nil)
+				s.CreateMFAChallengeReturns("challenge", nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/login", &rest.LoginRequest{Username: "admin", Password: "secret"}),
+			expectedStatus: http.StatusAccepted,
+			expected:       &rest.LoginResponse{Message: "MFA Required", MFAToken: "challenge"},
+			target:         &rest.LoginResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.CreateTokenCallCount() != 0 {
+					t.Fatalf("expected no token to be issued before the second factor")
+				}
+			},
+		},
+		{
+			name: "OK: 202 enrollment",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.MFAStatusReturns(internal.MFAStatus{Required: true}, nil)
+				s.CreateMFAChallengeReturns("challenge", nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/login", &rest.LoginRequest{Username: "admin", Password: "secret"}),
+			expectedStatus: http.StatusAccepted,
+			expected:       &rest.LoginResponse{Message: "MFA Required", MFAToken: "challenge", MFAEnrollmentRequired: true},
+			target:         &rest.LoginResponse{},
+		},
+	})
+}
+
+func TestMFAVerify_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.VerifyMFAReturns("admin", []string{"recovery"}, nil)
+				s.CreateTokenReturns("access", nil)
+				s.CreateRefreshTokenReturns("refresh", nil)
+			},
+			conf:           rest.Config{TokenInBody: true},
+			req:            newRequest(http.MethodPost, "/v0/mfa/verify", &rest.MFAVerifyRequest{MFAToken: "challenge", Code: "123456"}),
+			expectedStatus: http.StatusCreated,
+			expected: &rest.MFAResponse{
+				Message:       "Login Succesfully",
+				RecoveryCodes: []string{"recovery"},
+				Token:         "access",
+				RefreshToken:  "refresh",
+			},
+			target: &rest.MFAResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, challenge, code, ip := s.VerifyMFAArgsForCall(0); challenge != "challenge" || code != "123456" || ip != "192.0.2.1" {
+					t.Fatalf("unexpected verification of %q with %q from %q", challenge, code, ip)
+				}
+				if _, username := s.CreateTokenArgsForCall(0); username != "admin" {
+					t.Fatalf("expected token for %q, actual %q", "admin", username)
+				}
+			},
+		},
+		{
+			name: "ERR: 401",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.VerifyMFAReturns("", nil, internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid mfa code"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/mfa/verify", &rest.MFAVerifyRequest{MFAToken: "challenge", Code: "000000"}),
+			expectedStatus: http.StatusUnauthorized,
+			expected:       &errorResponse{Error: "mfa verification failed"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestMFAEnroll_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.EnrollMFAReturns(internal.MFAEnrollment{Secret: "secret", ProvisioningURI: "otpauth://totp/rbac:admin"}, nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/accounts/mfa/enroll", nil),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.MFAEnrollResponse{Secret: "secret", ProvisioningURI: "otpauth://totp/rbac:admin"},
+			target:         &rest.MFAEnrollResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.EnrollMFAArgsForCall(0); username != "admin" {
+					t.Fatalf("expected username %q, actual %q", "admin", username)
+				}
+			},
+		},
+	})
+}
+
+func TestMFAConfirm_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.ConfirmMFAReturns([]string{"recovery"}, nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/accounts/mfa/confirm", &rest.MFAConfirmRequest{Code: "123456"}),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.MFAResponse{Message: "MFA Enabled Successfully", RecoveryCodes: []string{"recovery"}},
+			target:         &rest.MFAResponse{},
+		},
+		{
+			name: "ERR: 400",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.ConfirmMFAReturns(nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid mfa code"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/accounts/mfa/confirm", &rest.MFAConfirmRequest{Code: "000000"}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "error confirming mfa"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestMFA_Delete(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200 own",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/accounts/mfa/admin", &rest.MFADisableRequest{Code: "123456"}),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.MFAResponse{Message: "MFA Disabled Successfully"},
+			target:         &rest.MFAResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username, code := s.DisableOwnMFAArgsForCall(0); username != "admin" || code != "123456" {
+					t.Fatalf("unexpected disabling of %q with %q", username, code)
+				}
+				if s.DisableMFACallCount() != 0 {
+					t.Fatalf("expected the own mfa to be disabled with a code")
+				}
+			},
+		},
+		{
+			name: "OK: 200 other",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/accounts/mfa/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.MFAResponse{Message: "MFA Disabled Successfully"},
+			target:         &rest.MFAResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.DisableMFAArgsForCall(0); username != "alice" {
+					t.Fatalf("expected username %q, actual %q", "alice", username)
+				}
+				if _, _, task, _ := s.IsAllowedOnArgsForCall(0); task != internal.DISABLE_MFA {
+					t.Fatalf("expected task %q, actual %q", internal.DISABLE_MFA, task)
+				}
+			},
+		},
+	})
+}
@@ -1,21 +1,130 @@
 package rest
 
-import "net/http"
+import (
+	"crypto/subtle"
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/condition"
+	"rbac/internal/tokenmaker"
+	"strings"
+	"time"
+)
+
+const (
+	// apiKeyScheme is the Authorization header scheme used to send API keys.
+	apiKeyScheme = "ApiKey "
+	// bearerScheme is the Authorization header scheme used to send access tokens.
+	bearerScheme = "Bearer "
+	// csrfHeader must repeat the value of the csrf_token cookie on unsafe requests.
+	csrfHeader = "X-CSRF-Token"
+)
 
 func (a *RBACHandler) middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		c, err := r.Cookie("token")
+		// conditions of role tasks are evaluated against the request
+		r = r.WithContext(condition.NewContext(r.Context(), condition.Request{IP: clientIP(r), Time: time.Now()}))
+		if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, apiKeyScheme) {
+			username, err := a.svc.AuthenticateAPIKey(r.Context(), strings.TrimPrefix(auth, apiKeyScheme))
+			if err != nil {
+				renderErrorResponse(r.Context(), w, "invalid api key", err)
+				return
+			}
+			r.Header.Set("username", username)
+			r, err = a.withTenant(r, username, "")
+			if err != nil {
+				renderErrorResponse(r.Context(), w, "error getting tenant", err)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+		token, fromCookie := a.accessToken(r)
+		if token == "" {
+			renderErrorResponse(r.Context(), w, "invalid token", internal.NewErrorf(internal.ErrorCodeUnauthorized, "missing token"))
+			return
+		}
+		if fromCookie && a.conf.CSRFProtection && !validCSRF(r) {
+			renderErrorResponse(r.Context(), w, "invalid csrf token", internal.NewErrorf(internal.ErrorCodeForbidden, "csrf token mismatch"))
+			return
+		}
+		payload, err := a.svc.VerifyToken(token)
 		if err != nil {
 			renderErrorResponse(r.Context(), w, "invalid token", err)
 			return
 		}
-		payload, err := a.svc.VerifyToken(c.Value)
+		revoked, err := a.svc.IsTokenRevoked(r.Context(), payload)
 		if err != nil {
-			renderErrorResponse(r.Context(), w, "invalid token", err)
+			renderErrorResponse(r.Context(), w, "error checking token", err)
+			return
+		}
+		if revoked {
+			renderErrorResponse(r.Context(), w, "invalid token", internal.NewErrorf(internal.ErrorCodeUnauthorized, "token was revoked"))
+			return
+		}
+		stale, err := a.svc.IsPermissionStale(r.Context(), payload)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "error checking token", err)
+			return
+		}
+		if stale {
+			renderErrorResponse(r.Context(), w, "invalid token", internal.NewErrorf(internal.ErrorCodeUnauthorized, "token permissions changed, refresh the token"))
 			return
 		}
 		r.Header.Set("username", payload.Username)
+		r, err = a.withTenant(r, payload.Username, payload.Tenant)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "error getting tenant", err)
+			return
+		}
 		// fmt.Println(payload)
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(tokenmaker.NewContext(r.Context(), payload)))
 	})
 }
+
+// withTenant makes the request act in the tenant, the tenant the account acts in when none is given as
+// for API keys and tokens issued before tenants existed.
+func (a *RBACHandler) withTenant(r *http.Request, username string, tenantId string) (*http.Request, error) {
+	if tenantId == "" {
+		tenant, err := a.svc.ActiveTenant(r.Context(), username)
+		if err != nil {
+			return r, err
+		}
+		tenantId = tenant.Id
+	}
+	return r.WithContext(internal.NewTenantContext(r.Context(), tenantId)), nil
+}
+
+// accessToken returns the access token sent with the request, from the Authorization header or the
+// token cookie depending on the configured precedence, and whether it was read from the cookie.
+func (a *RBACHandler) accessToken(r *http.Request) (string, bool) {
+	var bearer, cookie string
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, bearerScheme) {
+		bearer = strings.TrimPrefix(auth, bearerScheme)
+	}
+	if c, err := r.Cookie("token"); err == nil {
+		cookie = c.Value
+	}
+	if a.conf.TokenPrecedence == TokenPrecedenceCookie {
+		if cookie != "" {
+			return cookie, true
+		}
+		return bearer, false
+	}
+	if bearer != "" {
+		return bearer, false
+	}
+	return cookie, cookie != ""
+}
+
+// validCSRF implements the double submit check, safe methods don't need it as they must not change state.
+func validCSRF(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	c, err := r.Cookie("csrf_token")
+	if err != nil || c.Value == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(c.Value), []byte(r.Header.Get(csrfHeader))) == 1
+}
@@ -0,0 +1,233 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+func TestAccessToken_Precedence(t *testing.T) {
+	t.Parallel()
+
+	// newTokenRequest sends the bearer token "token" and the cookie token "cookie"
+	newTokenRequest := func() *http.Request {
+		req := newRequest(http.MethodPut, "/v0/accounts/block/alice", nil)
+		req.AddCookie(&http.Cookie{Name: "token", Value: "cookie"})
+		return req
+	}
+	// newCookieRequest only sends the cookie token
+	newCookieRequest := func() *http.Request {
+		req := newTokenRequest()
+		req.Header.Del("Authorization")
+		return req
+	}
+	assertToken := func(expected string) func(*testing.T, *resttesting.FakeRBACService) {
+		return func(t *testing.T, s *resttesting.FakeRBACService) {
+			if actual := s.VerifyTokenArgsForCall(0); actual != expected {
+				t.Fatalf("expected token %q, actual %q", expected, actual)
+			}
+		}
+	}
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name:           "OK: 200 header first",
+			setup:          authenticate,
+			req:            newTokenRequest(),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.BlockAccountResponse{Message: "Blocked Successfully"},
+			target:         &rest.BlockAccountResponse{},
+			assert:         assertToken("token"),
+		},
+		{
+			name:           "OK: 200 header first without header",
+			setup:          authenticate,
+			req:            newCookieRequest(),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.BlockAccountResponse{Message: "Blocked Successfully"},
+			target:         &rest.BlockAccountResponse{},
+			assert:         assertToken("cookie"),
+		},
+		{
+			name:           "OK: 200 cookie first",
+			setup:          authenticate,
+			conf:           rest.Config{TokenPrecedence: rest.TokenPrecedenceCookie},
+			req:            newTokenRequest(),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.BlockAccountResponse{Message: "Blocked Successfully"},
+			target:         &rest.BlockAccountResponse{},
+			assert:         assertToken("cookie"),
+		},
+		{
+			name:           "OK: 200 cookie first without cookie",
+			setup:          authenticate,
+			conf:           rest.Config{TokenPrecedence: rest.TokenPrecedenceCookie},
+			req:            newRequest(http.MethodPut, "/v0/accounts/block/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.BlockAccountResponse{Message: "Blocked Successfully"},
+			target:         &rest.BlockAccountResponse{},
+			assert:         assertToken("token"),
+		},
+		{
+			name:  "ERR: 401 without token",
+			setup: authenticate,
+			req: func() *http.Request {
+				req := newRequest(http.MethodPut, "/v0/accounts/block/alice", nil)
+				req.Header.Del("Authorization")
+				return req
+			}(),
+			expectedStatus: http.StatusUnauthorized,
+			expected:       &errorResponse{Error: "invalid token"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.VerifyTokenCallCount() != 0 {
+					t.Fatalf("expected no token to be verified")
+				}
+			},
+		},
+	})
+}
+
+func TestCSRF(t *testing.T) {
+	t.Parallel()
+
+	// newCookieRequest authenticates with the cookie token, header repeats the CSRF cookie when not empty
+	newCookieRequest := func(method string, target string, header string) *http.Request {
+		req := newRequest(method, target, nil)
+		req.Header.Del("Authorization")
+		req.AddCookie(&http.Cookie{Name: "token", Value: "cookie"})
+		req.AddCookie(&http.Cookie{Name: "csrf_token", Value: "csrf"})
+		if header != "" {
+			req.Header.Set("X-CSRF-Token", header)
+		}
+		return req
+	}
+	conf := rest.Config{TokenPrecedence: rest.TokenPrecedenceCookie, CSRFProtection: true}
+	assertNotBlocked := func(t *testing.T, s *resttesting.FakeRBACService) {
+		if s.VerifyTokenCallCount() != 0 || s.BlockAccountCallCount() != 0 {
+			t.Fatalf("expected the request to be refused before its token is verified")
+		}
+	}
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name:           "OK: 200",
+			setup:          authenticate,
+			conf:           conf,
+			req:            newCookieRequest(http.MethodPut, "/v0/accounts/block/alice", "csrf"),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.BlockAccountResponse{Message: "Blocked Successfully"},
+			target:         &rest.BlockAccountResponse{},
+		},
+		{
+			name:           "ERR: 403 mismatch",
+			setup:          authenticate,
+			conf:           conf,
+			req:            newCookieRequest(http.MethodPut, "/v0/accounts/block/alice", "forged"),
+			expectedStatus: http.StatusForbidden,
+			expected:       &errorResponse{Error: "invalid csrf token"},
+			target:         &errorResponse{},
+			assert:         assertNotBlocked,
+		},
+		{
+			name:           "ERR: 403 missing header",
+			setup:          authenticate,
+			conf:           conf,
+			req:            newCookieRequest(http.MethodPut, "/v0/accounts/block/alice", ""),
+			expectedStatus: http.StatusForbidden,
+			expected:       &errorResponse{Error: "invalid csrf token"},
+			target:         &errorResponse{},
+			assert:         assertNotBlocked,
+		},
+		{
+			name: "OK: 200 safe method",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.EmergencyRolesReturns([]internal.Roles{{Id: "r1", Role: "EMERGENCY"}}, nil)
+			},
+			conf:           conf,
+			req:            newCookieRequest(http.MethodGet, "/v0/breakglass/roles", ""),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.EmergencyRolesResponse{Roles: []rest.Role{{Id: "r1", Role: "EMERGENCY"}}},
+			target:         &rest.EmergencyRolesResponse{},
+		},
+		{
+			name:           "OK: 200 bearer token",
+			setup:          authenticate,
+			conf:           conf,
+			req:            newRequest(http.MethodPut, "/v0/accounts/block/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.BlockAccountResponse{Message: "Blocked Successfully"},
+			target:         &rest.BlockAccountResponse{},
+		},
+	})
+}
+
+func TestCSRF_Cookie(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		setup func(*resttesting.FakeRBACService)
+		req   *http.Request
+	}{
+		{
+			"login",
+			func(s *resttesting.FakeRBACService) {
+				s.CreateTokenReturns("access", nil)
+				s.CreateRefreshTokenReturns("refresh", nil)
+			},
+			newRequest(http.MethodPost, "/v0/login", &rest.LoginRequest{Username: "alice", Password: "secret"}),
+		},
+		{
+			"refresh",
+			func(s *resttesting.FakeRBACService) {
+				s.RefreshTokenReturns("access", "next", nil)
+			},
+			newRequest(http.MethodPost, "/v0/token/refresh", &rest.RefreshTokenRequest{RefreshToken: "refresh"}),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeRBACService{}
+			tt.setup(svc)
+
+			rest.NewRBACHandler(svc, rest.Config{CSRFProtection: true}).Register(router)
+
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, tt.req)
+			res := rr.Result()
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusCreated {
+				t.Fatalf("expected code %d, actual %d", http.StatusCreated, res.StatusCode)
+			}
+			cookies := map[string]*http.Cookie{}
+			for _, c := range res.Cookies() {
+				cookies[c.Name] = c
+			}
+			csrf, ok := cookies["csrf_token"]
+			if !ok || csrf.Value == "" {
+				t.Fatalf("expected a csrf_token cookie, actual %v", res.Cookies())
+			}
+			// the page must read the cookie to repeat it in the header
+			if csrf.HttpOnly {
+				t.Fatalf("expected the csrf_token cookie to be readable by scripts")
+			}
+			if cookies["token"] == nil || cookies["token"].Value != "access" {
+				t.Fatalf("expected the access token cookie, actual %v", res.Cookies())
+			}
+		})
+	}
+}
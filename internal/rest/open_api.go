@@ -36,6 +36,42 @@ func NewOpenAPI3() openapi3.Swagger {
 		},
 	}
 
+	swagger.Components.SecuritySchemes = openapi3.SecuritySchemes{
+		"BearerAuth": &openapi3.SecuritySchemeRef{
+			Value: openapi3.NewSecurityScheme().
+				WithType("http").
+				WithScheme("bearer").
+				WithDescription("Access token returned by login, sent as `Authorization: Bearer <token>`."),
+		},
+		"CookieAuth": &openapi3.SecuritySchemeRef{
+			Value: openapi3.NewSecurityScheme().
+				WithType("apiKey").
+				WithIn("cookie").
+				WithName("token").
+				WithDescription("Access token set as a cookie by login, unsafe requests also need the X-CSRF-Token header."),
+		},
+		"CSRFToken": &openapi3.SecuritySchemeRef{
+			Value: openapi3.NewSecurityScheme().
+				WithType("apiKey").
+				WithIn("header").
+				WithName(csrfHeader).
+				WithDescription("Value of the csrf_token cookie, required with CookieAuth on POST, PUT and DELETE requests."),
+		},
+		"APIKeyAuth": &openapi3.SecuritySchemeRef{
+			Value: openapi3.NewSecurityScheme().
+				WithType("apiKey").
+				WithIn("header").
+				WithName("Authorization").
+				WithDescription("API key sent as `Authorization: ApiKey <key>`."),
+		},
+	}
+
+	swagger.Security = openapi3.SecurityRequirements{
+		openapi3.NewSecurityRequirement().Authenticate("BearerAuth"),
+		openapi3.NewSecurityRequirement().Authenticate("CookieAuth").Authenticate("CSRFToken"),
+		openapi3.NewSecurityRequirement().Authenticate("APIKeyAuth"),
+	}
+
 	swagger.Components.Schemas = openapi3.Schemas{
 		"Profile": openapi3.NewSchemaRef("",
 			openapi3.NewObjectSchema().
@@ -56,6 +92,54 @@ func NewOpenAPI3() openapi3.Swagger {
 				WithPropertyRef("profile", &openapi3.SchemaRef{
 					Ref: "#/components/schemas/Profile",
 				})),
+		"Resource": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("type", openapi3.NewStringSchema()).
+				WithProperty("id", openapi3.NewStringSchema())),
+		"PermissionCheck": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("subject", openapi3.NewStringSchema()).
+				WithProperty("task", openapi3.NewStringSchema()).
+				WithPropertyRef("resource", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Resource",
+				}).
+				WithProperty("ip", openapi3.NewStringSchema()).
+				WithProperty("time", openapi3.NewStringSchema().WithFormat("date-time"))),
+		"PermissionDecision": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("subject", openapi3.NewStringSchema()).
+				WithProperty("task", openapi3.NewStringSchema()).
+				WithPropertyRef("resource", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Resource",
+				}).
+				WithProperty("allowed", openapi3.NewBoolSchema()).
+				WithProperty("error", openapi3.NewStringSchema())),
+		"ExplainedRole": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("role", openapi3.NewStringSchema()).
+				WithProperty("via", openapi3.NewStringSchema()).
+				WithProperty("inherited_from", openapi3.NewStringSchema()).
+				WithProperty("grants", openapi3.NewBoolSchema()).
+				WithProperty("denies", openapi3.NewBoolSchema())),
+		"ExplainedGrant": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("role_id", openapi3.NewUUIDSchema()).
+				WithPropertyRef("role_scope", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Resource",
+				}).
+				WithPropertyRef("task_scope", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Resource",
+				}).
+				WithProperty("condition", openapi3.NewStringSchema()).
+				WithProperty("effect", openapi3.NewStringSchema()).
+				WithProperty("covers", openapi3.NewBoolSchema()).
+				WithProperty("holds", openapi3.NewBoolSchema())),
+		"Lookup": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("name", openapi3.NewStringSchema()).
+				WithProperty("key", openapi3.NewStringSchema()).
+				WithProperty("source", openapi3.NewStringSchema())),
 	}
 
 	swagger.Components.RequestBodies = openapi3.RequestBodies{
@@ -87,6 +171,24 @@ func NewOpenAPI3() openapi3.Swagger {
 					WithProperty("mobile", openapi3.NewStringSchema()).
 					WithProperty("email", openapi3.NewStringSchema())),
 		},
+		"CheckPermissionsRequest": &openapi3.RequestBodyRef{
+			Value: openapi3.NewRequestBody().
+				WithDescription("Request used for checking whether subjects may perform tasks, at most 100 checks at once.").
+				WithRequired(true).
+				WithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("checks", arrayOfRef("#/components/schemas/PermissionCheck"))),
+		},
+		"ExplainPermissionRequest": &openapi3.RequestBodyRef{
+			Value: openapi3.NewRequestBody().
+				WithDescription("Request used for explaining whether an account may perform a task.").
+				WithRequired(true).
+				WithJSONSchema(openapi3.NewSchema().
+					WithProperty("username", openapi3.NewStringSchema()).
+					WithProperty("task", openapi3.NewStringSchema()).
+					WithPropertyRef("resource", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Resource",
+					})),
+		},
 	}
 
 	swagger.Components.Responses = openapi3.Responses{
@@ -110,6 +212,27 @@ func NewOpenAPI3() openapi3.Swagger {
 						Ref: "#/components/schemas/Account",
 					}))),
 		},
+		"CheckPermissionsResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after checking permissions, one decision per check in the same order.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("decisions", arrayOfRef("#/components/schemas/PermissionDecision")))),
+		},
+		"ExplainPermissionResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after explaining a permission, with the roles, grants and lookups behind the decision.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithProperty("username", openapi3.NewStringSchema()).
+					WithProperty("task", openapi3.NewStringSchema()).
+					WithPropertyRef("resource", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Resource",
+					}).
+					WithProperty("allowed", openapi3.NewBoolSchema()).
+					WithProperty("reason", openapi3.NewStringSchema()).
+					WithPropertyRef("roles", arrayOfRef("#/components/schemas/ExplainedRole")).
+					WithPropertyRef("grants", arrayOfRef("#/components/schemas/ExplainedGrant")).
+					WithPropertyRef("lookups", arrayOfRef("#/components/schemas/Lookup")))),
+		},
 		// "ReadTasksResponse": &openapi3.ResponseRef{
 		// 	Value: openapi3.NewResponse().
 		// 		WithDescription("Response returned back after searching one task.").
@@ -122,6 +245,7 @@ func NewOpenAPI3() openapi3.Swagger {
 		"/register": &openapi3.PathItem{
 			Post: &openapi3.Operation{
 				OperationID: "RegisterAccount",
+				Security:    openapi3.NewSecurityRequirements(),
 				RequestBody: &openapi3.RequestBodyRef{
 					Ref: "#/components/requestBodies/CreateAccountRequest",
 				},
@@ -157,6 +281,52 @@ func NewOpenAPI3() openapi3.Swagger {
 				},
 			},
 		},
+		"/authz/check": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "CheckPermissions",
+				Description: "Decides whether each subject may perform the task, on the resource when one is given. Requires the check permission task.",
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/CheckPermissionsRequest",
+				},
+				Responses: openapi3.Responses{
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"403": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/CheckPermissionsResponse",
+					},
+				},
+			},
+		},
+		"/authz/explain": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "ExplainPermission",
+				Description: "Explains whether the account may perform the task, on the resource when one is given. Requires the explain permission task.",
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/ExplainPermissionRequest",
+				},
+				Responses: openapi3.Responses{
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"403": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ExplainPermissionResponse",
+					},
+				},
+			},
+		},
 		// 	Put: &openapi3.Operation{
 		// 		OperationID: "UpdateTask",
 		// 		Parameters: []*openapi3.ParameterRef{
@@ -186,6 +356,13 @@ func NewOpenAPI3() openapi3.Swagger {
 	return swagger
 }
 
+// arrayOfRef returns the schema of an array of the referenced schema.
+func arrayOfRef(ref string) *openapi3.SchemaRef {
+	schema := openapi3.NewArraySchema()
+	schema.Items = &openapi3.SchemaRef{Ref: ref}
+	return openapi3.NewSchemaRef("", schema)
+}
+
 func RegisterOpenAPI(r *mux.Router) {
 	swagger := NewOpenAPI3()
 
@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type ForgotPasswordRequest struct {
+	Username string `json:"username"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type PasswordResponse struct {
+	Message string `json:"message"`
+}
+
+func (rb *RBACHandler) forgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	err := rb.svc.ForgotPassword(r.Context(), req.Username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error requesting password reset", err)
+		return
+	}
+	renderResponse(w,
+		&PasswordResponse{
+			Message: "If the account exists a password reset token was sent",
+		}, http.StatusAccepted)
+}
+
+func (rb *RBACHandler) resetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	err := rb.svc.ResetPassword(r.Context(), req.Token, req.Password)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error resetting password", err)
+		return
+	}
+	renderResponse(w,
+		&PasswordResponse{
+			Message: "Password Reset Successfully",
+		}, http.StatusOK)
+}
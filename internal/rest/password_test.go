@@ -0,0 +1,59 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+)
+
+func TestForgotPassword_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name:           "OK: 202",
+			setup:          func(s *resttesting.FakeRBACService) {},
+			req:            newRequest(http.MethodPost, "/v0/password/forgot", &rest.ForgotPasswordRequest{Username: "alice"}),
+			expectedStatus: http.StatusAccepted,
+			expected:       &rest.PasswordResponse{Message: "If the account exists a password reset token was sent"},
+			target:         &rest.PasswordResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.ForgotPasswordArgsForCall(0); username != "alice" {
+					t.Fatalf("expected username %q, actual %q", "alice", username)
+				}
+			},
+		},
+	})
+}
+
+func TestResetPassword_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name:           "OK: 200",
+			setup:          func(s *resttesting.FakeRBACService) {},
+			req:            newRequest(http.MethodPost, "/v0/password/reset", &rest.ResetPasswordRequest{Token: "reset", Password: "secret"}),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.PasswordResponse{Message: "Password Reset Successfully"},
+			target:         &rest.PasswordResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, token, password := s.ResetPasswordArgsForCall(0); token != "reset" || password != "secret" {
+					t.Fatalf("unexpected reset with token %q and password %q", token, password)
+				}
+			},
+		},
+		{
+			name: "ERR: 401",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.ResetPasswordReturns(internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid password reset token"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/password/reset", &rest.ResetPasswordRequest{Token: "used", Password: "secret"}),
+			expectedStatus: http.StatusUnauthorized,
+			expected:       &errorResponse{Error: "error resetting password"},
+			target:         &errorResponse{},
+		},
+	})
+}
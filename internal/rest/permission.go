@@ -0,0 +1,148 @@
+package rest
+
+import (
+	"net/http"
+	"rbac/internal"
+
+	"github.com/gorilla/mux"
+)
+
+// SourceRole is a role granting or denying a task to an account.
+type SourceRole struct {
+	Id   string `json:"id"`
+	Role string `json:"role"`
+}
+
+type EffectiveTask struct {
+	Task  Task         `json:"task"`
+	Roles []SourceRole `json:"roles"`
+}
+
+type AccountPermissionsResponse struct {
+	Username    string          `json:"username"`
+	Tasks       []EffectiveTask `json:"tasks"`
+	DeniedTasks []EffectiveTask `json:"denied_tasks"`
+}
+
+type TaskHolder struct {
+	Username string       `json:"username"`
+	Roles    []SourceRole `json:"roles"`
+}
+
+type TaskAccountsResponse struct {
+	Task     Task         `json:"task"`
+	Accounts []TaskHolder `json:"accounts"`
+}
+
+func (rb *RBACHandler) accountPermissions(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_ACCOUNT, internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: username})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed && authusername != username {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	p, err := rb.svc.EffectivePermissions(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting effective permissions", err)
+		return
+	}
+	renderResponse(w, &AccountPermissionsResponse{
+		Username:    p.Username,
+		Tasks:       effectiveTasks(p.Tasks),
+		DeniedTasks: effectiveTasks(p.DeniedTasks),
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) taskAccounts(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	taskId := mux.Vars(r)["taskId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_TASK, internal.Resource{Type: internal.RESOURCE_TASK, Id: taskId})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	th, err := rb.svc.TaskHolders(r.Context(), taskId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting task holders", err)
+		return
+	}
+	accounts := make([]TaskHolder, 0, len(th.Accounts))
+	for _, value := range th.Accounts {
+		accounts = append(accounts, TaskHolder{
+			Username: value.Username,
+			Roles:    sourceRoles(value.Roles),
+		})
+	}
+	renderResponse(w, &TaskAccountsResponse{
+		Task: Task{
+			Id:        th.Task.Id,
+			Task:      th.Task.Task,
+			CreatedAt: th.Task.CreatedAt,
+		},
+		Accounts: accounts,
+	}, http.StatusOK)
+}
+
+// accountRoles returns the roles of the account with their tasks, false is returned when an error was
+// rendered.
+func (rb *RBACHandler) accountRoles(w http.ResponseWriter, r *http.Request, username string) ([]Role, bool) {
+	rts, err := rb.svc.AccountRoleTasks(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting task by role", err)
+		return nil, false
+	}
+	roles := make([]Role, 0, len(rts))
+	for _, rt := range rts {
+		var tasks []Task
+		for _, value := range rt.Tasks {
+			tasks = append(tasks, Task{
+				Id:        value.Id,
+				Task:      value.Task,
+				CreatedAt: value.CreatedAt,
+			})
+		}
+		roles = append(roles, Role{
+			Id:         rt.Role.Id,
+			Role:       rt.Role.Role,
+			Task:       tasks,
+			DeniedTask: deniedTasks(rt),
+			CreatedAt:  rt.Role.CreatedAt,
+		})
+	}
+	return roles, true
+}
+
+func effectiveTasks(tasks []internal.EffectiveTask) []EffectiveTask {
+	res := make([]EffectiveTask, 0, len(tasks))
+	for _, value := range tasks {
+		res = append(res, EffectiveTask{
+			Task: Task{
+				Id:        value.Task.Id,
+				Task:      value.Task.Task,
+				CreatedAt: value.Task.CreatedAt,
+			},
+			Roles: sourceRoles(value.Roles),
+		})
+	}
+	return res
+}
+
+func sourceRoles(roles []internal.Roles) []SourceRole {
+	res := make([]SourceRole, 0, len(roles))
+	for _, value := range roles {
+		res = append(res, SourceRole{
+			Id:   value.Id,
+			Role: value.Role,
+		})
+	}
+	return res
+}
@@ -0,0 +1,119 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+	"time"
+)
+
+func TestAccountPermissions_Get(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.EffectivePermissionsReturns(internal.EffectivePermissions{
+					Username: "alice",
+					Tasks: []internal.EffectiveTask{{
+						Task:  internal.Tasks{Id: "t1", Task: "document.read", CreatedAt: createdAt},
+						Roles: []internal.Roles{{Id: "r1", Role: "EDITOR"}, {Id: "r2", Role: "VIEWER"}},
+					}},
+					DeniedTasks: []internal.EffectiveTask{{
+						Task:  internal.Tasks{Id: "t2", Task: "document.write", CreatedAt: createdAt},
+						Roles: []internal.Roles{{Id: "r3", Role: "AUDITOR"}},
+					}},
+				}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/accounts/permissions/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.AccountPermissionsResponse{
+				Username: "alice",
+				Tasks: []rest.EffectiveTask{{
+					Task:  rest.Task{Id: "t1", Task: "document.read", CreatedAt: createdAt},
+					Roles: []rest.SourceRole{{Id: "r1", Role: "EDITOR"}, {Id: "r2", Role: "VIEWER"}},
+				}},
+				DeniedTasks: []rest.EffectiveTask{{
+					Task:  rest.Task{Id: "t2", Task: "document.write", CreatedAt: createdAt},
+					Roles: []rest.SourceRole{{Id: "r3", Role: "AUDITOR"}},
+				}},
+			},
+			target: &rest.AccountPermissionsResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.EffectivePermissionsArgsForCall(0); username != "alice" {
+					t.Fatalf("expected username %q, actual %q", "alice", username)
+				}
+			},
+		},
+		{
+			name: "OK: 200 own",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsAllowedOnReturns(false, nil)
+				s.EffectivePermissionsReturns(internal.EffectivePermissions{Username: "admin"}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/accounts/permissions/admin", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.AccountPermissionsResponse{
+				Username:    "admin",
+				Tasks:       []rest.EffectiveTask{},
+				DeniedTasks: []rest.EffectiveTask{},
+			},
+			target: &rest.AccountPermissionsResponse{},
+		},
+	})
+}
+
+func TestTaskAccounts_Get(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.TaskHoldersReturns(internal.TaskHolders{
+					Task: internal.Tasks{Id: "t1", Task: "document.read", CreatedAt: createdAt},
+					Accounts: []internal.TaskHolder{
+						{Username: "alice", Roles: []internal.Roles{{Id: "r1", Role: "EDITOR"}}},
+						{Username: "bob", Roles: []internal.Roles{{Id: "r1", Role: "EDITOR"}, {Id: "r2", Role: "VIEWER"}}},
+					},
+				}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/task/accounts/t1", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.TaskAccountsResponse{
+				Task: rest.Task{Id: "t1", Task: "document.read", CreatedAt: createdAt},
+				Accounts: []rest.TaskHolder{
+					{Username: "alice", Roles: []rest.SourceRole{{Id: "r1", Role: "EDITOR"}}},
+					{Username: "bob", Roles: []rest.SourceRole{{Id: "r1", Role: "EDITOR"}, {Id: "r2", Role: "VIEWER"}}},
+				},
+			},
+			target: &rest.TaskAccountsResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, taskId := s.TaskHoldersArgsForCall(0); taskId != "t1" {
+					t.Fatalf("expected task %q, actual %q", "t1", taskId)
+				}
+			},
+		},
+		{
+			name: "ERR: 404",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.TaskHoldersReturns(internal.TaskHolders{}, internal.NewErrorf(internal.ErrorCodeNotFound, "task not found"))
+			},
+			req:            newRequest(http.MethodGet, "/v0/task/accounts/t2", nil),
+			expectedStatus: http.StatusNotFound,
+			expected:       &errorResponse{Error: "error getting task holders"},
+			target:         &errorResponse{},
+		},
+	})
+}
@@ -5,28 +5,80 @@ import (
 	"net/http"
 	"rbac/internal"
 	"rbac/internal/tokenmaker"
+	"time"
 
 	"github.com/gorilla/mux"
 )
 
 //go:generate counterfeiter -o resttesting/rbac_service.gen.go . RBACService
 type RBACService interface {
-	Logout(ctx context.Context) error
-	Login(ctx context.Context, username string, password string) error
+	Logout(ctx context.Context, token string, refreshToken string) error
+	Login(ctx context.Context, username string, password string, ip string) error
 	CreateAccount(ctx context.Context, account internal.Account, password string) (string, error)
 	Account(ctx context.Context, username string) (internal.Account, error)
 	AccountByID(ctx context.Context, id string) (internal.Account, error)
 	UpdateProfile(ctx context.Context, profile internal.Profile) error
 	ChangePassword(ctx context.Context, username string, password string) error
+	ForgotPassword(ctx context.Context, username string) error
+	ResetPassword(ctx context.Context, token string, password string) error
+	MFAStatus(ctx context.Context, username string) (internal.MFAStatus, error)
+	CreateMFAChallenge(ctx context.Context, username string) (string, error)
+	EnrollMFA(ctx context.Context, username string) (internal.MFAEnrollment, error)
+	EnrollMFAWithChallenge(ctx context.Context, challenge string) (internal.MFAEnrollment, error)
+	ConfirmMFA(ctx context.Context, username string, code string) ([]string, error)
+	VerifyMFA(ctx context.Context, challenge string, code string, ip string) (string, []string, error)
+	DisableMFA(ctx context.Context, username string) error
+	DisableOwnMFA(ctx context.Context, username string, code string) error
 	DeleteAccount(ctx context.Context, username string) error
+	BlockAccount(ctx context.Context, username string) error
+	UnblockAccount(ctx context.Context, username string) error
+	CreateServiceAccount(ctx context.Context, username string) (internal.Account, error)
+	CreateAPIKey(ctx context.Context, username string, name string, expiresAt time.Time) (string, internal.APIKey, error)
+	APIKeys(ctx context.Context, username string) ([]internal.APIKey, error)
+	RevokeAPIKey(ctx context.Context, username string, id string) error
+	AuthenticateAPIKey(ctx context.Context, key string) (string, error)
+	AccountLockout(ctx context.Context, username string) (internal.Lockout, error)
+	ClearAccountLockout(ctx context.Context, username string) error
+	IPLockout(ctx context.Context, ip string) (internal.Lockout, error)
+	ClearIPLockout(ctx context.Context, ip string) error
 	ListAccount(ctx context.Context, args internal.ListArgs) (internal.ListAccount, error)
 	IsAllowed(ctx context.Context, username string, task string) (bool, error)
+	IsAllowedOn(ctx context.Context, username string, task string, resource internal.Resource) (bool, error)
+	CheckPermissions(ctx context.Context, checks []internal.PermissionCheck) ([]internal.PermissionDecision, error)
+	ExplainPermission(ctx context.Context, username string, task string, resource *internal.Resource) (internal.Explanation, error)
+	AccountRoleTasks(ctx context.Context, username string) ([]internal.RoleTaskByRole, error)
+	EffectivePermissions(ctx context.Context, username string) (internal.EffectivePermissions, error)
+	TaskHolders(ctx context.Context, taskId string) (internal.TaskHolders, error)
+	CreateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) (string, error)
+	ExclusiveRoleSet(ctx context.Context, id string) (internal.ExclusiveRoleSet, error)
+	ExclusiveRoleSets(ctx context.Context) ([]internal.ExclusiveRoleSet, error)
+	UpdateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) error
+	DeleteExclusiveRoleSet(ctx context.Context, id string) error
+	RoleConflicts(ctx context.Context) ([]internal.RoleConflict, error)
+	CreateAccessRequest(ctx context.Context, request internal.AccessRequest) (string, error)
+	AccessRequest(ctx context.Context, id string) (internal.AccessRequest, error)
+	AccessRequests(ctx context.Context) ([]internal.AccessRequest, error)
+	AccountAccessRequests(ctx context.Context, username string) ([]internal.AccessRequest, error)
+	PendingApprovals(ctx context.Context, username string) ([]internal.AccessRequest, error)
+	ApproveAccessRequest(ctx context.Context, approver string, id string, comment string) error
+	RejectAccessRequest(ctx context.Context, approver string, id string, comment string) error
+	AddRoleApprover(ctx context.Context, roleId string, approverRoleId string) (string, error)
+	RemoveRoleApprover(ctx context.Context, roleId string, approverRoleId string) error
+	RoleApprovers(ctx context.Context, roleId string) ([]internal.Roles, error)
+	BreakGlass(ctx context.Context, activation internal.BreakGlassActivation) (internal.BreakGlassActivation, error)
+	BreakGlassActivations(ctx context.Context, since time.Time) ([]internal.BreakGlassActivation, error)
+	EmergencyRoles(ctx context.Context) ([]internal.Roles, error)
+	SetEmergencyRole(ctx context.Context, roleId string, emergency bool) error
 
 	CreateRole(ctx context.Context, rolename string) (string, error)
 	Role(ctx context.Context, id string) (internal.Roles, error)
 	UpdateRole(ctx context.Context, rl internal.Roles) error
 	ListRole(ctx context.Context, args internal.ListArgs) (internal.ListRole, error)
 	DeleteRole(ctx context.Context, id string) error
+	CreateRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) error
+	DeleteRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) error
+	InheritedRoles(ctx context.Context, roleId string) ([]internal.Roles, error)
+	SetRoleMFARequired(ctx context.Context, roleId string, required bool) error
 
 	CreateAccountRole(ctx context.Context, accountRole internal.AccountRoles) error
 	AccountRole(ctx context.Context, accountRoleId string) (internal.AccountRoles, error)
@@ -67,17 +119,67 @@ type RBACService interface {
 	ListNavigation(ctx context.Context, args internal.ListArgs) (internal.ListNavigation, error)
 	DeleteNavigation(ctx context.Context, id string) error
 
-	CreateToken(username string) (string, error)
+	CreateTenant(ctx context.Context, tenant internal.Tenant, username string) (string, error)
+	Tenants(ctx context.Context) ([]internal.Tenant, error)
+	AccountTenants(ctx context.Context, username string) ([]internal.Tenant, error)
+	ActiveTenant(ctx context.Context, username string) (internal.Tenant, error)
+	AddAccountTenant(ctx context.Context, username string, tenantId string) error
+	RemoveAccountTenant(ctx context.Context, username string, tenantId string) error
+	SwitchTenant(ctx context.Context, username string, tenantId string) (string, error)
+
+	CreateGroup(ctx context.Context, group internal.Group) (string, error)
+	Group(ctx context.Context, id string) (internal.Group, error)
+	Groups(ctx context.Context) ([]internal.Group, error)
+	UpdateGroup(ctx context.Context, group internal.Group) error
+	DeleteGroup(ctx context.Context, id string) error
+	AddGroupMember(ctx context.Context, groupId string, username string) error
+	RemoveGroupMember(ctx context.Context, groupId string, username string) error
+	GroupMembers(ctx context.Context, groupId string) ([]internal.GroupMember, error)
+	AddMemberGroup(ctx context.Context, groupId string, memberGroupId string) error
+	RemoveMemberGroup(ctx context.Context, groupId string, memberGroupId string) error
+	MemberGroups(ctx context.Context, groupId string) ([]internal.Group, error)
+	AddGroupRole(ctx context.Context, groupId string, roleId string) error
+	RemoveGroupRole(ctx context.Context, groupId string, roleId string) error
+	GroupRoles(ctx context.Context, groupId string) ([]internal.Roles, error)
+
+	CreateToken(ctx context.Context, username string) (string, error)
 	VerifyToken(token string) (*tokenmaker.Payload, error)
+	PublicKeys() []tokenmaker.JWK
+	TokenKeys(ctx context.Context) ([]tokenmaker.KeyInfo, error)
+	ReloadTokenKeys(ctx context.Context) ([]tokenmaker.KeyInfo, error)
+	CreateRefreshToken(ctx context.Context, username string) (string, error)
+	RefreshToken(ctx context.Context, refreshToken string) (string, string, error)
+	IsTokenRevoked(ctx context.Context, payload *tokenmaker.Payload) (bool, error)
+	IsPermissionStale(ctx context.Context, payload *tokenmaker.Payload) (bool, error)
+	RevokeSessions(ctx context.Context, username string) error
+}
+
+const (
+	// TokenPrecedenceHeader uses the Authorization header when a request sends both a header and a cookie.
+	TokenPrecedenceHeader = "HEADER"
+	// TokenPrecedenceCookie uses the cookie when a request sends both a header and a cookie.
+	TokenPrecedenceCookie = "COOKIE"
+)
+
+// Config defines how the handlers accept and return access tokens.
+type Config struct {
+	// TokenPrecedence is either TokenPrecedenceHeader or TokenPrecedenceCookie, empty means header.
+	TokenPrecedence string
+	// CSRFProtection requires a double submitted CSRF token on unsafe requests authenticated by cookie.
+	CSRFProtection bool
+	// TokenInBody returns the access and refresh tokens in the body of login and refresh responses.
+	TokenInBody bool
 }
 
 type RBACHandler struct {
-	svc RBACService
+	svc  RBACService
+	conf Config
 }
 
-func NewRBACHandler(svc RBACService) *RBACHandler {
+func NewRBACHandler(svc RBACService, conf Config) *RBACHandler {
 	return &RBACHandler{
-		svc: svc,
+		svc:  svc,
+		conf: conf,
 	}
 }
 
@@ -85,8 +187,14 @@ func (rb *RBACHandler) Register(r *mux.Router) {
 
 	v0 := r.PathPrefix("/v0/").Subrouter()
 
+	r.HandleFunc("/.well-known/jwks.json", rb.jwks).Methods(http.MethodGet)
 	r.HandleFunc("/v0/login", rb.login).Methods(http.MethodPost)
 	r.HandleFunc("/v0/register", rb.register).Methods(http.MethodPost)
+	r.HandleFunc("/v0/token/refresh", rb.refreshToken).Methods(http.MethodPost)
+	r.HandleFunc("/v0/password/forgot", rb.forgotPassword).Methods(http.MethodPost)
+	r.HandleFunc("/v0/password/reset", rb.resetPassword).Methods(http.MethodPost)
+	r.HandleFunc("/v0/mfa/enroll", rb.enrollMFAChallenge).Methods(http.MethodPost)
+	r.HandleFunc("/v0/mfa/verify", rb.verifyMFA).Methods(http.MethodPost)
 
 	v0.Use(rb.middleware)
 	accountRouter := v0.PathPrefix("/accounts/").Subrouter()
@@ -95,19 +203,100 @@ func (rb *RBACHandler) Register(r *mux.Router) {
 
 	accountRouter.HandleFunc("/{username}", rb.account).Methods(http.MethodGet)
 	accountRouter.HandleFunc("/roles/{username}", rb.getAccountRoleByAccount).Methods(http.MethodGet)
+	accountRouter.HandleFunc("/permissions/{username}", rb.accountPermissions).Methods(http.MethodGet)
 	accountRouter.HandleFunc("/", rb.listaccount).Methods(http.MethodGet)
 	accountRouter.HandleFunc("/", rb.updateProfile).Methods(http.MethodPut)
 	accountRouter.HandleFunc("/changepassword", rb.changePassword).Methods(http.MethodPut)
+	accountRouter.HandleFunc("/sessions/{username}", rb.revokeSessions).Methods(http.MethodDelete)
+	accountRouter.HandleFunc("/block/{username}", rb.blockAccount).Methods(http.MethodPut)
+	accountRouter.HandleFunc("/unblock/{username}", rb.unblockAccount).Methods(http.MethodPut)
+	accountRouter.HandleFunc("/service", rb.createServiceAccount).Methods(http.MethodPost)
+	accountRouter.HandleFunc("/apikeys/{username}", rb.createAPIKey).Methods(http.MethodPost)
+	accountRouter.HandleFunc("/apikeys/{username}", rb.apiKeys).Methods(http.MethodGet)
+	accountRouter.HandleFunc("/apikeys/{username}/{apiKeyId}", rb.revokeAPIKey).Methods(http.MethodDelete)
+	accountRouter.HandleFunc("/mfa/enroll", rb.enrollMFA).Methods(http.MethodPost)
+	accountRouter.HandleFunc("/mfa/confirm", rb.confirmMFA).Methods(http.MethodPost)
+	accountRouter.HandleFunc("/mfa/{username}", rb.disableMFA).Methods(http.MethodDelete)
 	accountRouter.HandleFunc("/{username}", rb.deleteAccount).Methods(http.MethodDelete)
 
+	authzRouter := v0.PathPrefix("/authz/").Subrouter()
+	authzRouter.HandleFunc("/check", rb.checkPermissions).Methods(http.MethodPost)
+	authzRouter.HandleFunc("/explain", rb.explainPermission).Methods(http.MethodPost)
+
+	lockoutRouter := v0.PathPrefix("/lockouts/").Subrouter()
+	lockoutRouter.HandleFunc("/accounts/{username}", rb.accountLockout).Methods(http.MethodGet)
+	lockoutRouter.HandleFunc("/accounts/{username}", rb.clearAccountLockout).Methods(http.MethodDelete)
+	lockoutRouter.HandleFunc("/ips/{ip}", rb.ipLockout).Methods(http.MethodGet)
+	lockoutRouter.HandleFunc("/ips/{ip}", rb.clearIPLockout).Methods(http.MethodDelete)
+
+	tokenRouter := v0.PathPrefix("/tokens/").Subrouter()
+	tokenRouter.HandleFunc("/keys", rb.tokenKeys).Methods(http.MethodGet)
+	tokenRouter.HandleFunc("/keys/reload", rb.reloadTokenKeys).Methods(http.MethodPost)
+	tokenRouter.HandleFunc("/tenant", rb.switchTenant).Methods(http.MethodPost)
+
+	tenantRouter := v0.PathPrefix("/tenants/").Subrouter()
+	tenantRouter.HandleFunc("/", rb.createTenant).Methods(http.MethodPost)
+	tenantRouter.HandleFunc("/", rb.listTenant).Methods(http.MethodGet)
+	tenantRouter.HandleFunc("/me", rb.myTenants).Methods(http.MethodGet)
+	tenantRouter.HandleFunc("/{tenantId}/accounts/{username}", rb.addTenantAccount).Methods(http.MethodPost)
+	tenantRouter.HandleFunc("/{tenantId}/accounts/{username}", rb.removeTenantAccount).Methods(http.MethodDelete)
+
 	roleRouter := v0.PathPrefix("/roles/").Subrouter()
 	roleRouter.HandleFunc("/", rb.createRole).Methods(http.MethodPost)
 	roleRouter.HandleFunc("/{roleId}", rb.role).Methods(http.MethodGet)
 	roleRouter.HandleFunc("/accounts/{roleId}", rb.getAccountRoleByRole).Methods(http.MethodGet)
+	roleRouter.HandleFunc("/mfa/{roleId}", rb.requireRoleMFA).Methods(http.MethodPut)
+	roleRouter.HandleFunc("/mfa/{roleId}", rb.unrequireRoleMFA).Methods(http.MethodDelete)
+	roleRouter.HandleFunc("/inherits/{roleId}", rb.createRoleInheritance).Methods(http.MethodPost)
+	roleRouter.HandleFunc("/inherits/{roleId}", rb.inheritedRoles).Methods(http.MethodGet)
+	roleRouter.HandleFunc("/inherits/{roleId}/{inheritedRoleId}", rb.deleteRoleInheritance).Methods(http.MethodDelete)
+	roleRouter.HandleFunc("/approvers/{roleId}", rb.createRoleApprover).Methods(http.MethodPost)
+	roleRouter.HandleFunc("/approvers/{roleId}", rb.roleApprovers).Methods(http.MethodGet)
+	roleRouter.HandleFunc("/approvers/{roleId}/{approverRoleId}", rb.deleteRoleApprover).Methods(http.MethodDelete)
+	roleRouter.HandleFunc("/emergency/{roleId}", rb.addEmergencyRole).Methods(http.MethodPut)
+	roleRouter.HandleFunc("/emergency/{roleId}", rb.removeEmergencyRole).Methods(http.MethodDelete)
 	roleRouter.HandleFunc("/", rb.updateRole).Methods(http.MethodPut)
 	roleRouter.HandleFunc("/", rb.listrole).Methods(http.MethodGet)
 	roleRouter.HandleFunc("/{roleId}", rb.deleteRole).Methods(http.MethodDelete)
 
+	groupRouter := v0.PathPrefix("/groups/").Subrouter()
+	groupRouter.HandleFunc("/", rb.createGroup).Methods(http.MethodPost)
+	groupRouter.HandleFunc("/", rb.listGroup).Methods(http.MethodGet)
+	groupRouter.HandleFunc("/", rb.updateGroup).Methods(http.MethodPut)
+	groupRouter.HandleFunc("/{groupId}", rb.group).Methods(http.MethodGet)
+	groupRouter.HandleFunc("/{groupId}", rb.deleteGroup).Methods(http.MethodDelete)
+	groupRouter.HandleFunc("/{groupId}/members", rb.groupMembers).Methods(http.MethodGet)
+	groupRouter.HandleFunc("/{groupId}/members/{username}", rb.addGroupMember).Methods(http.MethodPost)
+	groupRouter.HandleFunc("/{groupId}/members/{username}", rb.removeGroupMember).Methods(http.MethodDelete)
+	groupRouter.HandleFunc("/{groupId}/groups", rb.memberGroups).Methods(http.MethodGet)
+	groupRouter.HandleFunc("/{groupId}/groups/{memberGroupId}", rb.addMemberGroup).Methods(http.MethodPost)
+	groupRouter.HandleFunc("/{groupId}/groups/{memberGroupId}", rb.removeMemberGroup).Methods(http.MethodDelete)
+	groupRouter.HandleFunc("/{groupId}/roles", rb.groupRoles).Methods(http.MethodGet)
+	groupRouter.HandleFunc("/{groupId}/roles/{roleId}", rb.addGroupRole).Methods(http.MethodPost)
+	groupRouter.HandleFunc("/{groupId}/roles/{roleId}", rb.removeGroupRole).Methods(http.MethodDelete)
+
+	exclusiveRoleSetRouter := v0.PathPrefix("/exclusiverolesets/").Subrouter()
+	exclusiveRoleSetRouter.HandleFunc("/", rb.createExclusiveRoleSet).Methods(http.MethodPost)
+	exclusiveRoleSetRouter.HandleFunc("/", rb.listExclusiveRoleSet).Methods(http.MethodGet)
+	exclusiveRoleSetRouter.HandleFunc("/", rb.updateExclusiveRoleSet).Methods(http.MethodPut)
+	exclusiveRoleSetRouter.HandleFunc("/conflicts", rb.roleConflicts).Methods(http.MethodGet)
+	exclusiveRoleSetRouter.HandleFunc("/{exclusiveRoleSetId}", rb.exclusiveRoleSet).Methods(http.MethodGet)
+	exclusiveRoleSetRouter.HandleFunc("/{exclusiveRoleSetId}", rb.deleteExclusiveRoleSet).Methods(http.MethodDelete)
+
+	accessRequestRouter := v0.PathPrefix("/accessrequests/").Subrouter()
+	accessRequestRouter.HandleFunc("/", rb.createAccessRequest).Methods(http.MethodPost)
+	accessRequestRouter.HandleFunc("/", rb.listAccessRequest).Methods(http.MethodGet)
+	accessRequestRouter.HandleFunc("/me", rb.myAccessRequests).Methods(http.MethodGet)
+	accessRequestRouter.HandleFunc("/approvals", rb.pendingApprovals).Methods(http.MethodGet)
+	accessRequestRouter.HandleFunc("/{accessRequestId}", rb.accessRequest).Methods(http.MethodGet)
+	accessRequestRouter.HandleFunc("/{accessRequestId}/approve", rb.approveAccessRequest).Methods(http.MethodPut)
+	accessRequestRouter.HandleFunc("/{accessRequestId}/reject", rb.rejectAccessRequest).Methods(http.MethodPut)
+
+	breakGlassRouter := v0.PathPrefix("/breakglass/").Subrouter()
+	breakGlassRouter.HandleFunc("/", rb.breakGlass).Methods(http.MethodPost)
+	breakGlassRouter.HandleFunc("/", rb.breakGlassReport).Methods(http.MethodGet)
+	breakGlassRouter.HandleFunc("/roles", rb.emergencyRoles).Methods(http.MethodGet)
+
 	accountroleRouter := v0.PathPrefix("/accountroles/").Subrouter()
 	accountroleRouter.HandleFunc("/", rb.createAccountRole).Methods(http.MethodPost)
 	accountroleRouter.HandleFunc("/{accountRoleId}", rb.accountRole).Methods(http.MethodGet)
@@ -118,6 +307,7 @@ func (rb *RBACHandler) Register(r *mux.Router) {
 	taskRouter := v0.PathPrefix("/task/").Subrouter()
 	taskRouter.HandleFunc("/", rb.createTask).Methods(http.MethodPost)
 	taskRouter.HandleFunc("/{taskId}", rb.task).Methods(http.MethodGet)
+	taskRouter.HandleFunc("/accounts/{taskId}", rb.taskAccounts).Methods(http.MethodGet)
 	taskRouter.HandleFunc("/", rb.updateTask).Methods(http.MethodPut)
 	taskRouter.HandleFunc("/", rb.listtask).Methods(http.MethodGet)
 	taskRouter.HandleFunc("/{taskId}", rb.deleteTask).Methods(http.MethodDelete)
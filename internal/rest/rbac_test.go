@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"rbac/internal/rest"
 	"rbac/internal/rest/resttesting"
+	"rbac/internal/tokenmaker"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -98,7 +99,7 @@ func TestTasks_Post(t *testing.T) {
 			svc := &resttesting.FakeRBACService{}
 			tt.setup(svc)
 
-			rest.NewRBACHandler(svc).Register(router)
+			rest.NewRBACHandler(svc, rest.Config{}).Register(router)
 
 			//-
 
@@ -116,6 +117,79 @@ func TestTasks_Post(t *testing.T) {
 	}
 }
 
+// handlerTest is a request sent to the handler along with the response expected back.
+type handlerTest struct {
+	name           string
+	setup          func(*resttesting.FakeRBACService)
+	conf           rest.Config
+	req            *http.Request
+	expectedStatus int
+	expected       interface{}
+	target         interface{}
+	// assert checks the calls made to the service, it's optional.
+	assert func(*testing.T, *resttesting.FakeRBACService)
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func runHandlerTests(t *testing.T, tests []handlerTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeRBACService{}
+			tt.setup(svc)
+
+			rest.NewRBACHandler(svc, tt.conf).Register(router)
+
+			//-
+
+			res := doRequest(router, tt.req)
+
+			//-
+
+			if tt.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			assertResponse(t, res, test{tt.expected, tt.target})
+
+			if tt.assert != nil {
+				tt.assert(t, svc)
+			}
+		})
+	}
+}
+
+// authenticate makes the service accept the bearer token of admin acting in tenant t1, allowed to perform
+// every task.
+func authenticate(s *resttesting.FakeRBACService) {
+	s.VerifyTokenReturns(&tokenmaker.Payload{Username: "admin", Tenant: "t1"}, nil)
+	s.IsTokenRevokedReturns(false, nil)
+	s.IsPermissionStaleReturns(false, nil)
+	s.IsAllowedReturns(true, nil)
+	s.IsAllowedOnReturns(true, nil)
+}
+
+// newRequest returns a request with the body encoded as JSON and a bearer token.
+func newRequest(method string, target string, body interface{}) *http.Request {
+	var b []byte
+	if body != nil {
+		b, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, target, bytes.NewReader(b))
+	req.Header.Set("Authorization", "Bearer token")
+
+	return req
+}
+
 type test struct {
 	expected interface{}
 	target   interface{}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"rbac/internal"
 )
@@ -26,6 +27,12 @@ func renderErrorResponse(ctx context.Context, w http.ResponseWriter, msg string,
 			status = http.StatusNotFound
 		case internal.ErrorCodeInvalidArgument:
 			status = http.StatusBadRequest
+		case internal.ErrorCodeUnauthorized:
+			status = http.StatusUnauthorized
+		case internal.ErrorCodeTooManyRequests:
+			status = http.StatusTooManyRequests
+		case internal.ErrorCodeForbidden:
+			status = http.StatusForbidden
 		}
 	}
 	if err != nil {
@@ -91,3 +98,24 @@ func addCookie(w http.ResponseWriter, name, value string) {
 	}
 	http.SetCookie(w, &cookie)
 }
+
+// addCSRFCookie sets the double submit CSRF token, it isn't HttpOnly as clients must copy it into the
+// X-CSRF-Token header.
+func addCSRFCookie(w http.ResponseWriter, value string) {
+	cookie := http.Cookie{
+		Name:     "csrf_token",
+		Value:    value,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+	}
+	http.SetCookie(w, &cookie)
+}
+
+// clientIP returns the address of the peer that sent the request.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
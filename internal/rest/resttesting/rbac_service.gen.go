@@ -7,9 +7,51 @@ import (
 	"rbac/internal/rest"
 	"rbac/internal/tokenmaker"
 	"sync"
+	"time"
 )
 
 type FakeRBACService struct {
+	APIKeysStub        func(context.Context, string) ([]internal.APIKey, error)
+	aPIKeysMutex       sync.RWMutex
+	aPIKeysArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	aPIKeysReturns struct {
+		result1 []internal.APIKey
+		result2 error
+	}
+	aPIKeysReturnsOnCall map[int]struct {
+		result1 []internal.APIKey
+		result2 error
+	}
+	AccessRequestStub        func(context.Context, string) (internal.AccessRequest, error)
+	accessRequestMutex       sync.RWMutex
+	accessRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accessRequestReturns struct {
+		result1 internal.AccessRequest
+		result2 error
+	}
+	accessRequestReturnsOnCall map[int]struct {
+		result1 internal.AccessRequest
+		result2 error
+	}
+	AccessRequestsStub        func(context.Context) ([]internal.AccessRequest, error)
+	accessRequestsMutex       sync.RWMutex
+	accessRequestsArgsForCall []struct {
+		arg1 context.Context
+	}
+	accessRequestsReturns struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}
+	accessRequestsReturnsOnCall map[int]struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}
 	AccountStub        func(context.Context, string) (internal.Account, error)
 	accountMutex       sync.RWMutex
 	accountArgsForCall []struct {
@@ -24,6 +66,20 @@ type FakeRBACService struct {
 		result1 internal.Account
 		result2 error
 	}
+	AccountAccessRequestsStub        func(context.Context, string) ([]internal.AccessRequest, error)
+	accountAccessRequestsMutex       sync.RWMutex
+	accountAccessRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountAccessRequestsReturns struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}
+	accountAccessRequestsReturnsOnCall map[int]struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}
 	AccountByIDStub        func(context.Context, string) (internal.Account, error)
 	accountByIDMutex       sync.RWMutex
 	accountByIDArgsForCall []struct {
@@ -38,6 +94,20 @@ type FakeRBACService struct {
 		result1 internal.Account
 		result2 error
 	}
+	AccountLockoutStub        func(context.Context, string) (internal.Lockout, error)
+	accountLockoutMutex       sync.RWMutex
+	accountLockoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountLockoutReturns struct {
+		result1 internal.Lockout
+		result2 error
+	}
+	accountLockoutReturnsOnCall map[int]struct {
+		result1 internal.Lockout
+		result2 error
+	}
 	AccountRoleStub        func(context.Context, string) (internal.AccountRoles, error)
 	accountRoleMutex       sync.RWMutex
 	accountRoleArgsForCall []struct {
@@ -80,6 +150,183 @@ type FakeRBACService struct {
 		result1 internal.AccountRoleByRoleResult
 		result2 error
 	}
+	AccountRoleTasksStub        func(context.Context, string) ([]internal.RoleTaskByRole, error)
+	accountRoleTasksMutex       sync.RWMutex
+	accountRoleTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountRoleTasksReturns struct {
+		result1 []internal.RoleTaskByRole
+		result2 error
+	}
+	accountRoleTasksReturnsOnCall map[int]struct {
+		result1 []internal.RoleTaskByRole
+		result2 error
+	}
+	AccountTenantsStub        func(context.Context, string) ([]internal.Tenant, error)
+	accountTenantsMutex       sync.RWMutex
+	accountTenantsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountTenantsReturns struct {
+		result1 []internal.Tenant
+		result2 error
+	}
+	accountTenantsReturnsOnCall map[int]struct {
+		result1 []internal.Tenant
+		result2 error
+	}
+	ActiveTenantStub        func(context.Context, string) (internal.Tenant, error)
+	activeTenantMutex       sync.RWMutex
+	activeTenantArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	activeTenantReturns struct {
+		result1 internal.Tenant
+		result2 error
+	}
+	activeTenantReturnsOnCall map[int]struct {
+		result1 internal.Tenant
+		result2 error
+	}
+	AddAccountTenantStub        func(context.Context, string, string) error
+	addAccountTenantMutex       sync.RWMutex
+	addAccountTenantArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addAccountTenantReturns struct {
+		result1 error
+	}
+	addAccountTenantReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddGroupMemberStub        func(context.Context, string, string) error
+	addGroupMemberMutex       sync.RWMutex
+	addGroupMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addGroupMemberReturns struct {
+		result1 error
+	}
+	addGroupMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddGroupRoleStub        func(context.Context, string, string) error
+	addGroupRoleMutex       sync.RWMutex
+	addGroupRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addGroupRoleReturns struct {
+		result1 error
+	}
+	addGroupRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddMemberGroupStub        func(context.Context, string, string) error
+	addMemberGroupMutex       sync.RWMutex
+	addMemberGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addMemberGroupReturns struct {
+		result1 error
+	}
+	addMemberGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddRoleApproverStub        func(context.Context, string, string) (string, error)
+	addRoleApproverMutex       sync.RWMutex
+	addRoleApproverArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addRoleApproverReturns struct {
+		result1 string
+		result2 error
+	}
+	addRoleApproverReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ApproveAccessRequestStub        func(context.Context, string, string, string) error
+	approveAccessRequestMutex       sync.RWMutex
+	approveAccessRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	approveAccessRequestReturns struct {
+		result1 error
+	}
+	approveAccessRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuthenticateAPIKeyStub        func(context.Context, string) (string, error)
+	authenticateAPIKeyMutex       sync.RWMutex
+	authenticateAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	authenticateAPIKeyReturns struct {
+		result1 string
+		result2 error
+	}
+	authenticateAPIKeyReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	BlockAccountStub        func(context.Context, string) error
+	blockAccountMutex       sync.RWMutex
+	blockAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	blockAccountReturns struct {
+		result1 error
+	}
+	blockAccountReturnsOnCall map[int]struct {
+		result1 error
+	}
+	BreakGlassStub        func(context.Context, internal.BreakGlassActivation) (internal.BreakGlassActivation, error)
+	breakGlassMutex       sync.RWMutex
+	breakGlassArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.BreakGlassActivation
+	}
+	breakGlassReturns struct {
+		result1 internal.BreakGlassActivation
+		result2 error
+	}
+	breakGlassReturnsOnCall map[int]struct {
+		result1 internal.BreakGlassActivation
+		result2 error
+	}
+	BreakGlassActivationsStub        func(context.Context, time.Time) ([]internal.BreakGlassActivation, error)
+	breakGlassActivationsMutex       sync.RWMutex
+	breakGlassActivationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	breakGlassActivationsReturns struct {
+		result1 []internal.BreakGlassActivation
+		result2 error
+	}
+	breakGlassActivationsReturnsOnCall map[int]struct {
+		result1 []internal.BreakGlassActivation
+		result2 error
+	}
 	ChangePasswordStub        func(context.Context, string, string) error
 	changePasswordMutex       sync.RWMutex
 	changePasswordArgsForCall []struct {
@@ -93,6 +340,91 @@ type FakeRBACService struct {
 	changePasswordReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CheckPermissionsStub        func(context.Context, []internal.PermissionCheck) ([]internal.PermissionDecision, error)
+	checkPermissionsMutex       sync.RWMutex
+	checkPermissionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 []internal.PermissionCheck
+	}
+	checkPermissionsReturns struct {
+		result1 []internal.PermissionDecision
+		result2 error
+	}
+	checkPermissionsReturnsOnCall map[int]struct {
+		result1 []internal.PermissionDecision
+		result2 error
+	}
+	ClearAccountLockoutStub        func(context.Context, string) error
+	clearAccountLockoutMutex       sync.RWMutex
+	clearAccountLockoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	clearAccountLockoutReturns struct {
+		result1 error
+	}
+	clearAccountLockoutReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ClearIPLockoutStub        func(context.Context, string) error
+	clearIPLockoutMutex       sync.RWMutex
+	clearIPLockoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	clearIPLockoutReturns struct {
+		result1 error
+	}
+	clearIPLockoutReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ConfirmMFAStub        func(context.Context, string, string) ([]string, error)
+	confirmMFAMutex       sync.RWMutex
+	confirmMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	confirmMFAReturns struct {
+		result1 []string
+		result2 error
+	}
+	confirmMFAReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	CreateAPIKeyStub        func(context.Context, string, string, time.Time) (string, internal.APIKey, error)
+	createAPIKeyMutex       sync.RWMutex
+	createAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}
+	createAPIKeyReturns struct {
+		result1 string
+		result2 internal.APIKey
+		result3 error
+	}
+	createAPIKeyReturnsOnCall map[int]struct {
+		result1 string
+		result2 internal.APIKey
+		result3 error
+	}
+	CreateAccessRequestStub        func(context.Context, internal.AccessRequest) (string, error)
+	createAccessRequestMutex       sync.RWMutex
+	createAccessRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.AccessRequest
+	}
+	createAccessRequestReturns struct {
+		result1 string
+		result2 error
+	}
+	createAccessRequestReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	CreateAccountStub        func(context.Context, internal.Account, string) (string, error)
 	createAccountMutex       sync.RWMutex
 	createAccountArgsForCall []struct {
@@ -120,6 +452,34 @@ type FakeRBACService struct {
 	createAccountRoleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CreateExclusiveRoleSetStub        func(context.Context, internal.ExclusiveRoleSet) (string, error)
+	createExclusiveRoleSetMutex       sync.RWMutex
+	createExclusiveRoleSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ExclusiveRoleSet
+	}
+	createExclusiveRoleSetReturns struct {
+		result1 string
+		result2 error
+	}
+	createExclusiveRoleSetReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateGroupStub        func(context.Context, internal.Group) (string, error)
+	createGroupMutex       sync.RWMutex
+	createGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Group
+	}
+	createGroupReturns struct {
+		result1 string
+		result2 error
+	}
+	createGroupReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	CreateHelpTextStub        func(context.Context, internal.HelpText) error
 	createHelpTextMutex       sync.RWMutex
 	createHelpTextArgsForCall []struct {
@@ -132,6 +492,20 @@ type FakeRBACService struct {
 	createHelpTextReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CreateMFAChallengeStub        func(context.Context, string) (string, error)
+	createMFAChallengeMutex       sync.RWMutex
+	createMFAChallengeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	createMFAChallengeReturns struct {
+		result1 string
+		result2 error
+	}
+	createMFAChallengeReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	CreateMenuStub        func(context.Context, internal.Menu) error
 	createMenuMutex       sync.RWMutex
 	createMenuArgsForCall []struct {
@@ -156,6 +530,20 @@ type FakeRBACService struct {
 	createNavigationReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CreateRefreshTokenStub        func(context.Context, string) (string, error)
+	createRefreshTokenMutex       sync.RWMutex
+	createRefreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	createRefreshTokenReturns struct {
+		result1 string
+		result2 error
+	}
+	createRefreshTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	CreateRoleStub        func(context.Context, string) (string, error)
 	createRoleMutex       sync.RWMutex
 	createRoleArgsForCall []struct {
@@ -170,6 +558,19 @@ type FakeRBACService struct {
 		result1 string
 		result2 error
 	}
+	CreateRoleInheritanceStub        func(context.Context, string, string) error
+	createRoleInheritanceMutex       sync.RWMutex
+	createRoleInheritanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	createRoleInheritanceReturns struct {
+		result1 error
+	}
+	createRoleInheritanceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateRoleTaskStub        func(context.Context, internal.RoleTasks) error
 	createRoleTaskMutex       sync.RWMutex
 	createRoleTaskArgsForCall []struct {
@@ -182,6 +583,20 @@ type FakeRBACService struct {
 	createRoleTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CreateServiceAccountStub        func(context.Context, string) (internal.Account, error)
+	createServiceAccountMutex       sync.RWMutex
+	createServiceAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	createServiceAccountReturns struct {
+		result1 internal.Account
+		result2 error
+	}
+	createServiceAccountReturnsOnCall map[int]struct {
+		result1 internal.Account
+		result2 error
+	}
 	CreateTaskStub        func(context.Context, string) (string, error)
 	createTaskMutex       sync.RWMutex
 	createTaskArgsForCall []struct {
@@ -196,10 +611,26 @@ type FakeRBACService struct {
 		result1 string
 		result2 error
 	}
-	CreateTokenStub        func(string) (string, error)
+	CreateTenantStub        func(context.Context, internal.Tenant, string) (string, error)
+	createTenantMutex       sync.RWMutex
+	createTenantArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Tenant
+		arg3 string
+	}
+	createTenantReturns struct {
+		result1 string
+		result2 error
+	}
+	createTenantReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateTokenStub        func(context.Context, string) (string, error)
 	createTokenMutex       sync.RWMutex
 	createTokenArgsForCall []struct {
-		arg1 string
+		arg1 context.Context
+		arg2 string
 	}
 	createTokenReturns struct {
 		result1 string
@@ -233,6 +664,30 @@ type FakeRBACService struct {
 	deleteAccountRoleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteExclusiveRoleSetStub        func(context.Context, string) error
+	deleteExclusiveRoleSetMutex       sync.RWMutex
+	deleteExclusiveRoleSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteExclusiveRoleSetReturns struct {
+		result1 error
+	}
+	deleteExclusiveRoleSetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteGroupStub        func(context.Context, string) error
+	deleteGroupMutex       sync.RWMutex
+	deleteGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteGroupReturns struct {
+		result1 error
+	}
+	deleteGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteHelpTextStub        func(context.Context, string) error
 	deleteHelpTextMutex       sync.RWMutex
 	deleteHelpTextArgsForCall []struct {
@@ -281,8 +736,21 @@ type FakeRBACService struct {
 	deleteRoleReturnsOnCall map[int]struct {
 		result1 error
 	}
-	DeleteRoleTaskStub        func(context.Context, string) error
-	deleteRoleTaskMutex       sync.RWMutex
+	DeleteRoleInheritanceStub        func(context.Context, string, string) error
+	deleteRoleInheritanceMutex       sync.RWMutex
+	deleteRoleInheritanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	deleteRoleInheritanceReturns struct {
+		result1 error
+	}
+	deleteRoleInheritanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRoleTaskStub        func(context.Context, string) error
+	deleteRoleTaskMutex       sync.RWMutex
 	deleteRoleTaskArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
@@ -305,6 +773,196 @@ type FakeRBACService struct {
 	deleteTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisableMFAStub        func(context.Context, string) error
+	disableMFAMutex       sync.RWMutex
+	disableMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	disableMFAReturns struct {
+		result1 error
+	}
+	disableMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DisableOwnMFAStub        func(context.Context, string, string) error
+	disableOwnMFAMutex       sync.RWMutex
+	disableOwnMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	disableOwnMFAReturns struct {
+		result1 error
+	}
+	disableOwnMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EffectivePermissionsStub        func(context.Context, string) (internal.EffectivePermissions, error)
+	effectivePermissionsMutex       sync.RWMutex
+	effectivePermissionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	effectivePermissionsReturns struct {
+		result1 internal.EffectivePermissions
+		result2 error
+	}
+	effectivePermissionsReturnsOnCall map[int]struct {
+		result1 internal.EffectivePermissions
+		result2 error
+	}
+	EmergencyRolesStub        func(context.Context) ([]internal.Roles, error)
+	emergencyRolesMutex       sync.RWMutex
+	emergencyRolesArgsForCall []struct {
+		arg1 context.Context
+	}
+	emergencyRolesReturns struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	emergencyRolesReturnsOnCall map[int]struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	EnrollMFAStub        func(context.Context, string) (internal.MFAEnrollment, error)
+	enrollMFAMutex       sync.RWMutex
+	enrollMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	enrollMFAReturns struct {
+		result1 internal.MFAEnrollment
+		result2 error
+	}
+	enrollMFAReturnsOnCall map[int]struct {
+		result1 internal.MFAEnrollment
+		result2 error
+	}
+	EnrollMFAWithChallengeStub        func(context.Context, string) (internal.MFAEnrollment, error)
+	enrollMFAWithChallengeMutex       sync.RWMutex
+	enrollMFAWithChallengeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	enrollMFAWithChallengeReturns struct {
+		result1 internal.MFAEnrollment
+		result2 error
+	}
+	enrollMFAWithChallengeReturnsOnCall map[int]struct {
+		result1 internal.MFAEnrollment
+		result2 error
+	}
+	ExclusiveRoleSetStub        func(context.Context, string) (internal.ExclusiveRoleSet, error)
+	exclusiveRoleSetMutex       sync.RWMutex
+	exclusiveRoleSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	exclusiveRoleSetReturns struct {
+		result1 internal.ExclusiveRoleSet
+		result2 error
+	}
+	exclusiveRoleSetReturnsOnCall map[int]struct {
+		result1 internal.ExclusiveRoleSet
+		result2 error
+	}
+	ExclusiveRoleSetsStub        func(context.Context) ([]internal.ExclusiveRoleSet, error)
+	exclusiveRoleSetsMutex       sync.RWMutex
+	exclusiveRoleSetsArgsForCall []struct {
+		arg1 context.Context
+	}
+	exclusiveRoleSetsReturns struct {
+		result1 []internal.ExclusiveRoleSet
+		result2 error
+	}
+	exclusiveRoleSetsReturnsOnCall map[int]struct {
+		result1 []internal.ExclusiveRoleSet
+		result2 error
+	}
+	ExplainPermissionStub        func(context.Context, string, string, *internal.Resource) (internal.Explanation, error)
+	explainPermissionMutex       sync.RWMutex
+	explainPermissionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 *internal.Resource
+	}
+	explainPermissionReturns struct {
+		result1 internal.Explanation
+		result2 error
+	}
+	explainPermissionReturnsOnCall map[int]struct {
+		result1 internal.Explanation
+		result2 error
+	}
+	ForgotPasswordStub        func(context.Context, string) error
+	forgotPasswordMutex       sync.RWMutex
+	forgotPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	forgotPasswordReturns struct {
+		result1 error
+	}
+	forgotPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GroupStub        func(context.Context, string) (internal.Group, error)
+	groupMutex       sync.RWMutex
+	groupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	groupReturns struct {
+		result1 internal.Group
+		result2 error
+	}
+	groupReturnsOnCall map[int]struct {
+		result1 internal.Group
+		result2 error
+	}
+	GroupMembersStub        func(context.Context, string) ([]internal.GroupMember, error)
+	groupMembersMutex       sync.RWMutex
+	groupMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	groupMembersReturns struct {
+		result1 []internal.GroupMember
+		result2 error
+	}
+	groupMembersReturnsOnCall map[int]struct {
+		result1 []internal.GroupMember
+		result2 error
+	}
+	GroupRolesStub        func(context.Context, string) ([]internal.Roles, error)
+	groupRolesMutex       sync.RWMutex
+	groupRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	groupRolesReturns struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	groupRolesReturnsOnCall map[int]struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	GroupsStub        func(context.Context) ([]internal.Group, error)
+	groupsMutex       sync.RWMutex
+	groupsArgsForCall []struct {
+		arg1 context.Context
+	}
+	groupsReturns struct {
+		result1 []internal.Group
+		result2 error
+	}
+	groupsReturnsOnCall map[int]struct {
+		result1 []internal.Group
+		result2 error
+	}
 	HelpTextStub        func(context.Context, string) (internal.HelpText, error)
 	helpTextMutex       sync.RWMutex
 	helpTextArgsForCall []struct {
@@ -319,6 +977,34 @@ type FakeRBACService struct {
 		result1 internal.HelpText
 		result2 error
 	}
+	IPLockoutStub        func(context.Context, string) (internal.Lockout, error)
+	iPLockoutMutex       sync.RWMutex
+	iPLockoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	iPLockoutReturns struct {
+		result1 internal.Lockout
+		result2 error
+	}
+	iPLockoutReturnsOnCall map[int]struct {
+		result1 internal.Lockout
+		result2 error
+	}
+	InheritedRolesStub        func(context.Context, string) ([]internal.Roles, error)
+	inheritedRolesMutex       sync.RWMutex
+	inheritedRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	inheritedRolesReturns struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	inheritedRolesReturnsOnCall map[int]struct {
+		result1 []internal.Roles
+		result2 error
+	}
 	IsAllowedStub        func(context.Context, string, string) (bool, error)
 	isAllowedMutex       sync.RWMutex
 	isAllowedArgsForCall []struct {
@@ -334,6 +1020,50 @@ type FakeRBACService struct {
 		result1 bool
 		result2 error
 	}
+	IsAllowedOnStub        func(context.Context, string, string, internal.Resource) (bool, error)
+	isAllowedOnMutex       sync.RWMutex
+	isAllowedOnArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Resource
+	}
+	isAllowedOnReturns struct {
+		result1 bool
+		result2 error
+	}
+	isAllowedOnReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	IsPermissionStaleStub        func(context.Context, *tokenmaker.Payload) (bool, error)
+	isPermissionStaleMutex       sync.RWMutex
+	isPermissionStaleArgsForCall []struct {
+		arg1 context.Context
+		arg2 *tokenmaker.Payload
+	}
+	isPermissionStaleReturns struct {
+		result1 bool
+		result2 error
+	}
+	isPermissionStaleReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	IsTokenRevokedStub        func(context.Context, *tokenmaker.Payload) (bool, error)
+	isTokenRevokedMutex       sync.RWMutex
+	isTokenRevokedArgsForCall []struct {
+		arg1 context.Context
+		arg2 *tokenmaker.Payload
+	}
+	isTokenRevokedReturns struct {
+		result1 bool
+		result2 error
+	}
+	isTokenRevokedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ListAccountStub        func(context.Context, internal.ListArgs) (internal.ListAccount, error)
 	listAccountMutex       sync.RWMutex
 	listAccountArgsForCall []struct {
@@ -446,12 +1176,13 @@ type FakeRBACService struct {
 		result1 internal.ListTask
 		result2 error
 	}
-	LoginStub        func(context.Context, string, string) error
+	LoginStub        func(context.Context, string, string, string) error
 	loginMutex       sync.RWMutex
 	loginArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
+		arg4 string
 	}
 	loginReturns struct {
 		result1 error
@@ -459,10 +1190,12 @@ type FakeRBACService struct {
 	loginReturnsOnCall map[int]struct {
 		result1 error
 	}
-	LogoutStub        func(context.Context) error
+	LogoutStub        func(context.Context, string, string) error
 	logoutMutex       sync.RWMutex
 	logoutArgsForCall []struct {
 		arg1 context.Context
+		arg2 string
+		arg3 string
 	}
 	logoutReturns struct {
 		result1 error
@@ -470,6 +1203,34 @@ type FakeRBACService struct {
 	logoutReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MFAStatusStub        func(context.Context, string) (internal.MFAStatus, error)
+	mFAStatusMutex       sync.RWMutex
+	mFAStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	mFAStatusReturns struct {
+		result1 internal.MFAStatus
+		result2 error
+	}
+	mFAStatusReturnsOnCall map[int]struct {
+		result1 internal.MFAStatus
+		result2 error
+	}
+	MemberGroupsStub        func(context.Context, string) ([]internal.Group, error)
+	memberGroupsMutex       sync.RWMutex
+	memberGroupsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	memberGroupsReturns struct {
+		result1 []internal.Group
+		result2 error
+	}
+	memberGroupsReturnsOnCall map[int]struct {
+		result1 []internal.Group
+		result2 error
+	}
 	MenuStub        func(context.Context, string) (internal.Menu, error)
 	menuMutex       sync.RWMutex
 	menuArgsForCall []struct {
@@ -498,139 +1259,453 @@ type FakeRBACService struct {
 		result1 internal.Navigation
 		result2 error
 	}
-	RoleStub        func(context.Context, string) (internal.Roles, error)
-	roleMutex       sync.RWMutex
-	roleArgsForCall []struct {
+	PendingApprovalsStub        func(context.Context, string) ([]internal.AccessRequest, error)
+	pendingApprovalsMutex       sync.RWMutex
+	pendingApprovalsArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 	}
-	roleReturns struct {
-		result1 internal.Roles
+	pendingApprovalsReturns struct {
+		result1 []internal.AccessRequest
 		result2 error
 	}
-	roleReturnsOnCall map[int]struct {
-		result1 internal.Roles
+	pendingApprovalsReturnsOnCall map[int]struct {
+		result1 []internal.AccessRequest
 		result2 error
 	}
-	RoleTaskStub        func(context.Context, string) (internal.RoleTasks, error)
-	roleTaskMutex       sync.RWMutex
-	roleTaskArgsForCall []struct {
+	PublicKeysStub        func() []tokenmaker.JWK
+	publicKeysMutex       sync.RWMutex
+	publicKeysArgsForCall []struct {
+	}
+	publicKeysReturns struct {
+		result1 []tokenmaker.JWK
+	}
+	publicKeysReturnsOnCall map[int]struct {
+		result1 []tokenmaker.JWK
+	}
+	RefreshTokenStub        func(context.Context, string) (string, string, error)
+	refreshTokenMutex       sync.RWMutex
+	refreshTokenArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 	}
-	roleTaskReturns struct {
-		result1 internal.RoleTasks
-		result2 error
+	refreshTokenReturns struct {
+		result1 string
+		result2 string
+		result3 error
 	}
-	roleTaskReturnsOnCall map[int]struct {
-		result1 internal.RoleTasks
-		result2 error
+	refreshTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
 	}
-	RoleTaskByRoleStub        func(context.Context, string) (internal.RoleTaskByRole, error)
-	roleTaskByRoleMutex       sync.RWMutex
-	roleTaskByRoleArgsForCall []struct {
+	RejectAccessRequestStub        func(context.Context, string, string, string) error
+	rejectAccessRequestMutex       sync.RWMutex
+	rejectAccessRequestArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
+		arg3 string
+		arg4 string
 	}
-	roleTaskByRoleReturns struct {
-		result1 internal.RoleTaskByRole
-		result2 error
+	rejectAccessRequestReturns struct {
+		result1 error
 	}
-	roleTaskByRoleReturnsOnCall map[int]struct {
-		result1 internal.RoleTaskByRole
-		result2 error
+	rejectAccessRequestReturnsOnCall map[int]struct {
+		result1 error
 	}
-	TaskStub        func(context.Context, string) (internal.Tasks, error)
-	taskMutex       sync.RWMutex
-	taskArgsForCall []struct {
+	ReloadTokenKeysStub        func(context.Context) ([]tokenmaker.KeyInfo, error)
+	reloadTokenKeysMutex       sync.RWMutex
+	reloadTokenKeysArgsForCall []struct {
 		arg1 context.Context
-		arg2 string
 	}
-	taskReturns struct {
-		result1 internal.Tasks
+	reloadTokenKeysReturns struct {
+		result1 []tokenmaker.KeyInfo
 		result2 error
 	}
-	taskReturnsOnCall map[int]struct {
-		result1 internal.Tasks
+	reloadTokenKeysReturnsOnCall map[int]struct {
+		result1 []tokenmaker.KeyInfo
 		result2 error
 	}
-	UpdateAccountRoleStub        func(context.Context, internal.AccountRoles) error
-	updateAccountRoleMutex       sync.RWMutex
-	updateAccountRoleArgsForCall []struct {
+	RemoveAccountTenantStub        func(context.Context, string, string) error
+	removeAccountTenantMutex       sync.RWMutex
+	removeAccountTenantArgsForCall []struct {
 		arg1 context.Context
-		arg2 internal.AccountRoles
+		arg2 string
+		arg3 string
 	}
-	updateAccountRoleReturns struct {
+	removeAccountTenantReturns struct {
 		result1 error
 	}
-	updateAccountRoleReturnsOnCall map[int]struct {
+	removeAccountTenantReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateHelpTextStub        func(context.Context, internal.HelpText) error
-	updateHelpTextMutex       sync.RWMutex
-	updateHelpTextArgsForCall []struct {
+	RemoveGroupMemberStub        func(context.Context, string, string) error
+	removeGroupMemberMutex       sync.RWMutex
+	removeGroupMemberArgsForCall []struct {
 		arg1 context.Context
-		arg2 internal.HelpText
+		arg2 string
+		arg3 string
 	}
-	updateHelpTextReturns struct {
+	removeGroupMemberReturns struct {
 		result1 error
 	}
-	updateHelpTextReturnsOnCall map[int]struct {
+	removeGroupMemberReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateMenuStub        func(context.Context, internal.Menu) error
-	updateMenuMutex       sync.RWMutex
-	updateMenuArgsForCall []struct {
+	RemoveGroupRoleStub        func(context.Context, string, string) error
+	removeGroupRoleMutex       sync.RWMutex
+	removeGroupRoleArgsForCall []struct {
 		arg1 context.Context
-		arg2 internal.Menu
+		arg2 string
+		arg3 string
 	}
-	updateMenuReturns struct {
+	removeGroupRoleReturns struct {
 		result1 error
 	}
-	updateMenuReturnsOnCall map[int]struct {
+	removeGroupRoleReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateNavigationStub        func(context.Context, internal.Navigation) error
-	updateNavigationMutex       sync.RWMutex
-	updateNavigationArgsForCall []struct {
+	RemoveMemberGroupStub        func(context.Context, string, string) error
+	removeMemberGroupMutex       sync.RWMutex
+	removeMemberGroupArgsForCall []struct {
 		arg1 context.Context
-		arg2 internal.Navigation
+		arg2 string
+		arg3 string
 	}
-	updateNavigationReturns struct {
+	removeMemberGroupReturns struct {
 		result1 error
 	}
-	updateNavigationReturnsOnCall map[int]struct {
+	removeMemberGroupReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateProfileStub        func(context.Context, internal.Profile) error
-	updateProfileMutex       sync.RWMutex
-	updateProfileArgsForCall []struct {
+	RemoveRoleApproverStub        func(context.Context, string, string) error
+	removeRoleApproverMutex       sync.RWMutex
+	removeRoleApproverArgsForCall []struct {
 		arg1 context.Context
-		arg2 internal.Profile
+		arg2 string
+		arg3 string
 	}
-	updateProfileReturns struct {
+	removeRoleApproverReturns struct {
 		result1 error
 	}
-	updateProfileReturnsOnCall map[int]struct {
+	removeRoleApproverReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateRoleStub        func(context.Context, internal.Roles) error
-	updateRoleMutex       sync.RWMutex
-	updateRoleArgsForCall []struct {
+	ResetPasswordStub        func(context.Context, string, string) error
+	resetPasswordMutex       sync.RWMutex
+	resetPasswordArgsForCall []struct {
 		arg1 context.Context
-		arg2 internal.Roles
+		arg2 string
+		arg3 string
 	}
-	updateRoleReturns struct {
+	resetPasswordReturns struct {
 		result1 error
 	}
-	updateRoleReturnsOnCall map[int]struct {
+	resetPasswordReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateRoleTaskStub        func(context.Context, internal.RoleTasks) error
-	updateRoleTaskMutex       sync.RWMutex
-	updateRoleTaskArgsForCall []struct {
+	RevokeAPIKeyStub        func(context.Context, string, string) error
+	revokeAPIKeyMutex       sync.RWMutex
+	revokeAPIKeyArgsForCall []struct {
 		arg1 context.Context
-		arg2 internal.RoleTasks
+		arg2 string
+		arg3 string
+	}
+	revokeAPIKeyReturns struct {
+		result1 error
+	}
+	revokeAPIKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeSessionsStub        func(context.Context, string) error
+	revokeSessionsMutex       sync.RWMutex
+	revokeSessionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	revokeSessionsReturns struct {
+		result1 error
+	}
+	revokeSessionsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RoleStub        func(context.Context, string) (internal.Roles, error)
+	roleMutex       sync.RWMutex
+	roleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	roleReturns struct {
+		result1 internal.Roles
+		result2 error
+	}
+	roleReturnsOnCall map[int]struct {
+		result1 internal.Roles
+		result2 error
+	}
+	RoleApproversStub        func(context.Context, string) ([]internal.Roles, error)
+	roleApproversMutex       sync.RWMutex
+	roleApproversArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	roleApproversReturns struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	roleApproversReturnsOnCall map[int]struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	RoleConflictsStub        func(context.Context) ([]internal.RoleConflict, error)
+	roleConflictsMutex       sync.RWMutex
+	roleConflictsArgsForCall []struct {
+		arg1 context.Context
+	}
+	roleConflictsReturns struct {
+		result1 []internal.RoleConflict
+		result2 error
+	}
+	roleConflictsReturnsOnCall map[int]struct {
+		result1 []internal.RoleConflict
+		result2 error
+	}
+	RoleTaskStub        func(context.Context, string) (internal.RoleTasks, error)
+	roleTaskMutex       sync.RWMutex
+	roleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	roleTaskReturns struct {
+		result1 internal.RoleTasks
+		result2 error
+	}
+	roleTaskReturnsOnCall map[int]struct {
+		result1 internal.RoleTasks
+		result2 error
+	}
+	RoleTaskByRoleStub        func(context.Context, string) (internal.RoleTaskByRole, error)
+	roleTaskByRoleMutex       sync.RWMutex
+	roleTaskByRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	roleTaskByRoleReturns struct {
+		result1 internal.RoleTaskByRole
+		result2 error
+	}
+	roleTaskByRoleReturnsOnCall map[int]struct {
+		result1 internal.RoleTaskByRole
+		result2 error
+	}
+	SetEmergencyRoleStub        func(context.Context, string, bool) error
+	setEmergencyRoleMutex       sync.RWMutex
+	setEmergencyRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	setEmergencyRoleReturns struct {
+		result1 error
+	}
+	setEmergencyRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetRoleMFARequiredStub        func(context.Context, string, bool) error
+	setRoleMFARequiredMutex       sync.RWMutex
+	setRoleMFARequiredArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	setRoleMFARequiredReturns struct {
+		result1 error
+	}
+	setRoleMFARequiredReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SwitchTenantStub        func(context.Context, string, string) (string, error)
+	switchTenantMutex       sync.RWMutex
+	switchTenantArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	switchTenantReturns struct {
+		result1 string
+		result2 error
+	}
+	switchTenantReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	TaskStub        func(context.Context, string) (internal.Tasks, error)
+	taskMutex       sync.RWMutex
+	taskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	taskReturns struct {
+		result1 internal.Tasks
+		result2 error
+	}
+	taskReturnsOnCall map[int]struct {
+		result1 internal.Tasks
+		result2 error
+	}
+	TaskHoldersStub        func(context.Context, string) (internal.TaskHolders, error)
+	taskHoldersMutex       sync.RWMutex
+	taskHoldersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	taskHoldersReturns struct {
+		result1 internal.TaskHolders
+		result2 error
+	}
+	taskHoldersReturnsOnCall map[int]struct {
+		result1 internal.TaskHolders
+		result2 error
+	}
+	TenantsStub        func(context.Context) ([]internal.Tenant, error)
+	tenantsMutex       sync.RWMutex
+	tenantsArgsForCall []struct {
+		arg1 context.Context
+	}
+	tenantsReturns struct {
+		result1 []internal.Tenant
+		result2 error
+	}
+	tenantsReturnsOnCall map[int]struct {
+		result1 []internal.Tenant
+		result2 error
+	}
+	TokenKeysStub        func(context.Context) ([]tokenmaker.KeyInfo, error)
+	tokenKeysMutex       sync.RWMutex
+	tokenKeysArgsForCall []struct {
+		arg1 context.Context
+	}
+	tokenKeysReturns struct {
+		result1 []tokenmaker.KeyInfo
+		result2 error
+	}
+	tokenKeysReturnsOnCall map[int]struct {
+		result1 []tokenmaker.KeyInfo
+		result2 error
+	}
+	UnblockAccountStub        func(context.Context, string) error
+	unblockAccountMutex       sync.RWMutex
+	unblockAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	unblockAccountReturns struct {
+		result1 error
+	}
+	unblockAccountReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateAccountRoleStub        func(context.Context, internal.AccountRoles) error
+	updateAccountRoleMutex       sync.RWMutex
+	updateAccountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}
+	updateAccountRoleReturns struct {
+		result1 error
+	}
+	updateAccountRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateExclusiveRoleSetStub        func(context.Context, internal.ExclusiveRoleSet) error
+	updateExclusiveRoleSetMutex       sync.RWMutex
+	updateExclusiveRoleSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ExclusiveRoleSet
+	}
+	updateExclusiveRoleSetReturns struct {
+		result1 error
+	}
+	updateExclusiveRoleSetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateGroupStub        func(context.Context, internal.Group) error
+	updateGroupMutex       sync.RWMutex
+	updateGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Group
+	}
+	updateGroupReturns struct {
+		result1 error
+	}
+	updateGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateHelpTextStub        func(context.Context, internal.HelpText) error
+	updateHelpTextMutex       sync.RWMutex
+	updateHelpTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.HelpText
+	}
+	updateHelpTextReturns struct {
+		result1 error
+	}
+	updateHelpTextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateMenuStub        func(context.Context, internal.Menu) error
+	updateMenuMutex       sync.RWMutex
+	updateMenuArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Menu
+	}
+	updateMenuReturns struct {
+		result1 error
+	}
+	updateMenuReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateNavigationStub        func(context.Context, internal.Navigation) error
+	updateNavigationMutex       sync.RWMutex
+	updateNavigationArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Navigation
+	}
+	updateNavigationReturns struct {
+		result1 error
+	}
+	updateNavigationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateProfileStub        func(context.Context, internal.Profile) error
+	updateProfileMutex       sync.RWMutex
+	updateProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}
+	updateProfileReturns struct {
+		result1 error
+	}
+	updateProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateRoleStub        func(context.Context, internal.Roles) error
+	updateRoleMutex       sync.RWMutex
+	updateRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Roles
+	}
+	updateRoleReturns struct {
+		result1 error
+	}
+	updateRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateRoleTaskStub        func(context.Context, internal.RoleTasks) error
+	updateRoleTaskMutex       sync.RWMutex
+	updateRoleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
 	}
 	updateRoleTaskReturns struct {
 		result1 error
@@ -650,6 +1725,24 @@ type FakeRBACService struct {
 	updateTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	VerifyMFAStub        func(context.Context, string, string, string) (string, []string, error)
+	verifyMFAMutex       sync.RWMutex
+	verifyMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	verifyMFAReturns struct {
+		result1 string
+		result2 []string
+		result3 error
+	}
+	verifyMFAReturnsOnCall map[int]struct {
+		result1 string
+		result2 []string
+		result3 error
+	}
 	VerifyTokenStub        func(string) (*tokenmaker.Payload, error)
 	verifyTokenMutex       sync.RWMutex
 	verifyTokenArgsForCall []struct {
@@ -667,17 +1760,17 @@ type FakeRBACService struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeRBACService) Account(arg1 context.Context, arg2 string) (internal.Account, error) {
-	fake.accountMutex.Lock()
-	ret, specificReturn := fake.accountReturnsOnCall[len(fake.accountArgsForCall)]
-	fake.accountArgsForCall = append(fake.accountArgsForCall, struct {
+func (fake *FakeRBACService) APIKeys(arg1 context.Context, arg2 string) ([]internal.APIKey, error) {
+	fake.aPIKeysMutex.Lock()
+	ret, specificReturn := fake.aPIKeysReturnsOnCall[len(fake.aPIKeysArgsForCall)]
+	fake.aPIKeysArgsForCall = append(fake.aPIKeysArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.AccountStub
-	fakeReturns := fake.accountReturns
-	fake.recordInvocation("Account", []interface{}{arg1, arg2})
-	fake.accountMutex.Unlock()
+	stub := fake.APIKeysStub
+	fakeReturns := fake.aPIKeysReturns
+	fake.recordInvocation("APIKeys", []interface{}{arg1, arg2})
+	fake.aPIKeysMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -687,31 +1780,225 @@ func (fake *FakeRBACService) Account(arg1 context.Context, arg2 string) (interna
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) AccountCallCount() int {
-	fake.accountMutex.RLock()
-	defer fake.accountMutex.RUnlock()
-	return len(fake.accountArgsForCall)
+func (fake *FakeRBACService) APIKeysCallCount() int {
+	fake.aPIKeysMutex.RLock()
+	defer fake.aPIKeysMutex.RUnlock()
+	return len(fake.aPIKeysArgsForCall)
 }
 
-func (fake *FakeRBACService) AccountCalls(stub func(context.Context, string) (internal.Account, error)) {
-	fake.accountMutex.Lock()
-	defer fake.accountMutex.Unlock()
-	fake.AccountStub = stub
+func (fake *FakeRBACService) APIKeysCalls(stub func(context.Context, string) ([]internal.APIKey, error)) {
+	fake.aPIKeysMutex.Lock()
+	defer fake.aPIKeysMutex.Unlock()
+	fake.APIKeysStub = stub
 }
 
-func (fake *FakeRBACService) AccountArgsForCall(i int) (context.Context, string) {
-	fake.accountMutex.RLock()
-	defer fake.accountMutex.RUnlock()
-	argsForCall := fake.accountArgsForCall[i]
+func (fake *FakeRBACService) APIKeysArgsForCall(i int) (context.Context, string) {
+	fake.aPIKeysMutex.RLock()
+	defer fake.aPIKeysMutex.RUnlock()
+	argsForCall := fake.aPIKeysArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) AccountReturns(result1 internal.Account, result2 error) {
-	fake.accountMutex.Lock()
-	defer fake.accountMutex.Unlock()
-	fake.AccountStub = nil
-	fake.accountReturns = struct {
-		result1 internal.Account
+func (fake *FakeRBACService) APIKeysReturns(result1 []internal.APIKey, result2 error) {
+	fake.aPIKeysMutex.Lock()
+	defer fake.aPIKeysMutex.Unlock()
+	fake.APIKeysStub = nil
+	fake.aPIKeysReturns = struct {
+		result1 []internal.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) APIKeysReturnsOnCall(i int, result1 []internal.APIKey, result2 error) {
+	fake.aPIKeysMutex.Lock()
+	defer fake.aPIKeysMutex.Unlock()
+	fake.APIKeysStub = nil
+	if fake.aPIKeysReturnsOnCall == nil {
+		fake.aPIKeysReturnsOnCall = make(map[int]struct {
+			result1 []internal.APIKey
+			result2 error
+		})
+	}
+	fake.aPIKeysReturnsOnCall[i] = struct {
+		result1 []internal.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccessRequest(arg1 context.Context, arg2 string) (internal.AccessRequest, error) {
+	fake.accessRequestMutex.Lock()
+	ret, specificReturn := fake.accessRequestReturnsOnCall[len(fake.accessRequestArgsForCall)]
+	fake.accessRequestArgsForCall = append(fake.accessRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccessRequestStub
+	fakeReturns := fake.accessRequestReturns
+	fake.recordInvocation("AccessRequest", []interface{}{arg1, arg2})
+	fake.accessRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccessRequestCallCount() int {
+	fake.accessRequestMutex.RLock()
+	defer fake.accessRequestMutex.RUnlock()
+	return len(fake.accessRequestArgsForCall)
+}
+
+func (fake *FakeRBACService) AccessRequestCalls(stub func(context.Context, string) (internal.AccessRequest, error)) {
+	fake.accessRequestMutex.Lock()
+	defer fake.accessRequestMutex.Unlock()
+	fake.AccessRequestStub = stub
+}
+
+func (fake *FakeRBACService) AccessRequestArgsForCall(i int) (context.Context, string) {
+	fake.accessRequestMutex.RLock()
+	defer fake.accessRequestMutex.RUnlock()
+	argsForCall := fake.accessRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AccessRequestReturns(result1 internal.AccessRequest, result2 error) {
+	fake.accessRequestMutex.Lock()
+	defer fake.accessRequestMutex.Unlock()
+	fake.AccessRequestStub = nil
+	fake.accessRequestReturns = struct {
+		result1 internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccessRequestReturnsOnCall(i int, result1 internal.AccessRequest, result2 error) {
+	fake.accessRequestMutex.Lock()
+	defer fake.accessRequestMutex.Unlock()
+	fake.AccessRequestStub = nil
+	if fake.accessRequestReturnsOnCall == nil {
+		fake.accessRequestReturnsOnCall = make(map[int]struct {
+			result1 internal.AccessRequest
+			result2 error
+		})
+	}
+	fake.accessRequestReturnsOnCall[i] = struct {
+		result1 internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccessRequests(arg1 context.Context) ([]internal.AccessRequest, error) {
+	fake.accessRequestsMutex.Lock()
+	ret, specificReturn := fake.accessRequestsReturnsOnCall[len(fake.accessRequestsArgsForCall)]
+	fake.accessRequestsArgsForCall = append(fake.accessRequestsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.AccessRequestsStub
+	fakeReturns := fake.accessRequestsReturns
+	fake.recordInvocation("AccessRequests", []interface{}{arg1})
+	fake.accessRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccessRequestsCallCount() int {
+	fake.accessRequestsMutex.RLock()
+	defer fake.accessRequestsMutex.RUnlock()
+	return len(fake.accessRequestsArgsForCall)
+}
+
+func (fake *FakeRBACService) AccessRequestsCalls(stub func(context.Context) ([]internal.AccessRequest, error)) {
+	fake.accessRequestsMutex.Lock()
+	defer fake.accessRequestsMutex.Unlock()
+	fake.AccessRequestsStub = stub
+}
+
+func (fake *FakeRBACService) AccessRequestsArgsForCall(i int) context.Context {
+	fake.accessRequestsMutex.RLock()
+	defer fake.accessRequestsMutex.RUnlock()
+	argsForCall := fake.accessRequestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACService) AccessRequestsReturns(result1 []internal.AccessRequest, result2 error) {
+	fake.accessRequestsMutex.Lock()
+	defer fake.accessRequestsMutex.Unlock()
+	fake.AccessRequestsStub = nil
+	fake.accessRequestsReturns = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccessRequestsReturnsOnCall(i int, result1 []internal.AccessRequest, result2 error) {
+	fake.accessRequestsMutex.Lock()
+	defer fake.accessRequestsMutex.Unlock()
+	fake.AccessRequestsStub = nil
+	if fake.accessRequestsReturnsOnCall == nil {
+		fake.accessRequestsReturnsOnCall = make(map[int]struct {
+			result1 []internal.AccessRequest
+			result2 error
+		})
+	}
+	fake.accessRequestsReturnsOnCall[i] = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) Account(arg1 context.Context, arg2 string) (internal.Account, error) {
+	fake.accountMutex.Lock()
+	ret, specificReturn := fake.accountReturnsOnCall[len(fake.accountArgsForCall)]
+	fake.accountArgsForCall = append(fake.accountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountStub
+	fakeReturns := fake.accountReturns
+	fake.recordInvocation("Account", []interface{}{arg1, arg2})
+	fake.accountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccountCallCount() int {
+	fake.accountMutex.RLock()
+	defer fake.accountMutex.RUnlock()
+	return len(fake.accountArgsForCall)
+}
+
+func (fake *FakeRBACService) AccountCalls(stub func(context.Context, string) (internal.Account, error)) {
+	fake.accountMutex.Lock()
+	defer fake.accountMutex.Unlock()
+	fake.AccountStub = stub
+}
+
+func (fake *FakeRBACService) AccountArgsForCall(i int) (context.Context, string) {
+	fake.accountMutex.RLock()
+	defer fake.accountMutex.RUnlock()
+	argsForCall := fake.accountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AccountReturns(result1 internal.Account, result2 error) {
+	fake.accountMutex.Lock()
+	defer fake.accountMutex.Unlock()
+	fake.AccountStub = nil
+	fake.accountReturns = struct {
+		result1 internal.Account
 		result2 error
 	}{result1, result2}
 }
@@ -732,6 +2019,71 @@ func (fake *FakeRBACService) AccountReturnsOnCall(i int, result1 internal.Accoun
 	}{result1, result2}
 }
 
+func (fake *FakeRBACService) AccountAccessRequests(arg1 context.Context, arg2 string) ([]internal.AccessRequest, error) {
+	fake.accountAccessRequestsMutex.Lock()
+	ret, specificReturn := fake.accountAccessRequestsReturnsOnCall[len(fake.accountAccessRequestsArgsForCall)]
+	fake.accountAccessRequestsArgsForCall = append(fake.accountAccessRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountAccessRequestsStub
+	fakeReturns := fake.accountAccessRequestsReturns
+	fake.recordInvocation("AccountAccessRequests", []interface{}{arg1, arg2})
+	fake.accountAccessRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccountAccessRequestsCallCount() int {
+	fake.accountAccessRequestsMutex.RLock()
+	defer fake.accountAccessRequestsMutex.RUnlock()
+	return len(fake.accountAccessRequestsArgsForCall)
+}
+
+func (fake *FakeRBACService) AccountAccessRequestsCalls(stub func(context.Context, string) ([]internal.AccessRequest, error)) {
+	fake.accountAccessRequestsMutex.Lock()
+	defer fake.accountAccessRequestsMutex.Unlock()
+	fake.AccountAccessRequestsStub = stub
+}
+
+func (fake *FakeRBACService) AccountAccessRequestsArgsForCall(i int) (context.Context, string) {
+	fake.accountAccessRequestsMutex.RLock()
+	defer fake.accountAccessRequestsMutex.RUnlock()
+	argsForCall := fake.accountAccessRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AccountAccessRequestsReturns(result1 []internal.AccessRequest, result2 error) {
+	fake.accountAccessRequestsMutex.Lock()
+	defer fake.accountAccessRequestsMutex.Unlock()
+	fake.AccountAccessRequestsStub = nil
+	fake.accountAccessRequestsReturns = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountAccessRequestsReturnsOnCall(i int, result1 []internal.AccessRequest, result2 error) {
+	fake.accountAccessRequestsMutex.Lock()
+	defer fake.accountAccessRequestsMutex.Unlock()
+	fake.AccountAccessRequestsStub = nil
+	if fake.accountAccessRequestsReturnsOnCall == nil {
+		fake.accountAccessRequestsReturnsOnCall = make(map[int]struct {
+			result1 []internal.AccessRequest
+			result2 error
+		})
+	}
+	fake.accountAccessRequestsReturnsOnCall[i] = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRBACService) AccountByID(arg1 context.Context, arg2 string) (internal.Account, error) {
 	fake.accountByIDMutex.Lock()
 	ret, specificReturn := fake.accountByIDReturnsOnCall[len(fake.accountByIDArgsForCall)]
@@ -771,43 +2123,4727 @@ func (fake *FakeRBACService) AccountByIDArgsForCall(i int) (context.Context, str
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) AccountByIDReturns(result1 internal.Account, result2 error) {
-	fake.accountByIDMutex.Lock()
-	defer fake.accountByIDMutex.Unlock()
-	fake.AccountByIDStub = nil
-	fake.accountByIDReturns = struct {
-		result1 internal.Account
+func (fake *FakeRBACService) AccountByIDReturns(result1 internal.Account, result2 error) {
+	fake.accountByIDMutex.Lock()
+	defer fake.accountByIDMutex.Unlock()
+	fake.AccountByIDStub = nil
+	fake.accountByIDReturns = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountByIDReturnsOnCall(i int, result1 internal.Account, result2 error) {
+	fake.accountByIDMutex.Lock()
+	defer fake.accountByIDMutex.Unlock()
+	fake.AccountByIDStub = nil
+	if fake.accountByIDReturnsOnCall == nil {
+		fake.accountByIDReturnsOnCall = make(map[int]struct {
+			result1 internal.Account
+			result2 error
+		})
+	}
+	fake.accountByIDReturnsOnCall[i] = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountLockout(arg1 context.Context, arg2 string) (internal.Lockout, error) {
+	fake.accountLockoutMutex.Lock()
+	ret, specificReturn := fake.accountLockoutReturnsOnCall[len(fake.accountLockoutArgsForCall)]
+	fake.accountLockoutArgsForCall = append(fake.accountLockoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountLockoutStub
+	fakeReturns := fake.accountLockoutReturns
+	fake.recordInvocation("AccountLockout", []interface{}{arg1, arg2})
+	fake.accountLockoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccountLockoutCallCount() int {
+	fake.accountLockoutMutex.RLock()
+	defer fake.accountLockoutMutex.RUnlock()
+	return len(fake.accountLockoutArgsForCall)
+}
+
+func (fake *FakeRBACService) AccountLockoutCalls(stub func(context.Context, string) (internal.Lockout, error)) {
+	fake.accountLockoutMutex.Lock()
+	defer fake.accountLockoutMutex.Unlock()
+	fake.AccountLockoutStub = stub
+}
+
+func (fake *FakeRBACService) AccountLockoutArgsForCall(i int) (context.Context, string) {
+	fake.accountLockoutMutex.RLock()
+	defer fake.accountLockoutMutex.RUnlock()
+	argsForCall := fake.accountLockoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AccountLockoutReturns(result1 internal.Lockout, result2 error) {
+	fake.accountLockoutMutex.Lock()
+	defer fake.accountLockoutMutex.Unlock()
+	fake.AccountLockoutStub = nil
+	fake.accountLockoutReturns = struct {
+		result1 internal.Lockout
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountLockoutReturnsOnCall(i int, result1 internal.Lockout, result2 error) {
+	fake.accountLockoutMutex.Lock()
+	defer fake.accountLockoutMutex.Unlock()
+	fake.AccountLockoutStub = nil
+	if fake.accountLockoutReturnsOnCall == nil {
+		fake.accountLockoutReturnsOnCall = make(map[int]struct {
+			result1 internal.Lockout
+			result2 error
+		})
+	}
+	fake.accountLockoutReturnsOnCall[i] = struct {
+		result1 internal.Lockout
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountRole(arg1 context.Context, arg2 string) (internal.AccountRoles, error) {
+	fake.accountRoleMutex.Lock()
+	ret, specificReturn := fake.accountRoleReturnsOnCall[len(fake.accountRoleArgsForCall)]
+	fake.accountRoleArgsForCall = append(fake.accountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountRoleStub
+	fakeReturns := fake.accountRoleReturns
+	fake.recordInvocation("AccountRole", []interface{}{arg1, arg2})
+	fake.accountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccountRoleCallCount() int {
+	fake.accountRoleMutex.RLock()
+	defer fake.accountRoleMutex.RUnlock()
+	return len(fake.accountRoleArgsForCall)
+}
+
+func (fake *FakeRBACService) AccountRoleCalls(stub func(context.Context, string) (internal.AccountRoles, error)) {
+	fake.accountRoleMutex.Lock()
+	defer fake.accountRoleMutex.Unlock()
+	fake.AccountRoleStub = stub
+}
+
+func (fake *FakeRBACService) AccountRoleArgsForCall(i int) (context.Context, string) {
+	fake.accountRoleMutex.RLock()
+	defer fake.accountRoleMutex.RUnlock()
+	argsForCall := fake.accountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AccountRoleReturns(result1 internal.AccountRoles, result2 error) {
+	fake.accountRoleMutex.Lock()
+	defer fake.accountRoleMutex.Unlock()
+	fake.AccountRoleStub = nil
+	fake.accountRoleReturns = struct {
+		result1 internal.AccountRoles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountRoleReturnsOnCall(i int, result1 internal.AccountRoles, result2 error) {
+	fake.accountRoleMutex.Lock()
+	defer fake.accountRoleMutex.Unlock()
+	fake.AccountRoleStub = nil
+	if fake.accountRoleReturnsOnCall == nil {
+		fake.accountRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.AccountRoles
+			result2 error
+		})
+	}
+	fake.accountRoleReturnsOnCall[i] = struct {
+		result1 internal.AccountRoles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountRoleByAccount(arg1 context.Context, arg2 string) (internal.AccountRoleByAccountResult, error) {
+	fake.accountRoleByAccountMutex.Lock()
+	ret, specificReturn := fake.accountRoleByAccountReturnsOnCall[len(fake.accountRoleByAccountArgsForCall)]
+	fake.accountRoleByAccountArgsForCall = append(fake.accountRoleByAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountRoleByAccountStub
+	fakeReturns := fake.accountRoleByAccountReturns
+	fake.recordInvocation("AccountRoleByAccount", []interface{}{arg1, arg2})
+	fake.accountRoleByAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccountRoleByAccountCallCount() int {
+	fake.accountRoleByAccountMutex.RLock()
+	defer fake.accountRoleByAccountMutex.RUnlock()
+	return len(fake.accountRoleByAccountArgsForCall)
+}
+
+func (fake *FakeRBACService) AccountRoleByAccountCalls(stub func(context.Context, string) (internal.AccountRoleByAccountResult, error)) {
+	fake.accountRoleByAccountMutex.Lock()
+	defer fake.accountRoleByAccountMutex.Unlock()
+	fake.AccountRoleByAccountStub = stub
+}
+
+func (fake *FakeRBACService) AccountRoleByAccountArgsForCall(i int) (context.Context, string) {
+	fake.accountRoleByAccountMutex.RLock()
+	defer fake.accountRoleByAccountMutex.RUnlock()
+	argsForCall := fake.accountRoleByAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AccountRoleByAccountReturns(result1 internal.AccountRoleByAccountResult, result2 error) {
+	fake.accountRoleByAccountMutex.Lock()
+	defer fake.accountRoleByAccountMutex.Unlock()
+	fake.AccountRoleByAccountStub = nil
+	fake.accountRoleByAccountReturns = struct {
+		result1 internal.AccountRoleByAccountResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountRoleByAccountReturnsOnCall(i int, result1 internal.AccountRoleByAccountResult, result2 error) {
+	fake.accountRoleByAccountMutex.Lock()
+	defer fake.accountRoleByAccountMutex.Unlock()
+	fake.AccountRoleByAccountStub = nil
+	if fake.accountRoleByAccountReturnsOnCall == nil {
+		fake.accountRoleByAccountReturnsOnCall = make(map[int]struct {
+			result1 internal.AccountRoleByAccountResult
+			result2 error
+		})
+	}
+	fake.accountRoleByAccountReturnsOnCall[i] = struct {
+		result1 internal.AccountRoleByAccountResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountRoleByRole(arg1 context.Context, arg2 string) (internal.AccountRoleByRoleResult, error) {
+	fake.accountRoleByRoleMutex.Lock()
+	ret, specificReturn := fake.accountRoleByRoleReturnsOnCall[len(fake.accountRoleByRoleArgsForCall)]
+	fake.accountRoleByRoleArgsForCall = append(fake.accountRoleByRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountRoleByRoleStub
+	fakeReturns := fake.accountRoleByRoleReturns
+	fake.recordInvocation("AccountRoleByRole", []interface{}{arg1, arg2})
+	fake.accountRoleByRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccountRoleByRoleCallCount() int {
+	fake.accountRoleByRoleMutex.RLock()
+	defer fake.accountRoleByRoleMutex.RUnlock()
+	return len(fake.accountRoleByRoleArgsForCall)
+}
+
+func (fake *FakeRBACService) AccountRoleByRoleCalls(stub func(context.Context, string) (internal.AccountRoleByRoleResult, error)) {
+	fake.accountRoleByRoleMutex.Lock()
+	defer fake.accountRoleByRoleMutex.Unlock()
+	fake.AccountRoleByRoleStub = stub
+}
+
+func (fake *FakeRBACService) AccountRoleByRoleArgsForCall(i int) (context.Context, string) {
+	fake.accountRoleByRoleMutex.RLock()
+	defer fake.accountRoleByRoleMutex.RUnlock()
+	argsForCall := fake.accountRoleByRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AccountRoleByRoleReturns(result1 internal.AccountRoleByRoleResult, result2 error) {
+	fake.accountRoleByRoleMutex.Lock()
+	defer fake.accountRoleByRoleMutex.Unlock()
+	fake.AccountRoleByRoleStub = nil
+	fake.accountRoleByRoleReturns = struct {
+		result1 internal.AccountRoleByRoleResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountRoleByRoleReturnsOnCall(i int, result1 internal.AccountRoleByRoleResult, result2 error) {
+	fake.accountRoleByRoleMutex.Lock()
+	defer fake.accountRoleByRoleMutex.Unlock()
+	fake.AccountRoleByRoleStub = nil
+	if fake.accountRoleByRoleReturnsOnCall == nil {
+		fake.accountRoleByRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.AccountRoleByRoleResult
+			result2 error
+		})
+	}
+	fake.accountRoleByRoleReturnsOnCall[i] = struct {
+		result1 internal.AccountRoleByRoleResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountRoleTasks(arg1 context.Context, arg2 string) ([]internal.RoleTaskByRole, error) {
+	fake.accountRoleTasksMutex.Lock()
+	ret, specificReturn := fake.accountRoleTasksReturnsOnCall[len(fake.accountRoleTasksArgsForCall)]
+	fake.accountRoleTasksArgsForCall = append(fake.accountRoleTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountRoleTasksStub
+	fakeReturns := fake.accountRoleTasksReturns
+	fake.recordInvocation("AccountRoleTasks", []interface{}{arg1, arg2})
+	fake.accountRoleTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccountRoleTasksCallCount() int {
+	fake.accountRoleTasksMutex.RLock()
+	defer fake.accountRoleTasksMutex.RUnlock()
+	return len(fake.accountRoleTasksArgsForCall)
+}
+
+func (fake *FakeRBACService) AccountRoleTasksCalls(stub func(context.Context, string) ([]internal.RoleTaskByRole, error)) {
+	fake.accountRoleTasksMutex.Lock()
+	defer fake.accountRoleTasksMutex.Unlock()
+	fake.AccountRoleTasksStub = stub
+}
+
+func (fake *FakeRBACService) AccountRoleTasksArgsForCall(i int) (context.Context, string) {
+	fake.accountRoleTasksMutex.RLock()
+	defer fake.accountRoleTasksMutex.RUnlock()
+	argsForCall := fake.accountRoleTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AccountRoleTasksReturns(result1 []internal.RoleTaskByRole, result2 error) {
+	fake.accountRoleTasksMutex.Lock()
+	defer fake.accountRoleTasksMutex.Unlock()
+	fake.AccountRoleTasksStub = nil
+	fake.accountRoleTasksReturns = struct {
+		result1 []internal.RoleTaskByRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountRoleTasksReturnsOnCall(i int, result1 []internal.RoleTaskByRole, result2 error) {
+	fake.accountRoleTasksMutex.Lock()
+	defer fake.accountRoleTasksMutex.Unlock()
+	fake.AccountRoleTasksStub = nil
+	if fake.accountRoleTasksReturnsOnCall == nil {
+		fake.accountRoleTasksReturnsOnCall = make(map[int]struct {
+			result1 []internal.RoleTaskByRole
+			result2 error
+		})
+	}
+	fake.accountRoleTasksReturnsOnCall[i] = struct {
+		result1 []internal.RoleTaskByRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountTenants(arg1 context.Context, arg2 string) ([]internal.Tenant, error) {
+	fake.accountTenantsMutex.Lock()
+	ret, specificReturn := fake.accountTenantsReturnsOnCall[len(fake.accountTenantsArgsForCall)]
+	fake.accountTenantsArgsForCall = append(fake.accountTenantsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountTenantsStub
+	fakeReturns := fake.accountTenantsReturns
+	fake.recordInvocation("AccountTenants", []interface{}{arg1, arg2})
+	fake.accountTenantsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AccountTenantsCallCount() int {
+	fake.accountTenantsMutex.RLock()
+	defer fake.accountTenantsMutex.RUnlock()
+	return len(fake.accountTenantsArgsForCall)
+}
+
+func (fake *FakeRBACService) AccountTenantsCalls(stub func(context.Context, string) ([]internal.Tenant, error)) {
+	fake.accountTenantsMutex.Lock()
+	defer fake.accountTenantsMutex.Unlock()
+	fake.AccountTenantsStub = stub
+}
+
+func (fake *FakeRBACService) AccountTenantsArgsForCall(i int) (context.Context, string) {
+	fake.accountTenantsMutex.RLock()
+	defer fake.accountTenantsMutex.RUnlock()
+	argsForCall := fake.accountTenantsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AccountTenantsReturns(result1 []internal.Tenant, result2 error) {
+	fake.accountTenantsMutex.Lock()
+	defer fake.accountTenantsMutex.Unlock()
+	fake.AccountTenantsStub = nil
+	fake.accountTenantsReturns = struct {
+		result1 []internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AccountTenantsReturnsOnCall(i int, result1 []internal.Tenant, result2 error) {
+	fake.accountTenantsMutex.Lock()
+	defer fake.accountTenantsMutex.Unlock()
+	fake.AccountTenantsStub = nil
+	if fake.accountTenantsReturnsOnCall == nil {
+		fake.accountTenantsReturnsOnCall = make(map[int]struct {
+			result1 []internal.Tenant
+			result2 error
+		})
+	}
+	fake.accountTenantsReturnsOnCall[i] = struct {
+		result1 []internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ActiveTenant(arg1 context.Context, arg2 string) (internal.Tenant, error) {
+	fake.activeTenantMutex.Lock()
+	ret, specificReturn := fake.activeTenantReturnsOnCall[len(fake.activeTenantArgsForCall)]
+	fake.activeTenantArgsForCall = append(fake.activeTenantArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ActiveTenantStub
+	fakeReturns := fake.activeTenantReturns
+	fake.recordInvocation("ActiveTenant", []interface{}{arg1, arg2})
+	fake.activeTenantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) ActiveTenantCallCount() int {
+	fake.activeTenantMutex.RLock()
+	defer fake.activeTenantMutex.RUnlock()
+	return len(fake.activeTenantArgsForCall)
+}
+
+func (fake *FakeRBACService) ActiveTenantCalls(stub func(context.Context, string) (internal.Tenant, error)) {
+	fake.activeTenantMutex.Lock()
+	defer fake.activeTenantMutex.Unlock()
+	fake.ActiveTenantStub = stub
+}
+
+func (fake *FakeRBACService) ActiveTenantArgsForCall(i int) (context.Context, string) {
+	fake.activeTenantMutex.RLock()
+	defer fake.activeTenantMutex.RUnlock()
+	argsForCall := fake.activeTenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) ActiveTenantReturns(result1 internal.Tenant, result2 error) {
+	fake.activeTenantMutex.Lock()
+	defer fake.activeTenantMutex.Unlock()
+	fake.ActiveTenantStub = nil
+	fake.activeTenantReturns = struct {
+		result1 internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ActiveTenantReturnsOnCall(i int, result1 internal.Tenant, result2 error) {
+	fake.activeTenantMutex.Lock()
+	defer fake.activeTenantMutex.Unlock()
+	fake.ActiveTenantStub = nil
+	if fake.activeTenantReturnsOnCall == nil {
+		fake.activeTenantReturnsOnCall = make(map[int]struct {
+			result1 internal.Tenant
+			result2 error
+		})
+	}
+	fake.activeTenantReturnsOnCall[i] = struct {
+		result1 internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AddAccountTenant(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.addAccountTenantMutex.Lock()
+	ret, specificReturn := fake.addAccountTenantReturnsOnCall[len(fake.addAccountTenantArgsForCall)]
+	fake.addAccountTenantArgsForCall = append(fake.addAccountTenantArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddAccountTenantStub
+	fakeReturns := fake.addAccountTenantReturns
+	fake.recordInvocation("AddAccountTenant", []interface{}{arg1, arg2, arg3})
+	fake.addAccountTenantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) AddAccountTenantCallCount() int {
+	fake.addAccountTenantMutex.RLock()
+	defer fake.addAccountTenantMutex.RUnlock()
+	return len(fake.addAccountTenantArgsForCall)
+}
+
+func (fake *FakeRBACService) AddAccountTenantCalls(stub func(context.Context, string, string) error) {
+	fake.addAccountTenantMutex.Lock()
+	defer fake.addAccountTenantMutex.Unlock()
+	fake.AddAccountTenantStub = stub
+}
+
+func (fake *FakeRBACService) AddAccountTenantArgsForCall(i int) (context.Context, string, string) {
+	fake.addAccountTenantMutex.RLock()
+	defer fake.addAccountTenantMutex.RUnlock()
+	argsForCall := fake.addAccountTenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) AddAccountTenantReturns(result1 error) {
+	fake.addAccountTenantMutex.Lock()
+	defer fake.addAccountTenantMutex.Unlock()
+	fake.AddAccountTenantStub = nil
+	fake.addAccountTenantReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) AddAccountTenantReturnsOnCall(i int, result1 error) {
+	fake.addAccountTenantMutex.Lock()
+	defer fake.addAccountTenantMutex.Unlock()
+	fake.AddAccountTenantStub = nil
+	if fake.addAccountTenantReturnsOnCall == nil {
+		fake.addAccountTenantReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addAccountTenantReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) AddGroupMember(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.addGroupMemberMutex.Lock()
+	ret, specificReturn := fake.addGroupMemberReturnsOnCall[len(fake.addGroupMemberArgsForCall)]
+	fake.addGroupMemberArgsForCall = append(fake.addGroupMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddGroupMemberStub
+	fakeReturns := fake.addGroupMemberReturns
+	fake.recordInvocation("AddGroupMember", []interface{}{arg1, arg2, arg3})
+	fake.addGroupMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) AddGroupMemberCallCount() int {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return len(fake.addGroupMemberArgsForCall)
+}
+
+func (fake *FakeRBACService) AddGroupMemberCalls(stub func(context.Context, string, string) error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = stub
+}
+
+func (fake *FakeRBACService) AddGroupMemberArgsForCall(i int) (context.Context, string, string) {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	argsForCall := fake.addGroupMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) AddGroupMemberReturns(result1 error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = nil
+	fake.addGroupMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) AddGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = nil
+	if fake.addGroupMemberReturnsOnCall == nil {
+		fake.addGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addGroupMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) AddGroupRole(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.addGroupRoleMutex.Lock()
+	ret, specificReturn := fake.addGroupRoleReturnsOnCall[len(fake.addGroupRoleArgsForCall)]
+	fake.addGroupRoleArgsForCall = append(fake.addGroupRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddGroupRoleStub
+	fakeReturns := fake.addGroupRoleReturns
+	fake.recordInvocation("AddGroupRole", []interface{}{arg1, arg2, arg3})
+	fake.addGroupRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) AddGroupRoleCallCount() int {
+	fake.addGroupRoleMutex.RLock()
+	defer fake.addGroupRoleMutex.RUnlock()
+	return len(fake.addGroupRoleArgsForCall)
+}
+
+func (fake *FakeRBACService) AddGroupRoleCalls(stub func(context.Context, string, string) error) {
+	fake.addGroupRoleMutex.Lock()
+	defer fake.addGroupRoleMutex.Unlock()
+	fake.AddGroupRoleStub = stub
+}
+
+func (fake *FakeRBACService) AddGroupRoleArgsForCall(i int) (context.Context, string, string) {
+	fake.addGroupRoleMutex.RLock()
+	defer fake.addGroupRoleMutex.RUnlock()
+	argsForCall := fake.addGroupRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) AddGroupRoleReturns(result1 error) {
+	fake.addGroupRoleMutex.Lock()
+	defer fake.addGroupRoleMutex.Unlock()
+	fake.AddGroupRoleStub = nil
+	fake.addGroupRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) AddGroupRoleReturnsOnCall(i int, result1 error) {
+	fake.addGroupRoleMutex.Lock()
+	defer fake.addGroupRoleMutex.Unlock()
+	fake.AddGroupRoleStub = nil
+	if fake.addGroupRoleReturnsOnCall == nil {
+		fake.addGroupRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addGroupRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) AddMemberGroup(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.addMemberGroupMutex.Lock()
+	ret, specificReturn := fake.addMemberGroupReturnsOnCall[len(fake.addMemberGroupArgsForCall)]
+	fake.addMemberGroupArgsForCall = append(fake.addMemberGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddMemberGroupStub
+	fakeReturns := fake.addMemberGroupReturns
+	fake.recordInvocation("AddMemberGroup", []interface{}{arg1, arg2, arg3})
+	fake.addMemberGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) AddMemberGroupCallCount() int {
+	fake.addMemberGroupMutex.RLock()
+	defer fake.addMemberGroupMutex.RUnlock()
+	return len(fake.addMemberGroupArgsForCall)
+}
+
+func (fake *FakeRBACService) AddMemberGroupCalls(stub func(context.Context, string, string) error) {
+	fake.addMemberGroupMutex.Lock()
+	defer fake.addMemberGroupMutex.Unlock()
+	fake.AddMemberGroupStub = stub
+}
+
+func (fake *FakeRBACService) AddMemberGroupArgsForCall(i int) (context.Context, string, string) {
+	fake.addMemberGroupMutex.RLock()
+	defer fake.addMemberGroupMutex.RUnlock()
+	argsForCall := fake.addMemberGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) AddMemberGroupReturns(result1 error) {
+	fake.addMemberGroupMutex.Lock()
+	defer fake.addMemberGroupMutex.Unlock()
+	fake.AddMemberGroupStub = nil
+	fake.addMemberGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) AddMemberGroupReturnsOnCall(i int, result1 error) {
+	fake.addMemberGroupMutex.Lock()
+	defer fake.addMemberGroupMutex.Unlock()
+	fake.AddMemberGroupStub = nil
+	if fake.addMemberGroupReturnsOnCall == nil {
+		fake.addMemberGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addMemberGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) AddRoleApprover(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.addRoleApproverMutex.Lock()
+	ret, specificReturn := fake.addRoleApproverReturnsOnCall[len(fake.addRoleApproverArgsForCall)]
+	fake.addRoleApproverArgsForCall = append(fake.addRoleApproverArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddRoleApproverStub
+	fakeReturns := fake.addRoleApproverReturns
+	fake.recordInvocation("AddRoleApprover", []interface{}{arg1, arg2, arg3})
+	fake.addRoleApproverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AddRoleApproverCallCount() int {
+	fake.addRoleApproverMutex.RLock()
+	defer fake.addRoleApproverMutex.RUnlock()
+	return len(fake.addRoleApproverArgsForCall)
+}
+
+func (fake *FakeRBACService) AddRoleApproverCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.addRoleApproverMutex.Lock()
+	defer fake.addRoleApproverMutex.Unlock()
+	fake.AddRoleApproverStub = stub
+}
+
+func (fake *FakeRBACService) AddRoleApproverArgsForCall(i int) (context.Context, string, string) {
+	fake.addRoleApproverMutex.RLock()
+	defer fake.addRoleApproverMutex.RUnlock()
+	argsForCall := fake.addRoleApproverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) AddRoleApproverReturns(result1 string, result2 error) {
+	fake.addRoleApproverMutex.Lock()
+	defer fake.addRoleApproverMutex.Unlock()
+	fake.AddRoleApproverStub = nil
+	fake.addRoleApproverReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AddRoleApproverReturnsOnCall(i int, result1 string, result2 error) {
+	fake.addRoleApproverMutex.Lock()
+	defer fake.addRoleApproverMutex.Unlock()
+	fake.AddRoleApproverStub = nil
+	if fake.addRoleApproverReturnsOnCall == nil {
+		fake.addRoleApproverReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.addRoleApproverReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ApproveAccessRequest(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.approveAccessRequestMutex.Lock()
+	ret, specificReturn := fake.approveAccessRequestReturnsOnCall[len(fake.approveAccessRequestArgsForCall)]
+	fake.approveAccessRequestArgsForCall = append(fake.approveAccessRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ApproveAccessRequestStub
+	fakeReturns := fake.approveAccessRequestReturns
+	fake.recordInvocation("ApproveAccessRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.approveAccessRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) ApproveAccessRequestCallCount() int {
+	fake.approveAccessRequestMutex.RLock()
+	defer fake.approveAccessRequestMutex.RUnlock()
+	return len(fake.approveAccessRequestArgsForCall)
+}
+
+func (fake *FakeRBACService) ApproveAccessRequestCalls(stub func(context.Context, string, string, string) error) {
+	fake.approveAccessRequestMutex.Lock()
+	defer fake.approveAccessRequestMutex.Unlock()
+	fake.ApproveAccessRequestStub = stub
+}
+
+func (fake *FakeRBACService) ApproveAccessRequestArgsForCall(i int) (context.Context, string, string, string) {
+	fake.approveAccessRequestMutex.RLock()
+	defer fake.approveAccessRequestMutex.RUnlock()
+	argsForCall := fake.approveAccessRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACService) ApproveAccessRequestReturns(result1 error) {
+	fake.approveAccessRequestMutex.Lock()
+	defer fake.approveAccessRequestMutex.Unlock()
+	fake.ApproveAccessRequestStub = nil
+	fake.approveAccessRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) ApproveAccessRequestReturnsOnCall(i int, result1 error) {
+	fake.approveAccessRequestMutex.Lock()
+	defer fake.approveAccessRequestMutex.Unlock()
+	fake.ApproveAccessRequestStub = nil
+	if fake.approveAccessRequestReturnsOnCall == nil {
+		fake.approveAccessRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.approveAccessRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) AuthenticateAPIKey(arg1 context.Context, arg2 string) (string, error) {
+	fake.authenticateAPIKeyMutex.Lock()
+	ret, specificReturn := fake.authenticateAPIKeyReturnsOnCall[len(fake.authenticateAPIKeyArgsForCall)]
+	fake.authenticateAPIKeyArgsForCall = append(fake.authenticateAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AuthenticateAPIKeyStub
+	fakeReturns := fake.authenticateAPIKeyReturns
+	fake.recordInvocation("AuthenticateAPIKey", []interface{}{arg1, arg2})
+	fake.authenticateAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) AuthenticateAPIKeyCallCount() int {
+	fake.authenticateAPIKeyMutex.RLock()
+	defer fake.authenticateAPIKeyMutex.RUnlock()
+	return len(fake.authenticateAPIKeyArgsForCall)
+}
+
+func (fake *FakeRBACService) AuthenticateAPIKeyCalls(stub func(context.Context, string) (string, error)) {
+	fake.authenticateAPIKeyMutex.Lock()
+	defer fake.authenticateAPIKeyMutex.Unlock()
+	fake.AuthenticateAPIKeyStub = stub
+}
+
+func (fake *FakeRBACService) AuthenticateAPIKeyArgsForCall(i int) (context.Context, string) {
+	fake.authenticateAPIKeyMutex.RLock()
+	defer fake.authenticateAPIKeyMutex.RUnlock()
+	argsForCall := fake.authenticateAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) AuthenticateAPIKeyReturns(result1 string, result2 error) {
+	fake.authenticateAPIKeyMutex.Lock()
+	defer fake.authenticateAPIKeyMutex.Unlock()
+	fake.AuthenticateAPIKeyStub = nil
+	fake.authenticateAPIKeyReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) AuthenticateAPIKeyReturnsOnCall(i int, result1 string, result2 error) {
+	fake.authenticateAPIKeyMutex.Lock()
+	defer fake.authenticateAPIKeyMutex.Unlock()
+	fake.AuthenticateAPIKeyStub = nil
+	if fake.authenticateAPIKeyReturnsOnCall == nil {
+		fake.authenticateAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authenticateAPIKeyReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) BlockAccount(arg1 context.Context, arg2 string) error {
+	fake.blockAccountMutex.Lock()
+	ret, specificReturn := fake.blockAccountReturnsOnCall[len(fake.blockAccountArgsForCall)]
+	fake.blockAccountArgsForCall = append(fake.blockAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.BlockAccountStub
+	fakeReturns := fake.blockAccountReturns
+	fake.recordInvocation("BlockAccount", []interface{}{arg1, arg2})
+	fake.blockAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) BlockAccountCallCount() int {
+	fake.blockAccountMutex.RLock()
+	defer fake.blockAccountMutex.RUnlock()
+	return len(fake.blockAccountArgsForCall)
+}
+
+func (fake *FakeRBACService) BlockAccountCalls(stub func(context.Context, string) error) {
+	fake.blockAccountMutex.Lock()
+	defer fake.blockAccountMutex.Unlock()
+	fake.BlockAccountStub = stub
+}
+
+func (fake *FakeRBACService) BlockAccountArgsForCall(i int) (context.Context, string) {
+	fake.blockAccountMutex.RLock()
+	defer fake.blockAccountMutex.RUnlock()
+	argsForCall := fake.blockAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) BlockAccountReturns(result1 error) {
+	fake.blockAccountMutex.Lock()
+	defer fake.blockAccountMutex.Unlock()
+	fake.BlockAccountStub = nil
+	fake.blockAccountReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) BlockAccountReturnsOnCall(i int, result1 error) {
+	fake.blockAccountMutex.Lock()
+	defer fake.blockAccountMutex.Unlock()
+	fake.BlockAccountStub = nil
+	if fake.blockAccountReturnsOnCall == nil {
+		fake.blockAccountReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.blockAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) BreakGlass(arg1 context.Context, arg2 internal.BreakGlassActivation) (internal.BreakGlassActivation, error) {
+	fake.breakGlassMutex.Lock()
+	ret, specificReturn := fake.breakGlassReturnsOnCall[len(fake.breakGlassArgsForCall)]
+	fake.breakGlassArgsForCall = append(fake.breakGlassArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.BreakGlassActivation
+	}{arg1, arg2})
+	stub := fake.BreakGlassStub
+	fakeReturns := fake.breakGlassReturns
+	fake.recordInvocation("BreakGlass", []interface{}{arg1, arg2})
+	fake.breakGlassMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) BreakGlassCallCount() int {
+	fake.breakGlassMutex.RLock()
+	defer fake.breakGlassMutex.RUnlock()
+	return len(fake.breakGlassArgsForCall)
+}
+
+func (fake *FakeRBACService) BreakGlassCalls(stub func(context.Context, internal.BreakGlassActivation) (internal.BreakGlassActivation, error)) {
+	fake.breakGlassMutex.Lock()
+	defer fake.breakGlassMutex.Unlock()
+	fake.BreakGlassStub = stub
+}
+
+func (fake *FakeRBACService) BreakGlassArgsForCall(i int) (context.Context, internal.BreakGlassActivation) {
+	fake.breakGlassMutex.RLock()
+	defer fake.breakGlassMutex.RUnlock()
+	argsForCall := fake.breakGlassArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) BreakGlassReturns(result1 internal.BreakGlassActivation, result2 error) {
+	fake.breakGlassMutex.Lock()
+	defer fake.breakGlassMutex.Unlock()
+	fake.BreakGlassStub = nil
+	fake.breakGlassReturns = struct {
+		result1 internal.BreakGlassActivation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) BreakGlassReturnsOnCall(i int, result1 internal.BreakGlassActivation, result2 error) {
+	fake.breakGlassMutex.Lock()
+	defer fake.breakGlassMutex.Unlock()
+	fake.BreakGlassStub = nil
+	if fake.breakGlassReturnsOnCall == nil {
+		fake.breakGlassReturnsOnCall = make(map[int]struct {
+			result1 internal.BreakGlassActivation
+			result2 error
+		})
+	}
+	fake.breakGlassReturnsOnCall[i] = struct {
+		result1 internal.BreakGlassActivation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) BreakGlassActivations(arg1 context.Context, arg2 time.Time) ([]internal.BreakGlassActivation, error) {
+	fake.breakGlassActivationsMutex.Lock()
+	ret, specificReturn := fake.breakGlassActivationsReturnsOnCall[len(fake.breakGlassActivationsArgsForCall)]
+	fake.breakGlassActivationsArgsForCall = append(fake.breakGlassActivationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.BreakGlassActivationsStub
+	fakeReturns := fake.breakGlassActivationsReturns
+	fake.recordInvocation("BreakGlassActivations", []interface{}{arg1, arg2})
+	fake.breakGlassActivationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) BreakGlassActivationsCallCount() int {
+	fake.breakGlassActivationsMutex.RLock()
+	defer fake.breakGlassActivationsMutex.RUnlock()
+	return len(fake.breakGlassActivationsArgsForCall)
+}
+
+func (fake *FakeRBACService) BreakGlassActivationsCalls(stub func(context.Context, time.Time) ([]internal.BreakGlassActivation, error)) {
+	fake.breakGlassActivationsMutex.Lock()
+	defer fake.breakGlassActivationsMutex.Unlock()
+	fake.BreakGlassActivationsStub = stub
+}
+
+func (fake *FakeRBACService) BreakGlassActivationsArgsForCall(i int) (context.Context, time.Time) {
+	fake.breakGlassActivationsMutex.RLock()
+	defer fake.breakGlassActivationsMutex.RUnlock()
+	argsForCall := fake.breakGlassActivationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) BreakGlassActivationsReturns(result1 []internal.BreakGlassActivation, result2 error) {
+	fake.breakGlassActivationsMutex.Lock()
+	defer fake.breakGlassActivationsMutex.Unlock()
+	fake.BreakGlassActivationsStub = nil
+	fake.breakGlassActivationsReturns = struct {
+		result1 []internal.BreakGlassActivation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) BreakGlassActivationsReturnsOnCall(i int, result1 []internal.BreakGlassActivation, result2 error) {
+	fake.breakGlassActivationsMutex.Lock()
+	defer fake.breakGlassActivationsMutex.Unlock()
+	fake.BreakGlassActivationsStub = nil
+	if fake.breakGlassActivationsReturnsOnCall == nil {
+		fake.breakGlassActivationsReturnsOnCall = make(map[int]struct {
+			result1 []internal.BreakGlassActivation
+			result2 error
+		})
+	}
+	fake.breakGlassActivationsReturnsOnCall[i] = struct {
+		result1 []internal.BreakGlassActivation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ChangePassword(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.changePasswordMutex.Lock()
+	ret, specificReturn := fake.changePasswordReturnsOnCall[len(fake.changePasswordArgsForCall)]
+	fake.changePasswordArgsForCall = append(fake.changePasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ChangePasswordStub
+	fakeReturns := fake.changePasswordReturns
+	fake.recordInvocation("ChangePassword", []interface{}{arg1, arg2, arg3})
+	fake.changePasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) ChangePasswordCallCount() int {
+	fake.changePasswordMutex.RLock()
+	defer fake.changePasswordMutex.RUnlock()
+	return len(fake.changePasswordArgsForCall)
+}
+
+func (fake *FakeRBACService) ChangePasswordCalls(stub func(context.Context, string, string) error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = stub
+}
+
+func (fake *FakeRBACService) ChangePasswordArgsForCall(i int) (context.Context, string, string) {
+	fake.changePasswordMutex.RLock()
+	defer fake.changePasswordMutex.RUnlock()
+	argsForCall := fake.changePasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) ChangePasswordReturns(result1 error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = nil
+	fake.changePasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) ChangePasswordReturnsOnCall(i int, result1 error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = nil
+	if fake.changePasswordReturnsOnCall == nil {
+		fake.changePasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.changePasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CheckPermissions(arg1 context.Context, arg2 []internal.PermissionCheck) ([]internal.PermissionDecision, error) {
+	var arg2Copy []internal.PermissionCheck
+	if arg2 != nil {
+		arg2Copy = make([]internal.PermissionCheck, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.checkPermissionsMutex.Lock()
+	ret, specificReturn := fake.checkPermissionsReturnsOnCall[len(fake.checkPermissionsArgsForCall)]
+	fake.checkPermissionsArgsForCall = append(fake.checkPermissionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []internal.PermissionCheck
+	}{arg1, arg2Copy})
+	stub := fake.CheckPermissionsStub
+	fakeReturns := fake.checkPermissionsReturns
+	fake.recordInvocation("CheckPermissions", []interface{}{arg1, arg2Copy})
+	fake.checkPermissionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CheckPermissionsCallCount() int {
+	fake.checkPermissionsMutex.RLock()
+	defer fake.checkPermissionsMutex.RUnlock()
+	return len(fake.checkPermissionsArgsForCall)
+}
+
+func (fake *FakeRBACService) CheckPermissionsCalls(stub func(context.Context, []internal.PermissionCheck) ([]internal.PermissionDecision, error)) {
+	fake.checkPermissionsMutex.Lock()
+	defer fake.checkPermissionsMutex.Unlock()
+	fake.CheckPermissionsStub = stub
+}
+
+func (fake *FakeRBACService) CheckPermissionsArgsForCall(i int) (context.Context, []internal.PermissionCheck) {
+	fake.checkPermissionsMutex.RLock()
+	defer fake.checkPermissionsMutex.RUnlock()
+	argsForCall := fake.checkPermissionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CheckPermissionsReturns(result1 []internal.PermissionDecision, result2 error) {
+	fake.checkPermissionsMutex.Lock()
+	defer fake.checkPermissionsMutex.Unlock()
+	fake.CheckPermissionsStub = nil
+	fake.checkPermissionsReturns = struct {
+		result1 []internal.PermissionDecision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CheckPermissionsReturnsOnCall(i int, result1 []internal.PermissionDecision, result2 error) {
+	fake.checkPermissionsMutex.Lock()
+	defer fake.checkPermissionsMutex.Unlock()
+	fake.CheckPermissionsStub = nil
+	if fake.checkPermissionsReturnsOnCall == nil {
+		fake.checkPermissionsReturnsOnCall = make(map[int]struct {
+			result1 []internal.PermissionDecision
+			result2 error
+		})
+	}
+	fake.checkPermissionsReturnsOnCall[i] = struct {
+		result1 []internal.PermissionDecision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ClearAccountLockout(arg1 context.Context, arg2 string) error {
+	fake.clearAccountLockoutMutex.Lock()
+	ret, specificReturn := fake.clearAccountLockoutReturnsOnCall[len(fake.clearAccountLockoutArgsForCall)]
+	fake.clearAccountLockoutArgsForCall = append(fake.clearAccountLockoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ClearAccountLockoutStub
+	fakeReturns := fake.clearAccountLockoutReturns
+	fake.recordInvocation("ClearAccountLockout", []interface{}{arg1, arg2})
+	fake.clearAccountLockoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) ClearAccountLockoutCallCount() int {
+	fake.clearAccountLockoutMutex.RLock()
+	defer fake.clearAccountLockoutMutex.RUnlock()
+	return len(fake.clearAccountLockoutArgsForCall)
+}
+
+func (fake *FakeRBACService) ClearAccountLockoutCalls(stub func(context.Context, string) error) {
+	fake.clearAccountLockoutMutex.Lock()
+	defer fake.clearAccountLockoutMutex.Unlock()
+	fake.ClearAccountLockoutStub = stub
+}
+
+func (fake *FakeRBACService) ClearAccountLockoutArgsForCall(i int) (context.Context, string) {
+	fake.clearAccountLockoutMutex.RLock()
+	defer fake.clearAccountLockoutMutex.RUnlock()
+	argsForCall := fake.clearAccountLockoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) ClearAccountLockoutReturns(result1 error) {
+	fake.clearAccountLockoutMutex.Lock()
+	defer fake.clearAccountLockoutMutex.Unlock()
+	fake.ClearAccountLockoutStub = nil
+	fake.clearAccountLockoutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) ClearAccountLockoutReturnsOnCall(i int, result1 error) {
+	fake.clearAccountLockoutMutex.Lock()
+	defer fake.clearAccountLockoutMutex.Unlock()
+	fake.ClearAccountLockoutStub = nil
+	if fake.clearAccountLockoutReturnsOnCall == nil {
+		fake.clearAccountLockoutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearAccountLockoutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) ClearIPLockout(arg1 context.Context, arg2 string) error {
+	fake.clearIPLockoutMutex.Lock()
+	ret, specificReturn := fake.clearIPLockoutReturnsOnCall[len(fake.clearIPLockoutArgsForCall)]
+	fake.clearIPLockoutArgsForCall = append(fake.clearIPLockoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ClearIPLockoutStub
+	fakeReturns := fake.clearIPLockoutReturns
+	fake.recordInvocation("ClearIPLockout", []interface{}{arg1, arg2})
+	fake.clearIPLockoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) ClearIPLockoutCallCount() int {
+	fake.clearIPLockoutMutex.RLock()
+	defer fake.clearIPLockoutMutex.RUnlock()
+	return len(fake.clearIPLockoutArgsForCall)
+}
+
+func (fake *FakeRBACService) ClearIPLockoutCalls(stub func(context.Context, string) error) {
+	fake.clearIPLockoutMutex.Lock()
+	defer fake.clearIPLockoutMutex.Unlock()
+	fake.ClearIPLockoutStub = stub
+}
+
+func (fake *FakeRBACService) ClearIPLockoutArgsForCall(i int) (context.Context, string) {
+	fake.clearIPLockoutMutex.RLock()
+	defer fake.clearIPLockoutMutex.RUnlock()
+	argsForCall := fake.clearIPLockoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) ClearIPLockoutReturns(result1 error) {
+	fake.clearIPLockoutMutex.Lock()
+	defer fake.clearIPLockoutMutex.Unlock()
+	fake.ClearIPLockoutStub = nil
+	fake.clearIPLockoutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) ClearIPLockoutReturnsOnCall(i int, result1 error) {
+	fake.clearIPLockoutMutex.Lock()
+	defer fake.clearIPLockoutMutex.Unlock()
+	fake.ClearIPLockoutStub = nil
+	if fake.clearIPLockoutReturnsOnCall == nil {
+		fake.clearIPLockoutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearIPLockoutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) ConfirmMFA(arg1 context.Context, arg2 string, arg3 string) ([]string, error) {
+	fake.confirmMFAMutex.Lock()
+	ret, specificReturn := fake.confirmMFAReturnsOnCall[len(fake.confirmMFAArgsForCall)]
+	fake.confirmMFAArgsForCall = append(fake.confirmMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ConfirmMFAStub
+	fakeReturns := fake.confirmMFAReturns
+	fake.recordInvocation("ConfirmMFA", []interface{}{arg1, arg2, arg3})
+	fake.confirmMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) ConfirmMFACallCount() int {
+	fake.confirmMFAMutex.RLock()
+	defer fake.confirmMFAMutex.RUnlock()
+	return len(fake.confirmMFAArgsForCall)
+}
+
+func (fake *FakeRBACService) ConfirmMFACalls(stub func(context.Context, string, string) ([]string, error)) {
+	fake.confirmMFAMutex.Lock()
+	defer fake.confirmMFAMutex.Unlock()
+	fake.ConfirmMFAStub = stub
+}
+
+func (fake *FakeRBACService) ConfirmMFAArgsForCall(i int) (context.Context, string, string) {
+	fake.confirmMFAMutex.RLock()
+	defer fake.confirmMFAMutex.RUnlock()
+	argsForCall := fake.confirmMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) ConfirmMFAReturns(result1 []string, result2 error) {
+	fake.confirmMFAMutex.Lock()
+	defer fake.confirmMFAMutex.Unlock()
+	fake.ConfirmMFAStub = nil
+	fake.confirmMFAReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ConfirmMFAReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.confirmMFAMutex.Lock()
+	defer fake.confirmMFAMutex.Unlock()
+	fake.ConfirmMFAStub = nil
+	if fake.confirmMFAReturnsOnCall == nil {
+		fake.confirmMFAReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.confirmMFAReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateAPIKey(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time) (string, internal.APIKey, error) {
+	fake.createAPIKeyMutex.Lock()
+	ret, specificReturn := fake.createAPIKeyReturnsOnCall[len(fake.createAPIKeyArgsForCall)]
+	fake.createAPIKeyArgsForCall = append(fake.createAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateAPIKeyStub
+	fakeReturns := fake.createAPIKeyReturns
+	fake.recordInvocation("CreateAPIKey", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRBACService) CreateAPIKeyCallCount() int {
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	return len(fake.createAPIKeyArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateAPIKeyCalls(stub func(context.Context, string, string, time.Time) (string, internal.APIKey, error)) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = stub
+}
+
+func (fake *FakeRBACService) CreateAPIKeyArgsForCall(i int) (context.Context, string, string, time.Time) {
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	argsForCall := fake.createAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACService) CreateAPIKeyReturns(result1 string, result2 internal.APIKey, result3 error) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = nil
+	fake.createAPIKeyReturns = struct {
+		result1 string
+		result2 internal.APIKey
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRBACService) CreateAPIKeyReturnsOnCall(i int, result1 string, result2 internal.APIKey, result3 error) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = nil
+	if fake.createAPIKeyReturnsOnCall == nil {
+		fake.createAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 internal.APIKey
+			result3 error
+		})
+	}
+	fake.createAPIKeyReturnsOnCall[i] = struct {
+		result1 string
+		result2 internal.APIKey
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRBACService) CreateAccessRequest(arg1 context.Context, arg2 internal.AccessRequest) (string, error) {
+	fake.createAccessRequestMutex.Lock()
+	ret, specificReturn := fake.createAccessRequestReturnsOnCall[len(fake.createAccessRequestArgsForCall)]
+	fake.createAccessRequestArgsForCall = append(fake.createAccessRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.AccessRequest
+	}{arg1, arg2})
+	stub := fake.CreateAccessRequestStub
+	fakeReturns := fake.createAccessRequestReturns
+	fake.recordInvocation("CreateAccessRequest", []interface{}{arg1, arg2})
+	fake.createAccessRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateAccessRequestCallCount() int {
+	fake.createAccessRequestMutex.RLock()
+	defer fake.createAccessRequestMutex.RUnlock()
+	return len(fake.createAccessRequestArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateAccessRequestCalls(stub func(context.Context, internal.AccessRequest) (string, error)) {
+	fake.createAccessRequestMutex.Lock()
+	defer fake.createAccessRequestMutex.Unlock()
+	fake.CreateAccessRequestStub = stub
+}
+
+func (fake *FakeRBACService) CreateAccessRequestArgsForCall(i int) (context.Context, internal.AccessRequest) {
+	fake.createAccessRequestMutex.RLock()
+	defer fake.createAccessRequestMutex.RUnlock()
+	argsForCall := fake.createAccessRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateAccessRequestReturns(result1 string, result2 error) {
+	fake.createAccessRequestMutex.Lock()
+	defer fake.createAccessRequestMutex.Unlock()
+	fake.CreateAccessRequestStub = nil
+	fake.createAccessRequestReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateAccessRequestReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createAccessRequestMutex.Lock()
+	defer fake.createAccessRequestMutex.Unlock()
+	fake.CreateAccessRequestStub = nil
+	if fake.createAccessRequestReturnsOnCall == nil {
+		fake.createAccessRequestReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createAccessRequestReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateAccount(arg1 context.Context, arg2 internal.Account, arg3 string) (string, error) {
+	fake.createAccountMutex.Lock()
+	ret, specificReturn := fake.createAccountReturnsOnCall[len(fake.createAccountArgsForCall)]
+	fake.createAccountArgsForCall = append(fake.createAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Account
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CreateAccountStub
+	fakeReturns := fake.createAccountReturns
+	fake.recordInvocation("CreateAccount", []interface{}{arg1, arg2, arg3})
+	fake.createAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateAccountCallCount() int {
+	fake.createAccountMutex.RLock()
+	defer fake.createAccountMutex.RUnlock()
+	return len(fake.createAccountArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateAccountCalls(stub func(context.Context, internal.Account, string) (string, error)) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = stub
+}
+
+func (fake *FakeRBACService) CreateAccountArgsForCall(i int) (context.Context, internal.Account, string) {
+	fake.createAccountMutex.RLock()
+	defer fake.createAccountMutex.RUnlock()
+	argsForCall := fake.createAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) CreateAccountReturns(result1 string, result2 error) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = nil
+	fake.createAccountReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateAccountReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = nil
+	if fake.createAccountReturnsOnCall == nil {
+		fake.createAccountReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createAccountReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateAccountRole(arg1 context.Context, arg2 internal.AccountRoles) error {
+	fake.createAccountRoleMutex.Lock()
+	ret, specificReturn := fake.createAccountRoleReturnsOnCall[len(fake.createAccountRoleArgsForCall)]
+	fake.createAccountRoleArgsForCall = append(fake.createAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}{arg1, arg2})
+	stub := fake.CreateAccountRoleStub
+	fakeReturns := fake.createAccountRoleReturns
+	fake.recordInvocation("CreateAccountRole", []interface{}{arg1, arg2})
+	fake.createAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) CreateAccountRoleCallCount() int {
+	fake.createAccountRoleMutex.RLock()
+	defer fake.createAccountRoleMutex.RUnlock()
+	return len(fake.createAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateAccountRoleCalls(stub func(context.Context, internal.AccountRoles) error) {
+	fake.createAccountRoleMutex.Lock()
+	defer fake.createAccountRoleMutex.Unlock()
+	fake.CreateAccountRoleStub = stub
+}
+
+func (fake *FakeRBACService) CreateAccountRoleArgsForCall(i int) (context.Context, internal.AccountRoles) {
+	fake.createAccountRoleMutex.RLock()
+	defer fake.createAccountRoleMutex.RUnlock()
+	argsForCall := fake.createAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateAccountRoleReturns(result1 error) {
+	fake.createAccountRoleMutex.Lock()
+	defer fake.createAccountRoleMutex.Unlock()
+	fake.CreateAccountRoleStub = nil
+	fake.createAccountRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateAccountRoleReturnsOnCall(i int, result1 error) {
+	fake.createAccountRoleMutex.Lock()
+	defer fake.createAccountRoleMutex.Unlock()
+	fake.CreateAccountRoleStub = nil
+	if fake.createAccountRoleReturnsOnCall == nil {
+		fake.createAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createAccountRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateExclusiveRoleSet(arg1 context.Context, arg2 internal.ExclusiveRoleSet) (string, error) {
+	fake.createExclusiveRoleSetMutex.Lock()
+	ret, specificReturn := fake.createExclusiveRoleSetReturnsOnCall[len(fake.createExclusiveRoleSetArgsForCall)]
+	fake.createExclusiveRoleSetArgsForCall = append(fake.createExclusiveRoleSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ExclusiveRoleSet
+	}{arg1, arg2})
+	stub := fake.CreateExclusiveRoleSetStub
+	fakeReturns := fake.createExclusiveRoleSetReturns
+	fake.recordInvocation("CreateExclusiveRoleSet", []interface{}{arg1, arg2})
+	fake.createExclusiveRoleSetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateExclusiveRoleSetCallCount() int {
+	fake.createExclusiveRoleSetMutex.RLock()
+	defer fake.createExclusiveRoleSetMutex.RUnlock()
+	return len(fake.createExclusiveRoleSetArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateExclusiveRoleSetCalls(stub func(context.Context, internal.ExclusiveRoleSet) (string, error)) {
+	fake.createExclusiveRoleSetMutex.Lock()
+	defer fake.createExclusiveRoleSetMutex.Unlock()
+	fake.CreateExclusiveRoleSetStub = stub
+}
+
+func (fake *FakeRBACService) CreateExclusiveRoleSetArgsForCall(i int) (context.Context, internal.ExclusiveRoleSet) {
+	fake.createExclusiveRoleSetMutex.RLock()
+	defer fake.createExclusiveRoleSetMutex.RUnlock()
+	argsForCall := fake.createExclusiveRoleSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateExclusiveRoleSetReturns(result1 string, result2 error) {
+	fake.createExclusiveRoleSetMutex.Lock()
+	defer fake.createExclusiveRoleSetMutex.Unlock()
+	fake.CreateExclusiveRoleSetStub = nil
+	fake.createExclusiveRoleSetReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateExclusiveRoleSetReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createExclusiveRoleSetMutex.Lock()
+	defer fake.createExclusiveRoleSetMutex.Unlock()
+	fake.CreateExclusiveRoleSetStub = nil
+	if fake.createExclusiveRoleSetReturnsOnCall == nil {
+		fake.createExclusiveRoleSetReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createExclusiveRoleSetReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateGroup(arg1 context.Context, arg2 internal.Group) (string, error) {
+	fake.createGroupMutex.Lock()
+	ret, specificReturn := fake.createGroupReturnsOnCall[len(fake.createGroupArgsForCall)]
+	fake.createGroupArgsForCall = append(fake.createGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Group
+	}{arg1, arg2})
+	stub := fake.CreateGroupStub
+	fakeReturns := fake.createGroupReturns
+	fake.recordInvocation("CreateGroup", []interface{}{arg1, arg2})
+	fake.createGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateGroupCallCount() int {
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
+	return len(fake.createGroupArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateGroupCalls(stub func(context.Context, internal.Group) (string, error)) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = stub
+}
+
+func (fake *FakeRBACService) CreateGroupArgsForCall(i int) (context.Context, internal.Group) {
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
+	argsForCall := fake.createGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateGroupReturns(result1 string, result2 error) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = nil
+	fake.createGroupReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateGroupReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = nil
+	if fake.createGroupReturnsOnCall == nil {
+		fake.createGroupReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createGroupReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateHelpText(arg1 context.Context, arg2 internal.HelpText) error {
+	fake.createHelpTextMutex.Lock()
+	ret, specificReturn := fake.createHelpTextReturnsOnCall[len(fake.createHelpTextArgsForCall)]
+	fake.createHelpTextArgsForCall = append(fake.createHelpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.HelpText
+	}{arg1, arg2})
+	stub := fake.CreateHelpTextStub
+	fakeReturns := fake.createHelpTextReturns
+	fake.recordInvocation("CreateHelpText", []interface{}{arg1, arg2})
+	fake.createHelpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) CreateHelpTextCallCount() int {
+	fake.createHelpTextMutex.RLock()
+	defer fake.createHelpTextMutex.RUnlock()
+	return len(fake.createHelpTextArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateHelpTextCalls(stub func(context.Context, internal.HelpText) error) {
+	fake.createHelpTextMutex.Lock()
+	defer fake.createHelpTextMutex.Unlock()
+	fake.CreateHelpTextStub = stub
+}
+
+func (fake *FakeRBACService) CreateHelpTextArgsForCall(i int) (context.Context, internal.HelpText) {
+	fake.createHelpTextMutex.RLock()
+	defer fake.createHelpTextMutex.RUnlock()
+	argsForCall := fake.createHelpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateHelpTextReturns(result1 error) {
+	fake.createHelpTextMutex.Lock()
+	defer fake.createHelpTextMutex.Unlock()
+	fake.CreateHelpTextStub = nil
+	fake.createHelpTextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateHelpTextReturnsOnCall(i int, result1 error) {
+	fake.createHelpTextMutex.Lock()
+	defer fake.createHelpTextMutex.Unlock()
+	fake.CreateHelpTextStub = nil
+	if fake.createHelpTextReturnsOnCall == nil {
+		fake.createHelpTextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createHelpTextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateMFAChallenge(arg1 context.Context, arg2 string) (string, error) {
+	fake.createMFAChallengeMutex.Lock()
+	ret, specificReturn := fake.createMFAChallengeReturnsOnCall[len(fake.createMFAChallengeArgsForCall)]
+	fake.createMFAChallengeArgsForCall = append(fake.createMFAChallengeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateMFAChallengeStub
+	fakeReturns := fake.createMFAChallengeReturns
+	fake.recordInvocation("CreateMFAChallenge", []interface{}{arg1, arg2})
+	fake.createMFAChallengeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateMFAChallengeCallCount() int {
+	fake.createMFAChallengeMutex.RLock()
+	defer fake.createMFAChallengeMutex.RUnlock()
+	return len(fake.createMFAChallengeArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateMFAChallengeCalls(stub func(context.Context, string) (string, error)) {
+	fake.createMFAChallengeMutex.Lock()
+	defer fake.createMFAChallengeMutex.Unlock()
+	fake.CreateMFAChallengeStub = stub
+}
+
+func (fake *FakeRBACService) CreateMFAChallengeArgsForCall(i int) (context.Context, string) {
+	fake.createMFAChallengeMutex.RLock()
+	defer fake.createMFAChallengeMutex.RUnlock()
+	argsForCall := fake.createMFAChallengeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateMFAChallengeReturns(result1 string, result2 error) {
+	fake.createMFAChallengeMutex.Lock()
+	defer fake.createMFAChallengeMutex.Unlock()
+	fake.CreateMFAChallengeStub = nil
+	fake.createMFAChallengeReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateMFAChallengeReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createMFAChallengeMutex.Lock()
+	defer fake.createMFAChallengeMutex.Unlock()
+	fake.CreateMFAChallengeStub = nil
+	if fake.createMFAChallengeReturnsOnCall == nil {
+		fake.createMFAChallengeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createMFAChallengeReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateMenu(arg1 context.Context, arg2 internal.Menu) error {
+	fake.createMenuMutex.Lock()
+	ret, specificReturn := fake.createMenuReturnsOnCall[len(fake.createMenuArgsForCall)]
+	fake.createMenuArgsForCall = append(fake.createMenuArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Menu
+	}{arg1, arg2})
+	stub := fake.CreateMenuStub
+	fakeReturns := fake.createMenuReturns
+	fake.recordInvocation("CreateMenu", []interface{}{arg1, arg2})
+	fake.createMenuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) CreateMenuCallCount() int {
+	fake.createMenuMutex.RLock()
+	defer fake.createMenuMutex.RUnlock()
+	return len(fake.createMenuArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateMenuCalls(stub func(context.Context, internal.Menu) error) {
+	fake.createMenuMutex.Lock()
+	defer fake.createMenuMutex.Unlock()
+	fake.CreateMenuStub = stub
+}
+
+func (fake *FakeRBACService) CreateMenuArgsForCall(i int) (context.Context, internal.Menu) {
+	fake.createMenuMutex.RLock()
+	defer fake.createMenuMutex.RUnlock()
+	argsForCall := fake.createMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateMenuReturns(result1 error) {
+	fake.createMenuMutex.Lock()
+	defer fake.createMenuMutex.Unlock()
+	fake.CreateMenuStub = nil
+	fake.createMenuReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateMenuReturnsOnCall(i int, result1 error) {
+	fake.createMenuMutex.Lock()
+	defer fake.createMenuMutex.Unlock()
+	fake.CreateMenuStub = nil
+	if fake.createMenuReturnsOnCall == nil {
+		fake.createMenuReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createMenuReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateNavigation(arg1 context.Context, arg2 internal.Navigation) error {
+	fake.createNavigationMutex.Lock()
+	ret, specificReturn := fake.createNavigationReturnsOnCall[len(fake.createNavigationArgsForCall)]
+	fake.createNavigationArgsForCall = append(fake.createNavigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Navigation
+	}{arg1, arg2})
+	stub := fake.CreateNavigationStub
+	fakeReturns := fake.createNavigationReturns
+	fake.recordInvocation("CreateNavigation", []interface{}{arg1, arg2})
+	fake.createNavigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) CreateNavigationCallCount() int {
+	fake.createNavigationMutex.RLock()
+	defer fake.createNavigationMutex.RUnlock()
+	return len(fake.createNavigationArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateNavigationCalls(stub func(context.Context, internal.Navigation) error) {
+	fake.createNavigationMutex.Lock()
+	defer fake.createNavigationMutex.Unlock()
+	fake.CreateNavigationStub = stub
+}
+
+func (fake *FakeRBACService) CreateNavigationArgsForCall(i int) (context.Context, internal.Navigation) {
+	fake.createNavigationMutex.RLock()
+	defer fake.createNavigationMutex.RUnlock()
+	argsForCall := fake.createNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateNavigationReturns(result1 error) {
+	fake.createNavigationMutex.Lock()
+	defer fake.createNavigationMutex.Unlock()
+	fake.CreateNavigationStub = nil
+	fake.createNavigationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateNavigationReturnsOnCall(i int, result1 error) {
+	fake.createNavigationMutex.Lock()
+	defer fake.createNavigationMutex.Unlock()
+	fake.CreateNavigationStub = nil
+	if fake.createNavigationReturnsOnCall == nil {
+		fake.createNavigationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createNavigationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateRefreshToken(arg1 context.Context, arg2 string) (string, error) {
+	fake.createRefreshTokenMutex.Lock()
+	ret, specificReturn := fake.createRefreshTokenReturnsOnCall[len(fake.createRefreshTokenArgsForCall)]
+	fake.createRefreshTokenArgsForCall = append(fake.createRefreshTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateRefreshTokenStub
+	fakeReturns := fake.createRefreshTokenReturns
+	fake.recordInvocation("CreateRefreshToken", []interface{}{arg1, arg2})
+	fake.createRefreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateRefreshTokenCallCount() int {
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	return len(fake.createRefreshTokenArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateRefreshTokenCalls(stub func(context.Context, string) (string, error)) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = stub
+}
+
+func (fake *FakeRBACService) CreateRefreshTokenArgsForCall(i int) (context.Context, string) {
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	argsForCall := fake.createRefreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateRefreshTokenReturns(result1 string, result2 error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = nil
+	fake.createRefreshTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateRefreshTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = nil
+	if fake.createRefreshTokenReturnsOnCall == nil {
+		fake.createRefreshTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createRefreshTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateRole(arg1 context.Context, arg2 string) (string, error) {
+	fake.createRoleMutex.Lock()
+	ret, specificReturn := fake.createRoleReturnsOnCall[len(fake.createRoleArgsForCall)]
+	fake.createRoleArgsForCall = append(fake.createRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateRoleStub
+	fakeReturns := fake.createRoleReturns
+	fake.recordInvocation("CreateRole", []interface{}{arg1, arg2})
+	fake.createRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateRoleCallCount() int {
+	fake.createRoleMutex.RLock()
+	defer fake.createRoleMutex.RUnlock()
+	return len(fake.createRoleArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateRoleCalls(stub func(context.Context, string) (string, error)) {
+	fake.createRoleMutex.Lock()
+	defer fake.createRoleMutex.Unlock()
+	fake.CreateRoleStub = stub
+}
+
+func (fake *FakeRBACService) CreateRoleArgsForCall(i int) (context.Context, string) {
+	fake.createRoleMutex.RLock()
+	defer fake.createRoleMutex.RUnlock()
+	argsForCall := fake.createRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateRoleReturns(result1 string, result2 error) {
+	fake.createRoleMutex.Lock()
+	defer fake.createRoleMutex.Unlock()
+	fake.CreateRoleStub = nil
+	fake.createRoleReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateRoleReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createRoleMutex.Lock()
+	defer fake.createRoleMutex.Unlock()
+	fake.CreateRoleStub = nil
+	if fake.createRoleReturnsOnCall == nil {
+		fake.createRoleReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createRoleReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateRoleInheritance(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.createRoleInheritanceMutex.Lock()
+	ret, specificReturn := fake.createRoleInheritanceReturnsOnCall[len(fake.createRoleInheritanceArgsForCall)]
+	fake.createRoleInheritanceArgsForCall = append(fake.createRoleInheritanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CreateRoleInheritanceStub
+	fakeReturns := fake.createRoleInheritanceReturns
+	fake.recordInvocation("CreateRoleInheritance", []interface{}{arg1, arg2, arg3})
+	fake.createRoleInheritanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) CreateRoleInheritanceCallCount() int {
+	fake.createRoleInheritanceMutex.RLock()
+	defer fake.createRoleInheritanceMutex.RUnlock()
+	return len(fake.createRoleInheritanceArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateRoleInheritanceCalls(stub func(context.Context, string, string) error) {
+	fake.createRoleInheritanceMutex.Lock()
+	defer fake.createRoleInheritanceMutex.Unlock()
+	fake.CreateRoleInheritanceStub = stub
+}
+
+func (fake *FakeRBACService) CreateRoleInheritanceArgsForCall(i int) (context.Context, string, string) {
+	fake.createRoleInheritanceMutex.RLock()
+	defer fake.createRoleInheritanceMutex.RUnlock()
+	argsForCall := fake.createRoleInheritanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) CreateRoleInheritanceReturns(result1 error) {
+	fake.createRoleInheritanceMutex.Lock()
+	defer fake.createRoleInheritanceMutex.Unlock()
+	fake.CreateRoleInheritanceStub = nil
+	fake.createRoleInheritanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateRoleInheritanceReturnsOnCall(i int, result1 error) {
+	fake.createRoleInheritanceMutex.Lock()
+	defer fake.createRoleInheritanceMutex.Unlock()
+	fake.CreateRoleInheritanceStub = nil
+	if fake.createRoleInheritanceReturnsOnCall == nil {
+		fake.createRoleInheritanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createRoleInheritanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateRoleTask(arg1 context.Context, arg2 internal.RoleTasks) error {
+	fake.createRoleTaskMutex.Lock()
+	ret, specificReturn := fake.createRoleTaskReturnsOnCall[len(fake.createRoleTaskArgsForCall)]
+	fake.createRoleTaskArgsForCall = append(fake.createRoleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
+	}{arg1, arg2})
+	stub := fake.CreateRoleTaskStub
+	fakeReturns := fake.createRoleTaskReturns
+	fake.recordInvocation("CreateRoleTask", []interface{}{arg1, arg2})
+	fake.createRoleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) CreateRoleTaskCallCount() int {
+	fake.createRoleTaskMutex.RLock()
+	defer fake.createRoleTaskMutex.RUnlock()
+	return len(fake.createRoleTaskArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateRoleTaskCalls(stub func(context.Context, internal.RoleTasks) error) {
+	fake.createRoleTaskMutex.Lock()
+	defer fake.createRoleTaskMutex.Unlock()
+	fake.CreateRoleTaskStub = stub
+}
+
+func (fake *FakeRBACService) CreateRoleTaskArgsForCall(i int) (context.Context, internal.RoleTasks) {
+	fake.createRoleTaskMutex.RLock()
+	defer fake.createRoleTaskMutex.RUnlock()
+	argsForCall := fake.createRoleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateRoleTaskReturns(result1 error) {
+	fake.createRoleTaskMutex.Lock()
+	defer fake.createRoleTaskMutex.Unlock()
+	fake.CreateRoleTaskStub = nil
+	fake.createRoleTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateRoleTaskReturnsOnCall(i int, result1 error) {
+	fake.createRoleTaskMutex.Lock()
+	defer fake.createRoleTaskMutex.Unlock()
+	fake.CreateRoleTaskStub = nil
+	if fake.createRoleTaskReturnsOnCall == nil {
+		fake.createRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createRoleTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) CreateServiceAccount(arg1 context.Context, arg2 string) (internal.Account, error) {
+	fake.createServiceAccountMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountReturnsOnCall[len(fake.createServiceAccountArgsForCall)]
+	fake.createServiceAccountArgsForCall = append(fake.createServiceAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateServiceAccountStub
+	fakeReturns := fake.createServiceAccountReturns
+	fake.recordInvocation("CreateServiceAccount", []interface{}{arg1, arg2})
+	fake.createServiceAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateServiceAccountCallCount() int {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	return len(fake.createServiceAccountArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateServiceAccountCalls(stub func(context.Context, string) (internal.Account, error)) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = stub
+}
+
+func (fake *FakeRBACService) CreateServiceAccountArgsForCall(i int) (context.Context, string) {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	argsForCall := fake.createServiceAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateServiceAccountReturns(result1 internal.Account, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	fake.createServiceAccountReturns = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateServiceAccountReturnsOnCall(i int, result1 internal.Account, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	if fake.createServiceAccountReturnsOnCall == nil {
+		fake.createServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 internal.Account
+			result2 error
+		})
+	}
+	fake.createServiceAccountReturnsOnCall[i] = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateTask(arg1 context.Context, arg2 string) (string, error) {
+	fake.createTaskMutex.Lock()
+	ret, specificReturn := fake.createTaskReturnsOnCall[len(fake.createTaskArgsForCall)]
+	fake.createTaskArgsForCall = append(fake.createTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateTaskStub
+	fakeReturns := fake.createTaskReturns
+	fake.recordInvocation("CreateTask", []interface{}{arg1, arg2})
+	fake.createTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateTaskCallCount() int {
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	return len(fake.createTaskArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateTaskCalls(stub func(context.Context, string) (string, error)) {
+	fake.createTaskMutex.Lock()
+	defer fake.createTaskMutex.Unlock()
+	fake.CreateTaskStub = stub
+}
+
+func (fake *FakeRBACService) CreateTaskArgsForCall(i int) (context.Context, string) {
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	argsForCall := fake.createTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateTaskReturns(result1 string, result2 error) {
+	fake.createTaskMutex.Lock()
+	defer fake.createTaskMutex.Unlock()
+	fake.CreateTaskStub = nil
+	fake.createTaskReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateTaskReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createTaskMutex.Lock()
+	defer fake.createTaskMutex.Unlock()
+	fake.CreateTaskStub = nil
+	if fake.createTaskReturnsOnCall == nil {
+		fake.createTaskReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createTaskReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateTenant(arg1 context.Context, arg2 internal.Tenant, arg3 string) (string, error) {
+	fake.createTenantMutex.Lock()
+	ret, specificReturn := fake.createTenantReturnsOnCall[len(fake.createTenantArgsForCall)]
+	fake.createTenantArgsForCall = append(fake.createTenantArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Tenant
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CreateTenantStub
+	fakeReturns := fake.createTenantReturns
+	fake.recordInvocation("CreateTenant", []interface{}{arg1, arg2, arg3})
+	fake.createTenantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateTenantCallCount() int {
+	fake.createTenantMutex.RLock()
+	defer fake.createTenantMutex.RUnlock()
+	return len(fake.createTenantArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateTenantCalls(stub func(context.Context, internal.Tenant, string) (string, error)) {
+	fake.createTenantMutex.Lock()
+	defer fake.createTenantMutex.Unlock()
+	fake.CreateTenantStub = stub
+}
+
+func (fake *FakeRBACService) CreateTenantArgsForCall(i int) (context.Context, internal.Tenant, string) {
+	fake.createTenantMutex.RLock()
+	defer fake.createTenantMutex.RUnlock()
+	argsForCall := fake.createTenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) CreateTenantReturns(result1 string, result2 error) {
+	fake.createTenantMutex.Lock()
+	defer fake.createTenantMutex.Unlock()
+	fake.CreateTenantStub = nil
+	fake.createTenantReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateTenantReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createTenantMutex.Lock()
+	defer fake.createTenantMutex.Unlock()
+	fake.CreateTenantStub = nil
+	if fake.createTenantReturnsOnCall == nil {
+		fake.createTenantReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createTenantReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateToken(arg1 context.Context, arg2 string) (string, error) {
+	fake.createTokenMutex.Lock()
+	ret, specificReturn := fake.createTokenReturnsOnCall[len(fake.createTokenArgsForCall)]
+	fake.createTokenArgsForCall = append(fake.createTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateTokenStub
+	fakeReturns := fake.createTokenReturns
+	fake.recordInvocation("CreateToken", []interface{}{arg1, arg2})
+	fake.createTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) CreateTokenCallCount() int {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	return len(fake.createTokenArgsForCall)
+}
+
+func (fake *FakeRBACService) CreateTokenCalls(stub func(context.Context, string) (string, error)) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = stub
+}
+
+func (fake *FakeRBACService) CreateTokenArgsForCall(i int) (context.Context, string) {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	argsForCall := fake.createTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) CreateTokenReturns(result1 string, result2 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	fake.createTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) CreateTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	if fake.createTokenReturnsOnCall == nil {
+		fake.createTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) DeleteAccount(arg1 context.Context, arg2 string) error {
+	fake.deleteAccountMutex.Lock()
+	ret, specificReturn := fake.deleteAccountReturnsOnCall[len(fake.deleteAccountArgsForCall)]
+	fake.deleteAccountArgsForCall = append(fake.deleteAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAccountStub
+	fakeReturns := fake.deleteAccountReturns
+	fake.recordInvocation("DeleteAccount", []interface{}{arg1, arg2})
+	fake.deleteAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteAccountCallCount() int {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	return len(fake.deleteAccountArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteAccountCalls(stub func(context.Context, string) error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = stub
+}
+
+func (fake *FakeRBACService) DeleteAccountArgsForCall(i int) (context.Context, string) {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	argsForCall := fake.deleteAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteAccountReturns(result1 error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = nil
+	fake.deleteAccountReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteAccountReturnsOnCall(i int, result1 error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = nil
+	if fake.deleteAccountReturnsOnCall == nil {
+		fake.deleteAccountReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteAccountRole(arg1 context.Context, arg2 string) error {
+	fake.deleteAccountRoleMutex.Lock()
+	ret, specificReturn := fake.deleteAccountRoleReturnsOnCall[len(fake.deleteAccountRoleArgsForCall)]
+	fake.deleteAccountRoleArgsForCall = append(fake.deleteAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAccountRoleStub
+	fakeReturns := fake.deleteAccountRoleReturns
+	fake.recordInvocation("DeleteAccountRole", []interface{}{arg1, arg2})
+	fake.deleteAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteAccountRoleCallCount() int {
+	fake.deleteAccountRoleMutex.RLock()
+	defer fake.deleteAccountRoleMutex.RUnlock()
+	return len(fake.deleteAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteAccountRoleCalls(stub func(context.Context, string) error) {
+	fake.deleteAccountRoleMutex.Lock()
+	defer fake.deleteAccountRoleMutex.Unlock()
+	fake.DeleteAccountRoleStub = stub
+}
+
+func (fake *FakeRBACService) DeleteAccountRoleArgsForCall(i int) (context.Context, string) {
+	fake.deleteAccountRoleMutex.RLock()
+	defer fake.deleteAccountRoleMutex.RUnlock()
+	argsForCall := fake.deleteAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteAccountRoleReturns(result1 error) {
+	fake.deleteAccountRoleMutex.Lock()
+	defer fake.deleteAccountRoleMutex.Unlock()
+	fake.DeleteAccountRoleStub = nil
+	fake.deleteAccountRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteAccountRoleReturnsOnCall(i int, result1 error) {
+	fake.deleteAccountRoleMutex.Lock()
+	defer fake.deleteAccountRoleMutex.Unlock()
+	fake.DeleteAccountRoleStub = nil
+	if fake.deleteAccountRoleReturnsOnCall == nil {
+		fake.deleteAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccountRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteExclusiveRoleSet(arg1 context.Context, arg2 string) error {
+	fake.deleteExclusiveRoleSetMutex.Lock()
+	ret, specificReturn := fake.deleteExclusiveRoleSetReturnsOnCall[len(fake.deleteExclusiveRoleSetArgsForCall)]
+	fake.deleteExclusiveRoleSetArgsForCall = append(fake.deleteExclusiveRoleSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteExclusiveRoleSetStub
+	fakeReturns := fake.deleteExclusiveRoleSetReturns
+	fake.recordInvocation("DeleteExclusiveRoleSet", []interface{}{arg1, arg2})
+	fake.deleteExclusiveRoleSetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteExclusiveRoleSetCallCount() int {
+	fake.deleteExclusiveRoleSetMutex.RLock()
+	defer fake.deleteExclusiveRoleSetMutex.RUnlock()
+	return len(fake.deleteExclusiveRoleSetArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteExclusiveRoleSetCalls(stub func(context.Context, string) error) {
+	fake.deleteExclusiveRoleSetMutex.Lock()
+	defer fake.deleteExclusiveRoleSetMutex.Unlock()
+	fake.DeleteExclusiveRoleSetStub = stub
+}
+
+func (fake *FakeRBACService) DeleteExclusiveRoleSetArgsForCall(i int) (context.Context, string) {
+	fake.deleteExclusiveRoleSetMutex.RLock()
+	defer fake.deleteExclusiveRoleSetMutex.RUnlock()
+	argsForCall := fake.deleteExclusiveRoleSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteExclusiveRoleSetReturns(result1 error) {
+	fake.deleteExclusiveRoleSetMutex.Lock()
+	defer fake.deleteExclusiveRoleSetMutex.Unlock()
+	fake.DeleteExclusiveRoleSetStub = nil
+	fake.deleteExclusiveRoleSetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteExclusiveRoleSetReturnsOnCall(i int, result1 error) {
+	fake.deleteExclusiveRoleSetMutex.Lock()
+	defer fake.deleteExclusiveRoleSetMutex.Unlock()
+	fake.DeleteExclusiveRoleSetStub = nil
+	if fake.deleteExclusiveRoleSetReturnsOnCall == nil {
+		fake.deleteExclusiveRoleSetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteExclusiveRoleSetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteGroup(arg1 context.Context, arg2 string) error {
+	fake.deleteGroupMutex.Lock()
+	ret, specificReturn := fake.deleteGroupReturnsOnCall[len(fake.deleteGroupArgsForCall)]
+	fake.deleteGroupArgsForCall = append(fake.deleteGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteGroupStub
+	fakeReturns := fake.deleteGroupReturns
+	fake.recordInvocation("DeleteGroup", []interface{}{arg1, arg2})
+	fake.deleteGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteGroupCallCount() int {
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
+	return len(fake.deleteGroupArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteGroupCalls(stub func(context.Context, string) error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = stub
+}
+
+func (fake *FakeRBACService) DeleteGroupArgsForCall(i int) (context.Context, string) {
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
+	argsForCall := fake.deleteGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteGroupReturns(result1 error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = nil
+	fake.deleteGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteGroupReturnsOnCall(i int, result1 error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = nil
+	if fake.deleteGroupReturnsOnCall == nil {
+		fake.deleteGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteHelpText(arg1 context.Context, arg2 string) error {
+	fake.deleteHelpTextMutex.Lock()
+	ret, specificReturn := fake.deleteHelpTextReturnsOnCall[len(fake.deleteHelpTextArgsForCall)]
+	fake.deleteHelpTextArgsForCall = append(fake.deleteHelpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteHelpTextStub
+	fakeReturns := fake.deleteHelpTextReturns
+	fake.recordInvocation("DeleteHelpText", []interface{}{arg1, arg2})
+	fake.deleteHelpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteHelpTextCallCount() int {
+	fake.deleteHelpTextMutex.RLock()
+	defer fake.deleteHelpTextMutex.RUnlock()
+	return len(fake.deleteHelpTextArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteHelpTextCalls(stub func(context.Context, string) error) {
+	fake.deleteHelpTextMutex.Lock()
+	defer fake.deleteHelpTextMutex.Unlock()
+	fake.DeleteHelpTextStub = stub
+}
+
+func (fake *FakeRBACService) DeleteHelpTextArgsForCall(i int) (context.Context, string) {
+	fake.deleteHelpTextMutex.RLock()
+	defer fake.deleteHelpTextMutex.RUnlock()
+	argsForCall := fake.deleteHelpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteHelpTextReturns(result1 error) {
+	fake.deleteHelpTextMutex.Lock()
+	defer fake.deleteHelpTextMutex.Unlock()
+	fake.DeleteHelpTextStub = nil
+	fake.deleteHelpTextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteHelpTextReturnsOnCall(i int, result1 error) {
+	fake.deleteHelpTextMutex.Lock()
+	defer fake.deleteHelpTextMutex.Unlock()
+	fake.DeleteHelpTextStub = nil
+	if fake.deleteHelpTextReturnsOnCall == nil {
+		fake.deleteHelpTextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteHelpTextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteMenu(arg1 context.Context, arg2 string) error {
+	fake.deleteMenuMutex.Lock()
+	ret, specificReturn := fake.deleteMenuReturnsOnCall[len(fake.deleteMenuArgsForCall)]
+	fake.deleteMenuArgsForCall = append(fake.deleteMenuArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteMenuStub
+	fakeReturns := fake.deleteMenuReturns
+	fake.recordInvocation("DeleteMenu", []interface{}{arg1, arg2})
+	fake.deleteMenuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteMenuCallCount() int {
+	fake.deleteMenuMutex.RLock()
+	defer fake.deleteMenuMutex.RUnlock()
+	return len(fake.deleteMenuArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteMenuCalls(stub func(context.Context, string) error) {
+	fake.deleteMenuMutex.Lock()
+	defer fake.deleteMenuMutex.Unlock()
+	fake.DeleteMenuStub = stub
+}
+
+func (fake *FakeRBACService) DeleteMenuArgsForCall(i int) (context.Context, string) {
+	fake.deleteMenuMutex.RLock()
+	defer fake.deleteMenuMutex.RUnlock()
+	argsForCall := fake.deleteMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteMenuReturns(result1 error) {
+	fake.deleteMenuMutex.Lock()
+	defer fake.deleteMenuMutex.Unlock()
+	fake.DeleteMenuStub = nil
+	fake.deleteMenuReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteMenuReturnsOnCall(i int, result1 error) {
+	fake.deleteMenuMutex.Lock()
+	defer fake.deleteMenuMutex.Unlock()
+	fake.DeleteMenuStub = nil
+	if fake.deleteMenuReturnsOnCall == nil {
+		fake.deleteMenuReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteMenuReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteNavigation(arg1 context.Context, arg2 string) error {
+	fake.deleteNavigationMutex.Lock()
+	ret, specificReturn := fake.deleteNavigationReturnsOnCall[len(fake.deleteNavigationArgsForCall)]
+	fake.deleteNavigationArgsForCall = append(fake.deleteNavigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteNavigationStub
+	fakeReturns := fake.deleteNavigationReturns
+	fake.recordInvocation("DeleteNavigation", []interface{}{arg1, arg2})
+	fake.deleteNavigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteNavigationCallCount() int {
+	fake.deleteNavigationMutex.RLock()
+	defer fake.deleteNavigationMutex.RUnlock()
+	return len(fake.deleteNavigationArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteNavigationCalls(stub func(context.Context, string) error) {
+	fake.deleteNavigationMutex.Lock()
+	defer fake.deleteNavigationMutex.Unlock()
+	fake.DeleteNavigationStub = stub
+}
+
+func (fake *FakeRBACService) DeleteNavigationArgsForCall(i int) (context.Context, string) {
+	fake.deleteNavigationMutex.RLock()
+	defer fake.deleteNavigationMutex.RUnlock()
+	argsForCall := fake.deleteNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteNavigationReturns(result1 error) {
+	fake.deleteNavigationMutex.Lock()
+	defer fake.deleteNavigationMutex.Unlock()
+	fake.DeleteNavigationStub = nil
+	fake.deleteNavigationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteNavigationReturnsOnCall(i int, result1 error) {
+	fake.deleteNavigationMutex.Lock()
+	defer fake.deleteNavigationMutex.Unlock()
+	fake.DeleteNavigationStub = nil
+	if fake.deleteNavigationReturnsOnCall == nil {
+		fake.deleteNavigationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteNavigationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteRole(arg1 context.Context, arg2 string) error {
+	fake.deleteRoleMutex.Lock()
+	ret, specificReturn := fake.deleteRoleReturnsOnCall[len(fake.deleteRoleArgsForCall)]
+	fake.deleteRoleArgsForCall = append(fake.deleteRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteRoleStub
+	fakeReturns := fake.deleteRoleReturns
+	fake.recordInvocation("DeleteRole", []interface{}{arg1, arg2})
+	fake.deleteRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteRoleCallCount() int {
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	return len(fake.deleteRoleArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteRoleCalls(stub func(context.Context, string) error) {
+	fake.deleteRoleMutex.Lock()
+	defer fake.deleteRoleMutex.Unlock()
+	fake.DeleteRoleStub = stub
+}
+
+func (fake *FakeRBACService) DeleteRoleArgsForCall(i int) (context.Context, string) {
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	argsForCall := fake.deleteRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteRoleReturns(result1 error) {
+	fake.deleteRoleMutex.Lock()
+	defer fake.deleteRoleMutex.Unlock()
+	fake.DeleteRoleStub = nil
+	fake.deleteRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteRoleReturnsOnCall(i int, result1 error) {
+	fake.deleteRoleMutex.Lock()
+	defer fake.deleteRoleMutex.Unlock()
+	fake.DeleteRoleStub = nil
+	if fake.deleteRoleReturnsOnCall == nil {
+		fake.deleteRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteRoleInheritance(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.deleteRoleInheritanceMutex.Lock()
+	ret, specificReturn := fake.deleteRoleInheritanceReturnsOnCall[len(fake.deleteRoleInheritanceArgsForCall)]
+	fake.deleteRoleInheritanceArgsForCall = append(fake.deleteRoleInheritanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteRoleInheritanceStub
+	fakeReturns := fake.deleteRoleInheritanceReturns
+	fake.recordInvocation("DeleteRoleInheritance", []interface{}{arg1, arg2, arg3})
+	fake.deleteRoleInheritanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteRoleInheritanceCallCount() int {
+	fake.deleteRoleInheritanceMutex.RLock()
+	defer fake.deleteRoleInheritanceMutex.RUnlock()
+	return len(fake.deleteRoleInheritanceArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteRoleInheritanceCalls(stub func(context.Context, string, string) error) {
+	fake.deleteRoleInheritanceMutex.Lock()
+	defer fake.deleteRoleInheritanceMutex.Unlock()
+	fake.DeleteRoleInheritanceStub = stub
+}
+
+func (fake *FakeRBACService) DeleteRoleInheritanceArgsForCall(i int) (context.Context, string, string) {
+	fake.deleteRoleInheritanceMutex.RLock()
+	defer fake.deleteRoleInheritanceMutex.RUnlock()
+	argsForCall := fake.deleteRoleInheritanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) DeleteRoleInheritanceReturns(result1 error) {
+	fake.deleteRoleInheritanceMutex.Lock()
+	defer fake.deleteRoleInheritanceMutex.Unlock()
+	fake.DeleteRoleInheritanceStub = nil
+	fake.deleteRoleInheritanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteRoleInheritanceReturnsOnCall(i int, result1 error) {
+	fake.deleteRoleInheritanceMutex.Lock()
+	defer fake.deleteRoleInheritanceMutex.Unlock()
+	fake.DeleteRoleInheritanceStub = nil
+	if fake.deleteRoleInheritanceReturnsOnCall == nil {
+		fake.deleteRoleInheritanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRoleInheritanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteRoleTask(arg1 context.Context, arg2 string) error {
+	fake.deleteRoleTaskMutex.Lock()
+	ret, specificReturn := fake.deleteRoleTaskReturnsOnCall[len(fake.deleteRoleTaskArgsForCall)]
+	fake.deleteRoleTaskArgsForCall = append(fake.deleteRoleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteRoleTaskStub
+	fakeReturns := fake.deleteRoleTaskReturns
+	fake.recordInvocation("DeleteRoleTask", []interface{}{arg1, arg2})
+	fake.deleteRoleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteRoleTaskCallCount() int {
+	fake.deleteRoleTaskMutex.RLock()
+	defer fake.deleteRoleTaskMutex.RUnlock()
+	return len(fake.deleteRoleTaskArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteRoleTaskCalls(stub func(context.Context, string) error) {
+	fake.deleteRoleTaskMutex.Lock()
+	defer fake.deleteRoleTaskMutex.Unlock()
+	fake.DeleteRoleTaskStub = stub
+}
+
+func (fake *FakeRBACService) DeleteRoleTaskArgsForCall(i int) (context.Context, string) {
+	fake.deleteRoleTaskMutex.RLock()
+	defer fake.deleteRoleTaskMutex.RUnlock()
+	argsForCall := fake.deleteRoleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteRoleTaskReturns(result1 error) {
+	fake.deleteRoleTaskMutex.Lock()
+	defer fake.deleteRoleTaskMutex.Unlock()
+	fake.DeleteRoleTaskStub = nil
+	fake.deleteRoleTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteRoleTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteRoleTaskMutex.Lock()
+	defer fake.deleteRoleTaskMutex.Unlock()
+	fake.DeleteRoleTaskStub = nil
+	if fake.deleteRoleTaskReturnsOnCall == nil {
+		fake.deleteRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRoleTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteTask(arg1 context.Context, arg2 string) error {
+	fake.deleteTaskMutex.Lock()
+	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
+	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteTaskStub
+	fakeReturns := fake.deleteTaskReturns
+	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2})
+	fake.deleteTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DeleteTaskCallCount() int {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	return len(fake.deleteTaskArgsForCall)
+}
+
+func (fake *FakeRBACService) DeleteTaskCalls(stub func(context.Context, string) error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = stub
+}
+
+func (fake *FakeRBACService) DeleteTaskArgsForCall(i int) (context.Context, string) {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	argsForCall := fake.deleteTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DeleteTaskReturns(result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	fake.deleteTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DeleteTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	if fake.deleteTaskReturnsOnCall == nil {
+		fake.deleteTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DisableMFA(arg1 context.Context, arg2 string) error {
+	fake.disableMFAMutex.Lock()
+	ret, specificReturn := fake.disableMFAReturnsOnCall[len(fake.disableMFAArgsForCall)]
+	fake.disableMFAArgsForCall = append(fake.disableMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DisableMFAStub
+	fakeReturns := fake.disableMFAReturns
+	fake.recordInvocation("DisableMFA", []interface{}{arg1, arg2})
+	fake.disableMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DisableMFACallCount() int {
+	fake.disableMFAMutex.RLock()
+	defer fake.disableMFAMutex.RUnlock()
+	return len(fake.disableMFAArgsForCall)
+}
+
+func (fake *FakeRBACService) DisableMFACalls(stub func(context.Context, string) error) {
+	fake.disableMFAMutex.Lock()
+	defer fake.disableMFAMutex.Unlock()
+	fake.DisableMFAStub = stub
+}
+
+func (fake *FakeRBACService) DisableMFAArgsForCall(i int) (context.Context, string) {
+	fake.disableMFAMutex.RLock()
+	defer fake.disableMFAMutex.RUnlock()
+	argsForCall := fake.disableMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) DisableMFAReturns(result1 error) {
+	fake.disableMFAMutex.Lock()
+	defer fake.disableMFAMutex.Unlock()
+	fake.DisableMFAStub = nil
+	fake.disableMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DisableMFAReturnsOnCall(i int, result1 error) {
+	fake.disableMFAMutex.Lock()
+	defer fake.disableMFAMutex.Unlock()
+	fake.DisableMFAStub = nil
+	if fake.disableMFAReturnsOnCall == nil {
+		fake.disableMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DisableOwnMFA(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.disableOwnMFAMutex.Lock()
+	ret, specificReturn := fake.disableOwnMFAReturnsOnCall[len(fake.disableOwnMFAArgsForCall)]
+	fake.disableOwnMFAArgsForCall = append(fake.disableOwnMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DisableOwnMFAStub
+	fakeReturns := fake.disableOwnMFAReturns
+	fake.recordInvocation("DisableOwnMFA", []interface{}{arg1, arg2, arg3})
+	fake.disableOwnMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) DisableOwnMFACallCount() int {
+	fake.disableOwnMFAMutex.RLock()
+	defer fake.disableOwnMFAMutex.RUnlock()
+	return len(fake.disableOwnMFAArgsForCall)
+}
+
+func (fake *FakeRBACService) DisableOwnMFACalls(stub func(context.Context, string, string) error) {
+	fake.disableOwnMFAMutex.Lock()
+	defer fake.disableOwnMFAMutex.Unlock()
+	fake.DisableOwnMFAStub = stub
+}
+
+func (fake *FakeRBACService) DisableOwnMFAArgsForCall(i int) (context.Context, string, string) {
+	fake.disableOwnMFAMutex.RLock()
+	defer fake.disableOwnMFAMutex.RUnlock()
+	argsForCall := fake.disableOwnMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) DisableOwnMFAReturns(result1 error) {
+	fake.disableOwnMFAMutex.Lock()
+	defer fake.disableOwnMFAMutex.Unlock()
+	fake.DisableOwnMFAStub = nil
+	fake.disableOwnMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) DisableOwnMFAReturnsOnCall(i int, result1 error) {
+	fake.disableOwnMFAMutex.Lock()
+	defer fake.disableOwnMFAMutex.Unlock()
+	fake.DisableOwnMFAStub = nil
+	if fake.disableOwnMFAReturnsOnCall == nil {
+		fake.disableOwnMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableOwnMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) EffectivePermissions(arg1 context.Context, arg2 string) (internal.EffectivePermissions, error) {
+	fake.effectivePermissionsMutex.Lock()
+	ret, specificReturn := fake.effectivePermissionsReturnsOnCall[len(fake.effectivePermissionsArgsForCall)]
+	fake.effectivePermissionsArgsForCall = append(fake.effectivePermissionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.EffectivePermissionsStub
+	fakeReturns := fake.effectivePermissionsReturns
+	fake.recordInvocation("EffectivePermissions", []interface{}{arg1, arg2})
+	fake.effectivePermissionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) EffectivePermissionsCallCount() int {
+	fake.effectivePermissionsMutex.RLock()
+	defer fake.effectivePermissionsMutex.RUnlock()
+	return len(fake.effectivePermissionsArgsForCall)
+}
+
+func (fake *FakeRBACService) EffectivePermissionsCalls(stub func(context.Context, string) (internal.EffectivePermissions, error)) {
+	fake.effectivePermissionsMutex.Lock()
+	defer fake.effectivePermissionsMutex.Unlock()
+	fake.EffectivePermissionsStub = stub
+}
+
+func (fake *FakeRBACService) EffectivePermissionsArgsForCall(i int) (context.Context, string) {
+	fake.effectivePermissionsMutex.RLock()
+	defer fake.effectivePermissionsMutex.RUnlock()
+	argsForCall := fake.effectivePermissionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) EffectivePermissionsReturns(result1 internal.EffectivePermissions, result2 error) {
+	fake.effectivePermissionsMutex.Lock()
+	defer fake.effectivePermissionsMutex.Unlock()
+	fake.EffectivePermissionsStub = nil
+	fake.effectivePermissionsReturns = struct {
+		result1 internal.EffectivePermissions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) EffectivePermissionsReturnsOnCall(i int, result1 internal.EffectivePermissions, result2 error) {
+	fake.effectivePermissionsMutex.Lock()
+	defer fake.effectivePermissionsMutex.Unlock()
+	fake.EffectivePermissionsStub = nil
+	if fake.effectivePermissionsReturnsOnCall == nil {
+		fake.effectivePermissionsReturnsOnCall = make(map[int]struct {
+			result1 internal.EffectivePermissions
+			result2 error
+		})
+	}
+	fake.effectivePermissionsReturnsOnCall[i] = struct {
+		result1 internal.EffectivePermissions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) EmergencyRoles(arg1 context.Context) ([]internal.Roles, error) {
+	fake.emergencyRolesMutex.Lock()
+	ret, specificReturn := fake.emergencyRolesReturnsOnCall[len(fake.emergencyRolesArgsForCall)]
+	fake.emergencyRolesArgsForCall = append(fake.emergencyRolesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.EmergencyRolesStub
+	fakeReturns := fake.emergencyRolesReturns
+	fake.recordInvocation("EmergencyRoles", []interface{}{arg1})
+	fake.emergencyRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) EmergencyRolesCallCount() int {
+	fake.emergencyRolesMutex.RLock()
+	defer fake.emergencyRolesMutex.RUnlock()
+	return len(fake.emergencyRolesArgsForCall)
+}
+
+func (fake *FakeRBACService) EmergencyRolesCalls(stub func(context.Context) ([]internal.Roles, error)) {
+	fake.emergencyRolesMutex.Lock()
+	defer fake.emergencyRolesMutex.Unlock()
+	fake.EmergencyRolesStub = stub
+}
+
+func (fake *FakeRBACService) EmergencyRolesArgsForCall(i int) context.Context {
+	fake.emergencyRolesMutex.RLock()
+	defer fake.emergencyRolesMutex.RUnlock()
+	argsForCall := fake.emergencyRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACService) EmergencyRolesReturns(result1 []internal.Roles, result2 error) {
+	fake.emergencyRolesMutex.Lock()
+	defer fake.emergencyRolesMutex.Unlock()
+	fake.EmergencyRolesStub = nil
+	fake.emergencyRolesReturns = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) EmergencyRolesReturnsOnCall(i int, result1 []internal.Roles, result2 error) {
+	fake.emergencyRolesMutex.Lock()
+	defer fake.emergencyRolesMutex.Unlock()
+	fake.EmergencyRolesStub = nil
+	if fake.emergencyRolesReturnsOnCall == nil {
+		fake.emergencyRolesReturnsOnCall = make(map[int]struct {
+			result1 []internal.Roles
+			result2 error
+		})
+	}
+	fake.emergencyRolesReturnsOnCall[i] = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) EnrollMFA(arg1 context.Context, arg2 string) (internal.MFAEnrollment, error) {
+	fake.enrollMFAMutex.Lock()
+	ret, specificReturn := fake.enrollMFAReturnsOnCall[len(fake.enrollMFAArgsForCall)]
+	fake.enrollMFAArgsForCall = append(fake.enrollMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.EnrollMFAStub
+	fakeReturns := fake.enrollMFAReturns
+	fake.recordInvocation("EnrollMFA", []interface{}{arg1, arg2})
+	fake.enrollMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) EnrollMFACallCount() int {
+	fake.enrollMFAMutex.RLock()
+	defer fake.enrollMFAMutex.RUnlock()
+	return len(fake.enrollMFAArgsForCall)
+}
+
+func (fake *FakeRBACService) EnrollMFACalls(stub func(context.Context, string) (internal.MFAEnrollment, error)) {
+	fake.enrollMFAMutex.Lock()
+	defer fake.enrollMFAMutex.Unlock()
+	fake.EnrollMFAStub = stub
+}
+
+func (fake *FakeRBACService) EnrollMFAArgsForCall(i int) (context.Context, string) {
+	fake.enrollMFAMutex.RLock()
+	defer fake.enrollMFAMutex.RUnlock()
+	argsForCall := fake.enrollMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) EnrollMFAReturns(result1 internal.MFAEnrollment, result2 error) {
+	fake.enrollMFAMutex.Lock()
+	defer fake.enrollMFAMutex.Unlock()
+	fake.EnrollMFAStub = nil
+	fake.enrollMFAReturns = struct {
+		result1 internal.MFAEnrollment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) EnrollMFAReturnsOnCall(i int, result1 internal.MFAEnrollment, result2 error) {
+	fake.enrollMFAMutex.Lock()
+	defer fake.enrollMFAMutex.Unlock()
+	fake.EnrollMFAStub = nil
+	if fake.enrollMFAReturnsOnCall == nil {
+		fake.enrollMFAReturnsOnCall = make(map[int]struct {
+			result1 internal.MFAEnrollment
+			result2 error
+		})
+	}
+	fake.enrollMFAReturnsOnCall[i] = struct {
+		result1 internal.MFAEnrollment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) EnrollMFAWithChallenge(arg1 context.Context, arg2 string) (internal.MFAEnrollment, error) {
+	fake.enrollMFAWithChallengeMutex.Lock()
+	ret, specificReturn := fake.enrollMFAWithChallengeReturnsOnCall[len(fake.enrollMFAWithChallengeArgsForCall)]
+	fake.enrollMFAWithChallengeArgsForCall = append(fake.enrollMFAWithChallengeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.EnrollMFAWithChallengeStub
+	fakeReturns := fake.enrollMFAWithChallengeReturns
+	fake.recordInvocation("EnrollMFAWithChallenge", []interface{}{arg1, arg2})
+	fake.enrollMFAWithChallengeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) EnrollMFAWithChallengeCallCount() int {
+	fake.enrollMFAWithChallengeMutex.RLock()
+	defer fake.enrollMFAWithChallengeMutex.RUnlock()
+	return len(fake.enrollMFAWithChallengeArgsForCall)
+}
+
+func (fake *FakeRBACService) EnrollMFAWithChallengeCalls(stub func(context.Context, string) (internal.MFAEnrollment, error)) {
+	fake.enrollMFAWithChallengeMutex.Lock()
+	defer fake.enrollMFAWithChallengeMutex.Unlock()
+	fake.EnrollMFAWithChallengeStub = stub
+}
+
+func (fake *FakeRBACService) EnrollMFAWithChallengeArgsForCall(i int) (context.Context, string) {
+	fake.enrollMFAWithChallengeMutex.RLock()
+	defer fake.enrollMFAWithChallengeMutex.RUnlock()
+	argsForCall := fake.enrollMFAWithChallengeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) EnrollMFAWithChallengeReturns(result1 internal.MFAEnrollment, result2 error) {
+	fake.enrollMFAWithChallengeMutex.Lock()
+	defer fake.enrollMFAWithChallengeMutex.Unlock()
+	fake.EnrollMFAWithChallengeStub = nil
+	fake.enrollMFAWithChallengeReturns = struct {
+		result1 internal.MFAEnrollment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) EnrollMFAWithChallengeReturnsOnCall(i int, result1 internal.MFAEnrollment, result2 error) {
+	fake.enrollMFAWithChallengeMutex.Lock()
+	defer fake.enrollMFAWithChallengeMutex.Unlock()
+	fake.EnrollMFAWithChallengeStub = nil
+	if fake.enrollMFAWithChallengeReturnsOnCall == nil {
+		fake.enrollMFAWithChallengeReturnsOnCall = make(map[int]struct {
+			result1 internal.MFAEnrollment
+			result2 error
+		})
+	}
+	fake.enrollMFAWithChallengeReturnsOnCall[i] = struct {
+		result1 internal.MFAEnrollment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSet(arg1 context.Context, arg2 string) (internal.ExclusiveRoleSet, error) {
+	fake.exclusiveRoleSetMutex.Lock()
+	ret, specificReturn := fake.exclusiveRoleSetReturnsOnCall[len(fake.exclusiveRoleSetArgsForCall)]
+	fake.exclusiveRoleSetArgsForCall = append(fake.exclusiveRoleSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ExclusiveRoleSetStub
+	fakeReturns := fake.exclusiveRoleSetReturns
+	fake.recordInvocation("ExclusiveRoleSet", []interface{}{arg1, arg2})
+	fake.exclusiveRoleSetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetCallCount() int {
+	fake.exclusiveRoleSetMutex.RLock()
+	defer fake.exclusiveRoleSetMutex.RUnlock()
+	return len(fake.exclusiveRoleSetArgsForCall)
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetCalls(stub func(context.Context, string) (internal.ExclusiveRoleSet, error)) {
+	fake.exclusiveRoleSetMutex.Lock()
+	defer fake.exclusiveRoleSetMutex.Unlock()
+	fake.ExclusiveRoleSetStub = stub
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetArgsForCall(i int) (context.Context, string) {
+	fake.exclusiveRoleSetMutex.RLock()
+	defer fake.exclusiveRoleSetMutex.RUnlock()
+	argsForCall := fake.exclusiveRoleSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetReturns(result1 internal.ExclusiveRoleSet, result2 error) {
+	fake.exclusiveRoleSetMutex.Lock()
+	defer fake.exclusiveRoleSetMutex.Unlock()
+	fake.ExclusiveRoleSetStub = nil
+	fake.exclusiveRoleSetReturns = struct {
+		result1 internal.ExclusiveRoleSet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetReturnsOnCall(i int, result1 internal.ExclusiveRoleSet, result2 error) {
+	fake.exclusiveRoleSetMutex.Lock()
+	defer fake.exclusiveRoleSetMutex.Unlock()
+	fake.ExclusiveRoleSetStub = nil
+	if fake.exclusiveRoleSetReturnsOnCall == nil {
+		fake.exclusiveRoleSetReturnsOnCall = make(map[int]struct {
+			result1 internal.ExclusiveRoleSet
+			result2 error
+		})
+	}
+	fake.exclusiveRoleSetReturnsOnCall[i] = struct {
+		result1 internal.ExclusiveRoleSet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSets(arg1 context.Context) ([]internal.ExclusiveRoleSet, error) {
+	fake.exclusiveRoleSetsMutex.Lock()
+	ret, specificReturn := fake.exclusiveRoleSetsReturnsOnCall[len(fake.exclusiveRoleSetsArgsForCall)]
+	fake.exclusiveRoleSetsArgsForCall = append(fake.exclusiveRoleSetsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ExclusiveRoleSetsStub
+	fakeReturns := fake.exclusiveRoleSetsReturns
+	fake.recordInvocation("ExclusiveRoleSets", []interface{}{arg1})
+	fake.exclusiveRoleSetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetsCallCount() int {
+	fake.exclusiveRoleSetsMutex.RLock()
+	defer fake.exclusiveRoleSetsMutex.RUnlock()
+	return len(fake.exclusiveRoleSetsArgsForCall)
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetsCalls(stub func(context.Context) ([]internal.ExclusiveRoleSet, error)) {
+	fake.exclusiveRoleSetsMutex.Lock()
+	defer fake.exclusiveRoleSetsMutex.Unlock()
+	fake.ExclusiveRoleSetsStub = stub
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetsArgsForCall(i int) context.Context {
+	fake.exclusiveRoleSetsMutex.RLock()
+	defer fake.exclusiveRoleSetsMutex.RUnlock()
+	argsForCall := fake.exclusiveRoleSetsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetsReturns(result1 []internal.ExclusiveRoleSet, result2 error) {
+	fake.exclusiveRoleSetsMutex.Lock()
+	defer fake.exclusiveRoleSetsMutex.Unlock()
+	fake.ExclusiveRoleSetsStub = nil
+	fake.exclusiveRoleSetsReturns = struct {
+		result1 []internal.ExclusiveRoleSet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ExclusiveRoleSetsReturnsOnCall(i int, result1 []internal.ExclusiveRoleSet, result2 error) {
+	fake.exclusiveRoleSetsMutex.Lock()
+	defer fake.exclusiveRoleSetsMutex.Unlock()
+	fake.ExclusiveRoleSetsStub = nil
+	if fake.exclusiveRoleSetsReturnsOnCall == nil {
+		fake.exclusiveRoleSetsReturnsOnCall = make(map[int]struct {
+			result1 []internal.ExclusiveRoleSet
+			result2 error
+		})
+	}
+	fake.exclusiveRoleSetsReturnsOnCall[i] = struct {
+		result1 []internal.ExclusiveRoleSet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ExplainPermission(arg1 context.Context, arg2 string, arg3 string, arg4 *internal.Resource) (internal.Explanation, error) {
+	fake.explainPermissionMutex.Lock()
+	ret, specificReturn := fake.explainPermissionReturnsOnCall[len(fake.explainPermissionArgsForCall)]
+	fake.explainPermissionArgsForCall = append(fake.explainPermissionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 *internal.Resource
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ExplainPermissionStub
+	fakeReturns := fake.explainPermissionReturns
+	fake.recordInvocation("ExplainPermission", []interface{}{arg1, arg2, arg3, arg4})
+	fake.explainPermissionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) ExplainPermissionCallCount() int {
+	fake.explainPermissionMutex.RLock()
+	defer fake.explainPermissionMutex.RUnlock()
+	return len(fake.explainPermissionArgsForCall)
+}
+
+func (fake *FakeRBACService) ExplainPermissionCalls(stub func(context.Context, string, string, *internal.Resource) (internal.Explanation, error)) {
+	fake.explainPermissionMutex.Lock()
+	defer fake.explainPermissionMutex.Unlock()
+	fake.ExplainPermissionStub = stub
+}
+
+func (fake *FakeRBACService) ExplainPermissionArgsForCall(i int) (context.Context, string, string, *internal.Resource) {
+	fake.explainPermissionMutex.RLock()
+	defer fake.explainPermissionMutex.RUnlock()
+	argsForCall := fake.explainPermissionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACService) ExplainPermissionReturns(result1 internal.Explanation, result2 error) {
+	fake.explainPermissionMutex.Lock()
+	defer fake.explainPermissionMutex.Unlock()
+	fake.ExplainPermissionStub = nil
+	fake.explainPermissionReturns = struct {
+		result1 internal.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ExplainPermissionReturnsOnCall(i int, result1 internal.Explanation, result2 error) {
+	fake.explainPermissionMutex.Lock()
+	defer fake.explainPermissionMutex.Unlock()
+	fake.ExplainPermissionStub = nil
+	if fake.explainPermissionReturnsOnCall == nil {
+		fake.explainPermissionReturnsOnCall = make(map[int]struct {
+			result1 internal.Explanation
+			result2 error
+		})
+	}
+	fake.explainPermissionReturnsOnCall[i] = struct {
+		result1 internal.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ForgotPassword(arg1 context.Context, arg2 string) error {
+	fake.forgotPasswordMutex.Lock()
+	ret, specificReturn := fake.forgotPasswordReturnsOnCall[len(fake.forgotPasswordArgsForCall)]
+	fake.forgotPasswordArgsForCall = append(fake.forgotPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ForgotPasswordStub
+	fakeReturns := fake.forgotPasswordReturns
+	fake.recordInvocation("ForgotPassword", []interface{}{arg1, arg2})
+	fake.forgotPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) ForgotPasswordCallCount() int {
+	fake.forgotPasswordMutex.RLock()
+	defer fake.forgotPasswordMutex.RUnlock()
+	return len(fake.forgotPasswordArgsForCall)
+}
+
+func (fake *FakeRBACService) ForgotPasswordCalls(stub func(context.Context, string) error) {
+	fake.forgotPasswordMutex.Lock()
+	defer fake.forgotPasswordMutex.Unlock()
+	fake.ForgotPasswordStub = stub
+}
+
+func (fake *FakeRBACService) ForgotPasswordArgsForCall(i int) (context.Context, string) {
+	fake.forgotPasswordMutex.RLock()
+	defer fake.forgotPasswordMutex.RUnlock()
+	argsForCall := fake.forgotPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) ForgotPasswordReturns(result1 error) {
+	fake.forgotPasswordMutex.Lock()
+	defer fake.forgotPasswordMutex.Unlock()
+	fake.ForgotPasswordStub = nil
+	fake.forgotPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) ForgotPasswordReturnsOnCall(i int, result1 error) {
+	fake.forgotPasswordMutex.Lock()
+	defer fake.forgotPasswordMutex.Unlock()
+	fake.ForgotPasswordStub = nil
+	if fake.forgotPasswordReturnsOnCall == nil {
+		fake.forgotPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forgotPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) Group(arg1 context.Context, arg2 string) (internal.Group, error) {
+	fake.groupMutex.Lock()
+	ret, specificReturn := fake.groupReturnsOnCall[len(fake.groupArgsForCall)]
+	fake.groupArgsForCall = append(fake.groupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GroupStub
+	fakeReturns := fake.groupReturns
+	fake.recordInvocation("Group", []interface{}{arg1, arg2})
+	fake.groupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) GroupCallCount() int {
+	fake.groupMutex.RLock()
+	defer fake.groupMutex.RUnlock()
+	return len(fake.groupArgsForCall)
+}
+
+func (fake *FakeRBACService) GroupCalls(stub func(context.Context, string) (internal.Group, error)) {
+	fake.groupMutex.Lock()
+	defer fake.groupMutex.Unlock()
+	fake.GroupStub = stub
+}
+
+func (fake *FakeRBACService) GroupArgsForCall(i int) (context.Context, string) {
+	fake.groupMutex.RLock()
+	defer fake.groupMutex.RUnlock()
+	argsForCall := fake.groupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) GroupReturns(result1 internal.Group, result2 error) {
+	fake.groupMutex.Lock()
+	defer fake.groupMutex.Unlock()
+	fake.GroupStub = nil
+	fake.groupReturns = struct {
+		result1 internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) GroupReturnsOnCall(i int, result1 internal.Group, result2 error) {
+	fake.groupMutex.Lock()
+	defer fake.groupMutex.Unlock()
+	fake.GroupStub = nil
+	if fake.groupReturnsOnCall == nil {
+		fake.groupReturnsOnCall = make(map[int]struct {
+			result1 internal.Group
+			result2 error
+		})
+	}
+	fake.groupReturnsOnCall[i] = struct {
+		result1 internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) GroupMembers(arg1 context.Context, arg2 string) ([]internal.GroupMember, error) {
+	fake.groupMembersMutex.Lock()
+	ret, specificReturn := fake.groupMembersReturnsOnCall[len(fake.groupMembersArgsForCall)]
+	fake.groupMembersArgsForCall = append(fake.groupMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GroupMembersStub
+	fakeReturns := fake.groupMembersReturns
+	fake.recordInvocation("GroupMembers", []interface{}{arg1, arg2})
+	fake.groupMembersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) GroupMembersCallCount() int {
+	fake.groupMembersMutex.RLock()
+	defer fake.groupMembersMutex.RUnlock()
+	return len(fake.groupMembersArgsForCall)
+}
+
+func (fake *FakeRBACService) GroupMembersCalls(stub func(context.Context, string) ([]internal.GroupMember, error)) {
+	fake.groupMembersMutex.Lock()
+	defer fake.groupMembersMutex.Unlock()
+	fake.GroupMembersStub = stub
+}
+
+func (fake *FakeRBACService) GroupMembersArgsForCall(i int) (context.Context, string) {
+	fake.groupMembersMutex.RLock()
+	defer fake.groupMembersMutex.RUnlock()
+	argsForCall := fake.groupMembersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) GroupMembersReturns(result1 []internal.GroupMember, result2 error) {
+	fake.groupMembersMutex.Lock()
+	defer fake.groupMembersMutex.Unlock()
+	fake.GroupMembersStub = nil
+	fake.groupMembersReturns = struct {
+		result1 []internal.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) GroupMembersReturnsOnCall(i int, result1 []internal.GroupMember, result2 error) {
+	fake.groupMembersMutex.Lock()
+	defer fake.groupMembersMutex.Unlock()
+	fake.GroupMembersStub = nil
+	if fake.groupMembersReturnsOnCall == nil {
+		fake.groupMembersReturnsOnCall = make(map[int]struct {
+			result1 []internal.GroupMember
+			result2 error
+		})
+	}
+	fake.groupMembersReturnsOnCall[i] = struct {
+		result1 []internal.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) GroupRoles(arg1 context.Context, arg2 string) ([]internal.Roles, error) {
+	fake.groupRolesMutex.Lock()
+	ret, specificReturn := fake.groupRolesReturnsOnCall[len(fake.groupRolesArgsForCall)]
+	fake.groupRolesArgsForCall = append(fake.groupRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GroupRolesStub
+	fakeReturns := fake.groupRolesReturns
+	fake.recordInvocation("GroupRoles", []interface{}{arg1, arg2})
+	fake.groupRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) GroupRolesCallCount() int {
+	fake.groupRolesMutex.RLock()
+	defer fake.groupRolesMutex.RUnlock()
+	return len(fake.groupRolesArgsForCall)
+}
+
+func (fake *FakeRBACService) GroupRolesCalls(stub func(context.Context, string) ([]internal.Roles, error)) {
+	fake.groupRolesMutex.Lock()
+	defer fake.groupRolesMutex.Unlock()
+	fake.GroupRolesStub = stub
+}
+
+func (fake *FakeRBACService) GroupRolesArgsForCall(i int) (context.Context, string) {
+	fake.groupRolesMutex.RLock()
+	defer fake.groupRolesMutex.RUnlock()
+	argsForCall := fake.groupRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) GroupRolesReturns(result1 []internal.Roles, result2 error) {
+	fake.groupRolesMutex.Lock()
+	defer fake.groupRolesMutex.Unlock()
+	fake.GroupRolesStub = nil
+	fake.groupRolesReturns = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) GroupRolesReturnsOnCall(i int, result1 []internal.Roles, result2 error) {
+	fake.groupRolesMutex.Lock()
+	defer fake.groupRolesMutex.Unlock()
+	fake.GroupRolesStub = nil
+	if fake.groupRolesReturnsOnCall == nil {
+		fake.groupRolesReturnsOnCall = make(map[int]struct {
+			result1 []internal.Roles
+			result2 error
+		})
+	}
+	fake.groupRolesReturnsOnCall[i] = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) Groups(arg1 context.Context) ([]internal.Group, error) {
+	fake.groupsMutex.Lock()
+	ret, specificReturn := fake.groupsReturnsOnCall[len(fake.groupsArgsForCall)]
+	fake.groupsArgsForCall = append(fake.groupsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GroupsStub
+	fakeReturns := fake.groupsReturns
+	fake.recordInvocation("Groups", []interface{}{arg1})
+	fake.groupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) GroupsCallCount() int {
+	fake.groupsMutex.RLock()
+	defer fake.groupsMutex.RUnlock()
+	return len(fake.groupsArgsForCall)
+}
+
+func (fake *FakeRBACService) GroupsCalls(stub func(context.Context) ([]internal.Group, error)) {
+	fake.groupsMutex.Lock()
+	defer fake.groupsMutex.Unlock()
+	fake.GroupsStub = stub
+}
+
+func (fake *FakeRBACService) GroupsArgsForCall(i int) context.Context {
+	fake.groupsMutex.RLock()
+	defer fake.groupsMutex.RUnlock()
+	argsForCall := fake.groupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACService) GroupsReturns(result1 []internal.Group, result2 error) {
+	fake.groupsMutex.Lock()
+	defer fake.groupsMutex.Unlock()
+	fake.GroupsStub = nil
+	fake.groupsReturns = struct {
+		result1 []internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) GroupsReturnsOnCall(i int, result1 []internal.Group, result2 error) {
+	fake.groupsMutex.Lock()
+	defer fake.groupsMutex.Unlock()
+	fake.GroupsStub = nil
+	if fake.groupsReturnsOnCall == nil {
+		fake.groupsReturnsOnCall = make(map[int]struct {
+			result1 []internal.Group
+			result2 error
+		})
+	}
+	fake.groupsReturnsOnCall[i] = struct {
+		result1 []internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) HelpText(arg1 context.Context, arg2 string) (internal.HelpText, error) {
+	fake.helpTextMutex.Lock()
+	ret, specificReturn := fake.helpTextReturnsOnCall[len(fake.helpTextArgsForCall)]
+	fake.helpTextArgsForCall = append(fake.helpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.HelpTextStub
+	fakeReturns := fake.helpTextReturns
+	fake.recordInvocation("HelpText", []interface{}{arg1, arg2})
+	fake.helpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) HelpTextCallCount() int {
+	fake.helpTextMutex.RLock()
+	defer fake.helpTextMutex.RUnlock()
+	return len(fake.helpTextArgsForCall)
+}
+
+func (fake *FakeRBACService) HelpTextCalls(stub func(context.Context, string) (internal.HelpText, error)) {
+	fake.helpTextMutex.Lock()
+	defer fake.helpTextMutex.Unlock()
+	fake.HelpTextStub = stub
+}
+
+func (fake *FakeRBACService) HelpTextArgsForCall(i int) (context.Context, string) {
+	fake.helpTextMutex.RLock()
+	defer fake.helpTextMutex.RUnlock()
+	argsForCall := fake.helpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) HelpTextReturns(result1 internal.HelpText, result2 error) {
+	fake.helpTextMutex.Lock()
+	defer fake.helpTextMutex.Unlock()
+	fake.HelpTextStub = nil
+	fake.helpTextReturns = struct {
+		result1 internal.HelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) HelpTextReturnsOnCall(i int, result1 internal.HelpText, result2 error) {
+	fake.helpTextMutex.Lock()
+	defer fake.helpTextMutex.Unlock()
+	fake.HelpTextStub = nil
+	if fake.helpTextReturnsOnCall == nil {
+		fake.helpTextReturnsOnCall = make(map[int]struct {
+			result1 internal.HelpText
+			result2 error
+		})
+	}
+	fake.helpTextReturnsOnCall[i] = struct {
+		result1 internal.HelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IPLockout(arg1 context.Context, arg2 string) (internal.Lockout, error) {
+	fake.iPLockoutMutex.Lock()
+	ret, specificReturn := fake.iPLockoutReturnsOnCall[len(fake.iPLockoutArgsForCall)]
+	fake.iPLockoutArgsForCall = append(fake.iPLockoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IPLockoutStub
+	fakeReturns := fake.iPLockoutReturns
+	fake.recordInvocation("IPLockout", []interface{}{arg1, arg2})
+	fake.iPLockoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) IPLockoutCallCount() int {
+	fake.iPLockoutMutex.RLock()
+	defer fake.iPLockoutMutex.RUnlock()
+	return len(fake.iPLockoutArgsForCall)
+}
+
+func (fake *FakeRBACService) IPLockoutCalls(stub func(context.Context, string) (internal.Lockout, error)) {
+	fake.iPLockoutMutex.Lock()
+	defer fake.iPLockoutMutex.Unlock()
+	fake.IPLockoutStub = stub
+}
+
+func (fake *FakeRBACService) IPLockoutArgsForCall(i int) (context.Context, string) {
+	fake.iPLockoutMutex.RLock()
+	defer fake.iPLockoutMutex.RUnlock()
+	argsForCall := fake.iPLockoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) IPLockoutReturns(result1 internal.Lockout, result2 error) {
+	fake.iPLockoutMutex.Lock()
+	defer fake.iPLockoutMutex.Unlock()
+	fake.IPLockoutStub = nil
+	fake.iPLockoutReturns = struct {
+		result1 internal.Lockout
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IPLockoutReturnsOnCall(i int, result1 internal.Lockout, result2 error) {
+	fake.iPLockoutMutex.Lock()
+	defer fake.iPLockoutMutex.Unlock()
+	fake.IPLockoutStub = nil
+	if fake.iPLockoutReturnsOnCall == nil {
+		fake.iPLockoutReturnsOnCall = make(map[int]struct {
+			result1 internal.Lockout
+			result2 error
+		})
+	}
+	fake.iPLockoutReturnsOnCall[i] = struct {
+		result1 internal.Lockout
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) InheritedRoles(arg1 context.Context, arg2 string) ([]internal.Roles, error) {
+	fake.inheritedRolesMutex.Lock()
+	ret, specificReturn := fake.inheritedRolesReturnsOnCall[len(fake.inheritedRolesArgsForCall)]
+	fake.inheritedRolesArgsForCall = append(fake.inheritedRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.InheritedRolesStub
+	fakeReturns := fake.inheritedRolesReturns
+	fake.recordInvocation("InheritedRoles", []interface{}{arg1, arg2})
+	fake.inheritedRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) InheritedRolesCallCount() int {
+	fake.inheritedRolesMutex.RLock()
+	defer fake.inheritedRolesMutex.RUnlock()
+	return len(fake.inheritedRolesArgsForCall)
+}
+
+func (fake *FakeRBACService) InheritedRolesCalls(stub func(context.Context, string) ([]internal.Roles, error)) {
+	fake.inheritedRolesMutex.Lock()
+	defer fake.inheritedRolesMutex.Unlock()
+	fake.InheritedRolesStub = stub
+}
+
+func (fake *FakeRBACService) InheritedRolesArgsForCall(i int) (context.Context, string) {
+	fake.inheritedRolesMutex.RLock()
+	defer fake.inheritedRolesMutex.RUnlock()
+	argsForCall := fake.inheritedRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) InheritedRolesReturns(result1 []internal.Roles, result2 error) {
+	fake.inheritedRolesMutex.Lock()
+	defer fake.inheritedRolesMutex.Unlock()
+	fake.InheritedRolesStub = nil
+	fake.inheritedRolesReturns = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) InheritedRolesReturnsOnCall(i int, result1 []internal.Roles, result2 error) {
+	fake.inheritedRolesMutex.Lock()
+	defer fake.inheritedRolesMutex.Unlock()
+	fake.InheritedRolesStub = nil
+	if fake.inheritedRolesReturnsOnCall == nil {
+		fake.inheritedRolesReturnsOnCall = make(map[int]struct {
+			result1 []internal.Roles
+			result2 error
+		})
+	}
+	fake.inheritedRolesReturnsOnCall[i] = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IsAllowed(arg1 context.Context, arg2 string, arg3 string) (bool, error) {
+	fake.isAllowedMutex.Lock()
+	ret, specificReturn := fake.isAllowedReturnsOnCall[len(fake.isAllowedArgsForCall)]
+	fake.isAllowedArgsForCall = append(fake.isAllowedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.IsAllowedStub
+	fakeReturns := fake.isAllowedReturns
+	fake.recordInvocation("IsAllowed", []interface{}{arg1, arg2, arg3})
+	fake.isAllowedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) IsAllowedCallCount() int {
+	fake.isAllowedMutex.RLock()
+	defer fake.isAllowedMutex.RUnlock()
+	return len(fake.isAllowedArgsForCall)
+}
+
+func (fake *FakeRBACService) IsAllowedCalls(stub func(context.Context, string, string) (bool, error)) {
+	fake.isAllowedMutex.Lock()
+	defer fake.isAllowedMutex.Unlock()
+	fake.IsAllowedStub = stub
+}
+
+func (fake *FakeRBACService) IsAllowedArgsForCall(i int) (context.Context, string, string) {
+	fake.isAllowedMutex.RLock()
+	defer fake.isAllowedMutex.RUnlock()
+	argsForCall := fake.isAllowedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) IsAllowedReturns(result1 bool, result2 error) {
+	fake.isAllowedMutex.Lock()
+	defer fake.isAllowedMutex.Unlock()
+	fake.IsAllowedStub = nil
+	fake.isAllowedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IsAllowedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isAllowedMutex.Lock()
+	defer fake.isAllowedMutex.Unlock()
+	fake.IsAllowedStub = nil
+	if fake.isAllowedReturnsOnCall == nil {
+		fake.isAllowedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isAllowedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IsAllowedOn(arg1 context.Context, arg2 string, arg3 string, arg4 internal.Resource) (bool, error) {
+	fake.isAllowedOnMutex.Lock()
+	ret, specificReturn := fake.isAllowedOnReturnsOnCall[len(fake.isAllowedOnArgsForCall)]
+	fake.isAllowedOnArgsForCall = append(fake.isAllowedOnArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Resource
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.IsAllowedOnStub
+	fakeReturns := fake.isAllowedOnReturns
+	fake.recordInvocation("IsAllowedOn", []interface{}{arg1, arg2, arg3, arg4})
+	fake.isAllowedOnMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) IsAllowedOnCallCount() int {
+	fake.isAllowedOnMutex.RLock()
+	defer fake.isAllowedOnMutex.RUnlock()
+	return len(fake.isAllowedOnArgsForCall)
+}
+
+func (fake *FakeRBACService) IsAllowedOnCalls(stub func(context.Context, string, string, internal.Resource) (bool, error)) {
+	fake.isAllowedOnMutex.Lock()
+	defer fake.isAllowedOnMutex.Unlock()
+	fake.IsAllowedOnStub = stub
+}
+
+func (fake *FakeRBACService) IsAllowedOnArgsForCall(i int) (context.Context, string, string, internal.Resource) {
+	fake.isAllowedOnMutex.RLock()
+	defer fake.isAllowedOnMutex.RUnlock()
+	argsForCall := fake.isAllowedOnArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACService) IsAllowedOnReturns(result1 bool, result2 error) {
+	fake.isAllowedOnMutex.Lock()
+	defer fake.isAllowedOnMutex.Unlock()
+	fake.IsAllowedOnStub = nil
+	fake.isAllowedOnReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IsAllowedOnReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isAllowedOnMutex.Lock()
+	defer fake.isAllowedOnMutex.Unlock()
+	fake.IsAllowedOnStub = nil
+	if fake.isAllowedOnReturnsOnCall == nil {
+		fake.isAllowedOnReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isAllowedOnReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IsPermissionStale(arg1 context.Context, arg2 *tokenmaker.Payload) (bool, error) {
+	fake.isPermissionStaleMutex.Lock()
+	ret, specificReturn := fake.isPermissionStaleReturnsOnCall[len(fake.isPermissionStaleArgsForCall)]
+	fake.isPermissionStaleArgsForCall = append(fake.isPermissionStaleArgsForCall, struct {
+		arg1 context.Context
+		arg2 *tokenmaker.Payload
+	}{arg1, arg2})
+	stub := fake.IsPermissionStaleStub
+	fakeReturns := fake.isPermissionStaleReturns
+	fake.recordInvocation("IsPermissionStale", []interface{}{arg1, arg2})
+	fake.isPermissionStaleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) IsPermissionStaleCallCount() int {
+	fake.isPermissionStaleMutex.RLock()
+	defer fake.isPermissionStaleMutex.RUnlock()
+	return len(fake.isPermissionStaleArgsForCall)
+}
+
+func (fake *FakeRBACService) IsPermissionStaleCalls(stub func(context.Context, *tokenmaker.Payload) (bool, error)) {
+	fake.isPermissionStaleMutex.Lock()
+	defer fake.isPermissionStaleMutex.Unlock()
+	fake.IsPermissionStaleStub = stub
+}
+
+func (fake *FakeRBACService) IsPermissionStaleArgsForCall(i int) (context.Context, *tokenmaker.Payload) {
+	fake.isPermissionStaleMutex.RLock()
+	defer fake.isPermissionStaleMutex.RUnlock()
+	argsForCall := fake.isPermissionStaleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) IsPermissionStaleReturns(result1 bool, result2 error) {
+	fake.isPermissionStaleMutex.Lock()
+	defer fake.isPermissionStaleMutex.Unlock()
+	fake.IsPermissionStaleStub = nil
+	fake.isPermissionStaleReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IsPermissionStaleReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isPermissionStaleMutex.Lock()
+	defer fake.isPermissionStaleMutex.Unlock()
+	fake.IsPermissionStaleStub = nil
+	if fake.isPermissionStaleReturnsOnCall == nil {
+		fake.isPermissionStaleReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isPermissionStaleReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IsTokenRevoked(arg1 context.Context, arg2 *tokenmaker.Payload) (bool, error) {
+	fake.isTokenRevokedMutex.Lock()
+	ret, specificReturn := fake.isTokenRevokedReturnsOnCall[len(fake.isTokenRevokedArgsForCall)]
+	fake.isTokenRevokedArgsForCall = append(fake.isTokenRevokedArgsForCall, struct {
+		arg1 context.Context
+		arg2 *tokenmaker.Payload
+	}{arg1, arg2})
+	stub := fake.IsTokenRevokedStub
+	fakeReturns := fake.isTokenRevokedReturns
+	fake.recordInvocation("IsTokenRevoked", []interface{}{arg1, arg2})
+	fake.isTokenRevokedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) IsTokenRevokedCallCount() int {
+	fake.isTokenRevokedMutex.RLock()
+	defer fake.isTokenRevokedMutex.RUnlock()
+	return len(fake.isTokenRevokedArgsForCall)
+}
+
+func (fake *FakeRBACService) IsTokenRevokedCalls(stub func(context.Context, *tokenmaker.Payload) (bool, error)) {
+	fake.isTokenRevokedMutex.Lock()
+	defer fake.isTokenRevokedMutex.Unlock()
+	fake.IsTokenRevokedStub = stub
+}
+
+func (fake *FakeRBACService) IsTokenRevokedArgsForCall(i int) (context.Context, *tokenmaker.Payload) {
+	fake.isTokenRevokedMutex.RLock()
+	defer fake.isTokenRevokedMutex.RUnlock()
+	argsForCall := fake.isTokenRevokedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) IsTokenRevokedReturns(result1 bool, result2 error) {
+	fake.isTokenRevokedMutex.Lock()
+	defer fake.isTokenRevokedMutex.Unlock()
+	fake.IsTokenRevokedStub = nil
+	fake.isTokenRevokedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) IsTokenRevokedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isTokenRevokedMutex.Lock()
+	defer fake.isTokenRevokedMutex.Unlock()
+	fake.IsTokenRevokedStub = nil
+	if fake.isTokenRevokedReturnsOnCall == nil {
+		fake.isTokenRevokedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isTokenRevokedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ListAccount(arg1 context.Context, arg2 internal.ListArgs) (internal.ListAccount, error) {
+	fake.listAccountMutex.Lock()
+	ret, specificReturn := fake.listAccountReturnsOnCall[len(fake.listAccountArgsForCall)]
+	fake.listAccountArgsForCall = append(fake.listAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListAccountStub
+	fakeReturns := fake.listAccountReturns
+	fake.recordInvocation("ListAccount", []interface{}{arg1, arg2})
+	fake.listAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACService) ListAccountCallCount() int {
+	fake.listAccountMutex.RLock()
+	defer fake.listAccountMutex.RUnlock()
+	return len(fake.listAccountArgsForCall)
+}
+
+func (fake *FakeRBACService) ListAccountCalls(stub func(context.Context, internal.ListArgs) (internal.ListAccount, error)) {
+	fake.listAccountMutex.Lock()
+	defer fake.listAccountMutex.Unlock()
+	fake.ListAccountStub = stub
+}
+
+func (fake *FakeRBACService) ListAccountArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listAccountMutex.RLock()
+	defer fake.listAccountMutex.RUnlock()
+	argsForCall := fake.listAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) ListAccountReturns(result1 internal.ListAccount, result2 error) {
+	fake.listAccountMutex.Lock()
+	defer fake.listAccountMutex.Unlock()
+	fake.ListAccountStub = nil
+	fake.listAccountReturns = struct {
+		result1 internal.ListAccount
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) AccountByIDReturnsOnCall(i int, result1 internal.Account, result2 error) {
-	fake.accountByIDMutex.Lock()
-	defer fake.accountByIDMutex.Unlock()
-	fake.AccountByIDStub = nil
-	if fake.accountByIDReturnsOnCall == nil {
-		fake.accountByIDReturnsOnCall = make(map[int]struct {
-			result1 internal.Account
+func (fake *FakeRBACService) ListAccountReturnsOnCall(i int, result1 internal.ListAccount, result2 error) {
+	fake.listAccountMutex.Lock()
+	defer fake.listAccountMutex.Unlock()
+	fake.ListAccountStub = nil
+	if fake.listAccountReturnsOnCall == nil {
+		fake.listAccountReturnsOnCall = make(map[int]struct {
+			result1 internal.ListAccount
 			result2 error
 		})
 	}
-	fake.accountByIDReturnsOnCall[i] = struct {
-		result1 internal.Account
+	fake.listAccountReturnsOnCall[i] = struct {
+		result1 internal.ListAccount
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) AccountRole(arg1 context.Context, arg2 string) (internal.AccountRoles, error) {
-	fake.accountRoleMutex.Lock()
-	ret, specificReturn := fake.accountRoleReturnsOnCall[len(fake.accountRoleArgsForCall)]
-	fake.accountRoleArgsForCall = append(fake.accountRoleArgsForCall, struct {
+func (fake *FakeRBACService) ListAccountRole(arg1 context.Context, arg2 internal.ListArgs) (internal.ListAccountRole, error) {
+	fake.listAccountRoleMutex.Lock()
+	ret, specificReturn := fake.listAccountRoleReturnsOnCall[len(fake.listAccountRoleArgsForCall)]
+	fake.listAccountRoleArgsForCall = append(fake.listAccountRoleArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
+		arg2 internal.ListArgs
 	}{arg1, arg2})
-	stub := fake.AccountRoleStub
-	fakeReturns := fake.accountRoleReturns
-	fake.recordInvocation("AccountRole", []interface{}{arg1, arg2})
-	fake.accountRoleMutex.Unlock()
+	stub := fake.ListAccountRoleStub
+	fakeReturns := fake.listAccountRoleReturns
+	fake.recordInvocation("ListAccountRole", []interface{}{arg1, arg2})
+	fake.listAccountRoleMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -817,62 +6853,62 @@ func (fake *FakeRBACService) AccountRole(arg1 context.Context, arg2 string) (int
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) AccountRoleCallCount() int {
-	fake.accountRoleMutex.RLock()
-	defer fake.accountRoleMutex.RUnlock()
-	return len(fake.accountRoleArgsForCall)
+func (fake *FakeRBACService) ListAccountRoleCallCount() int {
+	fake.listAccountRoleMutex.RLock()
+	defer fake.listAccountRoleMutex.RUnlock()
+	return len(fake.listAccountRoleArgsForCall)
 }
 
-func (fake *FakeRBACService) AccountRoleCalls(stub func(context.Context, string) (internal.AccountRoles, error)) {
-	fake.accountRoleMutex.Lock()
-	defer fake.accountRoleMutex.Unlock()
-	fake.AccountRoleStub = stub
+func (fake *FakeRBACService) ListAccountRoleCalls(stub func(context.Context, internal.ListArgs) (internal.ListAccountRole, error)) {
+	fake.listAccountRoleMutex.Lock()
+	defer fake.listAccountRoleMutex.Unlock()
+	fake.ListAccountRoleStub = stub
 }
 
-func (fake *FakeRBACService) AccountRoleArgsForCall(i int) (context.Context, string) {
-	fake.accountRoleMutex.RLock()
-	defer fake.accountRoleMutex.RUnlock()
-	argsForCall := fake.accountRoleArgsForCall[i]
+func (fake *FakeRBACService) ListAccountRoleArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listAccountRoleMutex.RLock()
+	defer fake.listAccountRoleMutex.RUnlock()
+	argsForCall := fake.listAccountRoleArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) AccountRoleReturns(result1 internal.AccountRoles, result2 error) {
-	fake.accountRoleMutex.Lock()
-	defer fake.accountRoleMutex.Unlock()
-	fake.AccountRoleStub = nil
-	fake.accountRoleReturns = struct {
-		result1 internal.AccountRoles
+func (fake *FakeRBACService) ListAccountRoleReturns(result1 internal.ListAccountRole, result2 error) {
+	fake.listAccountRoleMutex.Lock()
+	defer fake.listAccountRoleMutex.Unlock()
+	fake.ListAccountRoleStub = nil
+	fake.listAccountRoleReturns = struct {
+		result1 internal.ListAccountRole
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) AccountRoleReturnsOnCall(i int, result1 internal.AccountRoles, result2 error) {
-	fake.accountRoleMutex.Lock()
-	defer fake.accountRoleMutex.Unlock()
-	fake.AccountRoleStub = nil
-	if fake.accountRoleReturnsOnCall == nil {
-		fake.accountRoleReturnsOnCall = make(map[int]struct {
-			result1 internal.AccountRoles
+func (fake *FakeRBACService) ListAccountRoleReturnsOnCall(i int, result1 internal.ListAccountRole, result2 error) {
+	fake.listAccountRoleMutex.Lock()
+	defer fake.listAccountRoleMutex.Unlock()
+	fake.ListAccountRoleStub = nil
+	if fake.listAccountRoleReturnsOnCall == nil {
+		fake.listAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.ListAccountRole
 			result2 error
 		})
 	}
-	fake.accountRoleReturnsOnCall[i] = struct {
-		result1 internal.AccountRoles
+	fake.listAccountRoleReturnsOnCall[i] = struct {
+		result1 internal.ListAccountRole
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) AccountRoleByAccount(arg1 context.Context, arg2 string) (internal.AccountRoleByAccountResult, error) {
-	fake.accountRoleByAccountMutex.Lock()
-	ret, specificReturn := fake.accountRoleByAccountReturnsOnCall[len(fake.accountRoleByAccountArgsForCall)]
-	fake.accountRoleByAccountArgsForCall = append(fake.accountRoleByAccountArgsForCall, struct {
+func (fake *FakeRBACService) ListHelpText(arg1 context.Context, arg2 internal.ListArgs) (internal.ListHelpText, error) {
+	fake.listHelpTextMutex.Lock()
+	ret, specificReturn := fake.listHelpTextReturnsOnCall[len(fake.listHelpTextArgsForCall)]
+	fake.listHelpTextArgsForCall = append(fake.listHelpTextArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
+		arg2 internal.ListArgs
 	}{arg1, arg2})
-	stub := fake.AccountRoleByAccountStub
-	fakeReturns := fake.accountRoleByAccountReturns
-	fake.recordInvocation("AccountRoleByAccount", []interface{}{arg1, arg2})
-	fake.accountRoleByAccountMutex.Unlock()
+	stub := fake.ListHelpTextStub
+	fakeReturns := fake.listHelpTextReturns
+	fake.recordInvocation("ListHelpText", []interface{}{arg1, arg2})
+	fake.listHelpTextMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -882,62 +6918,62 @@ func (fake *FakeRBACService) AccountRoleByAccount(arg1 context.Context, arg2 str
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) AccountRoleByAccountCallCount() int {
-	fake.accountRoleByAccountMutex.RLock()
-	defer fake.accountRoleByAccountMutex.RUnlock()
-	return len(fake.accountRoleByAccountArgsForCall)
+func (fake *FakeRBACService) ListHelpTextCallCount() int {
+	fake.listHelpTextMutex.RLock()
+	defer fake.listHelpTextMutex.RUnlock()
+	return len(fake.listHelpTextArgsForCall)
 }
 
-func (fake *FakeRBACService) AccountRoleByAccountCalls(stub func(context.Context, string) (internal.AccountRoleByAccountResult, error)) {
-	fake.accountRoleByAccountMutex.Lock()
-	defer fake.accountRoleByAccountMutex.Unlock()
-	fake.AccountRoleByAccountStub = stub
+func (fake *FakeRBACService) ListHelpTextCalls(stub func(context.Context, internal.ListArgs) (internal.ListHelpText, error)) {
+	fake.listHelpTextMutex.Lock()
+	defer fake.listHelpTextMutex.Unlock()
+	fake.ListHelpTextStub = stub
 }
 
-func (fake *FakeRBACService) AccountRoleByAccountArgsForCall(i int) (context.Context, string) {
-	fake.accountRoleByAccountMutex.RLock()
-	defer fake.accountRoleByAccountMutex.RUnlock()
-	argsForCall := fake.accountRoleByAccountArgsForCall[i]
+func (fake *FakeRBACService) ListHelpTextArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listHelpTextMutex.RLock()
+	defer fake.listHelpTextMutex.RUnlock()
+	argsForCall := fake.listHelpTextArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) AccountRoleByAccountReturns(result1 internal.AccountRoleByAccountResult, result2 error) {
-	fake.accountRoleByAccountMutex.Lock()
-	defer fake.accountRoleByAccountMutex.Unlock()
-	fake.AccountRoleByAccountStub = nil
-	fake.accountRoleByAccountReturns = struct {
-		result1 internal.AccountRoleByAccountResult
+func (fake *FakeRBACService) ListHelpTextReturns(result1 internal.ListHelpText, result2 error) {
+	fake.listHelpTextMutex.Lock()
+	defer fake.listHelpTextMutex.Unlock()
+	fake.ListHelpTextStub = nil
+	fake.listHelpTextReturns = struct {
+		result1 internal.ListHelpText
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) AccountRoleByAccountReturnsOnCall(i int, result1 internal.AccountRoleByAccountResult, result2 error) {
-	fake.accountRoleByAccountMutex.Lock()
-	defer fake.accountRoleByAccountMutex.Unlock()
-	fake.AccountRoleByAccountStub = nil
-	if fake.accountRoleByAccountReturnsOnCall == nil {
-		fake.accountRoleByAccountReturnsOnCall = make(map[int]struct {
-			result1 internal.AccountRoleByAccountResult
+func (fake *FakeRBACService) ListHelpTextReturnsOnCall(i int, result1 internal.ListHelpText, result2 error) {
+	fake.listHelpTextMutex.Lock()
+	defer fake.listHelpTextMutex.Unlock()
+	fake.ListHelpTextStub = nil
+	if fake.listHelpTextReturnsOnCall == nil {
+		fake.listHelpTextReturnsOnCall = make(map[int]struct {
+			result1 internal.ListHelpText
 			result2 error
 		})
 	}
-	fake.accountRoleByAccountReturnsOnCall[i] = struct {
-		result1 internal.AccountRoleByAccountResult
+	fake.listHelpTextReturnsOnCall[i] = struct {
+		result1 internal.ListHelpText
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) AccountRoleByRole(arg1 context.Context, arg2 string) (internal.AccountRoleByRoleResult, error) {
-	fake.accountRoleByRoleMutex.Lock()
-	ret, specificReturn := fake.accountRoleByRoleReturnsOnCall[len(fake.accountRoleByRoleArgsForCall)]
-	fake.accountRoleByRoleArgsForCall = append(fake.accountRoleByRoleArgsForCall, struct {
+func (fake *FakeRBACService) ListMenu(arg1 context.Context, arg2 internal.ListArgs) (internal.ListMenu, error) {
+	fake.listMenuMutex.Lock()
+	ret, specificReturn := fake.listMenuReturnsOnCall[len(fake.listMenuArgsForCall)]
+	fake.listMenuArgsForCall = append(fake.listMenuArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
+		arg2 internal.ListArgs
 	}{arg1, arg2})
-	stub := fake.AccountRoleByRoleStub
-	fakeReturns := fake.accountRoleByRoleReturns
-	fake.recordInvocation("AccountRoleByRole", []interface{}{arg1, arg2})
-	fake.accountRoleByRoleMutex.Unlock()
+	stub := fake.ListMenuStub
+	fakeReturns := fake.listMenuReturns
+	fake.recordInvocation("ListMenu", []interface{}{arg1, arg2})
+	fake.listMenuMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -947,128 +6983,129 @@ func (fake *FakeRBACService) AccountRoleByRole(arg1 context.Context, arg2 string
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) AccountRoleByRoleCallCount() int {
-	fake.accountRoleByRoleMutex.RLock()
-	defer fake.accountRoleByRoleMutex.RUnlock()
-	return len(fake.accountRoleByRoleArgsForCall)
+func (fake *FakeRBACService) ListMenuCallCount() int {
+	fake.listMenuMutex.RLock()
+	defer fake.listMenuMutex.RUnlock()
+	return len(fake.listMenuArgsForCall)
 }
 
-func (fake *FakeRBACService) AccountRoleByRoleCalls(stub func(context.Context, string) (internal.AccountRoleByRoleResult, error)) {
-	fake.accountRoleByRoleMutex.Lock()
-	defer fake.accountRoleByRoleMutex.Unlock()
-	fake.AccountRoleByRoleStub = stub
+func (fake *FakeRBACService) ListMenuCalls(stub func(context.Context, internal.ListArgs) (internal.ListMenu, error)) {
+	fake.listMenuMutex.Lock()
+	defer fake.listMenuMutex.Unlock()
+	fake.ListMenuStub = stub
 }
 
-func (fake *FakeRBACService) AccountRoleByRoleArgsForCall(i int) (context.Context, string) {
-	fake.accountRoleByRoleMutex.RLock()
-	defer fake.accountRoleByRoleMutex.RUnlock()
-	argsForCall := fake.accountRoleByRoleArgsForCall[i]
+func (fake *FakeRBACService) ListMenuArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listMenuMutex.RLock()
+	defer fake.listMenuMutex.RUnlock()
+	argsForCall := fake.listMenuArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) AccountRoleByRoleReturns(result1 internal.AccountRoleByRoleResult, result2 error) {
-	fake.accountRoleByRoleMutex.Lock()
-	defer fake.accountRoleByRoleMutex.Unlock()
-	fake.AccountRoleByRoleStub = nil
-	fake.accountRoleByRoleReturns = struct {
-		result1 internal.AccountRoleByRoleResult
+func (fake *FakeRBACService) ListMenuReturns(result1 internal.ListMenu, result2 error) {
+	fake.listMenuMutex.Lock()
+	defer fake.listMenuMutex.Unlock()
+	fake.ListMenuStub = nil
+	fake.listMenuReturns = struct {
+		result1 internal.ListMenu
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) AccountRoleByRoleReturnsOnCall(i int, result1 internal.AccountRoleByRoleResult, result2 error) {
-	fake.accountRoleByRoleMutex.Lock()
-	defer fake.accountRoleByRoleMutex.Unlock()
-	fake.AccountRoleByRoleStub = nil
-	if fake.accountRoleByRoleReturnsOnCall == nil {
-		fake.accountRoleByRoleReturnsOnCall = make(map[int]struct {
-			result1 internal.AccountRoleByRoleResult
+func (fake *FakeRBACService) ListMenuReturnsOnCall(i int, result1 internal.ListMenu, result2 error) {
+	fake.listMenuMutex.Lock()
+	defer fake.listMenuMutex.Unlock()
+	fake.ListMenuStub = nil
+	if fake.listMenuReturnsOnCall == nil {
+		fake.listMenuReturnsOnCall = make(map[int]struct {
+			result1 internal.ListMenu
 			result2 error
 		})
 	}
-	fake.accountRoleByRoleReturnsOnCall[i] = struct {
-		result1 internal.AccountRoleByRoleResult
+	fake.listMenuReturnsOnCall[i] = struct {
+		result1 internal.ListMenu
 		result2 error
 	}{result1, result2}
-}
-
-func (fake *FakeRBACService) ChangePassword(arg1 context.Context, arg2 string, arg3 string) error {
-	fake.changePasswordMutex.Lock()
-	ret, specificReturn := fake.changePasswordReturnsOnCall[len(fake.changePasswordArgsForCall)]
-	fake.changePasswordArgsForCall = append(fake.changePasswordArgsForCall, struct {
+}
+
+func (fake *FakeRBACService) ListNavigation(arg1 context.Context, arg2 internal.ListArgs) (internal.ListNavigation, error) {
+	fake.listNavigationMutex.Lock()
+	ret, specificReturn := fake.listNavigationReturnsOnCall[len(fake.listNavigationArgsForCall)]
+	fake.listNavigationArgsForCall = append(fake.listNavigationArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
-		arg3 string
-	}{arg1, arg2, arg3})
-	stub := fake.ChangePasswordStub
-	fakeReturns := fake.changePasswordReturns
-	fake.recordInvocation("ChangePassword", []interface{}{arg1, arg2, arg3})
-	fake.changePasswordMutex.Unlock()
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListNavigationStub
+	fakeReturns := fake.listNavigationReturns
+	fake.recordInvocation("ListNavigation", []interface{}{arg1, arg2})
+	fake.listNavigationMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) ChangePasswordCallCount() int {
-	fake.changePasswordMutex.RLock()
-	defer fake.changePasswordMutex.RUnlock()
-	return len(fake.changePasswordArgsForCall)
+func (fake *FakeRBACService) ListNavigationCallCount() int {
+	fake.listNavigationMutex.RLock()
+	defer fake.listNavigationMutex.RUnlock()
+	return len(fake.listNavigationArgsForCall)
 }
 
-func (fake *FakeRBACService) ChangePasswordCalls(stub func(context.Context, string, string) error) {
-	fake.changePasswordMutex.Lock()
-	defer fake.changePasswordMutex.Unlock()
-	fake.ChangePasswordStub = stub
+func (fake *FakeRBACService) ListNavigationCalls(stub func(context.Context, internal.ListArgs) (internal.ListNavigation, error)) {
+	fake.listNavigationMutex.Lock()
+	defer fake.listNavigationMutex.Unlock()
+	fake.ListNavigationStub = stub
 }
 
-func (fake *FakeRBACService) ChangePasswordArgsForCall(i int) (context.Context, string, string) {
-	fake.changePasswordMutex.RLock()
-	defer fake.changePasswordMutex.RUnlock()
-	argsForCall := fake.changePasswordArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeRBACService) ListNavigationArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listNavigationMutex.RLock()
+	defer fake.listNavigationMutex.RUnlock()
+	argsForCall := fake.listNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) ChangePasswordReturns(result1 error) {
-	fake.changePasswordMutex.Lock()
-	defer fake.changePasswordMutex.Unlock()
-	fake.ChangePasswordStub = nil
-	fake.changePasswordReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeRBACService) ListNavigationReturns(result1 internal.ListNavigation, result2 error) {
+	fake.listNavigationMutex.Lock()
+	defer fake.listNavigationMutex.Unlock()
+	fake.ListNavigationStub = nil
+	fake.listNavigationReturns = struct {
+		result1 internal.ListNavigation
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ChangePasswordReturnsOnCall(i int, result1 error) {
-	fake.changePasswordMutex.Lock()
-	defer fake.changePasswordMutex.Unlock()
-	fake.ChangePasswordStub = nil
-	if fake.changePasswordReturnsOnCall == nil {
-		fake.changePasswordReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeRBACService) ListNavigationReturnsOnCall(i int, result1 internal.ListNavigation, result2 error) {
+	fake.listNavigationMutex.Lock()
+	defer fake.listNavigationMutex.Unlock()
+	fake.ListNavigationStub = nil
+	if fake.listNavigationReturnsOnCall == nil {
+		fake.listNavigationReturnsOnCall = make(map[int]struct {
+			result1 internal.ListNavigation
+			result2 error
 		})
 	}
-	fake.changePasswordReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.listNavigationReturnsOnCall[i] = struct {
+		result1 internal.ListNavigation
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateAccount(arg1 context.Context, arg2 internal.Account, arg3 string) (string, error) {
-	fake.createAccountMutex.Lock()
-	ret, specificReturn := fake.createAccountReturnsOnCall[len(fake.createAccountArgsForCall)]
-	fake.createAccountArgsForCall = append(fake.createAccountArgsForCall, struct {
+func (fake *FakeRBACService) ListRole(arg1 context.Context, arg2 internal.ListArgs) (internal.ListRole, error) {
+	fake.listRoleMutex.Lock()
+	ret, specificReturn := fake.listRoleReturnsOnCall[len(fake.listRoleArgsForCall)]
+	fake.listRoleArgsForCall = append(fake.listRoleArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.Account
-		arg3 string
-	}{arg1, arg2, arg3})
-	stub := fake.CreateAccountStub
-	fakeReturns := fake.createAccountReturns
-	fake.recordInvocation("CreateAccount", []interface{}{arg1, arg2, arg3})
-	fake.createAccountMutex.Unlock()
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListRoleStub
+	fakeReturns := fake.listRoleReturns
+	fake.recordInvocation("ListRole", []interface{}{arg1, arg2})
+	fake.listRoleMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1076,188 +7113,196 @@ func (fake *FakeRBACService) CreateAccount(arg1 context.Context, arg2 internal.A
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) CreateAccountCallCount() int {
-	fake.createAccountMutex.RLock()
-	defer fake.createAccountMutex.RUnlock()
-	return len(fake.createAccountArgsForCall)
+func (fake *FakeRBACService) ListRoleCallCount() int {
+	fake.listRoleMutex.RLock()
+	defer fake.listRoleMutex.RUnlock()
+	return len(fake.listRoleArgsForCall)
 }
 
-func (fake *FakeRBACService) CreateAccountCalls(stub func(context.Context, internal.Account, string) (string, error)) {
-	fake.createAccountMutex.Lock()
-	defer fake.createAccountMutex.Unlock()
-	fake.CreateAccountStub = stub
+func (fake *FakeRBACService) ListRoleCalls(stub func(context.Context, internal.ListArgs) (internal.ListRole, error)) {
+	fake.listRoleMutex.Lock()
+	defer fake.listRoleMutex.Unlock()
+	fake.ListRoleStub = stub
 }
 
-func (fake *FakeRBACService) CreateAccountArgsForCall(i int) (context.Context, internal.Account, string) {
-	fake.createAccountMutex.RLock()
-	defer fake.createAccountMutex.RUnlock()
-	argsForCall := fake.createAccountArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeRBACService) ListRoleArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listRoleMutex.RLock()
+	defer fake.listRoleMutex.RUnlock()
+	argsForCall := fake.listRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) CreateAccountReturns(result1 string, result2 error) {
-	fake.createAccountMutex.Lock()
-	defer fake.createAccountMutex.Unlock()
-	fake.CreateAccountStub = nil
-	fake.createAccountReturns = struct {
-		result1 string
+func (fake *FakeRBACService) ListRoleReturns(result1 internal.ListRole, result2 error) {
+	fake.listRoleMutex.Lock()
+	defer fake.listRoleMutex.Unlock()
+	fake.ListRoleStub = nil
+	fake.listRoleReturns = struct {
+		result1 internal.ListRole
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateAccountReturnsOnCall(i int, result1 string, result2 error) {
-	fake.createAccountMutex.Lock()
-	defer fake.createAccountMutex.Unlock()
-	fake.CreateAccountStub = nil
-	if fake.createAccountReturnsOnCall == nil {
-		fake.createAccountReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeRBACService) ListRoleReturnsOnCall(i int, result1 internal.ListRole, result2 error) {
+	fake.listRoleMutex.Lock()
+	defer fake.listRoleMutex.Unlock()
+	fake.ListRoleStub = nil
+	if fake.listRoleReturnsOnCall == nil {
+		fake.listRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.ListRole
 			result2 error
 		})
 	}
-	fake.createAccountReturnsOnCall[i] = struct {
-		result1 string
+	fake.listRoleReturnsOnCall[i] = struct {
+		result1 internal.ListRole
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateAccountRole(arg1 context.Context, arg2 internal.AccountRoles) error {
-	fake.createAccountRoleMutex.Lock()
-	ret, specificReturn := fake.createAccountRoleReturnsOnCall[len(fake.createAccountRoleArgsForCall)]
-	fake.createAccountRoleArgsForCall = append(fake.createAccountRoleArgsForCall, struct {
+func (fake *FakeRBACService) ListRoleTask(arg1 context.Context, arg2 internal.ListArgs) (internal.ListRoleTask, error) {
+	fake.listRoleTaskMutex.Lock()
+	ret, specificReturn := fake.listRoleTaskReturnsOnCall[len(fake.listRoleTaskArgsForCall)]
+	fake.listRoleTaskArgsForCall = append(fake.listRoleTaskArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.AccountRoles
+		arg2 internal.ListArgs
 	}{arg1, arg2})
-	stub := fake.CreateAccountRoleStub
-	fakeReturns := fake.createAccountRoleReturns
-	fake.recordInvocation("CreateAccountRole", []interface{}{arg1, arg2})
-	fake.createAccountRoleMutex.Unlock()
+	stub := fake.ListRoleTaskStub
+	fakeReturns := fake.listRoleTaskReturns
+	fake.recordInvocation("ListRoleTask", []interface{}{arg1, arg2})
+	fake.listRoleTaskMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) CreateAccountRoleCallCount() int {
-	fake.createAccountRoleMutex.RLock()
-	defer fake.createAccountRoleMutex.RUnlock()
-	return len(fake.createAccountRoleArgsForCall)
+func (fake *FakeRBACService) ListRoleTaskCallCount() int {
+	fake.listRoleTaskMutex.RLock()
+	defer fake.listRoleTaskMutex.RUnlock()
+	return len(fake.listRoleTaskArgsForCall)
 }
 
-func (fake *FakeRBACService) CreateAccountRoleCalls(stub func(context.Context, internal.AccountRoles) error) {
-	fake.createAccountRoleMutex.Lock()
-	defer fake.createAccountRoleMutex.Unlock()
-	fake.CreateAccountRoleStub = stub
+func (fake *FakeRBACService) ListRoleTaskCalls(stub func(context.Context, internal.ListArgs) (internal.ListRoleTask, error)) {
+	fake.listRoleTaskMutex.Lock()
+	defer fake.listRoleTaskMutex.Unlock()
+	fake.ListRoleTaskStub = stub
 }
 
-func (fake *FakeRBACService) CreateAccountRoleArgsForCall(i int) (context.Context, internal.AccountRoles) {
-	fake.createAccountRoleMutex.RLock()
-	defer fake.createAccountRoleMutex.RUnlock()
-	argsForCall := fake.createAccountRoleArgsForCall[i]
+func (fake *FakeRBACService) ListRoleTaskArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listRoleTaskMutex.RLock()
+	defer fake.listRoleTaskMutex.RUnlock()
+	argsForCall := fake.listRoleTaskArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) CreateAccountRoleReturns(result1 error) {
-	fake.createAccountRoleMutex.Lock()
-	defer fake.createAccountRoleMutex.Unlock()
-	fake.CreateAccountRoleStub = nil
-	fake.createAccountRoleReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeRBACService) ListRoleTaskReturns(result1 internal.ListRoleTask, result2 error) {
+	fake.listRoleTaskMutex.Lock()
+	defer fake.listRoleTaskMutex.Unlock()
+	fake.ListRoleTaskStub = nil
+	fake.listRoleTaskReturns = struct {
+		result1 internal.ListRoleTask
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateAccountRoleReturnsOnCall(i int, result1 error) {
-	fake.createAccountRoleMutex.Lock()
-	defer fake.createAccountRoleMutex.Unlock()
-	fake.CreateAccountRoleStub = nil
-	if fake.createAccountRoleReturnsOnCall == nil {
-		fake.createAccountRoleReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeRBACService) ListRoleTaskReturnsOnCall(i int, result1 internal.ListRoleTask, result2 error) {
+	fake.listRoleTaskMutex.Lock()
+	defer fake.listRoleTaskMutex.Unlock()
+	fake.ListRoleTaskStub = nil
+	if fake.listRoleTaskReturnsOnCall == nil {
+		fake.listRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.ListRoleTask
+			result2 error
 		})
 	}
-	fake.createAccountRoleReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeRBACService) CreateHelpText(arg1 context.Context, arg2 internal.HelpText) error {
-	fake.createHelpTextMutex.Lock()
-	ret, specificReturn := fake.createHelpTextReturnsOnCall[len(fake.createHelpTextArgsForCall)]
-	fake.createHelpTextArgsForCall = append(fake.createHelpTextArgsForCall, struct {
+	fake.listRoleTaskReturnsOnCall[i] = struct {
+		result1 internal.ListRoleTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACService) ListTask(arg1 context.Context, arg2 internal.ListArgs) (internal.ListTask, error) {
+	fake.listTaskMutex.Lock()
+	ret, specificReturn := fake.listTaskReturnsOnCall[len(fake.listTaskArgsForCall)]
+	fake.listTaskArgsForCall = append(fake.listTaskArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.HelpText
+		arg2 internal.ListArgs
 	}{arg1, arg2})
-	stub := fake.CreateHelpTextStub
-	fakeReturns := fake.createHelpTextReturns
-	fake.recordInvocation("CreateHelpText", []interface{}{arg1, arg2})
-	fake.createHelpTextMutex.Unlock()
+	stub := fake.ListTaskStub
+	fakeReturns := fake.listTaskReturns
+	fake.recordInvocation("ListTask", []interface{}{arg1, arg2})
+	fake.listTaskMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) CreateHelpTextCallCount() int {
-	fake.createHelpTextMutex.RLock()
-	defer fake.createHelpTextMutex.RUnlock()
-	return len(fake.createHelpTextArgsForCall)
+func (fake *FakeRBACService) ListTaskCallCount() int {
+	fake.listTaskMutex.RLock()
+	defer fake.listTaskMutex.RUnlock()
+	return len(fake.listTaskArgsForCall)
 }
 
-func (fake *FakeRBACService) CreateHelpTextCalls(stub func(context.Context, internal.HelpText) error) {
-	fake.createHelpTextMutex.Lock()
-	defer fake.createHelpTextMutex.Unlock()
-	fake.CreateHelpTextStub = stub
+func (fake *FakeRBACService) ListTaskCalls(stub func(context.Context, internal.ListArgs) (internal.ListTask, error)) {
+	fake.listTaskMutex.Lock()
+	defer fake.listTaskMutex.Unlock()
+	fake.ListTaskStub = stub
 }
 
-func (fake *FakeRBACService) CreateHelpTextArgsForCall(i int) (context.Context, internal.HelpText) {
-	fake.createHelpTextMutex.RLock()
-	defer fake.createHelpTextMutex.RUnlock()
-	argsForCall := fake.createHelpTextArgsForCall[i]
+func (fake *FakeRBACService) ListTaskArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listTaskMutex.RLock()
+	defer fake.listTaskMutex.RUnlock()
+	argsForCall := fake.listTaskArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) CreateHelpTextReturns(result1 error) {
-	fake.createHelpTextMutex.Lock()
-	defer fake.createHelpTextMutex.Unlock()
-	fake.CreateHelpTextStub = nil
-	fake.createHelpTextReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeRBACService) ListTaskReturns(result1 internal.ListTask, result2 error) {
+	fake.listTaskMutex.Lock()
+	defer fake.listTaskMutex.Unlock()
+	fake.ListTaskStub = nil
+	fake.listTaskReturns = struct {
+		result1 internal.ListTask
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateHelpTextReturnsOnCall(i int, result1 error) {
-	fake.createHelpTextMutex.Lock()
-	defer fake.createHelpTextMutex.Unlock()
-	fake.CreateHelpTextStub = nil
-	if fake.createHelpTextReturnsOnCall == nil {
-		fake.createHelpTextReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeRBACService) ListTaskReturnsOnCall(i int, result1 internal.ListTask, result2 error) {
+	fake.listTaskMutex.Lock()
+	defer fake.listTaskMutex.Unlock()
+	fake.ListTaskStub = nil
+	if fake.listTaskReturnsOnCall == nil {
+		fake.listTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.ListTask
+			result2 error
 		})
 	}
-	fake.createHelpTextReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.listTaskReturnsOnCall[i] = struct {
+		result1 internal.ListTask
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateMenu(arg1 context.Context, arg2 internal.Menu) error {
-	fake.createMenuMutex.Lock()
-	ret, specificReturn := fake.createMenuReturnsOnCall[len(fake.createMenuArgsForCall)]
-	fake.createMenuArgsForCall = append(fake.createMenuArgsForCall, struct {
+func (fake *FakeRBACService) Login(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.loginMutex.Lock()
+	ret, specificReturn := fake.loginReturnsOnCall[len(fake.loginArgsForCall)]
+	fake.loginArgsForCall = append(fake.loginArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.Menu
-	}{arg1, arg2})
-	stub := fake.CreateMenuStub
-	fakeReturns := fake.createMenuReturns
-	fake.recordInvocation("CreateMenu", []interface{}{arg1, arg2})
-	fake.createMenuMutex.Unlock()
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.LoginStub
+	fakeReturns := fake.loginReturns
+	fake.recordInvocation("Login", []interface{}{arg1, arg2, arg3, arg4})
+	fake.loginMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1265,61 +7310,62 @@ func (fake *FakeRBACService) CreateMenu(arg1 context.Context, arg2 internal.Menu
 	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) CreateMenuCallCount() int {
-	fake.createMenuMutex.RLock()
-	defer fake.createMenuMutex.RUnlock()
-	return len(fake.createMenuArgsForCall)
+func (fake *FakeRBACService) LoginCallCount() int {
+	fake.loginMutex.RLock()
+	defer fake.loginMutex.RUnlock()
+	return len(fake.loginArgsForCall)
 }
 
-func (fake *FakeRBACService) CreateMenuCalls(stub func(context.Context, internal.Menu) error) {
-	fake.createMenuMutex.Lock()
-	defer fake.createMenuMutex.Unlock()
-	fake.CreateMenuStub = stub
+func (fake *FakeRBACService) LoginCalls(stub func(context.Context, string, string, string) error) {
+	fake.loginMutex.Lock()
+	defer fake.loginMutex.Unlock()
+	fake.LoginStub = stub
 }
 
-func (fake *FakeRBACService) CreateMenuArgsForCall(i int) (context.Context, internal.Menu) {
-	fake.createMenuMutex.RLock()
-	defer fake.createMenuMutex.RUnlock()
-	argsForCall := fake.createMenuArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) LoginArgsForCall(i int) (context.Context, string, string, string) {
+	fake.loginMutex.RLock()
+	defer fake.loginMutex.RUnlock()
+	argsForCall := fake.loginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeRBACService) CreateMenuReturns(result1 error) {
-	fake.createMenuMutex.Lock()
-	defer fake.createMenuMutex.Unlock()
-	fake.CreateMenuStub = nil
-	fake.createMenuReturns = struct {
+func (fake *FakeRBACService) LoginReturns(result1 error) {
+	fake.loginMutex.Lock()
+	defer fake.loginMutex.Unlock()
+	fake.LoginStub = nil
+	fake.loginReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) CreateMenuReturnsOnCall(i int, result1 error) {
-	fake.createMenuMutex.Lock()
-	defer fake.createMenuMutex.Unlock()
-	fake.CreateMenuStub = nil
-	if fake.createMenuReturnsOnCall == nil {
-		fake.createMenuReturnsOnCall = make(map[int]struct {
+func (fake *FakeRBACService) LoginReturnsOnCall(i int, result1 error) {
+	fake.loginMutex.Lock()
+	defer fake.loginMutex.Unlock()
+	fake.LoginStub = nil
+	if fake.loginReturnsOnCall == nil {
+		fake.loginReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.createMenuReturnsOnCall[i] = struct {
+	fake.loginReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) CreateNavigation(arg1 context.Context, arg2 internal.Navigation) error {
-	fake.createNavigationMutex.Lock()
-	ret, specificReturn := fake.createNavigationReturnsOnCall[len(fake.createNavigationArgsForCall)]
-	fake.createNavigationArgsForCall = append(fake.createNavigationArgsForCall, struct {
+func (fake *FakeRBACService) Logout(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.logoutMutex.Lock()
+	ret, specificReturn := fake.logoutReturnsOnCall[len(fake.logoutArgsForCall)]
+	fake.logoutArgsForCall = append(fake.logoutArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.Navigation
-	}{arg1, arg2})
-	stub := fake.CreateNavigationStub
-	fakeReturns := fake.createNavigationReturns
-	fake.recordInvocation("CreateNavigation", []interface{}{arg1, arg2})
-	fake.createNavigationMutex.Unlock()
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.LogoutStub
+	fakeReturns := fake.logoutReturns
+	fake.recordInvocation("Logout", []interface{}{arg1, arg2, arg3})
+	fake.logoutMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1327,59 +7373,59 @@ func (fake *FakeRBACService) CreateNavigation(arg1 context.Context, arg2 interna
 	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) CreateNavigationCallCount() int {
-	fake.createNavigationMutex.RLock()
-	defer fake.createNavigationMutex.RUnlock()
-	return len(fake.createNavigationArgsForCall)
+func (fake *FakeRBACService) LogoutCallCount() int {
+	fake.logoutMutex.RLock()
+	defer fake.logoutMutex.RUnlock()
+	return len(fake.logoutArgsForCall)
 }
 
-func (fake *FakeRBACService) CreateNavigationCalls(stub func(context.Context, internal.Navigation) error) {
-	fake.createNavigationMutex.Lock()
-	defer fake.createNavigationMutex.Unlock()
-	fake.CreateNavigationStub = stub
+func (fake *FakeRBACService) LogoutCalls(stub func(context.Context, string, string) error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = stub
 }
 
-func (fake *FakeRBACService) CreateNavigationArgsForCall(i int) (context.Context, internal.Navigation) {
-	fake.createNavigationMutex.RLock()
-	defer fake.createNavigationMutex.RUnlock()
-	argsForCall := fake.createNavigationArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) LogoutArgsForCall(i int) (context.Context, string, string) {
+	fake.logoutMutex.RLock()
+	defer fake.logoutMutex.RUnlock()
+	argsForCall := fake.logoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) CreateNavigationReturns(result1 error) {
-	fake.createNavigationMutex.Lock()
-	defer fake.createNavigationMutex.Unlock()
-	fake.CreateNavigationStub = nil
-	fake.createNavigationReturns = struct {
+func (fake *FakeRBACService) LogoutReturns(result1 error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = nil
+	fake.logoutReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) CreateNavigationReturnsOnCall(i int, result1 error) {
-	fake.createNavigationMutex.Lock()
-	defer fake.createNavigationMutex.Unlock()
-	fake.CreateNavigationStub = nil
-	if fake.createNavigationReturnsOnCall == nil {
-		fake.createNavigationReturnsOnCall = make(map[int]struct {
+func (fake *FakeRBACService) LogoutReturnsOnCall(i int, result1 error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = nil
+	if fake.logoutReturnsOnCall == nil {
+		fake.logoutReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.createNavigationReturnsOnCall[i] = struct {
+	fake.logoutReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) CreateRole(arg1 context.Context, arg2 string) (string, error) {
-	fake.createRoleMutex.Lock()
-	ret, specificReturn := fake.createRoleReturnsOnCall[len(fake.createRoleArgsForCall)]
-	fake.createRoleArgsForCall = append(fake.createRoleArgsForCall, struct {
+func (fake *FakeRBACService) MFAStatus(arg1 context.Context, arg2 string) (internal.MFAStatus, error) {
+	fake.mFAStatusMutex.Lock()
+	ret, specificReturn := fake.mFAStatusReturnsOnCall[len(fake.mFAStatusArgsForCall)]
+	fake.mFAStatusArgsForCall = append(fake.mFAStatusArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.CreateRoleStub
-	fakeReturns := fake.createRoleReturns
-	fake.recordInvocation("CreateRole", []interface{}{arg1, arg2})
-	fake.createRoleMutex.Unlock()
+	stub := fake.MFAStatusStub
+	fakeReturns := fake.mFAStatusReturns
+	fake.recordInvocation("MFAStatus", []interface{}{arg1, arg2})
+	fake.mFAStatusMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -1389,124 +7435,127 @@ func (fake *FakeRBACService) CreateRole(arg1 context.Context, arg2 string) (stri
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) CreateRoleCallCount() int {
-	fake.createRoleMutex.RLock()
-	defer fake.createRoleMutex.RUnlock()
-	return len(fake.createRoleArgsForCall)
+func (fake *FakeRBACService) MFAStatusCallCount() int {
+	fake.mFAStatusMutex.RLock()
+	defer fake.mFAStatusMutex.RUnlock()
+	return len(fake.mFAStatusArgsForCall)
 }
 
-func (fake *FakeRBACService) CreateRoleCalls(stub func(context.Context, string) (string, error)) {
-	fake.createRoleMutex.Lock()
-	defer fake.createRoleMutex.Unlock()
-	fake.CreateRoleStub = stub
+func (fake *FakeRBACService) MFAStatusCalls(stub func(context.Context, string) (internal.MFAStatus, error)) {
+	fake.mFAStatusMutex.Lock()
+	defer fake.mFAStatusMutex.Unlock()
+	fake.MFAStatusStub = stub
 }
 
-func (fake *FakeRBACService) CreateRoleArgsForCall(i int) (context.Context, string) {
-	fake.createRoleMutex.RLock()
-	defer fake.createRoleMutex.RUnlock()
-	argsForCall := fake.createRoleArgsForCall[i]
+func (fake *FakeRBACService) MFAStatusArgsForCall(i int) (context.Context, string) {
+	fake.mFAStatusMutex.RLock()
+	defer fake.mFAStatusMutex.RUnlock()
+	argsForCall := fake.mFAStatusArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) CreateRoleReturns(result1 string, result2 error) {
-	fake.createRoleMutex.Lock()
-	defer fake.createRoleMutex.Unlock()
-	fake.CreateRoleStub = nil
-	fake.createRoleReturns = struct {
-		result1 string
+func (fake *FakeRBACService) MFAStatusReturns(result1 internal.MFAStatus, result2 error) {
+	fake.mFAStatusMutex.Lock()
+	defer fake.mFAStatusMutex.Unlock()
+	fake.MFAStatusStub = nil
+	fake.mFAStatusReturns = struct {
+		result1 internal.MFAStatus
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateRoleReturnsOnCall(i int, result1 string, result2 error) {
-	fake.createRoleMutex.Lock()
-	defer fake.createRoleMutex.Unlock()
-	fake.CreateRoleStub = nil
-	if fake.createRoleReturnsOnCall == nil {
-		fake.createRoleReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeRBACService) MFAStatusReturnsOnCall(i int, result1 internal.MFAStatus, result2 error) {
+	fake.mFAStatusMutex.Lock()
+	defer fake.mFAStatusMutex.Unlock()
+	fake.MFAStatusStub = nil
+	if fake.mFAStatusReturnsOnCall == nil {
+		fake.mFAStatusReturnsOnCall = make(map[int]struct {
+			result1 internal.MFAStatus
 			result2 error
 		})
 	}
-	fake.createRoleReturnsOnCall[i] = struct {
-		result1 string
+	fake.mFAStatusReturnsOnCall[i] = struct {
+		result1 internal.MFAStatus
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateRoleTask(arg1 context.Context, arg2 internal.RoleTasks) error {
-	fake.createRoleTaskMutex.Lock()
-	ret, specificReturn := fake.createRoleTaskReturnsOnCall[len(fake.createRoleTaskArgsForCall)]
-	fake.createRoleTaskArgsForCall = append(fake.createRoleTaskArgsForCall, struct {
+func (fake *FakeRBACService) MemberGroups(arg1 context.Context, arg2 string) ([]internal.Group, error) {
+	fake.memberGroupsMutex.Lock()
+	ret, specificReturn := fake.memberGroupsReturnsOnCall[len(fake.memberGroupsArgsForCall)]
+	fake.memberGroupsArgsForCall = append(fake.memberGroupsArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.RoleTasks
+		arg2 string
 	}{arg1, arg2})
-	stub := fake.CreateRoleTaskStub
-	fakeReturns := fake.createRoleTaskReturns
-	fake.recordInvocation("CreateRoleTask", []interface{}{arg1, arg2})
-	fake.createRoleTaskMutex.Unlock()
+	stub := fake.MemberGroupsStub
+	fakeReturns := fake.memberGroupsReturns
+	fake.recordInvocation("MemberGroups", []interface{}{arg1, arg2})
+	fake.memberGroupsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) CreateRoleTaskCallCount() int {
-	fake.createRoleTaskMutex.RLock()
-	defer fake.createRoleTaskMutex.RUnlock()
-	return len(fake.createRoleTaskArgsForCall)
+func (fake *FakeRBACService) MemberGroupsCallCount() int {
+	fake.memberGroupsMutex.RLock()
+	defer fake.memberGroupsMutex.RUnlock()
+	return len(fake.memberGroupsArgsForCall)
 }
 
-func (fake *FakeRBACService) CreateRoleTaskCalls(stub func(context.Context, internal.RoleTasks) error) {
-	fake.createRoleTaskMutex.Lock()
-	defer fake.createRoleTaskMutex.Unlock()
-	fake.CreateRoleTaskStub = stub
+func (fake *FakeRBACService) MemberGroupsCalls(stub func(context.Context, string) ([]internal.Group, error)) {
+	fake.memberGroupsMutex.Lock()
+	defer fake.memberGroupsMutex.Unlock()
+	fake.MemberGroupsStub = stub
 }
 
-func (fake *FakeRBACService) CreateRoleTaskArgsForCall(i int) (context.Context, internal.RoleTasks) {
-	fake.createRoleTaskMutex.RLock()
-	defer fake.createRoleTaskMutex.RUnlock()
-	argsForCall := fake.createRoleTaskArgsForCall[i]
+func (fake *FakeRBACService) MemberGroupsArgsForCall(i int) (context.Context, string) {
+	fake.memberGroupsMutex.RLock()
+	defer fake.memberGroupsMutex.RUnlock()
+	argsForCall := fake.memberGroupsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) CreateRoleTaskReturns(result1 error) {
-	fake.createRoleTaskMutex.Lock()
-	defer fake.createRoleTaskMutex.Unlock()
-	fake.CreateRoleTaskStub = nil
-	fake.createRoleTaskReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeRBACService) MemberGroupsReturns(result1 []internal.Group, result2 error) {
+	fake.memberGroupsMutex.Lock()
+	defer fake.memberGroupsMutex.Unlock()
+	fake.MemberGroupsStub = nil
+	fake.memberGroupsReturns = struct {
+		result1 []internal.Group
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateRoleTaskReturnsOnCall(i int, result1 error) {
-	fake.createRoleTaskMutex.Lock()
-	defer fake.createRoleTaskMutex.Unlock()
-	fake.CreateRoleTaskStub = nil
-	if fake.createRoleTaskReturnsOnCall == nil {
-		fake.createRoleTaskReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeRBACService) MemberGroupsReturnsOnCall(i int, result1 []internal.Group, result2 error) {
+	fake.memberGroupsMutex.Lock()
+	defer fake.memberGroupsMutex.Unlock()
+	fake.MemberGroupsStub = nil
+	if fake.memberGroupsReturnsOnCall == nil {
+		fake.memberGroupsReturnsOnCall = make(map[int]struct {
+			result1 []internal.Group
+			result2 error
 		})
 	}
-	fake.createRoleTaskReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.memberGroupsReturnsOnCall[i] = struct {
+		result1 []internal.Group
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateTask(arg1 context.Context, arg2 string) (string, error) {
-	fake.createTaskMutex.Lock()
-	ret, specificReturn := fake.createTaskReturnsOnCall[len(fake.createTaskArgsForCall)]
-	fake.createTaskArgsForCall = append(fake.createTaskArgsForCall, struct {
+func (fake *FakeRBACService) Menu(arg1 context.Context, arg2 string) (internal.Menu, error) {
+	fake.menuMutex.Lock()
+	ret, specificReturn := fake.menuReturnsOnCall[len(fake.menuArgsForCall)]
+	fake.menuArgsForCall = append(fake.menuArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.CreateTaskStub
-	fakeReturns := fake.createTaskReturns
-	fake.recordInvocation("CreateTask", []interface{}{arg1, arg2})
-	fake.createTaskMutex.Unlock()
+	stub := fake.MenuStub
+	fakeReturns := fake.menuReturns
+	fake.recordInvocation("Menu", []interface{}{arg1, arg2})
+	fake.menuMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -1516,63 +7565,64 @@ func (fake *FakeRBACService) CreateTask(arg1 context.Context, arg2 string) (stri
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) CreateTaskCallCount() int {
-	fake.createTaskMutex.RLock()
-	defer fake.createTaskMutex.RUnlock()
-	return len(fake.createTaskArgsForCall)
+func (fake *FakeRBACService) MenuCallCount() int {
+	fake.menuMutex.RLock()
+	defer fake.menuMutex.RUnlock()
+	return len(fake.menuArgsForCall)
 }
 
-func (fake *FakeRBACService) CreateTaskCalls(stub func(context.Context, string) (string, error)) {
-	fake.createTaskMutex.Lock()
-	defer fake.createTaskMutex.Unlock()
-	fake.CreateTaskStub = stub
+func (fake *FakeRBACService) MenuCalls(stub func(context.Context, string) (internal.Menu, error)) {
+	fake.menuMutex.Lock()
+	defer fake.menuMutex.Unlock()
+	fake.MenuStub = stub
 }
 
-func (fake *FakeRBACService) CreateTaskArgsForCall(i int) (context.Context, string) {
-	fake.createTaskMutex.RLock()
-	defer fake.createTaskMutex.RUnlock()
-	argsForCall := fake.createTaskArgsForCall[i]
+func (fake *FakeRBACService) MenuArgsForCall(i int) (context.Context, string) {
+	fake.menuMutex.RLock()
+	defer fake.menuMutex.RUnlock()
+	argsForCall := fake.menuArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) CreateTaskReturns(result1 string, result2 error) {
-	fake.createTaskMutex.Lock()
-	defer fake.createTaskMutex.Unlock()
-	fake.CreateTaskStub = nil
-	fake.createTaskReturns = struct {
-		result1 string
+func (fake *FakeRBACService) MenuReturns(result1 internal.Menu, result2 error) {
+	fake.menuMutex.Lock()
+	defer fake.menuMutex.Unlock()
+	fake.MenuStub = nil
+	fake.menuReturns = struct {
+		result1 internal.Menu
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateTaskReturnsOnCall(i int, result1 string, result2 error) {
-	fake.createTaskMutex.Lock()
-	defer fake.createTaskMutex.Unlock()
-	fake.CreateTaskStub = nil
-	if fake.createTaskReturnsOnCall == nil {
-		fake.createTaskReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeRBACService) MenuReturnsOnCall(i int, result1 internal.Menu, result2 error) {
+	fake.menuMutex.Lock()
+	defer fake.menuMutex.Unlock()
+	fake.MenuStub = nil
+	if fake.menuReturnsOnCall == nil {
+		fake.menuReturnsOnCall = make(map[int]struct {
+			result1 internal.Menu
 			result2 error
 		})
 	}
-	fake.createTaskReturnsOnCall[i] = struct {
-		result1 string
+	fake.menuReturnsOnCall[i] = struct {
+		result1 internal.Menu
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateToken(arg1 string) (string, error) {
-	fake.createTokenMutex.Lock()
-	ret, specificReturn := fake.createTokenReturnsOnCall[len(fake.createTokenArgsForCall)]
-	fake.createTokenArgsForCall = append(fake.createTokenArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	stub := fake.CreateTokenStub
-	fakeReturns := fake.createTokenReturns
-	fake.recordInvocation("CreateToken", []interface{}{arg1})
-	fake.createTokenMutex.Unlock()
+func (fake *FakeRBACService) Navigation(arg1 context.Context, arg2 string) (internal.Navigation, error) {
+	fake.navigationMutex.Lock()
+	ret, specificReturn := fake.navigationReturnsOnCall[len(fake.navigationArgsForCall)]
+	fake.navigationArgsForCall = append(fake.navigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.NavigationStub
+	fakeReturns := fake.navigationReturns
+	fake.recordInvocation("Navigation", []interface{}{arg1, arg2})
+	fake.navigationMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1580,250 +7630,252 @@ func (fake *FakeRBACService) CreateToken(arg1 string) (string, error) {
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) CreateTokenCallCount() int {
-	fake.createTokenMutex.RLock()
-	defer fake.createTokenMutex.RUnlock()
-	return len(fake.createTokenArgsForCall)
+func (fake *FakeRBACService) NavigationCallCount() int {
+	fake.navigationMutex.RLock()
+	defer fake.navigationMutex.RUnlock()
+	return len(fake.navigationArgsForCall)
 }
 
-func (fake *FakeRBACService) CreateTokenCalls(stub func(string) (string, error)) {
-	fake.createTokenMutex.Lock()
-	defer fake.createTokenMutex.Unlock()
-	fake.CreateTokenStub = stub
+func (fake *FakeRBACService) NavigationCalls(stub func(context.Context, string) (internal.Navigation, error)) {
+	fake.navigationMutex.Lock()
+	defer fake.navigationMutex.Unlock()
+	fake.NavigationStub = stub
 }
 
-func (fake *FakeRBACService) CreateTokenArgsForCall(i int) string {
-	fake.createTokenMutex.RLock()
-	defer fake.createTokenMutex.RUnlock()
-	argsForCall := fake.createTokenArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeRBACService) NavigationArgsForCall(i int) (context.Context, string) {
+	fake.navigationMutex.RLock()
+	defer fake.navigationMutex.RUnlock()
+	argsForCall := fake.navigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) CreateTokenReturns(result1 string, result2 error) {
-	fake.createTokenMutex.Lock()
-	defer fake.createTokenMutex.Unlock()
-	fake.CreateTokenStub = nil
-	fake.createTokenReturns = struct {
-		result1 string
+func (fake *FakeRBACService) NavigationReturns(result1 internal.Navigation, result2 error) {
+	fake.navigationMutex.Lock()
+	defer fake.navigationMutex.Unlock()
+	fake.NavigationStub = nil
+	fake.navigationReturns = struct {
+		result1 internal.Navigation
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) CreateTokenReturnsOnCall(i int, result1 string, result2 error) {
-	fake.createTokenMutex.Lock()
-	defer fake.createTokenMutex.Unlock()
-	fake.CreateTokenStub = nil
-	if fake.createTokenReturnsOnCall == nil {
-		fake.createTokenReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeRBACService) NavigationReturnsOnCall(i int, result1 internal.Navigation, result2 error) {
+	fake.navigationMutex.Lock()
+	defer fake.navigationMutex.Unlock()
+	fake.NavigationStub = nil
+	if fake.navigationReturnsOnCall == nil {
+		fake.navigationReturnsOnCall = make(map[int]struct {
+			result1 internal.Navigation
 			result2 error
 		})
 	}
-	fake.createTokenReturnsOnCall[i] = struct {
-		result1 string
+	fake.navigationReturnsOnCall[i] = struct {
+		result1 internal.Navigation
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) DeleteAccount(arg1 context.Context, arg2 string) error {
-	fake.deleteAccountMutex.Lock()
-	ret, specificReturn := fake.deleteAccountReturnsOnCall[len(fake.deleteAccountArgsForCall)]
-	fake.deleteAccountArgsForCall = append(fake.deleteAccountArgsForCall, struct {
+func (fake *FakeRBACService) PendingApprovals(arg1 context.Context, arg2 string) ([]internal.AccessRequest, error) {
+	fake.pendingApprovalsMutex.Lock()
+	ret, specificReturn := fake.pendingApprovalsReturnsOnCall[len(fake.pendingApprovalsArgsForCall)]
+	fake.pendingApprovalsArgsForCall = append(fake.pendingApprovalsArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.DeleteAccountStub
-	fakeReturns := fake.deleteAccountReturns
-	fake.recordInvocation("DeleteAccount", []interface{}{arg1, arg2})
-	fake.deleteAccountMutex.Unlock()
+	stub := fake.PendingApprovalsStub
+	fakeReturns := fake.pendingApprovalsReturns
+	fake.recordInvocation("PendingApprovals", []interface{}{arg1, arg2})
+	fake.pendingApprovalsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) DeleteAccountCallCount() int {
-	fake.deleteAccountMutex.RLock()
-	defer fake.deleteAccountMutex.RUnlock()
-	return len(fake.deleteAccountArgsForCall)
+func (fake *FakeRBACService) PendingApprovalsCallCount() int {
+	fake.pendingApprovalsMutex.RLock()
+	defer fake.pendingApprovalsMutex.RUnlock()
+	return len(fake.pendingApprovalsArgsForCall)
 }
 
-func (fake *FakeRBACService) DeleteAccountCalls(stub func(context.Context, string) error) {
-	fake.deleteAccountMutex.Lock()
-	defer fake.deleteAccountMutex.Unlock()
-	fake.DeleteAccountStub = stub
+func (fake *FakeRBACService) PendingApprovalsCalls(stub func(context.Context, string) ([]internal.AccessRequest, error)) {
+	fake.pendingApprovalsMutex.Lock()
+	defer fake.pendingApprovalsMutex.Unlock()
+	fake.PendingApprovalsStub = stub
 }
 
-func (fake *FakeRBACService) DeleteAccountArgsForCall(i int) (context.Context, string) {
-	fake.deleteAccountMutex.RLock()
-	defer fake.deleteAccountMutex.RUnlock()
-	argsForCall := fake.deleteAccountArgsForCall[i]
+func (fake *FakeRBACService) PendingApprovalsArgsForCall(i int) (context.Context, string) {
+	fake.pendingApprovalsMutex.RLock()
+	defer fake.pendingApprovalsMutex.RUnlock()
+	argsForCall := fake.pendingApprovalsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) DeleteAccountReturns(result1 error) {
-	fake.deleteAccountMutex.Lock()
-	defer fake.deleteAccountMutex.Unlock()
-	fake.DeleteAccountStub = nil
-	fake.deleteAccountReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeRBACService) PendingApprovalsReturns(result1 []internal.AccessRequest, result2 error) {
+	fake.pendingApprovalsMutex.Lock()
+	defer fake.pendingApprovalsMutex.Unlock()
+	fake.PendingApprovalsStub = nil
+	fake.pendingApprovalsReturns = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) DeleteAccountReturnsOnCall(i int, result1 error) {
-	fake.deleteAccountMutex.Lock()
-	defer fake.deleteAccountMutex.Unlock()
-	fake.DeleteAccountStub = nil
-	if fake.deleteAccountReturnsOnCall == nil {
-		fake.deleteAccountReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeRBACService) PendingApprovalsReturnsOnCall(i int, result1 []internal.AccessRequest, result2 error) {
+	fake.pendingApprovalsMutex.Lock()
+	defer fake.pendingApprovalsMutex.Unlock()
+	fake.PendingApprovalsStub = nil
+	if fake.pendingApprovalsReturnsOnCall == nil {
+		fake.pendingApprovalsReturnsOnCall = make(map[int]struct {
+			result1 []internal.AccessRequest
+			result2 error
 		})
 	}
-	fake.deleteAccountReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.pendingApprovalsReturnsOnCall[i] = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) DeleteAccountRole(arg1 context.Context, arg2 string) error {
-	fake.deleteAccountRoleMutex.Lock()
-	ret, specificReturn := fake.deleteAccountRoleReturnsOnCall[len(fake.deleteAccountRoleArgsForCall)]
-	fake.deleteAccountRoleArgsForCall = append(fake.deleteAccountRoleArgsForCall, struct {
-		arg1 context.Context
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.DeleteAccountRoleStub
-	fakeReturns := fake.deleteAccountRoleReturns
-	fake.recordInvocation("DeleteAccountRole", []interface{}{arg1, arg2})
-	fake.deleteAccountRoleMutex.Unlock()
+func (fake *FakeRBACService) PublicKeys() []tokenmaker.JWK {
+	fake.publicKeysMutex.Lock()
+	ret, specificReturn := fake.publicKeysReturnsOnCall[len(fake.publicKeysArgsForCall)]
+	fake.publicKeysArgsForCall = append(fake.publicKeysArgsForCall, struct {
+	}{})
+	stub := fake.PublicKeysStub
+	fakeReturns := fake.publicKeysReturns
+	fake.recordInvocation("PublicKeys", []interface{}{})
+	fake.publicKeysMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1
+		return stub()
 	}
-	return fakeReturns.result1
-}
-
-func (fake *FakeRBACService) DeleteAccountRoleCallCount() int {
-	fake.deleteAccountRoleMutex.RLock()
-	defer fake.deleteAccountRoleMutex.RUnlock()
-	return len(fake.deleteAccountRoleArgsForCall)
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) DeleteAccountRoleCalls(stub func(context.Context, string) error) {
-	fake.deleteAccountRoleMutex.Lock()
-	defer fake.deleteAccountRoleMutex.Unlock()
-	fake.DeleteAccountRoleStub = stub
+func (fake *FakeRBACService) PublicKeysCallCount() int {
+	fake.publicKeysMutex.RLock()
+	defer fake.publicKeysMutex.RUnlock()
+	return len(fake.publicKeysArgsForCall)
 }
 
-func (fake *FakeRBACService) DeleteAccountRoleArgsForCall(i int) (context.Context, string) {
-	fake.deleteAccountRoleMutex.RLock()
-	defer fake.deleteAccountRoleMutex.RUnlock()
-	argsForCall := fake.deleteAccountRoleArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) PublicKeysCalls(stub func() []tokenmaker.JWK) {
+	fake.publicKeysMutex.Lock()
+	defer fake.publicKeysMutex.Unlock()
+	fake.PublicKeysStub = stub
 }
 
-func (fake *FakeRBACService) DeleteAccountRoleReturns(result1 error) {
-	fake.deleteAccountRoleMutex.Lock()
-	defer fake.deleteAccountRoleMutex.Unlock()
-	fake.DeleteAccountRoleStub = nil
-	fake.deleteAccountRoleReturns = struct {
-		result1 error
+func (fake *FakeRBACService) PublicKeysReturns(result1 []tokenmaker.JWK) {
+	fake.publicKeysMutex.Lock()
+	defer fake.publicKeysMutex.Unlock()
+	fake.PublicKeysStub = nil
+	fake.publicKeysReturns = struct {
+		result1 []tokenmaker.JWK
 	}{result1}
 }
 
-func (fake *FakeRBACService) DeleteAccountRoleReturnsOnCall(i int, result1 error) {
-	fake.deleteAccountRoleMutex.Lock()
-	defer fake.deleteAccountRoleMutex.Unlock()
-	fake.DeleteAccountRoleStub = nil
-	if fake.deleteAccountRoleReturnsOnCall == nil {
-		fake.deleteAccountRoleReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeRBACService) PublicKeysReturnsOnCall(i int, result1 []tokenmaker.JWK) {
+	fake.publicKeysMutex.Lock()
+	defer fake.publicKeysMutex.Unlock()
+	fake.PublicKeysStub = nil
+	if fake.publicKeysReturnsOnCall == nil {
+		fake.publicKeysReturnsOnCall = make(map[int]struct {
+			result1 []tokenmaker.JWK
 		})
 	}
-	fake.deleteAccountRoleReturnsOnCall[i] = struct {
-		result1 error
+	fake.publicKeysReturnsOnCall[i] = struct {
+		result1 []tokenmaker.JWK
 	}{result1}
 }
 
-func (fake *FakeRBACService) DeleteHelpText(arg1 context.Context, arg2 string) error {
-	fake.deleteHelpTextMutex.Lock()
-	ret, specificReturn := fake.deleteHelpTextReturnsOnCall[len(fake.deleteHelpTextArgsForCall)]
-	fake.deleteHelpTextArgsForCall = append(fake.deleteHelpTextArgsForCall, struct {
+func (fake *FakeRBACService) RefreshToken(arg1 context.Context, arg2 string) (string, string, error) {
+	fake.refreshTokenMutex.Lock()
+	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
+	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.DeleteHelpTextStub
-	fakeReturns := fake.deleteHelpTextReturns
-	fake.recordInvocation("DeleteHelpText", []interface{}{arg1, arg2})
-	fake.deleteHelpTextMutex.Unlock()
+	stub := fake.RefreshTokenStub
+	fakeReturns := fake.refreshTokenReturns
+	fake.recordInvocation("RefreshToken", []interface{}{arg1, arg2})
+	fake.refreshTokenMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeRBACService) DeleteHelpTextCallCount() int {
-	fake.deleteHelpTextMutex.RLock()
-	defer fake.deleteHelpTextMutex.RUnlock()
-	return len(fake.deleteHelpTextArgsForCall)
+func (fake *FakeRBACService) RefreshTokenCallCount() int {
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	return len(fake.refreshTokenArgsForCall)
 }
 
-func (fake *FakeRBACService) DeleteHelpTextCalls(stub func(context.Context, string) error) {
-	fake.deleteHelpTextMutex.Lock()
-	defer fake.deleteHelpTextMutex.Unlock()
-	fake.DeleteHelpTextStub = stub
+func (fake *FakeRBACService) RefreshTokenCalls(stub func(context.Context, string) (string, string, error)) {
+	fake.refreshTokenMutex.Lock()
+	defer fake.refreshTokenMutex.Unlock()
+	fake.RefreshTokenStub = stub
 }
 
-func (fake *FakeRBACService) DeleteHelpTextArgsForCall(i int) (context.Context, string) {
-	fake.deleteHelpTextMutex.RLock()
-	defer fake.deleteHelpTextMutex.RUnlock()
-	argsForCall := fake.deleteHelpTextArgsForCall[i]
+func (fake *FakeRBACService) RefreshTokenArgsForCall(i int) (context.Context, string) {
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	argsForCall := fake.refreshTokenArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) DeleteHelpTextReturns(result1 error) {
-	fake.deleteHelpTextMutex.Lock()
-	defer fake.deleteHelpTextMutex.Unlock()
-	fake.DeleteHelpTextStub = nil
-	fake.deleteHelpTextReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeRBACService) RefreshTokenReturns(result1 string, result2 string, result3 error) {
+	fake.refreshTokenMutex.Lock()
+	defer fake.refreshTokenMutex.Unlock()
+	fake.RefreshTokenStub = nil
+	fake.refreshTokenReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeRBACService) DeleteHelpTextReturnsOnCall(i int, result1 error) {
-	fake.deleteHelpTextMutex.Lock()
-	defer fake.deleteHelpTextMutex.Unlock()
-	fake.DeleteHelpTextStub = nil
-	if fake.deleteHelpTextReturnsOnCall == nil {
-		fake.deleteHelpTextReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeRBACService) RefreshTokenReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.refreshTokenMutex.Lock()
+	defer fake.refreshTokenMutex.Unlock()
+	fake.RefreshTokenStub = nil
+	if fake.refreshTokenReturnsOnCall == nil {
+		fake.refreshTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
 		})
 	}
-	fake.deleteHelpTextReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.refreshTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeRBACService) DeleteMenu(arg1 context.Context, arg2 string) error {
-	fake.deleteMenuMutex.Lock()
-	ret, specificReturn := fake.deleteMenuReturnsOnCall[len(fake.deleteMenuArgsForCall)]
-	fake.deleteMenuArgsForCall = append(fake.deleteMenuArgsForCall, struct {
+func (fake *FakeRBACService) RejectAccessRequest(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.rejectAccessRequestMutex.Lock()
+	ret, specificReturn := fake.rejectAccessRequestReturnsOnCall[len(fake.rejectAccessRequestArgsForCall)]
+	fake.rejectAccessRequestArgsForCall = append(fake.rejectAccessRequestArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
-	stub := fake.DeleteMenuStub
-	fakeReturns := fake.deleteMenuReturns
-	fake.recordInvocation("DeleteMenu", []interface{}{arg1, arg2})
-	fake.deleteMenuMutex.Unlock()
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RejectAccessRequestStub
+	fakeReturns := fake.rejectAccessRequestReturns
+	fake.recordInvocation("RejectAccessRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rejectAccessRequestMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1831,123 +7883,126 @@ func (fake *FakeRBACService) DeleteMenu(arg1 context.Context, arg2 string) error
 	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) DeleteMenuCallCount() int {
-	fake.deleteMenuMutex.RLock()
-	defer fake.deleteMenuMutex.RUnlock()
-	return len(fake.deleteMenuArgsForCall)
+func (fake *FakeRBACService) RejectAccessRequestCallCount() int {
+	fake.rejectAccessRequestMutex.RLock()
+	defer fake.rejectAccessRequestMutex.RUnlock()
+	return len(fake.rejectAccessRequestArgsForCall)
 }
 
-func (fake *FakeRBACService) DeleteMenuCalls(stub func(context.Context, string) error) {
-	fake.deleteMenuMutex.Lock()
-	defer fake.deleteMenuMutex.Unlock()
-	fake.DeleteMenuStub = stub
+func (fake *FakeRBACService) RejectAccessRequestCalls(stub func(context.Context, string, string, string) error) {
+	fake.rejectAccessRequestMutex.Lock()
+	defer fake.rejectAccessRequestMutex.Unlock()
+	fake.RejectAccessRequestStub = stub
 }
 
-func (fake *FakeRBACService) DeleteMenuArgsForCall(i int) (context.Context, string) {
-	fake.deleteMenuMutex.RLock()
-	defer fake.deleteMenuMutex.RUnlock()
-	argsForCall := fake.deleteMenuArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) RejectAccessRequestArgsForCall(i int) (context.Context, string, string, string) {
+	fake.rejectAccessRequestMutex.RLock()
+	defer fake.rejectAccessRequestMutex.RUnlock()
+	argsForCall := fake.rejectAccessRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeRBACService) DeleteMenuReturns(result1 error) {
-	fake.deleteMenuMutex.Lock()
-	defer fake.deleteMenuMutex.Unlock()
-	fake.DeleteMenuStub = nil
-	fake.deleteMenuReturns = struct {
+func (fake *FakeRBACService) RejectAccessRequestReturns(result1 error) {
+	fake.rejectAccessRequestMutex.Lock()
+	defer fake.rejectAccessRequestMutex.Unlock()
+	fake.RejectAccessRequestStub = nil
+	fake.rejectAccessRequestReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) DeleteMenuReturnsOnCall(i int, result1 error) {
-	fake.deleteMenuMutex.Lock()
-	defer fake.deleteMenuMutex.Unlock()
-	fake.DeleteMenuStub = nil
-	if fake.deleteMenuReturnsOnCall == nil {
-		fake.deleteMenuReturnsOnCall = make(map[int]struct {
+func (fake *FakeRBACService) RejectAccessRequestReturnsOnCall(i int, result1 error) {
+	fake.rejectAccessRequestMutex.Lock()
+	defer fake.rejectAccessRequestMutex.Unlock()
+	fake.RejectAccessRequestStub = nil
+	if fake.rejectAccessRequestReturnsOnCall == nil {
+		fake.rejectAccessRequestReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteMenuReturnsOnCall[i] = struct {
+	fake.rejectAccessRequestReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) DeleteNavigation(arg1 context.Context, arg2 string) error {
-	fake.deleteNavigationMutex.Lock()
-	ret, specificReturn := fake.deleteNavigationReturnsOnCall[len(fake.deleteNavigationArgsForCall)]
-	fake.deleteNavigationArgsForCall = append(fake.deleteNavigationArgsForCall, struct {
+func (fake *FakeRBACService) ReloadTokenKeys(arg1 context.Context) ([]tokenmaker.KeyInfo, error) {
+	fake.reloadTokenKeysMutex.Lock()
+	ret, specificReturn := fake.reloadTokenKeysReturnsOnCall[len(fake.reloadTokenKeysArgsForCall)]
+	fake.reloadTokenKeysArgsForCall = append(fake.reloadTokenKeysArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.DeleteNavigationStub
-	fakeReturns := fake.deleteNavigationReturns
-	fake.recordInvocation("DeleteNavigation", []interface{}{arg1, arg2})
-	fake.deleteNavigationMutex.Unlock()
+	}{arg1})
+	stub := fake.ReloadTokenKeysStub
+	fakeReturns := fake.reloadTokenKeysReturns
+	fake.recordInvocation("ReloadTokenKeys", []interface{}{arg1})
+	fake.reloadTokenKeysMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) DeleteNavigationCallCount() int {
-	fake.deleteNavigationMutex.RLock()
-	defer fake.deleteNavigationMutex.RUnlock()
-	return len(fake.deleteNavigationArgsForCall)
+func (fake *FakeRBACService) ReloadTokenKeysCallCount() int {
+	fake.reloadTokenKeysMutex.RLock()
+	defer fake.reloadTokenKeysMutex.RUnlock()
+	return len(fake.reloadTokenKeysArgsForCall)
 }
 
-func (fake *FakeRBACService) DeleteNavigationCalls(stub func(context.Context, string) error) {
-	fake.deleteNavigationMutex.Lock()
-	defer fake.deleteNavigationMutex.Unlock()
-	fake.DeleteNavigationStub = stub
+func (fake *FakeRBACService) ReloadTokenKeysCalls(stub func(context.Context) ([]tokenmaker.KeyInfo, error)) {
+	fake.reloadTokenKeysMutex.Lock()
+	defer fake.reloadTokenKeysMutex.Unlock()
+	fake.ReloadTokenKeysStub = stub
 }
 
-func (fake *FakeRBACService) DeleteNavigationArgsForCall(i int) (context.Context, string) {
-	fake.deleteNavigationMutex.RLock()
-	defer fake.deleteNavigationMutex.RUnlock()
-	argsForCall := fake.deleteNavigationArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) ReloadTokenKeysArgsForCall(i int) context.Context {
+	fake.reloadTokenKeysMutex.RLock()
+	defer fake.reloadTokenKeysMutex.RUnlock()
+	argsForCall := fake.reloadTokenKeysArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeRBACService) DeleteNavigationReturns(result1 error) {
-	fake.deleteNavigationMutex.Lock()
-	defer fake.deleteNavigationMutex.Unlock()
-	fake.DeleteNavigationStub = nil
-	fake.deleteNavigationReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeRBACService) ReloadTokenKeysReturns(result1 []tokenmaker.KeyInfo, result2 error) {
+	fake.reloadTokenKeysMutex.Lock()
+	defer fake.reloadTokenKeysMutex.Unlock()
+	fake.ReloadTokenKeysStub = nil
+	fake.reloadTokenKeysReturns = struct {
+		result1 []tokenmaker.KeyInfo
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) DeleteNavigationReturnsOnCall(i int, result1 error) {
-	fake.deleteNavigationMutex.Lock()
-	defer fake.deleteNavigationMutex.Unlock()
-	fake.DeleteNavigationStub = nil
-	if fake.deleteNavigationReturnsOnCall == nil {
-		fake.deleteNavigationReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeRBACService) ReloadTokenKeysReturnsOnCall(i int, result1 []tokenmaker.KeyInfo, result2 error) {
+	fake.reloadTokenKeysMutex.Lock()
+	defer fake.reloadTokenKeysMutex.Unlock()
+	fake.ReloadTokenKeysStub = nil
+	if fake.reloadTokenKeysReturnsOnCall == nil {
+		fake.reloadTokenKeysReturnsOnCall = make(map[int]struct {
+			result1 []tokenmaker.KeyInfo
+			result2 error
 		})
 	}
-	fake.deleteNavigationReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.reloadTokenKeysReturnsOnCall[i] = struct {
+		result1 []tokenmaker.KeyInfo
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeRBACService) DeleteRole(arg1 context.Context, arg2 string) error {
-	fake.deleteRoleMutex.Lock()
-	ret, specificReturn := fake.deleteRoleReturnsOnCall[len(fake.deleteRoleArgsForCall)]
-	fake.deleteRoleArgsForCall = append(fake.deleteRoleArgsForCall, struct {
+func (fake *FakeRBACService) RemoveAccountTenant(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.removeAccountTenantMutex.Lock()
+	ret, specificReturn := fake.removeAccountTenantReturnsOnCall[len(fake.removeAccountTenantArgsForCall)]
+	fake.removeAccountTenantArgsForCall = append(fake.removeAccountTenantArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
-	stub := fake.DeleteRoleStub
-	fakeReturns := fake.deleteRoleReturns
-	fake.recordInvocation("DeleteRole", []interface{}{arg1, arg2})
-	fake.deleteRoleMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveAccountTenantStub
+	fakeReturns := fake.removeAccountTenantReturns
+	fake.recordInvocation("RemoveAccountTenant", []interface{}{arg1, arg2, arg3})
+	fake.removeAccountTenantMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1955,61 +8010,62 @@ func (fake *FakeRBACService) DeleteRole(arg1 context.Context, arg2 string) error
 	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) DeleteRoleCallCount() int {
-	fake.deleteRoleMutex.RLock()
-	defer fake.deleteRoleMutex.RUnlock()
-	return len(fake.deleteRoleArgsForCall)
+func (fake *FakeRBACService) RemoveAccountTenantCallCount() int {
+	fake.removeAccountTenantMutex.RLock()
+	defer fake.removeAccountTenantMutex.RUnlock()
+	return len(fake.removeAccountTenantArgsForCall)
 }
 
-func (fake *FakeRBACService) DeleteRoleCalls(stub func(context.Context, string) error) {
-	fake.deleteRoleMutex.Lock()
-	defer fake.deleteRoleMutex.Unlock()
-	fake.DeleteRoleStub = stub
+func (fake *FakeRBACService) RemoveAccountTenantCalls(stub func(context.Context, string, string) error) {
+	fake.removeAccountTenantMutex.Lock()
+	defer fake.removeAccountTenantMutex.Unlock()
+	fake.RemoveAccountTenantStub = stub
 }
 
-func (fake *FakeRBACService) DeleteRoleArgsForCall(i int) (context.Context, string) {
-	fake.deleteRoleMutex.RLock()
-	defer fake.deleteRoleMutex.RUnlock()
-	argsForCall := fake.deleteRoleArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) RemoveAccountTenantArgsForCall(i int) (context.Context, string, string) {
+	fake.removeAccountTenantMutex.RLock()
+	defer fake.removeAccountTenantMutex.RUnlock()
+	argsForCall := fake.removeAccountTenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) DeleteRoleReturns(result1 error) {
-	fake.deleteRoleMutex.Lock()
-	defer fake.deleteRoleMutex.Unlock()
-	fake.DeleteRoleStub = nil
-	fake.deleteRoleReturns = struct {
+func (fake *FakeRBACService) RemoveAccountTenantReturns(result1 error) {
+	fake.removeAccountTenantMutex.Lock()
+	defer fake.removeAccountTenantMutex.Unlock()
+	fake.RemoveAccountTenantStub = nil
+	fake.removeAccountTenantReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) DeleteRoleReturnsOnCall(i int, result1 error) {
-	fake.deleteRoleMutex.Lock()
-	defer fake.deleteRoleMutex.Unlock()
-	fake.DeleteRoleStub = nil
-	if fake.deleteRoleReturnsOnCall == nil {
-		fake.deleteRoleReturnsOnCall = make(map[int]struct {
+func (fake *FakeRBACService) RemoveAccountTenantReturnsOnCall(i int, result1 error) {
+	fake.removeAccountTenantMutex.Lock()
+	defer fake.removeAccountTenantMutex.Unlock()
+	fake.RemoveAccountTenantStub = nil
+	if fake.removeAccountTenantReturnsOnCall == nil {
+		fake.removeAccountTenantReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteRoleReturnsOnCall[i] = struct {
+	fake.removeAccountTenantReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) DeleteRoleTask(arg1 context.Context, arg2 string) error {
-	fake.deleteRoleTaskMutex.Lock()
-	ret, specificReturn := fake.deleteRoleTaskReturnsOnCall[len(fake.deleteRoleTaskArgsForCall)]
-	fake.deleteRoleTaskArgsForCall = append(fake.deleteRoleTaskArgsForCall, struct {
+func (fake *FakeRBACService) RemoveGroupMember(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.removeGroupMemberMutex.Lock()
+	ret, specificReturn := fake.removeGroupMemberReturnsOnCall[len(fake.removeGroupMemberArgsForCall)]
+	fake.removeGroupMemberArgsForCall = append(fake.removeGroupMemberArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
-	stub := fake.DeleteRoleTaskStub
-	fakeReturns := fake.deleteRoleTaskReturns
-	fake.recordInvocation("DeleteRoleTask", []interface{}{arg1, arg2})
-	fake.deleteRoleTaskMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveGroupMemberStub
+	fakeReturns := fake.removeGroupMemberReturns
+	fake.recordInvocation("RemoveGroupMember", []interface{}{arg1, arg2, arg3})
+	fake.removeGroupMemberMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -2017,61 +8073,62 @@ func (fake *FakeRBACService) DeleteRoleTask(arg1 context.Context, arg2 string) e
 	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) DeleteRoleTaskCallCount() int {
-	fake.deleteRoleTaskMutex.RLock()
-	defer fake.deleteRoleTaskMutex.RUnlock()
-	return len(fake.deleteRoleTaskArgsForCall)
+func (fake *FakeRBACService) RemoveGroupMemberCallCount() int {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return len(fake.removeGroupMemberArgsForCall)
 }
 
-func (fake *FakeRBACService) DeleteRoleTaskCalls(stub func(context.Context, string) error) {
-	fake.deleteRoleTaskMutex.Lock()
-	defer fake.deleteRoleTaskMutex.Unlock()
-	fake.DeleteRoleTaskStub = stub
+func (fake *FakeRBACService) RemoveGroupMemberCalls(stub func(context.Context, string, string) error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = stub
 }
 
-func (fake *FakeRBACService) DeleteRoleTaskArgsForCall(i int) (context.Context, string) {
-	fake.deleteRoleTaskMutex.RLock()
-	defer fake.deleteRoleTaskMutex.RUnlock()
-	argsForCall := fake.deleteRoleTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) RemoveGroupMemberArgsForCall(i int) (context.Context, string, string) {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	argsForCall := fake.removeGroupMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) DeleteRoleTaskReturns(result1 error) {
-	fake.deleteRoleTaskMutex.Lock()
-	defer fake.deleteRoleTaskMutex.Unlock()
-	fake.DeleteRoleTaskStub = nil
-	fake.deleteRoleTaskReturns = struct {
+func (fake *FakeRBACService) RemoveGroupMemberReturns(result1 error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = nil
+	fake.removeGroupMemberReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) DeleteRoleTaskReturnsOnCall(i int, result1 error) {
-	fake.deleteRoleTaskMutex.Lock()
-	defer fake.deleteRoleTaskMutex.Unlock()
-	fake.DeleteRoleTaskStub = nil
-	if fake.deleteRoleTaskReturnsOnCall == nil {
-		fake.deleteRoleTaskReturnsOnCall = make(map[int]struct {
+func (fake *FakeRBACService) RemoveGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = nil
+	if fake.removeGroupMemberReturnsOnCall == nil {
+		fake.removeGroupMemberReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteRoleTaskReturnsOnCall[i] = struct {
+	fake.removeGroupMemberReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) DeleteTask(arg1 context.Context, arg2 string) error {
-	fake.deleteTaskMutex.Lock()
-	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
-	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+func (fake *FakeRBACService) RemoveGroupRole(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.removeGroupRoleMutex.Lock()
+	ret, specificReturn := fake.removeGroupRoleReturnsOnCall[len(fake.removeGroupRoleArgsForCall)]
+	fake.removeGroupRoleArgsForCall = append(fake.removeGroupRoleArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
-	stub := fake.DeleteTaskStub
-	fakeReturns := fake.deleteTaskReturns
-	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2})
-	fake.deleteTaskMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveGroupRoleStub
+	fakeReturns := fake.removeGroupRoleReturns
+	fake.recordInvocation("RemoveGroupRole", []interface{}{arg1, arg2, arg3})
+	fake.removeGroupRoleMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -2079,385 +8136,373 @@ func (fake *FakeRBACService) DeleteTask(arg1 context.Context, arg2 string) error
 	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) DeleteTaskCallCount() int {
-	fake.deleteTaskMutex.RLock()
-	defer fake.deleteTaskMutex.RUnlock()
-	return len(fake.deleteTaskArgsForCall)
-}
-
-func (fake *FakeRBACService) DeleteTaskCalls(stub func(context.Context, string) error) {
-	fake.deleteTaskMutex.Lock()
-	defer fake.deleteTaskMutex.Unlock()
-	fake.DeleteTaskStub = stub
+func (fake *FakeRBACService) RemoveGroupRoleCallCount() int {
+	fake.removeGroupRoleMutex.RLock()
+	defer fake.removeGroupRoleMutex.RUnlock()
+	return len(fake.removeGroupRoleArgsForCall)
 }
 
-func (fake *FakeRBACService) DeleteTaskArgsForCall(i int) (context.Context, string) {
-	fake.deleteTaskMutex.RLock()
-	defer fake.deleteTaskMutex.RUnlock()
-	argsForCall := fake.deleteTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) RemoveGroupRoleCalls(stub func(context.Context, string, string) error) {
+	fake.removeGroupRoleMutex.Lock()
+	defer fake.removeGroupRoleMutex.Unlock()
+	fake.RemoveGroupRoleStub = stub
 }
 
-func (fake *FakeRBACService) DeleteTaskReturns(result1 error) {
-	fake.deleteTaskMutex.Lock()
-	defer fake.deleteTaskMutex.Unlock()
-	fake.DeleteTaskStub = nil
-	fake.deleteTaskReturns = struct {
+func (fake *FakeRBACService) RemoveGroupRoleArgsForCall(i int) (context.Context, string, string) {
+	fake.removeGroupRoleMutex.RLock()
+	defer fake.removeGroupRoleMutex.RUnlock()
+	argsForCall := fake.removeGroupRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACService) RemoveGroupRoleReturns(result1 error) {
+	fake.removeGroupRoleMutex.Lock()
+	defer fake.removeGroupRoleMutex.Unlock()
+	fake.RemoveGroupRoleStub = nil
+	fake.removeGroupRoleReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) DeleteTaskReturnsOnCall(i int, result1 error) {
-	fake.deleteTaskMutex.Lock()
-	defer fake.deleteTaskMutex.Unlock()
-	fake.DeleteTaskStub = nil
-	if fake.deleteTaskReturnsOnCall == nil {
-		fake.deleteTaskReturnsOnCall = make(map[int]struct {
+func (fake *FakeRBACService) RemoveGroupRoleReturnsOnCall(i int, result1 error) {
+	fake.removeGroupRoleMutex.Lock()
+	defer fake.removeGroupRoleMutex.Unlock()
+	fake.RemoveGroupRoleStub = nil
+	if fake.removeGroupRoleReturnsOnCall == nil {
+		fake.removeGroupRoleReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteTaskReturnsOnCall[i] = struct {
+	fake.removeGroupRoleReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) HelpText(arg1 context.Context, arg2 string) (internal.HelpText, error) {
-	fake.helpTextMutex.Lock()
-	ret, specificReturn := fake.helpTextReturnsOnCall[len(fake.helpTextArgsForCall)]
-	fake.helpTextArgsForCall = append(fake.helpTextArgsForCall, struct {
+func (fake *FakeRBACService) RemoveMemberGroup(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.removeMemberGroupMutex.Lock()
+	ret, specificReturn := fake.removeMemberGroupReturnsOnCall[len(fake.removeMemberGroupArgsForCall)]
+	fake.removeMemberGroupArgsForCall = append(fake.removeMemberGroupArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
-	stub := fake.HelpTextStub
-	fakeReturns := fake.helpTextReturns
-	fake.recordInvocation("HelpText", []interface{}{arg1, arg2})
-	fake.helpTextMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveMemberGroupStub
+	fakeReturns := fake.removeMemberGroupReturns
+	fake.recordInvocation("RemoveMemberGroup", []interface{}{arg1, arg2, arg3})
+	fake.removeMemberGroupMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) HelpTextCallCount() int {
-	fake.helpTextMutex.RLock()
-	defer fake.helpTextMutex.RUnlock()
-	return len(fake.helpTextArgsForCall)
+func (fake *FakeRBACService) RemoveMemberGroupCallCount() int {
+	fake.removeMemberGroupMutex.RLock()
+	defer fake.removeMemberGroupMutex.RUnlock()
+	return len(fake.removeMemberGroupArgsForCall)
 }
 
-func (fake *FakeRBACService) HelpTextCalls(stub func(context.Context, string) (internal.HelpText, error)) {
-	fake.helpTextMutex.Lock()
-	defer fake.helpTextMutex.Unlock()
-	fake.HelpTextStub = stub
+func (fake *FakeRBACService) RemoveMemberGroupCalls(stub func(context.Context, string, string) error) {
+	fake.removeMemberGroupMutex.Lock()
+	defer fake.removeMemberGroupMutex.Unlock()
+	fake.RemoveMemberGroupStub = stub
 }
 
-func (fake *FakeRBACService) HelpTextArgsForCall(i int) (context.Context, string) {
-	fake.helpTextMutex.RLock()
-	defer fake.helpTextMutex.RUnlock()
-	argsForCall := fake.helpTextArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) RemoveMemberGroupArgsForCall(i int) (context.Context, string, string) {
+	fake.removeMemberGroupMutex.RLock()
+	defer fake.removeMemberGroupMutex.RUnlock()
+	argsForCall := fake.removeMemberGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) HelpTextReturns(result1 internal.HelpText, result2 error) {
-	fake.helpTextMutex.Lock()
-	defer fake.helpTextMutex.Unlock()
-	fake.HelpTextStub = nil
-	fake.helpTextReturns = struct {
-		result1 internal.HelpText
-		result2 error
-	}{result1, result2}
+func (fake *FakeRBACService) RemoveMemberGroupReturns(result1 error) {
+	fake.removeMemberGroupMutex.Lock()
+	defer fake.removeMemberGroupMutex.Unlock()
+	fake.RemoveMemberGroupStub = nil
+	fake.removeMemberGroupReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) HelpTextReturnsOnCall(i int, result1 internal.HelpText, result2 error) {
-	fake.helpTextMutex.Lock()
-	defer fake.helpTextMutex.Unlock()
-	fake.HelpTextStub = nil
-	if fake.helpTextReturnsOnCall == nil {
-		fake.helpTextReturnsOnCall = make(map[int]struct {
-			result1 internal.HelpText
-			result2 error
+func (fake *FakeRBACService) RemoveMemberGroupReturnsOnCall(i int, result1 error) {
+	fake.removeMemberGroupMutex.Lock()
+	defer fake.removeMemberGroupMutex.Unlock()
+	fake.RemoveMemberGroupStub = nil
+	if fake.removeMemberGroupReturnsOnCall == nil {
+		fake.removeMemberGroupReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.helpTextReturnsOnCall[i] = struct {
-		result1 internal.HelpText
-		result2 error
-	}{result1, result2}
+	fake.removeMemberGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) IsAllowed(arg1 context.Context, arg2 string, arg3 string) (bool, error) {
-	fake.isAllowedMutex.Lock()
-	ret, specificReturn := fake.isAllowedReturnsOnCall[len(fake.isAllowedArgsForCall)]
-	fake.isAllowedArgsForCall = append(fake.isAllowedArgsForCall, struct {
+func (fake *FakeRBACService) RemoveRoleApprover(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.removeRoleApproverMutex.Lock()
+	ret, specificReturn := fake.removeRoleApproverReturnsOnCall[len(fake.removeRoleApproverArgsForCall)]
+	fake.removeRoleApproverArgsForCall = append(fake.removeRoleApproverArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.IsAllowedStub
-	fakeReturns := fake.isAllowedReturns
-	fake.recordInvocation("IsAllowed", []interface{}{arg1, arg2, arg3})
-	fake.isAllowedMutex.Unlock()
+	stub := fake.RemoveRoleApproverStub
+	fakeReturns := fake.removeRoleApproverReturns
+	fake.recordInvocation("RemoveRoleApprover", []interface{}{arg1, arg2, arg3})
+	fake.removeRoleApproverMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) IsAllowedCallCount() int {
-	fake.isAllowedMutex.RLock()
-	defer fake.isAllowedMutex.RUnlock()
-	return len(fake.isAllowedArgsForCall)
+func (fake *FakeRBACService) RemoveRoleApproverCallCount() int {
+	fake.removeRoleApproverMutex.RLock()
+	defer fake.removeRoleApproverMutex.RUnlock()
+	return len(fake.removeRoleApproverArgsForCall)
 }
 
-func (fake *FakeRBACService) IsAllowedCalls(stub func(context.Context, string, string) (bool, error)) {
-	fake.isAllowedMutex.Lock()
-	defer fake.isAllowedMutex.Unlock()
-	fake.IsAllowedStub = stub
+func (fake *FakeRBACService) RemoveRoleApproverCalls(stub func(context.Context, string, string) error) {
+	fake.removeRoleApproverMutex.Lock()
+	defer fake.removeRoleApproverMutex.Unlock()
+	fake.RemoveRoleApproverStub = stub
 }
 
-func (fake *FakeRBACService) IsAllowedArgsForCall(i int) (context.Context, string, string) {
-	fake.isAllowedMutex.RLock()
-	defer fake.isAllowedMutex.RUnlock()
-	argsForCall := fake.isAllowedArgsForCall[i]
+func (fake *FakeRBACService) RemoveRoleApproverArgsForCall(i int) (context.Context, string, string) {
+	fake.removeRoleApproverMutex.RLock()
+	defer fake.removeRoleApproverMutex.RUnlock()
+	argsForCall := fake.removeRoleApproverArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) IsAllowedReturns(result1 bool, result2 error) {
-	fake.isAllowedMutex.Lock()
-	defer fake.isAllowedMutex.Unlock()
-	fake.IsAllowedStub = nil
-	fake.isAllowedReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+func (fake *FakeRBACService) RemoveRoleApproverReturns(result1 error) {
+	fake.removeRoleApproverMutex.Lock()
+	defer fake.removeRoleApproverMutex.Unlock()
+	fake.RemoveRoleApproverStub = nil
+	fake.removeRoleApproverReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) IsAllowedReturnsOnCall(i int, result1 bool, result2 error) {
-	fake.isAllowedMutex.Lock()
-	defer fake.isAllowedMutex.Unlock()
-	fake.IsAllowedStub = nil
-	if fake.isAllowedReturnsOnCall == nil {
-		fake.isAllowedReturnsOnCall = make(map[int]struct {
-			result1 bool
-			result2 error
+func (fake *FakeRBACService) RemoveRoleApproverReturnsOnCall(i int, result1 error) {
+	fake.removeRoleApproverMutex.Lock()
+	defer fake.removeRoleApproverMutex.Unlock()
+	fake.RemoveRoleApproverStub = nil
+	if fake.removeRoleApproverReturnsOnCall == nil {
+		fake.removeRoleApproverReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.isAllowedReturnsOnCall[i] = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+	fake.removeRoleApproverReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) ListAccount(arg1 context.Context, arg2 internal.ListArgs) (internal.ListAccount, error) {
-	fake.listAccountMutex.Lock()
-	ret, specificReturn := fake.listAccountReturnsOnCall[len(fake.listAccountArgsForCall)]
-	fake.listAccountArgsForCall = append(fake.listAccountArgsForCall, struct {
+func (fake *FakeRBACService) ResetPassword(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.resetPasswordMutex.Lock()
+	ret, specificReturn := fake.resetPasswordReturnsOnCall[len(fake.resetPasswordArgsForCall)]
+	fake.resetPasswordArgsForCall = append(fake.resetPasswordArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.ListArgs
-	}{arg1, arg2})
-	stub := fake.ListAccountStub
-	fakeReturns := fake.listAccountReturns
-	fake.recordInvocation("ListAccount", []interface{}{arg1, arg2})
-	fake.listAccountMutex.Unlock()
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResetPasswordStub
+	fakeReturns := fake.resetPasswordReturns
+	fake.recordInvocation("ResetPassword", []interface{}{arg1, arg2, arg3})
+	fake.resetPasswordMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) ListAccountCallCount() int {
-	fake.listAccountMutex.RLock()
-	defer fake.listAccountMutex.RUnlock()
-	return len(fake.listAccountArgsForCall)
+func (fake *FakeRBACService) ResetPasswordCallCount() int {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	return len(fake.resetPasswordArgsForCall)
 }
 
-func (fake *FakeRBACService) ListAccountCalls(stub func(context.Context, internal.ListArgs) (internal.ListAccount, error)) {
-	fake.listAccountMutex.Lock()
-	defer fake.listAccountMutex.Unlock()
-	fake.ListAccountStub = stub
+func (fake *FakeRBACService) ResetPasswordCalls(stub func(context.Context, string, string) error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = stub
 }
 
-func (fake *FakeRBACService) ListAccountArgsForCall(i int) (context.Context, internal.ListArgs) {
-	fake.listAccountMutex.RLock()
-	defer fake.listAccountMutex.RUnlock()
-	argsForCall := fake.listAccountArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) ResetPasswordArgsForCall(i int) (context.Context, string, string) {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	argsForCall := fake.resetPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) ListAccountReturns(result1 internal.ListAccount, result2 error) {
-	fake.listAccountMutex.Lock()
-	defer fake.listAccountMutex.Unlock()
-	fake.ListAccountStub = nil
-	fake.listAccountReturns = struct {
-		result1 internal.ListAccount
-		result2 error
-	}{result1, result2}
+func (fake *FakeRBACService) ResetPasswordReturns(result1 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	fake.resetPasswordReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) ListAccountReturnsOnCall(i int, result1 internal.ListAccount, result2 error) {
-	fake.listAccountMutex.Lock()
-	defer fake.listAccountMutex.Unlock()
-	fake.ListAccountStub = nil
-	if fake.listAccountReturnsOnCall == nil {
-		fake.listAccountReturnsOnCall = make(map[int]struct {
-			result1 internal.ListAccount
-			result2 error
+func (fake *FakeRBACService) ResetPasswordReturnsOnCall(i int, result1 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	if fake.resetPasswordReturnsOnCall == nil {
+		fake.resetPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.listAccountReturnsOnCall[i] = struct {
-		result1 internal.ListAccount
-		result2 error
-	}{result1, result2}
+	fake.resetPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) ListAccountRole(arg1 context.Context, arg2 internal.ListArgs) (internal.ListAccountRole, error) {
-	fake.listAccountRoleMutex.Lock()
-	ret, specificReturn := fake.listAccountRoleReturnsOnCall[len(fake.listAccountRoleArgsForCall)]
-	fake.listAccountRoleArgsForCall = append(fake.listAccountRoleArgsForCall, struct {
+func (fake *FakeRBACService) RevokeAPIKey(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.revokeAPIKeyMutex.Lock()
+	ret, specificReturn := fake.revokeAPIKeyReturnsOnCall[len(fake.revokeAPIKeyArgsForCall)]
+	fake.revokeAPIKeyArgsForCall = append(fake.revokeAPIKeyArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.ListArgs
-	}{arg1, arg2})
-	stub := fake.ListAccountRoleStub
-	fakeReturns := fake.listAccountRoleReturns
-	fake.recordInvocation("ListAccountRole", []interface{}{arg1, arg2})
-	fake.listAccountRoleMutex.Unlock()
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RevokeAPIKeyStub
+	fakeReturns := fake.revokeAPIKeyReturns
+	fake.recordInvocation("RevokeAPIKey", []interface{}{arg1, arg2, arg3})
+	fake.revokeAPIKeyMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) ListAccountRoleCallCount() int {
-	fake.listAccountRoleMutex.RLock()
-	defer fake.listAccountRoleMutex.RUnlock()
-	return len(fake.listAccountRoleArgsForCall)
+func (fake *FakeRBACService) RevokeAPIKeyCallCount() int {
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	return len(fake.revokeAPIKeyArgsForCall)
 }
 
-func (fake *FakeRBACService) ListAccountRoleCalls(stub func(context.Context, internal.ListArgs) (internal.ListAccountRole, error)) {
-	fake.listAccountRoleMutex.Lock()
-	defer fake.listAccountRoleMutex.Unlock()
-	fake.ListAccountRoleStub = stub
+func (fake *FakeRBACService) RevokeAPIKeyCalls(stub func(context.Context, string, string) error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = stub
 }
 
-func (fake *FakeRBACService) ListAccountRoleArgsForCall(i int) (context.Context, internal.ListArgs) {
-	fake.listAccountRoleMutex.RLock()
-	defer fake.listAccountRoleMutex.RUnlock()
-	argsForCall := fake.listAccountRoleArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) RevokeAPIKeyArgsForCall(i int) (context.Context, string, string) {
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	argsForCall := fake.revokeAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) ListAccountRoleReturns(result1 internal.ListAccountRole, result2 error) {
-	fake.listAccountRoleMutex.Lock()
-	defer fake.listAccountRoleMutex.Unlock()
-	fake.ListAccountRoleStub = nil
-	fake.listAccountRoleReturns = struct {
-		result1 internal.ListAccountRole
-		result2 error
-	}{result1, result2}
+func (fake *FakeRBACService) RevokeAPIKeyReturns(result1 error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = nil
+	fake.revokeAPIKeyReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) ListAccountRoleReturnsOnCall(i int, result1 internal.ListAccountRole, result2 error) {
-	fake.listAccountRoleMutex.Lock()
-	defer fake.listAccountRoleMutex.Unlock()
-	fake.ListAccountRoleStub = nil
-	if fake.listAccountRoleReturnsOnCall == nil {
-		fake.listAccountRoleReturnsOnCall = make(map[int]struct {
-			result1 internal.ListAccountRole
-			result2 error
+func (fake *FakeRBACService) RevokeAPIKeyReturnsOnCall(i int, result1 error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = nil
+	if fake.revokeAPIKeyReturnsOnCall == nil {
+		fake.revokeAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.listAccountRoleReturnsOnCall[i] = struct {
-		result1 internal.ListAccountRole
-		result2 error
-	}{result1, result2}
+	fake.revokeAPIKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) ListHelpText(arg1 context.Context, arg2 internal.ListArgs) (internal.ListHelpText, error) {
-	fake.listHelpTextMutex.Lock()
-	ret, specificReturn := fake.listHelpTextReturnsOnCall[len(fake.listHelpTextArgsForCall)]
-	fake.listHelpTextArgsForCall = append(fake.listHelpTextArgsForCall, struct {
-		arg1 context.Context
-		arg2 internal.ListArgs
-	}{arg1, arg2})
-	stub := fake.ListHelpTextStub
-	fakeReturns := fake.listHelpTextReturns
-	fake.recordInvocation("ListHelpText", []interface{}{arg1, arg2})
-	fake.listHelpTextMutex.Unlock()
+func (fake *FakeRBACService) RevokeSessions(arg1 context.Context, arg2 string) error {
+	fake.revokeSessionsMutex.Lock()
+	ret, specificReturn := fake.revokeSessionsReturnsOnCall[len(fake.revokeSessionsArgsForCall)]
+	fake.revokeSessionsArgsForCall = append(fake.revokeSessionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RevokeSessionsStub
+	fakeReturns := fake.revokeSessionsReturns
+	fake.recordInvocation("RevokeSessions", []interface{}{arg1, arg2})
+	fake.revokeSessionsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) ListHelpTextCallCount() int {
-	fake.listHelpTextMutex.RLock()
-	defer fake.listHelpTextMutex.RUnlock()
-	return len(fake.listHelpTextArgsForCall)
+func (fake *FakeRBACService) RevokeSessionsCallCount() int {
+	fake.revokeSessionsMutex.RLock()
+	defer fake.revokeSessionsMutex.RUnlock()
+	return len(fake.revokeSessionsArgsForCall)
 }
 
-func (fake *FakeRBACService) ListHelpTextCalls(stub func(context.Context, internal.ListArgs) (internal.ListHelpText, error)) {
-	fake.listHelpTextMutex.Lock()
-	defer fake.listHelpTextMutex.Unlock()
-	fake.ListHelpTextStub = stub
+func (fake *FakeRBACService) RevokeSessionsCalls(stub func(context.Context, string) error) {
+	fake.revokeSessionsMutex.Lock()
+	defer fake.revokeSessionsMutex.Unlock()
+	fake.RevokeSessionsStub = stub
 }
 
-func (fake *FakeRBACService) ListHelpTextArgsForCall(i int) (context.Context, internal.ListArgs) {
-	fake.listHelpTextMutex.RLock()
-	defer fake.listHelpTextMutex.RUnlock()
-	argsForCall := fake.listHelpTextArgsForCall[i]
+func (fake *FakeRBACService) RevokeSessionsArgsForCall(i int) (context.Context, string) {
+	fake.revokeSessionsMutex.RLock()
+	defer fake.revokeSessionsMutex.RUnlock()
+	argsForCall := fake.revokeSessionsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) ListHelpTextReturns(result1 internal.ListHelpText, result2 error) {
-	fake.listHelpTextMutex.Lock()
-	defer fake.listHelpTextMutex.Unlock()
-	fake.ListHelpTextStub = nil
-	fake.listHelpTextReturns = struct {
-		result1 internal.ListHelpText
-		result2 error
-	}{result1, result2}
+func (fake *FakeRBACService) RevokeSessionsReturns(result1 error) {
+	fake.revokeSessionsMutex.Lock()
+	defer fake.revokeSessionsMutex.Unlock()
+	fake.RevokeSessionsStub = nil
+	fake.revokeSessionsReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) ListHelpTextReturnsOnCall(i int, result1 internal.ListHelpText, result2 error) {
-	fake.listHelpTextMutex.Lock()
-	defer fake.listHelpTextMutex.Unlock()
-	fake.ListHelpTextStub = nil
-	if fake.listHelpTextReturnsOnCall == nil {
-		fake.listHelpTextReturnsOnCall = make(map[int]struct {
-			result1 internal.ListHelpText
-			result2 error
+func (fake *FakeRBACService) RevokeSessionsReturnsOnCall(i int, result1 error) {
+	fake.revokeSessionsMutex.Lock()
+	defer fake.revokeSessionsMutex.Unlock()
+	fake.RevokeSessionsStub = nil
+	if fake.revokeSessionsReturnsOnCall == nil {
+		fake.revokeSessionsReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.listHelpTextReturnsOnCall[i] = struct {
-		result1 internal.ListHelpText
-		result2 error
-	}{result1, result2}
+	fake.revokeSessionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) ListMenu(arg1 context.Context, arg2 internal.ListArgs) (internal.ListMenu, error) {
-	fake.listMenuMutex.Lock()
-	ret, specificReturn := fake.listMenuReturnsOnCall[len(fake.listMenuArgsForCall)]
-	fake.listMenuArgsForCall = append(fake.listMenuArgsForCall, struct {
+func (fake *FakeRBACService) Role(arg1 context.Context, arg2 string) (internal.Roles, error) {
+	fake.roleMutex.Lock()
+	ret, specificReturn := fake.roleReturnsOnCall[len(fake.roleArgsForCall)]
+	fake.roleArgsForCall = append(fake.roleArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.ListArgs
+		arg2 string
 	}{arg1, arg2})
-	stub := fake.ListMenuStub
-	fakeReturns := fake.listMenuReturns
-	fake.recordInvocation("ListMenu", []interface{}{arg1, arg2})
-	fake.listMenuMutex.Unlock()
+	stub := fake.RoleStub
+	fakeReturns := fake.roleReturns
+	fake.recordInvocation("Role", []interface{}{arg1, arg2})
+	fake.roleMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -2467,62 +8512,62 @@ func (fake *FakeRBACService) ListMenu(arg1 context.Context, arg2 internal.ListAr
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) ListMenuCallCount() int {
-	fake.listMenuMutex.RLock()
-	defer fake.listMenuMutex.RUnlock()
-	return len(fake.listMenuArgsForCall)
+func (fake *FakeRBACService) RoleCallCount() int {
+	fake.roleMutex.RLock()
+	defer fake.roleMutex.RUnlock()
+	return len(fake.roleArgsForCall)
 }
 
-func (fake *FakeRBACService) ListMenuCalls(stub func(context.Context, internal.ListArgs) (internal.ListMenu, error)) {
-	fake.listMenuMutex.Lock()
-	defer fake.listMenuMutex.Unlock()
-	fake.ListMenuStub = stub
+func (fake *FakeRBACService) RoleCalls(stub func(context.Context, string) (internal.Roles, error)) {
+	fake.roleMutex.Lock()
+	defer fake.roleMutex.Unlock()
+	fake.RoleStub = stub
 }
 
-func (fake *FakeRBACService) ListMenuArgsForCall(i int) (context.Context, internal.ListArgs) {
-	fake.listMenuMutex.RLock()
-	defer fake.listMenuMutex.RUnlock()
-	argsForCall := fake.listMenuArgsForCall[i]
+func (fake *FakeRBACService) RoleArgsForCall(i int) (context.Context, string) {
+	fake.roleMutex.RLock()
+	defer fake.roleMutex.RUnlock()
+	argsForCall := fake.roleArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) ListMenuReturns(result1 internal.ListMenu, result2 error) {
-	fake.listMenuMutex.Lock()
-	defer fake.listMenuMutex.Unlock()
-	fake.ListMenuStub = nil
-	fake.listMenuReturns = struct {
-		result1 internal.ListMenu
+func (fake *FakeRBACService) RoleReturns(result1 internal.Roles, result2 error) {
+	fake.roleMutex.Lock()
+	defer fake.roleMutex.Unlock()
+	fake.RoleStub = nil
+	fake.roleReturns = struct {
+		result1 internal.Roles
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ListMenuReturnsOnCall(i int, result1 internal.ListMenu, result2 error) {
-	fake.listMenuMutex.Lock()
-	defer fake.listMenuMutex.Unlock()
-	fake.ListMenuStub = nil
-	if fake.listMenuReturnsOnCall == nil {
-		fake.listMenuReturnsOnCall = make(map[int]struct {
-			result1 internal.ListMenu
+func (fake *FakeRBACService) RoleReturnsOnCall(i int, result1 internal.Roles, result2 error) {
+	fake.roleMutex.Lock()
+	defer fake.roleMutex.Unlock()
+	fake.RoleStub = nil
+	if fake.roleReturnsOnCall == nil {
+		fake.roleReturnsOnCall = make(map[int]struct {
+			result1 internal.Roles
 			result2 error
 		})
 	}
-	fake.listMenuReturnsOnCall[i] = struct {
-		result1 internal.ListMenu
+	fake.roleReturnsOnCall[i] = struct {
+		result1 internal.Roles
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ListNavigation(arg1 context.Context, arg2 internal.ListArgs) (internal.ListNavigation, error) {
-	fake.listNavigationMutex.Lock()
-	ret, specificReturn := fake.listNavigationReturnsOnCall[len(fake.listNavigationArgsForCall)]
-	fake.listNavigationArgsForCall = append(fake.listNavigationArgsForCall, struct {
+func (fake *FakeRBACService) RoleApprovers(arg1 context.Context, arg2 string) ([]internal.Roles, error) {
+	fake.roleApproversMutex.Lock()
+	ret, specificReturn := fake.roleApproversReturnsOnCall[len(fake.roleApproversArgsForCall)]
+	fake.roleApproversArgsForCall = append(fake.roleApproversArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.ListArgs
+		arg2 string
 	}{arg1, arg2})
-	stub := fake.ListNavigationStub
-	fakeReturns := fake.listNavigationReturns
-	fake.recordInvocation("ListNavigation", []interface{}{arg1, arg2})
-	fake.listNavigationMutex.Unlock()
+	stub := fake.RoleApproversStub
+	fakeReturns := fake.roleApproversReturns
+	fake.recordInvocation("RoleApprovers", []interface{}{arg1, arg2})
+	fake.roleApproversMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -2532,64 +8577,63 @@ func (fake *FakeRBACService) ListNavigation(arg1 context.Context, arg2 internal.
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) ListNavigationCallCount() int {
-	fake.listNavigationMutex.RLock()
-	defer fake.listNavigationMutex.RUnlock()
-	return len(fake.listNavigationArgsForCall)
+func (fake *FakeRBACService) RoleApproversCallCount() int {
+	fake.roleApproversMutex.RLock()
+	defer fake.roleApproversMutex.RUnlock()
+	return len(fake.roleApproversArgsForCall)
 }
 
-func (fake *FakeRBACService) ListNavigationCalls(stub func(context.Context, internal.ListArgs) (internal.ListNavigation, error)) {
-	fake.listNavigationMutex.Lock()
-	defer fake.listNavigationMutex.Unlock()
-	fake.ListNavigationStub = stub
+func (fake *FakeRBACService) RoleApproversCalls(stub func(context.Context, string) ([]internal.Roles, error)) {
+	fake.roleApproversMutex.Lock()
+	defer fake.roleApproversMutex.Unlock()
+	fake.RoleApproversStub = stub
 }
 
-func (fake *FakeRBACService) ListNavigationArgsForCall(i int) (context.Context, internal.ListArgs) {
-	fake.listNavigationMutex.RLock()
-	defer fake.listNavigationMutex.RUnlock()
-	argsForCall := fake.listNavigationArgsForCall[i]
+func (fake *FakeRBACService) RoleApproversArgsForCall(i int) (context.Context, string) {
+	fake.roleApproversMutex.RLock()
+	defer fake.roleApproversMutex.RUnlock()
+	argsForCall := fake.roleApproversArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) ListNavigationReturns(result1 internal.ListNavigation, result2 error) {
-	fake.listNavigationMutex.Lock()
-	defer fake.listNavigationMutex.Unlock()
-	fake.ListNavigationStub = nil
-	fake.listNavigationReturns = struct {
-		result1 internal.ListNavigation
+func (fake *FakeRBACService) RoleApproversReturns(result1 []internal.Roles, result2 error) {
+	fake.roleApproversMutex.Lock()
+	defer fake.roleApproversMutex.Unlock()
+	fake.RoleApproversStub = nil
+	fake.roleApproversReturns = struct {
+		result1 []internal.Roles
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ListNavigationReturnsOnCall(i int, result1 internal.ListNavigation, result2 error) {
-	fake.listNavigationMutex.Lock()
-	defer fake.listNavigationMutex.Unlock()
-	fake.ListNavigationStub = nil
-	if fake.listNavigationReturnsOnCall == nil {
-		fake.listNavigationReturnsOnCall = make(map[int]struct {
-			result1 internal.ListNavigation
+func (fake *FakeRBACService) RoleApproversReturnsOnCall(i int, result1 []internal.Roles, result2 error) {
+	fake.roleApproversMutex.Lock()
+	defer fake.roleApproversMutex.Unlock()
+	fake.RoleApproversStub = nil
+	if fake.roleApproversReturnsOnCall == nil {
+		fake.roleApproversReturnsOnCall = make(map[int]struct {
+			result1 []internal.Roles
 			result2 error
 		})
 	}
-	fake.listNavigationReturnsOnCall[i] = struct {
-		result1 internal.ListNavigation
+	fake.roleApproversReturnsOnCall[i] = struct {
+		result1 []internal.Roles
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ListRole(arg1 context.Context, arg2 internal.ListArgs) (internal.ListRole, error) {
-	fake.listRoleMutex.Lock()
-	ret, specificReturn := fake.listRoleReturnsOnCall[len(fake.listRoleArgsForCall)]
-	fake.listRoleArgsForCall = append(fake.listRoleArgsForCall, struct {
+func (fake *FakeRBACService) RoleConflicts(arg1 context.Context) ([]internal.RoleConflict, error) {
+	fake.roleConflictsMutex.Lock()
+	ret, specificReturn := fake.roleConflictsReturnsOnCall[len(fake.roleConflictsArgsForCall)]
+	fake.roleConflictsArgsForCall = append(fake.roleConflictsArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.ListArgs
-	}{arg1, arg2})
-	stub := fake.ListRoleStub
-	fakeReturns := fake.listRoleReturns
-	fake.recordInvocation("ListRole", []interface{}{arg1, arg2})
-	fake.listRoleMutex.Unlock()
+	}{arg1})
+	stub := fake.RoleConflictsStub
+	fakeReturns := fake.roleConflictsReturns
+	fake.recordInvocation("RoleConflicts", []interface{}{arg1})
+	fake.roleConflictsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2597,62 +8641,62 @@ func (fake *FakeRBACService) ListRole(arg1 context.Context, arg2 internal.ListAr
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) ListRoleCallCount() int {
-	fake.listRoleMutex.RLock()
-	defer fake.listRoleMutex.RUnlock()
-	return len(fake.listRoleArgsForCall)
+func (fake *FakeRBACService) RoleConflictsCallCount() int {
+	fake.roleConflictsMutex.RLock()
+	defer fake.roleConflictsMutex.RUnlock()
+	return len(fake.roleConflictsArgsForCall)
 }
 
-func (fake *FakeRBACService) ListRoleCalls(stub func(context.Context, internal.ListArgs) (internal.ListRole, error)) {
-	fake.listRoleMutex.Lock()
-	defer fake.listRoleMutex.Unlock()
-	fake.ListRoleStub = stub
+func (fake *FakeRBACService) RoleConflictsCalls(stub func(context.Context) ([]internal.RoleConflict, error)) {
+	fake.roleConflictsMutex.Lock()
+	defer fake.roleConflictsMutex.Unlock()
+	fake.RoleConflictsStub = stub
 }
 
-func (fake *FakeRBACService) ListRoleArgsForCall(i int) (context.Context, internal.ListArgs) {
-	fake.listRoleMutex.RLock()
-	defer fake.listRoleMutex.RUnlock()
-	argsForCall := fake.listRoleArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) RoleConflictsArgsForCall(i int) context.Context {
+	fake.roleConflictsMutex.RLock()
+	defer fake.roleConflictsMutex.RUnlock()
+	argsForCall := fake.roleConflictsArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeRBACService) ListRoleReturns(result1 internal.ListRole, result2 error) {
-	fake.listRoleMutex.Lock()
-	defer fake.listRoleMutex.Unlock()
-	fake.ListRoleStub = nil
-	fake.listRoleReturns = struct {
-		result1 internal.ListRole
+func (fake *FakeRBACService) RoleConflictsReturns(result1 []internal.RoleConflict, result2 error) {
+	fake.roleConflictsMutex.Lock()
+	defer fake.roleConflictsMutex.Unlock()
+	fake.RoleConflictsStub = nil
+	fake.roleConflictsReturns = struct {
+		result1 []internal.RoleConflict
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ListRoleReturnsOnCall(i int, result1 internal.ListRole, result2 error) {
-	fake.listRoleMutex.Lock()
-	defer fake.listRoleMutex.Unlock()
-	fake.ListRoleStub = nil
-	if fake.listRoleReturnsOnCall == nil {
-		fake.listRoleReturnsOnCall = make(map[int]struct {
-			result1 internal.ListRole
+func (fake *FakeRBACService) RoleConflictsReturnsOnCall(i int, result1 []internal.RoleConflict, result2 error) {
+	fake.roleConflictsMutex.Lock()
+	defer fake.roleConflictsMutex.Unlock()
+	fake.RoleConflictsStub = nil
+	if fake.roleConflictsReturnsOnCall == nil {
+		fake.roleConflictsReturnsOnCall = make(map[int]struct {
+			result1 []internal.RoleConflict
 			result2 error
 		})
 	}
-	fake.listRoleReturnsOnCall[i] = struct {
-		result1 internal.ListRole
+	fake.roleConflictsReturnsOnCall[i] = struct {
+		result1 []internal.RoleConflict
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ListRoleTask(arg1 context.Context, arg2 internal.ListArgs) (internal.ListRoleTask, error) {
-	fake.listRoleTaskMutex.Lock()
-	ret, specificReturn := fake.listRoleTaskReturnsOnCall[len(fake.listRoleTaskArgsForCall)]
-	fake.listRoleTaskArgsForCall = append(fake.listRoleTaskArgsForCall, struct {
+func (fake *FakeRBACService) RoleTask(arg1 context.Context, arg2 string) (internal.RoleTasks, error) {
+	fake.roleTaskMutex.Lock()
+	ret, specificReturn := fake.roleTaskReturnsOnCall[len(fake.roleTaskArgsForCall)]
+	fake.roleTaskArgsForCall = append(fake.roleTaskArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.ListArgs
+		arg2 string
 	}{arg1, arg2})
-	stub := fake.ListRoleTaskStub
-	fakeReturns := fake.listRoleTaskReturns
-	fake.recordInvocation("ListRoleTask", []interface{}{arg1, arg2})
-	fake.listRoleTaskMutex.Unlock()
+	stub := fake.RoleTaskStub
+	fakeReturns := fake.roleTaskReturns
+	fake.recordInvocation("RoleTask", []interface{}{arg1, arg2})
+	fake.roleTaskMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -2662,62 +8706,62 @@ func (fake *FakeRBACService) ListRoleTask(arg1 context.Context, arg2 internal.Li
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) ListRoleTaskCallCount() int {
-	fake.listRoleTaskMutex.RLock()
-	defer fake.listRoleTaskMutex.RUnlock()
-	return len(fake.listRoleTaskArgsForCall)
+func (fake *FakeRBACService) RoleTaskCallCount() int {
+	fake.roleTaskMutex.RLock()
+	defer fake.roleTaskMutex.RUnlock()
+	return len(fake.roleTaskArgsForCall)
 }
 
-func (fake *FakeRBACService) ListRoleTaskCalls(stub func(context.Context, internal.ListArgs) (internal.ListRoleTask, error)) {
-	fake.listRoleTaskMutex.Lock()
-	defer fake.listRoleTaskMutex.Unlock()
-	fake.ListRoleTaskStub = stub
+func (fake *FakeRBACService) RoleTaskCalls(stub func(context.Context, string) (internal.RoleTasks, error)) {
+	fake.roleTaskMutex.Lock()
+	defer fake.roleTaskMutex.Unlock()
+	fake.RoleTaskStub = stub
 }
 
-func (fake *FakeRBACService) ListRoleTaskArgsForCall(i int) (context.Context, internal.ListArgs) {
-	fake.listRoleTaskMutex.RLock()
-	defer fake.listRoleTaskMutex.RUnlock()
-	argsForCall := fake.listRoleTaskArgsForCall[i]
+func (fake *FakeRBACService) RoleTaskArgsForCall(i int) (context.Context, string) {
+	fake.roleTaskMutex.RLock()
+	defer fake.roleTaskMutex.RUnlock()
+	argsForCall := fake.roleTaskArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) ListRoleTaskReturns(result1 internal.ListRoleTask, result2 error) {
-	fake.listRoleTaskMutex.Lock()
-	defer fake.listRoleTaskMutex.Unlock()
-	fake.ListRoleTaskStub = nil
-	fake.listRoleTaskReturns = struct {
-		result1 internal.ListRoleTask
+func (fake *FakeRBACService) RoleTaskReturns(result1 internal.RoleTasks, result2 error) {
+	fake.roleTaskMutex.Lock()
+	defer fake.roleTaskMutex.Unlock()
+	fake.RoleTaskStub = nil
+	fake.roleTaskReturns = struct {
+		result1 internal.RoleTasks
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ListRoleTaskReturnsOnCall(i int, result1 internal.ListRoleTask, result2 error) {
-	fake.listRoleTaskMutex.Lock()
-	defer fake.listRoleTaskMutex.Unlock()
-	fake.ListRoleTaskStub = nil
-	if fake.listRoleTaskReturnsOnCall == nil {
-		fake.listRoleTaskReturnsOnCall = make(map[int]struct {
-			result1 internal.ListRoleTask
+func (fake *FakeRBACService) RoleTaskReturnsOnCall(i int, result1 internal.RoleTasks, result2 error) {
+	fake.roleTaskMutex.Lock()
+	defer fake.roleTaskMutex.Unlock()
+	fake.RoleTaskStub = nil
+	if fake.roleTaskReturnsOnCall == nil {
+		fake.roleTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.RoleTasks
 			result2 error
 		})
 	}
-	fake.listRoleTaskReturnsOnCall[i] = struct {
-		result1 internal.ListRoleTask
+	fake.roleTaskReturnsOnCall[i] = struct {
+		result1 internal.RoleTasks
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ListTask(arg1 context.Context, arg2 internal.ListArgs) (internal.ListTask, error) {
-	fake.listTaskMutex.Lock()
-	ret, specificReturn := fake.listTaskReturnsOnCall[len(fake.listTaskArgsForCall)]
-	fake.listTaskArgsForCall = append(fake.listTaskArgsForCall, struct {
+func (fake *FakeRBACService) RoleTaskByRole(arg1 context.Context, arg2 string) (internal.RoleTaskByRole, error) {
+	fake.roleTaskByRoleMutex.Lock()
+	ret, specificReturn := fake.roleTaskByRoleReturnsOnCall[len(fake.roleTaskByRoleArgsForCall)]
+	fake.roleTaskByRoleArgsForCall = append(fake.roleTaskByRoleArgsForCall, struct {
 		arg1 context.Context
-		arg2 internal.ListArgs
+		arg2 string
 	}{arg1, arg2})
-	stub := fake.ListTaskStub
-	fakeReturns := fake.listTaskReturns
-	fake.recordInvocation("ListTask", []interface{}{arg1, arg2})
-	fake.listTaskMutex.Unlock()
+	stub := fake.RoleTaskByRoleStub
+	fakeReturns := fake.roleTaskByRoleReturns
+	fake.recordInvocation("RoleTaskByRole", []interface{}{arg1, arg2})
+	fake.roleTaskByRoleMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -2727,63 +8771,63 @@ func (fake *FakeRBACService) ListTask(arg1 context.Context, arg2 internal.ListAr
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) ListTaskCallCount() int {
-	fake.listTaskMutex.RLock()
-	defer fake.listTaskMutex.RUnlock()
-	return len(fake.listTaskArgsForCall)
+func (fake *FakeRBACService) RoleTaskByRoleCallCount() int {
+	fake.roleTaskByRoleMutex.RLock()
+	defer fake.roleTaskByRoleMutex.RUnlock()
+	return len(fake.roleTaskByRoleArgsForCall)
 }
 
-func (fake *FakeRBACService) ListTaskCalls(stub func(context.Context, internal.ListArgs) (internal.ListTask, error)) {
-	fake.listTaskMutex.Lock()
-	defer fake.listTaskMutex.Unlock()
-	fake.ListTaskStub = stub
+func (fake *FakeRBACService) RoleTaskByRoleCalls(stub func(context.Context, string) (internal.RoleTaskByRole, error)) {
+	fake.roleTaskByRoleMutex.Lock()
+	defer fake.roleTaskByRoleMutex.Unlock()
+	fake.RoleTaskByRoleStub = stub
 }
 
-func (fake *FakeRBACService) ListTaskArgsForCall(i int) (context.Context, internal.ListArgs) {
-	fake.listTaskMutex.RLock()
-	defer fake.listTaskMutex.RUnlock()
-	argsForCall := fake.listTaskArgsForCall[i]
+func (fake *FakeRBACService) RoleTaskByRoleArgsForCall(i int) (context.Context, string) {
+	fake.roleTaskByRoleMutex.RLock()
+	defer fake.roleTaskByRoleMutex.RUnlock()
+	argsForCall := fake.roleTaskByRoleArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) ListTaskReturns(result1 internal.ListTask, result2 error) {
-	fake.listTaskMutex.Lock()
-	defer fake.listTaskMutex.Unlock()
-	fake.ListTaskStub = nil
-	fake.listTaskReturns = struct {
-		result1 internal.ListTask
+func (fake *FakeRBACService) RoleTaskByRoleReturns(result1 internal.RoleTaskByRole, result2 error) {
+	fake.roleTaskByRoleMutex.Lock()
+	defer fake.roleTaskByRoleMutex.Unlock()
+	fake.RoleTaskByRoleStub = nil
+	fake.roleTaskByRoleReturns = struct {
+		result1 internal.RoleTaskByRole
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) ListTaskReturnsOnCall(i int, result1 internal.ListTask, result2 error) {
-	fake.listTaskMutex.Lock()
-	defer fake.listTaskMutex.Unlock()
-	fake.ListTaskStub = nil
-	if fake.listTaskReturnsOnCall == nil {
-		fake.listTaskReturnsOnCall = make(map[int]struct {
-			result1 internal.ListTask
+func (fake *FakeRBACService) RoleTaskByRoleReturnsOnCall(i int, result1 internal.RoleTaskByRole, result2 error) {
+	fake.roleTaskByRoleMutex.Lock()
+	defer fake.roleTaskByRoleMutex.Unlock()
+	fake.RoleTaskByRoleStub = nil
+	if fake.roleTaskByRoleReturnsOnCall == nil {
+		fake.roleTaskByRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.RoleTaskByRole
 			result2 error
 		})
 	}
-	fake.listTaskReturnsOnCall[i] = struct {
-		result1 internal.ListTask
+	fake.roleTaskByRoleReturnsOnCall[i] = struct {
+		result1 internal.RoleTaskByRole
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) Login(arg1 context.Context, arg2 string, arg3 string) error {
-	fake.loginMutex.Lock()
-	ret, specificReturn := fake.loginReturnsOnCall[len(fake.loginArgsForCall)]
-	fake.loginArgsForCall = append(fake.loginArgsForCall, struct {
+func (fake *FakeRBACService) SetEmergencyRole(arg1 context.Context, arg2 string, arg3 bool) error {
+	fake.setEmergencyRoleMutex.Lock()
+	ret, specificReturn := fake.setEmergencyRoleReturnsOnCall[len(fake.setEmergencyRoleArgsForCall)]
+	fake.setEmergencyRoleArgsForCall = append(fake.setEmergencyRoleArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-		arg3 string
+		arg3 bool
 	}{arg1, arg2, arg3})
-	stub := fake.LoginStub
-	fakeReturns := fake.loginReturns
-	fake.recordInvocation("Login", []interface{}{arg1, arg2, arg3})
-	fake.loginMutex.Unlock()
+	stub := fake.SetEmergencyRoleStub
+	fakeReturns := fake.setEmergencyRoleReturns
+	fake.recordInvocation("SetEmergencyRole", []interface{}{arg1, arg2, arg3})
+	fake.setEmergencyRoleMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -2793,60 +8837,62 @@ func (fake *FakeRBACService) Login(arg1 context.Context, arg2 string, arg3 strin
 	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) LoginCallCount() int {
-	fake.loginMutex.RLock()
-	defer fake.loginMutex.RUnlock()
-	return len(fake.loginArgsForCall)
+func (fake *FakeRBACService) SetEmergencyRoleCallCount() int {
+	fake.setEmergencyRoleMutex.RLock()
+	defer fake.setEmergencyRoleMutex.RUnlock()
+	return len(fake.setEmergencyRoleArgsForCall)
 }
 
-func (fake *FakeRBACService) LoginCalls(stub func(context.Context, string, string) error) {
-	fake.loginMutex.Lock()
-	defer fake.loginMutex.Unlock()
-	fake.LoginStub = stub
+func (fake *FakeRBACService) SetEmergencyRoleCalls(stub func(context.Context, string, bool) error) {
+	fake.setEmergencyRoleMutex.Lock()
+	defer fake.setEmergencyRoleMutex.Unlock()
+	fake.SetEmergencyRoleStub = stub
 }
 
-func (fake *FakeRBACService) LoginArgsForCall(i int) (context.Context, string, string) {
-	fake.loginMutex.RLock()
-	defer fake.loginMutex.RUnlock()
-	argsForCall := fake.loginArgsForCall[i]
+func (fake *FakeRBACService) SetEmergencyRoleArgsForCall(i int) (context.Context, string, bool) {
+	fake.setEmergencyRoleMutex.RLock()
+	defer fake.setEmergencyRoleMutex.RUnlock()
+	argsForCall := fake.setEmergencyRoleArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) LoginReturns(result1 error) {
-	fake.loginMutex.Lock()
-	defer fake.loginMutex.Unlock()
-	fake.LoginStub = nil
-	fake.loginReturns = struct {
+func (fake *FakeRBACService) SetEmergencyRoleReturns(result1 error) {
+	fake.setEmergencyRoleMutex.Lock()
+	defer fake.setEmergencyRoleMutex.Unlock()
+	fake.SetEmergencyRoleStub = nil
+	fake.setEmergencyRoleReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) LoginReturnsOnCall(i int, result1 error) {
-	fake.loginMutex.Lock()
-	defer fake.loginMutex.Unlock()
-	fake.LoginStub = nil
-	if fake.loginReturnsOnCall == nil {
-		fake.loginReturnsOnCall = make(map[int]struct {
+func (fake *FakeRBACService) SetEmergencyRoleReturnsOnCall(i int, result1 error) {
+	fake.setEmergencyRoleMutex.Lock()
+	defer fake.setEmergencyRoleMutex.Unlock()
+	fake.SetEmergencyRoleStub = nil
+	if fake.setEmergencyRoleReturnsOnCall == nil {
+		fake.setEmergencyRoleReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.loginReturnsOnCall[i] = struct {
+	fake.setEmergencyRoleReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) Logout(arg1 context.Context) error {
-	fake.logoutMutex.Lock()
-	ret, specificReturn := fake.logoutReturnsOnCall[len(fake.logoutArgsForCall)]
-	fake.logoutArgsForCall = append(fake.logoutArgsForCall, struct {
+func (fake *FakeRBACService) SetRoleMFARequired(arg1 context.Context, arg2 string, arg3 bool) error {
+	fake.setRoleMFARequiredMutex.Lock()
+	ret, specificReturn := fake.setRoleMFARequiredReturnsOnCall[len(fake.setRoleMFARequiredArgsForCall)]
+	fake.setRoleMFARequiredArgsForCall = append(fake.setRoleMFARequiredArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.LogoutStub
-	fakeReturns := fake.logoutReturns
-	fake.recordInvocation("Logout", []interface{}{arg1})
-	fake.logoutMutex.Unlock()
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.SetRoleMFARequiredStub
+	fakeReturns := fake.setRoleMFARequiredReturns
+	fake.recordInvocation("SetRoleMFARequired", []interface{}{arg1, arg2, arg3})
+	fake.setRoleMFARequiredMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -2854,61 +8900,62 @@ func (fake *FakeRBACService) Logout(arg1 context.Context) error {
 	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) LogoutCallCount() int {
-	fake.logoutMutex.RLock()
-	defer fake.logoutMutex.RUnlock()
-	return len(fake.logoutArgsForCall)
+func (fake *FakeRBACService) SetRoleMFARequiredCallCount() int {
+	fake.setRoleMFARequiredMutex.RLock()
+	defer fake.setRoleMFARequiredMutex.RUnlock()
+	return len(fake.setRoleMFARequiredArgsForCall)
 }
 
-func (fake *FakeRBACService) LogoutCalls(stub func(context.Context) error) {
-	fake.logoutMutex.Lock()
-	defer fake.logoutMutex.Unlock()
-	fake.LogoutStub = stub
+func (fake *FakeRBACService) SetRoleMFARequiredCalls(stub func(context.Context, string, bool) error) {
+	fake.setRoleMFARequiredMutex.Lock()
+	defer fake.setRoleMFARequiredMutex.Unlock()
+	fake.SetRoleMFARequiredStub = stub
 }
 
-func (fake *FakeRBACService) LogoutArgsForCall(i int) context.Context {
-	fake.logoutMutex.RLock()
-	defer fake.logoutMutex.RUnlock()
-	argsForCall := fake.logoutArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeRBACService) SetRoleMFARequiredArgsForCall(i int) (context.Context, string, bool) {
+	fake.setRoleMFARequiredMutex.RLock()
+	defer fake.setRoleMFARequiredMutex.RUnlock()
+	argsForCall := fake.setRoleMFARequiredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) LogoutReturns(result1 error) {
-	fake.logoutMutex.Lock()
-	defer fake.logoutMutex.Unlock()
-	fake.LogoutStub = nil
-	fake.logoutReturns = struct {
+func (fake *FakeRBACService) SetRoleMFARequiredReturns(result1 error) {
+	fake.setRoleMFARequiredMutex.Lock()
+	defer fake.setRoleMFARequiredMutex.Unlock()
+	fake.SetRoleMFARequiredStub = nil
+	fake.setRoleMFARequiredReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) LogoutReturnsOnCall(i int, result1 error) {
-	fake.logoutMutex.Lock()
-	defer fake.logoutMutex.Unlock()
-	fake.LogoutStub = nil
-	if fake.logoutReturnsOnCall == nil {
-		fake.logoutReturnsOnCall = make(map[int]struct {
+func (fake *FakeRBACService) SetRoleMFARequiredReturnsOnCall(i int, result1 error) {
+	fake.setRoleMFARequiredMutex.Lock()
+	defer fake.setRoleMFARequiredMutex.Unlock()
+	fake.SetRoleMFARequiredStub = nil
+	if fake.setRoleMFARequiredReturnsOnCall == nil {
+		fake.setRoleMFARequiredReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.logoutReturnsOnCall[i] = struct {
+	fake.setRoleMFARequiredReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeRBACService) Menu(arg1 context.Context, arg2 string) (internal.Menu, error) {
-	fake.menuMutex.Lock()
-	ret, specificReturn := fake.menuReturnsOnCall[len(fake.menuArgsForCall)]
-	fake.menuArgsForCall = append(fake.menuArgsForCall, struct {
+func (fake *FakeRBACService) SwitchTenant(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.switchTenantMutex.Lock()
+	ret, specificReturn := fake.switchTenantReturnsOnCall[len(fake.switchTenantArgsForCall)]
+	fake.switchTenantArgsForCall = append(fake.switchTenantArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-	}{arg1, arg2})
-	stub := fake.MenuStub
-	fakeReturns := fake.menuReturns
-	fake.recordInvocation("Menu", []interface{}{arg1, arg2})
-	fake.menuMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SwitchTenantStub
+	fakeReturns := fake.switchTenantReturns
+	fake.recordInvocation("SwitchTenant", []interface{}{arg1, arg2, arg3})
+	fake.switchTenantMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2916,62 +8963,62 @@ func (fake *FakeRBACService) Menu(arg1 context.Context, arg2 string) (internal.M
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) MenuCallCount() int {
-	fake.menuMutex.RLock()
-	defer fake.menuMutex.RUnlock()
-	return len(fake.menuArgsForCall)
+func (fake *FakeRBACService) SwitchTenantCallCount() int {
+	fake.switchTenantMutex.RLock()
+	defer fake.switchTenantMutex.RUnlock()
+	return len(fake.switchTenantArgsForCall)
 }
 
-func (fake *FakeRBACService) MenuCalls(stub func(context.Context, string) (internal.Menu, error)) {
-	fake.menuMutex.Lock()
-	defer fake.menuMutex.Unlock()
-	fake.MenuStub = stub
+func (fake *FakeRBACService) SwitchTenantCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.switchTenantMutex.Lock()
+	defer fake.switchTenantMutex.Unlock()
+	fake.SwitchTenantStub = stub
 }
 
-func (fake *FakeRBACService) MenuArgsForCall(i int) (context.Context, string) {
-	fake.menuMutex.RLock()
-	defer fake.menuMutex.RUnlock()
-	argsForCall := fake.menuArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) SwitchTenantArgsForCall(i int) (context.Context, string, string) {
+	fake.switchTenantMutex.RLock()
+	defer fake.switchTenantMutex.RUnlock()
+	argsForCall := fake.switchTenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeRBACService) MenuReturns(result1 internal.Menu, result2 error) {
-	fake.menuMutex.Lock()
-	defer fake.menuMutex.Unlock()
-	fake.MenuStub = nil
-	fake.menuReturns = struct {
-		result1 internal.Menu
+func (fake *FakeRBACService) SwitchTenantReturns(result1 string, result2 error) {
+	fake.switchTenantMutex.Lock()
+	defer fake.switchTenantMutex.Unlock()
+	fake.SwitchTenantStub = nil
+	fake.switchTenantReturns = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) MenuReturnsOnCall(i int, result1 internal.Menu, result2 error) {
-	fake.menuMutex.Lock()
-	defer fake.menuMutex.Unlock()
-	fake.MenuStub = nil
-	if fake.menuReturnsOnCall == nil {
-		fake.menuReturnsOnCall = make(map[int]struct {
-			result1 internal.Menu
+func (fake *FakeRBACService) SwitchTenantReturnsOnCall(i int, result1 string, result2 error) {
+	fake.switchTenantMutex.Lock()
+	defer fake.switchTenantMutex.Unlock()
+	fake.SwitchTenantStub = nil
+	if fake.switchTenantReturnsOnCall == nil {
+		fake.switchTenantReturnsOnCall = make(map[int]struct {
+			result1 string
 			result2 error
 		})
 	}
-	fake.menuReturnsOnCall[i] = struct {
-		result1 internal.Menu
+	fake.switchTenantReturnsOnCall[i] = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) Navigation(arg1 context.Context, arg2 string) (internal.Navigation, error) {
-	fake.navigationMutex.Lock()
-	ret, specificReturn := fake.navigationReturnsOnCall[len(fake.navigationArgsForCall)]
-	fake.navigationArgsForCall = append(fake.navigationArgsForCall, struct {
+func (fake *FakeRBACService) Task(arg1 context.Context, arg2 string) (internal.Tasks, error) {
+	fake.taskMutex.Lock()
+	ret, specificReturn := fake.taskReturnsOnCall[len(fake.taskArgsForCall)]
+	fake.taskArgsForCall = append(fake.taskArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.NavigationStub
-	fakeReturns := fake.navigationReturns
-	fake.recordInvocation("Navigation", []interface{}{arg1, arg2})
-	fake.navigationMutex.Unlock()
+	stub := fake.TaskStub
+	fakeReturns := fake.taskReturns
+	fake.recordInvocation("Task", []interface{}{arg1, arg2})
+	fake.taskMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -2981,62 +9028,62 @@ func (fake *FakeRBACService) Navigation(arg1 context.Context, arg2 string) (inte
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) NavigationCallCount() int {
-	fake.navigationMutex.RLock()
-	defer fake.navigationMutex.RUnlock()
-	return len(fake.navigationArgsForCall)
+func (fake *FakeRBACService) TaskCallCount() int {
+	fake.taskMutex.RLock()
+	defer fake.taskMutex.RUnlock()
+	return len(fake.taskArgsForCall)
 }
 
-func (fake *FakeRBACService) NavigationCalls(stub func(context.Context, string) (internal.Navigation, error)) {
-	fake.navigationMutex.Lock()
-	defer fake.navigationMutex.Unlock()
-	fake.NavigationStub = stub
+func (fake *FakeRBACService) TaskCalls(stub func(context.Context, string) (internal.Tasks, error)) {
+	fake.taskMutex.Lock()
+	defer fake.taskMutex.Unlock()
+	fake.TaskStub = stub
 }
 
-func (fake *FakeRBACService) NavigationArgsForCall(i int) (context.Context, string) {
-	fake.navigationMutex.RLock()
-	defer fake.navigationMutex.RUnlock()
-	argsForCall := fake.navigationArgsForCall[i]
+func (fake *FakeRBACService) TaskArgsForCall(i int) (context.Context, string) {
+	fake.taskMutex.RLock()
+	defer fake.taskMutex.RUnlock()
+	argsForCall := fake.taskArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) NavigationReturns(result1 internal.Navigation, result2 error) {
-	fake.navigationMutex.Lock()
-	defer fake.navigationMutex.Unlock()
-	fake.NavigationStub = nil
-	fake.navigationReturns = struct {
-		result1 internal.Navigation
+func (fake *FakeRBACService) TaskReturns(result1 internal.Tasks, result2 error) {
+	fake.taskMutex.Lock()
+	defer fake.taskMutex.Unlock()
+	fake.TaskStub = nil
+	fake.taskReturns = struct {
+		result1 internal.Tasks
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) NavigationReturnsOnCall(i int, result1 internal.Navigation, result2 error) {
-	fake.navigationMutex.Lock()
-	defer fake.navigationMutex.Unlock()
-	fake.NavigationStub = nil
-	if fake.navigationReturnsOnCall == nil {
-		fake.navigationReturnsOnCall = make(map[int]struct {
-			result1 internal.Navigation
+func (fake *FakeRBACService) TaskReturnsOnCall(i int, result1 internal.Tasks, result2 error) {
+	fake.taskMutex.Lock()
+	defer fake.taskMutex.Unlock()
+	fake.TaskStub = nil
+	if fake.taskReturnsOnCall == nil {
+		fake.taskReturnsOnCall = make(map[int]struct {
+			result1 internal.Tasks
 			result2 error
 		})
 	}
-	fake.navigationReturnsOnCall[i] = struct {
-		result1 internal.Navigation
+	fake.taskReturnsOnCall[i] = struct {
+		result1 internal.Tasks
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) Role(arg1 context.Context, arg2 string) (internal.Roles, error) {
-	fake.roleMutex.Lock()
-	ret, specificReturn := fake.roleReturnsOnCall[len(fake.roleArgsForCall)]
-	fake.roleArgsForCall = append(fake.roleArgsForCall, struct {
+func (fake *FakeRBACService) TaskHolders(arg1 context.Context, arg2 string) (internal.TaskHolders, error) {
+	fake.taskHoldersMutex.Lock()
+	ret, specificReturn := fake.taskHoldersReturnsOnCall[len(fake.taskHoldersArgsForCall)]
+	fake.taskHoldersArgsForCall = append(fake.taskHoldersArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.RoleStub
-	fakeReturns := fake.roleReturns
-	fake.recordInvocation("Role", []interface{}{arg1, arg2})
-	fake.roleMutex.Unlock()
+	stub := fake.TaskHoldersStub
+	fakeReturns := fake.taskHoldersReturns
+	fake.recordInvocation("TaskHolders", []interface{}{arg1, arg2})
+	fake.taskHoldersMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -3046,64 +9093,63 @@ func (fake *FakeRBACService) Role(arg1 context.Context, arg2 string) (internal.R
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) RoleCallCount() int {
-	fake.roleMutex.RLock()
-	defer fake.roleMutex.RUnlock()
-	return len(fake.roleArgsForCall)
+func (fake *FakeRBACService) TaskHoldersCallCount() int {
+	fake.taskHoldersMutex.RLock()
+	defer fake.taskHoldersMutex.RUnlock()
+	return len(fake.taskHoldersArgsForCall)
 }
 
-func (fake *FakeRBACService) RoleCalls(stub func(context.Context, string) (internal.Roles, error)) {
-	fake.roleMutex.Lock()
-	defer fake.roleMutex.Unlock()
-	fake.RoleStub = stub
+func (fake *FakeRBACService) TaskHoldersCalls(stub func(context.Context, string) (internal.TaskHolders, error)) {
+	fake.taskHoldersMutex.Lock()
+	defer fake.taskHoldersMutex.Unlock()
+	fake.TaskHoldersStub = stub
 }
 
-func (fake *FakeRBACService) RoleArgsForCall(i int) (context.Context, string) {
-	fake.roleMutex.RLock()
-	defer fake.roleMutex.RUnlock()
-	argsForCall := fake.roleArgsForCall[i]
+func (fake *FakeRBACService) TaskHoldersArgsForCall(i int) (context.Context, string) {
+	fake.taskHoldersMutex.RLock()
+	defer fake.taskHoldersMutex.RUnlock()
+	argsForCall := fake.taskHoldersArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) RoleReturns(result1 internal.Roles, result2 error) {
-	fake.roleMutex.Lock()
-	defer fake.roleMutex.Unlock()
-	fake.RoleStub = nil
-	fake.roleReturns = struct {
-		result1 internal.Roles
+func (fake *FakeRBACService) TaskHoldersReturns(result1 internal.TaskHolders, result2 error) {
+	fake.taskHoldersMutex.Lock()
+	defer fake.taskHoldersMutex.Unlock()
+	fake.TaskHoldersStub = nil
+	fake.taskHoldersReturns = struct {
+		result1 internal.TaskHolders
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) RoleReturnsOnCall(i int, result1 internal.Roles, result2 error) {
-	fake.roleMutex.Lock()
-	defer fake.roleMutex.Unlock()
-	fake.RoleStub = nil
-	if fake.roleReturnsOnCall == nil {
-		fake.roleReturnsOnCall = make(map[int]struct {
-			result1 internal.Roles
+func (fake *FakeRBACService) TaskHoldersReturnsOnCall(i int, result1 internal.TaskHolders, result2 error) {
+	fake.taskHoldersMutex.Lock()
+	defer fake.taskHoldersMutex.Unlock()
+	fake.TaskHoldersStub = nil
+	if fake.taskHoldersReturnsOnCall == nil {
+		fake.taskHoldersReturnsOnCall = make(map[int]struct {
+			result1 internal.TaskHolders
 			result2 error
 		})
 	}
-	fake.roleReturnsOnCall[i] = struct {
-		result1 internal.Roles
+	fake.taskHoldersReturnsOnCall[i] = struct {
+		result1 internal.TaskHolders
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) RoleTask(arg1 context.Context, arg2 string) (internal.RoleTasks, error) {
-	fake.roleTaskMutex.Lock()
-	ret, specificReturn := fake.roleTaskReturnsOnCall[len(fake.roleTaskArgsForCall)]
-	fake.roleTaskArgsForCall = append(fake.roleTaskArgsForCall, struct {
+func (fake *FakeRBACService) Tenants(arg1 context.Context) ([]internal.Tenant, error) {
+	fake.tenantsMutex.Lock()
+	ret, specificReturn := fake.tenantsReturnsOnCall[len(fake.tenantsArgsForCall)]
+	fake.tenantsArgsForCall = append(fake.tenantsArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.RoleTaskStub
-	fakeReturns := fake.roleTaskReturns
-	fake.recordInvocation("RoleTask", []interface{}{arg1, arg2})
-	fake.roleTaskMutex.Unlock()
+	}{arg1})
+	stub := fake.TenantsStub
+	fakeReturns := fake.tenantsReturns
+	fake.recordInvocation("Tenants", []interface{}{arg1})
+	fake.tenantsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3111,64 +9157,63 @@ func (fake *FakeRBACService) RoleTask(arg1 context.Context, arg2 string) (intern
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) RoleTaskCallCount() int {
-	fake.roleTaskMutex.RLock()
-	defer fake.roleTaskMutex.RUnlock()
-	return len(fake.roleTaskArgsForCall)
+func (fake *FakeRBACService) TenantsCallCount() int {
+	fake.tenantsMutex.RLock()
+	defer fake.tenantsMutex.RUnlock()
+	return len(fake.tenantsArgsForCall)
 }
 
-func (fake *FakeRBACService) RoleTaskCalls(stub func(context.Context, string) (internal.RoleTasks, error)) {
-	fake.roleTaskMutex.Lock()
-	defer fake.roleTaskMutex.Unlock()
-	fake.RoleTaskStub = stub
+func (fake *FakeRBACService) TenantsCalls(stub func(context.Context) ([]internal.Tenant, error)) {
+	fake.tenantsMutex.Lock()
+	defer fake.tenantsMutex.Unlock()
+	fake.TenantsStub = stub
 }
 
-func (fake *FakeRBACService) RoleTaskArgsForCall(i int) (context.Context, string) {
-	fake.roleTaskMutex.RLock()
-	defer fake.roleTaskMutex.RUnlock()
-	argsForCall := fake.roleTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) TenantsArgsForCall(i int) context.Context {
+	fake.tenantsMutex.RLock()
+	defer fake.tenantsMutex.RUnlock()
+	argsForCall := fake.tenantsArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeRBACService) RoleTaskReturns(result1 internal.RoleTasks, result2 error) {
-	fake.roleTaskMutex.Lock()
-	defer fake.roleTaskMutex.Unlock()
-	fake.RoleTaskStub = nil
-	fake.roleTaskReturns = struct {
-		result1 internal.RoleTasks
+func (fake *FakeRBACService) TenantsReturns(result1 []internal.Tenant, result2 error) {
+	fake.tenantsMutex.Lock()
+	defer fake.tenantsMutex.Unlock()
+	fake.TenantsStub = nil
+	fake.tenantsReturns = struct {
+		result1 []internal.Tenant
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) RoleTaskReturnsOnCall(i int, result1 internal.RoleTasks, result2 error) {
-	fake.roleTaskMutex.Lock()
-	defer fake.roleTaskMutex.Unlock()
-	fake.RoleTaskStub = nil
-	if fake.roleTaskReturnsOnCall == nil {
-		fake.roleTaskReturnsOnCall = make(map[int]struct {
-			result1 internal.RoleTasks
+func (fake *FakeRBACService) TenantsReturnsOnCall(i int, result1 []internal.Tenant, result2 error) {
+	fake.tenantsMutex.Lock()
+	defer fake.tenantsMutex.Unlock()
+	fake.TenantsStub = nil
+	if fake.tenantsReturnsOnCall == nil {
+		fake.tenantsReturnsOnCall = make(map[int]struct {
+			result1 []internal.Tenant
 			result2 error
 		})
 	}
-	fake.roleTaskReturnsOnCall[i] = struct {
-		result1 internal.RoleTasks
+	fake.tenantsReturnsOnCall[i] = struct {
+		result1 []internal.Tenant
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) RoleTaskByRole(arg1 context.Context, arg2 string) (internal.RoleTaskByRole, error) {
-	fake.roleTaskByRoleMutex.Lock()
-	ret, specificReturn := fake.roleTaskByRoleReturnsOnCall[len(fake.roleTaskByRoleArgsForCall)]
-	fake.roleTaskByRoleArgsForCall = append(fake.roleTaskByRoleArgsForCall, struct {
+func (fake *FakeRBACService) TokenKeys(arg1 context.Context) ([]tokenmaker.KeyInfo, error) {
+	fake.tokenKeysMutex.Lock()
+	ret, specificReturn := fake.tokenKeysReturnsOnCall[len(fake.tokenKeysArgsForCall)]
+	fake.tokenKeysArgsForCall = append(fake.tokenKeysArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.RoleTaskByRoleStub
-	fakeReturns := fake.roleTaskByRoleReturns
-	fake.recordInvocation("RoleTaskByRole", []interface{}{arg1, arg2})
-	fake.roleTaskByRoleMutex.Unlock()
+	}{arg1})
+	stub := fake.TokenKeysStub
+	fakeReturns := fake.tokenKeysReturns
+	fake.recordInvocation("TokenKeys", []interface{}{arg1})
+	fake.tokenKeysMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3176,114 +9221,111 @@ func (fake *FakeRBACService) RoleTaskByRole(arg1 context.Context, arg2 string) (
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeRBACService) RoleTaskByRoleCallCount() int {
-	fake.roleTaskByRoleMutex.RLock()
-	defer fake.roleTaskByRoleMutex.RUnlock()
-	return len(fake.roleTaskByRoleArgsForCall)
+func (fake *FakeRBACService) TokenKeysCallCount() int {
+	fake.tokenKeysMutex.RLock()
+	defer fake.tokenKeysMutex.RUnlock()
+	return len(fake.tokenKeysArgsForCall)
 }
 
-func (fake *FakeRBACService) RoleTaskByRoleCalls(stub func(context.Context, string) (internal.RoleTaskByRole, error)) {
-	fake.roleTaskByRoleMutex.Lock()
-	defer fake.roleTaskByRoleMutex.Unlock()
-	fake.RoleTaskByRoleStub = stub
+func (fake *FakeRBACService) TokenKeysCalls(stub func(context.Context) ([]tokenmaker.KeyInfo, error)) {
+	fake.tokenKeysMutex.Lock()
+	defer fake.tokenKeysMutex.Unlock()
+	fake.TokenKeysStub = stub
 }
 
-func (fake *FakeRBACService) RoleTaskByRoleArgsForCall(i int) (context.Context, string) {
-	fake.roleTaskByRoleMutex.RLock()
-	defer fake.roleTaskByRoleMutex.RUnlock()
-	argsForCall := fake.roleTaskByRoleArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeRBACService) TokenKeysArgsForCall(i int) context.Context {
+	fake.tokenKeysMutex.RLock()
+	defer fake.tokenKeysMutex.RUnlock()
+	argsForCall := fake.tokenKeysArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeRBACService) RoleTaskByRoleReturns(result1 internal.RoleTaskByRole, result2 error) {
-	fake.roleTaskByRoleMutex.Lock()
-	defer fake.roleTaskByRoleMutex.Unlock()
-	fake.RoleTaskByRoleStub = nil
-	fake.roleTaskByRoleReturns = struct {
-		result1 internal.RoleTaskByRole
+func (fake *FakeRBACService) TokenKeysReturns(result1 []tokenmaker.KeyInfo, result2 error) {
+	fake.tokenKeysMutex.Lock()
+	defer fake.tokenKeysMutex.Unlock()
+	fake.TokenKeysStub = nil
+	fake.tokenKeysReturns = struct {
+		result1 []tokenmaker.KeyInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) RoleTaskByRoleReturnsOnCall(i int, result1 internal.RoleTaskByRole, result2 error) {
-	fake.roleTaskByRoleMutex.Lock()
-	defer fake.roleTaskByRoleMutex.Unlock()
-	fake.RoleTaskByRoleStub = nil
-	if fake.roleTaskByRoleReturnsOnCall == nil {
-		fake.roleTaskByRoleReturnsOnCall = make(map[int]struct {
-			result1 internal.RoleTaskByRole
+func (fake *FakeRBACService) TokenKeysReturnsOnCall(i int, result1 []tokenmaker.KeyInfo, result2 error) {
+	fake.tokenKeysMutex.Lock()
+	defer fake.tokenKeysMutex.Unlock()
+	fake.TokenKeysStub = nil
+	if fake.tokenKeysReturnsOnCall == nil {
+		fake.tokenKeysReturnsOnCall = make(map[int]struct {
+			result1 []tokenmaker.KeyInfo
 			result2 error
 		})
 	}
-	fake.roleTaskByRoleReturnsOnCall[i] = struct {
-		result1 internal.RoleTaskByRole
+	fake.tokenKeysReturnsOnCall[i] = struct {
+		result1 []tokenmaker.KeyInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeRBACService) Task(arg1 context.Context, arg2 string) (internal.Tasks, error) {
-	fake.taskMutex.Lock()
-	ret, specificReturn := fake.taskReturnsOnCall[len(fake.taskArgsForCall)]
-	fake.taskArgsForCall = append(fake.taskArgsForCall, struct {
+func (fake *FakeRBACService) UnblockAccount(arg1 context.Context, arg2 string) error {
+	fake.unblockAccountMutex.Lock()
+	ret, specificReturn := fake.unblockAccountReturnsOnCall[len(fake.unblockAccountArgsForCall)]
+	fake.unblockAccountArgsForCall = append(fake.unblockAccountArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.TaskStub
-	fakeReturns := fake.taskReturns
-	fake.recordInvocation("Task", []interface{}{arg1, arg2})
-	fake.taskMutex.Unlock()
+	stub := fake.UnblockAccountStub
+	fakeReturns := fake.unblockAccountReturns
+	fake.recordInvocation("UnblockAccount", []interface{}{arg1, arg2})
+	fake.unblockAccountMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeRBACService) TaskCallCount() int {
-	fake.taskMutex.RLock()
-	defer fake.taskMutex.RUnlock()
-	return len(fake.taskArgsForCall)
+func (fake *FakeRBACService) UnblockAccountCallCount() int {
+	fake.unblockAccountMutex.RLock()
+	defer fake.unblockAccountMutex.RUnlock()
+	return len(fake.unblockAccountArgsForCall)
 }
 
-func (fake *FakeRBACService) TaskCalls(stub func(context.Context, string) (internal.Tasks, error)) {
-	fake.taskMutex.Lock()
-	defer fake.taskMutex.Unlock()
-	fake.TaskStub = stub
+func (fake *FakeRBACService) UnblockAccountCalls(stub func(context.Context, string) error) {
+	fake.unblockAccountMutex.Lock()
+	defer fake.unblockAccountMutex.Unlock()
+	fake.UnblockAccountStub = stub
 }
 
-func (fake *FakeRBACService) TaskArgsForCall(i int) (context.Context, string) {
-	fake.taskMutex.RLock()
-	defer fake.taskMutex.RUnlock()
-	argsForCall := fake.taskArgsForCall[i]
+func (fake *FakeRBACService) UnblockAccountArgsForCall(i int) (context.Context, string) {
+	fake.unblockAccountMutex.RLock()
+	defer fake.unblockAccountMutex.RUnlock()
+	argsForCall := fake.unblockAccountArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeRBACService) TaskReturns(result1 internal.Tasks, result2 error) {
-	fake.taskMutex.Lock()
-	defer fake.taskMutex.Unlock()
-	fake.TaskStub = nil
-	fake.taskReturns = struct {
-		result1 internal.Tasks
-		result2 error
-	}{result1, result2}
+func (fake *FakeRBACService) UnblockAccountReturns(result1 error) {
+	fake.unblockAccountMutex.Lock()
+	defer fake.unblockAccountMutex.Unlock()
+	fake.UnblockAccountStub = nil
+	fake.unblockAccountReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRBACService) TaskReturnsOnCall(i int, result1 internal.Tasks, result2 error) {
-	fake.taskMutex.Lock()
-	defer fake.taskMutex.Unlock()
-	fake.TaskStub = nil
-	if fake.taskReturnsOnCall == nil {
-		fake.taskReturnsOnCall = make(map[int]struct {
-			result1 internal.Tasks
-			result2 error
+func (fake *FakeRBACService) UnblockAccountReturnsOnCall(i int, result1 error) {
+	fake.unblockAccountMutex.Lock()
+	defer fake.unblockAccountMutex.Unlock()
+	fake.UnblockAccountStub = nil
+	if fake.unblockAccountReturnsOnCall == nil {
+		fake.unblockAccountReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.taskReturnsOnCall[i] = struct {
-		result1 internal.Tasks
-		result2 error
-	}{result1, result2}
+	fake.unblockAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
 func (fake *FakeRBACService) UpdateAccountRole(arg1 context.Context, arg2 internal.AccountRoles) error {
@@ -3348,6 +9390,130 @@ func (fake *FakeRBACService) UpdateAccountRoleReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
+func (fake *FakeRBACService) UpdateExclusiveRoleSet(arg1 context.Context, arg2 internal.ExclusiveRoleSet) error {
+	fake.updateExclusiveRoleSetMutex.Lock()
+	ret, specificReturn := fake.updateExclusiveRoleSetReturnsOnCall[len(fake.updateExclusiveRoleSetArgsForCall)]
+	fake.updateExclusiveRoleSetArgsForCall = append(fake.updateExclusiveRoleSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ExclusiveRoleSet
+	}{arg1, arg2})
+	stub := fake.UpdateExclusiveRoleSetStub
+	fakeReturns := fake.updateExclusiveRoleSetReturns
+	fake.recordInvocation("UpdateExclusiveRoleSet", []interface{}{arg1, arg2})
+	fake.updateExclusiveRoleSetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) UpdateExclusiveRoleSetCallCount() int {
+	fake.updateExclusiveRoleSetMutex.RLock()
+	defer fake.updateExclusiveRoleSetMutex.RUnlock()
+	return len(fake.updateExclusiveRoleSetArgsForCall)
+}
+
+func (fake *FakeRBACService) UpdateExclusiveRoleSetCalls(stub func(context.Context, internal.ExclusiveRoleSet) error) {
+	fake.updateExclusiveRoleSetMutex.Lock()
+	defer fake.updateExclusiveRoleSetMutex.Unlock()
+	fake.UpdateExclusiveRoleSetStub = stub
+}
+
+func (fake *FakeRBACService) UpdateExclusiveRoleSetArgsForCall(i int) (context.Context, internal.ExclusiveRoleSet) {
+	fake.updateExclusiveRoleSetMutex.RLock()
+	defer fake.updateExclusiveRoleSetMutex.RUnlock()
+	argsForCall := fake.updateExclusiveRoleSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) UpdateExclusiveRoleSetReturns(result1 error) {
+	fake.updateExclusiveRoleSetMutex.Lock()
+	defer fake.updateExclusiveRoleSetMutex.Unlock()
+	fake.UpdateExclusiveRoleSetStub = nil
+	fake.updateExclusiveRoleSetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) UpdateExclusiveRoleSetReturnsOnCall(i int, result1 error) {
+	fake.updateExclusiveRoleSetMutex.Lock()
+	defer fake.updateExclusiveRoleSetMutex.Unlock()
+	fake.UpdateExclusiveRoleSetStub = nil
+	if fake.updateExclusiveRoleSetReturnsOnCall == nil {
+		fake.updateExclusiveRoleSetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateExclusiveRoleSetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) UpdateGroup(arg1 context.Context, arg2 internal.Group) error {
+	fake.updateGroupMutex.Lock()
+	ret, specificReturn := fake.updateGroupReturnsOnCall[len(fake.updateGroupArgsForCall)]
+	fake.updateGroupArgsForCall = append(fake.updateGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Group
+	}{arg1, arg2})
+	stub := fake.UpdateGroupStub
+	fakeReturns := fake.updateGroupReturns
+	fake.recordInvocation("UpdateGroup", []interface{}{arg1, arg2})
+	fake.updateGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACService) UpdateGroupCallCount() int {
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
+	return len(fake.updateGroupArgsForCall)
+}
+
+func (fake *FakeRBACService) UpdateGroupCalls(stub func(context.Context, internal.Group) error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = stub
+}
+
+func (fake *FakeRBACService) UpdateGroupArgsForCall(i int) (context.Context, internal.Group) {
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
+	argsForCall := fake.updateGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACService) UpdateGroupReturns(result1 error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = nil
+	fake.updateGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACService) UpdateGroupReturnsOnCall(i int, result1 error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = nil
+	if fake.updateGroupReturnsOnCall == nil {
+		fake.updateGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRBACService) UpdateHelpText(arg1 context.Context, arg2 internal.HelpText) error {
 	fake.updateHelpTextMutex.Lock()
 	ret, specificReturn := fake.updateHelpTextReturnsOnCall[len(fake.updateHelpTextArgsForCall)]
@@ -3782,6 +9948,76 @@ func (fake *FakeRBACService) UpdateTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRBACService) VerifyMFA(arg1 context.Context, arg2 string, arg3 string, arg4 string) (string, []string, error) {
+	fake.verifyMFAMutex.Lock()
+	ret, specificReturn := fake.verifyMFAReturnsOnCall[len(fake.verifyMFAArgsForCall)]
+	fake.verifyMFAArgsForCall = append(fake.verifyMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.VerifyMFAStub
+	fakeReturns := fake.verifyMFAReturns
+	fake.recordInvocation("VerifyMFA", []interface{}{arg1, arg2, arg3, arg4})
+	fake.verifyMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRBACService) VerifyMFACallCount() int {
+	fake.verifyMFAMutex.RLock()
+	defer fake.verifyMFAMutex.RUnlock()
+	return len(fake.verifyMFAArgsForCall)
+}
+
+func (fake *FakeRBACService) VerifyMFACalls(stub func(context.Context, string, string, string) (string, []string, error)) {
+	fake.verifyMFAMutex.Lock()
+	defer fake.verifyMFAMutex.Unlock()
+	fake.VerifyMFAStub = stub
+}
+
+func (fake *FakeRBACService) VerifyMFAArgsForCall(i int) (context.Context, string, string, string) {
+	fake.verifyMFAMutex.RLock()
+	defer fake.verifyMFAMutex.RUnlock()
+	argsForCall := fake.verifyMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACService) VerifyMFAReturns(result1 string, result2 []string, result3 error) {
+	fake.verifyMFAMutex.Lock()
+	defer fake.verifyMFAMutex.Unlock()
+	fake.VerifyMFAStub = nil
+	fake.verifyMFAReturns = struct {
+		result1 string
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRBACService) VerifyMFAReturnsOnCall(i int, result1 string, result2 []string, result3 error) {
+	fake.verifyMFAMutex.Lock()
+	defer fake.verifyMFAMutex.Unlock()
+	fake.VerifyMFAStub = nil
+	if fake.verifyMFAReturnsOnCall == nil {
+		fake.verifyMFAReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 []string
+			result3 error
+		})
+	}
+	fake.verifyMFAReturnsOnCall[i] = struct {
+		result1 string
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeRBACService) VerifyToken(arg1 string) (*tokenmaker.Payload, error) {
 	fake.verifyTokenMutex.Lock()
 	ret, specificReturn := fake.verifyTokenReturnsOnCall[len(fake.verifyTokenArgsForCall)]
@@ -3849,40 +10085,106 @@ func (fake *FakeRBACService) VerifyTokenReturnsOnCall(i int, result1 *tokenmaker
 func (fake *FakeRBACService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aPIKeysMutex.RLock()
+	defer fake.aPIKeysMutex.RUnlock()
+	fake.accessRequestMutex.RLock()
+	defer fake.accessRequestMutex.RUnlock()
+	fake.accessRequestsMutex.RLock()
+	defer fake.accessRequestsMutex.RUnlock()
 	fake.accountMutex.RLock()
 	defer fake.accountMutex.RUnlock()
+	fake.accountAccessRequestsMutex.RLock()
+	defer fake.accountAccessRequestsMutex.RUnlock()
 	fake.accountByIDMutex.RLock()
 	defer fake.accountByIDMutex.RUnlock()
+	fake.accountLockoutMutex.RLock()
+	defer fake.accountLockoutMutex.RUnlock()
 	fake.accountRoleMutex.RLock()
 	defer fake.accountRoleMutex.RUnlock()
 	fake.accountRoleByAccountMutex.RLock()
 	defer fake.accountRoleByAccountMutex.RUnlock()
 	fake.accountRoleByRoleMutex.RLock()
 	defer fake.accountRoleByRoleMutex.RUnlock()
+	fake.accountRoleTasksMutex.RLock()
+	defer fake.accountRoleTasksMutex.RUnlock()
+	fake.accountTenantsMutex.RLock()
+	defer fake.accountTenantsMutex.RUnlock()
+	fake.activeTenantMutex.RLock()
+	defer fake.activeTenantMutex.RUnlock()
+	fake.addAccountTenantMutex.RLock()
+	defer fake.addAccountTenantMutex.RUnlock()
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	fake.addGroupRoleMutex.RLock()
+	defer fake.addGroupRoleMutex.RUnlock()
+	fake.addMemberGroupMutex.RLock()
+	defer fake.addMemberGroupMutex.RUnlock()
+	fake.addRoleApproverMutex.RLock()
+	defer fake.addRoleApproverMutex.RUnlock()
+	fake.approveAccessRequestMutex.RLock()
+	defer fake.approveAccessRequestMutex.RUnlock()
+	fake.authenticateAPIKeyMutex.RLock()
+	defer fake.authenticateAPIKeyMutex.RUnlock()
+	fake.blockAccountMutex.RLock()
+	defer fake.blockAccountMutex.RUnlock()
+	fake.breakGlassMutex.RLock()
+	defer fake.breakGlassMutex.RUnlock()
+	fake.breakGlassActivationsMutex.RLock()
+	defer fake.breakGlassActivationsMutex.RUnlock()
 	fake.changePasswordMutex.RLock()
 	defer fake.changePasswordMutex.RUnlock()
+	fake.checkPermissionsMutex.RLock()
+	defer fake.checkPermissionsMutex.RUnlock()
+	fake.clearAccountLockoutMutex.RLock()
+	defer fake.clearAccountLockoutMutex.RUnlock()
+	fake.clearIPLockoutMutex.RLock()
+	defer fake.clearIPLockoutMutex.RUnlock()
+	fake.confirmMFAMutex.RLock()
+	defer fake.confirmMFAMutex.RUnlock()
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	fake.createAccessRequestMutex.RLock()
+	defer fake.createAccessRequestMutex.RUnlock()
 	fake.createAccountMutex.RLock()
 	defer fake.createAccountMutex.RUnlock()
 	fake.createAccountRoleMutex.RLock()
 	defer fake.createAccountRoleMutex.RUnlock()
+	fake.createExclusiveRoleSetMutex.RLock()
+	defer fake.createExclusiveRoleSetMutex.RUnlock()
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
 	fake.createHelpTextMutex.RLock()
 	defer fake.createHelpTextMutex.RUnlock()
+	fake.createMFAChallengeMutex.RLock()
+	defer fake.createMFAChallengeMutex.RUnlock()
 	fake.createMenuMutex.RLock()
 	defer fake.createMenuMutex.RUnlock()
 	fake.createNavigationMutex.RLock()
 	defer fake.createNavigationMutex.RUnlock()
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
 	fake.createRoleMutex.RLock()
 	defer fake.createRoleMutex.RUnlock()
+	fake.createRoleInheritanceMutex.RLock()
+	defer fake.createRoleInheritanceMutex.RUnlock()
 	fake.createRoleTaskMutex.RLock()
 	defer fake.createRoleTaskMutex.RUnlock()
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
 	fake.createTaskMutex.RLock()
 	defer fake.createTaskMutex.RUnlock()
+	fake.createTenantMutex.RLock()
+	defer fake.createTenantMutex.RUnlock()
 	fake.createTokenMutex.RLock()
 	defer fake.createTokenMutex.RUnlock()
 	fake.deleteAccountMutex.RLock()
 	defer fake.deleteAccountMutex.RUnlock()
 	fake.deleteAccountRoleMutex.RLock()
 	defer fake.deleteAccountRoleMutex.RUnlock()
+	fake.deleteExclusiveRoleSetMutex.RLock()
+	defer fake.deleteExclusiveRoleSetMutex.RUnlock()
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
 	fake.deleteHelpTextMutex.RLock()
 	defer fake.deleteHelpTextMutex.RUnlock()
 	fake.deleteMenuMutex.RLock()
@@ -3891,14 +10193,54 @@ func (fake *FakeRBACService) Invocations() map[string][][]interface{} {
 	defer fake.deleteNavigationMutex.RUnlock()
 	fake.deleteRoleMutex.RLock()
 	defer fake.deleteRoleMutex.RUnlock()
+	fake.deleteRoleInheritanceMutex.RLock()
+	defer fake.deleteRoleInheritanceMutex.RUnlock()
 	fake.deleteRoleTaskMutex.RLock()
 	defer fake.deleteRoleTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
+	fake.disableMFAMutex.RLock()
+	defer fake.disableMFAMutex.RUnlock()
+	fake.disableOwnMFAMutex.RLock()
+	defer fake.disableOwnMFAMutex.RUnlock()
+	fake.effectivePermissionsMutex.RLock()
+	defer fake.effectivePermissionsMutex.RUnlock()
+	fake.emergencyRolesMutex.RLock()
+	defer fake.emergencyRolesMutex.RUnlock()
+	fake.enrollMFAMutex.RLock()
+	defer fake.enrollMFAMutex.RUnlock()
+	fake.enrollMFAWithChallengeMutex.RLock()
+	defer fake.enrollMFAWithChallengeMutex.RUnlock()
+	fake.exclusiveRoleSetMutex.RLock()
+	defer fake.exclusiveRoleSetMutex.RUnlock()
+	fake.exclusiveRoleSetsMutex.RLock()
+	defer fake.exclusiveRoleSetsMutex.RUnlock()
+	fake.explainPermissionMutex.RLock()
+	defer fake.explainPermissionMutex.RUnlock()
+	fake.forgotPasswordMutex.RLock()
+	defer fake.forgotPasswordMutex.RUnlock()
+	fake.groupMutex.RLock()
+	defer fake.groupMutex.RUnlock()
+	fake.groupMembersMutex.RLock()
+	defer fake.groupMembersMutex.RUnlock()
+	fake.groupRolesMutex.RLock()
+	defer fake.groupRolesMutex.RUnlock()
+	fake.groupsMutex.RLock()
+	defer fake.groupsMutex.RUnlock()
 	fake.helpTextMutex.RLock()
 	defer fake.helpTextMutex.RUnlock()
+	fake.iPLockoutMutex.RLock()
+	defer fake.iPLockoutMutex.RUnlock()
+	fake.inheritedRolesMutex.RLock()
+	defer fake.inheritedRolesMutex.RUnlock()
 	fake.isAllowedMutex.RLock()
 	defer fake.isAllowedMutex.RUnlock()
+	fake.isAllowedOnMutex.RLock()
+	defer fake.isAllowedOnMutex.RUnlock()
+	fake.isPermissionStaleMutex.RLock()
+	defer fake.isPermissionStaleMutex.RUnlock()
+	fake.isTokenRevokedMutex.RLock()
+	defer fake.isTokenRevokedMutex.RUnlock()
 	fake.listAccountMutex.RLock()
 	defer fake.listAccountMutex.RUnlock()
 	fake.listAccountRoleMutex.RLock()
@@ -3919,20 +10261,72 @@ func (fake *FakeRBACService) Invocations() map[string][][]interface{} {
 	defer fake.loginMutex.RUnlock()
 	fake.logoutMutex.RLock()
 	defer fake.logoutMutex.RUnlock()
+	fake.mFAStatusMutex.RLock()
+	defer fake.mFAStatusMutex.RUnlock()
+	fake.memberGroupsMutex.RLock()
+	defer fake.memberGroupsMutex.RUnlock()
 	fake.menuMutex.RLock()
 	defer fake.menuMutex.RUnlock()
 	fake.navigationMutex.RLock()
 	defer fake.navigationMutex.RUnlock()
+	fake.pendingApprovalsMutex.RLock()
+	defer fake.pendingApprovalsMutex.RUnlock()
+	fake.publicKeysMutex.RLock()
+	defer fake.publicKeysMutex.RUnlock()
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	fake.rejectAccessRequestMutex.RLock()
+	defer fake.rejectAccessRequestMutex.RUnlock()
+	fake.reloadTokenKeysMutex.RLock()
+	defer fake.reloadTokenKeysMutex.RUnlock()
+	fake.removeAccountTenantMutex.RLock()
+	defer fake.removeAccountTenantMutex.RUnlock()
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	fake.removeGroupRoleMutex.RLock()
+	defer fake.removeGroupRoleMutex.RUnlock()
+	fake.removeMemberGroupMutex.RLock()
+	defer fake.removeMemberGroupMutex.RUnlock()
+	fake.removeRoleApproverMutex.RLock()
+	defer fake.removeRoleApproverMutex.RUnlock()
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	fake.revokeSessionsMutex.RLock()
+	defer fake.revokeSessionsMutex.RUnlock()
 	fake.roleMutex.RLock()
 	defer fake.roleMutex.RUnlock()
+	fake.roleApproversMutex.RLock()
+	defer fake.roleApproversMutex.RUnlock()
+	fake.roleConflictsMutex.RLock()
+	defer fake.roleConflictsMutex.RUnlock()
 	fake.roleTaskMutex.RLock()
 	defer fake.roleTaskMutex.RUnlock()
 	fake.roleTaskByRoleMutex.RLock()
 	defer fake.roleTaskByRoleMutex.RUnlock()
+	fake.setEmergencyRoleMutex.RLock()
+	defer fake.setEmergencyRoleMutex.RUnlock()
+	fake.setRoleMFARequiredMutex.RLock()
+	defer fake.setRoleMFARequiredMutex.RUnlock()
+	fake.switchTenantMutex.RLock()
+	defer fake.switchTenantMutex.RUnlock()
 	fake.taskMutex.RLock()
 	defer fake.taskMutex.RUnlock()
+	fake.taskHoldersMutex.RLock()
+	defer fake.taskHoldersMutex.RUnlock()
+	fake.tenantsMutex.RLock()
+	defer fake.tenantsMutex.RUnlock()
+	fake.tokenKeysMutex.RLock()
+	defer fake.tokenKeysMutex.RUnlock()
+	fake.unblockAccountMutex.RLock()
+	defer fake.unblockAccountMutex.RUnlock()
 	fake.updateAccountRoleMutex.RLock()
 	defer fake.updateAccountRoleMutex.RUnlock()
+	fake.updateExclusiveRoleSetMutex.RLock()
+	defer fake.updateExclusiveRoleSetMutex.RUnlock()
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
 	fake.updateHelpTextMutex.RLock()
 	defer fake.updateHelpTextMutex.RUnlock()
 	fake.updateMenuMutex.RLock()
@@ -3947,6 +10341,8 @@ func (fake *FakeRBACService) Invocations() map[string][][]interface{} {
 	defer fake.updateRoleTaskMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
+	fake.verifyMFAMutex.RLock()
+	defer fake.verifyMFAMutex.RUnlock()
 	fake.verifyTokenMutex.RLock()
 	defer fake.verifyTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
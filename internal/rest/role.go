@@ -10,11 +10,27 @@ import (
 )
 
 type Role struct {
-	Id        string    `json:"id"`
-	Role      string    `json:"role"`
-	Task      []Task    `json:"tasks"`
-	CreatedAt time.Time `json:"created_at"`
+	Id   string `json:"id"`
+	Role string `json:"role"`
+	Task []Task `json:"tasks"`
+	// DeniedTask are the tasks denied to the accounts holding the role, whatever their other roles grant.
+	DeniedTask []Task    `json:"denied_tasks,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// deniedTasks returns the tasks denied by the role.
+func deniedTasks(rt internal.RoleTaskByRole) []Task {
+	var tasks []Task
+	for _, value := range rt.DeniedTasks {
+		tasks = append(tasks, Task{
+			Id:        value.Id,
+			Task:      value.Task,
+			CreatedAt: value.CreatedAt,
+		})
+	}
+	return tasks
 }
+
 type CreateRoleRequest struct {
 	Role string `json:"role"`
 }
@@ -55,7 +71,8 @@ type GetRoleResponse struct {
 
 func (rb *RBACHandler) role(w http.ResponseWriter, r *http.Request) {
 	authusername := r.Header.Get("username")
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_ROLE)
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_ROLE, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -64,7 +81,6 @@ func (rb *RBACHandler) role(w http.ResponseWriter, r *http.Request) {
 		renderErrorResponse(r.Context(), w, "user is not allowed", err)
 		return
 	}
-	roleId := mux.Vars(r)["roleId"]
 	// role, err := rb.svc.Role(r.Context(), roleId)
 	// if err != nil {
 	// 	renderErrorResponse(r.Context(), w, "error getting the role", err)
@@ -86,10 +102,11 @@ func (rb *RBACHandler) role(w http.ResponseWriter, r *http.Request) {
 	}
 	renderResponse(w, &GetRoleResponse{
 		Role: Role{
-			Id:        rt.Role.Id,
-			Role:      rt.Role.Role,
-			Task:      tasks,
-			CreatedAt: rt.Role.CreatedAt,
+			Id:         rt.Role.Id,
+			Role:       rt.Role.Role,
+			Task:       tasks,
+			DeniedTask: deniedTasks(rt),
+			CreatedAt:  rt.Role.CreatedAt,
 		},
 	}, http.StatusOK)
 }
@@ -179,10 +196,11 @@ func (rb *RBACHandler) listrole(w http.ResponseWriter, r *http.Request) {
 			})
 		}
 		acc := Role{
-			Id:        value.Id,
-			Role:      value.Role,
-			Task:      tasks,
-			CreatedAt: value.CreatedAt,
+			Id:         value.Id,
+			Role:       value.Role,
+			Task:       tasks,
+			DeniedTask: deniedTasks(rt),
+			CreatedAt:  value.CreatedAt,
 		}
 		roles = append(roles, acc)
 	}
@@ -199,7 +217,8 @@ type AccountRoleByRole struct {
 
 func (rb *RBACHandler) getAccountRoleByRole(w http.ResponseWriter, r *http.Request) {
 	authusername := r.Header.Get("username")
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_ROLE)
+	id := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_ROLE, internal.Resource{Type: internal.RESOURCE_ROLE, Id: id})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -208,7 +227,6 @@ func (rb *RBACHandler) getAccountRoleByRole(w http.ResponseWriter, r *http.Reque
 		renderErrorResponse(r.Context(), w, "user is not allowed", err)
 		return
 	}
-	id := mux.Vars(r)["roleId"]
 	la, err := rb.svc.AccountRoleByRole(r.Context(), id)
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "invalid request", err)
@@ -247,7 +265,8 @@ func (rb *RBACHandler) getAccountRoleByRole(w http.ResponseWriter, r *http.Reque
 
 func (rb *RBACHandler) deleteRole(w http.ResponseWriter, r *http.Request) {
 	authusername := r.Header.Get("username")
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.DELETE_ROLE)
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.DELETE_ROLE, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -256,7 +275,6 @@ func (rb *RBACHandler) deleteRole(w http.ResponseWriter, r *http.Request) {
 		renderErrorResponse(r.Context(), w, "user is not allowed", err)
 		return
 	}
-	roleId := mux.Vars(r)["roleId"]
 	err = rb.svc.DeleteRole(r.Context(), roleId)
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error deleting role", err)
@@ -0,0 +1,103 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+
+	"github.com/gorilla/mux"
+)
+
+type CreateRoleInheritanceRequest struct {
+	InheritedRoleId string `json:"inherited_role_id"`
+}
+
+type RoleInheritanceResponse struct {
+	Message string `json:"message"`
+}
+
+type InheritedRolesResponse struct {
+	Roles []Role `json:"roles"`
+}
+
+// createRoleInheritance makes the role inherit the tasks of another role, e.g. admin inheriting editor.
+func (rb *RBACHandler) createRoleInheritance(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.UPDATE_ROLE, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req CreateRoleInheritanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	err = rb.svc.CreateRoleInheritance(r.Context(), roleId, req.InheritedRoleId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create role inheritance failed", err)
+		return
+	}
+	renderResponse(w, &RoleInheritanceResponse{
+		Message: "Created Successfully",
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) inheritedRoles(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	roleId := mux.Vars(r)["roleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_ROLE, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	roles, err := rb.svc.InheritedRoles(r.Context(), roleId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting inherited roles", err)
+		return
+	}
+	res := make([]Role, len(roles))
+	for i, value := range roles {
+		res[i] = Role{
+			Id:        value.Id,
+			Role:      value.Role,
+			CreatedAt: value.CreatedAt,
+		}
+	}
+	renderResponse(w, &InheritedRolesResponse{
+		Roles: res,
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) deleteRoleInheritance(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	roleId := mux.Vars(r)["roleId"]
+	inheritedRoleId := mux.Vars(r)["inheritedRoleId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.UPDATE_ROLE, internal.Resource{Type: internal.RESOURCE_ROLE, Id: roleId})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.DeleteRoleInheritance(r.Context(), roleId, inheritedRoleId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "delete role inheritance failed", err)
+		return
+	}
+	renderResponse(w, &RoleInheritanceResponse{
+		Message: "Deleted Successfully",
+	}, http.StatusOK)
+}
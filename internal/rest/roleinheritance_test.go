@@ -0,0 +1,90 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+	"time"
+)
+
+func TestRoleInheritance_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPost, "/v0/roles/inherits/admin", &rest.CreateRoleInheritanceRequest{InheritedRoleId: "editor"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.RoleInheritanceResponse{Message: "Created Successfully"},
+			target:         &rest.RoleInheritanceResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, roleId, inheritedRoleId := s.CreateRoleInheritanceArgsForCall(0); roleId != "admin" || inheritedRoleId != "editor" {
+					t.Fatalf("unexpected inheritance of %q by %q", inheritedRoleId, roleId)
+				}
+				if _, _, task, resource := s.IsAllowedOnArgsForCall(0); task != internal.UPDATE_ROLE || resource.Id != "admin" {
+					t.Fatalf("unexpected authorization of %s on %v", task, resource)
+				}
+			},
+		},
+		{
+			name: "ERR: 400 cycle",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateRoleInheritanceReturns(internal.NewErrorf(internal.ErrorCodeInvalidArgument, "role inheritance would create a cycle"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/roles/inherits/editor", &rest.CreateRoleInheritanceRequest{InheritedRoleId: "admin"}),
+			expectedStatus: http.StatusBadRequest,
+			expected:       &errorResponse{Error: "create role inheritance failed"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestRoleInheritance_Get(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.InheritedRolesReturns([]internal.Roles{{Id: "editor", Role: "EDITOR", CreatedAt: createdAt}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/roles/inherits/admin", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.InheritedRolesResponse{
+				Roles: []rest.Role{{Id: "editor", Role: "EDITOR", CreatedAt: createdAt}},
+			},
+			target: &rest.InheritedRolesResponse{},
+		},
+	})
+}
+
+func TestRoleInheritance_Delete(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/roles/inherits/admin/editor", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.RoleInheritanceResponse{Message: "Deleted Successfully"},
+			target:         &rest.RoleInheritanceResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, roleId, inheritedRoleId := s.DeleteRoleInheritanceArgsForCall(0); roleId != "admin" || inheritedRoleId != "editor" {
+					t.Fatalf("unexpected inheritance of %q by %q", inheritedRoleId, roleId)
+				}
+			},
+		},
+	})
+}
@@ -10,14 +10,25 @@ import (
 )
 
 type RoleTask struct {
-	Id        string    `json:"id"`
-	Task      Task      `json:"task"`
-	Role      Role      `json:"role"`
-	CreatedAt time.Time `json:"created_at"`
+	Id           string    `json:"id"`
+	Task         Task      `json:"task"`
+	Role         Role      `json:"role"`
+	Effect       string    `json:"effect"`
+	ResourceType string    `json:"resourceType,omitempty"`
+	ResourceId   string    `json:"resourceId,omitempty"`
+	Condition    string    `json:"condition,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
 }
 type CreateRoleTaskRequest struct {
 	TaskId string `json:"taskId"`
 	RoleId string `json:"roleId"`
+	// ResourceType and ResourceId restrict the task to matching resources, "$self" only matches the caller.
+	ResourceType string `json:"resourceType"`
+	ResourceId   string `json:"resourceId"`
+	// Condition restricts the task to requests satisfying it, e.g. ip in "10.0.0.0/8".
+	Condition string `json:"condition"`
+	// Effect is allow, the default, or deny to refuse the task to the accounts holding the role.
+	Effect string `json:"effect"`
 }
 type RoleTaskResponse struct {
 	Message string `json:"message"`
@@ -47,6 +58,12 @@ func (rb *RBACHandler) createRoleTask(w http.ResponseWriter, r *http.Request) {
 		Role: internal.Roles{
 			Id: req.RoleId,
 		},
+		Scope: internal.Scope{
+			ResourceType: req.ResourceType,
+			ResourceId:   req.ResourceId,
+		},
+		Condition: req.Condition,
+		Effect:    req.Effect,
 	})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "create accountrole failed", err)
@@ -102,10 +119,14 @@ func (rb *RBACHandler) roleTask(w http.ResponseWriter, r *http.Request) {
 	}
 	renderResponse(w, &GetRoleTaskResponse{
 		RoleTask: RoleTask{
-			Id:        roleTask.Id,
-			Task:      task,
-			Role:      role,
-			CreatedAt: roleTask.CreatedAt,
+			Id:           roleTask.Id,
+			Task:         task,
+			Role:         role,
+			Effect:       roleTask.Effect,
+			ResourceType: roleTask.Scope.ResourceType,
+			ResourceId:   roleTask.Scope.ResourceId,
+			Condition:    roleTask.Condition,
+			CreatedAt:    roleTask.CreatedAt,
 		},
 	}, http.StatusOK)
 }
@@ -210,10 +231,14 @@ func (rb *RBACHandler) listRoleTask(w http.ResponseWriter, r *http.Request) {
 			CreatedAt: rl.CreatedAt,
 		}
 		roleTask = append(roleTask, RoleTask{
-			Id:        value.Id,
-			Task:      task,
-			Role:      role,
-			CreatedAt: value.CreatedAt,
+			Id:           value.Id,
+			Task:         task,
+			Role:         role,
+			Effect:       value.Effect,
+			ResourceType: value.Scope.ResourceType,
+			ResourceId:   value.Scope.ResourceId,
+			Condition:    value.Condition,
+			CreatedAt:    value.CreatedAt,
 		})
 	}
 	renderResponse(w, &ListRoleTaskResponse{
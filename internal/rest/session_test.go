@@ -0,0 +1,141 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+)
+
+func TestLogout_Post(t *testing.T) {
+	t.Parallel()
+
+	req := newRequest(http.MethodPost, "/v0/accounts/logout", nil)
+	req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "refresh"})
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            req,
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.LoginResponse{Message: "Logout Succesfully"},
+			target:         &rest.LoginResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, token, refreshToken := s.LogoutArgsForCall(0); token != "token" || refreshToken != "refresh" {
+					t.Fatalf("expected tokens %q and %q to be revoked, actual %q and %q", "token", "refresh", token, refreshToken)
+				}
+			},
+		},
+		{
+			name: "OK: 201 body",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			conf:           rest.Config{TokenInBody: true},
+			req:            newRequest(http.MethodPost, "/v0/accounts/logout", &rest.LogoutRequest{RefreshToken: "refresh"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.LoginResponse{Message: "Logout Succesfully"},
+			target:         &rest.LoginResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, token, refreshToken := s.LogoutArgsForCall(0); token != "token" || refreshToken != "refresh" {
+					t.Fatalf("expected tokens %q and %q to be revoked, actual %q and %q", "token", "refresh", token, refreshToken)
+				}
+			},
+		},
+		{
+			name: "OK: 201 access token only",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPost, "/v0/accounts/logout", nil),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.LoginResponse{Message: "Logout Succesfully"},
+			target:         &rest.LoginResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, token, refreshToken := s.LogoutArgsForCall(0); token != "token" || refreshToken != "" {
+					t.Fatalf("expected only token %q to be revoked, actual %q and %q", "token", token, refreshToken)
+				}
+			},
+		},
+	})
+}
+
+func TestRevokedToken(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "ERR: 401",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsTokenRevokedReturns(true, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/accounts/me", nil),
+			expectedStatus: http.StatusUnauthorized,
+			expected:       &errorResponse{Error: "invalid token"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.AccountCallCount() != 0 {
+					t.Fatalf("expected a revoked token not to reach the handler")
+				}
+			},
+		},
+	})
+}
+
+func TestSessions_Delete(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/accounts/sessions/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.RevokeSessionsResponse{Message: "Sessions Revoked Successfully"},
+			target:         &rest.RevokeSessionsResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.RevokeSessionsArgsForCall(0); username != "alice" {
+					t.Fatalf("expected username %q, actual %q", "alice", username)
+				}
+				_, _, task, resource := s.IsAllowedOnArgsForCall(0)
+				if task != internal.REVOKE_SESSION || resource.Id != "alice" {
+					t.Fatalf("expected task %q on %q, actual %q on %q", internal.REVOKE_SESSION, "alice", task, resource.Id)
+				}
+			},
+		},
+		{
+			name: "OK: 200 own",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsAllowedOnReturns(false, nil)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/accounts/sessions/admin", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.RevokeSessionsResponse{Message: "Sessions Revoked Successfully"},
+			target:         &rest.RevokeSessionsResponse{},
+		},
+		{
+			name: "ERR: 500 not allowed",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.IsAllowedOnReturns(false, nil)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/accounts/sessions/alice", nil),
+			expectedStatus: http.StatusInternalServerError,
+			expected:       &errorResponse{Error: "internal error"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.RevokeSessionsCallCount() != 0 {
+					t.Fatalf("expected the sessions not to be revoked")
+				}
+			},
+		},
+	})
+}
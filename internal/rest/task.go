@@ -57,7 +57,8 @@ type GetTaskResponse struct {
 
 func (rb *RBACHandler) task(w http.ResponseWriter, r *http.Request) {
 	authusername := r.Header.Get("username")
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.GET_TASK)
+	taskId := mux.Vars(r)["taskId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.GET_TASK, internal.Resource{Type: internal.RESOURCE_TASK, Id: taskId})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -66,7 +67,6 @@ func (rb *RBACHandler) task(w http.ResponseWriter, r *http.Request) {
 		renderErrorResponse(r.Context(), w, "user is not allowed", err)
 		return
 	}
-	taskId := mux.Vars(r)["taskId"]
 	task, err := rb.svc.Task(r.Context(), taskId)
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting the account", err)
@@ -183,7 +183,8 @@ func (rb *RBACHandler) listtask(w http.ResponseWriter, r *http.Request) {
 
 func (rb *RBACHandler) deleteTask(w http.ResponseWriter, r *http.Request) {
 	authusername := r.Header.Get("username")
-	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.DELETE_TASK)
+	taskId := mux.Vars(r)["taskId"]
+	allowed, err := rb.svc.IsAllowedOn(r.Context(), authusername, internal.DELETE_TASK, internal.Resource{Type: internal.RESOURCE_TASK, Id: taskId})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
 		return
@@ -192,7 +193,6 @@ func (rb *RBACHandler) deleteTask(w http.ResponseWriter, r *http.Request) {
 		renderErrorResponse(r.Context(), w, "user is not allowed", err)
 		return
 	}
-	taskId := mux.Vars(r)["taskId"]
 	err = rb.svc.DeleteTask(r.Context(), taskId)
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "error deleting task", err)
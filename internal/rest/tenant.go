@@ -0,0 +1,182 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type Tenant struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CreateTenantRequest struct {
+	Name string `json:"name"`
+}
+
+type CreateTenantResponse struct {
+	Message string `json:"message"`
+	Id      string `json:"id"`
+}
+
+type TenantResponse struct {
+	Message string `json:"message"`
+}
+
+type ListTenantResponse struct {
+	Tenants []Tenant `json:"tenants"`
+}
+
+type SwitchTenantRequest struct {
+	TenantId string `json:"tenant_id"`
+}
+
+// createTenant creates a tenant, the caller joins it with an ADMIN role granted the tasks it holds in the
+// tenant it acts in.
+func (rb *RBACHandler) createTenant(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.CREATE_TENANT)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	var req CreateTenantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	id, err := rb.svc.CreateTenant(r.Context(), internal.Tenant{Name: req.Name}, authusername)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create tenant failed", err)
+		return
+	}
+	renderResponse(w, &CreateTenantResponse{
+		Message: "Created Successfully",
+		Id:      id,
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) listTenant(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.LIST_TENANT)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	tenants, err := rb.svc.Tenants(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the tenants", err)
+		return
+	}
+	renderResponse(w, &ListTenantResponse{
+		Tenants: convertTenants(tenants),
+	}, http.StatusOK)
+}
+
+// myTenants returns the tenants of the caller, the one it acts in first.
+func (rb *RBACHandler) myTenants(w http.ResponseWriter, r *http.Request) {
+	username := r.Header.Get("username")
+	tenants, err := rb.svc.AccountTenants(r.Context(), username)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting the tenants", err)
+		return
+	}
+	renderResponse(w, &ListTenantResponse{
+		Tenants: convertTenants(tenants),
+	}, http.StatusOK)
+}
+
+func (rb *RBACHandler) addTenantAccount(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	tenantId := mux.Vars(r)["tenantId"]
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.ADD_TENANT_ACCOUNT)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.AddAccountTenant(r.Context(), username, tenantId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "add tenant account failed", err)
+		return
+	}
+	renderResponse(w, &TenantResponse{
+		Message: "Added Successfully",
+	}, http.StatusCreated)
+}
+
+func (rb *RBACHandler) removeTenantAccount(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	tenantId := mux.Vars(r)["tenantId"]
+	username := mux.Vars(r)["username"]
+	allowed, err := rb.svc.IsAllowed(r.Context(), authusername, internal.REMOVE_TENANT_ACCOUNT)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	err = rb.svc.RemoveAccountTenant(r.Context(), username, tenantId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "remove tenant account failed", err)
+		return
+	}
+	renderResponse(w, &TenantResponse{
+		Message: "Removed Successfully",
+	}, http.StatusOK)
+}
+
+// switchTenant makes the caller act in another of its tenants and returns an access token for it, the
+// refresh token keeps working and issues tokens for the new tenant.
+func (rb *RBACHandler) switchTenant(w http.ResponseWriter, r *http.Request) {
+	username := r.Header.Get("username")
+	var req SwitchTenantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	token, err := rb.svc.SwitchTenant(r.Context(), username, req.TenantId)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "switch tenant failed", err)
+		return
+	}
+	addCookie(w, "token", token)
+	res := &RefreshTokenResponse{
+		Message: "Tenant Switched Successfully",
+	}
+	if rb.conf.TokenInBody {
+		res.Token = token
+	}
+	renderResponse(w, res, http.StatusCreated)
+}
+
+func convertTenants(tenants []internal.Tenant) []Tenant {
+	res := make([]Tenant, len(tenants))
+	for i, t := range tenants {
+		res[i] = Tenant{
+			Id:        t.Id,
+			Name:      t.Name,
+			CreatedAt: t.CreatedAt,
+		}
+	}
+	return res
+}
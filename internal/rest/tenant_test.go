@@ -0,0 +1,204 @@
+package rest_test
+
+import (
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"rbac/internal/tokenmaker"
+	"testing"
+	"time"
+)
+
+func TestTenant_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.CreateTenantReturns("t2", nil)
+			},
+			req:            newRequest(http.MethodPost, "/v0/tenants/", &rest.CreateTenantRequest{Name: "acme"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.CreateTenantResponse{Message: "Created Successfully", Id: "t2"},
+			target:         &rest.CreateTenantResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				ctx, tenant, username := s.CreateTenantArgsForCall(0)
+				if tenant.Name != "acme" || username != "admin" {
+					t.Fatalf("unexpected tenant %q created by %q", tenant.Name, username)
+				}
+				if tenantId := internal.TenantFromContext(ctx); tenantId != "t1" {
+					t.Fatalf("expected the request to act in %q, actual %q", "t1", tenantId)
+				}
+			},
+		},
+	})
+}
+
+func TestTenant_Get(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.TenantsReturns([]internal.Tenant{{Id: "t1", Name: "default", CreatedAt: createdAt}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/tenants/", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.ListTenantResponse{
+				Tenants: []rest.Tenant{{Id: "t1", Name: "default", CreatedAt: createdAt}},
+			},
+			target: &rest.ListTenantResponse{},
+		},
+		{
+			name: "OK: 200 mine",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.AccountTenantsReturns([]internal.Tenant{{Id: "t1", Name: "default", CreatedAt: createdAt}}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/tenants/me", nil),
+			expectedStatus: http.StatusOK,
+			expected: &rest.ListTenantResponse{
+				Tenants: []rest.Tenant{{Id: "t1", Name: "default", CreatedAt: createdAt}},
+			},
+			target: &rest.ListTenantResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username := s.AccountTenantsArgsForCall(0); username != "admin" {
+					t.Fatalf("expected username %q, actual %q", "admin", username)
+				}
+			},
+		},
+	})
+}
+
+func TestTenantAccount_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodPost, "/v0/tenants/t1/accounts/alice", nil),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.TenantResponse{Message: "Added Successfully"},
+			target:         &rest.TenantResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username, tenantId := s.AddAccountTenantArgsForCall(0); username != "alice" || tenantId != "t1" {
+					t.Fatalf("unexpected account %q added to %q", username, tenantId)
+				}
+			},
+		},
+		{
+			name: "ERR: 403 another tenant",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.AddAccountTenantReturns(internal.NewErrorf(internal.ErrorCodeForbidden, "only the tenant the request acts in can be changed"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/tenants/t2/accounts/alice", nil),
+			expectedStatus: http.StatusForbidden,
+			expected:       &errorResponse{Error: "add tenant account failed"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestTenantAccount_Delete(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+			},
+			req:            newRequest(http.MethodDelete, "/v0/tenants/t1/accounts/alice", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.TenantResponse{Message: "Removed Successfully"},
+			target:         &rest.TenantResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username, tenantId := s.RemoveAccountTenantArgsForCall(0); username != "alice" || tenantId != "t1" {
+					t.Fatalf("unexpected account %q removed from %q", username, tenantId)
+				}
+			},
+		},
+	})
+}
+
+func TestSwitchTenant_Post(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.SwitchTenantReturns("access", nil)
+			},
+			conf:           rest.Config{TokenInBody: true},
+			req:            newRequest(http.MethodPost, "/v0/tokens/tenant", &rest.SwitchTenantRequest{TenantId: "t2"}),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.RefreshTokenResponse{Message: "Tenant Switched Successfully", Token: "access"},
+			target:         &rest.RefreshTokenResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, username, tenantId := s.SwitchTenantArgsForCall(0); username != "admin" || tenantId != "t2" {
+					t.Fatalf("unexpected switch of %q to %q", username, tenantId)
+				}
+			},
+		},
+		{
+			name: "ERR: 403",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.SwitchTenantReturns("", internal.NewErrorf(internal.ErrorCodeForbidden, "account doesn't belong to the tenant"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/tokens/tenant", &rest.SwitchTenantRequest{TenantId: "t3"}),
+			expectedStatus: http.StatusForbidden,
+			expected:       &errorResponse{Error: "switch tenant failed"},
+			target:         &errorResponse{},
+		},
+	})
+}
+
+func TestTenant_ActiveTenant(t *testing.T) {
+	t.Parallel()
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 200 token without tenant",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.VerifyTokenReturns(&tokenmaker.Payload{Username: "admin"}, nil)
+				s.ActiveTenantReturns(internal.Tenant{Id: "t2"}, nil)
+			},
+			req:            newRequest(http.MethodGet, "/v0/tenants/me", nil),
+			expectedStatus: http.StatusOK,
+			expected:       &rest.ListTenantResponse{Tenants: []rest.Tenant{}},
+			target:         &rest.ListTenantResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if ctx, _ := s.AccountTenantsArgsForCall(0); internal.TenantFromContext(ctx) != "t2" {
+					t.Fatalf("expected the request to act in %q, actual %q", "t2", internal.TenantFromContext(ctx))
+				}
+			},
+		},
+		{
+			name: "ERR: 401 without tenant",
+			setup: func(s *resttesting.FakeRBACService) {
+				authenticate(s)
+				s.VerifyTokenReturns(&tokenmaker.Payload{Username: "admin"}, nil)
+				s.ActiveTenantReturns(internal.Tenant{}, internal.NewErrorf(internal.ErrorCodeUnauthorized, "account doesn't belong to any tenant"))
+			},
+			req:            newRequest(http.MethodGet, "/v0/tenants/me", nil),
+			expectedStatus: http.StatusUnauthorized,
+			expected:       &errorResponse{Error: "error getting tenant"},
+			target:         &errorResponse{},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/tokenmaker"
+)
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+type RefreshTokenResponse struct {
+	Message      string `json:"message"`
+	Token        string `json:"token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+// JWKSResponse is a JSON Web Key Set (RFC 7517).
+type JWKSResponse struct {
+	Keys []tokenmaker.JWK `json:"keys"`
+}
+
+// jwks publishes the public keys verifying the access tokens so other services can validate them offline.
+func (a *RBACHandler) jwks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	renderResponse(w, &JWKSResponse{
+		Keys: a.svc.PublicKeys(),
+	}, http.StatusOK)
+}
+
+func (a *RBACHandler) refreshToken(w http.ResponseWriter, r *http.Request) {
+	var req RefreshTokenRequest
+	if c, err := r.Cookie("refresh_token"); err == nil {
+		// the browser sends the cookie along with any request, the CSRF token proves the page sent this one
+		if a.conf.CSRFProtection && !validCSRF(r) {
+			renderErrorResponse(r.Context(), w, "invalid csrf token", internal.NewErrorf(internal.ErrorCodeForbidden, "csrf token mismatch"))
+			return
+		}
+		req.RefreshToken = c.Value
+	} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+	defer r.Body.Close()
+	token, refreshToken, err := a.svc.RefreshToken(r.Context(), req.RefreshToken)
+	if err != nil {
+		addCookie(w, "refresh_token", "")
+		renderErrorResponse(r.Context(), w, "refresh token failed", err)
+		return
+	}
+	if !a.setTokenCookies(w, r, token, refreshToken) {
+		return
+	}
+	res := &RefreshTokenResponse{
+		Message: "Token Refreshed Succesfully",
+	}
+	if a.conf.TokenInBody {
+		res.Token = token
+		res.RefreshToken = refreshToken
+	}
+	renderResponse(w, res, http.StatusCreated)
+}
+
+type TokenKey struct {
+	Kid    string `json:"kid"`
+	Status string `json:"status"`
+}
+
+type TokenKeysResponse struct {
+	Keys []TokenKey `json:"keys"`
+}
+
+func (a *RBACHandler) tokenKeys(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := a.svc.IsAllowed(r.Context(), authusername, internal.GET_TOKEN_KEY)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	keys, err := a.svc.TokenKeys(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting token keys", err)
+		return
+	}
+	renderResponse(w, &TokenKeysResponse{
+		Keys: convertTokenKeys(keys),
+	}, http.StatusOK)
+}
+
+// reloadTokenKeys makes this instance read the token keys again, used for rotating them without downtime.
+func (a *RBACHandler) reloadTokenKeys(w http.ResponseWriter, r *http.Request) {
+	authusername := r.Header.Get("username")
+	allowed, err := a.svc.IsAllowed(r.Context(), authusername, internal.ROTATE_TOKEN_KEY)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error getting user tasks", err)
+		return
+	}
+	if !allowed {
+		renderErrorResponse(r.Context(), w, "user is not allowed", err)
+		return
+	}
+	keys, err := a.svc.ReloadTokenKeys(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "error reloading token keys", err)
+		return
+	}
+	renderResponse(w, &TokenKeysResponse{
+		Keys: convertTokenKeys(keys),
+	}, http.StatusOK)
+}
+
+func convertTokenKeys(keys []tokenmaker.KeyInfo) []TokenKey {
+	res := make([]TokenKey, len(keys))
+	for i, k := range keys {
+		res[i] = TokenKey{
+			Kid:    k.ID,
+			Status: string(k.Status),
+		}
+	}
+	return res
+}
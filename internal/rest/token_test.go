@@ -0,0 +1,108 @@
+package rest_test
+
+import (
+	"errors"
+	"net/http"
+	"rbac/internal"
+	"rbac/internal/rest"
+	"rbac/internal/rest/resttesting"
+	"testing"
+)
+
+func TestRefreshToken_Post(t *testing.T) {
+	t.Parallel()
+
+	// newCookieRequest sends the refresh token cookie, along with the CSRF header repeating the cookie
+	newCookieRequest := func(header string) *http.Request {
+		req := newRequest(http.MethodPost, "/v0/token/refresh", nil)
+		req.Header.Del("Authorization")
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "refresh"})
+		req.AddCookie(&http.Cookie{Name: "csrf_token", Value: "csrf"})
+		if header != "" {
+			req.Header.Set("X-CSRF-Token", header)
+		}
+		return req
+	}
+
+	runHandlerTests(t, []handlerTest{
+		{
+			name: "OK: 201",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.RefreshTokenReturns("access", "next", nil)
+			},
+			conf:           rest.Config{TokenInBody: true},
+			req:            newRequest(http.MethodPost, "/v0/token/refresh", &rest.RefreshTokenRequest{RefreshToken: "refresh"}),
+			expectedStatus: http.StatusCreated,
+			expected: &rest.RefreshTokenResponse{
+				Message:      "Token Refreshed Succesfully",
+				Token:        "access",
+				RefreshToken: "next",
+			},
+			target: &rest.RefreshTokenResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, token := s.RefreshTokenArgsForCall(0); token != "refresh" {
+					t.Fatalf("expected refresh token %q, actual %q", "refresh", token)
+				}
+			},
+		},
+		{
+			name: "OK: 201 cookie",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.RefreshTokenReturns("access", "next", nil)
+			},
+			conf:           rest.Config{CSRFProtection: true},
+			req:            newCookieRequest("csrf"),
+			expectedStatus: http.StatusCreated,
+			expected:       &rest.RefreshTokenResponse{Message: "Token Refreshed Succesfully"},
+			target:         &rest.RefreshTokenResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if _, token := s.RefreshTokenArgsForCall(0); token != "refresh" {
+					t.Fatalf("expected refresh token %q, actual %q", "refresh", token)
+				}
+			},
+		},
+		{
+			name:           "ERR: 403 cookie without csrf token",
+			setup:          func(s *resttesting.FakeRBACService) {},
+			conf:           rest.Config{CSRFProtection: true},
+			req:            newCookieRequest(""),
+			expectedStatus: http.StatusForbidden,
+			expected:       &errorResponse{Error: "invalid csrf token"},
+			target:         &errorResponse{},
+			assert: func(t *testing.T, s *resttesting.FakeRBACService) {
+				if s.RefreshTokenCallCount() != 0 {
+					t.Fatalf("expected the token not to be refreshed")
+				}
+			},
+		},
+		{
+			name:           "ERR: 403 cookie with another csrf token",
+			setup:          func(s *resttesting.FakeRBACService) {},
+			conf:           rest.Config{CSRFProtection: true},
+			req:            newCookieRequest("forged"),
+			expectedStatus: http.StatusForbidden,
+			expected:       &errorResponse{Error: "invalid csrf token"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "ERR: 401 reuse",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.RefreshTokenReturns("", "", internal.NewErrorf(internal.ErrorCodeUnauthorized, "refresh token reuse detected"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/token/refresh", &rest.RefreshTokenRequest{RefreshToken: "refresh"}),
+			expectedStatus: http.StatusUnauthorized,
+			expected:       &errorResponse{Error: "refresh token failed"},
+			target:         &errorResponse{},
+		},
+		{
+			name: "ERR: 500",
+			setup: func(s *resttesting.FakeRBACService) {
+				s.RefreshTokenReturns("", "", errors.New("repo error"))
+			},
+			req:            newRequest(http.MethodPost, "/v0/token/refresh", &rest.RefreshTokenRequest{RefreshToken: "refresh"}),
+			expectedStatus: http.StatusInternalServerError,
+			expected:       &errorResponse{Error: "internal error"},
+			target:         &errorResponse{},
+		},
+	})
+}
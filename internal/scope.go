@@ -0,0 +1,82 @@
+package internal
+
+import "path"
+
+const (
+	RESOURCE_ACCOUNT = "account"
+	RESOURCE_ROLE    = "role"
+	RESOURCE_TASK    = "task"
+
+	// SCOPE_SELF as the resource id of a scope only matches the account performing the task.
+	SCOPE_SELF = "$self"
+)
+
+// Resource identifies what a task is performed on.
+type Resource struct {
+	Type string
+	Id   string
+}
+
+// Scope restricts a role assignment or a role task to resources of a type whose id matches ResourceId,
+// a path.Match pattern such as "dept-x-*". The zero value grants the task on every resource.
+type Scope struct {
+	ResourceType string
+	ResourceId   string
+}
+
+// IsGlobal returns true when the scope doesn't restrict anything.
+func (s Scope) IsGlobal() bool {
+	return s.ResourceType == ""
+}
+
+// Validate checks the resource id is a valid pattern.
+func (s Scope) Validate() error {
+	if s.IsGlobal() {
+		if s.ResourceId != "" {
+			return NewErrorf(ErrorCodeInvalidArgument, "resource id given without resource type")
+		}
+		return nil
+	}
+	if s.ResourceId == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "resource id is required, use * for every resource")
+	}
+	if _, err := path.Match(s.ResourceId, ""); err != nil {
+		return WrapErrorf(err, ErrorCodeInvalidArgument, "invalid resource id pattern")
+	}
+	return nil
+}
+
+// Matches returns true when the task performed by username on the resource falls within the scope.
+func (s Scope) Matches(username string, resource Resource) bool {
+	if s.IsGlobal() {
+		return true
+	}
+	if s.ResourceType != resource.Type {
+		return false
+	}
+	if s.ResourceId == SCOPE_SELF {
+		return resource.Type == RESOURCE_ACCOUNT && resource.Id == username
+	}
+	ok, err := path.Match(s.ResourceId, resource.Id)
+	return err == nil && ok
+}
+
+// RestrictedGrant is a task granted through an account role and a role task where at least one of them is
+// scoped or the role task has a condition, both scopes must match the resource and the condition must hold.
+// Grants with the EFFECT_DENY effect deny the task on the resources they match instead.
+type RestrictedGrant struct {
+	// Task is the name of the task granted or denied.
+	Task string
+	// RoleId is the role holding the role task, an inherited role for grants through role inheritance.
+	RoleId    string
+	RoleScope Scope
+	TaskScope Scope
+	Condition string
+	Effect    string
+}
+
+// Matches returns true when the scopes of the grant cover the task performed by username on the resource,
+// the condition is evaluated separately.
+func (g RestrictedGrant) Matches(username string, resource Resource) bool {
+	return g.RoleScope.Matches(username, resource) && g.TaskScope.Matches(username, resource)
+}
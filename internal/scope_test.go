@@ -0,0 +1,34 @@
+package internal_test
+
+import (
+	"rbac/internal"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScope_Matches(t *testing.T) {
+	account := internal.Resource{Type: internal.RESOURCE_ACCOUNT, Id: "dept-x-alice"}
+
+	require.True(t, internal.Scope{}.Matches("bob", account))
+	require.True(t, internal.Scope{ResourceType: internal.RESOURCE_ACCOUNT, ResourceId: "dept-x-*"}.Matches("bob", account))
+	require.False(t, internal.Scope{ResourceType: internal.RESOURCE_ACCOUNT, ResourceId: "dept-y-*"}.Matches("bob", account))
+	require.False(t, internal.Scope{ResourceType: internal.RESOURCE_ROLE, ResourceId: "*"}.Matches("bob", account))
+	require.True(t, internal.Scope{ResourceType: internal.RESOURCE_ACCOUNT, ResourceId: internal.SCOPE_SELF}.Matches("dept-x-alice", account))
+	require.False(t, internal.Scope{ResourceType: internal.RESOURCE_ACCOUNT, ResourceId: internal.SCOPE_SELF}.Matches("bob", account))
+
+	grant := internal.RestrictedGrant{
+		RoleScope: internal.Scope{ResourceType: internal.RESOURCE_ACCOUNT, ResourceId: "dept-x-*"},
+		TaskScope: internal.Scope{ResourceType: internal.RESOURCE_ACCOUNT, ResourceId: internal.SCOPE_SELF},
+	}
+	require.False(t, grant.Matches("bob", account))
+	require.True(t, grant.Matches("dept-x-alice", account))
+}
+
+func TestScope_Validate(t *testing.T) {
+	require.NoError(t, internal.Scope{}.Validate())
+	require.NoError(t, internal.Scope{ResourceType: internal.RESOURCE_ACCOUNT, ResourceId: "*"}.Validate())
+	require.Error(t, internal.Scope{ResourceId: "x"}.Validate())
+	require.Error(t, internal.Scope{ResourceType: internal.RESOURCE_ACCOUNT}.Validate())
+	require.Error(t, internal.Scope{ResourceType: internal.RESOURCE_ACCOUNT, ResourceId: "["}.Validate())
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CreateAccessRequest records a request of the account for the role, it must be decided by a holder of one
+// of the approver roles of the role before it expires.
+func (r *RBAC) CreateAccessRequest(ctx context.Context, request internal.AccessRequest) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.Create")
+	defer span.End()
+	if err := request.Validate(); err != nil {
+		return "", err
+	}
+	held, err := r.accountRoleIDs(ctx, request.Account.UserName)
+	if err != nil {
+		return "", err
+	}
+	for _, value := range held {
+		if value == request.Role.Id {
+			return "", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the account already holds the role")
+		}
+	}
+	approvers, err := r.repo.RoleApprovers(ctx, request.Role.Id)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	if len(approvers) == 0 {
+		return "", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the role can't be requested, it has no approvers")
+	}
+	id, err := r.repo.CreateAccessRequest(ctx, request.Account.UserName, request.Role.Id, request.Justification, time.Now().Add(r.conf.AccessRequestExpiration))
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	ar, err := r.repo.AccessRequest(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.AccessRequestCreated(ctx, ar)
+	return id, nil
+}
+
+func (r *RBAC) AccessRequest(ctx context.Context, id string) (internal.AccessRequest, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.AccessRequest")
+	defer span.End()
+	ar, err := r.repo.AccessRequest(ctx, id)
+	if err != nil {
+		return internal.AccessRequest{}, fmt.Errorf("repo: %w", err)
+	}
+	return ar, nil
+}
+
+func (r *RBAC) AccessRequests(ctx context.Context) ([]internal.AccessRequest, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.AccessRequests")
+	defer span.End()
+	requests, err := r.repo.AccessRequests(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return requests, nil
+}
+
+func (r *RBAC) AccountAccessRequests(ctx context.Context, username string) ([]internal.AccessRequest, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.AccountAccessRequests")
+	defer span.End()
+	requests, err := r.repo.AccountAccessRequests(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return requests, nil
+}
+
+// PendingApprovals returns the pending access requests the account can approve or reject.
+func (r *RBAC) PendingApprovals(ctx context.Context, username string) ([]internal.AccessRequest, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.PendingApprovals")
+	defer span.End()
+	requests, err := r.repo.AccessRequests(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	held, err := r.accountRoleIDs(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	approves := map[string]bool{}
+	pending := []internal.AccessRequest{}
+	for _, value := range requests {
+		if value.Status != internal.ACCESS_REQUEST_PENDING || value.Account.UserName == username {
+			continue
+		}
+		ok, found := approves[value.Role.Id]
+		if !found {
+			ok, err = r.holdsApproverRole(ctx, value.Role.Id, held)
+			if err != nil {
+				return nil, err
+			}
+			approves[value.Role.Id] = ok
+		}
+		if ok {
+			pending = append(pending, value)
+		}
+	}
+	return pending, nil
+}
+
+// ApproveAccessRequest approves the request and assigns the role to the requester at once, the request stays
+// pending when the role can't be assigned, such as when it's exclusive with a role of the requester.
+func (r *RBAC) ApproveAccessRequest(ctx context.Context, approver string, id string, comment string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.Approve")
+	defer span.End()
+	ar, err := r.canDecide(ctx, approver, id)
+	if err != nil {
+		return err
+	}
+	arid, err := r.repo.ApproveAccessRequest(ctx, ar.Id, approver, comment)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	if err := r.accountRoleCreated(ctx, arid); err != nil {
+		return err
+	}
+	return r.accessRequestDecided(ctx, id)
+}
+
+func (r *RBAC) RejectAccessRequest(ctx context.Context, approver string, id string, comment string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.Reject")
+	defer span.End()
+	if _, err := r.canDecide(ctx, approver, id); err != nil {
+		return err
+	}
+	err := r.repo.DecideAccessRequest(ctx, id, internal.ACCESS_REQUEST_REJECTED, approver, comment)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return r.accessRequestDecided(ctx, id)
+}
+
+// canDecide returns the access request when the account holds one of the approver roles of its role,
+// requesters never decide their own requests.
+func (r *RBAC) canDecide(ctx context.Context, approver string, id string) (internal.AccessRequest, error) {
+	ar, err := r.repo.AccessRequest(ctx, id)
+	if err != nil {
+		return internal.AccessRequest{}, fmt.Errorf("repo: %w", err)
+	}
+	if ar.Account.UserName == approver {
+		return internal.AccessRequest{}, internal.NewErrorf(internal.ErrorCodeForbidden, "an access request can't be decided by its requester")
+	}
+	held, err := r.accountRoleIDs(ctx, approver)
+	if err != nil {
+		return internal.AccessRequest{}, err
+	}
+	ok, err := r.holdsApproverRole(ctx, ar.Role.Id, held)
+	if err != nil {
+		return internal.AccessRequest{}, err
+	}
+	if !ok {
+		return internal.AccessRequest{}, internal.NewErrorf(internal.ErrorCodeForbidden, "the account is not an approver of the role")
+	}
+	return ar, nil
+}
+
+// holdsApproverRole returns true when one of the held roles approves the access requests for the role.
+func (r *RBAC) holdsApproverRole(ctx context.Context, roleId string, held []string) (bool, error) {
+	approvers, err := r.repo.RoleApprovers(ctx, roleId)
+	if err != nil {
+		return false, fmt.Errorf("repo: %w", err)
+	}
+	for _, approver := range approvers {
+		for _, value := range held {
+			if value == approver.Id {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (r *RBAC) accessRequestDecided(ctx context.Context, id string) error {
+	ar, err := r.repo.AccessRequest(ctx, id)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.AccessRequestDecided(ctx, ar)
+	return nil
+}
+
+func (r *RBAC) AddRoleApprover(ctx context.Context, roleId string, approverRoleId string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.AddRoleApprover")
+	defer span.End()
+	id, err := r.repo.AddRoleApprover(ctx, roleId, approverRoleId)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	return id, nil
+}
+
+func (r *RBAC) RemoveRoleApprover(ctx context.Context, roleId string, approverRoleId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.RemoveRoleApprover")
+	defer span.End()
+	err := r.repo.RemoveRoleApprover(ctx, roleId, approverRoleId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
+
+func (r *RBAC) RoleApprovers(ctx context.Context, roleId string) ([]internal.Roles, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccessRequest.RoleApprovers")
+	defer span.End()
+	roles, err := r.repo.RoleApprovers(ctx, roleId)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return roles, nil
+}
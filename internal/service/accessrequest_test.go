@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"rbac/internal"
+	"rbac/internal/service"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// withAccessRequest makes alice request the payments role approved by managers, admin being a manager.
+func withAccessRequest(f fakes) {
+	f.repo.AccessRequestReturns(internal.AccessRequest{
+		Id:      "req1",
+		Account: internal.Account{UserName: "alice"},
+		Role:    internal.Roles{Id: "payments"},
+		Status:  internal.ACCESS_REQUEST_PENDING,
+	}, nil)
+	f.repo.RoleApproversReturns([]internal.Roles{{Id: "manager"}}, nil)
+	withRoles(f, map[string]internal.RoleTaskByRole{"manager": {}})
+}
+
+func TestRBAC_CreateAccessRequest(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{AccessRequestExpiration: time.Hour})
+	f.repo.RoleApproversReturns([]internal.Roles{{Id: "manager"}}, nil)
+	f.repo.CreateAccessRequestReturns("req1", nil)
+
+	before := time.Now()
+	id, err := svc.CreateAccessRequest(ctx, internal.AccessRequest{
+		Account:       internal.Account{UserName: "alice"},
+		Role:          internal.Roles{Id: "payments"},
+		Justification: "month end closing",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "req1", id)
+
+	_, username, roleId, _, expiresAt := f.repo.CreateAccessRequestArgsForCall(0)
+	require.Equal(t, "alice", username)
+	require.Equal(t, "payments", roleId)
+	require.False(t, expiresAt.Before(before.Add(time.Hour)))
+	require.False(t, expiresAt.After(time.Now().Add(time.Hour)))
+	require.Equal(t, 1, f.msgBroker.AccessRequestCreatedCallCount())
+}
+
+func TestRBAC_CreateAccessRequest_NoApprovers(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+
+	_, err := svc.CreateAccessRequest(ctx, internal.AccessRequest{
+		Account:       internal.Account{UserName: "alice"},
+		Role:          internal.Roles{Id: "payments"},
+		Justification: "month end closing",
+	})
+	requireErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	require.Equal(t, 0, f.repo.CreateAccessRequestCallCount())
+}
+
+func TestRBAC_ApproveAccessRequest(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	withAccessRequest(f)
+	f.repo.ApproveAccessRequestReturns("ar1", nil)
+	f.repo.AccountRoleReturns(internal.AccountRoles{Id: "ar1", Role: internal.Roles{Id: "payments"}}, nil)
+
+	require.NoError(t, svc.ApproveAccessRequest(ctx, "admin", "req1", "approved for the closing"))
+
+	_, id, decidedBy, comment := f.repo.ApproveAccessRequestArgsForCall(0)
+	require.Equal(t, "req1", id)
+	require.Equal(t, "admin", decidedBy)
+	require.Equal(t, "approved for the closing", comment)
+	require.Equal(t, 0, f.repo.DecideAccessRequestCallCount())
+	require.Equal(t, 0, f.repo.CreateAccountRoleCallCount())
+
+	require.Equal(t, 1, f.msgBroker.AccountRoleCreatedCallCount())
+	_, accRole := f.msgBroker.AccountRoleCreatedArgsForCall(0)
+	require.Equal(t, "ar1", accRole.Id)
+	require.Equal(t, 1, f.msgBroker.AccessRequestDecidedCallCount())
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+}
+
+func TestRBAC_ApproveAccessRequest_NotPublished(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	withAccessRequest(f)
+	f.repo.ApproveAccessRequestReturns("ar1", nil)
+	f.repo.AccountRoleReturns(internal.AccountRoles{Id: "ar1", Role: internal.Roles{Id: "payments"}}, nil)
+	f.msgBroker.AccountRoleCreatedReturns(errors.New("broker down"))
+
+	require.Error(t, svc.ApproveAccessRequest(ctx, "admin", "req1", ""))
+
+	// the role is assigned, tokens must be issued again whether it was published or not
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+}
+
+func TestRBAC_ApproveAccessRequest_Expired(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	withAccessRequest(f)
+	f.repo.ApproveAccessRequestReturns("", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "access request is not pending"))
+
+	err := svc.ApproveAccessRequest(ctx, "admin", "req1", "")
+	requireErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+
+	require.Equal(t, 0, f.msgBroker.AccountRoleCreatedCallCount())
+	require.Equal(t, 0, f.msgBroker.AccessRequestDecidedCallCount())
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), version)
+}
+
+func TestRBAC_ApproveAccessRequest_Forbidden(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requester", func(t *testing.T) {
+		svc, f := newRBAC(t, service.Config{})
+		withAccessRequest(f)
+
+		err := svc.ApproveAccessRequest(ctx, "alice", "req1", "")
+		requireErrorCode(t, err, internal.ErrorCodeForbidden)
+		require.Equal(t, 0, f.repo.ApproveAccessRequestCallCount())
+	})
+
+	t.Run("not an approver", func(t *testing.T) {
+		svc, f := newRBAC(t, service.Config{})
+		withAccessRequest(f)
+		withRoles(f, map[string]internal.RoleTaskByRole{"clerk": {}})
+
+		err := svc.ApproveAccessRequest(ctx, "admin", "req1", "")
+		requireErrorCode(t, err, internal.ErrorCodeForbidden)
+		require.Equal(t, 0, f.repo.ApproveAccessRequestCallCount())
+	})
+}
+
+func TestRBAC_RejectAccessRequest(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	withAccessRequest(f)
+
+	require.NoError(t, svc.RejectAccessRequest(ctx, "admin", "req1", "not needed"))
+
+	_, id, status, decidedBy, comment := f.repo.DecideAccessRequestArgsForCall(0)
+	require.Equal(t, "req1", id)
+	require.Equal(t, internal.ACCESS_REQUEST_REJECTED, status)
+	require.Equal(t, "admin", decidedBy)
+	require.Equal(t, "not needed", comment)
+	require.Equal(t, 0, f.repo.ApproveAccessRequestCallCount())
+	require.Equal(t, 0, f.msgBroker.AccountRoleCreatedCallCount())
+	require.Equal(t, 1, f.msgBroker.AccessRequestDecidedCallCount())
+}
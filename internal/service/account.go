@@ -1,47 +1,214 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"rbac/internal"
+	"rbac/internal/condition"
+	"rbac/internal/tokenmaker"
 	"strings"
 
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/context"
 )
 
-func (r *RBAC) Logout(ctx context.Context) error {
-	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Logout")
+// Logout revokes the access token, and the refresh token family when a refresh token is given.
+func (r *RBAC) Logout(ctx context.Context, token string, refreshToken string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Logout")
 	defer span.End()
+	payload, err := r.token.VerifyToken(token)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnauthorized, "verify token")
+	}
+	err = r.sessions.RevokeToken(ctx, payload.ID.String(), payload.ExpiredAt)
+	if err != nil {
+		return fmt.Errorf("sessions: %w", err)
+	}
+	if refreshToken == "" {
+		return nil
+	}
+	rt, err := r.repo.RefreshTokenByHash(ctx, tokenmaker.HashOpaqueToken(refreshToken))
+	if err != nil {
+		// the access token is already revoked, an unknown refresh token has nothing left to revoke
+		return nil
+	}
+	if rt.Username != payload.Username {
+		return nil
+	}
+	err = r.repo.RevokeRefreshTokenFamily(ctx, rt.FamilyId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
 	return nil
 }
-func (r *RBAC) Login(ctx context.Context, username string, password string) error {
+
+// Login checks the credentials of the account, failed attempts are counted per username and per IP address
+// and logins are refused for a while once too many of them happen.
+func (r *RBAC) Login(ctx context.Context, username string, password string, ip string) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Login")
 	defer span.End()
-	err := r.repo.Login(ctx, username, password)
+	keys := loginKeys(username, ip)
+	err := r.checkLoginLockout(ctx, keys)
 	if err != nil {
+		return err
+	}
+	err = r.repo.Login(ctx, username, password)
+	if err != nil {
+		var ierr *internal.Error
+		// blocked accounts used the right password, they are not counted as failures
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeUnauthorized {
+			if err := r.loginFailed(ctx, keys); err != nil {
+				return fmt.Errorf("login attempts: %w", err)
+			}
+		}
 		return fmt.Errorf("repo login: %w", err)
 	}
+	// logins waiting for their second factor keep their failures until VerifyMFA succeeds
+	status, err := r.MFAStatus(ctx, username)
+	if err != nil {
+		return err
+	}
+	if status.Enrolled || status.Required {
+		return nil
+	}
+	err = r.loginSucceeded(ctx, keys)
+	if err != nil {
+		return fmt.Errorf("login attempts: %w", err)
+	}
 	return nil
 }
+
+// IsAllowed returns true when one of the roles of the account grants the task and none denies it, requests
+// authenticated with a token carrying permission claims are authorized from them. Role tasks with a condition
+// grant the task when it holds for the request.
 func (r *RBAC) IsAllowed(ctx context.Context, username string, task string) (bool, error) {
-	acrole, err := r.search.GetAccountRoleByAccount(ctx, username)
+	return r.isAllowed(ctx, username, task, nil)
+}
+
+// IsAllowedOn returns true when the account may perform the task on the resource, either because the task
+// is granted globally or because a scoped account role or role task covers the resource and its condition
+// holds, and no deny role task covering the resource overrides it.
+func (r *RBAC) IsAllowedOn(ctx context.Context, username string, task string, resource internal.Resource) (bool, error) {
+	return r.isAllowed(ctx, username, task, &resource)
+}
+
+func (r *RBAC) isAllowed(ctx context.Context, username string, task string, resource *internal.Resource) (bool, error) {
+	granted, denied, err := r.isGranted(ctx, username, task)
 	if err != nil {
-		return false, fmt.Errorf("search: %w", err)
+		return false, err
 	}
-	var tasks []internal.Tasks
-	for _, value := range acrole.Roles {
-		rt, err := r.search.GetRoleTaskByRole(ctx, value.Id)
-		if err != nil {
-			return false, fmt.Errorf("search: %w", err)
+	v, err := r.newEvaluator(username, task).decide(ctx, question{
+		task:     task,
+		resource: resource,
+		request:  condition.FromContext(ctx),
+		granted:  granted,
+		denied:   denied,
+	})
+	if err != nil {
+		return false, err
+	}
+	return v.allowed, nil
+}
+
+// isGranted returns whether the task is granted or denied without scope nor condition, from the claims of
+// the token when it carries them or from search.
+func (r *RBAC) isGranted(ctx context.Context, username string, task string) (bool, bool, error) {
+	if payload, ok := tokenmaker.FromContext(ctx); ok && payload.HasPermissions() && payload.Username == username {
+		return payload.Permissions.Can(task), payload.Permissions.Denies(task), nil
+	}
+	_, tasks, denied, err := r.accountPermissions(ctx, username)
+	if err != nil {
+		return false, false, err
+	}
+	for _, value := range denied {
+		if value == task {
+			return false, true, nil
 		}
-		tasks = append(tasks, rt.Tasks...)
 	}
 	for _, value := range tasks {
-		if value.Task == task {
-			return true, nil
+		if value == task {
+			return true, false, nil
+		}
+	}
+	return false, false, nil
+}
+
+// accountPermissions returns the ids of the roles of the account, including the inherited ones, the tasks
+// they grant and the tasks they deny, denied tasks are left out of the granted ones.
+func (r *RBAC) accountPermissions(ctx context.Context, username string) ([]string, []string, []string, error) {
+	roles, granted, denied, err := r.accountTasks(ctx, username)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var tasks, deniedTasks []string
+	for _, t := range granted {
+		tasks = append(tasks, t.Task)
+	}
+	for _, t := range denied {
+		deniedTasks = append(deniedTasks, t.Task)
+	}
+	return roles, tasks, deniedTasks, nil
+}
+
+// accountTasks is accountPermissions returning the tasks instead of their names.
+func (r *RBAC) accountTasks(ctx context.Context, username string) ([]string, []internal.Tasks, []internal.Tasks, error) {
+	roles, err := r.accountRoleIDs(ctx, username)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var granted, denied []internal.Tasks
+	isDenied := map[string]bool{}
+	for _, id := range roles {
+		rt, err := r.search.GetRoleTaskByRole(ctx, id)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("search: %w", err)
+		}
+		granted = append(granted, rt.Tasks...)
+		for _, t := range rt.DeniedTasks {
+			denied = append(denied, t)
+			isDenied[t.Task] = true
+		}
+	}
+	var tasks []internal.Tasks
+	for _, task := range granted {
+		if !isDenied[task.Task] {
+			tasks = append(tasks, task)
 		}
 	}
-	return false, nil
+	return roles, tasks, denied, nil
+}
+
+// accountRoleIDs returns the ids of the roles assigned to the account, held through groups and inherited
+// from them.
+func (r *RBAC) accountRoleIDs(ctx context.Context, username string) ([]string, error) {
+	acrole, err := r.search.GetAccountRoleByAccount(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+	var direct []string
+	for _, value := range acrole.Roles {
+		direct = append(direct, value.Id)
+	}
+	groupRoles, err := r.groupRoleIDs(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	direct = append(direct, groupRoles...)
+	return r.effectiveRoleIDs(ctx, direct)
+}
+
+// groupRoleIDs returns the ids of the roles the account holds through its groups in the tenant the request
+// acts in, cached until the permissions change.
+func (r *RBAC) groupRoleIDs(ctx context.Context, username string) ([]string, error) {
+	key := "groups_" + internal.TenantFromContext(ctx) + "_" + username
+	return r.cachedRoleIDs(ctx, key, func() ([]string, error) {
+		ids, err := r.repo.GroupRoleIDs(ctx, username)
+		if err != nil {
+			return nil, fmt.Errorf("repo: %w", err)
+		}
+		internal.RecordLookup(ctx, "GroupRoleIDs", username, internal.SOURCE_DATABASE)
+		return ids, nil
+	})
 }
 func (r *RBAC) CreateAccount(ctx context.Context, account internal.Account, password string) (string, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Create")
@@ -148,6 +315,53 @@ func (r *RBAC) DeleteAccount(ctx context.Context, username string) error {
 	_ = r.msgBroker.AccountDeleted(ctx, username)
 	return nil
 }
+
+// BlockAccount blocks the account, revokes all of its sessions and publishes an event so the account
+// loses its permissions in the search index.
+func (r *RBAC) BlockAccount(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Block")
+	defer span.End()
+	err := r.repo.BlockAccount(ctx, username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	err = r.RevokeSessions(ctx, username)
+	if err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+	acc, err := r.repo.Account(ctx, username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.AccountBlocked(ctx, acc)
+	return nil
+}
+
+// UnblockAccount unblocks the account and publishes its account roles again so its permissions are restored.
+func (r *RBAC) UnblockAccount(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.Unblock")
+	defer span.End()
+	err := r.repo.UnblockAccount(ctx, username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	acc, err := r.repo.Account(ctx, username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.AccountUnblocked(ctx, acc)
+	// blocking drops the account roles of every tenant from the index, restore them all
+	ars, err := r.repo.AccountRolesByAccount(internal.NewTenantContext(ctx, ""), username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	for _, ar := range ars {
+		if ar.Scope.IsGlobal() {
+			_ = r.msgBroker.AccountRoleCreated(ctx, ar)
+		}
+	}
+	return nil
+}
 func (r *RBAC) ListAccount(ctx context.Context, args internal.ListArgs) (internal.ListAccount, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.List")
 	defer span.End()
@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"context"
+	"rbac/internal"
+	"rbac/internal/service"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// withRoles makes admin hold the roles, each role granting and denying the tasks given.
+func withRoles(f fakes, roles map[string]internal.RoleTaskByRole) {
+	var held []internal.Roles
+	for id := range roles {
+		held = append(held, internal.Roles{Id: id})
+	}
+	f.search.GetAccountRoleByAccountReturns(internal.AccountRoleByAccountResult{
+		Account: internal.Account{UserName: "admin"},
+		Roles:   held,
+	}, nil)
+	f.search.GetRoleTaskByRoleCalls(func(_ context.Context, id string) (internal.RoleTaskByRole, error) {
+		return roles[id], nil
+	})
+}
+
+func TestRBAC_IsAllowed_DenyOverrides(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	withRoles(f, map[string]internal.RoleTaskByRole{
+		"editor":  {Tasks: []internal.Tasks{{Task: "document.read"}, {Task: "document.write"}}},
+		"auditor": {DeniedTasks: []internal.Tasks{{Task: "document.write"}}},
+	})
+
+	allowed, err := svc.IsAllowed(ctx, "admin", "document.read")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, err = svc.IsAllowed(ctx, "admin", "document.write")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	allowed, err = svc.IsAllowed(ctx, "admin", "document.delete")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
+
+func TestRBAC_IsAllowedOn_DenyOverrides(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	withRoles(f, map[string]internal.RoleTaskByRole{
+		"editor": {Tasks: []internal.Tasks{{Task: "document.write"}}},
+	})
+	f.repo.RestrictedGrantsReturns([]internal.RestrictedGrant{{
+		Task:      "document.write",
+		RoleId:    "auditor",
+		TaskScope: internal.Scope{ResourceType: "document", ResourceId: "secret-*"},
+		Effect:    internal.EFFECT_DENY,
+	}}, nil)
+
+	allowed, err := svc.IsAllowedOn(ctx, "admin", "document.write", internal.Resource{Type: "document", Id: "public-1"})
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, err = svc.IsAllowedOn(ctx, "admin", "document.write", internal.Resource{Type: "document", Id: "secret-1"})
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
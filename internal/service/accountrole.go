@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"rbac/internal"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel/trace"
 )
@@ -12,16 +13,40 @@ import (
 func (r *RBAC) CreateAccountRole(ctx context.Context, accountRole internal.AccountRoles) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.Create")
 	defer span.End()
-	id, err := r.repo.CreateAccountRole(ctx, accountRole.Account.Id, accountRole.Role.Id)
+	if err := accountRole.Scope.Validate(); err != nil {
+		return err
+	}
+	if err := accountRole.Validity.Validate(); err != nil {
+		return err
+	}
+	if !accountRole.Validity.Until.IsZero() && !accountRole.Validity.Until.After(time.Now()) {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "valid until must be in the future")
+	}
+	id, err := r.repo.CreateAccountRole(ctx, accountRole.Account.Id, accountRole.Role.Id, accountRole.Scope, accountRole.Validity)
 	if err != nil {
 		return fmt.Errorf("repo: %w", err)
 	}
+	return r.accountRoleCreated(ctx, id)
+}
+
+// accountRoleCreated publishes the account role assigned in the repository and makes the tokens carrying
+// permission claims stale. The permissions changed whether the account role was published or not.
+func (r *RBAC) accountRoleCreated(ctx context.Context, id string) error {
 	ar, err := r.repo.AccountRole(ctx, id)
 	if err != nil {
 		return fmt.Errorf("repo: %w", err)
 	}
-	_ = r.msgBroker.AccountRoleCreated(ctx, ar)
-	return nil
+	var perr error
+	// scoped account roles are only evaluated by IsAllowedOn, indexing them would grant the role everywhere
+	if ar.Scope.IsGlobal() {
+		if err := r.msgBroker.AccountRoleCreated(ctx, ar); err != nil {
+			perr = fmt.Errorf("msgBroker: %w", err)
+		}
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return perr
 }
 func (r *RBAC) AccountRole(ctx context.Context, accountRoleId string) (internal.AccountRoles, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.AccountRole")
@@ -33,6 +58,9 @@ func (r *RBAC) AccountRole(ctx context.Context, accountRoleId string) (internal.
 			if err != nil {
 				return internal.AccountRoles{}, fmt.Errorf("get account: %w", err)
 			}
+			if !accRole.Scope.IsGlobal() {
+				return accRole, nil
+			}
 			//if you get here means account and profile has value and no error
 			err = r.search.IndexAccountRole(ctx, accRole)
 			if err != nil {
@@ -50,6 +78,26 @@ func (r *RBAC) AccountRoleByAccount(ctx context.Context, username string) (inter
 	if err != nil {
 		return internal.AccountRoleByAccountResult{}, fmt.Errorf("search: %w", err)
 	}
+	// roles held through groups are listed along with the assigned ones
+	groupRoles, err := r.groupRoleIDs(ctx, username)
+	if err != nil {
+		return internal.AccountRoleByAccountResult{}, err
+	}
+	seen := map[string]bool{}
+	for _, value := range role.Roles {
+		seen[value.Id] = true
+	}
+	for _, id := range groupRoles {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		gr, err := r.search.GetRole(ctx, id)
+		if err != nil {
+			return internal.AccountRoleByAccountResult{}, fmt.Errorf("search: %w", err)
+		}
+		role.Roles = append(role.Roles, gr)
+	}
 	return role, err
 }
 func (r *RBAC) AccountRoleByRole(ctx context.Context, id string) (internal.AccountRoleByRoleResult, error) {
@@ -68,7 +116,16 @@ func (r *RBAC) UpdateAccountRole(ctx context.Context, accountRole internal.Accou
 	if err != nil {
 		return fmt.Errorf("search: %w", err)
 	}
-	_ = r.msgBroker.AccountRoleUpdated(ctx, accountRole)
+	ar, err := r.repo.AccountRole(ctx, accountRole.Id)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	if ar.Scope.IsGlobal() {
+		_ = r.msgBroker.AccountRoleUpdated(ctx, ar)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
 	return err
 }
 func (r *RBAC) DeleteAccountRole(ctx context.Context, id string) error {
@@ -79,6 +136,9 @@ func (r *RBAC) DeleteAccountRole(ctx context.Context, id string) error {
 		return fmt.Errorf("repo: %w", err)
 	}
 	_ = r.msgBroker.AccountRoleDeleted(ctx, id)
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
 	return err
 }
 func (r *RBAC) ListAccountRole(ctx context.Context, args internal.ListArgs) (internal.ListAccountRole, error) {
@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"context"
+	"rbac/internal"
+	"rbac/internal/service"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func errExclusiveRoles() error {
+	return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the account would hold the exclusive roles requester, approver of payments")
+}
+
+func TestRBAC_CreateAccountRole(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	f.repo.CreateAccountRoleReturns("ar1", nil)
+	f.repo.AccountRoleReturns(internal.AccountRoles{Id: "ar1"}, nil)
+
+	err := svc.CreateAccountRole(ctx, internal.AccountRoles{
+		Account: internal.Account{Id: "a1"},
+		Role:    internal.Roles{Id: "requester"},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, f.msgBroker.AccountRoleCreatedCallCount())
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+}
+
+func TestRBAC_CreateAccountRole_ExclusiveRoles(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	f.repo.CreateAccountRoleReturns("", errExclusiveRoles())
+
+	err := svc.CreateAccountRole(ctx, internal.AccountRoles{
+		Account: internal.Account{Id: "a1"},
+		Role:    internal.Roles{Id: "approver"},
+	})
+	requireErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+
+	require.Equal(t, 0, f.repo.AccountRoleCallCount())
+	require.Equal(t, 0, f.msgBroker.AccountRoleCreatedCallCount())
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), version)
+}
+
+func TestRBAC_UpdateAccountRole_ExclusiveRoles(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	f.repo.UpdateAccountRoleReturns(errExclusiveRoles())
+
+	err := svc.UpdateAccountRole(ctx, internal.AccountRoles{
+		Id:      "ar1",
+		Account: internal.Account{Id: "a1"},
+		Role:    internal.Roles{Id: "approver"},
+	})
+	requireErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+
+	_, aid, rid, id := f.repo.UpdateAccountRoleArgsForCall(0)
+	require.Equal(t, "a1", aid)
+	require.Equal(t, "approver", rid)
+	require.Equal(t, "ar1", id)
+	require.Equal(t, 0, f.msgBroker.AccountRoleUpdatedCallCount())
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), version)
+}
+
+func TestRBAC_UpdateAccountRole(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	f.repo.AccountRoleReturns(internal.AccountRoles{
+		Id:       "ar1",
+		Account:  internal.Account{Id: "a1", UserName: "alice"},
+		Role:     internal.Roles{Id: "approver", TenantId: "t1"},
+		Validity: internal.Validity{Until: until},
+	}, nil)
+
+	err := svc.UpdateAccountRole(ctx, internal.AccountRoles{
+		Id:      "ar1",
+		Account: internal.Account{Id: "a1"},
+		Role:    internal.Roles{Id: "approver"},
+	})
+	require.NoError(t, err)
+
+	// the stored row is published, the request only carries the ids
+	_, ar := f.msgBroker.AccountRoleUpdatedArgsForCall(0)
+	require.Equal(t, "alice", ar.Account.UserName)
+	require.Equal(t, "t1", ar.Role.TenantId)
+	require.Equal(t, until, ar.Validity.Until)
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+}
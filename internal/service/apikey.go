@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+	"rbac/internal/tokenmaker"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// apiKeyPrefix marks API keys so they can be recognized when leaked, e.g. by secret scanners.
+	apiKeyPrefix = "rbac_"
+	// apiKeyDisplayLength is the number of leading characters stored in clear to tell keys apart.
+	apiKeyDisplayLength = 12
+)
+
+// CreateServiceAccount creates an account used for machine to machine calls, it has no password and
+// no profile and can only authenticate with API keys.
+func (r *RBAC) CreateServiceAccount(ctx context.Context, username string) (internal.Account, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.CreateServiceAccount")
+	defer span.End()
+	if username == "" {
+		return internal.Account{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "username is required")
+	}
+	acc, err := r.repo.CreateServiceAccount(ctx, username)
+	if err != nil {
+		return internal.Account{}, fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.AccountCreated(ctx, acc)
+	_ = r.msgBroker.ProfileCreated(ctx, acc.Profile)
+	return acc, nil
+}
+
+// CreateAPIKey issues a new API key for the account, the key is returned only once, a zero expiresAt
+// creates a key that never expires.
+func (r *RBAC) CreateAPIKey(ctx context.Context, username string, name string, expiresAt time.Time) (string, internal.APIKey, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "APIKey.Create")
+	defer span.End()
+	if !expiresAt.IsZero() && expiresAt.Before(time.Now()) {
+		return "", internal.APIKey{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "expiration must be in the future")
+	}
+	token, err := tokenmaker.NewOpaqueToken()
+	if err != nil {
+		return "", internal.APIKey{}, fmt.Errorf("new api key: %w", err)
+	}
+	key := apiKeyPrefix + token
+	apiKey, err := r.repo.CreateAPIKey(ctx, username, name, key[:apiKeyDisplayLength], tokenmaker.HashOpaqueToken(key), expiresAt)
+	if err != nil {
+		return "", internal.APIKey{}, fmt.Errorf("repo: %w", err)
+	}
+	return key, apiKey, nil
+}
+
+// APIKeys lists the API keys of the account, revoked and expired keys included.
+func (r *RBAC) APIKeys(ctx context.Context, username string) ([]internal.APIKey, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "APIKey.APIKeys")
+	defer span.End()
+	keys, err := r.repo.APIKeysByAccount(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revokes an API key of the account, the key is refused from the next request on.
+func (r *RBAC) RevokeAPIKey(ctx context.Context, username string, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "APIKey.Revoke")
+	defer span.End()
+	err := r.repo.RevokeAPIKey(ctx, username, id)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
+
+// AuthenticateAPIKey returns the username owning the API key, unknown, revoked and expired keys as
+// well as keys of blocked accounts are refused.
+func (r *RBAC) AuthenticateAPIKey(ctx context.Context, key string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "APIKey.Authenticate")
+	defer span.End()
+	apiKey, err := r.repo.APIKeyByHash(ctx, tokenmaker.HashOpaqueToken(key))
+	if err != nil {
+		if isErrorCode(err, internal.ErrorCodeNotFound) {
+			return "", internal.WrapErrorf(err, internal.ErrorCodeUnauthorized, "invalid api key")
+		}
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	if apiKey.IsRevoked() {
+		return "", internal.NewErrorf(internal.ErrorCodeUnauthorized, "api key is revoked")
+	}
+	if apiKey.IsExpired() {
+		return "", internal.NewErrorf(internal.ErrorCodeUnauthorized, "api key has expired")
+	}
+	acc, err := r.repo.Account(ctx, apiKey.Username)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	if acc.IsBlocked {
+		return "", internal.NewErrorf(internal.ErrorCodeUnauthorized, "account is blocked")
+	}
+	err = r.repo.TouchAPIKey(ctx, apiKey.Id)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	return apiKey.Username, nil
+}
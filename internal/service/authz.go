@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"rbac/internal"
+	"rbac/internal/condition"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CheckPermissions decides every check the way IsAllowed and IsAllowedOn do, the permissions and the
+// restricted grants of each subject are read once per batch. A check that can't be decided is refused with
+// its error, the other checks of the batch are still decided.
+func (r *RBAC) CheckPermissions(ctx context.Context, checks []internal.PermissionCheck) ([]internal.PermissionDecision, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Authz.CheckPermissions")
+	defer span.End()
+	if len(checks) > internal.MAX_PERMISSION_CHECKS {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "at most %d checks can be sent at once", internal.MAX_PERMISSION_CHECKS)
+	}
+	type subject struct {
+		granted map[string]bool
+		denied  map[string]bool
+		eval    *evaluator
+		err     error
+	}
+	decisions := make([]internal.PermissionDecision, len(checks))
+	tasks := map[string][]string{}
+	for i, check := range checks {
+		decisions[i].PermissionCheck = check
+		decisions[i].Err = check.Validate()
+		if decisions[i].Err == nil {
+			tasks[check.Subject] = append(tasks[check.Subject], check.Task)
+		}
+	}
+	subjects := map[string]*subject{}
+	now := time.Now()
+	for i, check := range checks {
+		if decisions[i].Err != nil {
+			continue
+		}
+		s, ok := subjects[check.Subject]
+		if !ok {
+			s = &subject{granted: map[string]bool{}, denied: map[string]bool{}}
+			_, granted, denied, err := r.accountPermissions(ctx, check.Subject)
+			s.err = err
+			for _, value := range granted {
+				s.granted[value] = true
+			}
+			for _, value := range denied {
+				s.denied[value] = true
+			}
+			s.eval = r.newEvaluator(check.Subject, tasks[check.Subject]...)
+			subjects[check.Subject] = s
+		}
+		if s.err != nil {
+			decisions[i].Err = s.err
+			continue
+		}
+		// the conditions are about the subject, the request of the caller says nothing about it
+		req := condition.Request{IP: check.IP, Time: check.Time}
+		if req.Time.IsZero() {
+			req.Time = now
+		}
+		v, err := s.eval.decide(ctx, question{
+			task:     check.Task,
+			resource: check.Resource,
+			request:  req,
+			granted:  s.granted[check.Task],
+			denied:   s.denied[check.Task],
+		})
+		decisions[i].Allowed = v.allowed
+		decisions[i].Err = err
+	}
+	return decisions, nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BreakGlass grants the emergency role to the account for the configured duration and records the activation
+// at once, the role is removed by the account role sweep once it expires. Every activation is published as a
+// high priority event.
+func (r *RBAC) BreakGlass(ctx context.Context, activation internal.BreakGlassActivation) (internal.BreakGlassActivation, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.Activate")
+	defer span.End()
+	if err := activation.Validate(); err != nil {
+		return internal.BreakGlassActivation{}, err
+	}
+	emergency, err := r.repo.IsEmergencyRole(ctx, activation.Role.Id)
+	if err != nil {
+		return internal.BreakGlassActivation{}, fmt.Errorf("repo: %w", err)
+	}
+	if !emergency {
+		return internal.BreakGlassActivation{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the role is not an emergency role")
+	}
+	held, err := r.repo.AccountRolesByAccount(ctx, activation.Account.UserName)
+	if err != nil {
+		return internal.BreakGlassActivation{}, fmt.Errorf("repo: %w", err)
+	}
+	for _, value := range held {
+		if value.Role.Id == activation.Role.Id {
+			return internal.BreakGlassActivation{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "the account already holds the role")
+		}
+	}
+	expiresAt := time.Now().Add(r.conf.BreakGlassDuration)
+	if !expiresAt.After(time.Now()) {
+		return internal.BreakGlassActivation{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "valid until must be in the future")
+	}
+	id, arid, err := r.repo.CreateBreakGlassActivation(ctx, activation.Account.UserName, activation.Role.Id, activation.Reason, expiresAt)
+	if err != nil {
+		return internal.BreakGlassActivation{}, fmt.Errorf("repo: %w", err)
+	}
+	// the alert is sent even when the account role couldn't be published
+	aerr := r.accountRoleCreated(ctx, arid)
+	res, err := r.repo.BreakGlassActivation(ctx, id)
+	if err != nil {
+		return internal.BreakGlassActivation{}, fmt.Errorf("repo: %w", err)
+	}
+	// the role is granted whether the alert was published or not, the caller must know nobody was alerted
+	if err := r.msgBroker.BreakGlassActivated(ctx, res); err != nil {
+		return res, fmt.Errorf("msgBroker: %w", err)
+	}
+	return res, aerr
+}
+
+// BreakGlassActivations returns the activations made since the time, newest first.
+func (r *RBAC) BreakGlassActivations(ctx context.Context, since time.Time) ([]internal.BreakGlassActivation, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.Activations")
+	defer span.End()
+	activations, err := r.repo.BreakGlassActivations(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return activations, nil
+}
+
+func (r *RBAC) EmergencyRoles(ctx context.Context) ([]internal.Roles, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.EmergencyRoles")
+	defer span.End()
+	roles, err := r.repo.EmergencyRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return roles, nil
+}
+
+// SetEmergencyRole sets whether accounts allowed to break glass can self-elevate to the role.
+func (r *RBAC) SetEmergencyRole(ctx context.Context, roleId string, emergency bool) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BreakGlass.SetEmergencyRole")
+	defer span.End()
+	err := r.repo.SetEmergencyRole(ctx, roleId, emergency)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"rbac/internal"
+	"rbac/internal/service"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRBAC_BreakGlass(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{BreakGlassDuration: time.Hour})
+	f.repo.IsEmergencyRoleReturns(true, nil)
+	f.repo.AccountRoleReturns(internal.AccountRoles{Id: "ar1"}, nil)
+	f.repo.CreateBreakGlassActivationReturns("bg1", "ar1", nil)
+	f.repo.BreakGlassActivationReturns(internal.BreakGlassActivation{Id: "bg1"}, nil)
+
+	before := time.Now()
+	res, err := svc.BreakGlass(ctx, internal.BreakGlassActivation{
+		Account: internal.Account{UserName: "oncall"},
+		Role:    internal.Roles{Id: "dba"},
+		Reason:  "database down",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "bg1", res.Id)
+
+	// the role is granted with the activation until it expires, the account role sweep removes it then
+	_, username, roleId, reason, expiresAt := f.repo.CreateBreakGlassActivationArgsForCall(0)
+	require.Equal(t, "oncall", username)
+	require.Equal(t, "dba", roleId)
+	require.Equal(t, "database down", reason)
+	require.False(t, expiresAt.Before(before.Add(time.Hour)))
+	require.False(t, expiresAt.After(time.Now().Add(time.Hour)))
+	require.Equal(t, 0, f.repo.CreateAccountRoleCallCount())
+
+	require.Equal(t, 1, f.msgBroker.BreakGlassActivatedCallCount())
+	_, activation := f.msgBroker.BreakGlassActivatedArgsForCall(0)
+	require.Equal(t, "bg1", activation.Id)
+	_, ar := f.msgBroker.AccountRoleCreatedArgsForCall(0)
+	require.Equal(t, "ar1", ar.Id)
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+}
+
+func TestRBAC_BreakGlass_NotPublished(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{BreakGlassDuration: time.Hour})
+	f.repo.IsEmergencyRoleReturns(true, nil)
+	f.repo.CreateBreakGlassActivationReturns("bg1", "ar1", nil)
+	f.repo.BreakGlassActivationReturns(internal.BreakGlassActivation{Id: "bg1"}, nil)
+	f.msgBroker.BreakGlassActivatedReturns(errors.New("broker down"))
+
+	res, err := svc.BreakGlass(ctx, internal.BreakGlassActivation{
+		Account: internal.Account{UserName: "oncall"},
+		Role:    internal.Roles{Id: "dba"},
+		Reason:  "database down",
+	})
+	require.Error(t, err)
+	require.Equal(t, "bg1", res.Id)
+}
+
+func TestRBAC_BreakGlass_Refused(t *testing.T) {
+	ctx := context.Background()
+	activation := internal.BreakGlassActivation{
+		Account: internal.Account{UserName: "oncall"},
+		Role:    internal.Roles{Id: "dba"},
+		Reason:  "database down",
+	}
+
+	t.Run("not an emergency role", func(t *testing.T) {
+		svc, f := newRBAC(t, service.Config{BreakGlassDuration: time.Hour})
+
+		_, err := svc.BreakGlass(ctx, activation)
+		requireErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+		require.Equal(t, 0, f.repo.CreateBreakGlassActivationCallCount())
+		require.Equal(t, 0, f.msgBroker.BreakGlassActivatedCallCount())
+	})
+
+	t.Run("role already held", func(t *testing.T) {
+		svc, f := newRBAC(t, service.Config{BreakGlassDuration: time.Hour})
+		f.repo.IsEmergencyRoleReturns(true, nil)
+		f.repo.AccountRolesByAccountReturns([]internal.AccountRoles{{Role: internal.Roles{Id: "dba"}}}, nil)
+
+		_, err := svc.BreakGlass(ctx, activation)
+		requireErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+		require.Equal(t, 0, f.repo.CreateBreakGlassActivationCallCount())
+		require.Equal(t, 0, f.msgBroker.BreakGlassActivatedCallCount())
+	})
+
+	t.Run("without reason", func(t *testing.T) {
+		svc, f := newRBAC(t, service.Config{BreakGlassDuration: time.Hour})
+		f.repo.IsEmergencyRoleReturns(true, nil)
+
+		_, err := svc.BreakGlass(ctx, internal.BreakGlassActivation{Role: internal.Roles{Id: "dba"}})
+		requireErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+		require.Equal(t, 0, f.repo.CreateBreakGlassActivationCallCount())
+	})
+}
+
+func TestRBAC_BreakGlass_Expiry(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{TokenPermissions: true})
+	f.repo.AccountTenantsReturns([]internal.Tenant{{Id: "t1"}}, nil)
+	withRoles(f, map[string]internal.RoleTaskByRole{
+		"dba": {Tasks: []internal.Tasks{{Task: "database.restore"}}},
+	})
+
+	token, err := svc.CreateToken(ctx, "admin")
+	require.NoError(t, err)
+	payload, err := svc.VerifyToken(token)
+	require.NoError(t, err)
+	require.True(t, payload.Permissions.Can("database.restore"))
+
+	stale, err := svc.IsPermissionStale(ctx, payload)
+	require.NoError(t, err)
+	require.False(t, stale)
+
+	// the emergency role expired, tokens carrying it must be issued again
+	f.repo.DeleteExpiredAccountRolesReturns([]string{"ar1"}, nil)
+	_, err = svc.SweepAccountRoles(ctx, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	_, id := f.msgBroker.AccountRoleDeletedArgsForCall(0)
+	require.Equal(t, "ar1", id)
+	stale, err = svc.IsPermissionStale(ctx, payload)
+	require.NoError(t, err)
+	require.True(t, stale)
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+	"rbac/internal/condition"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// evaluator decides the tasks of an account, IsAllowedOn, CheckPermissions and ExplainPermission all decide
+// through it. The restricted grants of the tasks it is asked about are loaded together the first time one of
+// them is needed and the profile of the account the first time a condition refers to it.
+type evaluator struct {
+	r        *RBAC
+	username string
+	tasks    []string
+	grants   map[string][]internal.RestrictedGrant
+	// attributes are the username and, once loaded, the profile attributes of the account
+	attributes map[string]string
+	// roleNames names the roles in the reasons, roles without a name are named by their id
+	roleNames map[string]string
+}
+
+// newEvaluator returns an evaluator of the account, the restricted grants of the tasks are loaded at once.
+func (r *RBAC) newEvaluator(username string, tasks ...string) *evaluator {
+	return &evaluator{
+		r:          r,
+		username:   username,
+		tasks:      tasks,
+		attributes: map[string]string{condition.AttrUsername: username},
+		roleNames:  map[string]string{},
+	}
+}
+
+// question is a task asked about along with how the roles of the account grant or deny it without scope nor
+// condition. The granting and denying roles are only known when explaining.
+type question struct {
+	task     string
+	resource *internal.Resource
+	// request is the time and ip of the account the conditions are evaluated against
+	request      condition.Request
+	granted      bool
+	denied       bool
+	grantingRole string
+	denyingRole  string
+}
+
+// verdict is a decision along with why it was reached and the restricted grants it was derived from.
+type verdict struct {
+	allowed bool
+	reason  string
+	grants  []internal.ExplainedGrant
+}
+
+// decide decides the question: a role denying the task refuses it, a role granting it allows it when no
+// resource is given, otherwise a restricted grant covering the resource decides, a deny first.
+func (e *evaluator) decide(ctx context.Context, q question) (verdict, error) {
+	if q.denied {
+		return verdict{reason: fmt.Sprintf("denied by %s", roleReason(q.denyingRole))}, nil
+	}
+	// deny role tasks can't have a condition, without resource restricted grants can only add to granted
+	if q.granted && q.resource == nil {
+		return verdict{allowed: true, reason: fmt.Sprintf("granted by %s", roleReason(q.grantingRole))}, nil
+	}
+	grants, err := e.restrictedGrants(ctx, q.task)
+	if err != nil {
+		return verdict{}, err
+	}
+	v := verdict{grants: make([]internal.ExplainedGrant, len(grants))}
+	for i, grant := range grants {
+		v.grants[i].Grant = grant
+		v.grants[i].Covers = grantCovers(e.username, grant, q.resource)
+		// deny role tasks can't have a condition
+		if !v.grants[i].Covers || grant.Effect == internal.EFFECT_DENY {
+			v.grants[i].Holds = grant.Condition == ""
+			continue
+		}
+		v.grants[i].Holds, err = e.conditionHolds(ctx, grant.Condition, q.request)
+		if err != nil {
+			return verdict{}, err
+		}
+	}
+	v.allowed, v.reason = e.grantsDecision(v.grants, q)
+	return v, nil
+}
+
+// grantsDecision applies the restricted grants to the question and tells why.
+func (e *evaluator) grantsDecision(grants []internal.ExplainedGrant, q question) (bool, string) {
+	for _, value := range grants {
+		if value.Covers && value.Grant.Effect == internal.EFFECT_DENY {
+			return false, fmt.Sprintf("denied on the resource by role %s", e.roleName(value.Grant.RoleId))
+		}
+	}
+	if q.granted {
+		return true, fmt.Sprintf("granted by %s", roleReason(q.grantingRole))
+	}
+	covered := false
+	for _, value := range grants {
+		if !value.Covers {
+			continue
+		}
+		covered = true
+		if value.Holds && value.Grant.Condition != "" {
+			return true, fmt.Sprintf("granted by role %s, its condition holds", e.roleName(value.Grant.RoleId))
+		}
+		if value.Holds {
+			return true, fmt.Sprintf("granted on the resource by role %s", e.roleName(value.Grant.RoleId))
+		}
+	}
+	if covered {
+		return false, "the conditions of the grants covering the resource don't hold"
+	}
+	return false, "no role grants the task"
+}
+
+// roleReason names the role in a reason, the role isn't known when deciding from the permissions alone.
+func roleReason(name string) string {
+	if name == "" {
+		return "a role of the account"
+	}
+	return "role " + name
+}
+
+func (e *evaluator) roleName(id string) string {
+	if name := e.roleNames[id]; name != "" {
+		return name
+	}
+	return id
+}
+
+// restrictedGrants returns the restricted grants of the task, the grants of every task of the evaluator are
+// loaded with the first call.
+func (e *evaluator) restrictedGrants(ctx context.Context, task string) ([]internal.RestrictedGrant, error) {
+	if e.grants != nil {
+		return e.grants[task], nil
+	}
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Account.RestrictedGrants")
+	defer span.End()
+	tasks := e.tasks
+	if len(tasks) == 0 {
+		tasks = []string{task}
+	}
+	grants, err := e.r.repo.RestrictedGrants(ctx, e.username, tasks)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	internal.RecordLookup(ctx, "RestrictedGrants", e.username, internal.SOURCE_DATABASE)
+	e.grants = map[string][]internal.RestrictedGrant{}
+	for _, grant := range grants {
+		e.grants[grant.Task] = append(e.grants[grant.Task], grant)
+	}
+	return e.grants[task], nil
+}
+
+// grantCovers returns true when the scopes of the grant cover the resource, without resource only grants
+// without scope do.
+func grantCovers(username string, grant internal.RestrictedGrant, resource *internal.Resource) bool {
+	if resource == nil {
+		return grant.RoleScope.IsGlobal() && grant.TaskScope.IsGlobal()
+	}
+	return grant.Matches(username, *resource)
+}
+
+// conditionHolds evaluates the condition of a grant against the attributes of the account and the request.
+// An empty condition always holds.
+func (e *evaluator) conditionHolds(ctx context.Context, cond string, req condition.Request) (bool, error) {
+	if cond == "" {
+		return true, nil
+	}
+	// conditions are validated when the role task is created, one that doesn't parse grants nothing
+	c, err := condition.Parse(cond)
+	if err != nil {
+		return false, nil
+	}
+	if err := e.loadProfileAttributes(ctx, c); err != nil {
+		return false, err
+	}
+	return c.Eval(condition.Env{
+		Time:       req.Time,
+		IP:         req.IP,
+		Attributes: e.attributes,
+	}), nil
+}
+
+// loadProfileAttributes adds the profile of the account to the attributes the first time a condition refers
+// to it.
+func (e *evaluator) loadProfileAttributes(ctx context.Context, c *condition.Condition) error {
+	if _, ok := e.attributes[condition.AttrEmail]; ok {
+		return nil
+	}
+	for _, attr := range c.Attributes() {
+		switch attr {
+		case condition.AttrEmail, condition.AttrMobile, condition.AttrFirstName, condition.AttrLastName:
+			acc, err := e.r.repo.Account(ctx, e.username)
+			if err != nil {
+				return fmt.Errorf("repo: %w", err)
+			}
+			e.attributes[condition.AttrEmail] = acc.Profile.Email
+			e.attributes[condition.AttrMobile] = acc.Profile.Mobile
+			e.attributes[condition.AttrFirstName] = acc.Profile.First_Name
+			e.attributes[condition.AttrLastName] = acc.Profile.Last_Name
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (r *RBAC) CreateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.Create")
+	defer span.End()
+	if err := set.Validate(); err != nil {
+		return "", err
+	}
+	id, err := r.repo.CreateExclusiveRoleSet(ctx, set)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	return id, nil
+}
+
+func (r *RBAC) ExclusiveRoleSet(ctx context.Context, id string) (internal.ExclusiveRoleSet, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.ExclusiveRoleSet")
+	defer span.End()
+	set, err := r.repo.ExclusiveRoleSet(ctx, id)
+	if err != nil {
+		return internal.ExclusiveRoleSet{}, fmt.Errorf("repo: %w", err)
+	}
+	return set, nil
+}
+
+func (r *RBAC) ExclusiveRoleSets(ctx context.Context) ([]internal.ExclusiveRoleSet, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.ExclusiveRoleSets")
+	defer span.End()
+	sets, err := r.repo.ExclusiveRoleSets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return sets, nil
+}
+
+// UpdateExclusiveRoleSet renames the set and replaces its roles, accounts already holding several of them
+// show up in RoleConflicts.
+func (r *RBAC) UpdateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.Update")
+	defer span.End()
+	if err := set.Validate(); err != nil {
+		return err
+	}
+	err := r.repo.UpdateExclusiveRoleSet(ctx, set)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
+
+func (r *RBAC) DeleteExclusiveRoleSet(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.Delete")
+	defer span.End()
+	err := r.repo.DeleteExclusiveRoleSet(ctx, id)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
+
+// RoleConflicts returns the accounts holding more than one role of an exclusive role set. Account role
+// assignments are checked against the sets, conflicts come from group memberships, group roles, role
+// inheritance or sets created after the roles were held.
+func (r *RBAC) RoleConflicts(ctx context.Context) ([]internal.RoleConflict, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ExclusiveRoleSet.RoleConflicts")
+	defer span.End()
+	conflicts, err := r.repo.RoleConflicts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return conflicts, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+	"rbac/internal/condition"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ExplainPermission decides whether the account may perform the task, on the resource when one is given, the
+// way IsAllowedOn does and returns how the decision was derived. The permissions are read from the stored
+// roles rather than from token claims and conditions are evaluated against the explain request itself.
+func (r *RBAC) ExplainPermission(ctx context.Context, username string, task string, resource *internal.Resource) (internal.Explanation, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Authz.ExplainPermission")
+	defer span.End()
+	ctx, lookups := internal.NewLookupContext(ctx)
+	exp := internal.Explanation{
+		Username: username,
+		Task:     task,
+		Resource: resource,
+	}
+	roles, err := r.explainRoles(ctx, username)
+	if err != nil {
+		return internal.Explanation{}, err
+	}
+	var granting, denying string
+	for i := range roles {
+		rt, err := r.search.GetRoleTaskByRole(ctx, roles[i].Role.Id)
+		if err != nil {
+			return internal.Explanation{}, fmt.Errorf("search: %w", err)
+		}
+		if rt.Role.Id != "" {
+			roles[i].Role = rt.Role
+		}
+		for _, value := range rt.Tasks {
+			roles[i].Grants = roles[i].Grants || value.Task == task
+		}
+		for _, value := range rt.DeniedTasks {
+			roles[i].Denies = roles[i].Denies || value.Task == task
+		}
+		if roles[i].Grants && granting == "" {
+			granting = roles[i].Role.Role
+		}
+		if roles[i].Denies && denying == "" {
+			denying = roles[i].Role.Role
+		}
+	}
+	exp.Roles = roles
+	e := r.newEvaluator(username, task)
+	for _, value := range roles {
+		e.roleNames[value.Role.Id] = value.Role.Role
+	}
+	v, err := e.decide(ctx, question{
+		task:         task,
+		resource:     resource,
+		request:      condition.FromContext(ctx),
+		granted:      granting != "",
+		denied:       denying != "",
+		grantingRole: granting,
+		denyingRole:  denying,
+	})
+	if err != nil {
+		return internal.Explanation{}, err
+	}
+	exp.Allowed = v.allowed
+	exp.Reason = v.reason
+	exp.Grants = v.grants
+	exp.Lookups = lookups.All()
+	return exp, nil
+}
+
+// explainRoles returns the roles of the account, the ones assigned to it first, then the ones held through
+// groups and last the ones they inherit.
+func (r *RBAC) explainRoles(ctx context.Context, username string) ([]internal.ExplainedRole, error) {
+	var roles []internal.ExplainedRole
+	seen := map[string]bool{}
+	add := func(id string, via string, from string) {
+		if seen[id] {
+			return
+		}
+		seen[id] = true
+		roles = append(roles, internal.ExplainedRole{
+			Role:          internal.Roles{Id: id},
+			Via:           via,
+			InheritedFrom: from,
+		})
+	}
+	acrole, err := r.search.GetAccountRoleByAccount(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+	for _, value := range acrole.Roles {
+		add(value.Id, internal.ROLE_VIA_ACCOUNT, "")
+	}
+	groupRoles, err := r.groupRoleIDs(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range groupRoles {
+		add(id, internal.ROLE_VIA_GROUP, "")
+	}
+	// inherited roles are resolved transitively, the held roles are enough to find all of them
+	held := len(roles)
+	for i := 0; i < held; i++ {
+		id := roles[i].Role.Id
+		inherited, err := r.repo.InheritedRoleIDs(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("repo: %w", err)
+		}
+		internal.RecordLookup(ctx, "InheritedRoleIDs", id, internal.SOURCE_DATABASE)
+		for _, value := range inherited {
+			add(value, internal.ROLE_VIA_INHERITANCE, id)
+		}
+	}
+	return roles, nil
+}
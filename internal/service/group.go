@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (r *RBAC) CreateGroup(ctx context.Context, group internal.Group) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Create")
+	defer span.End()
+	if err := group.Validate(); err != nil {
+		return "", err
+	}
+	id, err := r.repo.CreateGroup(ctx, group.Name)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	return id, nil
+}
+
+func (r *RBAC) Group(ctx context.Context, id string) (internal.Group, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Group")
+	defer span.End()
+	group, err := r.repo.Group(ctx, id)
+	if err != nil {
+		return internal.Group{}, fmt.Errorf("repo: %w", err)
+	}
+	return group, nil
+}
+
+func (r *RBAC) Groups(ctx context.Context) ([]internal.Group, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Groups")
+	defer span.End()
+	groups, err := r.repo.Groups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return groups, nil
+}
+
+func (r *RBAC) UpdateGroup(ctx context.Context, group internal.Group) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Update")
+	defer span.End()
+	if err := group.Validate(); err != nil {
+		return err
+	}
+	err := r.repo.UpdateGroup(ctx, group.Id, group.Name)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
+
+// DeleteGroup deletes the group, its members lose the roles they held through it.
+func (r *RBAC) DeleteGroup(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Delete")
+	defer span.End()
+	ids, err := r.repo.DeleteGroup(ctx, id)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	for _, value := range ids {
+		_ = r.msgBroker.GroupMemberRemoved(ctx, value)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddGroupMember adds the account to the group, it holds the roles of the group and of the groups
+// containing it from then on.
+func (r *RBAC) AddGroupMember(ctx context.Context, groupId string, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.AddMember")
+	defer span.End()
+	member, err := r.repo.AddGroupMember(ctx, groupId, username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.GroupMemberAdded(ctx, member)
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RBAC) RemoveGroupMember(ctx context.Context, groupId string, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.RemoveMember")
+	defer span.End()
+	id, err := r.repo.RemoveGroupMember(ctx, groupId, username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.GroupMemberRemoved(ctx, id)
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GroupMembers returns the accounts directly member of the group.
+func (r *RBAC) GroupMembers(ctx context.Context, groupId string) ([]internal.GroupMember, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Members")
+	defer span.End()
+	members, err := r.repo.GroupMembers(ctx, groupId)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return members, nil
+}
+
+// AddMemberGroup nests the member group in the group, the members of the member group hold the roles of
+// the group as well.
+func (r *RBAC) AddMemberGroup(ctx context.Context, groupId string, memberGroupId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.AddMemberGroup")
+	defer span.End()
+	_, err := r.repo.AddMemberGroup(ctx, groupId, memberGroupId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RBAC) RemoveMemberGroup(ctx context.Context, groupId string, memberGroupId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.RemoveMemberGroup")
+	defer span.End()
+	err := r.repo.RemoveMemberGroup(ctx, groupId, memberGroupId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MemberGroups returns the groups directly nested in the group.
+func (r *RBAC) MemberGroups(ctx context.Context, groupId string) ([]internal.Group, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.MemberGroups")
+	defer span.End()
+	groups, err := r.repo.MemberGroups(ctx, groupId)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return groups, nil
+}
+
+func (r *RBAC) AddGroupRole(ctx context.Context, groupId string, roleId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.AddRole")
+	defer span.End()
+	_, err := r.repo.AddGroupRole(ctx, groupId, roleId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RBAC) RemoveGroupRole(ctx context.Context, groupId string, roleId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.RemoveRole")
+	defer span.End()
+	err := r.repo.RemoveGroupRole(ctx, groupId, roleId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GroupRoles returns the roles directly assigned to the group.
+func (r *RBAC) GroupRoles(ctx context.Context, groupId string) ([]internal.Roles, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Group.Roles")
+	defer span.End()
+	roles, err := r.repo.GroupRoles(ctx, groupId)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return roles, nil
+}
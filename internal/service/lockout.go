@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxBackoffShift caps the exponential backoff so the shift can't overflow.
+const maxBackoffShift = 30
+
+func usernameLockoutKey(username string) string {
+	return "username:" + username
+}
+
+func ipLockoutKey(ip string) string {
+	return "ip:" + ip
+}
+
+func loginKeys(username string, ip string) []string {
+	keys := []string{usernameLockoutKey(username)}
+	if ip != "" {
+		keys = append(keys, ipLockoutKey(ip))
+	}
+	return keys
+}
+
+func (r *RBAC) checkLoginLockout(ctx context.Context, keys []string) error {
+	if r.conf.LoginMaxAttempts <= 0 {
+		return nil
+	}
+	for _, key := range keys {
+		lockout, err := r.attempts.Lockout(ctx, key)
+		if err != nil {
+			return fmt.Errorf("login attempts: %w", err)
+		}
+		if lockout.IsLocked() {
+			return internal.NewErrorf(internal.ErrorCodeTooManyRequests, "too many failed login attempts, try again later")
+		}
+	}
+	return nil
+}
+
+// loginFailed counts the failure, every failure delays the next attempt twice as long as the previous one
+// and reaching LoginMaxAttempts locks logins for LoginLockoutDuration.
+func (r *RBAC) loginFailed(ctx context.Context, keys []string) error {
+	if r.conf.LoginMaxAttempts <= 0 {
+		return nil
+	}
+	for _, key := range keys {
+		failures, err := r.attempts.AddFailure(ctx, key, r.conf.LoginAttemptWindow)
+		if err != nil {
+			return err
+		}
+		locked := failures >= int64(r.conf.LoginMaxAttempts)
+		wait := r.conf.LoginLockoutDuration
+		if !locked {
+			shift := failures - 1
+			if shift > maxBackoffShift {
+				shift = maxBackoffShift
+			}
+			if backoff := r.conf.LoginBackoff << shift; backoff < wait {
+				wait = backoff
+			}
+		}
+		if wait <= 0 {
+			continue
+		}
+		until := time.Now().Add(wait)
+		if err := r.attempts.Lock(ctx, key, until); err != nil {
+			return err
+		}
+		if locked {
+			_ = r.msgBroker.AccountLocked(ctx, internal.Lockout{
+				Key:         key,
+				Failures:    failures,
+				LockedUntil: until,
+			})
+		}
+	}
+	return nil
+}
+
+func (r *RBAC) loginSucceeded(ctx context.Context, keys []string) error {
+	if r.conf.LoginMaxAttempts <= 0 {
+		return nil
+	}
+	for _, key := range keys {
+		if err := r.attempts.Clear(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AccountLockout returns the failed logins recorded for the username.
+func (r *RBAC) AccountLockout(ctx context.Context, username string) (internal.Lockout, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Lockout.AccountLockout")
+	defer span.End()
+	lockout, err := r.attempts.Lockout(ctx, usernameLockoutKey(username))
+	if err != nil {
+		return internal.Lockout{}, fmt.Errorf("login attempts: %w", err)
+	}
+	return lockout, nil
+}
+
+// ClearAccountLockout forgets the failed logins of the username and lifts its lock.
+func (r *RBAC) ClearAccountLockout(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Lockout.ClearAccountLockout")
+	defer span.End()
+	err := r.attempts.Clear(ctx, usernameLockoutKey(username))
+	if err != nil {
+		return fmt.Errorf("login attempts: %w", err)
+	}
+	return nil
+}
+
+// IPLockout returns the failed logins recorded for the IP address.
+func (r *RBAC) IPLockout(ctx context.Context, ip string) (internal.Lockout, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Lockout.IPLockout")
+	defer span.End()
+	lockout, err := r.attempts.Lockout(ctx, ipLockoutKey(ip))
+	if err != nil {
+		return internal.Lockout{}, fmt.Errorf("login attempts: %w", err)
+	}
+	return lockout, nil
+}
+
+// ClearIPLockout forgets the failed logins of the IP address and lifts its lock.
+func (r *RBAC) ClearIPLockout(ctx context.Context, ip string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Lockout.ClearIPLockout")
+	defer span.End()
+	err := r.attempts.Clear(ctx, ipLockoutKey(ip))
+	if err != nil {
+		return fmt.Errorf("login attempts: %w", err)
+	}
+	return nil
+}
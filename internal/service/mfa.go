@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"rbac/internal"
+	"rbac/internal/tokenmaker"
+	"rbac/internal/totp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// mfaMaxAttempts is the number of wrong codes after which a pending login is discarded.
+	mfaMaxAttempts    = 5
+	recoveryCodeCount = 10
+)
+
+func mfaChallengeKey(id string) string {
+	return "mfa:" + id
+}
+
+func isErrorCode(err error, code internal.ErrorCode) bool {
+	var ierr *internal.Error
+	return errors.As(err, &ierr) && ierr.Code() == code
+}
+
+// MFAStatus tells whether the account has MFA enabled and whether one of its roles requires it.
+func (r *RBAC) MFAStatus(ctx context.Context, username string) (internal.MFAStatus, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.Status")
+	defer span.End()
+	status := internal.MFAStatus{}
+	mfa, err := r.repo.MFA(ctx, username)
+	if err != nil && !isErrorCode(err, internal.ErrorCodeNotFound) {
+		return internal.MFAStatus{}, fmt.Errorf("repo: %w", err)
+	}
+	status.Enrolled = err == nil && mfa.IsConfirmed()
+	status.Required, err = r.repo.IsMFARequired(ctx, username)
+	if err != nil {
+		return internal.MFAStatus{}, fmt.Errorf("repo: %w", err)
+	}
+	return status, nil
+}
+
+// CreateMFAChallenge returns a short lived token standing for a login waiting for its second factor.
+func (r *RBAC) CreateMFAChallenge(ctx context.Context, username string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.CreateChallenge")
+	defer span.End()
+	token, err := tokenmaker.NewOpaqueToken()
+	if err != nil {
+		return "", fmt.Errorf("new mfa token: %w", err)
+	}
+	err = r.sessions.CreateMFAChallenge(ctx, tokenmaker.HashOpaqueToken(token), username, time.Now().Add(r.conf.MFAChallengeExpiration))
+	if err != nil {
+		return "", fmt.Errorf("sessions: %w", err)
+	}
+	return token, nil
+}
+
+func (r *RBAC) mfaChallengeUsername(ctx context.Context, challenge string) (string, error) {
+	username, err := r.sessions.MFAChallenge(ctx, tokenmaker.HashOpaqueToken(challenge))
+	if err != nil {
+		return "", fmt.Errorf("sessions: %w", err)
+	}
+	if username == "" {
+		return "", internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid mfa token")
+	}
+	return username, nil
+}
+
+// EnrollMFA starts a TOTP enrolment, it has to be confirmed with a code before it is enforced.
+func (r *RBAC) EnrollMFA(ctx context.Context, username string) (internal.MFAEnrollment, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.Enroll")
+	defer span.End()
+	mfa, err := r.repo.MFA(ctx, username)
+	if err != nil && !isErrorCode(err, internal.ErrorCodeNotFound) {
+		return internal.MFAEnrollment{}, fmt.Errorf("repo: %w", err)
+	}
+	if err == nil && mfa.IsConfirmed() {
+		return internal.MFAEnrollment{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "mfa is already enabled")
+	}
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return internal.MFAEnrollment{}, fmt.Errorf("new secret: %w", err)
+	}
+	err = r.repo.EnrollMFA(ctx, username, secret)
+	if err != nil {
+		return internal.MFAEnrollment{}, fmt.Errorf("repo: %w", err)
+	}
+	return internal.MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(r.conf.MFAIssuer, username, secret),
+	}, nil
+}
+
+// EnrollMFAWithChallenge starts the enrolment of an account whose roles require MFA, before its login is completed.
+func (r *RBAC) EnrollMFAWithChallenge(ctx context.Context, challenge string) (internal.MFAEnrollment, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.EnrollWithChallenge")
+	defer span.End()
+	username, err := r.mfaChallengeUsername(ctx, challenge)
+	if err != nil {
+		return internal.MFAEnrollment{}, err
+	}
+	return r.EnrollMFA(ctx, username)
+}
+
+// ConfirmMFA enables the pending enrolment and returns the recovery codes, they are only available once.
+func (r *RBAC) ConfirmMFA(ctx context.Context, username string, code string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.Confirm")
+	defer span.End()
+	mfa, err := r.repo.MFA(ctx, username)
+	if err != nil {
+		if isErrorCode(err, internal.ErrorCodeNotFound) {
+			return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "mfa enrolment not started")
+		}
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	if mfa.IsConfirmed() {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "mfa is already enabled")
+	}
+	step, ok, err := totp.Validate(mfa.Secret, code, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("validate code: %w", err)
+	}
+	if !ok {
+		return nil, internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid mfa code")
+	}
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		codes[i], err = totp.NewRecoveryCode()
+		if err != nil {
+			return nil, fmt.Errorf("new recovery code: %w", err)
+		}
+		hashes[i] = tokenmaker.HashOpaqueToken(codes[i])
+	}
+	err = r.repo.ConfirmMFA(ctx, username, step, hashes)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return codes, nil
+}
+
+// VerifyMFA completes a pending login using a TOTP or a recovery code and returns the username. When the
+// account was enrolling, the enrolment is confirmed and its recovery codes are returned as well. Wrong codes
+// count as failed logins, the failures of the login are only cleared once the code is right.
+func (r *RBAC) VerifyMFA(ctx context.Context, challenge string, code string, ip string) (string, []string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.Verify")
+	defer span.End()
+	username, err := r.mfaChallengeUsername(ctx, challenge)
+	if err != nil {
+		return "", nil, err
+	}
+	keys := loginKeys(username, ip)
+	err = r.checkLoginLockout(ctx, keys)
+	if err != nil {
+		return "", nil, err
+	}
+	id := tokenmaker.HashOpaqueToken(challenge)
+	mfa, err := r.repo.MFA(ctx, username)
+	if err != nil {
+		if isErrorCode(err, internal.ErrorCodeNotFound) {
+			return "", nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "mfa enrolment not started")
+		}
+		return "", nil, fmt.Errorf("repo: %w", err)
+	}
+	var recoveryCodes []string
+	if mfa.IsConfirmed() {
+		err = r.checkMFACode(ctx, username, mfa, code)
+	} else {
+		recoveryCodes, err = r.ConfirmMFA(ctx, username, code)
+	}
+	if err != nil {
+		if isErrorCode(err, internal.ErrorCodeUnauthorized) {
+			if ferr := r.mfaFailed(ctx, id); ferr != nil {
+				return "", nil, ferr
+			}
+			if ferr := r.loginFailed(ctx, keys); ferr != nil {
+				return "", nil, fmt.Errorf("login attempts: %w", ferr)
+			}
+		}
+		return "", nil, err
+	}
+	err = r.sessions.DeleteMFAChallenge(ctx, id)
+	if err != nil {
+		return "", nil, fmt.Errorf("sessions: %w", err)
+	}
+	err = r.attempts.Clear(ctx, mfaChallengeKey(id))
+	if err != nil {
+		return "", nil, fmt.Errorf("login attempts: %w", err)
+	}
+	err = r.loginSucceeded(ctx, keys)
+	if err != nil {
+		return "", nil, fmt.Errorf("login attempts: %w", err)
+	}
+	return username, recoveryCodes, nil
+}
+
+func (r *RBAC) checkMFACode(ctx context.Context, username string, mfa internal.MFA, code string) error {
+	step, ok, err := totp.Validate(mfa.Secret, code, time.Now())
+	if err != nil {
+		return fmt.Errorf("validate code: %w", err)
+	}
+	if ok {
+		err = r.repo.UseMFAStep(ctx, username, step)
+	} else {
+		err = r.repo.UseMFARecoveryCode(ctx, username, tokenmaker.HashOpaqueToken(strings.ToLower(strings.TrimSpace(code))))
+	}
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
+
+// mfaFailed discards the pending login once too many wrong codes were tried.
+func (r *RBAC) mfaFailed(ctx context.Context, id string) error {
+	failures, err := r.attempts.AddFailure(ctx, mfaChallengeKey(id), r.conf.MFAChallengeExpiration)
+	if err != nil {
+		return fmt.Errorf("login attempts: %w", err)
+	}
+	if failures < mfaMaxAttempts {
+		return nil
+	}
+	err = r.sessions.DeleteMFAChallenge(ctx, id)
+	if err != nil {
+		return fmt.Errorf("sessions: %w", err)
+	}
+	return nil
+}
+
+// DisableMFA removes the enrolment and the recovery codes of the account.
+func (r *RBAC) DisableMFA(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.Disable")
+	defer span.End()
+	err := r.repo.DeleteMFA(ctx, username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
+
+// DisableOwnMFA removes the enrolment of the account on its own behalf, a current TOTP code is required so
+// that a stolen session can't strip the second factor.
+func (r *RBAC) DisableOwnMFA(ctx context.Context, username string, code string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.DisableOwn")
+	defer span.End()
+	mfa, err := r.repo.MFA(ctx, username)
+	if err != nil {
+		if isErrorCode(err, internal.ErrorCodeNotFound) {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "mfa is not enabled")
+		}
+		return fmt.Errorf("repo: %w", err)
+	}
+	if !mfa.IsConfirmed() {
+		return r.DisableMFA(ctx, username)
+	}
+	step, ok, err := totp.Validate(mfa.Secret, code, time.Now())
+	if err != nil {
+		return fmt.Errorf("validate code: %w", err)
+	}
+	if !ok {
+		return internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid mfa code")
+	}
+	err = r.repo.UseMFAStep(ctx, username, step)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return r.DisableMFA(ctx, username)
+}
+
+// SetRoleMFARequired sets whether accounts having the role must use MFA to login.
+func (r *RBAC) SetRoleMFARequired(ctx context.Context, roleId string, required bool) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "MFA.SetRoleRequired")
+	defer span.End()
+	err := r.repo.SetRoleMFARequired(ctx, roleId, required)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
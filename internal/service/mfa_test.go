@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"rbac/internal"
+	"rbac/internal/service"
+	"rbac/internal/totp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newMFAConfig() service.Config {
+	return service.Config{
+		LoginMaxAttempts:       3,
+		LoginAttemptWindow:     time.Hour,
+		LoginLockoutDuration:   time.Hour,
+		MFAChallengeExpiration: time.Minute,
+	}
+}
+
+// withMFA enrolls alice and fails one of her logins before the right password is sent.
+func withMFA(t *testing.T, svc *service.RBAC, f fakes) string {
+	t.Helper()
+	ctx := context.Background()
+	secret, err := totp.NewSecret()
+	require.NoError(t, err)
+	f.repo.MFAReturns(internal.MFA{Secret: secret, ConfirmedAt: time.Now()}, nil)
+	f.repo.UseMFARecoveryCodeReturns(internal.NewErrorf(internal.ErrorCodeUnauthorized, "invalid mfa code"))
+
+	f.repo.LoginReturns(errors.New("wrong password"))
+	require.Error(t, svc.Login(ctx, "alice", "wrong", "10.0.0.1"))
+	f.repo.LoginReturns(nil)
+	require.NoError(t, svc.Login(ctx, "alice", "secret", "10.0.0.1"))
+
+	// the password alone doesn't clear the failures
+	lockout, err := svc.AccountLockout(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), lockout.Failures)
+	return secret
+}
+
+func TestRBAC_VerifyMFA(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, newMFAConfig())
+	secret := withMFA(t, svc, f)
+	challenge, err := svc.CreateMFAChallenge(ctx, "alice")
+	require.NoError(t, err)
+
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+	username, _, err := svc.VerifyMFA(ctx, challenge, code, "10.0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, "alice", username)
+
+	lockout, err := svc.AccountLockout(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), lockout.Failures)
+}
+
+func TestRBAC_VerifyMFA_Lockout(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, newMFAConfig())
+	secret := withMFA(t, svc, f)
+	challenge, err := svc.CreateMFAChallenge(ctx, "alice")
+	require.NoError(t, err)
+
+	// wrong codes count against the lockout of the account along with the wrong password
+	for i := 0; i < 2; i++ {
+		_, _, err = svc.VerifyMFA(ctx, challenge, "wrong", "10.0.0.1")
+		requireErrorCode(t, err, internal.ErrorCodeUnauthorized)
+	}
+	lockout, err := svc.AccountLockout(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), lockout.Failures)
+	require.True(t, lockout.IsLocked())
+
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+	_, _, err = svc.VerifyMFA(ctx, challenge, code, "10.0.0.1")
+	requireErrorCode(t, err, internal.ErrorCodeTooManyRequests)
+	require.Equal(t, 0, f.repo.UseMFAStepCallCount())
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"rbac/internal"
+	"rbac/internal/tokenmaker"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ForgotPassword sends a single use password reset token to the owner of the account. Unknown usernames
+// are ignored so callers can't find out which accounts exist.
+func (r *RBAC) ForgotPassword(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Password.Forgot")
+	defer span.End()
+	acc, err := r.repo.Account(ctx, username)
+	if err != nil {
+		var ierr *internal.Error
+		if errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound {
+			return nil
+		}
+		return fmt.Errorf("repo: %w", err)
+	}
+	if acc.IsBlocked || acc.IsServiceAccount {
+		return nil
+	}
+	token, err := tokenmaker.NewOpaqueToken()
+	if err != nil {
+		return fmt.Errorf("new password reset token: %w", err)
+	}
+	_, err = r.repo.CreatePasswordResetToken(ctx, username, tokenmaker.HashOpaqueToken(token), time.Now().Add(r.conf.PasswordResetExpiration))
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	err = r.notifier.PasswordReset(ctx, acc, token)
+	if err != nil {
+		return fmt.Errorf("notifier: %w", err)
+	}
+	return nil
+}
+
+// ResetPassword changes the password using a token sent by ForgotPassword, every session of the account
+// is revoked afterwards.
+func (r *RBAC) ResetPassword(ctx context.Context, token string, password string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Password.Reset")
+	defer span.End()
+	if password == "" {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "password is required")
+	}
+	username, err := r.repo.ResetPassword(ctx, tokenmaker.HashOpaqueToken(token), password)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	err = r.RevokeSessions(ctx, username)
+	if err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+	err = r.ClearAccountLockout(ctx, username)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AccountRoleTasks returns the roles of the account, assigned to it or held through groups, each with its
+// granted and denied tasks including the inherited ones.
+func (r *RBAC) AccountRoleTasks(ctx context.Context, username string) ([]internal.RoleTaskByRole, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Permission.AccountRoleTasks")
+	defer span.End()
+	acr, err := r.AccountRoleByAccount(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]internal.RoleTaskByRole, 0, len(acr.Roles))
+	for _, value := range acr.Roles {
+		rt, err := r.RoleTaskByRole(ctx, value.Id)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, rt)
+	}
+	return roles, nil
+}
+
+// EffectivePermissions returns the tasks the account may perform without scope nor condition, each with the
+// roles granting it, along with the tasks denied to it.
+func (r *RBAC) EffectivePermissions(ctx context.Context, username string) (internal.EffectivePermissions, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Permission.EffectivePermissions")
+	defer span.End()
+	ids, err := r.accountRoleIDs(ctx, username)
+	if err != nil {
+		return internal.EffectivePermissions{}, err
+	}
+	roles := make([]internal.RoleTaskByRole, 0, len(ids))
+	for _, id := range ids {
+		rt, err := r.search.GetRoleTaskByRole(ctx, id)
+		if err != nil {
+			return internal.EffectivePermissions{}, fmt.Errorf("search: %w", err)
+		}
+		roles = append(roles, rt)
+	}
+	return internal.NewEffectivePermissions(username, roles), nil
+}
+
+// TaskHolders returns the accounts granted the task without scope nor condition, through any role. Accounts
+// holding a role granting the task are left out when another of their roles denies it.
+func (r *RBAC) TaskHolders(ctx context.Context, taskId string) (internal.TaskHolders, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Permission.TaskHolders")
+	defer span.End()
+	holders, err := r.repo.TaskHolders(ctx, taskId)
+	if err != nil {
+		return internal.TaskHolders{}, fmt.Errorf("repo: %w", err)
+	}
+	return holders, nil
+}
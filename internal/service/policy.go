@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ApplyPolicy validates the policy, applies it to the repository in a single transaction and then publishes
+// and indexes the changes the way the individual endpoints do. Every change is published even when others
+// fail, the failures are returned together. A dry run only returns the changes to make.
+func (r *RBAC) ApplyPolicy(ctx context.Context, policy internal.Policy, opts internal.PolicyOptions) ([]internal.PolicyChange, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Policy.Apply")
+	defer span.End()
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	for _, role := range policy.Roles {
+		for _, grant := range role.Grants {
+			rt := internal.RoleTasks{Effect: grant.Effect, Scope: grant.Scope, Condition: grant.Condition}
+			if err := validateRoleTask(&rt); err != nil {
+				return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "role %s grant %s", role.Role, grant)
+			}
+		}
+	}
+	changes, err := r.repo.ApplyPolicy(ctx, policy, opts)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	if opts.DryRun || len(changes) == 0 {
+		return changes, nil
+	}
+	var errs policyErrors
+	for _, change := range changes {
+		if err := r.policyChanged(ctx, change); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", change, err))
+		}
+	}
+	// the changes are applied whether they were published or not
+	if err := r.permissionsChanged(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return changes, errs
+	}
+	return changes, nil
+}
+
+// policyErrors are the errors of the changes of an applied policy that couldn't be published or indexed.
+type policyErrors []error
+
+func (e policyErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the first error.
+func (e policyErrors) Unwrap() error {
+	return e[0]
+}
+
+// ExportPolicy returns the tasks and the roles of the tenant of the request as a policy.
+func (r *RBAC) ExportPolicy(ctx context.Context) (internal.Policy, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Policy.Export")
+	defer span.End()
+	policy, err := r.repo.ExportPolicy(ctx)
+	if err != nil {
+		return internal.Policy{}, fmt.Errorf("repo: %w", err)
+	}
+	return policy, nil
+}
+
+// policyChanged publishes or indexes an applied policy change.
+func (r *RBAC) policyChanged(ctx context.Context, change internal.PolicyChange) error {
+	switch change.Kind {
+	case internal.POLICY_TASK:
+		if change.Action == internal.POLICY_DELETE {
+			_ = r.msgBroker.TaskDeleted(ctx, change.Id)
+			return nil
+		}
+		task, err := r.repo.Task(ctx, change.Id)
+		if err != nil {
+			return fmt.Errorf("repo: %w", err)
+		}
+		_ = r.msgBroker.TaskCreated(ctx, task)
+	case internal.POLICY_ROLE:
+		if change.Action == internal.POLICY_DELETE {
+			_ = r.msgBroker.RoleDeleted(ctx, change.Id)
+			return nil
+		}
+		role, err := r.repo.Role(ctx, change.Id)
+		if err != nil {
+			return fmt.Errorf("repo: %w", err)
+		}
+		_ = r.msgBroker.RoleCreated(ctx, role)
+	case internal.POLICY_ROLE_TASK:
+		if change.Action == internal.POLICY_DELETE {
+			_ = r.msgBroker.RoleTaskDeleted(ctx, change.Id)
+			return nil
+		}
+		rt, err := r.repo.RoleTask(ctx, change.Id)
+		if err != nil {
+			return fmt.Errorf("repo: %w", err)
+		}
+		// restricted role tasks are evaluated from the repository, indexing them would grant the task everywhere
+		if !rt.IsRestricted() {
+			_ = r.msgBroker.RoleTaskCreated(ctx, rt)
+		}
+	case internal.POLICY_HELPTEXT:
+		if change.Action != internal.POLICY_CREATE {
+			if err := r.search.DeleteHelpText(ctx, change.Id); err != nil {
+				return fmt.Errorf("search: %w", err)
+			}
+		}
+		if change.Action == internal.POLICY_DELETE {
+			return nil
+		}
+		ht, err := r.repo.HelpText(ctx, change.Id)
+		if err != nil {
+			return fmt.Errorf("repo: %w", err)
+		}
+		if err := r.search.IndexHelpText(ctx, ht); err != nil {
+			return fmt.Errorf("search: %w", err)
+		}
+	case internal.POLICY_MENU:
+		if change.Action == internal.POLICY_DELETE {
+			if err := r.search.DeleteMenu(ctx, change.Id); err != nil {
+				return fmt.Errorf("search: %w", err)
+			}
+			return nil
+		}
+		m, err := r.repo.Menu(ctx, change.Id)
+		if err != nil {
+			return fmt.Errorf("repo: %w", err)
+		}
+		if err := r.search.IndexMenu(ctx, m); err != nil {
+			return fmt.Errorf("search: %w", err)
+		}
+	case internal.POLICY_NAVIGATION:
+		if change.Action == internal.POLICY_DELETE {
+			if err := r.search.DeleteNavigation(ctx, change.Id); err != nil {
+				return fmt.Errorf("search: %w", err)
+			}
+			return nil
+		}
+		n, err := r.repo.Navigation(ctx, change.Id)
+		if err != nil {
+			return fmt.Errorf("repo: %w", err)
+		}
+		if err := r.search.IndexNavigation(ctx, n); err != nil {
+			return fmt.Errorf("search: %w", err)
+		}
+	}
+	return nil
+}
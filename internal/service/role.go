@@ -75,5 +75,8 @@ func (r *RBAC) DeleteRole(ctx context.Context, id string) error {
 		return fmt.Errorf("repo: %w", err)
 	}
 	_ = r.msgBroker.RoleDeleted(ctx, id)
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
 	return err
 }
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"rbac/internal"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CreateRoleInheritance makes the role inherit every task of the inherited role, including the ones the
+// inherited role inherits itself.
+func (r *RBAC) CreateRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleInheritance.Create")
+	defer span.End()
+	_, err := r.repo.CreateRoleInheritance(ctx, roleId, inheritedRoleId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RBAC) DeleteRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleInheritance.Delete")
+	defer span.End()
+	err := r.repo.DeleteRoleInheritance(ctx, roleId, inheritedRoleId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// InheritedRoles returns the roles directly inherited by the role.
+func (r *RBAC) InheritedRoles(ctx context.Context, roleId string) ([]internal.Roles, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleInheritance.InheritedRoles")
+	defer span.End()
+	roles, err := r.repo.InheritedRoles(ctx, roleId)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return roles, nil
+}
+
+// effectiveRoleIDs returns the roles followed by every role they transitively inherit, without duplicates.
+// The inherited roles are resolved in a single query and cached until inheritance or roles change.
+func (r *RBAC) effectiveRoleIDs(ctx context.Context, roleIds []string) ([]string, error) {
+	res := []string{}
+	seen := make(map[string]bool, len(roleIds))
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			res = append(res, id)
+		}
+	}
+	for _, id := range roleIds {
+		add(id)
+	}
+	if len(res) == 0 {
+		return res, nil
+	}
+	sorted := append([]string{}, res...)
+	sort.Strings(sorted)
+	sum := sha256.Sum256([]byte(strings.Join(sorted, ",")))
+	inherited, err := r.cachedRoleIDs(ctx, "inherited_"+hex.EncodeToString(sum[:]), func() ([]string, error) {
+		ids, err := r.repo.InheritedRoleIDsOf(ctx, sorted)
+		if err != nil {
+			return nil, fmt.Errorf("repo: %w", err)
+		}
+		internal.RecordLookup(ctx, "InheritedRoleIDsOf", strings.Join(sorted, ","), internal.SOURCE_DATABASE)
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range inherited {
+		add(id)
+	}
+	return res, nil
+}
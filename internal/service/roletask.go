@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"rbac/internal"
+	"rbac/internal/condition"
 	"strings"
 
 	"go.opentelemetry.io/otel/trace"
@@ -12,7 +13,10 @@ import (
 func (r *RBAC) CreateRoleTask(ctx context.Context, roleTask internal.RoleTasks) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleTask.Create")
 	defer span.End()
-	id, err := r.repo.CreateRoleTasks(ctx, roleTask.Task.Id, roleTask.Role.Id)
+	if err := validateRoleTask(&roleTask); err != nil {
+		return err
+	}
+	id, err := r.repo.CreateRoleTasks(ctx, roleTask.Task.Id, roleTask.Role.Id, roleTask.Scope, roleTask.Condition, roleTask.Effect)
 	if err != nil {
 		return fmt.Errorf("repo: %w", err)
 	}
@@ -20,7 +24,13 @@ func (r *RBAC) CreateRoleTask(ctx context.Context, roleTask internal.RoleTasks)
 	if err != nil {
 		return fmt.Errorf("search: %w", err)
 	}
-	_ = r.msgBroker.RoleTaskCreated(ctx, rt)
+	// restricted role tasks are evaluated from the repository, indexing them would grant the task everywhere
+	if !rt.IsRestricted() {
+		_ = r.msgBroker.RoleTaskCreated(ctx, rt)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
 	return nil
 }
 func (r *RBAC) RoleTask(ctx context.Context, roleTaskId string) (internal.RoleTasks, error) {
@@ -33,6 +43,9 @@ func (r *RBAC) RoleTask(ctx context.Context, roleTaskId string) (internal.RoleTa
 			if err != nil {
 				return internal.RoleTasks{}, fmt.Errorf("get account: %w", err)
 			}
+			if roleTask.IsRestricted() {
+				return roleTask, nil
+			}
 			//if you get here means account and profile has value and no error
 			err = r.search.IndexRoleTask(ctx, roleTask)
 			if err != nil {
@@ -54,7 +67,12 @@ func (r *RBAC) UpdateRoleTask(ctx context.Context, roleTask internal.RoleTasks)
 	if err != nil {
 		return fmt.Errorf("repo: %w", err)
 	}
-	_ = r.msgBroker.RoleTaskUpdated(ctx, rt)
+	if !rt.IsRestricted() {
+		_ = r.msgBroker.RoleTaskUpdated(ctx, rt)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
 	return err
 }
 func (r *RBAC) DeleteRoleTask(ctx context.Context, id string) error {
@@ -65,6 +83,9 @@ func (r *RBAC) DeleteRoleTask(ctx context.Context, id string) error {
 		return fmt.Errorf("repo: %w", err)
 	}
 	_ = r.msgBroker.RoleTaskDeleted(ctx, id)
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
 	return err
 }
 
@@ -78,6 +99,8 @@ func (r *RBAC) ListRoleTask(ctx context.Context, args internal.ListArgs) (intern
 	return lacr, err
 }
 
+// RoleTaskByRole returns the role with its granted and denied tasks, including the ones inherited from other
+// roles.
 func (r *RBAC) RoleTaskByRole(ctx context.Context, roleId string) (internal.RoleTaskByRole, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "RoleTask.RoleTaskByRole")
 	defer span.End()
@@ -85,5 +108,61 @@ func (r *RBAC) RoleTaskByRole(ctx context.Context, roleId string) (internal.Role
 	if err != nil {
 		return internal.RoleTaskByRole{}, fmt.Errorf("search: %w", err)
 	}
-	return rt, err
+	inherited, err := r.repo.InheritedRoleIDs(ctx, roleId)
+	if err != nil {
+		return internal.RoleTaskByRole{}, fmt.Errorf("repo: %w", err)
+	}
+	seen := make(map[string]bool, len(rt.Tasks))
+	for _, value := range rt.Tasks {
+		seen[value.Id] = true
+	}
+	seenDenied := make(map[string]bool, len(rt.DeniedTasks))
+	for _, value := range rt.DeniedTasks {
+		seenDenied[value.Id] = true
+	}
+	for _, id := range inherited {
+		irt, err := r.search.GetRoleTaskByRole(ctx, id)
+		if err != nil {
+			return internal.RoleTaskByRole{}, fmt.Errorf("search: %w", err)
+		}
+		for _, value := range irt.Tasks {
+			if !seen[value.Id] {
+				seen[value.Id] = true
+				rt.Tasks = append(rt.Tasks, value)
+			}
+		}
+		for _, value := range irt.DeniedTasks {
+			if !seenDenied[value.Id] {
+				seenDenied[value.Id] = true
+				rt.DeniedTasks = append(rt.DeniedTasks, value)
+			}
+		}
+	}
+	return rt, nil
+}
+
+// validateRoleTask checks the scope, the condition and the effect of the role task, defaulting the effect to
+// EFFECT_ALLOW.
+func validateRoleTask(rt *internal.RoleTasks) error {
+	if err := rt.Scope.Validate(); err != nil {
+		return err
+	}
+	if rt.Condition != "" {
+		if _, err := condition.Parse(rt.Condition); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid condition")
+		}
+	}
+	switch rt.Effect {
+	case "":
+		rt.Effect = internal.EFFECT_ALLOW
+	case internal.EFFECT_ALLOW:
+	case internal.EFFECT_DENY:
+		// a deny that may or may not apply depending on the request can't override grants checked from claims
+		if rt.Condition != "" {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "deny role tasks can't have a condition")
+		}
+	default:
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "effect must be allow or deny")
+	}
+	return nil
 }
@@ -3,10 +3,12 @@ package service
 import (
 	"rbac/internal"
 	"rbac/internal/tokenmaker"
+	"time"
 
 	"golang.org/x/net/context"
 )
 
+//go:generate counterfeiter -o servicetesting/rbac_repository.gen.go . RBACRepository
 type RBACRepository interface {
 	Login(ctx context.Context, username string, password string) error
 	CreateAccount(ctx context.Context, account internal.Account, password string) (string, error)
@@ -15,23 +17,83 @@ type RBACRepository interface {
 	ChangePassword(ctx context.Context, username string, password string) error
 	DeleteAccount(ctx context.Context, username string) error
 	AccountByID(ctx context.Context, id string) (internal.Account, error)
+	BlockAccount(ctx context.Context, username string) error
+	UnblockAccount(ctx context.Context, username string) error
+	CreateServiceAccount(ctx context.Context, username string) (internal.Account, error)
+
+	CreateTenant(ctx context.Context, name string, username string, policy internal.Policy, admin string) (string, []internal.PolicyChange, string, error)
+	Tenant(ctx context.Context, id string) (internal.Tenant, error)
+	Tenants(ctx context.Context) ([]internal.Tenant, error)
+	AccountTenants(ctx context.Context, username string) ([]internal.Tenant, error)
+	AddAccountTenant(ctx context.Context, username string, tenantId string) error
+	RemoveAccountTenant(ctx context.Context, username string, tenantId string) ([]string, error)
+	SetActiveTenant(ctx context.Context, username string, tenantId string) error
 
 	CreateRole(ctx context.Context, rolename string) (string, error)
 	Role(ctx context.Context, id string) (internal.Roles, error)
 	UpdateRole(ctx context.Context, id string, rolename string) error
 	DeleteRole(ctx context.Context, id string) error
+	CreateRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) (string, error)
+	DeleteRoleInheritance(ctx context.Context, roleId string, inheritedRoleId string) error
+	InheritedRoles(ctx context.Context, roleId string) ([]internal.Roles, error)
+	InheritedRoleIDs(ctx context.Context, roleId string) ([]string, error)
+	InheritedRoleIDsOf(ctx context.Context, roleIds []string) ([]string, error)
+
+	CreateGroup(ctx context.Context, name string) (string, error)
+	Group(ctx context.Context, id string) (internal.Group, error)
+	Groups(ctx context.Context) ([]internal.Group, error)
+	UpdateGroup(ctx context.Context, id string, name string) error
+	DeleteGroup(ctx context.Context, id string) ([]string, error)
+	AddGroupMember(ctx context.Context, groupId string, username string) (internal.GroupMember, error)
+	RemoveGroupMember(ctx context.Context, groupId string, username string) (string, error)
+	GroupMembers(ctx context.Context, groupId string) ([]internal.GroupMember, error)
+	AddMemberGroup(ctx context.Context, groupId string, memberGroupId string) (string, error)
+	RemoveMemberGroup(ctx context.Context, groupId string, memberGroupId string) error
+	MemberGroups(ctx context.Context, groupId string) ([]internal.Group, error)
+	AddGroupRole(ctx context.Context, groupId string, roleId string) (string, error)
+	RemoveGroupRole(ctx context.Context, groupId string, roleId string) error
+	GroupRoles(ctx context.Context, groupId string) ([]internal.Roles, error)
+	GroupRoleIDs(ctx context.Context, username string) ([]string, error)
+	CreateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) (string, error)
+	ExclusiveRoleSet(ctx context.Context, id string) (internal.ExclusiveRoleSet, error)
+	ExclusiveRoleSets(ctx context.Context) ([]internal.ExclusiveRoleSet, error)
+	UpdateExclusiveRoleSet(ctx context.Context, set internal.ExclusiveRoleSet) error
+	DeleteExclusiveRoleSet(ctx context.Context, id string) error
+	RoleConflicts(ctx context.Context) ([]internal.RoleConflict, error)
+	AddRoleApprover(ctx context.Context, roleId string, approverRoleId string) (string, error)
+	RemoveRoleApprover(ctx context.Context, roleId string, approverRoleId string) error
+	RoleApprovers(ctx context.Context, roleId string) ([]internal.Roles, error)
+	CreateAccessRequest(ctx context.Context, username string, roleId string, justification string, expiresAt time.Time) (string, error)
+	AccessRequest(ctx context.Context, id string) (internal.AccessRequest, error)
+	AccessRequests(ctx context.Context) ([]internal.AccessRequest, error)
+	AccountAccessRequests(ctx context.Context, username string) ([]internal.AccessRequest, error)
+	DecideAccessRequest(ctx context.Context, id string, status string, decidedBy string, comment string) error
+	ApproveAccessRequest(ctx context.Context, id string, decidedBy string, comment string) (string, error)
+	SetEmergencyRole(ctx context.Context, roleId string, emergency bool) error
+	IsEmergencyRole(ctx context.Context, roleId string) (bool, error)
+	EmergencyRoles(ctx context.Context) ([]internal.Roles, error)
+	CreateBreakGlassActivation(ctx context.Context, username string, roleId string, reason string, expiresAt time.Time) (string, string, error)
+	BreakGlassActivation(ctx context.Context, id string) (internal.BreakGlassActivation, error)
+	BreakGlassActivations(ctx context.Context, since time.Time) ([]internal.BreakGlassActivation, error)
+	ExportPolicy(ctx context.Context) (internal.Policy, error)
+	ApplyPolicy(ctx context.Context, policy internal.Policy, opts internal.PolicyOptions) ([]internal.PolicyChange, error)
 
-	CreateAccountRole(ctx context.Context, accountId string, roleId string) (string, error)
+	CreateAccountRole(ctx context.Context, accountId string, roleId string, scope internal.Scope, validity internal.Validity) (string, error)
 	AccountRole(ctx context.Context, accountRoleId string) (internal.AccountRoles, error)
 	UpdateAccountRole(ctx context.Context, accountId string, roleId string, id string) error
 	DeleteAccountRole(ctx context.Context, id string) error
+	AccountRolesByAccount(ctx context.Context, username string) ([]internal.AccountRoles, error)
+	DeleteExpiredAccountRoles(ctx context.Context, now time.Time) ([]string, error)
+	CountStartedAccountRoles(ctx context.Context, since time.Time, now time.Time) (int64, error)
 
 	CreateTask(ctx context.Context, taskname string) (string, error)
 	Task(ctx context.Context, id string) (internal.Tasks, error)
 	UpdateTask(ctx context.Context, id string, taskname string) error
 	DeleteTask(ctx context.Context, id string) error
 
-	CreateRoleTasks(ctx context.Context, taskid string, roleid string) (string, error)
+	CreateRoleTasks(ctx context.Context, taskid string, roleid string, scope internal.Scope, condition string, effect string) (string, error)
+	RestrictedGrants(ctx context.Context, username string, tasks []string) ([]internal.RestrictedGrant, error)
+	TaskHolders(ctx context.Context, taskId string) (internal.TaskHolders, error)
 	RoleTask(ctx context.Context, roleTaskId string) (internal.RoleTasks, error)
 	UpdateRoleTask(ctx context.Context, taskId string, roleId string, id string) error
 	DeleteRoleTask(ctx context.Context, id string) error
@@ -50,8 +112,33 @@ type RBACRepository interface {
 	Navigation(ctx context.Context, id string) (internal.Navigation, error)
 	UpdateNavigation(ctx context.Context, menu internal.Navigation) error
 	DeleteNavigation(ctx context.Context, id string) error
+
+	CreateRefreshToken(ctx context.Context, username string, tokenHash string, expiresAt time.Time) (string, error)
+	RefreshTokenByHash(ctx context.Context, tokenHash string) (internal.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, refreshToken internal.RefreshToken, tokenHash string, expiresAt time.Time) (string, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyId string) error
+	RevokeAccountRefreshTokens(ctx context.Context, username string) error
+
+	CreatePasswordResetToken(ctx context.Context, username string, tokenHash string, expiresAt time.Time) (string, error)
+	ResetPassword(ctx context.Context, tokenHash string, password string) (string, error)
+
+	MFA(ctx context.Context, username string) (internal.MFA, error)
+	EnrollMFA(ctx context.Context, username string, secret string) error
+	ConfirmMFA(ctx context.Context, username string, step int64, recoveryCodeHashes []string) error
+	UseMFAStep(ctx context.Context, username string, step int64) error
+	UseMFARecoveryCode(ctx context.Context, username string, codeHash string) error
+	DeleteMFA(ctx context.Context, username string) error
+	IsMFARequired(ctx context.Context, username string) (bool, error)
+	SetRoleMFARequired(ctx context.Context, roleId string, required bool) error
+
+	CreateAPIKey(ctx context.Context, username string, name string, prefix string, keyHash string, expiresAt time.Time) (internal.APIKey, error)
+	APIKeyByHash(ctx context.Context, keyHash string) (internal.APIKey, error)
+	APIKeysByAccount(ctx context.Context, username string) ([]internal.APIKey, error)
+	TouchAPIKey(ctx context.Context, id string) error
+	RevokeAPIKey(ctx context.Context, username string, id string) error
 }
 
+//go:generate counterfeiter -o servicetesting/rbac_search_repository.gen.go . RBACSearchRepository
 type RBACSearchRepository interface {
 	IndexAccount(ctx context.Context, account internal.Account) error
 	GetAccount(ctx context.Context, username string) (internal.Account, error)
@@ -111,10 +198,14 @@ type RBACSearchRepository interface {
 	ListNavigation(ctx context.Context, args internal.ListArgs) (internal.ListNavigation, error)
 }
 
+//go:generate counterfeiter -o servicetesting/rbac_message_broker_repository.gen.go . RBACMessageBrokerRepository
 type RBACMessageBrokerRepository interface {
 	AccountCreated(ctx context.Context, accounts internal.Account) error
 	AccountDeleted(ctx context.Context, id string) error
 	AccountUpdated(ctx context.Context, profile internal.Account) error
+	AccountBlocked(ctx context.Context, account internal.Account) error
+	AccountUnblocked(ctx context.Context, account internal.Account) error
+	AccountLocked(ctx context.Context, lockout internal.Lockout) error
 
 	ProfileCreated(ctx context.Context, profile internal.Profile) error
 	ProfileDeleted(ctx context.Context, id string) error
@@ -135,24 +226,93 @@ type RBACMessageBrokerRepository interface {
 	AccountRoleCreated(ctx context.Context, accountRole internal.AccountRoles) error
 	AccountRoleDeleted(ctx context.Context, id string) error
 	AccountRoleUpdated(ctx context.Context, accountRole internal.AccountRoles) error
+
+	GroupMemberAdded(ctx context.Context, member internal.GroupMember) error
+	GroupMemberRemoved(ctx context.Context, id string) error
+	AccessRequestCreated(ctx context.Context, request internal.AccessRequest) error
+	AccessRequestDecided(ctx context.Context, request internal.AccessRequest) error
+	BreakGlassActivated(ctx context.Context, activation internal.BreakGlassActivation) error
+}
+type RBACSessionRepository interface {
+	RevokeToken(ctx context.Context, id string, expiresAt time.Time) error
+	IsTokenRevoked(ctx context.Context, id string) (bool, error)
+	RevokeAccountTokens(ctx context.Context, username string, at time.Time) error
+	AccountTokensRevokedAt(ctx context.Context, username string) (time.Time, error)
+	CreateMFAChallenge(ctx context.Context, id string, username string, expiresAt time.Time) error
+	MFAChallenge(ctx context.Context, id string) (string, error)
+	DeleteMFAChallenge(ctx context.Context, id string) error
+	PermissionVersion(ctx context.Context) (int64, error)
+	IncrementPermissionVersion(ctx context.Context) (int64, error)
+	CachedRoleIDs(ctx context.Context, key string) ([]string, bool, error)
+	CacheRoleIDs(ctx context.Context, key string, ids []string, expiration time.Duration) error
 }
+
+type RBACLoginAttemptRepository interface {
+	AddFailure(ctx context.Context, key string, window time.Duration) (int64, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Lockout(ctx context.Context, key string) (internal.Lockout, error)
+	Clear(ctx context.Context, key string) error
+}
+
+type RBACNotifier interface {
+	PasswordReset(ctx context.Context, account internal.Account, token string) error
+}
+
 type TokenMaker interface {
 	CreateToken(username string) (string, error)
+	CreateTokenWithClaims(username string, claims tokenmaker.Claims) (string, error)
 	VerifyToken(token string) (*tokenmaker.Payload, error)
 }
 
+// Config defines the tunable values used by the RBAC service.
+type Config struct {
+	RefreshTokenExpiration time.Duration
+	// LoginMaxAttempts is the number of failed logins that locks a username or IP address, zero disables it.
+	LoginMaxAttempts     int
+	LoginAttemptWindow   time.Duration
+	LoginBackoff         time.Duration
+	LoginLockoutDuration time.Duration
+
+	PasswordResetExpiration time.Duration
+
+	MFAChallengeExpiration time.Duration
+	// MFAIssuer is the name displayed by authenticator apps.
+	MFAIssuer string
+
+	// TokenPermissions embeds the roles and tasks of the account in its access tokens, requests are then
+	// authorized from the token instead of looking the permissions up.
+	TokenPermissions bool
+
+	// AccountRoleSweepInterval is how often expired account roles are deleted, zero disables it.
+	AccountRoleSweepInterval time.Duration
+
+	// AccessRequestExpiration is how long an access request can be approved or rejected.
+	AccessRequestExpiration time.Duration
+
+	// BreakGlassDuration is how long an emergency role is granted when breaking glass.
+	BreakGlassDuration time.Duration
+}
+
 type RBAC struct {
 	repo      RBACRepository
 	search    RBACSearchRepository
 	token     TokenMaker
 	msgBroker RBACMessageBrokerRepository
+	sessions  RBACSessionRepository
+	attempts  RBACLoginAttemptRepository
+	notifier  RBACNotifier
+	conf      Config
 }
 
-func NewRBAC(repo RBACRepository, search RBACSearchRepository, token TokenMaker, msgBroker RBACMessageBrokerRepository) *RBAC {
+func NewRBAC(repo RBACRepository, search RBACSearchRepository, token TokenMaker, msgBroker RBACMessageBrokerRepository, sessions RBACSessionRepository, attempts RBACLoginAttemptRepository, notifier RBACNotifier, conf Config) *RBAC {
 	return &RBAC{
 		repo:      repo,
 		search:    search,
 		token:     token,
 		msgBroker: msgBroker,
+		sessions:  sessions,
+		attempts:  attempts,
+		notifier:  notifier,
+		conf:      conf,
 	}
 }
@@ -0,0 +1,46 @@
+package service_test
+
+import (
+	"errors"
+	"rbac/internal"
+	"rbac/internal/memory"
+	"rbac/internal/service"
+	"rbac/internal/service/servicetesting"
+	"rbac/internal/tokenmaker/jwtmaker"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakes struct {
+	repo      *servicetesting.FakeRBACRepository
+	search    *servicetesting.FakeRBACSearchRepository
+	msgBroker *servicetesting.FakeRBACMessageBrokerRepository
+	sessions  *memory.Sessions
+}
+
+func newRBAC(t *testing.T, conf service.Config) (*service.RBAC, fakes) {
+	t.Helper()
+
+	token, err := jwtmaker.NewJWTMaker("01234567890123456789012345678901", time.Minute)
+	require.NoError(t, err)
+
+	f := fakes{
+		repo:      &servicetesting.FakeRBACRepository{},
+		search:    &servicetesting.FakeRBACSearchRepository{},
+		msgBroker: &servicetesting.FakeRBACMessageBrokerRepository{},
+		sessions:  memory.NewSessions(),
+	}
+	svc := service.NewRBAC(f.repo, f.search, token, f.msgBroker, f.sessions, memory.NewLoginAttempts(), nil, conf)
+
+	return svc, f
+}
+
+func requireErrorCode(t *testing.T, err error, code internal.ErrorCode) {
+	t.Helper()
+
+	var ierr *internal.Error
+	require.True(t, errors.As(err, &ierr), "expected an internal error, got %v", err)
+	require.Equal(t, code, ierr.Code())
+}
@@ -0,0 +1,2015 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicetesting
+
+import (
+	"rbac/internal"
+	"rbac/internal/service"
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+type FakeRBACMessageBrokerRepository struct {
+	AccessRequestCreatedStub        func(context.Context, internal.AccessRequest) error
+	accessRequestCreatedMutex       sync.RWMutex
+	accessRequestCreatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.AccessRequest
+	}
+	accessRequestCreatedReturns struct {
+		result1 error
+	}
+	accessRequestCreatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccessRequestDecidedStub        func(context.Context, internal.AccessRequest) error
+	accessRequestDecidedMutex       sync.RWMutex
+	accessRequestDecidedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.AccessRequest
+	}
+	accessRequestDecidedReturns struct {
+		result1 error
+	}
+	accessRequestDecidedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccountBlockedStub        func(context.Context, internal.Account) error
+	accountBlockedMutex       sync.RWMutex
+	accountBlockedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}
+	accountBlockedReturns struct {
+		result1 error
+	}
+	accountBlockedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccountCreatedStub        func(context.Context, internal.Account) error
+	accountCreatedMutex       sync.RWMutex
+	accountCreatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}
+	accountCreatedReturns struct {
+		result1 error
+	}
+	accountCreatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccountDeletedStub        func(context.Context, string) error
+	accountDeletedMutex       sync.RWMutex
+	accountDeletedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountDeletedReturns struct {
+		result1 error
+	}
+	accountDeletedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccountLockedStub        func(context.Context, internal.Lockout) error
+	accountLockedMutex       sync.RWMutex
+	accountLockedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Lockout
+	}
+	accountLockedReturns struct {
+		result1 error
+	}
+	accountLockedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccountRoleCreatedStub        func(context.Context, internal.AccountRoles) error
+	accountRoleCreatedMutex       sync.RWMutex
+	accountRoleCreatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}
+	accountRoleCreatedReturns struct {
+		result1 error
+	}
+	accountRoleCreatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccountRoleDeletedStub        func(context.Context, string) error
+	accountRoleDeletedMutex       sync.RWMutex
+	accountRoleDeletedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountRoleDeletedReturns struct {
+		result1 error
+	}
+	accountRoleDeletedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccountRoleUpdatedStub        func(context.Context, internal.AccountRoles) error
+	accountRoleUpdatedMutex       sync.RWMutex
+	accountRoleUpdatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}
+	accountRoleUpdatedReturns struct {
+		result1 error
+	}
+	accountRoleUpdatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccountUnblockedStub        func(context.Context, internal.Account) error
+	accountUnblockedMutex       sync.RWMutex
+	accountUnblockedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}
+	accountUnblockedReturns struct {
+		result1 error
+	}
+	accountUnblockedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccountUpdatedStub        func(context.Context, internal.Account) error
+	accountUpdatedMutex       sync.RWMutex
+	accountUpdatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}
+	accountUpdatedReturns struct {
+		result1 error
+	}
+	accountUpdatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	BreakGlassActivatedStub        func(context.Context, internal.BreakGlassActivation) error
+	breakGlassActivatedMutex       sync.RWMutex
+	breakGlassActivatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.BreakGlassActivation
+	}
+	breakGlassActivatedReturns struct {
+		result1 error
+	}
+	breakGlassActivatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GroupMemberAddedStub        func(context.Context, internal.GroupMember) error
+	groupMemberAddedMutex       sync.RWMutex
+	groupMemberAddedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.GroupMember
+	}
+	groupMemberAddedReturns struct {
+		result1 error
+	}
+	groupMemberAddedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GroupMemberRemovedStub        func(context.Context, string) error
+	groupMemberRemovedMutex       sync.RWMutex
+	groupMemberRemovedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	groupMemberRemovedReturns struct {
+		result1 error
+	}
+	groupMemberRemovedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ProfileCreatedStub        func(context.Context, internal.Profile) error
+	profileCreatedMutex       sync.RWMutex
+	profileCreatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}
+	profileCreatedReturns struct {
+		result1 error
+	}
+	profileCreatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ProfileDeletedStub        func(context.Context, string) error
+	profileDeletedMutex       sync.RWMutex
+	profileDeletedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	profileDeletedReturns struct {
+		result1 error
+	}
+	profileDeletedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ProfileUpdatedStub        func(context.Context, internal.Profile) error
+	profileUpdatedMutex       sync.RWMutex
+	profileUpdatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}
+	profileUpdatedReturns struct {
+		result1 error
+	}
+	profileUpdatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RoleCreatedStub        func(context.Context, internal.Roles) error
+	roleCreatedMutex       sync.RWMutex
+	roleCreatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Roles
+	}
+	roleCreatedReturns struct {
+		result1 error
+	}
+	roleCreatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RoleDeletedStub        func(context.Context, string) error
+	roleDeletedMutex       sync.RWMutex
+	roleDeletedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	roleDeletedReturns struct {
+		result1 error
+	}
+	roleDeletedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RoleTaskCreatedStub        func(context.Context, internal.RoleTasks) error
+	roleTaskCreatedMutex       sync.RWMutex
+	roleTaskCreatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
+	}
+	roleTaskCreatedReturns struct {
+		result1 error
+	}
+	roleTaskCreatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RoleTaskDeletedStub        func(context.Context, string) error
+	roleTaskDeletedMutex       sync.RWMutex
+	roleTaskDeletedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	roleTaskDeletedReturns struct {
+		result1 error
+	}
+	roleTaskDeletedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RoleTaskUpdatedStub        func(context.Context, internal.RoleTasks) error
+	roleTaskUpdatedMutex       sync.RWMutex
+	roleTaskUpdatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
+	}
+	roleTaskUpdatedReturns struct {
+		result1 error
+	}
+	roleTaskUpdatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RoleUpdatedStub        func(context.Context, internal.Roles) error
+	roleUpdatedMutex       sync.RWMutex
+	roleUpdatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Roles
+	}
+	roleUpdatedReturns struct {
+		result1 error
+	}
+	roleUpdatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TaskCreatedStub        func(context.Context, internal.Tasks) error
+	taskCreatedMutex       sync.RWMutex
+	taskCreatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Tasks
+	}
+	taskCreatedReturns struct {
+		result1 error
+	}
+	taskCreatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TaskDeletedStub        func(context.Context, string) error
+	taskDeletedMutex       sync.RWMutex
+	taskDeletedArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	taskDeletedReturns struct {
+		result1 error
+	}
+	taskDeletedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TaskUpdatedStub        func(context.Context, internal.Tasks) error
+	taskUpdatedMutex       sync.RWMutex
+	taskUpdatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Tasks
+	}
+	taskUpdatedReturns struct {
+		result1 error
+	}
+	taskUpdatedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestCreated(arg1 context.Context, arg2 internal.AccessRequest) error {
+	fake.accessRequestCreatedMutex.Lock()
+	ret, specificReturn := fake.accessRequestCreatedReturnsOnCall[len(fake.accessRequestCreatedArgsForCall)]
+	fake.accessRequestCreatedArgsForCall = append(fake.accessRequestCreatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.AccessRequest
+	}{arg1, arg2})
+	stub := fake.AccessRequestCreatedStub
+	fakeReturns := fake.accessRequestCreatedReturns
+	fake.recordInvocation("AccessRequestCreated", []interface{}{arg1, arg2})
+	fake.accessRequestCreatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestCreatedCallCount() int {
+	fake.accessRequestCreatedMutex.RLock()
+	defer fake.accessRequestCreatedMutex.RUnlock()
+	return len(fake.accessRequestCreatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestCreatedCalls(stub func(context.Context, internal.AccessRequest) error) {
+	fake.accessRequestCreatedMutex.Lock()
+	defer fake.accessRequestCreatedMutex.Unlock()
+	fake.AccessRequestCreatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestCreatedArgsForCall(i int) (context.Context, internal.AccessRequest) {
+	fake.accessRequestCreatedMutex.RLock()
+	defer fake.accessRequestCreatedMutex.RUnlock()
+	argsForCall := fake.accessRequestCreatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestCreatedReturns(result1 error) {
+	fake.accessRequestCreatedMutex.Lock()
+	defer fake.accessRequestCreatedMutex.Unlock()
+	fake.AccessRequestCreatedStub = nil
+	fake.accessRequestCreatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestCreatedReturnsOnCall(i int, result1 error) {
+	fake.accessRequestCreatedMutex.Lock()
+	defer fake.accessRequestCreatedMutex.Unlock()
+	fake.AccessRequestCreatedStub = nil
+	if fake.accessRequestCreatedReturnsOnCall == nil {
+		fake.accessRequestCreatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accessRequestCreatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestDecided(arg1 context.Context, arg2 internal.AccessRequest) error {
+	fake.accessRequestDecidedMutex.Lock()
+	ret, specificReturn := fake.accessRequestDecidedReturnsOnCall[len(fake.accessRequestDecidedArgsForCall)]
+	fake.accessRequestDecidedArgsForCall = append(fake.accessRequestDecidedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.AccessRequest
+	}{arg1, arg2})
+	stub := fake.AccessRequestDecidedStub
+	fakeReturns := fake.accessRequestDecidedReturns
+	fake.recordInvocation("AccessRequestDecided", []interface{}{arg1, arg2})
+	fake.accessRequestDecidedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestDecidedCallCount() int {
+	fake.accessRequestDecidedMutex.RLock()
+	defer fake.accessRequestDecidedMutex.RUnlock()
+	return len(fake.accessRequestDecidedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestDecidedCalls(stub func(context.Context, internal.AccessRequest) error) {
+	fake.accessRequestDecidedMutex.Lock()
+	defer fake.accessRequestDecidedMutex.Unlock()
+	fake.AccessRequestDecidedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestDecidedArgsForCall(i int) (context.Context, internal.AccessRequest) {
+	fake.accessRequestDecidedMutex.RLock()
+	defer fake.accessRequestDecidedMutex.RUnlock()
+	argsForCall := fake.accessRequestDecidedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestDecidedReturns(result1 error) {
+	fake.accessRequestDecidedMutex.Lock()
+	defer fake.accessRequestDecidedMutex.Unlock()
+	fake.AccessRequestDecidedStub = nil
+	fake.accessRequestDecidedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccessRequestDecidedReturnsOnCall(i int, result1 error) {
+	fake.accessRequestDecidedMutex.Lock()
+	defer fake.accessRequestDecidedMutex.Unlock()
+	fake.AccessRequestDecidedStub = nil
+	if fake.accessRequestDecidedReturnsOnCall == nil {
+		fake.accessRequestDecidedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accessRequestDecidedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountBlocked(arg1 context.Context, arg2 internal.Account) error {
+	fake.accountBlockedMutex.Lock()
+	ret, specificReturn := fake.accountBlockedReturnsOnCall[len(fake.accountBlockedArgsForCall)]
+	fake.accountBlockedArgsForCall = append(fake.accountBlockedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}{arg1, arg2})
+	stub := fake.AccountBlockedStub
+	fakeReturns := fake.accountBlockedReturns
+	fake.recordInvocation("AccountBlocked", []interface{}{arg1, arg2})
+	fake.accountBlockedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountBlockedCallCount() int {
+	fake.accountBlockedMutex.RLock()
+	defer fake.accountBlockedMutex.RUnlock()
+	return len(fake.accountBlockedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountBlockedCalls(stub func(context.Context, internal.Account) error) {
+	fake.accountBlockedMutex.Lock()
+	defer fake.accountBlockedMutex.Unlock()
+	fake.AccountBlockedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountBlockedArgsForCall(i int) (context.Context, internal.Account) {
+	fake.accountBlockedMutex.RLock()
+	defer fake.accountBlockedMutex.RUnlock()
+	argsForCall := fake.accountBlockedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountBlockedReturns(result1 error) {
+	fake.accountBlockedMutex.Lock()
+	defer fake.accountBlockedMutex.Unlock()
+	fake.AccountBlockedStub = nil
+	fake.accountBlockedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountBlockedReturnsOnCall(i int, result1 error) {
+	fake.accountBlockedMutex.Lock()
+	defer fake.accountBlockedMutex.Unlock()
+	fake.AccountBlockedStub = nil
+	if fake.accountBlockedReturnsOnCall == nil {
+		fake.accountBlockedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accountBlockedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountCreated(arg1 context.Context, arg2 internal.Account) error {
+	fake.accountCreatedMutex.Lock()
+	ret, specificReturn := fake.accountCreatedReturnsOnCall[len(fake.accountCreatedArgsForCall)]
+	fake.accountCreatedArgsForCall = append(fake.accountCreatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}{arg1, arg2})
+	stub := fake.AccountCreatedStub
+	fakeReturns := fake.accountCreatedReturns
+	fake.recordInvocation("AccountCreated", []interface{}{arg1, arg2})
+	fake.accountCreatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountCreatedCallCount() int {
+	fake.accountCreatedMutex.RLock()
+	defer fake.accountCreatedMutex.RUnlock()
+	return len(fake.accountCreatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountCreatedCalls(stub func(context.Context, internal.Account) error) {
+	fake.accountCreatedMutex.Lock()
+	defer fake.accountCreatedMutex.Unlock()
+	fake.AccountCreatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountCreatedArgsForCall(i int) (context.Context, internal.Account) {
+	fake.accountCreatedMutex.RLock()
+	defer fake.accountCreatedMutex.RUnlock()
+	argsForCall := fake.accountCreatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountCreatedReturns(result1 error) {
+	fake.accountCreatedMutex.Lock()
+	defer fake.accountCreatedMutex.Unlock()
+	fake.AccountCreatedStub = nil
+	fake.accountCreatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountCreatedReturnsOnCall(i int, result1 error) {
+	fake.accountCreatedMutex.Lock()
+	defer fake.accountCreatedMutex.Unlock()
+	fake.AccountCreatedStub = nil
+	if fake.accountCreatedReturnsOnCall == nil {
+		fake.accountCreatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accountCreatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountDeleted(arg1 context.Context, arg2 string) error {
+	fake.accountDeletedMutex.Lock()
+	ret, specificReturn := fake.accountDeletedReturnsOnCall[len(fake.accountDeletedArgsForCall)]
+	fake.accountDeletedArgsForCall = append(fake.accountDeletedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountDeletedStub
+	fakeReturns := fake.accountDeletedReturns
+	fake.recordInvocation("AccountDeleted", []interface{}{arg1, arg2})
+	fake.accountDeletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountDeletedCallCount() int {
+	fake.accountDeletedMutex.RLock()
+	defer fake.accountDeletedMutex.RUnlock()
+	return len(fake.accountDeletedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountDeletedCalls(stub func(context.Context, string) error) {
+	fake.accountDeletedMutex.Lock()
+	defer fake.accountDeletedMutex.Unlock()
+	fake.AccountDeletedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountDeletedArgsForCall(i int) (context.Context, string) {
+	fake.accountDeletedMutex.RLock()
+	defer fake.accountDeletedMutex.RUnlock()
+	argsForCall := fake.accountDeletedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountDeletedReturns(result1 error) {
+	fake.accountDeletedMutex.Lock()
+	defer fake.accountDeletedMutex.Unlock()
+	fake.AccountDeletedStub = nil
+	fake.accountDeletedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountDeletedReturnsOnCall(i int, result1 error) {
+	fake.accountDeletedMutex.Lock()
+	defer fake.accountDeletedMutex.Unlock()
+	fake.AccountDeletedStub = nil
+	if fake.accountDeletedReturnsOnCall == nil {
+		fake.accountDeletedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accountDeletedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountLocked(arg1 context.Context, arg2 internal.Lockout) error {
+	fake.accountLockedMutex.Lock()
+	ret, specificReturn := fake.accountLockedReturnsOnCall[len(fake.accountLockedArgsForCall)]
+	fake.accountLockedArgsForCall = append(fake.accountLockedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Lockout
+	}{arg1, arg2})
+	stub := fake.AccountLockedStub
+	fakeReturns := fake.accountLockedReturns
+	fake.recordInvocation("AccountLocked", []interface{}{arg1, arg2})
+	fake.accountLockedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountLockedCallCount() int {
+	fake.accountLockedMutex.RLock()
+	defer fake.accountLockedMutex.RUnlock()
+	return len(fake.accountLockedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountLockedCalls(stub func(context.Context, internal.Lockout) error) {
+	fake.accountLockedMutex.Lock()
+	defer fake.accountLockedMutex.Unlock()
+	fake.AccountLockedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountLockedArgsForCall(i int) (context.Context, internal.Lockout) {
+	fake.accountLockedMutex.RLock()
+	defer fake.accountLockedMutex.RUnlock()
+	argsForCall := fake.accountLockedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountLockedReturns(result1 error) {
+	fake.accountLockedMutex.Lock()
+	defer fake.accountLockedMutex.Unlock()
+	fake.AccountLockedStub = nil
+	fake.accountLockedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountLockedReturnsOnCall(i int, result1 error) {
+	fake.accountLockedMutex.Lock()
+	defer fake.accountLockedMutex.Unlock()
+	fake.AccountLockedStub = nil
+	if fake.accountLockedReturnsOnCall == nil {
+		fake.accountLockedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accountLockedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleCreated(arg1 context.Context, arg2 internal.AccountRoles) error {
+	fake.accountRoleCreatedMutex.Lock()
+	ret, specificReturn := fake.accountRoleCreatedReturnsOnCall[len(fake.accountRoleCreatedArgsForCall)]
+	fake.accountRoleCreatedArgsForCall = append(fake.accountRoleCreatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}{arg1, arg2})
+	stub := fake.AccountRoleCreatedStub
+	fakeReturns := fake.accountRoleCreatedReturns
+	fake.recordInvocation("AccountRoleCreated", []interface{}{arg1, arg2})
+	fake.accountRoleCreatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleCreatedCallCount() int {
+	fake.accountRoleCreatedMutex.RLock()
+	defer fake.accountRoleCreatedMutex.RUnlock()
+	return len(fake.accountRoleCreatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleCreatedCalls(stub func(context.Context, internal.AccountRoles) error) {
+	fake.accountRoleCreatedMutex.Lock()
+	defer fake.accountRoleCreatedMutex.Unlock()
+	fake.AccountRoleCreatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleCreatedArgsForCall(i int) (context.Context, internal.AccountRoles) {
+	fake.accountRoleCreatedMutex.RLock()
+	defer fake.accountRoleCreatedMutex.RUnlock()
+	argsForCall := fake.accountRoleCreatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleCreatedReturns(result1 error) {
+	fake.accountRoleCreatedMutex.Lock()
+	defer fake.accountRoleCreatedMutex.Unlock()
+	fake.AccountRoleCreatedStub = nil
+	fake.accountRoleCreatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleCreatedReturnsOnCall(i int, result1 error) {
+	fake.accountRoleCreatedMutex.Lock()
+	defer fake.accountRoleCreatedMutex.Unlock()
+	fake.AccountRoleCreatedStub = nil
+	if fake.accountRoleCreatedReturnsOnCall == nil {
+		fake.accountRoleCreatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accountRoleCreatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleDeleted(arg1 context.Context, arg2 string) error {
+	fake.accountRoleDeletedMutex.Lock()
+	ret, specificReturn := fake.accountRoleDeletedReturnsOnCall[len(fake.accountRoleDeletedArgsForCall)]
+	fake.accountRoleDeletedArgsForCall = append(fake.accountRoleDeletedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountRoleDeletedStub
+	fakeReturns := fake.accountRoleDeletedReturns
+	fake.recordInvocation("AccountRoleDeleted", []interface{}{arg1, arg2})
+	fake.accountRoleDeletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleDeletedCallCount() int {
+	fake.accountRoleDeletedMutex.RLock()
+	defer fake.accountRoleDeletedMutex.RUnlock()
+	return len(fake.accountRoleDeletedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleDeletedCalls(stub func(context.Context, string) error) {
+	fake.accountRoleDeletedMutex.Lock()
+	defer fake.accountRoleDeletedMutex.Unlock()
+	fake.AccountRoleDeletedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleDeletedArgsForCall(i int) (context.Context, string) {
+	fake.accountRoleDeletedMutex.RLock()
+	defer fake.accountRoleDeletedMutex.RUnlock()
+	argsForCall := fake.accountRoleDeletedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleDeletedReturns(result1 error) {
+	fake.accountRoleDeletedMutex.Lock()
+	defer fake.accountRoleDeletedMutex.Unlock()
+	fake.AccountRoleDeletedStub = nil
+	fake.accountRoleDeletedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleDeletedReturnsOnCall(i int, result1 error) {
+	fake.accountRoleDeletedMutex.Lock()
+	defer fake.accountRoleDeletedMutex.Unlock()
+	fake.AccountRoleDeletedStub = nil
+	if fake.accountRoleDeletedReturnsOnCall == nil {
+		fake.accountRoleDeletedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accountRoleDeletedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleUpdated(arg1 context.Context, arg2 internal.AccountRoles) error {
+	fake.accountRoleUpdatedMutex.Lock()
+	ret, specificReturn := fake.accountRoleUpdatedReturnsOnCall[len(fake.accountRoleUpdatedArgsForCall)]
+	fake.accountRoleUpdatedArgsForCall = append(fake.accountRoleUpdatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}{arg1, arg2})
+	stub := fake.AccountRoleUpdatedStub
+	fakeReturns := fake.accountRoleUpdatedReturns
+	fake.recordInvocation("AccountRoleUpdated", []interface{}{arg1, arg2})
+	fake.accountRoleUpdatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleUpdatedCallCount() int {
+	fake.accountRoleUpdatedMutex.RLock()
+	defer fake.accountRoleUpdatedMutex.RUnlock()
+	return len(fake.accountRoleUpdatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleUpdatedCalls(stub func(context.Context, internal.AccountRoles) error) {
+	fake.accountRoleUpdatedMutex.Lock()
+	defer fake.accountRoleUpdatedMutex.Unlock()
+	fake.AccountRoleUpdatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleUpdatedArgsForCall(i int) (context.Context, internal.AccountRoles) {
+	fake.accountRoleUpdatedMutex.RLock()
+	defer fake.accountRoleUpdatedMutex.RUnlock()
+	argsForCall := fake.accountRoleUpdatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleUpdatedReturns(result1 error) {
+	fake.accountRoleUpdatedMutex.Lock()
+	defer fake.accountRoleUpdatedMutex.Unlock()
+	fake.AccountRoleUpdatedStub = nil
+	fake.accountRoleUpdatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountRoleUpdatedReturnsOnCall(i int, result1 error) {
+	fake.accountRoleUpdatedMutex.Lock()
+	defer fake.accountRoleUpdatedMutex.Unlock()
+	fake.AccountRoleUpdatedStub = nil
+	if fake.accountRoleUpdatedReturnsOnCall == nil {
+		fake.accountRoleUpdatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accountRoleUpdatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUnblocked(arg1 context.Context, arg2 internal.Account) error {
+	fake.accountUnblockedMutex.Lock()
+	ret, specificReturn := fake.accountUnblockedReturnsOnCall[len(fake.accountUnblockedArgsForCall)]
+	fake.accountUnblockedArgsForCall = append(fake.accountUnblockedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}{arg1, arg2})
+	stub := fake.AccountUnblockedStub
+	fakeReturns := fake.accountUnblockedReturns
+	fake.recordInvocation("AccountUnblocked", []interface{}{arg1, arg2})
+	fake.accountUnblockedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUnblockedCallCount() int {
+	fake.accountUnblockedMutex.RLock()
+	defer fake.accountUnblockedMutex.RUnlock()
+	return len(fake.accountUnblockedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUnblockedCalls(stub func(context.Context, internal.Account) error) {
+	fake.accountUnblockedMutex.Lock()
+	defer fake.accountUnblockedMutex.Unlock()
+	fake.AccountUnblockedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUnblockedArgsForCall(i int) (context.Context, internal.Account) {
+	fake.accountUnblockedMutex.RLock()
+	defer fake.accountUnblockedMutex.RUnlock()
+	argsForCall := fake.accountUnblockedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUnblockedReturns(result1 error) {
+	fake.accountUnblockedMutex.Lock()
+	defer fake.accountUnblockedMutex.Unlock()
+	fake.AccountUnblockedStub = nil
+	fake.accountUnblockedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUnblockedReturnsOnCall(i int, result1 error) {
+	fake.accountUnblockedMutex.Lock()
+	defer fake.accountUnblockedMutex.Unlock()
+	fake.AccountUnblockedStub = nil
+	if fake.accountUnblockedReturnsOnCall == nil {
+		fake.accountUnblockedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accountUnblockedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUpdated(arg1 context.Context, arg2 internal.Account) error {
+	fake.accountUpdatedMutex.Lock()
+	ret, specificReturn := fake.accountUpdatedReturnsOnCall[len(fake.accountUpdatedArgsForCall)]
+	fake.accountUpdatedArgsForCall = append(fake.accountUpdatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}{arg1, arg2})
+	stub := fake.AccountUpdatedStub
+	fakeReturns := fake.accountUpdatedReturns
+	fake.recordInvocation("AccountUpdated", []interface{}{arg1, arg2})
+	fake.accountUpdatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUpdatedCallCount() int {
+	fake.accountUpdatedMutex.RLock()
+	defer fake.accountUpdatedMutex.RUnlock()
+	return len(fake.accountUpdatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUpdatedCalls(stub func(context.Context, internal.Account) error) {
+	fake.accountUpdatedMutex.Lock()
+	defer fake.accountUpdatedMutex.Unlock()
+	fake.AccountUpdatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUpdatedArgsForCall(i int) (context.Context, internal.Account) {
+	fake.accountUpdatedMutex.RLock()
+	defer fake.accountUpdatedMutex.RUnlock()
+	argsForCall := fake.accountUpdatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUpdatedReturns(result1 error) {
+	fake.accountUpdatedMutex.Lock()
+	defer fake.accountUpdatedMutex.Unlock()
+	fake.AccountUpdatedStub = nil
+	fake.accountUpdatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) AccountUpdatedReturnsOnCall(i int, result1 error) {
+	fake.accountUpdatedMutex.Lock()
+	defer fake.accountUpdatedMutex.Unlock()
+	fake.AccountUpdatedStub = nil
+	if fake.accountUpdatedReturnsOnCall == nil {
+		fake.accountUpdatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accountUpdatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) BreakGlassActivated(arg1 context.Context, arg2 internal.BreakGlassActivation) error {
+	fake.breakGlassActivatedMutex.Lock()
+	ret, specificReturn := fake.breakGlassActivatedReturnsOnCall[len(fake.breakGlassActivatedArgsForCall)]
+	fake.breakGlassActivatedArgsForCall = append(fake.breakGlassActivatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.BreakGlassActivation
+	}{arg1, arg2})
+	stub := fake.BreakGlassActivatedStub
+	fakeReturns := fake.breakGlassActivatedReturns
+	fake.recordInvocation("BreakGlassActivated", []interface{}{arg1, arg2})
+	fake.breakGlassActivatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) BreakGlassActivatedCallCount() int {
+	fake.breakGlassActivatedMutex.RLock()
+	defer fake.breakGlassActivatedMutex.RUnlock()
+	return len(fake.breakGlassActivatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) BreakGlassActivatedCalls(stub func(context.Context, internal.BreakGlassActivation) error) {
+	fake.breakGlassActivatedMutex.Lock()
+	defer fake.breakGlassActivatedMutex.Unlock()
+	fake.BreakGlassActivatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) BreakGlassActivatedArgsForCall(i int) (context.Context, internal.BreakGlassActivation) {
+	fake.breakGlassActivatedMutex.RLock()
+	defer fake.breakGlassActivatedMutex.RUnlock()
+	argsForCall := fake.breakGlassActivatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) BreakGlassActivatedReturns(result1 error) {
+	fake.breakGlassActivatedMutex.Lock()
+	defer fake.breakGlassActivatedMutex.Unlock()
+	fake.BreakGlassActivatedStub = nil
+	fake.breakGlassActivatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) BreakGlassActivatedReturnsOnCall(i int, result1 error) {
+	fake.breakGlassActivatedMutex.Lock()
+	defer fake.breakGlassActivatedMutex.Unlock()
+	fake.BreakGlassActivatedStub = nil
+	if fake.breakGlassActivatedReturnsOnCall == nil {
+		fake.breakGlassActivatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.breakGlassActivatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberAdded(arg1 context.Context, arg2 internal.GroupMember) error {
+	fake.groupMemberAddedMutex.Lock()
+	ret, specificReturn := fake.groupMemberAddedReturnsOnCall[len(fake.groupMemberAddedArgsForCall)]
+	fake.groupMemberAddedArgsForCall = append(fake.groupMemberAddedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.GroupMember
+	}{arg1, arg2})
+	stub := fake.GroupMemberAddedStub
+	fakeReturns := fake.groupMemberAddedReturns
+	fake.recordInvocation("GroupMemberAdded", []interface{}{arg1, arg2})
+	fake.groupMemberAddedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberAddedCallCount() int {
+	fake.groupMemberAddedMutex.RLock()
+	defer fake.groupMemberAddedMutex.RUnlock()
+	return len(fake.groupMemberAddedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberAddedCalls(stub func(context.Context, internal.GroupMember) error) {
+	fake.groupMemberAddedMutex.Lock()
+	defer fake.groupMemberAddedMutex.Unlock()
+	fake.GroupMemberAddedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberAddedArgsForCall(i int) (context.Context, internal.GroupMember) {
+	fake.groupMemberAddedMutex.RLock()
+	defer fake.groupMemberAddedMutex.RUnlock()
+	argsForCall := fake.groupMemberAddedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberAddedReturns(result1 error) {
+	fake.groupMemberAddedMutex.Lock()
+	defer fake.groupMemberAddedMutex.Unlock()
+	fake.GroupMemberAddedStub = nil
+	fake.groupMemberAddedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberAddedReturnsOnCall(i int, result1 error) {
+	fake.groupMemberAddedMutex.Lock()
+	defer fake.groupMemberAddedMutex.Unlock()
+	fake.GroupMemberAddedStub = nil
+	if fake.groupMemberAddedReturnsOnCall == nil {
+		fake.groupMemberAddedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.groupMemberAddedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberRemoved(arg1 context.Context, arg2 string) error {
+	fake.groupMemberRemovedMutex.Lock()
+	ret, specificReturn := fake.groupMemberRemovedReturnsOnCall[len(fake.groupMemberRemovedArgsForCall)]
+	fake.groupMemberRemovedArgsForCall = append(fake.groupMemberRemovedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GroupMemberRemovedStub
+	fakeReturns := fake.groupMemberRemovedReturns
+	fake.recordInvocation("GroupMemberRemoved", []interface{}{arg1, arg2})
+	fake.groupMemberRemovedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberRemovedCallCount() int {
+	fake.groupMemberRemovedMutex.RLock()
+	defer fake.groupMemberRemovedMutex.RUnlock()
+	return len(fake.groupMemberRemovedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberRemovedCalls(stub func(context.Context, string) error) {
+	fake.groupMemberRemovedMutex.Lock()
+	defer fake.groupMemberRemovedMutex.Unlock()
+	fake.GroupMemberRemovedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberRemovedArgsForCall(i int) (context.Context, string) {
+	fake.groupMemberRemovedMutex.RLock()
+	defer fake.groupMemberRemovedMutex.RUnlock()
+	argsForCall := fake.groupMemberRemovedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberRemovedReturns(result1 error) {
+	fake.groupMemberRemovedMutex.Lock()
+	defer fake.groupMemberRemovedMutex.Unlock()
+	fake.GroupMemberRemovedStub = nil
+	fake.groupMemberRemovedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) GroupMemberRemovedReturnsOnCall(i int, result1 error) {
+	fake.groupMemberRemovedMutex.Lock()
+	defer fake.groupMemberRemovedMutex.Unlock()
+	fake.GroupMemberRemovedStub = nil
+	if fake.groupMemberRemovedReturnsOnCall == nil {
+		fake.groupMemberRemovedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.groupMemberRemovedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileCreated(arg1 context.Context, arg2 internal.Profile) error {
+	fake.profileCreatedMutex.Lock()
+	ret, specificReturn := fake.profileCreatedReturnsOnCall[len(fake.profileCreatedArgsForCall)]
+	fake.profileCreatedArgsForCall = append(fake.profileCreatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}{arg1, arg2})
+	stub := fake.ProfileCreatedStub
+	fakeReturns := fake.profileCreatedReturns
+	fake.recordInvocation("ProfileCreated", []interface{}{arg1, arg2})
+	fake.profileCreatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileCreatedCallCount() int {
+	fake.profileCreatedMutex.RLock()
+	defer fake.profileCreatedMutex.RUnlock()
+	return len(fake.profileCreatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileCreatedCalls(stub func(context.Context, internal.Profile) error) {
+	fake.profileCreatedMutex.Lock()
+	defer fake.profileCreatedMutex.Unlock()
+	fake.ProfileCreatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileCreatedArgsForCall(i int) (context.Context, internal.Profile) {
+	fake.profileCreatedMutex.RLock()
+	defer fake.profileCreatedMutex.RUnlock()
+	argsForCall := fake.profileCreatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileCreatedReturns(result1 error) {
+	fake.profileCreatedMutex.Lock()
+	defer fake.profileCreatedMutex.Unlock()
+	fake.ProfileCreatedStub = nil
+	fake.profileCreatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileCreatedReturnsOnCall(i int, result1 error) {
+	fake.profileCreatedMutex.Lock()
+	defer fake.profileCreatedMutex.Unlock()
+	fake.ProfileCreatedStub = nil
+	if fake.profileCreatedReturnsOnCall == nil {
+		fake.profileCreatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.profileCreatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileDeleted(arg1 context.Context, arg2 string) error {
+	fake.profileDeletedMutex.Lock()
+	ret, specificReturn := fake.profileDeletedReturnsOnCall[len(fake.profileDeletedArgsForCall)]
+	fake.profileDeletedArgsForCall = append(fake.profileDeletedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ProfileDeletedStub
+	fakeReturns := fake.profileDeletedReturns
+	fake.recordInvocation("ProfileDeleted", []interface{}{arg1, arg2})
+	fake.profileDeletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileDeletedCallCount() int {
+	fake.profileDeletedMutex.RLock()
+	defer fake.profileDeletedMutex.RUnlock()
+	return len(fake.profileDeletedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileDeletedCalls(stub func(context.Context, string) error) {
+	fake.profileDeletedMutex.Lock()
+	defer fake.profileDeletedMutex.Unlock()
+	fake.ProfileDeletedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileDeletedArgsForCall(i int) (context.Context, string) {
+	fake.profileDeletedMutex.RLock()
+	defer fake.profileDeletedMutex.RUnlock()
+	argsForCall := fake.profileDeletedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileDeletedReturns(result1 error) {
+	fake.profileDeletedMutex.Lock()
+	defer fake.profileDeletedMutex.Unlock()
+	fake.ProfileDeletedStub = nil
+	fake.profileDeletedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileDeletedReturnsOnCall(i int, result1 error) {
+	fake.profileDeletedMutex.Lock()
+	defer fake.profileDeletedMutex.Unlock()
+	fake.ProfileDeletedStub = nil
+	if fake.profileDeletedReturnsOnCall == nil {
+		fake.profileDeletedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.profileDeletedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileUpdated(arg1 context.Context, arg2 internal.Profile) error {
+	fake.profileUpdatedMutex.Lock()
+	ret, specificReturn := fake.profileUpdatedReturnsOnCall[len(fake.profileUpdatedArgsForCall)]
+	fake.profileUpdatedArgsForCall = append(fake.profileUpdatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}{arg1, arg2})
+	stub := fake.ProfileUpdatedStub
+	fakeReturns := fake.profileUpdatedReturns
+	fake.recordInvocation("ProfileUpdated", []interface{}{arg1, arg2})
+	fake.profileUpdatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileUpdatedCallCount() int {
+	fake.profileUpdatedMutex.RLock()
+	defer fake.profileUpdatedMutex.RUnlock()
+	return len(fake.profileUpdatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileUpdatedCalls(stub func(context.Context, internal.Profile) error) {
+	fake.profileUpdatedMutex.Lock()
+	defer fake.profileUpdatedMutex.Unlock()
+	fake.ProfileUpdatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileUpdatedArgsForCall(i int) (context.Context, internal.Profile) {
+	fake.profileUpdatedMutex.RLock()
+	defer fake.profileUpdatedMutex.RUnlock()
+	argsForCall := fake.profileUpdatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileUpdatedReturns(result1 error) {
+	fake.profileUpdatedMutex.Lock()
+	defer fake.profileUpdatedMutex.Unlock()
+	fake.ProfileUpdatedStub = nil
+	fake.profileUpdatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) ProfileUpdatedReturnsOnCall(i int, result1 error) {
+	fake.profileUpdatedMutex.Lock()
+	defer fake.profileUpdatedMutex.Unlock()
+	fake.ProfileUpdatedStub = nil
+	if fake.profileUpdatedReturnsOnCall == nil {
+		fake.profileUpdatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.profileUpdatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleCreated(arg1 context.Context, arg2 internal.Roles) error {
+	fake.roleCreatedMutex.Lock()
+	ret, specificReturn := fake.roleCreatedReturnsOnCall[len(fake.roleCreatedArgsForCall)]
+	fake.roleCreatedArgsForCall = append(fake.roleCreatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Roles
+	}{arg1, arg2})
+	stub := fake.RoleCreatedStub
+	fakeReturns := fake.roleCreatedReturns
+	fake.recordInvocation("RoleCreated", []interface{}{arg1, arg2})
+	fake.roleCreatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleCreatedCallCount() int {
+	fake.roleCreatedMutex.RLock()
+	defer fake.roleCreatedMutex.RUnlock()
+	return len(fake.roleCreatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleCreatedCalls(stub func(context.Context, internal.Roles) error) {
+	fake.roleCreatedMutex.Lock()
+	defer fake.roleCreatedMutex.Unlock()
+	fake.RoleCreatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleCreatedArgsForCall(i int) (context.Context, internal.Roles) {
+	fake.roleCreatedMutex.RLock()
+	defer fake.roleCreatedMutex.RUnlock()
+	argsForCall := fake.roleCreatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleCreatedReturns(result1 error) {
+	fake.roleCreatedMutex.Lock()
+	defer fake.roleCreatedMutex.Unlock()
+	fake.RoleCreatedStub = nil
+	fake.roleCreatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleCreatedReturnsOnCall(i int, result1 error) {
+	fake.roleCreatedMutex.Lock()
+	defer fake.roleCreatedMutex.Unlock()
+	fake.RoleCreatedStub = nil
+	if fake.roleCreatedReturnsOnCall == nil {
+		fake.roleCreatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.roleCreatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleDeleted(arg1 context.Context, arg2 string) error {
+	fake.roleDeletedMutex.Lock()
+	ret, specificReturn := fake.roleDeletedReturnsOnCall[len(fake.roleDeletedArgsForCall)]
+	fake.roleDeletedArgsForCall = append(fake.roleDeletedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RoleDeletedStub
+	fakeReturns := fake.roleDeletedReturns
+	fake.recordInvocation("RoleDeleted", []interface{}{arg1, arg2})
+	fake.roleDeletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleDeletedCallCount() int {
+	fake.roleDeletedMutex.RLock()
+	defer fake.roleDeletedMutex.RUnlock()
+	return len(fake.roleDeletedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleDeletedCalls(stub func(context.Context, string) error) {
+	fake.roleDeletedMutex.Lock()
+	defer fake.roleDeletedMutex.Unlock()
+	fake.RoleDeletedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleDeletedArgsForCall(i int) (context.Context, string) {
+	fake.roleDeletedMutex.RLock()
+	defer fake.roleDeletedMutex.RUnlock()
+	argsForCall := fake.roleDeletedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleDeletedReturns(result1 error) {
+	fake.roleDeletedMutex.Lock()
+	defer fake.roleDeletedMutex.Unlock()
+	fake.RoleDeletedStub = nil
+	fake.roleDeletedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleDeletedReturnsOnCall(i int, result1 error) {
+	fake.roleDeletedMutex.Lock()
+	defer fake.roleDeletedMutex.Unlock()
+	fake.RoleDeletedStub = nil
+	if fake.roleDeletedReturnsOnCall == nil {
+		fake.roleDeletedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.roleDeletedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskCreated(arg1 context.Context, arg2 internal.RoleTasks) error {
+	fake.roleTaskCreatedMutex.Lock()
+	ret, specificReturn := fake.roleTaskCreatedReturnsOnCall[len(fake.roleTaskCreatedArgsForCall)]
+	fake.roleTaskCreatedArgsForCall = append(fake.roleTaskCreatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
+	}{arg1, arg2})
+	stub := fake.RoleTaskCreatedStub
+	fakeReturns := fake.roleTaskCreatedReturns
+	fake.recordInvocation("RoleTaskCreated", []interface{}{arg1, arg2})
+	fake.roleTaskCreatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskCreatedCallCount() int {
+	fake.roleTaskCreatedMutex.RLock()
+	defer fake.roleTaskCreatedMutex.RUnlock()
+	return len(fake.roleTaskCreatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskCreatedCalls(stub func(context.Context, internal.RoleTasks) error) {
+	fake.roleTaskCreatedMutex.Lock()
+	defer fake.roleTaskCreatedMutex.Unlock()
+	fake.RoleTaskCreatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskCreatedArgsForCall(i int) (context.Context, internal.RoleTasks) {
+	fake.roleTaskCreatedMutex.RLock()
+	defer fake.roleTaskCreatedMutex.RUnlock()
+	argsForCall := fake.roleTaskCreatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskCreatedReturns(result1 error) {
+	fake.roleTaskCreatedMutex.Lock()
+	defer fake.roleTaskCreatedMutex.Unlock()
+	fake.RoleTaskCreatedStub = nil
+	fake.roleTaskCreatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskCreatedReturnsOnCall(i int, result1 error) {
+	fake.roleTaskCreatedMutex.Lock()
+	defer fake.roleTaskCreatedMutex.Unlock()
+	fake.RoleTaskCreatedStub = nil
+	if fake.roleTaskCreatedReturnsOnCall == nil {
+		fake.roleTaskCreatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.roleTaskCreatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskDeleted(arg1 context.Context, arg2 string) error {
+	fake.roleTaskDeletedMutex.Lock()
+	ret, specificReturn := fake.roleTaskDeletedReturnsOnCall[len(fake.roleTaskDeletedArgsForCall)]
+	fake.roleTaskDeletedArgsForCall = append(fake.roleTaskDeletedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RoleTaskDeletedStub
+	fakeReturns := fake.roleTaskDeletedReturns
+	fake.recordInvocation("RoleTaskDeleted", []interface{}{arg1, arg2})
+	fake.roleTaskDeletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskDeletedCallCount() int {
+	fake.roleTaskDeletedMutex.RLock()
+	defer fake.roleTaskDeletedMutex.RUnlock()
+	return len(fake.roleTaskDeletedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskDeletedCalls(stub func(context.Context, string) error) {
+	fake.roleTaskDeletedMutex.Lock()
+	defer fake.roleTaskDeletedMutex.Unlock()
+	fake.RoleTaskDeletedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskDeletedArgsForCall(i int) (context.Context, string) {
+	fake.roleTaskDeletedMutex.RLock()
+	defer fake.roleTaskDeletedMutex.RUnlock()
+	argsForCall := fake.roleTaskDeletedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskDeletedReturns(result1 error) {
+	fake.roleTaskDeletedMutex.Lock()
+	defer fake.roleTaskDeletedMutex.Unlock()
+	fake.RoleTaskDeletedStub = nil
+	fake.roleTaskDeletedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskDeletedReturnsOnCall(i int, result1 error) {
+	fake.roleTaskDeletedMutex.Lock()
+	defer fake.roleTaskDeletedMutex.Unlock()
+	fake.RoleTaskDeletedStub = nil
+	if fake.roleTaskDeletedReturnsOnCall == nil {
+		fake.roleTaskDeletedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.roleTaskDeletedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskUpdated(arg1 context.Context, arg2 internal.RoleTasks) error {
+	fake.roleTaskUpdatedMutex.Lock()
+	ret, specificReturn := fake.roleTaskUpdatedReturnsOnCall[len(fake.roleTaskUpdatedArgsForCall)]
+	fake.roleTaskUpdatedArgsForCall = append(fake.roleTaskUpdatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
+	}{arg1, arg2})
+	stub := fake.RoleTaskUpdatedStub
+	fakeReturns := fake.roleTaskUpdatedReturns
+	fake.recordInvocation("RoleTaskUpdated", []interface{}{arg1, arg2})
+	fake.roleTaskUpdatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskUpdatedCallCount() int {
+	fake.roleTaskUpdatedMutex.RLock()
+	defer fake.roleTaskUpdatedMutex.RUnlock()
+	return len(fake.roleTaskUpdatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskUpdatedCalls(stub func(context.Context, internal.RoleTasks) error) {
+	fake.roleTaskUpdatedMutex.Lock()
+	defer fake.roleTaskUpdatedMutex.Unlock()
+	fake.RoleTaskUpdatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskUpdatedArgsForCall(i int) (context.Context, internal.RoleTasks) {
+	fake.roleTaskUpdatedMutex.RLock()
+	defer fake.roleTaskUpdatedMutex.RUnlock()
+	argsForCall := fake.roleTaskUpdatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskUpdatedReturns(result1 error) {
+	fake.roleTaskUpdatedMutex.Lock()
+	defer fake.roleTaskUpdatedMutex.Unlock()
+	fake.RoleTaskUpdatedStub = nil
+	fake.roleTaskUpdatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleTaskUpdatedReturnsOnCall(i int, result1 error) {
+	fake.roleTaskUpdatedMutex.Lock()
+	defer fake.roleTaskUpdatedMutex.Unlock()
+	fake.RoleTaskUpdatedStub = nil
+	if fake.roleTaskUpdatedReturnsOnCall == nil {
+		fake.roleTaskUpdatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.roleTaskUpdatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleUpdated(arg1 context.Context, arg2 internal.Roles) error {
+	fake.roleUpdatedMutex.Lock()
+	ret, specificReturn := fake.roleUpdatedReturnsOnCall[len(fake.roleUpdatedArgsForCall)]
+	fake.roleUpdatedArgsForCall = append(fake.roleUpdatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Roles
+	}{arg1, arg2})
+	stub := fake.RoleUpdatedStub
+	fakeReturns := fake.roleUpdatedReturns
+	fake.recordInvocation("RoleUpdated", []interface{}{arg1, arg2})
+	fake.roleUpdatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleUpdatedCallCount() int {
+	fake.roleUpdatedMutex.RLock()
+	defer fake.roleUpdatedMutex.RUnlock()
+	return len(fake.roleUpdatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleUpdatedCalls(stub func(context.Context, internal.Roles) error) {
+	fake.roleUpdatedMutex.Lock()
+	defer fake.roleUpdatedMutex.Unlock()
+	fake.RoleUpdatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleUpdatedArgsForCall(i int) (context.Context, internal.Roles) {
+	fake.roleUpdatedMutex.RLock()
+	defer fake.roleUpdatedMutex.RUnlock()
+	argsForCall := fake.roleUpdatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleUpdatedReturns(result1 error) {
+	fake.roleUpdatedMutex.Lock()
+	defer fake.roleUpdatedMutex.Unlock()
+	fake.RoleUpdatedStub = nil
+	fake.roleUpdatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) RoleUpdatedReturnsOnCall(i int, result1 error) {
+	fake.roleUpdatedMutex.Lock()
+	defer fake.roleUpdatedMutex.Unlock()
+	fake.RoleUpdatedStub = nil
+	if fake.roleUpdatedReturnsOnCall == nil {
+		fake.roleUpdatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.roleUpdatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskCreated(arg1 context.Context, arg2 internal.Tasks) error {
+	fake.taskCreatedMutex.Lock()
+	ret, specificReturn := fake.taskCreatedReturnsOnCall[len(fake.taskCreatedArgsForCall)]
+	fake.taskCreatedArgsForCall = append(fake.taskCreatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Tasks
+	}{arg1, arg2})
+	stub := fake.TaskCreatedStub
+	fakeReturns := fake.taskCreatedReturns
+	fake.recordInvocation("TaskCreated", []interface{}{arg1, arg2})
+	fake.taskCreatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskCreatedCallCount() int {
+	fake.taskCreatedMutex.RLock()
+	defer fake.taskCreatedMutex.RUnlock()
+	return len(fake.taskCreatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskCreatedCalls(stub func(context.Context, internal.Tasks) error) {
+	fake.taskCreatedMutex.Lock()
+	defer fake.taskCreatedMutex.Unlock()
+	fake.TaskCreatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskCreatedArgsForCall(i int) (context.Context, internal.Tasks) {
+	fake.taskCreatedMutex.RLock()
+	defer fake.taskCreatedMutex.RUnlock()
+	argsForCall := fake.taskCreatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskCreatedReturns(result1 error) {
+	fake.taskCreatedMutex.Lock()
+	defer fake.taskCreatedMutex.Unlock()
+	fake.TaskCreatedStub = nil
+	fake.taskCreatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskCreatedReturnsOnCall(i int, result1 error) {
+	fake.taskCreatedMutex.Lock()
+	defer fake.taskCreatedMutex.Unlock()
+	fake.TaskCreatedStub = nil
+	if fake.taskCreatedReturnsOnCall == nil {
+		fake.taskCreatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.taskCreatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskDeleted(arg1 context.Context, arg2 string) error {
+	fake.taskDeletedMutex.Lock()
+	ret, specificReturn := fake.taskDeletedReturnsOnCall[len(fake.taskDeletedArgsForCall)]
+	fake.taskDeletedArgsForCall = append(fake.taskDeletedArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TaskDeletedStub
+	fakeReturns := fake.taskDeletedReturns
+	fake.recordInvocation("TaskDeleted", []interface{}{arg1, arg2})
+	fake.taskDeletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskDeletedCallCount() int {
+	fake.taskDeletedMutex.RLock()
+	defer fake.taskDeletedMutex.RUnlock()
+	return len(fake.taskDeletedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskDeletedCalls(stub func(context.Context, string) error) {
+	fake.taskDeletedMutex.Lock()
+	defer fake.taskDeletedMutex.Unlock()
+	fake.TaskDeletedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskDeletedArgsForCall(i int) (context.Context, string) {
+	fake.taskDeletedMutex.RLock()
+	defer fake.taskDeletedMutex.RUnlock()
+	argsForCall := fake.taskDeletedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskDeletedReturns(result1 error) {
+	fake.taskDeletedMutex.Lock()
+	defer fake.taskDeletedMutex.Unlock()
+	fake.TaskDeletedStub = nil
+	fake.taskDeletedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskDeletedReturnsOnCall(i int, result1 error) {
+	fake.taskDeletedMutex.Lock()
+	defer fake.taskDeletedMutex.Unlock()
+	fake.TaskDeletedStub = nil
+	if fake.taskDeletedReturnsOnCall == nil {
+		fake.taskDeletedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.taskDeletedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskUpdated(arg1 context.Context, arg2 internal.Tasks) error {
+	fake.taskUpdatedMutex.Lock()
+	ret, specificReturn := fake.taskUpdatedReturnsOnCall[len(fake.taskUpdatedArgsForCall)]
+	fake.taskUpdatedArgsForCall = append(fake.taskUpdatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Tasks
+	}{arg1, arg2})
+	stub := fake.TaskUpdatedStub
+	fakeReturns := fake.taskUpdatedReturns
+	fake.recordInvocation("TaskUpdated", []interface{}{arg1, arg2})
+	fake.taskUpdatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskUpdatedCallCount() int {
+	fake.taskUpdatedMutex.RLock()
+	defer fake.taskUpdatedMutex.RUnlock()
+	return len(fake.taskUpdatedArgsForCall)
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskUpdatedCalls(stub func(context.Context, internal.Tasks) error) {
+	fake.taskUpdatedMutex.Lock()
+	defer fake.taskUpdatedMutex.Unlock()
+	fake.TaskUpdatedStub = stub
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskUpdatedArgsForCall(i int) (context.Context, internal.Tasks) {
+	fake.taskUpdatedMutex.RLock()
+	defer fake.taskUpdatedMutex.RUnlock()
+	argsForCall := fake.taskUpdatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskUpdatedReturns(result1 error) {
+	fake.taskUpdatedMutex.Lock()
+	defer fake.taskUpdatedMutex.Unlock()
+	fake.TaskUpdatedStub = nil
+	fake.taskUpdatedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) TaskUpdatedReturnsOnCall(i int, result1 error) {
+	fake.taskUpdatedMutex.Lock()
+	defer fake.taskUpdatedMutex.Unlock()
+	fake.TaskUpdatedStub = nil
+	if fake.taskUpdatedReturnsOnCall == nil {
+		fake.taskUpdatedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.taskUpdatedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACMessageBrokerRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.accessRequestCreatedMutex.RLock()
+	defer fake.accessRequestCreatedMutex.RUnlock()
+	fake.accessRequestDecidedMutex.RLock()
+	defer fake.accessRequestDecidedMutex.RUnlock()
+	fake.accountBlockedMutex.RLock()
+	defer fake.accountBlockedMutex.RUnlock()
+	fake.accountCreatedMutex.RLock()
+	defer fake.accountCreatedMutex.RUnlock()
+	fake.accountDeletedMutex.RLock()
+	defer fake.accountDeletedMutex.RUnlock()
+	fake.accountLockedMutex.RLock()
+	defer fake.accountLockedMutex.RUnlock()
+	fake.accountRoleCreatedMutex.RLock()
+	defer fake.accountRoleCreatedMutex.RUnlock()
+	fake.accountRoleDeletedMutex.RLock()
+	defer fake.accountRoleDeletedMutex.RUnlock()
+	fake.accountRoleUpdatedMutex.RLock()
+	defer fake.accountRoleUpdatedMutex.RUnlock()
+	fake.accountUnblockedMutex.RLock()
+	defer fake.accountUnblockedMutex.RUnlock()
+	fake.accountUpdatedMutex.RLock()
+	defer fake.accountUpdatedMutex.RUnlock()
+	fake.breakGlassActivatedMutex.RLock()
+	defer fake.breakGlassActivatedMutex.RUnlock()
+	fake.groupMemberAddedMutex.RLock()
+	defer fake.groupMemberAddedMutex.RUnlock()
+	fake.groupMemberRemovedMutex.RLock()
+	defer fake.groupMemberRemovedMutex.RUnlock()
+	fake.profileCreatedMutex.RLock()
+	defer fake.profileCreatedMutex.RUnlock()
+	fake.profileDeletedMutex.RLock()
+	defer fake.profileDeletedMutex.RUnlock()
+	fake.profileUpdatedMutex.RLock()
+	defer fake.profileUpdatedMutex.RUnlock()
+	fake.roleCreatedMutex.RLock()
+	defer fake.roleCreatedMutex.RUnlock()
+	fake.roleDeletedMutex.RLock()
+	defer fake.roleDeletedMutex.RUnlock()
+	fake.roleTaskCreatedMutex.RLock()
+	defer fake.roleTaskCreatedMutex.RUnlock()
+	fake.roleTaskDeletedMutex.RLock()
+	defer fake.roleTaskDeletedMutex.RUnlock()
+	fake.roleTaskUpdatedMutex.RLock()
+	defer fake.roleTaskUpdatedMutex.RUnlock()
+	fake.roleUpdatedMutex.RLock()
+	defer fake.roleUpdatedMutex.RUnlock()
+	fake.taskCreatedMutex.RLock()
+	defer fake.taskCreatedMutex.RUnlock()
+	fake.taskDeletedMutex.RLock()
+	defer fake.taskDeletedMutex.RUnlock()
+	fake.taskUpdatedMutex.RLock()
+	defer fake.taskUpdatedMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRBACMessageBrokerRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.RBACMessageBrokerRepository = new(FakeRBACMessageBrokerRepository)
@@ -0,0 +1,9136 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicetesting
+
+import (
+	"rbac/internal"
+	"rbac/internal/service"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+type FakeRBACRepository struct {
+	APIKeyByHashStub        func(context.Context, string) (internal.APIKey, error)
+	aPIKeyByHashMutex       sync.RWMutex
+	aPIKeyByHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	aPIKeyByHashReturns struct {
+		result1 internal.APIKey
+		result2 error
+	}
+	aPIKeyByHashReturnsOnCall map[int]struct {
+		result1 internal.APIKey
+		result2 error
+	}
+	APIKeysByAccountStub        func(context.Context, string) ([]internal.APIKey, error)
+	aPIKeysByAccountMutex       sync.RWMutex
+	aPIKeysByAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	aPIKeysByAccountReturns struct {
+		result1 []internal.APIKey
+		result2 error
+	}
+	aPIKeysByAccountReturnsOnCall map[int]struct {
+		result1 []internal.APIKey
+		result2 error
+	}
+	AccessRequestStub        func(context.Context, string) (internal.AccessRequest, error)
+	accessRequestMutex       sync.RWMutex
+	accessRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accessRequestReturns struct {
+		result1 internal.AccessRequest
+		result2 error
+	}
+	accessRequestReturnsOnCall map[int]struct {
+		result1 internal.AccessRequest
+		result2 error
+	}
+	AccessRequestsStub        func(context.Context) ([]internal.AccessRequest, error)
+	accessRequestsMutex       sync.RWMutex
+	accessRequestsArgsForCall []struct {
+		arg1 context.Context
+	}
+	accessRequestsReturns struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}
+	accessRequestsReturnsOnCall map[int]struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}
+	AccountStub        func(context.Context, string) (internal.Account, error)
+	accountMutex       sync.RWMutex
+	accountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountReturns struct {
+		result1 internal.Account
+		result2 error
+	}
+	accountReturnsOnCall map[int]struct {
+		result1 internal.Account
+		result2 error
+	}
+	AccountAccessRequestsStub        func(context.Context, string) ([]internal.AccessRequest, error)
+	accountAccessRequestsMutex       sync.RWMutex
+	accountAccessRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountAccessRequestsReturns struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}
+	accountAccessRequestsReturnsOnCall map[int]struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}
+	AccountByIDStub        func(context.Context, string) (internal.Account, error)
+	accountByIDMutex       sync.RWMutex
+	accountByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountByIDReturns struct {
+		result1 internal.Account
+		result2 error
+	}
+	accountByIDReturnsOnCall map[int]struct {
+		result1 internal.Account
+		result2 error
+	}
+	AccountRoleStub        func(context.Context, string) (internal.AccountRoles, error)
+	accountRoleMutex       sync.RWMutex
+	accountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountRoleReturns struct {
+		result1 internal.AccountRoles
+		result2 error
+	}
+	accountRoleReturnsOnCall map[int]struct {
+		result1 internal.AccountRoles
+		result2 error
+	}
+	AccountRolesByAccountStub        func(context.Context, string) ([]internal.AccountRoles, error)
+	accountRolesByAccountMutex       sync.RWMutex
+	accountRolesByAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountRolesByAccountReturns struct {
+		result1 []internal.AccountRoles
+		result2 error
+	}
+	accountRolesByAccountReturnsOnCall map[int]struct {
+		result1 []internal.AccountRoles
+		result2 error
+	}
+	AccountTenantsStub        func(context.Context, string) ([]internal.Tenant, error)
+	accountTenantsMutex       sync.RWMutex
+	accountTenantsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	accountTenantsReturns struct {
+		result1 []internal.Tenant
+		result2 error
+	}
+	accountTenantsReturnsOnCall map[int]struct {
+		result1 []internal.Tenant
+		result2 error
+	}
+	AddAccountTenantStub        func(context.Context, string, string) error
+	addAccountTenantMutex       sync.RWMutex
+	addAccountTenantArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addAccountTenantReturns struct {
+		result1 error
+	}
+	addAccountTenantReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddGroupMemberStub        func(context.Context, string, string) (internal.GroupMember, error)
+	addGroupMemberMutex       sync.RWMutex
+	addGroupMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addGroupMemberReturns struct {
+		result1 internal.GroupMember
+		result2 error
+	}
+	addGroupMemberReturnsOnCall map[int]struct {
+		result1 internal.GroupMember
+		result2 error
+	}
+	AddGroupRoleStub        func(context.Context, string, string) (string, error)
+	addGroupRoleMutex       sync.RWMutex
+	addGroupRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addGroupRoleReturns struct {
+		result1 string
+		result2 error
+	}
+	addGroupRoleReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	AddMemberGroupStub        func(context.Context, string, string) (string, error)
+	addMemberGroupMutex       sync.RWMutex
+	addMemberGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addMemberGroupReturns struct {
+		result1 string
+		result2 error
+	}
+	addMemberGroupReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	AddRoleApproverStub        func(context.Context, string, string) (string, error)
+	addRoleApproverMutex       sync.RWMutex
+	addRoleApproverArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	addRoleApproverReturns struct {
+		result1 string
+		result2 error
+	}
+	addRoleApproverReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ApplyPolicyStub        func(context.Context, internal.Policy, internal.PolicyOptions) ([]internal.PolicyChange, error)
+	applyPolicyMutex       sync.RWMutex
+	applyPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Policy
+		arg3 internal.PolicyOptions
+	}
+	applyPolicyReturns struct {
+		result1 []internal.PolicyChange
+		result2 error
+	}
+	applyPolicyReturnsOnCall map[int]struct {
+		result1 []internal.PolicyChange
+		result2 error
+	}
+	ApproveAccessRequestStub        func(context.Context, string, string, string) (string, error)
+	approveAccessRequestMutex       sync.RWMutex
+	approveAccessRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	approveAccessRequestReturns struct {
+		result1 string
+		result2 error
+	}
+	approveAccessRequestReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	BlockAccountStub        func(context.Context, string) error
+	blockAccountMutex       sync.RWMutex
+	blockAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	blockAccountReturns struct {
+		result1 error
+	}
+	blockAccountReturnsOnCall map[int]struct {
+		result1 error
+	}
+	BreakGlassActivationStub        func(context.Context, string) (internal.BreakGlassActivation, error)
+	breakGlassActivationMutex       sync.RWMutex
+	breakGlassActivationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	breakGlassActivationReturns struct {
+		result1 internal.BreakGlassActivation
+		result2 error
+	}
+	breakGlassActivationReturnsOnCall map[int]struct {
+		result1 internal.BreakGlassActivation
+		result2 error
+	}
+	BreakGlassActivationsStub        func(context.Context, time.Time) ([]internal.BreakGlassActivation, error)
+	breakGlassActivationsMutex       sync.RWMutex
+	breakGlassActivationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	breakGlassActivationsReturns struct {
+		result1 []internal.BreakGlassActivation
+		result2 error
+	}
+	breakGlassActivationsReturnsOnCall map[int]struct {
+		result1 []internal.BreakGlassActivation
+		result2 error
+	}
+	ChangePasswordStub        func(context.Context, string, string) error
+	changePasswordMutex       sync.RWMutex
+	changePasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	changePasswordReturns struct {
+		result1 error
+	}
+	changePasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ConfirmMFAStub        func(context.Context, string, int64, []string) error
+	confirmMFAMutex       sync.RWMutex
+	confirmMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int64
+		arg4 []string
+	}
+	confirmMFAReturns struct {
+		result1 error
+	}
+	confirmMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CountStartedAccountRolesStub        func(context.Context, time.Time, time.Time) (int64, error)
+	countStartedAccountRolesMutex       sync.RWMutex
+	countStartedAccountRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Time
+	}
+	countStartedAccountRolesReturns struct {
+		result1 int64
+		result2 error
+	}
+	countStartedAccountRolesReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	CreateAPIKeyStub        func(context.Context, string, string, string, string, time.Time) (internal.APIKey, error)
+	createAPIKeyMutex       sync.RWMutex
+	createAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+		arg6 time.Time
+	}
+	createAPIKeyReturns struct {
+		result1 internal.APIKey
+		result2 error
+	}
+	createAPIKeyReturnsOnCall map[int]struct {
+		result1 internal.APIKey
+		result2 error
+	}
+	CreateAccessRequestStub        func(context.Context, string, string, string, time.Time) (string, error)
+	createAccessRequestMutex       sync.RWMutex
+	createAccessRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Time
+	}
+	createAccessRequestReturns struct {
+		result1 string
+		result2 error
+	}
+	createAccessRequestReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateAccountStub        func(context.Context, internal.Account, string) (string, error)
+	createAccountMutex       sync.RWMutex
+	createAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Account
+		arg3 string
+	}
+	createAccountReturns struct {
+		result1 string
+		result2 error
+	}
+	createAccountReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateAccountRoleStub        func(context.Context, string, string, internal.Scope, internal.Validity) (string, error)
+	createAccountRoleMutex       sync.RWMutex
+	createAccountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Scope
+		arg5 internal.Validity
+	}
+	createAccountRoleReturns struct {
+		result1 string
+		result2 error
+	}
+	createAccountRoleReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateBreakGlassActivationStub        func(context.Context, string, string, string, time.Time) (string, string, error)
+	createBreakGlassActivationMutex       sync.RWMutex
+	createBreakGlassActivationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Time
+	}
+	createBreakGlassActivationReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	createBreakGlassActivationReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	CreateExclusiveRoleSetStub        func(context.Context, internal.ExclusiveRoleSet) (string, error)
+	createExclusiveRoleSetMutex       sync.RWMutex
+	createExclusiveRoleSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ExclusiveRoleSet
+	}
+	createExclusiveRoleSetReturns struct {
+		result1 string
+		result2 error
+	}
+	createExclusiveRoleSetReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateGroupStub        func(context.Context, string) (string, error)
+	createGroupMutex       sync.RWMutex
+	createGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	createGroupReturns struct {
+		result1 string
+		result2 error
+	}
+	createGroupReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateHelpTextStub        func(context.Context, internal.HelpText) (string, error)
+	createHelpTextMutex       sync.RWMutex
+	createHelpTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.HelpText
+	}
+	createHelpTextReturns struct {
+		result1 string
+		result2 error
+	}
+	createHelpTextReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateMenuStub        func(context.Context, internal.Menu) (string, error)
+	createMenuMutex       sync.RWMutex
+	createMenuArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Menu
+	}
+	createMenuReturns struct {
+		result1 string
+		result2 error
+	}
+	createMenuReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateNavigationStub        func(context.Context, internal.Navigation) (string, error)
+	createNavigationMutex       sync.RWMutex
+	createNavigationArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Navigation
+	}
+	createNavigationReturns struct {
+		result1 string
+		result2 error
+	}
+	createNavigationReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreatePasswordResetTokenStub        func(context.Context, string, string, time.Time) (string, error)
+	createPasswordResetTokenMutex       sync.RWMutex
+	createPasswordResetTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}
+	createPasswordResetTokenReturns struct {
+		result1 string
+		result2 error
+	}
+	createPasswordResetTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateRefreshTokenStub        func(context.Context, string, string, time.Time) (string, error)
+	createRefreshTokenMutex       sync.RWMutex
+	createRefreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}
+	createRefreshTokenReturns struct {
+		result1 string
+		result2 error
+	}
+	createRefreshTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateRoleStub        func(context.Context, string) (string, error)
+	createRoleMutex       sync.RWMutex
+	createRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	createRoleReturns struct {
+		result1 string
+		result2 error
+	}
+	createRoleReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateRoleInheritanceStub        func(context.Context, string, string) (string, error)
+	createRoleInheritanceMutex       sync.RWMutex
+	createRoleInheritanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	createRoleInheritanceReturns struct {
+		result1 string
+		result2 error
+	}
+	createRoleInheritanceReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateRoleTasksStub        func(context.Context, string, string, internal.Scope, string, string) (string, error)
+	createRoleTasksMutex       sync.RWMutex
+	createRoleTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Scope
+		arg5 string
+		arg6 string
+	}
+	createRoleTasksReturns struct {
+		result1 string
+		result2 error
+	}
+	createRoleTasksReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateServiceAccountStub        func(context.Context, string) (internal.Account, error)
+	createServiceAccountMutex       sync.RWMutex
+	createServiceAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	createServiceAccountReturns struct {
+		result1 internal.Account
+		result2 error
+	}
+	createServiceAccountReturnsOnCall map[int]struct {
+		result1 internal.Account
+		result2 error
+	}
+	CreateTaskStub        func(context.Context, string) (string, error)
+	createTaskMutex       sync.RWMutex
+	createTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	createTaskReturns struct {
+		result1 string
+		result2 error
+	}
+	createTaskReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	CreateTenantStub        func(context.Context, string, string, internal.Policy, string) (string, []internal.PolicyChange, string, error)
+	createTenantMutex       sync.RWMutex
+	createTenantArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Policy
+		arg5 string
+	}
+	createTenantReturns struct {
+		result1 string
+		result2 []internal.PolicyChange
+		result3 string
+		result4 error
+	}
+	createTenantReturnsOnCall map[int]struct {
+		result1 string
+		result2 []internal.PolicyChange
+		result3 string
+		result4 error
+	}
+	DecideAccessRequestStub        func(context.Context, string, string, string, string) error
+	decideAccessRequestMutex       sync.RWMutex
+	decideAccessRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}
+	decideAccessRequestReturns struct {
+		result1 error
+	}
+	decideAccessRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteAccountStub        func(context.Context, string) error
+	deleteAccountMutex       sync.RWMutex
+	deleteAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteAccountReturns struct {
+		result1 error
+	}
+	deleteAccountReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteAccountRoleStub        func(context.Context, string) error
+	deleteAccountRoleMutex       sync.RWMutex
+	deleteAccountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteAccountRoleReturns struct {
+		result1 error
+	}
+	deleteAccountRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteExclusiveRoleSetStub        func(context.Context, string) error
+	deleteExclusiveRoleSetMutex       sync.RWMutex
+	deleteExclusiveRoleSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteExclusiveRoleSetReturns struct {
+		result1 error
+	}
+	deleteExclusiveRoleSetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteExpiredAccountRolesStub        func(context.Context, time.Time) ([]string, error)
+	deleteExpiredAccountRolesMutex       sync.RWMutex
+	deleteExpiredAccountRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	deleteExpiredAccountRolesReturns struct {
+		result1 []string
+		result2 error
+	}
+	deleteExpiredAccountRolesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	DeleteGroupStub        func(context.Context, string) ([]string, error)
+	deleteGroupMutex       sync.RWMutex
+	deleteGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteGroupReturns struct {
+		result1 []string
+		result2 error
+	}
+	deleteGroupReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	DeleteHelpTextStub        func(context.Context, string) error
+	deleteHelpTextMutex       sync.RWMutex
+	deleteHelpTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteHelpTextReturns struct {
+		result1 error
+	}
+	deleteHelpTextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteMFAStub        func(context.Context, string) error
+	deleteMFAMutex       sync.RWMutex
+	deleteMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteMFAReturns struct {
+		result1 error
+	}
+	deleteMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteMenuStub        func(context.Context, string) error
+	deleteMenuMutex       sync.RWMutex
+	deleteMenuArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteMenuReturns struct {
+		result1 error
+	}
+	deleteMenuReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteNavigationStub        func(context.Context, string) error
+	deleteNavigationMutex       sync.RWMutex
+	deleteNavigationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteNavigationReturns struct {
+		result1 error
+	}
+	deleteNavigationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRoleStub        func(context.Context, string) error
+	deleteRoleMutex       sync.RWMutex
+	deleteRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteRoleReturns struct {
+		result1 error
+	}
+	deleteRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRoleInheritanceStub        func(context.Context, string, string) error
+	deleteRoleInheritanceMutex       sync.RWMutex
+	deleteRoleInheritanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	deleteRoleInheritanceReturns struct {
+		result1 error
+	}
+	deleteRoleInheritanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRoleTaskStub        func(context.Context, string) error
+	deleteRoleTaskMutex       sync.RWMutex
+	deleteRoleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteRoleTaskReturns struct {
+		result1 error
+	}
+	deleteRoleTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteTaskStub        func(context.Context, string) error
+	deleteTaskMutex       sync.RWMutex
+	deleteTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteTaskReturns struct {
+		result1 error
+	}
+	deleteTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EmergencyRolesStub        func(context.Context) ([]internal.Roles, error)
+	emergencyRolesMutex       sync.RWMutex
+	emergencyRolesArgsForCall []struct {
+		arg1 context.Context
+	}
+	emergencyRolesReturns struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	emergencyRolesReturnsOnCall map[int]struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	EnrollMFAStub        func(context.Context, string, string) error
+	enrollMFAMutex       sync.RWMutex
+	enrollMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	enrollMFAReturns struct {
+		result1 error
+	}
+	enrollMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExclusiveRoleSetStub        func(context.Context, string) (internal.ExclusiveRoleSet, error)
+	exclusiveRoleSetMutex       sync.RWMutex
+	exclusiveRoleSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	exclusiveRoleSetReturns struct {
+		result1 internal.ExclusiveRoleSet
+		result2 error
+	}
+	exclusiveRoleSetReturnsOnCall map[int]struct {
+		result1 internal.ExclusiveRoleSet
+		result2 error
+	}
+	ExclusiveRoleSetsStub        func(context.Context) ([]internal.ExclusiveRoleSet, error)
+	exclusiveRoleSetsMutex       sync.RWMutex
+	exclusiveRoleSetsArgsForCall []struct {
+		arg1 context.Context
+	}
+	exclusiveRoleSetsReturns struct {
+		result1 []internal.ExclusiveRoleSet
+		result2 error
+	}
+	exclusiveRoleSetsReturnsOnCall map[int]struct {
+		result1 []internal.ExclusiveRoleSet
+		result2 error
+	}
+	ExportPolicyStub        func(context.Context) (internal.Policy, error)
+	exportPolicyMutex       sync.RWMutex
+	exportPolicyArgsForCall []struct {
+		arg1 context.Context
+	}
+	exportPolicyReturns struct {
+		result1 internal.Policy
+		result2 error
+	}
+	exportPolicyReturnsOnCall map[int]struct {
+		result1 internal.Policy
+		result2 error
+	}
+	GroupStub        func(context.Context, string) (internal.Group, error)
+	groupMutex       sync.RWMutex
+	groupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	groupReturns struct {
+		result1 internal.Group
+		result2 error
+	}
+	groupReturnsOnCall map[int]struct {
+		result1 internal.Group
+		result2 error
+	}
+	GroupMembersStub        func(context.Context, string) ([]internal.GroupMember, error)
+	groupMembersMutex       sync.RWMutex
+	groupMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	groupMembersReturns struct {
+		result1 []internal.GroupMember
+		result2 error
+	}
+	groupMembersReturnsOnCall map[int]struct {
+		result1 []internal.GroupMember
+		result2 error
+	}
+	GroupRoleIDsStub        func(context.Context, string) ([]string, error)
+	groupRoleIDsMutex       sync.RWMutex
+	groupRoleIDsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	groupRoleIDsReturns struct {
+		result1 []string
+		result2 error
+	}
+	groupRoleIDsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GroupRolesStub        func(context.Context, string) ([]internal.Roles, error)
+	groupRolesMutex       sync.RWMutex
+	groupRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	groupRolesReturns struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	groupRolesReturnsOnCall map[int]struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	GroupsStub        func(context.Context) ([]internal.Group, error)
+	groupsMutex       sync.RWMutex
+	groupsArgsForCall []struct {
+		arg1 context.Context
+	}
+	groupsReturns struct {
+		result1 []internal.Group
+		result2 error
+	}
+	groupsReturnsOnCall map[int]struct {
+		result1 []internal.Group
+		result2 error
+	}
+	HelpTextStub        func(context.Context, string) (internal.HelpText, error)
+	helpTextMutex       sync.RWMutex
+	helpTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	helpTextReturns struct {
+		result1 internal.HelpText
+		result2 error
+	}
+	helpTextReturnsOnCall map[int]struct {
+		result1 internal.HelpText
+		result2 error
+	}
+	InheritedRoleIDsStub        func(context.Context, string) ([]string, error)
+	inheritedRoleIDsMutex       sync.RWMutex
+	inheritedRoleIDsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	inheritedRoleIDsReturns struct {
+		result1 []string
+		result2 error
+	}
+	inheritedRoleIDsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	InheritedRoleIDsOfStub        func(context.Context, []string) ([]string, error)
+	inheritedRoleIDsOfMutex       sync.RWMutex
+	inheritedRoleIDsOfArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+	}
+	inheritedRoleIDsOfReturns struct {
+		result1 []string
+		result2 error
+	}
+	inheritedRoleIDsOfReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	InheritedRolesStub        func(context.Context, string) ([]internal.Roles, error)
+	inheritedRolesMutex       sync.RWMutex
+	inheritedRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	inheritedRolesReturns struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	inheritedRolesReturnsOnCall map[int]struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	IsEmergencyRoleStub        func(context.Context, string) (bool, error)
+	isEmergencyRoleMutex       sync.RWMutex
+	isEmergencyRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	isEmergencyRoleReturns struct {
+		result1 bool
+		result2 error
+	}
+	isEmergencyRoleReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	IsMFARequiredStub        func(context.Context, string) (bool, error)
+	isMFARequiredMutex       sync.RWMutex
+	isMFARequiredArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	isMFARequiredReturns struct {
+		result1 bool
+		result2 error
+	}
+	isMFARequiredReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	LoginStub        func(context.Context, string, string) error
+	loginMutex       sync.RWMutex
+	loginArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	loginReturns struct {
+		result1 error
+	}
+	loginReturnsOnCall map[int]struct {
+		result1 error
+	}
+	MFAStub        func(context.Context, string) (internal.MFA, error)
+	mFAMutex       sync.RWMutex
+	mFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	mFAReturns struct {
+		result1 internal.MFA
+		result2 error
+	}
+	mFAReturnsOnCall map[int]struct {
+		result1 internal.MFA
+		result2 error
+	}
+	MemberGroupsStub        func(context.Context, string) ([]internal.Group, error)
+	memberGroupsMutex       sync.RWMutex
+	memberGroupsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	memberGroupsReturns struct {
+		result1 []internal.Group
+		result2 error
+	}
+	memberGroupsReturnsOnCall map[int]struct {
+		result1 []internal.Group
+		result2 error
+	}
+	MenuStub        func(context.Context, string) (internal.Menu, error)
+	menuMutex       sync.RWMutex
+	menuArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	menuReturns struct {
+		result1 internal.Menu
+		result2 error
+	}
+	menuReturnsOnCall map[int]struct {
+		result1 internal.Menu
+		result2 error
+	}
+	NavigationStub        func(context.Context, string) (internal.Navigation, error)
+	navigationMutex       sync.RWMutex
+	navigationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	navigationReturns struct {
+		result1 internal.Navigation
+		result2 error
+	}
+	navigationReturnsOnCall map[int]struct {
+		result1 internal.Navigation
+		result2 error
+	}
+	RefreshTokenByHashStub        func(context.Context, string) (internal.RefreshToken, error)
+	refreshTokenByHashMutex       sync.RWMutex
+	refreshTokenByHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	refreshTokenByHashReturns struct {
+		result1 internal.RefreshToken
+		result2 error
+	}
+	refreshTokenByHashReturnsOnCall map[int]struct {
+		result1 internal.RefreshToken
+		result2 error
+	}
+	RemoveAccountTenantStub        func(context.Context, string, string) ([]string, error)
+	removeAccountTenantMutex       sync.RWMutex
+	removeAccountTenantArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	removeAccountTenantReturns struct {
+		result1 []string
+		result2 error
+	}
+	removeAccountTenantReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	RemoveGroupMemberStub        func(context.Context, string, string) (string, error)
+	removeGroupMemberMutex       sync.RWMutex
+	removeGroupMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	removeGroupMemberReturns struct {
+		result1 string
+		result2 error
+	}
+	removeGroupMemberReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	RemoveGroupRoleStub        func(context.Context, string, string) error
+	removeGroupRoleMutex       sync.RWMutex
+	removeGroupRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	removeGroupRoleReturns struct {
+		result1 error
+	}
+	removeGroupRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveMemberGroupStub        func(context.Context, string, string) error
+	removeMemberGroupMutex       sync.RWMutex
+	removeMemberGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	removeMemberGroupReturns struct {
+		result1 error
+	}
+	removeMemberGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveRoleApproverStub        func(context.Context, string, string) error
+	removeRoleApproverMutex       sync.RWMutex
+	removeRoleApproverArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	removeRoleApproverReturns struct {
+		result1 error
+	}
+	removeRoleApproverReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResetPasswordStub        func(context.Context, string, string) (string, error)
+	resetPasswordMutex       sync.RWMutex
+	resetPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	resetPasswordReturns struct {
+		result1 string
+		result2 error
+	}
+	resetPasswordReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	RestrictedGrantsStub        func(context.Context, string, []string) ([]internal.RestrictedGrant, error)
+	restrictedGrantsMutex       sync.RWMutex
+	restrictedGrantsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}
+	restrictedGrantsReturns struct {
+		result1 []internal.RestrictedGrant
+		result2 error
+	}
+	restrictedGrantsReturnsOnCall map[int]struct {
+		result1 []internal.RestrictedGrant
+		result2 error
+	}
+	RevokeAPIKeyStub        func(context.Context, string, string) error
+	revokeAPIKeyMutex       sync.RWMutex
+	revokeAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	revokeAPIKeyReturns struct {
+		result1 error
+	}
+	revokeAPIKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeAccountRefreshTokensStub        func(context.Context, string) error
+	revokeAccountRefreshTokensMutex       sync.RWMutex
+	revokeAccountRefreshTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	revokeAccountRefreshTokensReturns struct {
+		result1 error
+	}
+	revokeAccountRefreshTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeRefreshTokenFamilyStub        func(context.Context, string) error
+	revokeRefreshTokenFamilyMutex       sync.RWMutex
+	revokeRefreshTokenFamilyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	revokeRefreshTokenFamilyReturns struct {
+		result1 error
+	}
+	revokeRefreshTokenFamilyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RoleStub        func(context.Context, string) (internal.Roles, error)
+	roleMutex       sync.RWMutex
+	roleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	roleReturns struct {
+		result1 internal.Roles
+		result2 error
+	}
+	roleReturnsOnCall map[int]struct {
+		result1 internal.Roles
+		result2 error
+	}
+	RoleApproversStub        func(context.Context, string) ([]internal.Roles, error)
+	roleApproversMutex       sync.RWMutex
+	roleApproversArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	roleApproversReturns struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	roleApproversReturnsOnCall map[int]struct {
+		result1 []internal.Roles
+		result2 error
+	}
+	RoleConflictsStub        func(context.Context) ([]internal.RoleConflict, error)
+	roleConflictsMutex       sync.RWMutex
+	roleConflictsArgsForCall []struct {
+		arg1 context.Context
+	}
+	roleConflictsReturns struct {
+		result1 []internal.RoleConflict
+		result2 error
+	}
+	roleConflictsReturnsOnCall map[int]struct {
+		result1 []internal.RoleConflict
+		result2 error
+	}
+	RoleTaskStub        func(context.Context, string) (internal.RoleTasks, error)
+	roleTaskMutex       sync.RWMutex
+	roleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	roleTaskReturns struct {
+		result1 internal.RoleTasks
+		result2 error
+	}
+	roleTaskReturnsOnCall map[int]struct {
+		result1 internal.RoleTasks
+		result2 error
+	}
+	RotateRefreshTokenStub        func(context.Context, internal.RefreshToken, string, time.Time) (string, error)
+	rotateRefreshTokenMutex       sync.RWMutex
+	rotateRefreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.RefreshToken
+		arg3 string
+		arg4 time.Time
+	}
+	rotateRefreshTokenReturns struct {
+		result1 string
+		result2 error
+	}
+	rotateRefreshTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	SetActiveTenantStub        func(context.Context, string, string) error
+	setActiveTenantMutex       sync.RWMutex
+	setActiveTenantArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	setActiveTenantReturns struct {
+		result1 error
+	}
+	setActiveTenantReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetEmergencyRoleStub        func(context.Context, string, bool) error
+	setEmergencyRoleMutex       sync.RWMutex
+	setEmergencyRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	setEmergencyRoleReturns struct {
+		result1 error
+	}
+	setEmergencyRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetRoleMFARequiredStub        func(context.Context, string, bool) error
+	setRoleMFARequiredMutex       sync.RWMutex
+	setRoleMFARequiredArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	setRoleMFARequiredReturns struct {
+		result1 error
+	}
+	setRoleMFARequiredReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TaskStub        func(context.Context, string) (internal.Tasks, error)
+	taskMutex       sync.RWMutex
+	taskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	taskReturns struct {
+		result1 internal.Tasks
+		result2 error
+	}
+	taskReturnsOnCall map[int]struct {
+		result1 internal.Tasks
+		result2 error
+	}
+	TaskHoldersStub        func(context.Context, string) (internal.TaskHolders, error)
+	taskHoldersMutex       sync.RWMutex
+	taskHoldersArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	taskHoldersReturns struct {
+		result1 internal.TaskHolders
+		result2 error
+	}
+	taskHoldersReturnsOnCall map[int]struct {
+		result1 internal.TaskHolders
+		result2 error
+	}
+	TenantStub        func(context.Context, string) (internal.Tenant, error)
+	tenantMutex       sync.RWMutex
+	tenantArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	tenantReturns struct {
+		result1 internal.Tenant
+		result2 error
+	}
+	tenantReturnsOnCall map[int]struct {
+		result1 internal.Tenant
+		result2 error
+	}
+	TenantsStub        func(context.Context) ([]internal.Tenant, error)
+	tenantsMutex       sync.RWMutex
+	tenantsArgsForCall []struct {
+		arg1 context.Context
+	}
+	tenantsReturns struct {
+		result1 []internal.Tenant
+		result2 error
+	}
+	tenantsReturnsOnCall map[int]struct {
+		result1 []internal.Tenant
+		result2 error
+	}
+	TouchAPIKeyStub        func(context.Context, string) error
+	touchAPIKeyMutex       sync.RWMutex
+	touchAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	touchAPIKeyReturns struct {
+		result1 error
+	}
+	touchAPIKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnblockAccountStub        func(context.Context, string) error
+	unblockAccountMutex       sync.RWMutex
+	unblockAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	unblockAccountReturns struct {
+		result1 error
+	}
+	unblockAccountReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateAccountRoleStub        func(context.Context, string, string, string) error
+	updateAccountRoleMutex       sync.RWMutex
+	updateAccountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	updateAccountRoleReturns struct {
+		result1 error
+	}
+	updateAccountRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateExclusiveRoleSetStub        func(context.Context, internal.ExclusiveRoleSet) error
+	updateExclusiveRoleSetMutex       sync.RWMutex
+	updateExclusiveRoleSetArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ExclusiveRoleSet
+	}
+	updateExclusiveRoleSetReturns struct {
+		result1 error
+	}
+	updateExclusiveRoleSetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateGroupStub        func(context.Context, string, string) error
+	updateGroupMutex       sync.RWMutex
+	updateGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	updateGroupReturns struct {
+		result1 error
+	}
+	updateGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateHelpTextStub        func(context.Context, internal.HelpText) error
+	updateHelpTextMutex       sync.RWMutex
+	updateHelpTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.HelpText
+	}
+	updateHelpTextReturns struct {
+		result1 error
+	}
+	updateHelpTextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateMenuStub        func(context.Context, internal.Menu) error
+	updateMenuMutex       sync.RWMutex
+	updateMenuArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Menu
+	}
+	updateMenuReturns struct {
+		result1 error
+	}
+	updateMenuReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateNavigationStub        func(context.Context, internal.Navigation) error
+	updateNavigationMutex       sync.RWMutex
+	updateNavigationArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Navigation
+	}
+	updateNavigationReturns struct {
+		result1 error
+	}
+	updateNavigationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateProfileStub        func(context.Context, internal.Profile) error
+	updateProfileMutex       sync.RWMutex
+	updateProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}
+	updateProfileReturns struct {
+		result1 error
+	}
+	updateProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateRoleStub        func(context.Context, string, string) error
+	updateRoleMutex       sync.RWMutex
+	updateRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	updateRoleReturns struct {
+		result1 error
+	}
+	updateRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateRoleTaskStub        func(context.Context, string, string, string) error
+	updateRoleTaskMutex       sync.RWMutex
+	updateRoleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	updateRoleTaskReturns struct {
+		result1 error
+	}
+	updateRoleTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateTaskStub        func(context.Context, string, string) error
+	updateTaskMutex       sync.RWMutex
+	updateTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	updateTaskReturns struct {
+		result1 error
+	}
+	updateTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UseMFARecoveryCodeStub        func(context.Context, string, string) error
+	useMFARecoveryCodeMutex       sync.RWMutex
+	useMFARecoveryCodeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	useMFARecoveryCodeReturns struct {
+		result1 error
+	}
+	useMFARecoveryCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UseMFAStepStub        func(context.Context, string, int64) error
+	useMFAStepMutex       sync.RWMutex
+	useMFAStepArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int64
+	}
+	useMFAStepReturns struct {
+		result1 error
+	}
+	useMFAStepReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRBACRepository) APIKeyByHash(arg1 context.Context, arg2 string) (internal.APIKey, error) {
+	fake.aPIKeyByHashMutex.Lock()
+	ret, specificReturn := fake.aPIKeyByHashReturnsOnCall[len(fake.aPIKeyByHashArgsForCall)]
+	fake.aPIKeyByHashArgsForCall = append(fake.aPIKeyByHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.APIKeyByHashStub
+	fakeReturns := fake.aPIKeyByHashReturns
+	fake.recordInvocation("APIKeyByHash", []interface{}{arg1, arg2})
+	fake.aPIKeyByHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) APIKeyByHashCallCount() int {
+	fake.aPIKeyByHashMutex.RLock()
+	defer fake.aPIKeyByHashMutex.RUnlock()
+	return len(fake.aPIKeyByHashArgsForCall)
+}
+
+func (fake *FakeRBACRepository) APIKeyByHashCalls(stub func(context.Context, string) (internal.APIKey, error)) {
+	fake.aPIKeyByHashMutex.Lock()
+	defer fake.aPIKeyByHashMutex.Unlock()
+	fake.APIKeyByHashStub = stub
+}
+
+func (fake *FakeRBACRepository) APIKeyByHashArgsForCall(i int) (context.Context, string) {
+	fake.aPIKeyByHashMutex.RLock()
+	defer fake.aPIKeyByHashMutex.RUnlock()
+	argsForCall := fake.aPIKeyByHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) APIKeyByHashReturns(result1 internal.APIKey, result2 error) {
+	fake.aPIKeyByHashMutex.Lock()
+	defer fake.aPIKeyByHashMutex.Unlock()
+	fake.APIKeyByHashStub = nil
+	fake.aPIKeyByHashReturns = struct {
+		result1 internal.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) APIKeyByHashReturnsOnCall(i int, result1 internal.APIKey, result2 error) {
+	fake.aPIKeyByHashMutex.Lock()
+	defer fake.aPIKeyByHashMutex.Unlock()
+	fake.APIKeyByHashStub = nil
+	if fake.aPIKeyByHashReturnsOnCall == nil {
+		fake.aPIKeyByHashReturnsOnCall = make(map[int]struct {
+			result1 internal.APIKey
+			result2 error
+		})
+	}
+	fake.aPIKeyByHashReturnsOnCall[i] = struct {
+		result1 internal.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) APIKeysByAccount(arg1 context.Context, arg2 string) ([]internal.APIKey, error) {
+	fake.aPIKeysByAccountMutex.Lock()
+	ret, specificReturn := fake.aPIKeysByAccountReturnsOnCall[len(fake.aPIKeysByAccountArgsForCall)]
+	fake.aPIKeysByAccountArgsForCall = append(fake.aPIKeysByAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.APIKeysByAccountStub
+	fakeReturns := fake.aPIKeysByAccountReturns
+	fake.recordInvocation("APIKeysByAccount", []interface{}{arg1, arg2})
+	fake.aPIKeysByAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) APIKeysByAccountCallCount() int {
+	fake.aPIKeysByAccountMutex.RLock()
+	defer fake.aPIKeysByAccountMutex.RUnlock()
+	return len(fake.aPIKeysByAccountArgsForCall)
+}
+
+func (fake *FakeRBACRepository) APIKeysByAccountCalls(stub func(context.Context, string) ([]internal.APIKey, error)) {
+	fake.aPIKeysByAccountMutex.Lock()
+	defer fake.aPIKeysByAccountMutex.Unlock()
+	fake.APIKeysByAccountStub = stub
+}
+
+func (fake *FakeRBACRepository) APIKeysByAccountArgsForCall(i int) (context.Context, string) {
+	fake.aPIKeysByAccountMutex.RLock()
+	defer fake.aPIKeysByAccountMutex.RUnlock()
+	argsForCall := fake.aPIKeysByAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) APIKeysByAccountReturns(result1 []internal.APIKey, result2 error) {
+	fake.aPIKeysByAccountMutex.Lock()
+	defer fake.aPIKeysByAccountMutex.Unlock()
+	fake.APIKeysByAccountStub = nil
+	fake.aPIKeysByAccountReturns = struct {
+		result1 []internal.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) APIKeysByAccountReturnsOnCall(i int, result1 []internal.APIKey, result2 error) {
+	fake.aPIKeysByAccountMutex.Lock()
+	defer fake.aPIKeysByAccountMutex.Unlock()
+	fake.APIKeysByAccountStub = nil
+	if fake.aPIKeysByAccountReturnsOnCall == nil {
+		fake.aPIKeysByAccountReturnsOnCall = make(map[int]struct {
+			result1 []internal.APIKey
+			result2 error
+		})
+	}
+	fake.aPIKeysByAccountReturnsOnCall[i] = struct {
+		result1 []internal.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccessRequest(arg1 context.Context, arg2 string) (internal.AccessRequest, error) {
+	fake.accessRequestMutex.Lock()
+	ret, specificReturn := fake.accessRequestReturnsOnCall[len(fake.accessRequestArgsForCall)]
+	fake.accessRequestArgsForCall = append(fake.accessRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccessRequestStub
+	fakeReturns := fake.accessRequestReturns
+	fake.recordInvocation("AccessRequest", []interface{}{arg1, arg2})
+	fake.accessRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AccessRequestCallCount() int {
+	fake.accessRequestMutex.RLock()
+	defer fake.accessRequestMutex.RUnlock()
+	return len(fake.accessRequestArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AccessRequestCalls(stub func(context.Context, string) (internal.AccessRequest, error)) {
+	fake.accessRequestMutex.Lock()
+	defer fake.accessRequestMutex.Unlock()
+	fake.AccessRequestStub = stub
+}
+
+func (fake *FakeRBACRepository) AccessRequestArgsForCall(i int) (context.Context, string) {
+	fake.accessRequestMutex.RLock()
+	defer fake.accessRequestMutex.RUnlock()
+	argsForCall := fake.accessRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) AccessRequestReturns(result1 internal.AccessRequest, result2 error) {
+	fake.accessRequestMutex.Lock()
+	defer fake.accessRequestMutex.Unlock()
+	fake.AccessRequestStub = nil
+	fake.accessRequestReturns = struct {
+		result1 internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccessRequestReturnsOnCall(i int, result1 internal.AccessRequest, result2 error) {
+	fake.accessRequestMutex.Lock()
+	defer fake.accessRequestMutex.Unlock()
+	fake.AccessRequestStub = nil
+	if fake.accessRequestReturnsOnCall == nil {
+		fake.accessRequestReturnsOnCall = make(map[int]struct {
+			result1 internal.AccessRequest
+			result2 error
+		})
+	}
+	fake.accessRequestReturnsOnCall[i] = struct {
+		result1 internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccessRequests(arg1 context.Context) ([]internal.AccessRequest, error) {
+	fake.accessRequestsMutex.Lock()
+	ret, specificReturn := fake.accessRequestsReturnsOnCall[len(fake.accessRequestsArgsForCall)]
+	fake.accessRequestsArgsForCall = append(fake.accessRequestsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.AccessRequestsStub
+	fakeReturns := fake.accessRequestsReturns
+	fake.recordInvocation("AccessRequests", []interface{}{arg1})
+	fake.accessRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AccessRequestsCallCount() int {
+	fake.accessRequestsMutex.RLock()
+	defer fake.accessRequestsMutex.RUnlock()
+	return len(fake.accessRequestsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AccessRequestsCalls(stub func(context.Context) ([]internal.AccessRequest, error)) {
+	fake.accessRequestsMutex.Lock()
+	defer fake.accessRequestsMutex.Unlock()
+	fake.AccessRequestsStub = stub
+}
+
+func (fake *FakeRBACRepository) AccessRequestsArgsForCall(i int) context.Context {
+	fake.accessRequestsMutex.RLock()
+	defer fake.accessRequestsMutex.RUnlock()
+	argsForCall := fake.accessRequestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACRepository) AccessRequestsReturns(result1 []internal.AccessRequest, result2 error) {
+	fake.accessRequestsMutex.Lock()
+	defer fake.accessRequestsMutex.Unlock()
+	fake.AccessRequestsStub = nil
+	fake.accessRequestsReturns = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccessRequestsReturnsOnCall(i int, result1 []internal.AccessRequest, result2 error) {
+	fake.accessRequestsMutex.Lock()
+	defer fake.accessRequestsMutex.Unlock()
+	fake.AccessRequestsStub = nil
+	if fake.accessRequestsReturnsOnCall == nil {
+		fake.accessRequestsReturnsOnCall = make(map[int]struct {
+			result1 []internal.AccessRequest
+			result2 error
+		})
+	}
+	fake.accessRequestsReturnsOnCall[i] = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) Account(arg1 context.Context, arg2 string) (internal.Account, error) {
+	fake.accountMutex.Lock()
+	ret, specificReturn := fake.accountReturnsOnCall[len(fake.accountArgsForCall)]
+	fake.accountArgsForCall = append(fake.accountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountStub
+	fakeReturns := fake.accountReturns
+	fake.recordInvocation("Account", []interface{}{arg1, arg2})
+	fake.accountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AccountCallCount() int {
+	fake.accountMutex.RLock()
+	defer fake.accountMutex.RUnlock()
+	return len(fake.accountArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AccountCalls(stub func(context.Context, string) (internal.Account, error)) {
+	fake.accountMutex.Lock()
+	defer fake.accountMutex.Unlock()
+	fake.AccountStub = stub
+}
+
+func (fake *FakeRBACRepository) AccountArgsForCall(i int) (context.Context, string) {
+	fake.accountMutex.RLock()
+	defer fake.accountMutex.RUnlock()
+	argsForCall := fake.accountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) AccountReturns(result1 internal.Account, result2 error) {
+	fake.accountMutex.Lock()
+	defer fake.accountMutex.Unlock()
+	fake.AccountStub = nil
+	fake.accountReturns = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountReturnsOnCall(i int, result1 internal.Account, result2 error) {
+	fake.accountMutex.Lock()
+	defer fake.accountMutex.Unlock()
+	fake.AccountStub = nil
+	if fake.accountReturnsOnCall == nil {
+		fake.accountReturnsOnCall = make(map[int]struct {
+			result1 internal.Account
+			result2 error
+		})
+	}
+	fake.accountReturnsOnCall[i] = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountAccessRequests(arg1 context.Context, arg2 string) ([]internal.AccessRequest, error) {
+	fake.accountAccessRequestsMutex.Lock()
+	ret, specificReturn := fake.accountAccessRequestsReturnsOnCall[len(fake.accountAccessRequestsArgsForCall)]
+	fake.accountAccessRequestsArgsForCall = append(fake.accountAccessRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountAccessRequestsStub
+	fakeReturns := fake.accountAccessRequestsReturns
+	fake.recordInvocation("AccountAccessRequests", []interface{}{arg1, arg2})
+	fake.accountAccessRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AccountAccessRequestsCallCount() int {
+	fake.accountAccessRequestsMutex.RLock()
+	defer fake.accountAccessRequestsMutex.RUnlock()
+	return len(fake.accountAccessRequestsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AccountAccessRequestsCalls(stub func(context.Context, string) ([]internal.AccessRequest, error)) {
+	fake.accountAccessRequestsMutex.Lock()
+	defer fake.accountAccessRequestsMutex.Unlock()
+	fake.AccountAccessRequestsStub = stub
+}
+
+func (fake *FakeRBACRepository) AccountAccessRequestsArgsForCall(i int) (context.Context, string) {
+	fake.accountAccessRequestsMutex.RLock()
+	defer fake.accountAccessRequestsMutex.RUnlock()
+	argsForCall := fake.accountAccessRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) AccountAccessRequestsReturns(result1 []internal.AccessRequest, result2 error) {
+	fake.accountAccessRequestsMutex.Lock()
+	defer fake.accountAccessRequestsMutex.Unlock()
+	fake.AccountAccessRequestsStub = nil
+	fake.accountAccessRequestsReturns = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountAccessRequestsReturnsOnCall(i int, result1 []internal.AccessRequest, result2 error) {
+	fake.accountAccessRequestsMutex.Lock()
+	defer fake.accountAccessRequestsMutex.Unlock()
+	fake.AccountAccessRequestsStub = nil
+	if fake.accountAccessRequestsReturnsOnCall == nil {
+		fake.accountAccessRequestsReturnsOnCall = make(map[int]struct {
+			result1 []internal.AccessRequest
+			result2 error
+		})
+	}
+	fake.accountAccessRequestsReturnsOnCall[i] = struct {
+		result1 []internal.AccessRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountByID(arg1 context.Context, arg2 string) (internal.Account, error) {
+	fake.accountByIDMutex.Lock()
+	ret, specificReturn := fake.accountByIDReturnsOnCall[len(fake.accountByIDArgsForCall)]
+	fake.accountByIDArgsForCall = append(fake.accountByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountByIDStub
+	fakeReturns := fake.accountByIDReturns
+	fake.recordInvocation("AccountByID", []interface{}{arg1, arg2})
+	fake.accountByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AccountByIDCallCount() int {
+	fake.accountByIDMutex.RLock()
+	defer fake.accountByIDMutex.RUnlock()
+	return len(fake.accountByIDArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AccountByIDCalls(stub func(context.Context, string) (internal.Account, error)) {
+	fake.accountByIDMutex.Lock()
+	defer fake.accountByIDMutex.Unlock()
+	fake.AccountByIDStub = stub
+}
+
+func (fake *FakeRBACRepository) AccountByIDArgsForCall(i int) (context.Context, string) {
+	fake.accountByIDMutex.RLock()
+	defer fake.accountByIDMutex.RUnlock()
+	argsForCall := fake.accountByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) AccountByIDReturns(result1 internal.Account, result2 error) {
+	fake.accountByIDMutex.Lock()
+	defer fake.accountByIDMutex.Unlock()
+	fake.AccountByIDStub = nil
+	fake.accountByIDReturns = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountByIDReturnsOnCall(i int, result1 internal.Account, result2 error) {
+	fake.accountByIDMutex.Lock()
+	defer fake.accountByIDMutex.Unlock()
+	fake.AccountByIDStub = nil
+	if fake.accountByIDReturnsOnCall == nil {
+		fake.accountByIDReturnsOnCall = make(map[int]struct {
+			result1 internal.Account
+			result2 error
+		})
+	}
+	fake.accountByIDReturnsOnCall[i] = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountRole(arg1 context.Context, arg2 string) (internal.AccountRoles, error) {
+	fake.accountRoleMutex.Lock()
+	ret, specificReturn := fake.accountRoleReturnsOnCall[len(fake.accountRoleArgsForCall)]
+	fake.accountRoleArgsForCall = append(fake.accountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountRoleStub
+	fakeReturns := fake.accountRoleReturns
+	fake.recordInvocation("AccountRole", []interface{}{arg1, arg2})
+	fake.accountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AccountRoleCallCount() int {
+	fake.accountRoleMutex.RLock()
+	defer fake.accountRoleMutex.RUnlock()
+	return len(fake.accountRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AccountRoleCalls(stub func(context.Context, string) (internal.AccountRoles, error)) {
+	fake.accountRoleMutex.Lock()
+	defer fake.accountRoleMutex.Unlock()
+	fake.AccountRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) AccountRoleArgsForCall(i int) (context.Context, string) {
+	fake.accountRoleMutex.RLock()
+	defer fake.accountRoleMutex.RUnlock()
+	argsForCall := fake.accountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) AccountRoleReturns(result1 internal.AccountRoles, result2 error) {
+	fake.accountRoleMutex.Lock()
+	defer fake.accountRoleMutex.Unlock()
+	fake.AccountRoleStub = nil
+	fake.accountRoleReturns = struct {
+		result1 internal.AccountRoles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountRoleReturnsOnCall(i int, result1 internal.AccountRoles, result2 error) {
+	fake.accountRoleMutex.Lock()
+	defer fake.accountRoleMutex.Unlock()
+	fake.AccountRoleStub = nil
+	if fake.accountRoleReturnsOnCall == nil {
+		fake.accountRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.AccountRoles
+			result2 error
+		})
+	}
+	fake.accountRoleReturnsOnCall[i] = struct {
+		result1 internal.AccountRoles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountRolesByAccount(arg1 context.Context, arg2 string) ([]internal.AccountRoles, error) {
+	fake.accountRolesByAccountMutex.Lock()
+	ret, specificReturn := fake.accountRolesByAccountReturnsOnCall[len(fake.accountRolesByAccountArgsForCall)]
+	fake.accountRolesByAccountArgsForCall = append(fake.accountRolesByAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountRolesByAccountStub
+	fakeReturns := fake.accountRolesByAccountReturns
+	fake.recordInvocation("AccountRolesByAccount", []interface{}{arg1, arg2})
+	fake.accountRolesByAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AccountRolesByAccountCallCount() int {
+	fake.accountRolesByAccountMutex.RLock()
+	defer fake.accountRolesByAccountMutex.RUnlock()
+	return len(fake.accountRolesByAccountArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AccountRolesByAccountCalls(stub func(context.Context, string) ([]internal.AccountRoles, error)) {
+	fake.accountRolesByAccountMutex.Lock()
+	defer fake.accountRolesByAccountMutex.Unlock()
+	fake.AccountRolesByAccountStub = stub
+}
+
+func (fake *FakeRBACRepository) AccountRolesByAccountArgsForCall(i int) (context.Context, string) {
+	fake.accountRolesByAccountMutex.RLock()
+	defer fake.accountRolesByAccountMutex.RUnlock()
+	argsForCall := fake.accountRolesByAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) AccountRolesByAccountReturns(result1 []internal.AccountRoles, result2 error) {
+	fake.accountRolesByAccountMutex.Lock()
+	defer fake.accountRolesByAccountMutex.Unlock()
+	fake.AccountRolesByAccountStub = nil
+	fake.accountRolesByAccountReturns = struct {
+		result1 []internal.AccountRoles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountRolesByAccountReturnsOnCall(i int, result1 []internal.AccountRoles, result2 error) {
+	fake.accountRolesByAccountMutex.Lock()
+	defer fake.accountRolesByAccountMutex.Unlock()
+	fake.AccountRolesByAccountStub = nil
+	if fake.accountRolesByAccountReturnsOnCall == nil {
+		fake.accountRolesByAccountReturnsOnCall = make(map[int]struct {
+			result1 []internal.AccountRoles
+			result2 error
+		})
+	}
+	fake.accountRolesByAccountReturnsOnCall[i] = struct {
+		result1 []internal.AccountRoles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountTenants(arg1 context.Context, arg2 string) ([]internal.Tenant, error) {
+	fake.accountTenantsMutex.Lock()
+	ret, specificReturn := fake.accountTenantsReturnsOnCall[len(fake.accountTenantsArgsForCall)]
+	fake.accountTenantsArgsForCall = append(fake.accountTenantsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AccountTenantsStub
+	fakeReturns := fake.accountTenantsReturns
+	fake.recordInvocation("AccountTenants", []interface{}{arg1, arg2})
+	fake.accountTenantsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AccountTenantsCallCount() int {
+	fake.accountTenantsMutex.RLock()
+	defer fake.accountTenantsMutex.RUnlock()
+	return len(fake.accountTenantsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AccountTenantsCalls(stub func(context.Context, string) ([]internal.Tenant, error)) {
+	fake.accountTenantsMutex.Lock()
+	defer fake.accountTenantsMutex.Unlock()
+	fake.AccountTenantsStub = stub
+}
+
+func (fake *FakeRBACRepository) AccountTenantsArgsForCall(i int) (context.Context, string) {
+	fake.accountTenantsMutex.RLock()
+	defer fake.accountTenantsMutex.RUnlock()
+	argsForCall := fake.accountTenantsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) AccountTenantsReturns(result1 []internal.Tenant, result2 error) {
+	fake.accountTenantsMutex.Lock()
+	defer fake.accountTenantsMutex.Unlock()
+	fake.AccountTenantsStub = nil
+	fake.accountTenantsReturns = struct {
+		result1 []internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AccountTenantsReturnsOnCall(i int, result1 []internal.Tenant, result2 error) {
+	fake.accountTenantsMutex.Lock()
+	defer fake.accountTenantsMutex.Unlock()
+	fake.AccountTenantsStub = nil
+	if fake.accountTenantsReturnsOnCall == nil {
+		fake.accountTenantsReturnsOnCall = make(map[int]struct {
+			result1 []internal.Tenant
+			result2 error
+		})
+	}
+	fake.accountTenantsReturnsOnCall[i] = struct {
+		result1 []internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AddAccountTenant(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.addAccountTenantMutex.Lock()
+	ret, specificReturn := fake.addAccountTenantReturnsOnCall[len(fake.addAccountTenantArgsForCall)]
+	fake.addAccountTenantArgsForCall = append(fake.addAccountTenantArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddAccountTenantStub
+	fakeReturns := fake.addAccountTenantReturns
+	fake.recordInvocation("AddAccountTenant", []interface{}{arg1, arg2, arg3})
+	fake.addAccountTenantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) AddAccountTenantCallCount() int {
+	fake.addAccountTenantMutex.RLock()
+	defer fake.addAccountTenantMutex.RUnlock()
+	return len(fake.addAccountTenantArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AddAccountTenantCalls(stub func(context.Context, string, string) error) {
+	fake.addAccountTenantMutex.Lock()
+	defer fake.addAccountTenantMutex.Unlock()
+	fake.AddAccountTenantStub = stub
+}
+
+func (fake *FakeRBACRepository) AddAccountTenantArgsForCall(i int) (context.Context, string, string) {
+	fake.addAccountTenantMutex.RLock()
+	defer fake.addAccountTenantMutex.RUnlock()
+	argsForCall := fake.addAccountTenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) AddAccountTenantReturns(result1 error) {
+	fake.addAccountTenantMutex.Lock()
+	defer fake.addAccountTenantMutex.Unlock()
+	fake.AddAccountTenantStub = nil
+	fake.addAccountTenantReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) AddAccountTenantReturnsOnCall(i int, result1 error) {
+	fake.addAccountTenantMutex.Lock()
+	defer fake.addAccountTenantMutex.Unlock()
+	fake.AddAccountTenantStub = nil
+	if fake.addAccountTenantReturnsOnCall == nil {
+		fake.addAccountTenantReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addAccountTenantReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) AddGroupMember(arg1 context.Context, arg2 string, arg3 string) (internal.GroupMember, error) {
+	fake.addGroupMemberMutex.Lock()
+	ret, specificReturn := fake.addGroupMemberReturnsOnCall[len(fake.addGroupMemberArgsForCall)]
+	fake.addGroupMemberArgsForCall = append(fake.addGroupMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddGroupMemberStub
+	fakeReturns := fake.addGroupMemberReturns
+	fake.recordInvocation("AddGroupMember", []interface{}{arg1, arg2, arg3})
+	fake.addGroupMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AddGroupMemberCallCount() int {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return len(fake.addGroupMemberArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AddGroupMemberCalls(stub func(context.Context, string, string) (internal.GroupMember, error)) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = stub
+}
+
+func (fake *FakeRBACRepository) AddGroupMemberArgsForCall(i int) (context.Context, string, string) {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	argsForCall := fake.addGroupMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) AddGroupMemberReturns(result1 internal.GroupMember, result2 error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = nil
+	fake.addGroupMemberReturns = struct {
+		result1 internal.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AddGroupMemberReturnsOnCall(i int, result1 internal.GroupMember, result2 error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = nil
+	if fake.addGroupMemberReturnsOnCall == nil {
+		fake.addGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 internal.GroupMember
+			result2 error
+		})
+	}
+	fake.addGroupMemberReturnsOnCall[i] = struct {
+		result1 internal.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AddGroupRole(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.addGroupRoleMutex.Lock()
+	ret, specificReturn := fake.addGroupRoleReturnsOnCall[len(fake.addGroupRoleArgsForCall)]
+	fake.addGroupRoleArgsForCall = append(fake.addGroupRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddGroupRoleStub
+	fakeReturns := fake.addGroupRoleReturns
+	fake.recordInvocation("AddGroupRole", []interface{}{arg1, arg2, arg3})
+	fake.addGroupRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AddGroupRoleCallCount() int {
+	fake.addGroupRoleMutex.RLock()
+	defer fake.addGroupRoleMutex.RUnlock()
+	return len(fake.addGroupRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AddGroupRoleCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.addGroupRoleMutex.Lock()
+	defer fake.addGroupRoleMutex.Unlock()
+	fake.AddGroupRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) AddGroupRoleArgsForCall(i int) (context.Context, string, string) {
+	fake.addGroupRoleMutex.RLock()
+	defer fake.addGroupRoleMutex.RUnlock()
+	argsForCall := fake.addGroupRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) AddGroupRoleReturns(result1 string, result2 error) {
+	fake.addGroupRoleMutex.Lock()
+	defer fake.addGroupRoleMutex.Unlock()
+	fake.AddGroupRoleStub = nil
+	fake.addGroupRoleReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AddGroupRoleReturnsOnCall(i int, result1 string, result2 error) {
+	fake.addGroupRoleMutex.Lock()
+	defer fake.addGroupRoleMutex.Unlock()
+	fake.AddGroupRoleStub = nil
+	if fake.addGroupRoleReturnsOnCall == nil {
+		fake.addGroupRoleReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.addGroupRoleReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AddMemberGroup(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.addMemberGroupMutex.Lock()
+	ret, specificReturn := fake.addMemberGroupReturnsOnCall[len(fake.addMemberGroupArgsForCall)]
+	fake.addMemberGroupArgsForCall = append(fake.addMemberGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddMemberGroupStub
+	fakeReturns := fake.addMemberGroupReturns
+	fake.recordInvocation("AddMemberGroup", []interface{}{arg1, arg2, arg3})
+	fake.addMemberGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AddMemberGroupCallCount() int {
+	fake.addMemberGroupMutex.RLock()
+	defer fake.addMemberGroupMutex.RUnlock()
+	return len(fake.addMemberGroupArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AddMemberGroupCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.addMemberGroupMutex.Lock()
+	defer fake.addMemberGroupMutex.Unlock()
+	fake.AddMemberGroupStub = stub
+}
+
+func (fake *FakeRBACRepository) AddMemberGroupArgsForCall(i int) (context.Context, string, string) {
+	fake.addMemberGroupMutex.RLock()
+	defer fake.addMemberGroupMutex.RUnlock()
+	argsForCall := fake.addMemberGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) AddMemberGroupReturns(result1 string, result2 error) {
+	fake.addMemberGroupMutex.Lock()
+	defer fake.addMemberGroupMutex.Unlock()
+	fake.AddMemberGroupStub = nil
+	fake.addMemberGroupReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AddMemberGroupReturnsOnCall(i int, result1 string, result2 error) {
+	fake.addMemberGroupMutex.Lock()
+	defer fake.addMemberGroupMutex.Unlock()
+	fake.AddMemberGroupStub = nil
+	if fake.addMemberGroupReturnsOnCall == nil {
+		fake.addMemberGroupReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.addMemberGroupReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AddRoleApprover(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.addRoleApproverMutex.Lock()
+	ret, specificReturn := fake.addRoleApproverReturnsOnCall[len(fake.addRoleApproverArgsForCall)]
+	fake.addRoleApproverArgsForCall = append(fake.addRoleApproverArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AddRoleApproverStub
+	fakeReturns := fake.addRoleApproverReturns
+	fake.recordInvocation("AddRoleApprover", []interface{}{arg1, arg2, arg3})
+	fake.addRoleApproverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) AddRoleApproverCallCount() int {
+	fake.addRoleApproverMutex.RLock()
+	defer fake.addRoleApproverMutex.RUnlock()
+	return len(fake.addRoleApproverArgsForCall)
+}
+
+func (fake *FakeRBACRepository) AddRoleApproverCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.addRoleApproverMutex.Lock()
+	defer fake.addRoleApproverMutex.Unlock()
+	fake.AddRoleApproverStub = stub
+}
+
+func (fake *FakeRBACRepository) AddRoleApproverArgsForCall(i int) (context.Context, string, string) {
+	fake.addRoleApproverMutex.RLock()
+	defer fake.addRoleApproverMutex.RUnlock()
+	argsForCall := fake.addRoleApproverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) AddRoleApproverReturns(result1 string, result2 error) {
+	fake.addRoleApproverMutex.Lock()
+	defer fake.addRoleApproverMutex.Unlock()
+	fake.AddRoleApproverStub = nil
+	fake.addRoleApproverReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) AddRoleApproverReturnsOnCall(i int, result1 string, result2 error) {
+	fake.addRoleApproverMutex.Lock()
+	defer fake.addRoleApproverMutex.Unlock()
+	fake.AddRoleApproverStub = nil
+	if fake.addRoleApproverReturnsOnCall == nil {
+		fake.addRoleApproverReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.addRoleApproverReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ApplyPolicy(arg1 context.Context, arg2 internal.Policy, arg3 internal.PolicyOptions) ([]internal.PolicyChange, error) {
+	fake.applyPolicyMutex.Lock()
+	ret, specificReturn := fake.applyPolicyReturnsOnCall[len(fake.applyPolicyArgsForCall)]
+	fake.applyPolicyArgsForCall = append(fake.applyPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Policy
+		arg3 internal.PolicyOptions
+	}{arg1, arg2, arg3})
+	stub := fake.ApplyPolicyStub
+	fakeReturns := fake.applyPolicyReturns
+	fake.recordInvocation("ApplyPolicy", []interface{}{arg1, arg2, arg3})
+	fake.applyPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) ApplyPolicyCallCount() int {
+	fake.applyPolicyMutex.RLock()
+	defer fake.applyPolicyMutex.RUnlock()
+	return len(fake.applyPolicyArgsForCall)
+}
+
+func (fake *FakeRBACRepository) ApplyPolicyCalls(stub func(context.Context, internal.Policy, internal.PolicyOptions) ([]internal.PolicyChange, error)) {
+	fake.applyPolicyMutex.Lock()
+	defer fake.applyPolicyMutex.Unlock()
+	fake.ApplyPolicyStub = stub
+}
+
+func (fake *FakeRBACRepository) ApplyPolicyArgsForCall(i int) (context.Context, internal.Policy, internal.PolicyOptions) {
+	fake.applyPolicyMutex.RLock()
+	defer fake.applyPolicyMutex.RUnlock()
+	argsForCall := fake.applyPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) ApplyPolicyReturns(result1 []internal.PolicyChange, result2 error) {
+	fake.applyPolicyMutex.Lock()
+	defer fake.applyPolicyMutex.Unlock()
+	fake.ApplyPolicyStub = nil
+	fake.applyPolicyReturns = struct {
+		result1 []internal.PolicyChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ApplyPolicyReturnsOnCall(i int, result1 []internal.PolicyChange, result2 error) {
+	fake.applyPolicyMutex.Lock()
+	defer fake.applyPolicyMutex.Unlock()
+	fake.ApplyPolicyStub = nil
+	if fake.applyPolicyReturnsOnCall == nil {
+		fake.applyPolicyReturnsOnCall = make(map[int]struct {
+			result1 []internal.PolicyChange
+			result2 error
+		})
+	}
+	fake.applyPolicyReturnsOnCall[i] = struct {
+		result1 []internal.PolicyChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ApproveAccessRequest(arg1 context.Context, arg2 string, arg3 string, arg4 string) (string, error) {
+	fake.approveAccessRequestMutex.Lock()
+	ret, specificReturn := fake.approveAccessRequestReturnsOnCall[len(fake.approveAccessRequestArgsForCall)]
+	fake.approveAccessRequestArgsForCall = append(fake.approveAccessRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ApproveAccessRequestStub
+	fakeReturns := fake.approveAccessRequestReturns
+	fake.recordInvocation("ApproveAccessRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.approveAccessRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) ApproveAccessRequestCallCount() int {
+	fake.approveAccessRequestMutex.RLock()
+	defer fake.approveAccessRequestMutex.RUnlock()
+	return len(fake.approveAccessRequestArgsForCall)
+}
+
+func (fake *FakeRBACRepository) ApproveAccessRequestCalls(stub func(context.Context, string, string, string) (string, error)) {
+	fake.approveAccessRequestMutex.Lock()
+	defer fake.approveAccessRequestMutex.Unlock()
+	fake.ApproveAccessRequestStub = stub
+}
+
+func (fake *FakeRBACRepository) ApproveAccessRequestArgsForCall(i int) (context.Context, string, string, string) {
+	fake.approveAccessRequestMutex.RLock()
+	defer fake.approveAccessRequestMutex.RUnlock()
+	argsForCall := fake.approveAccessRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACRepository) ApproveAccessRequestReturns(result1 string, result2 error) {
+	fake.approveAccessRequestMutex.Lock()
+	defer fake.approveAccessRequestMutex.Unlock()
+	fake.ApproveAccessRequestStub = nil
+	fake.approveAccessRequestReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ApproveAccessRequestReturnsOnCall(i int, result1 string, result2 error) {
+	fake.approveAccessRequestMutex.Lock()
+	defer fake.approveAccessRequestMutex.Unlock()
+	fake.ApproveAccessRequestStub = nil
+	if fake.approveAccessRequestReturnsOnCall == nil {
+		fake.approveAccessRequestReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.approveAccessRequestReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) BlockAccount(arg1 context.Context, arg2 string) error {
+	fake.blockAccountMutex.Lock()
+	ret, specificReturn := fake.blockAccountReturnsOnCall[len(fake.blockAccountArgsForCall)]
+	fake.blockAccountArgsForCall = append(fake.blockAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.BlockAccountStub
+	fakeReturns := fake.blockAccountReturns
+	fake.recordInvocation("BlockAccount", []interface{}{arg1, arg2})
+	fake.blockAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) BlockAccountCallCount() int {
+	fake.blockAccountMutex.RLock()
+	defer fake.blockAccountMutex.RUnlock()
+	return len(fake.blockAccountArgsForCall)
+}
+
+func (fake *FakeRBACRepository) BlockAccountCalls(stub func(context.Context, string) error) {
+	fake.blockAccountMutex.Lock()
+	defer fake.blockAccountMutex.Unlock()
+	fake.BlockAccountStub = stub
+}
+
+func (fake *FakeRBACRepository) BlockAccountArgsForCall(i int) (context.Context, string) {
+	fake.blockAccountMutex.RLock()
+	defer fake.blockAccountMutex.RUnlock()
+	argsForCall := fake.blockAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) BlockAccountReturns(result1 error) {
+	fake.blockAccountMutex.Lock()
+	defer fake.blockAccountMutex.Unlock()
+	fake.BlockAccountStub = nil
+	fake.blockAccountReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) BlockAccountReturnsOnCall(i int, result1 error) {
+	fake.blockAccountMutex.Lock()
+	defer fake.blockAccountMutex.Unlock()
+	fake.BlockAccountStub = nil
+	if fake.blockAccountReturnsOnCall == nil {
+		fake.blockAccountReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.blockAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivation(arg1 context.Context, arg2 string) (internal.BreakGlassActivation, error) {
+	fake.breakGlassActivationMutex.Lock()
+	ret, specificReturn := fake.breakGlassActivationReturnsOnCall[len(fake.breakGlassActivationArgsForCall)]
+	fake.breakGlassActivationArgsForCall = append(fake.breakGlassActivationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.BreakGlassActivationStub
+	fakeReturns := fake.breakGlassActivationReturns
+	fake.recordInvocation("BreakGlassActivation", []interface{}{arg1, arg2})
+	fake.breakGlassActivationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationCallCount() int {
+	fake.breakGlassActivationMutex.RLock()
+	defer fake.breakGlassActivationMutex.RUnlock()
+	return len(fake.breakGlassActivationArgsForCall)
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationCalls(stub func(context.Context, string) (internal.BreakGlassActivation, error)) {
+	fake.breakGlassActivationMutex.Lock()
+	defer fake.breakGlassActivationMutex.Unlock()
+	fake.BreakGlassActivationStub = stub
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationArgsForCall(i int) (context.Context, string) {
+	fake.breakGlassActivationMutex.RLock()
+	defer fake.breakGlassActivationMutex.RUnlock()
+	argsForCall := fake.breakGlassActivationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationReturns(result1 internal.BreakGlassActivation, result2 error) {
+	fake.breakGlassActivationMutex.Lock()
+	defer fake.breakGlassActivationMutex.Unlock()
+	fake.BreakGlassActivationStub = nil
+	fake.breakGlassActivationReturns = struct {
+		result1 internal.BreakGlassActivation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationReturnsOnCall(i int, result1 internal.BreakGlassActivation, result2 error) {
+	fake.breakGlassActivationMutex.Lock()
+	defer fake.breakGlassActivationMutex.Unlock()
+	fake.BreakGlassActivationStub = nil
+	if fake.breakGlassActivationReturnsOnCall == nil {
+		fake.breakGlassActivationReturnsOnCall = make(map[int]struct {
+			result1 internal.BreakGlassActivation
+			result2 error
+		})
+	}
+	fake.breakGlassActivationReturnsOnCall[i] = struct {
+		result1 internal.BreakGlassActivation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivations(arg1 context.Context, arg2 time.Time) ([]internal.BreakGlassActivation, error) {
+	fake.breakGlassActivationsMutex.Lock()
+	ret, specificReturn := fake.breakGlassActivationsReturnsOnCall[len(fake.breakGlassActivationsArgsForCall)]
+	fake.breakGlassActivationsArgsForCall = append(fake.breakGlassActivationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.BreakGlassActivationsStub
+	fakeReturns := fake.breakGlassActivationsReturns
+	fake.recordInvocation("BreakGlassActivations", []interface{}{arg1, arg2})
+	fake.breakGlassActivationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationsCallCount() int {
+	fake.breakGlassActivationsMutex.RLock()
+	defer fake.breakGlassActivationsMutex.RUnlock()
+	return len(fake.breakGlassActivationsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationsCalls(stub func(context.Context, time.Time) ([]internal.BreakGlassActivation, error)) {
+	fake.breakGlassActivationsMutex.Lock()
+	defer fake.breakGlassActivationsMutex.Unlock()
+	fake.BreakGlassActivationsStub = stub
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationsArgsForCall(i int) (context.Context, time.Time) {
+	fake.breakGlassActivationsMutex.RLock()
+	defer fake.breakGlassActivationsMutex.RUnlock()
+	argsForCall := fake.breakGlassActivationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationsReturns(result1 []internal.BreakGlassActivation, result2 error) {
+	fake.breakGlassActivationsMutex.Lock()
+	defer fake.breakGlassActivationsMutex.Unlock()
+	fake.BreakGlassActivationsStub = nil
+	fake.breakGlassActivationsReturns = struct {
+		result1 []internal.BreakGlassActivation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) BreakGlassActivationsReturnsOnCall(i int, result1 []internal.BreakGlassActivation, result2 error) {
+	fake.breakGlassActivationsMutex.Lock()
+	defer fake.breakGlassActivationsMutex.Unlock()
+	fake.BreakGlassActivationsStub = nil
+	if fake.breakGlassActivationsReturnsOnCall == nil {
+		fake.breakGlassActivationsReturnsOnCall = make(map[int]struct {
+			result1 []internal.BreakGlassActivation
+			result2 error
+		})
+	}
+	fake.breakGlassActivationsReturnsOnCall[i] = struct {
+		result1 []internal.BreakGlassActivation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ChangePassword(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.changePasswordMutex.Lock()
+	ret, specificReturn := fake.changePasswordReturnsOnCall[len(fake.changePasswordArgsForCall)]
+	fake.changePasswordArgsForCall = append(fake.changePasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ChangePasswordStub
+	fakeReturns := fake.changePasswordReturns
+	fake.recordInvocation("ChangePassword", []interface{}{arg1, arg2, arg3})
+	fake.changePasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) ChangePasswordCallCount() int {
+	fake.changePasswordMutex.RLock()
+	defer fake.changePasswordMutex.RUnlock()
+	return len(fake.changePasswordArgsForCall)
+}
+
+func (fake *FakeRBACRepository) ChangePasswordCalls(stub func(context.Context, string, string) error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = stub
+}
+
+func (fake *FakeRBACRepository) ChangePasswordArgsForCall(i int) (context.Context, string, string) {
+	fake.changePasswordMutex.RLock()
+	defer fake.changePasswordMutex.RUnlock()
+	argsForCall := fake.changePasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) ChangePasswordReturns(result1 error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = nil
+	fake.changePasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) ChangePasswordReturnsOnCall(i int, result1 error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = nil
+	if fake.changePasswordReturnsOnCall == nil {
+		fake.changePasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.changePasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) ConfirmMFA(arg1 context.Context, arg2 string, arg3 int64, arg4 []string) error {
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.confirmMFAMutex.Lock()
+	ret, specificReturn := fake.confirmMFAReturnsOnCall[len(fake.confirmMFAArgsForCall)]
+	fake.confirmMFAArgsForCall = append(fake.confirmMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int64
+		arg4 []string
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.ConfirmMFAStub
+	fakeReturns := fake.confirmMFAReturns
+	fake.recordInvocation("ConfirmMFA", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.confirmMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) ConfirmMFACallCount() int {
+	fake.confirmMFAMutex.RLock()
+	defer fake.confirmMFAMutex.RUnlock()
+	return len(fake.confirmMFAArgsForCall)
+}
+
+func (fake *FakeRBACRepository) ConfirmMFACalls(stub func(context.Context, string, int64, []string) error) {
+	fake.confirmMFAMutex.Lock()
+	defer fake.confirmMFAMutex.Unlock()
+	fake.ConfirmMFAStub = stub
+}
+
+func (fake *FakeRBACRepository) ConfirmMFAArgsForCall(i int) (context.Context, string, int64, []string) {
+	fake.confirmMFAMutex.RLock()
+	defer fake.confirmMFAMutex.RUnlock()
+	argsForCall := fake.confirmMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACRepository) ConfirmMFAReturns(result1 error) {
+	fake.confirmMFAMutex.Lock()
+	defer fake.confirmMFAMutex.Unlock()
+	fake.ConfirmMFAStub = nil
+	fake.confirmMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) ConfirmMFAReturnsOnCall(i int, result1 error) {
+	fake.confirmMFAMutex.Lock()
+	defer fake.confirmMFAMutex.Unlock()
+	fake.ConfirmMFAStub = nil
+	if fake.confirmMFAReturnsOnCall == nil {
+		fake.confirmMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.confirmMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) CountStartedAccountRoles(arg1 context.Context, arg2 time.Time, arg3 time.Time) (int64, error) {
+	fake.countStartedAccountRolesMutex.Lock()
+	ret, specificReturn := fake.countStartedAccountRolesReturnsOnCall[len(fake.countStartedAccountRolesArgsForCall)]
+	fake.countStartedAccountRolesArgsForCall = append(fake.countStartedAccountRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.CountStartedAccountRolesStub
+	fakeReturns := fake.countStartedAccountRolesReturns
+	fake.recordInvocation("CountStartedAccountRoles", []interface{}{arg1, arg2, arg3})
+	fake.countStartedAccountRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CountStartedAccountRolesCallCount() int {
+	fake.countStartedAccountRolesMutex.RLock()
+	defer fake.countStartedAccountRolesMutex.RUnlock()
+	return len(fake.countStartedAccountRolesArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CountStartedAccountRolesCalls(stub func(context.Context, time.Time, time.Time) (int64, error)) {
+	fake.countStartedAccountRolesMutex.Lock()
+	defer fake.countStartedAccountRolesMutex.Unlock()
+	fake.CountStartedAccountRolesStub = stub
+}
+
+func (fake *FakeRBACRepository) CountStartedAccountRolesArgsForCall(i int) (context.Context, time.Time, time.Time) {
+	fake.countStartedAccountRolesMutex.RLock()
+	defer fake.countStartedAccountRolesMutex.RUnlock()
+	argsForCall := fake.countStartedAccountRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) CountStartedAccountRolesReturns(result1 int64, result2 error) {
+	fake.countStartedAccountRolesMutex.Lock()
+	defer fake.countStartedAccountRolesMutex.Unlock()
+	fake.CountStartedAccountRolesStub = nil
+	fake.countStartedAccountRolesReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CountStartedAccountRolesReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.countStartedAccountRolesMutex.Lock()
+	defer fake.countStartedAccountRolesMutex.Unlock()
+	fake.CountStartedAccountRolesStub = nil
+	if fake.countStartedAccountRolesReturnsOnCall == nil {
+		fake.countStartedAccountRolesReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.countStartedAccountRolesReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateAPIKey(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 string, arg6 time.Time) (internal.APIKey, error) {
+	fake.createAPIKeyMutex.Lock()
+	ret, specificReturn := fake.createAPIKeyReturnsOnCall[len(fake.createAPIKeyArgsForCall)]
+	fake.createAPIKeyArgsForCall = append(fake.createAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+		arg6 time.Time
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.CreateAPIKeyStub
+	fakeReturns := fake.createAPIKeyReturns
+	fake.recordInvocation("CreateAPIKey", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.createAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateAPIKeyCallCount() int {
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	return len(fake.createAPIKeyArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateAPIKeyCalls(stub func(context.Context, string, string, string, string, time.Time) (internal.APIKey, error)) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateAPIKeyArgsForCall(i int) (context.Context, string, string, string, string, time.Time) {
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	argsForCall := fake.createAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeRBACRepository) CreateAPIKeyReturns(result1 internal.APIKey, result2 error) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = nil
+	fake.createAPIKeyReturns = struct {
+		result1 internal.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateAPIKeyReturnsOnCall(i int, result1 internal.APIKey, result2 error) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = nil
+	if fake.createAPIKeyReturnsOnCall == nil {
+		fake.createAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 internal.APIKey
+			result2 error
+		})
+	}
+	fake.createAPIKeyReturnsOnCall[i] = struct {
+		result1 internal.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateAccessRequest(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 time.Time) (string, error) {
+	fake.createAccessRequestMutex.Lock()
+	ret, specificReturn := fake.createAccessRequestReturnsOnCall[len(fake.createAccessRequestArgsForCall)]
+	fake.createAccessRequestArgsForCall = append(fake.createAccessRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CreateAccessRequestStub
+	fakeReturns := fake.createAccessRequestReturns
+	fake.recordInvocation("CreateAccessRequest", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createAccessRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateAccessRequestCallCount() int {
+	fake.createAccessRequestMutex.RLock()
+	defer fake.createAccessRequestMutex.RUnlock()
+	return len(fake.createAccessRequestArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateAccessRequestCalls(stub func(context.Context, string, string, string, time.Time) (string, error)) {
+	fake.createAccessRequestMutex.Lock()
+	defer fake.createAccessRequestMutex.Unlock()
+	fake.CreateAccessRequestStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateAccessRequestArgsForCall(i int) (context.Context, string, string, string, time.Time) {
+	fake.createAccessRequestMutex.RLock()
+	defer fake.createAccessRequestMutex.RUnlock()
+	argsForCall := fake.createAccessRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRBACRepository) CreateAccessRequestReturns(result1 string, result2 error) {
+	fake.createAccessRequestMutex.Lock()
+	defer fake.createAccessRequestMutex.Unlock()
+	fake.CreateAccessRequestStub = nil
+	fake.createAccessRequestReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateAccessRequestReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createAccessRequestMutex.Lock()
+	defer fake.createAccessRequestMutex.Unlock()
+	fake.CreateAccessRequestStub = nil
+	if fake.createAccessRequestReturnsOnCall == nil {
+		fake.createAccessRequestReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createAccessRequestReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateAccount(arg1 context.Context, arg2 internal.Account, arg3 string) (string, error) {
+	fake.createAccountMutex.Lock()
+	ret, specificReturn := fake.createAccountReturnsOnCall[len(fake.createAccountArgsForCall)]
+	fake.createAccountArgsForCall = append(fake.createAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Account
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CreateAccountStub
+	fakeReturns := fake.createAccountReturns
+	fake.recordInvocation("CreateAccount", []interface{}{arg1, arg2, arg3})
+	fake.createAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateAccountCallCount() int {
+	fake.createAccountMutex.RLock()
+	defer fake.createAccountMutex.RUnlock()
+	return len(fake.createAccountArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateAccountCalls(stub func(context.Context, internal.Account, string) (string, error)) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateAccountArgsForCall(i int) (context.Context, internal.Account, string) {
+	fake.createAccountMutex.RLock()
+	defer fake.createAccountMutex.RUnlock()
+	argsForCall := fake.createAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) CreateAccountReturns(result1 string, result2 error) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = nil
+	fake.createAccountReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateAccountReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createAccountMutex.Lock()
+	defer fake.createAccountMutex.Unlock()
+	fake.CreateAccountStub = nil
+	if fake.createAccountReturnsOnCall == nil {
+		fake.createAccountReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createAccountReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateAccountRole(arg1 context.Context, arg2 string, arg3 string, arg4 internal.Scope, arg5 internal.Validity) (string, error) {
+	fake.createAccountRoleMutex.Lock()
+	ret, specificReturn := fake.createAccountRoleReturnsOnCall[len(fake.createAccountRoleArgsForCall)]
+	fake.createAccountRoleArgsForCall = append(fake.createAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Scope
+		arg5 internal.Validity
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CreateAccountRoleStub
+	fakeReturns := fake.createAccountRoleReturns
+	fake.recordInvocation("CreateAccountRole", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateAccountRoleCallCount() int {
+	fake.createAccountRoleMutex.RLock()
+	defer fake.createAccountRoleMutex.RUnlock()
+	return len(fake.createAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateAccountRoleCalls(stub func(context.Context, string, string, internal.Scope, internal.Validity) (string, error)) {
+	fake.createAccountRoleMutex.Lock()
+	defer fake.createAccountRoleMutex.Unlock()
+	fake.CreateAccountRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateAccountRoleArgsForCall(i int) (context.Context, string, string, internal.Scope, internal.Validity) {
+	fake.createAccountRoleMutex.RLock()
+	defer fake.createAccountRoleMutex.RUnlock()
+	argsForCall := fake.createAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRBACRepository) CreateAccountRoleReturns(result1 string, result2 error) {
+	fake.createAccountRoleMutex.Lock()
+	defer fake.createAccountRoleMutex.Unlock()
+	fake.CreateAccountRoleStub = nil
+	fake.createAccountRoleReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateAccountRoleReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createAccountRoleMutex.Lock()
+	defer fake.createAccountRoleMutex.Unlock()
+	fake.CreateAccountRoleStub = nil
+	if fake.createAccountRoleReturnsOnCall == nil {
+		fake.createAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createAccountRoleReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateBreakGlassActivation(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 time.Time) (string, string, error) {
+	fake.createBreakGlassActivationMutex.Lock()
+	ret, specificReturn := fake.createBreakGlassActivationReturnsOnCall[len(fake.createBreakGlassActivationArgsForCall)]
+	fake.createBreakGlassActivationArgsForCall = append(fake.createBreakGlassActivationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CreateBreakGlassActivationStub
+	fakeReturns := fake.createBreakGlassActivationReturns
+	fake.recordInvocation("CreateBreakGlassActivation", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createBreakGlassActivationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRBACRepository) CreateBreakGlassActivationCallCount() int {
+	fake.createBreakGlassActivationMutex.RLock()
+	defer fake.createBreakGlassActivationMutex.RUnlock()
+	return len(fake.createBreakGlassActivationArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateBreakGlassActivationCalls(stub func(context.Context, string, string, string, time.Time) (string, string, error)) {
+	fake.createBreakGlassActivationMutex.Lock()
+	defer fake.createBreakGlassActivationMutex.Unlock()
+	fake.CreateBreakGlassActivationStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateBreakGlassActivationArgsForCall(i int) (context.Context, string, string, string, time.Time) {
+	fake.createBreakGlassActivationMutex.RLock()
+	defer fake.createBreakGlassActivationMutex.RUnlock()
+	argsForCall := fake.createBreakGlassActivationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRBACRepository) CreateBreakGlassActivationReturns(result1 string, result2 string, result3 error) {
+	fake.createBreakGlassActivationMutex.Lock()
+	defer fake.createBreakGlassActivationMutex.Unlock()
+	fake.CreateBreakGlassActivationStub = nil
+	fake.createBreakGlassActivationReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRBACRepository) CreateBreakGlassActivationReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.createBreakGlassActivationMutex.Lock()
+	defer fake.createBreakGlassActivationMutex.Unlock()
+	fake.CreateBreakGlassActivationStub = nil
+	if fake.createBreakGlassActivationReturnsOnCall == nil {
+		fake.createBreakGlassActivationReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.createBreakGlassActivationReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRBACRepository) CreateExclusiveRoleSet(arg1 context.Context, arg2 internal.ExclusiveRoleSet) (string, error) {
+	fake.createExclusiveRoleSetMutex.Lock()
+	ret, specificReturn := fake.createExclusiveRoleSetReturnsOnCall[len(fake.createExclusiveRoleSetArgsForCall)]
+	fake.createExclusiveRoleSetArgsForCall = append(fake.createExclusiveRoleSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ExclusiveRoleSet
+	}{arg1, arg2})
+	stub := fake.CreateExclusiveRoleSetStub
+	fakeReturns := fake.createExclusiveRoleSetReturns
+	fake.recordInvocation("CreateExclusiveRoleSet", []interface{}{arg1, arg2})
+	fake.createExclusiveRoleSetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateExclusiveRoleSetCallCount() int {
+	fake.createExclusiveRoleSetMutex.RLock()
+	defer fake.createExclusiveRoleSetMutex.RUnlock()
+	return len(fake.createExclusiveRoleSetArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateExclusiveRoleSetCalls(stub func(context.Context, internal.ExclusiveRoleSet) (string, error)) {
+	fake.createExclusiveRoleSetMutex.Lock()
+	defer fake.createExclusiveRoleSetMutex.Unlock()
+	fake.CreateExclusiveRoleSetStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateExclusiveRoleSetArgsForCall(i int) (context.Context, internal.ExclusiveRoleSet) {
+	fake.createExclusiveRoleSetMutex.RLock()
+	defer fake.createExclusiveRoleSetMutex.RUnlock()
+	argsForCall := fake.createExclusiveRoleSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) CreateExclusiveRoleSetReturns(result1 string, result2 error) {
+	fake.createExclusiveRoleSetMutex.Lock()
+	defer fake.createExclusiveRoleSetMutex.Unlock()
+	fake.CreateExclusiveRoleSetStub = nil
+	fake.createExclusiveRoleSetReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateExclusiveRoleSetReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createExclusiveRoleSetMutex.Lock()
+	defer fake.createExclusiveRoleSetMutex.Unlock()
+	fake.CreateExclusiveRoleSetStub = nil
+	if fake.createExclusiveRoleSetReturnsOnCall == nil {
+		fake.createExclusiveRoleSetReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createExclusiveRoleSetReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateGroup(arg1 context.Context, arg2 string) (string, error) {
+	fake.createGroupMutex.Lock()
+	ret, specificReturn := fake.createGroupReturnsOnCall[len(fake.createGroupArgsForCall)]
+	fake.createGroupArgsForCall = append(fake.createGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateGroupStub
+	fakeReturns := fake.createGroupReturns
+	fake.recordInvocation("CreateGroup", []interface{}{arg1, arg2})
+	fake.createGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateGroupCallCount() int {
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
+	return len(fake.createGroupArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateGroupCalls(stub func(context.Context, string) (string, error)) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateGroupArgsForCall(i int) (context.Context, string) {
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
+	argsForCall := fake.createGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) CreateGroupReturns(result1 string, result2 error) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = nil
+	fake.createGroupReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateGroupReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = nil
+	if fake.createGroupReturnsOnCall == nil {
+		fake.createGroupReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createGroupReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateHelpText(arg1 context.Context, arg2 internal.HelpText) (string, error) {
+	fake.createHelpTextMutex.Lock()
+	ret, specificReturn := fake.createHelpTextReturnsOnCall[len(fake.createHelpTextArgsForCall)]
+	fake.createHelpTextArgsForCall = append(fake.createHelpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.HelpText
+	}{arg1, arg2})
+	stub := fake.CreateHelpTextStub
+	fakeReturns := fake.createHelpTextReturns
+	fake.recordInvocation("CreateHelpText", []interface{}{arg1, arg2})
+	fake.createHelpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateHelpTextCallCount() int {
+	fake.createHelpTextMutex.RLock()
+	defer fake.createHelpTextMutex.RUnlock()
+	return len(fake.createHelpTextArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateHelpTextCalls(stub func(context.Context, internal.HelpText) (string, error)) {
+	fake.createHelpTextMutex.Lock()
+	defer fake.createHelpTextMutex.Unlock()
+	fake.CreateHelpTextStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateHelpTextArgsForCall(i int) (context.Context, internal.HelpText) {
+	fake.createHelpTextMutex.RLock()
+	defer fake.createHelpTextMutex.RUnlock()
+	argsForCall := fake.createHelpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) CreateHelpTextReturns(result1 string, result2 error) {
+	fake.createHelpTextMutex.Lock()
+	defer fake.createHelpTextMutex.Unlock()
+	fake.CreateHelpTextStub = nil
+	fake.createHelpTextReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateHelpTextReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createHelpTextMutex.Lock()
+	defer fake.createHelpTextMutex.Unlock()
+	fake.CreateHelpTextStub = nil
+	if fake.createHelpTextReturnsOnCall == nil {
+		fake.createHelpTextReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createHelpTextReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateMenu(arg1 context.Context, arg2 internal.Menu) (string, error) {
+	fake.createMenuMutex.Lock()
+	ret, specificReturn := fake.createMenuReturnsOnCall[len(fake.createMenuArgsForCall)]
+	fake.createMenuArgsForCall = append(fake.createMenuArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Menu
+	}{arg1, arg2})
+	stub := fake.CreateMenuStub
+	fakeReturns := fake.createMenuReturns
+	fake.recordInvocation("CreateMenu", []interface{}{arg1, arg2})
+	fake.createMenuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateMenuCallCount() int {
+	fake.createMenuMutex.RLock()
+	defer fake.createMenuMutex.RUnlock()
+	return len(fake.createMenuArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateMenuCalls(stub func(context.Context, internal.Menu) (string, error)) {
+	fake.createMenuMutex.Lock()
+	defer fake.createMenuMutex.Unlock()
+	fake.CreateMenuStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateMenuArgsForCall(i int) (context.Context, internal.Menu) {
+	fake.createMenuMutex.RLock()
+	defer fake.createMenuMutex.RUnlock()
+	argsForCall := fake.createMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) CreateMenuReturns(result1 string, result2 error) {
+	fake.createMenuMutex.Lock()
+	defer fake.createMenuMutex.Unlock()
+	fake.CreateMenuStub = nil
+	fake.createMenuReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateMenuReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createMenuMutex.Lock()
+	defer fake.createMenuMutex.Unlock()
+	fake.CreateMenuStub = nil
+	if fake.createMenuReturnsOnCall == nil {
+		fake.createMenuReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createMenuReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateNavigation(arg1 context.Context, arg2 internal.Navigation) (string, error) {
+	fake.createNavigationMutex.Lock()
+	ret, specificReturn := fake.createNavigationReturnsOnCall[len(fake.createNavigationArgsForCall)]
+	fake.createNavigationArgsForCall = append(fake.createNavigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Navigation
+	}{arg1, arg2})
+	stub := fake.CreateNavigationStub
+	fakeReturns := fake.createNavigationReturns
+	fake.recordInvocation("CreateNavigation", []interface{}{arg1, arg2})
+	fake.createNavigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateNavigationCallCount() int {
+	fake.createNavigationMutex.RLock()
+	defer fake.createNavigationMutex.RUnlock()
+	return len(fake.createNavigationArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateNavigationCalls(stub func(context.Context, internal.Navigation) (string, error)) {
+	fake.createNavigationMutex.Lock()
+	defer fake.createNavigationMutex.Unlock()
+	fake.CreateNavigationStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateNavigationArgsForCall(i int) (context.Context, internal.Navigation) {
+	fake.createNavigationMutex.RLock()
+	defer fake.createNavigationMutex.RUnlock()
+	argsForCall := fake.createNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) CreateNavigationReturns(result1 string, result2 error) {
+	fake.createNavigationMutex.Lock()
+	defer fake.createNavigationMutex.Unlock()
+	fake.CreateNavigationStub = nil
+	fake.createNavigationReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateNavigationReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createNavigationMutex.Lock()
+	defer fake.createNavigationMutex.Unlock()
+	fake.CreateNavigationStub = nil
+	if fake.createNavigationReturnsOnCall == nil {
+		fake.createNavigationReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createNavigationReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreatePasswordResetToken(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time) (string, error) {
+	fake.createPasswordResetTokenMutex.Lock()
+	ret, specificReturn := fake.createPasswordResetTokenReturnsOnCall[len(fake.createPasswordResetTokenArgsForCall)]
+	fake.createPasswordResetTokenArgsForCall = append(fake.createPasswordResetTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreatePasswordResetTokenStub
+	fakeReturns := fake.createPasswordResetTokenReturns
+	fake.recordInvocation("CreatePasswordResetToken", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createPasswordResetTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreatePasswordResetTokenCallCount() int {
+	fake.createPasswordResetTokenMutex.RLock()
+	defer fake.createPasswordResetTokenMutex.RUnlock()
+	return len(fake.createPasswordResetTokenArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreatePasswordResetTokenCalls(stub func(context.Context, string, string, time.Time) (string, error)) {
+	fake.createPasswordResetTokenMutex.Lock()
+	defer fake.createPasswordResetTokenMutex.Unlock()
+	fake.CreatePasswordResetTokenStub = stub
+}
+
+func (fake *FakeRBACRepository) CreatePasswordResetTokenArgsForCall(i int) (context.Context, string, string, time.Time) {
+	fake.createPasswordResetTokenMutex.RLock()
+	defer fake.createPasswordResetTokenMutex.RUnlock()
+	argsForCall := fake.createPasswordResetTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACRepository) CreatePasswordResetTokenReturns(result1 string, result2 error) {
+	fake.createPasswordResetTokenMutex.Lock()
+	defer fake.createPasswordResetTokenMutex.Unlock()
+	fake.CreatePasswordResetTokenStub = nil
+	fake.createPasswordResetTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreatePasswordResetTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createPasswordResetTokenMutex.Lock()
+	defer fake.createPasswordResetTokenMutex.Unlock()
+	fake.CreatePasswordResetTokenStub = nil
+	if fake.createPasswordResetTokenReturnsOnCall == nil {
+		fake.createPasswordResetTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createPasswordResetTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateRefreshToken(arg1 context.Context, arg2 string, arg3 string, arg4 time.Time) (string, error) {
+	fake.createRefreshTokenMutex.Lock()
+	ret, specificReturn := fake.createRefreshTokenReturnsOnCall[len(fake.createRefreshTokenArgsForCall)]
+	fake.createRefreshTokenArgsForCall = append(fake.createRefreshTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateRefreshTokenStub
+	fakeReturns := fake.createRefreshTokenReturns
+	fake.recordInvocation("CreateRefreshToken", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createRefreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateRefreshTokenCallCount() int {
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	return len(fake.createRefreshTokenArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateRefreshTokenCalls(stub func(context.Context, string, string, time.Time) (string, error)) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateRefreshTokenArgsForCall(i int) (context.Context, string, string, time.Time) {
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	argsForCall := fake.createRefreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACRepository) CreateRefreshTokenReturns(result1 string, result2 error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = nil
+	fake.createRefreshTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateRefreshTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = nil
+	if fake.createRefreshTokenReturnsOnCall == nil {
+		fake.createRefreshTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createRefreshTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateRole(arg1 context.Context, arg2 string) (string, error) {
+	fake.createRoleMutex.Lock()
+	ret, specificReturn := fake.createRoleReturnsOnCall[len(fake.createRoleArgsForCall)]
+	fake.createRoleArgsForCall = append(fake.createRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateRoleStub
+	fakeReturns := fake.createRoleReturns
+	fake.recordInvocation("CreateRole", []interface{}{arg1, arg2})
+	fake.createRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateRoleCallCount() int {
+	fake.createRoleMutex.RLock()
+	defer fake.createRoleMutex.RUnlock()
+	return len(fake.createRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateRoleCalls(stub func(context.Context, string) (string, error)) {
+	fake.createRoleMutex.Lock()
+	defer fake.createRoleMutex.Unlock()
+	fake.CreateRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateRoleArgsForCall(i int) (context.Context, string) {
+	fake.createRoleMutex.RLock()
+	defer fake.createRoleMutex.RUnlock()
+	argsForCall := fake.createRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) CreateRoleReturns(result1 string, result2 error) {
+	fake.createRoleMutex.Lock()
+	defer fake.createRoleMutex.Unlock()
+	fake.CreateRoleStub = nil
+	fake.createRoleReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateRoleReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createRoleMutex.Lock()
+	defer fake.createRoleMutex.Unlock()
+	fake.CreateRoleStub = nil
+	if fake.createRoleReturnsOnCall == nil {
+		fake.createRoleReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createRoleReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateRoleInheritance(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.createRoleInheritanceMutex.Lock()
+	ret, specificReturn := fake.createRoleInheritanceReturnsOnCall[len(fake.createRoleInheritanceArgsForCall)]
+	fake.createRoleInheritanceArgsForCall = append(fake.createRoleInheritanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CreateRoleInheritanceStub
+	fakeReturns := fake.createRoleInheritanceReturns
+	fake.recordInvocation("CreateRoleInheritance", []interface{}{arg1, arg2, arg3})
+	fake.createRoleInheritanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateRoleInheritanceCallCount() int {
+	fake.createRoleInheritanceMutex.RLock()
+	defer fake.createRoleInheritanceMutex.RUnlock()
+	return len(fake.createRoleInheritanceArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateRoleInheritanceCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.createRoleInheritanceMutex.Lock()
+	defer fake.createRoleInheritanceMutex.Unlock()
+	fake.CreateRoleInheritanceStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateRoleInheritanceArgsForCall(i int) (context.Context, string, string) {
+	fake.createRoleInheritanceMutex.RLock()
+	defer fake.createRoleInheritanceMutex.RUnlock()
+	argsForCall := fake.createRoleInheritanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) CreateRoleInheritanceReturns(result1 string, result2 error) {
+	fake.createRoleInheritanceMutex.Lock()
+	defer fake.createRoleInheritanceMutex.Unlock()
+	fake.CreateRoleInheritanceStub = nil
+	fake.createRoleInheritanceReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateRoleInheritanceReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createRoleInheritanceMutex.Lock()
+	defer fake.createRoleInheritanceMutex.Unlock()
+	fake.CreateRoleInheritanceStub = nil
+	if fake.createRoleInheritanceReturnsOnCall == nil {
+		fake.createRoleInheritanceReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createRoleInheritanceReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateRoleTasks(arg1 context.Context, arg2 string, arg3 string, arg4 internal.Scope, arg5 string, arg6 string) (string, error) {
+	fake.createRoleTasksMutex.Lock()
+	ret, specificReturn := fake.createRoleTasksReturnsOnCall[len(fake.createRoleTasksArgsForCall)]
+	fake.createRoleTasksArgsForCall = append(fake.createRoleTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Scope
+		arg5 string
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.CreateRoleTasksStub
+	fakeReturns := fake.createRoleTasksReturns
+	fake.recordInvocation("CreateRoleTasks", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.createRoleTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateRoleTasksCallCount() int {
+	fake.createRoleTasksMutex.RLock()
+	defer fake.createRoleTasksMutex.RUnlock()
+	return len(fake.createRoleTasksArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateRoleTasksCalls(stub func(context.Context, string, string, internal.Scope, string, string) (string, error)) {
+	fake.createRoleTasksMutex.Lock()
+	defer fake.createRoleTasksMutex.Unlock()
+	fake.CreateRoleTasksStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateRoleTasksArgsForCall(i int) (context.Context, string, string, internal.Scope, string, string) {
+	fake.createRoleTasksMutex.RLock()
+	defer fake.createRoleTasksMutex.RUnlock()
+	argsForCall := fake.createRoleTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeRBACRepository) CreateRoleTasksReturns(result1 string, result2 error) {
+	fake.createRoleTasksMutex.Lock()
+	defer fake.createRoleTasksMutex.Unlock()
+	fake.CreateRoleTasksStub = nil
+	fake.createRoleTasksReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateRoleTasksReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createRoleTasksMutex.Lock()
+	defer fake.createRoleTasksMutex.Unlock()
+	fake.CreateRoleTasksStub = nil
+	if fake.createRoleTasksReturnsOnCall == nil {
+		fake.createRoleTasksReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createRoleTasksReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateServiceAccount(arg1 context.Context, arg2 string) (internal.Account, error) {
+	fake.createServiceAccountMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountReturnsOnCall[len(fake.createServiceAccountArgsForCall)]
+	fake.createServiceAccountArgsForCall = append(fake.createServiceAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateServiceAccountStub
+	fakeReturns := fake.createServiceAccountReturns
+	fake.recordInvocation("CreateServiceAccount", []interface{}{arg1, arg2})
+	fake.createServiceAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateServiceAccountCallCount() int {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	return len(fake.createServiceAccountArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateServiceAccountCalls(stub func(context.Context, string) (internal.Account, error)) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateServiceAccountArgsForCall(i int) (context.Context, string) {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	argsForCall := fake.createServiceAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) CreateServiceAccountReturns(result1 internal.Account, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	fake.createServiceAccountReturns = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateServiceAccountReturnsOnCall(i int, result1 internal.Account, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	if fake.createServiceAccountReturnsOnCall == nil {
+		fake.createServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 internal.Account
+			result2 error
+		})
+	}
+	fake.createServiceAccountReturnsOnCall[i] = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateTask(arg1 context.Context, arg2 string) (string, error) {
+	fake.createTaskMutex.Lock()
+	ret, specificReturn := fake.createTaskReturnsOnCall[len(fake.createTaskArgsForCall)]
+	fake.createTaskArgsForCall = append(fake.createTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateTaskStub
+	fakeReturns := fake.createTaskReturns
+	fake.recordInvocation("CreateTask", []interface{}{arg1, arg2})
+	fake.createTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) CreateTaskCallCount() int {
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	return len(fake.createTaskArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateTaskCalls(stub func(context.Context, string) (string, error)) {
+	fake.createTaskMutex.Lock()
+	defer fake.createTaskMutex.Unlock()
+	fake.CreateTaskStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateTaskArgsForCall(i int) (context.Context, string) {
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	argsForCall := fake.createTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) CreateTaskReturns(result1 string, result2 error) {
+	fake.createTaskMutex.Lock()
+	defer fake.createTaskMutex.Unlock()
+	fake.CreateTaskStub = nil
+	fake.createTaskReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateTaskReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createTaskMutex.Lock()
+	defer fake.createTaskMutex.Unlock()
+	fake.CreateTaskStub = nil
+	if fake.createTaskReturnsOnCall == nil {
+		fake.createTaskReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createTaskReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) CreateTenant(arg1 context.Context, arg2 string, arg3 string, arg4 internal.Policy, arg5 string) (string, []internal.PolicyChange, string, error) {
+	fake.createTenantMutex.Lock()
+	ret, specificReturn := fake.createTenantReturnsOnCall[len(fake.createTenantArgsForCall)]
+	fake.createTenantArgsForCall = append(fake.createTenantArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Policy
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CreateTenantStub
+	fakeReturns := fake.createTenantReturns
+	fake.recordInvocation("CreateTenant", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createTenantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeRBACRepository) CreateTenantCallCount() int {
+	fake.createTenantMutex.RLock()
+	defer fake.createTenantMutex.RUnlock()
+	return len(fake.createTenantArgsForCall)
+}
+
+func (fake *FakeRBACRepository) CreateTenantCalls(stub func(context.Context, string, string, internal.Policy, string) (string, []internal.PolicyChange, string, error)) {
+	fake.createTenantMutex.Lock()
+	defer fake.createTenantMutex.Unlock()
+	fake.CreateTenantStub = stub
+}
+
+func (fake *FakeRBACRepository) CreateTenantArgsForCall(i int) (context.Context, string, string, internal.Policy, string) {
+	fake.createTenantMutex.RLock()
+	defer fake.createTenantMutex.RUnlock()
+	argsForCall := fake.createTenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRBACRepository) CreateTenantReturns(result1 string, result2 []internal.PolicyChange, result3 string, result4 error) {
+	fake.createTenantMutex.Lock()
+	defer fake.createTenantMutex.Unlock()
+	fake.CreateTenantStub = nil
+	fake.createTenantReturns = struct {
+		result1 string
+		result2 []internal.PolicyChange
+		result3 string
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeRBACRepository) CreateTenantReturnsOnCall(i int, result1 string, result2 []internal.PolicyChange, result3 string, result4 error) {
+	fake.createTenantMutex.Lock()
+	defer fake.createTenantMutex.Unlock()
+	fake.CreateTenantStub = nil
+	if fake.createTenantReturnsOnCall == nil {
+		fake.createTenantReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 []internal.PolicyChange
+			result3 string
+			result4 error
+		})
+	}
+	fake.createTenantReturnsOnCall[i] = struct {
+		result1 string
+		result2 []internal.PolicyChange
+		result3 string
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeRBACRepository) DecideAccessRequest(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 string) error {
+	fake.decideAccessRequestMutex.Lock()
+	ret, specificReturn := fake.decideAccessRequestReturnsOnCall[len(fake.decideAccessRequestArgsForCall)]
+	fake.decideAccessRequestArgsForCall = append(fake.decideAccessRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DecideAccessRequestStub
+	fakeReturns := fake.decideAccessRequestReturns
+	fake.recordInvocation("DecideAccessRequest", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.decideAccessRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DecideAccessRequestCallCount() int {
+	fake.decideAccessRequestMutex.RLock()
+	defer fake.decideAccessRequestMutex.RUnlock()
+	return len(fake.decideAccessRequestArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DecideAccessRequestCalls(stub func(context.Context, string, string, string, string) error) {
+	fake.decideAccessRequestMutex.Lock()
+	defer fake.decideAccessRequestMutex.Unlock()
+	fake.DecideAccessRequestStub = stub
+}
+
+func (fake *FakeRBACRepository) DecideAccessRequestArgsForCall(i int) (context.Context, string, string, string, string) {
+	fake.decideAccessRequestMutex.RLock()
+	defer fake.decideAccessRequestMutex.RUnlock()
+	argsForCall := fake.decideAccessRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRBACRepository) DecideAccessRequestReturns(result1 error) {
+	fake.decideAccessRequestMutex.Lock()
+	defer fake.decideAccessRequestMutex.Unlock()
+	fake.DecideAccessRequestStub = nil
+	fake.decideAccessRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DecideAccessRequestReturnsOnCall(i int, result1 error) {
+	fake.decideAccessRequestMutex.Lock()
+	defer fake.decideAccessRequestMutex.Unlock()
+	fake.DecideAccessRequestStub = nil
+	if fake.decideAccessRequestReturnsOnCall == nil {
+		fake.decideAccessRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.decideAccessRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteAccount(arg1 context.Context, arg2 string) error {
+	fake.deleteAccountMutex.Lock()
+	ret, specificReturn := fake.deleteAccountReturnsOnCall[len(fake.deleteAccountArgsForCall)]
+	fake.deleteAccountArgsForCall = append(fake.deleteAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAccountStub
+	fakeReturns := fake.deleteAccountReturns
+	fake.recordInvocation("DeleteAccount", []interface{}{arg1, arg2})
+	fake.deleteAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteAccountCallCount() int {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	return len(fake.deleteAccountArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteAccountCalls(stub func(context.Context, string) error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteAccountArgsForCall(i int) (context.Context, string) {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	argsForCall := fake.deleteAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteAccountReturns(result1 error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = nil
+	fake.deleteAccountReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteAccountReturnsOnCall(i int, result1 error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = nil
+	if fake.deleteAccountReturnsOnCall == nil {
+		fake.deleteAccountReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteAccountRole(arg1 context.Context, arg2 string) error {
+	fake.deleteAccountRoleMutex.Lock()
+	ret, specificReturn := fake.deleteAccountRoleReturnsOnCall[len(fake.deleteAccountRoleArgsForCall)]
+	fake.deleteAccountRoleArgsForCall = append(fake.deleteAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAccountRoleStub
+	fakeReturns := fake.deleteAccountRoleReturns
+	fake.recordInvocation("DeleteAccountRole", []interface{}{arg1, arg2})
+	fake.deleteAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteAccountRoleCallCount() int {
+	fake.deleteAccountRoleMutex.RLock()
+	defer fake.deleteAccountRoleMutex.RUnlock()
+	return len(fake.deleteAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteAccountRoleCalls(stub func(context.Context, string) error) {
+	fake.deleteAccountRoleMutex.Lock()
+	defer fake.deleteAccountRoleMutex.Unlock()
+	fake.DeleteAccountRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteAccountRoleArgsForCall(i int) (context.Context, string) {
+	fake.deleteAccountRoleMutex.RLock()
+	defer fake.deleteAccountRoleMutex.RUnlock()
+	argsForCall := fake.deleteAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteAccountRoleReturns(result1 error) {
+	fake.deleteAccountRoleMutex.Lock()
+	defer fake.deleteAccountRoleMutex.Unlock()
+	fake.DeleteAccountRoleStub = nil
+	fake.deleteAccountRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteAccountRoleReturnsOnCall(i int, result1 error) {
+	fake.deleteAccountRoleMutex.Lock()
+	defer fake.deleteAccountRoleMutex.Unlock()
+	fake.DeleteAccountRoleStub = nil
+	if fake.deleteAccountRoleReturnsOnCall == nil {
+		fake.deleteAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccountRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteExclusiveRoleSet(arg1 context.Context, arg2 string) error {
+	fake.deleteExclusiveRoleSetMutex.Lock()
+	ret, specificReturn := fake.deleteExclusiveRoleSetReturnsOnCall[len(fake.deleteExclusiveRoleSetArgsForCall)]
+	fake.deleteExclusiveRoleSetArgsForCall = append(fake.deleteExclusiveRoleSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteExclusiveRoleSetStub
+	fakeReturns := fake.deleteExclusiveRoleSetReturns
+	fake.recordInvocation("DeleteExclusiveRoleSet", []interface{}{arg1, arg2})
+	fake.deleteExclusiveRoleSetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteExclusiveRoleSetCallCount() int {
+	fake.deleteExclusiveRoleSetMutex.RLock()
+	defer fake.deleteExclusiveRoleSetMutex.RUnlock()
+	return len(fake.deleteExclusiveRoleSetArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteExclusiveRoleSetCalls(stub func(context.Context, string) error) {
+	fake.deleteExclusiveRoleSetMutex.Lock()
+	defer fake.deleteExclusiveRoleSetMutex.Unlock()
+	fake.DeleteExclusiveRoleSetStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteExclusiveRoleSetArgsForCall(i int) (context.Context, string) {
+	fake.deleteExclusiveRoleSetMutex.RLock()
+	defer fake.deleteExclusiveRoleSetMutex.RUnlock()
+	argsForCall := fake.deleteExclusiveRoleSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteExclusiveRoleSetReturns(result1 error) {
+	fake.deleteExclusiveRoleSetMutex.Lock()
+	defer fake.deleteExclusiveRoleSetMutex.Unlock()
+	fake.DeleteExclusiveRoleSetStub = nil
+	fake.deleteExclusiveRoleSetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteExclusiveRoleSetReturnsOnCall(i int, result1 error) {
+	fake.deleteExclusiveRoleSetMutex.Lock()
+	defer fake.deleteExclusiveRoleSetMutex.Unlock()
+	fake.DeleteExclusiveRoleSetStub = nil
+	if fake.deleteExclusiveRoleSetReturnsOnCall == nil {
+		fake.deleteExclusiveRoleSetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteExclusiveRoleSetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteExpiredAccountRoles(arg1 context.Context, arg2 time.Time) ([]string, error) {
+	fake.deleteExpiredAccountRolesMutex.Lock()
+	ret, specificReturn := fake.deleteExpiredAccountRolesReturnsOnCall[len(fake.deleteExpiredAccountRolesArgsForCall)]
+	fake.deleteExpiredAccountRolesArgsForCall = append(fake.deleteExpiredAccountRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.DeleteExpiredAccountRolesStub
+	fakeReturns := fake.deleteExpiredAccountRolesReturns
+	fake.recordInvocation("DeleteExpiredAccountRoles", []interface{}{arg1, arg2})
+	fake.deleteExpiredAccountRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) DeleteExpiredAccountRolesCallCount() int {
+	fake.deleteExpiredAccountRolesMutex.RLock()
+	defer fake.deleteExpiredAccountRolesMutex.RUnlock()
+	return len(fake.deleteExpiredAccountRolesArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteExpiredAccountRolesCalls(stub func(context.Context, time.Time) ([]string, error)) {
+	fake.deleteExpiredAccountRolesMutex.Lock()
+	defer fake.deleteExpiredAccountRolesMutex.Unlock()
+	fake.DeleteExpiredAccountRolesStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteExpiredAccountRolesArgsForCall(i int) (context.Context, time.Time) {
+	fake.deleteExpiredAccountRolesMutex.RLock()
+	defer fake.deleteExpiredAccountRolesMutex.RUnlock()
+	argsForCall := fake.deleteExpiredAccountRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteExpiredAccountRolesReturns(result1 []string, result2 error) {
+	fake.deleteExpiredAccountRolesMutex.Lock()
+	defer fake.deleteExpiredAccountRolesMutex.Unlock()
+	fake.DeleteExpiredAccountRolesStub = nil
+	fake.deleteExpiredAccountRolesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) DeleteExpiredAccountRolesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.deleteExpiredAccountRolesMutex.Lock()
+	defer fake.deleteExpiredAccountRolesMutex.Unlock()
+	fake.DeleteExpiredAccountRolesStub = nil
+	if fake.deleteExpiredAccountRolesReturnsOnCall == nil {
+		fake.deleteExpiredAccountRolesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.deleteExpiredAccountRolesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) DeleteGroup(arg1 context.Context, arg2 string) ([]string, error) {
+	fake.deleteGroupMutex.Lock()
+	ret, specificReturn := fake.deleteGroupReturnsOnCall[len(fake.deleteGroupArgsForCall)]
+	fake.deleteGroupArgsForCall = append(fake.deleteGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteGroupStub
+	fakeReturns := fake.deleteGroupReturns
+	fake.recordInvocation("DeleteGroup", []interface{}{arg1, arg2})
+	fake.deleteGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) DeleteGroupCallCount() int {
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
+	return len(fake.deleteGroupArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteGroupCalls(stub func(context.Context, string) ([]string, error)) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteGroupArgsForCall(i int) (context.Context, string) {
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
+	argsForCall := fake.deleteGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteGroupReturns(result1 []string, result2 error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = nil
+	fake.deleteGroupReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) DeleteGroupReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = nil
+	if fake.deleteGroupReturnsOnCall == nil {
+		fake.deleteGroupReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.deleteGroupReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) DeleteHelpText(arg1 context.Context, arg2 string) error {
+	fake.deleteHelpTextMutex.Lock()
+	ret, specificReturn := fake.deleteHelpTextReturnsOnCall[len(fake.deleteHelpTextArgsForCall)]
+	fake.deleteHelpTextArgsForCall = append(fake.deleteHelpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteHelpTextStub
+	fakeReturns := fake.deleteHelpTextReturns
+	fake.recordInvocation("DeleteHelpText", []interface{}{arg1, arg2})
+	fake.deleteHelpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteHelpTextCallCount() int {
+	fake.deleteHelpTextMutex.RLock()
+	defer fake.deleteHelpTextMutex.RUnlock()
+	return len(fake.deleteHelpTextArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteHelpTextCalls(stub func(context.Context, string) error) {
+	fake.deleteHelpTextMutex.Lock()
+	defer fake.deleteHelpTextMutex.Unlock()
+	fake.DeleteHelpTextStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteHelpTextArgsForCall(i int) (context.Context, string) {
+	fake.deleteHelpTextMutex.RLock()
+	defer fake.deleteHelpTextMutex.RUnlock()
+	argsForCall := fake.deleteHelpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteHelpTextReturns(result1 error) {
+	fake.deleteHelpTextMutex.Lock()
+	defer fake.deleteHelpTextMutex.Unlock()
+	fake.DeleteHelpTextStub = nil
+	fake.deleteHelpTextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteHelpTextReturnsOnCall(i int, result1 error) {
+	fake.deleteHelpTextMutex.Lock()
+	defer fake.deleteHelpTextMutex.Unlock()
+	fake.DeleteHelpTextStub = nil
+	if fake.deleteHelpTextReturnsOnCall == nil {
+		fake.deleteHelpTextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteHelpTextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteMFA(arg1 context.Context, arg2 string) error {
+	fake.deleteMFAMutex.Lock()
+	ret, specificReturn := fake.deleteMFAReturnsOnCall[len(fake.deleteMFAArgsForCall)]
+	fake.deleteMFAArgsForCall = append(fake.deleteMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteMFAStub
+	fakeReturns := fake.deleteMFAReturns
+	fake.recordInvocation("DeleteMFA", []interface{}{arg1, arg2})
+	fake.deleteMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteMFACallCount() int {
+	fake.deleteMFAMutex.RLock()
+	defer fake.deleteMFAMutex.RUnlock()
+	return len(fake.deleteMFAArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteMFACalls(stub func(context.Context, string) error) {
+	fake.deleteMFAMutex.Lock()
+	defer fake.deleteMFAMutex.Unlock()
+	fake.DeleteMFAStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteMFAArgsForCall(i int) (context.Context, string) {
+	fake.deleteMFAMutex.RLock()
+	defer fake.deleteMFAMutex.RUnlock()
+	argsForCall := fake.deleteMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteMFAReturns(result1 error) {
+	fake.deleteMFAMutex.Lock()
+	defer fake.deleteMFAMutex.Unlock()
+	fake.DeleteMFAStub = nil
+	fake.deleteMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteMFAReturnsOnCall(i int, result1 error) {
+	fake.deleteMFAMutex.Lock()
+	defer fake.deleteMFAMutex.Unlock()
+	fake.DeleteMFAStub = nil
+	if fake.deleteMFAReturnsOnCall == nil {
+		fake.deleteMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteMenu(arg1 context.Context, arg2 string) error {
+	fake.deleteMenuMutex.Lock()
+	ret, specificReturn := fake.deleteMenuReturnsOnCall[len(fake.deleteMenuArgsForCall)]
+	fake.deleteMenuArgsForCall = append(fake.deleteMenuArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteMenuStub
+	fakeReturns := fake.deleteMenuReturns
+	fake.recordInvocation("DeleteMenu", []interface{}{arg1, arg2})
+	fake.deleteMenuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteMenuCallCount() int {
+	fake.deleteMenuMutex.RLock()
+	defer fake.deleteMenuMutex.RUnlock()
+	return len(fake.deleteMenuArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteMenuCalls(stub func(context.Context, string) error) {
+	fake.deleteMenuMutex.Lock()
+	defer fake.deleteMenuMutex.Unlock()
+	fake.DeleteMenuStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteMenuArgsForCall(i int) (context.Context, string) {
+	fake.deleteMenuMutex.RLock()
+	defer fake.deleteMenuMutex.RUnlock()
+	argsForCall := fake.deleteMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteMenuReturns(result1 error) {
+	fake.deleteMenuMutex.Lock()
+	defer fake.deleteMenuMutex.Unlock()
+	fake.DeleteMenuStub = nil
+	fake.deleteMenuReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteMenuReturnsOnCall(i int, result1 error) {
+	fake.deleteMenuMutex.Lock()
+	defer fake.deleteMenuMutex.Unlock()
+	fake.DeleteMenuStub = nil
+	if fake.deleteMenuReturnsOnCall == nil {
+		fake.deleteMenuReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteMenuReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteNavigation(arg1 context.Context, arg2 string) error {
+	fake.deleteNavigationMutex.Lock()
+	ret, specificReturn := fake.deleteNavigationReturnsOnCall[len(fake.deleteNavigationArgsForCall)]
+	fake.deleteNavigationArgsForCall = append(fake.deleteNavigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteNavigationStub
+	fakeReturns := fake.deleteNavigationReturns
+	fake.recordInvocation("DeleteNavigation", []interface{}{arg1, arg2})
+	fake.deleteNavigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteNavigationCallCount() int {
+	fake.deleteNavigationMutex.RLock()
+	defer fake.deleteNavigationMutex.RUnlock()
+	return len(fake.deleteNavigationArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteNavigationCalls(stub func(context.Context, string) error) {
+	fake.deleteNavigationMutex.Lock()
+	defer fake.deleteNavigationMutex.Unlock()
+	fake.DeleteNavigationStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteNavigationArgsForCall(i int) (context.Context, string) {
+	fake.deleteNavigationMutex.RLock()
+	defer fake.deleteNavigationMutex.RUnlock()
+	argsForCall := fake.deleteNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteNavigationReturns(result1 error) {
+	fake.deleteNavigationMutex.Lock()
+	defer fake.deleteNavigationMutex.Unlock()
+	fake.DeleteNavigationStub = nil
+	fake.deleteNavigationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteNavigationReturnsOnCall(i int, result1 error) {
+	fake.deleteNavigationMutex.Lock()
+	defer fake.deleteNavigationMutex.Unlock()
+	fake.DeleteNavigationStub = nil
+	if fake.deleteNavigationReturnsOnCall == nil {
+		fake.deleteNavigationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteNavigationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteRole(arg1 context.Context, arg2 string) error {
+	fake.deleteRoleMutex.Lock()
+	ret, specificReturn := fake.deleteRoleReturnsOnCall[len(fake.deleteRoleArgsForCall)]
+	fake.deleteRoleArgsForCall = append(fake.deleteRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteRoleStub
+	fakeReturns := fake.deleteRoleReturns
+	fake.recordInvocation("DeleteRole", []interface{}{arg1, arg2})
+	fake.deleteRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteRoleCallCount() int {
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	return len(fake.deleteRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteRoleCalls(stub func(context.Context, string) error) {
+	fake.deleteRoleMutex.Lock()
+	defer fake.deleteRoleMutex.Unlock()
+	fake.DeleteRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteRoleArgsForCall(i int) (context.Context, string) {
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	argsForCall := fake.deleteRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteRoleReturns(result1 error) {
+	fake.deleteRoleMutex.Lock()
+	defer fake.deleteRoleMutex.Unlock()
+	fake.DeleteRoleStub = nil
+	fake.deleteRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteRoleReturnsOnCall(i int, result1 error) {
+	fake.deleteRoleMutex.Lock()
+	defer fake.deleteRoleMutex.Unlock()
+	fake.DeleteRoleStub = nil
+	if fake.deleteRoleReturnsOnCall == nil {
+		fake.deleteRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteRoleInheritance(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.deleteRoleInheritanceMutex.Lock()
+	ret, specificReturn := fake.deleteRoleInheritanceReturnsOnCall[len(fake.deleteRoleInheritanceArgsForCall)]
+	fake.deleteRoleInheritanceArgsForCall = append(fake.deleteRoleInheritanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteRoleInheritanceStub
+	fakeReturns := fake.deleteRoleInheritanceReturns
+	fake.recordInvocation("DeleteRoleInheritance", []interface{}{arg1, arg2, arg3})
+	fake.deleteRoleInheritanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteRoleInheritanceCallCount() int {
+	fake.deleteRoleInheritanceMutex.RLock()
+	defer fake.deleteRoleInheritanceMutex.RUnlock()
+	return len(fake.deleteRoleInheritanceArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteRoleInheritanceCalls(stub func(context.Context, string, string) error) {
+	fake.deleteRoleInheritanceMutex.Lock()
+	defer fake.deleteRoleInheritanceMutex.Unlock()
+	fake.DeleteRoleInheritanceStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteRoleInheritanceArgsForCall(i int) (context.Context, string, string) {
+	fake.deleteRoleInheritanceMutex.RLock()
+	defer fake.deleteRoleInheritanceMutex.RUnlock()
+	argsForCall := fake.deleteRoleInheritanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) DeleteRoleInheritanceReturns(result1 error) {
+	fake.deleteRoleInheritanceMutex.Lock()
+	defer fake.deleteRoleInheritanceMutex.Unlock()
+	fake.DeleteRoleInheritanceStub = nil
+	fake.deleteRoleInheritanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteRoleInheritanceReturnsOnCall(i int, result1 error) {
+	fake.deleteRoleInheritanceMutex.Lock()
+	defer fake.deleteRoleInheritanceMutex.Unlock()
+	fake.DeleteRoleInheritanceStub = nil
+	if fake.deleteRoleInheritanceReturnsOnCall == nil {
+		fake.deleteRoleInheritanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRoleInheritanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteRoleTask(arg1 context.Context, arg2 string) error {
+	fake.deleteRoleTaskMutex.Lock()
+	ret, specificReturn := fake.deleteRoleTaskReturnsOnCall[len(fake.deleteRoleTaskArgsForCall)]
+	fake.deleteRoleTaskArgsForCall = append(fake.deleteRoleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteRoleTaskStub
+	fakeReturns := fake.deleteRoleTaskReturns
+	fake.recordInvocation("DeleteRoleTask", []interface{}{arg1, arg2})
+	fake.deleteRoleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteRoleTaskCallCount() int {
+	fake.deleteRoleTaskMutex.RLock()
+	defer fake.deleteRoleTaskMutex.RUnlock()
+	return len(fake.deleteRoleTaskArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteRoleTaskCalls(stub func(context.Context, string) error) {
+	fake.deleteRoleTaskMutex.Lock()
+	defer fake.deleteRoleTaskMutex.Unlock()
+	fake.DeleteRoleTaskStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteRoleTaskArgsForCall(i int) (context.Context, string) {
+	fake.deleteRoleTaskMutex.RLock()
+	defer fake.deleteRoleTaskMutex.RUnlock()
+	argsForCall := fake.deleteRoleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteRoleTaskReturns(result1 error) {
+	fake.deleteRoleTaskMutex.Lock()
+	defer fake.deleteRoleTaskMutex.Unlock()
+	fake.DeleteRoleTaskStub = nil
+	fake.deleteRoleTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteRoleTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteRoleTaskMutex.Lock()
+	defer fake.deleteRoleTaskMutex.Unlock()
+	fake.DeleteRoleTaskStub = nil
+	if fake.deleteRoleTaskReturnsOnCall == nil {
+		fake.deleteRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRoleTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteTask(arg1 context.Context, arg2 string) error {
+	fake.deleteTaskMutex.Lock()
+	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
+	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteTaskStub
+	fakeReturns := fake.deleteTaskReturns
+	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2})
+	fake.deleteTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) DeleteTaskCallCount() int {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	return len(fake.deleteTaskArgsForCall)
+}
+
+func (fake *FakeRBACRepository) DeleteTaskCalls(stub func(context.Context, string) error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = stub
+}
+
+func (fake *FakeRBACRepository) DeleteTaskArgsForCall(i int) (context.Context, string) {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	argsForCall := fake.deleteTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) DeleteTaskReturns(result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	fake.deleteTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) DeleteTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	if fake.deleteTaskReturnsOnCall == nil {
+		fake.deleteTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) EmergencyRoles(arg1 context.Context) ([]internal.Roles, error) {
+	fake.emergencyRolesMutex.Lock()
+	ret, specificReturn := fake.emergencyRolesReturnsOnCall[len(fake.emergencyRolesArgsForCall)]
+	fake.emergencyRolesArgsForCall = append(fake.emergencyRolesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.EmergencyRolesStub
+	fakeReturns := fake.emergencyRolesReturns
+	fake.recordInvocation("EmergencyRoles", []interface{}{arg1})
+	fake.emergencyRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) EmergencyRolesCallCount() int {
+	fake.emergencyRolesMutex.RLock()
+	defer fake.emergencyRolesMutex.RUnlock()
+	return len(fake.emergencyRolesArgsForCall)
+}
+
+func (fake *FakeRBACRepository) EmergencyRolesCalls(stub func(context.Context) ([]internal.Roles, error)) {
+	fake.emergencyRolesMutex.Lock()
+	defer fake.emergencyRolesMutex.Unlock()
+	fake.EmergencyRolesStub = stub
+}
+
+func (fake *FakeRBACRepository) EmergencyRolesArgsForCall(i int) context.Context {
+	fake.emergencyRolesMutex.RLock()
+	defer fake.emergencyRolesMutex.RUnlock()
+	argsForCall := fake.emergencyRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACRepository) EmergencyRolesReturns(result1 []internal.Roles, result2 error) {
+	fake.emergencyRolesMutex.Lock()
+	defer fake.emergencyRolesMutex.Unlock()
+	fake.EmergencyRolesStub = nil
+	fake.emergencyRolesReturns = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) EmergencyRolesReturnsOnCall(i int, result1 []internal.Roles, result2 error) {
+	fake.emergencyRolesMutex.Lock()
+	defer fake.emergencyRolesMutex.Unlock()
+	fake.EmergencyRolesStub = nil
+	if fake.emergencyRolesReturnsOnCall == nil {
+		fake.emergencyRolesReturnsOnCall = make(map[int]struct {
+			result1 []internal.Roles
+			result2 error
+		})
+	}
+	fake.emergencyRolesReturnsOnCall[i] = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) EnrollMFA(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.enrollMFAMutex.Lock()
+	ret, specificReturn := fake.enrollMFAReturnsOnCall[len(fake.enrollMFAArgsForCall)]
+	fake.enrollMFAArgsForCall = append(fake.enrollMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.EnrollMFAStub
+	fakeReturns := fake.enrollMFAReturns
+	fake.recordInvocation("EnrollMFA", []interface{}{arg1, arg2, arg3})
+	fake.enrollMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) EnrollMFACallCount() int {
+	fake.enrollMFAMutex.RLock()
+	defer fake.enrollMFAMutex.RUnlock()
+	return len(fake.enrollMFAArgsForCall)
+}
+
+func (fake *FakeRBACRepository) EnrollMFACalls(stub func(context.Context, string, string) error) {
+	fake.enrollMFAMutex.Lock()
+	defer fake.enrollMFAMutex.Unlock()
+	fake.EnrollMFAStub = stub
+}
+
+func (fake *FakeRBACRepository) EnrollMFAArgsForCall(i int) (context.Context, string, string) {
+	fake.enrollMFAMutex.RLock()
+	defer fake.enrollMFAMutex.RUnlock()
+	argsForCall := fake.enrollMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) EnrollMFAReturns(result1 error) {
+	fake.enrollMFAMutex.Lock()
+	defer fake.enrollMFAMutex.Unlock()
+	fake.EnrollMFAStub = nil
+	fake.enrollMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) EnrollMFAReturnsOnCall(i int, result1 error) {
+	fake.enrollMFAMutex.Lock()
+	defer fake.enrollMFAMutex.Unlock()
+	fake.EnrollMFAStub = nil
+	if fake.enrollMFAReturnsOnCall == nil {
+		fake.enrollMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.enrollMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSet(arg1 context.Context, arg2 string) (internal.ExclusiveRoleSet, error) {
+	fake.exclusiveRoleSetMutex.Lock()
+	ret, specificReturn := fake.exclusiveRoleSetReturnsOnCall[len(fake.exclusiveRoleSetArgsForCall)]
+	fake.exclusiveRoleSetArgsForCall = append(fake.exclusiveRoleSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ExclusiveRoleSetStub
+	fakeReturns := fake.exclusiveRoleSetReturns
+	fake.recordInvocation("ExclusiveRoleSet", []interface{}{arg1, arg2})
+	fake.exclusiveRoleSetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetCallCount() int {
+	fake.exclusiveRoleSetMutex.RLock()
+	defer fake.exclusiveRoleSetMutex.RUnlock()
+	return len(fake.exclusiveRoleSetArgsForCall)
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetCalls(stub func(context.Context, string) (internal.ExclusiveRoleSet, error)) {
+	fake.exclusiveRoleSetMutex.Lock()
+	defer fake.exclusiveRoleSetMutex.Unlock()
+	fake.ExclusiveRoleSetStub = stub
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetArgsForCall(i int) (context.Context, string) {
+	fake.exclusiveRoleSetMutex.RLock()
+	defer fake.exclusiveRoleSetMutex.RUnlock()
+	argsForCall := fake.exclusiveRoleSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetReturns(result1 internal.ExclusiveRoleSet, result2 error) {
+	fake.exclusiveRoleSetMutex.Lock()
+	defer fake.exclusiveRoleSetMutex.Unlock()
+	fake.ExclusiveRoleSetStub = nil
+	fake.exclusiveRoleSetReturns = struct {
+		result1 internal.ExclusiveRoleSet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetReturnsOnCall(i int, result1 internal.ExclusiveRoleSet, result2 error) {
+	fake.exclusiveRoleSetMutex.Lock()
+	defer fake.exclusiveRoleSetMutex.Unlock()
+	fake.ExclusiveRoleSetStub = nil
+	if fake.exclusiveRoleSetReturnsOnCall == nil {
+		fake.exclusiveRoleSetReturnsOnCall = make(map[int]struct {
+			result1 internal.ExclusiveRoleSet
+			result2 error
+		})
+	}
+	fake.exclusiveRoleSetReturnsOnCall[i] = struct {
+		result1 internal.ExclusiveRoleSet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSets(arg1 context.Context) ([]internal.ExclusiveRoleSet, error) {
+	fake.exclusiveRoleSetsMutex.Lock()
+	ret, specificReturn := fake.exclusiveRoleSetsReturnsOnCall[len(fake.exclusiveRoleSetsArgsForCall)]
+	fake.exclusiveRoleSetsArgsForCall = append(fake.exclusiveRoleSetsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ExclusiveRoleSetsStub
+	fakeReturns := fake.exclusiveRoleSetsReturns
+	fake.recordInvocation("ExclusiveRoleSets", []interface{}{arg1})
+	fake.exclusiveRoleSetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetsCallCount() int {
+	fake.exclusiveRoleSetsMutex.RLock()
+	defer fake.exclusiveRoleSetsMutex.RUnlock()
+	return len(fake.exclusiveRoleSetsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetsCalls(stub func(context.Context) ([]internal.ExclusiveRoleSet, error)) {
+	fake.exclusiveRoleSetsMutex.Lock()
+	defer fake.exclusiveRoleSetsMutex.Unlock()
+	fake.ExclusiveRoleSetsStub = stub
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetsArgsForCall(i int) context.Context {
+	fake.exclusiveRoleSetsMutex.RLock()
+	defer fake.exclusiveRoleSetsMutex.RUnlock()
+	argsForCall := fake.exclusiveRoleSetsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetsReturns(result1 []internal.ExclusiveRoleSet, result2 error) {
+	fake.exclusiveRoleSetsMutex.Lock()
+	defer fake.exclusiveRoleSetsMutex.Unlock()
+	fake.ExclusiveRoleSetsStub = nil
+	fake.exclusiveRoleSetsReturns = struct {
+		result1 []internal.ExclusiveRoleSet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ExclusiveRoleSetsReturnsOnCall(i int, result1 []internal.ExclusiveRoleSet, result2 error) {
+	fake.exclusiveRoleSetsMutex.Lock()
+	defer fake.exclusiveRoleSetsMutex.Unlock()
+	fake.ExclusiveRoleSetsStub = nil
+	if fake.exclusiveRoleSetsReturnsOnCall == nil {
+		fake.exclusiveRoleSetsReturnsOnCall = make(map[int]struct {
+			result1 []internal.ExclusiveRoleSet
+			result2 error
+		})
+	}
+	fake.exclusiveRoleSetsReturnsOnCall[i] = struct {
+		result1 []internal.ExclusiveRoleSet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ExportPolicy(arg1 context.Context) (internal.Policy, error) {
+	fake.exportPolicyMutex.Lock()
+	ret, specificReturn := fake.exportPolicyReturnsOnCall[len(fake.exportPolicyArgsForCall)]
+	fake.exportPolicyArgsForCall = append(fake.exportPolicyArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ExportPolicyStub
+	fakeReturns := fake.exportPolicyReturns
+	fake.recordInvocation("ExportPolicy", []interface{}{arg1})
+	fake.exportPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) ExportPolicyCallCount() int {
+	fake.exportPolicyMutex.RLock()
+	defer fake.exportPolicyMutex.RUnlock()
+	return len(fake.exportPolicyArgsForCall)
+}
+
+func (fake *FakeRBACRepository) ExportPolicyCalls(stub func(context.Context) (internal.Policy, error)) {
+	fake.exportPolicyMutex.Lock()
+	defer fake.exportPolicyMutex.Unlock()
+	fake.ExportPolicyStub = stub
+}
+
+func (fake *FakeRBACRepository) ExportPolicyArgsForCall(i int) context.Context {
+	fake.exportPolicyMutex.RLock()
+	defer fake.exportPolicyMutex.RUnlock()
+	argsForCall := fake.exportPolicyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACRepository) ExportPolicyReturns(result1 internal.Policy, result2 error) {
+	fake.exportPolicyMutex.Lock()
+	defer fake.exportPolicyMutex.Unlock()
+	fake.ExportPolicyStub = nil
+	fake.exportPolicyReturns = struct {
+		result1 internal.Policy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ExportPolicyReturnsOnCall(i int, result1 internal.Policy, result2 error) {
+	fake.exportPolicyMutex.Lock()
+	defer fake.exportPolicyMutex.Unlock()
+	fake.ExportPolicyStub = nil
+	if fake.exportPolicyReturnsOnCall == nil {
+		fake.exportPolicyReturnsOnCall = make(map[int]struct {
+			result1 internal.Policy
+			result2 error
+		})
+	}
+	fake.exportPolicyReturnsOnCall[i] = struct {
+		result1 internal.Policy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) Group(arg1 context.Context, arg2 string) (internal.Group, error) {
+	fake.groupMutex.Lock()
+	ret, specificReturn := fake.groupReturnsOnCall[len(fake.groupArgsForCall)]
+	fake.groupArgsForCall = append(fake.groupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GroupStub
+	fakeReturns := fake.groupReturns
+	fake.recordInvocation("Group", []interface{}{arg1, arg2})
+	fake.groupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) GroupCallCount() int {
+	fake.groupMutex.RLock()
+	defer fake.groupMutex.RUnlock()
+	return len(fake.groupArgsForCall)
+}
+
+func (fake *FakeRBACRepository) GroupCalls(stub func(context.Context, string) (internal.Group, error)) {
+	fake.groupMutex.Lock()
+	defer fake.groupMutex.Unlock()
+	fake.GroupStub = stub
+}
+
+func (fake *FakeRBACRepository) GroupArgsForCall(i int) (context.Context, string) {
+	fake.groupMutex.RLock()
+	defer fake.groupMutex.RUnlock()
+	argsForCall := fake.groupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) GroupReturns(result1 internal.Group, result2 error) {
+	fake.groupMutex.Lock()
+	defer fake.groupMutex.Unlock()
+	fake.GroupStub = nil
+	fake.groupReturns = struct {
+		result1 internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) GroupReturnsOnCall(i int, result1 internal.Group, result2 error) {
+	fake.groupMutex.Lock()
+	defer fake.groupMutex.Unlock()
+	fake.GroupStub = nil
+	if fake.groupReturnsOnCall == nil {
+		fake.groupReturnsOnCall = make(map[int]struct {
+			result1 internal.Group
+			result2 error
+		})
+	}
+	fake.groupReturnsOnCall[i] = struct {
+		result1 internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) GroupMembers(arg1 context.Context, arg2 string) ([]internal.GroupMember, error) {
+	fake.groupMembersMutex.Lock()
+	ret, specificReturn := fake.groupMembersReturnsOnCall[len(fake.groupMembersArgsForCall)]
+	fake.groupMembersArgsForCall = append(fake.groupMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GroupMembersStub
+	fakeReturns := fake.groupMembersReturns
+	fake.recordInvocation("GroupMembers", []interface{}{arg1, arg2})
+	fake.groupMembersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) GroupMembersCallCount() int {
+	fake.groupMembersMutex.RLock()
+	defer fake.groupMembersMutex.RUnlock()
+	return len(fake.groupMembersArgsForCall)
+}
+
+func (fake *FakeRBACRepository) GroupMembersCalls(stub func(context.Context, string) ([]internal.GroupMember, error)) {
+	fake.groupMembersMutex.Lock()
+	defer fake.groupMembersMutex.Unlock()
+	fake.GroupMembersStub = stub
+}
+
+func (fake *FakeRBACRepository) GroupMembersArgsForCall(i int) (context.Context, string) {
+	fake.groupMembersMutex.RLock()
+	defer fake.groupMembersMutex.RUnlock()
+	argsForCall := fake.groupMembersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) GroupMembersReturns(result1 []internal.GroupMember, result2 error) {
+	fake.groupMembersMutex.Lock()
+	defer fake.groupMembersMutex.Unlock()
+	fake.GroupMembersStub = nil
+	fake.groupMembersReturns = struct {
+		result1 []internal.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) GroupMembersReturnsOnCall(i int, result1 []internal.GroupMember, result2 error) {
+	fake.groupMembersMutex.Lock()
+	defer fake.groupMembersMutex.Unlock()
+	fake.GroupMembersStub = nil
+	if fake.groupMembersReturnsOnCall == nil {
+		fake.groupMembersReturnsOnCall = make(map[int]struct {
+			result1 []internal.GroupMember
+			result2 error
+		})
+	}
+	fake.groupMembersReturnsOnCall[i] = struct {
+		result1 []internal.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) GroupRoleIDs(arg1 context.Context, arg2 string) ([]string, error) {
+	fake.groupRoleIDsMutex.Lock()
+	ret, specificReturn := fake.groupRoleIDsReturnsOnCall[len(fake.groupRoleIDsArgsForCall)]
+	fake.groupRoleIDsArgsForCall = append(fake.groupRoleIDsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GroupRoleIDsStub
+	fakeReturns := fake.groupRoleIDsReturns
+	fake.recordInvocation("GroupRoleIDs", []interface{}{arg1, arg2})
+	fake.groupRoleIDsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) GroupRoleIDsCallCount() int {
+	fake.groupRoleIDsMutex.RLock()
+	defer fake.groupRoleIDsMutex.RUnlock()
+	return len(fake.groupRoleIDsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) GroupRoleIDsCalls(stub func(context.Context, string) ([]string, error)) {
+	fake.groupRoleIDsMutex.Lock()
+	defer fake.groupRoleIDsMutex.Unlock()
+	fake.GroupRoleIDsStub = stub
+}
+
+func (fake *FakeRBACRepository) GroupRoleIDsArgsForCall(i int) (context.Context, string) {
+	fake.groupRoleIDsMutex.RLock()
+	defer fake.groupRoleIDsMutex.RUnlock()
+	argsForCall := fake.groupRoleIDsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) GroupRoleIDsReturns(result1 []string, result2 error) {
+	fake.groupRoleIDsMutex.Lock()
+	defer fake.groupRoleIDsMutex.Unlock()
+	fake.GroupRoleIDsStub = nil
+	fake.groupRoleIDsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) GroupRoleIDsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.groupRoleIDsMutex.Lock()
+	defer fake.groupRoleIDsMutex.Unlock()
+	fake.GroupRoleIDsStub = nil
+	if fake.groupRoleIDsReturnsOnCall == nil {
+		fake.groupRoleIDsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.groupRoleIDsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) GroupRoles(arg1 context.Context, arg2 string) ([]internal.Roles, error) {
+	fake.groupRolesMutex.Lock()
+	ret, specificReturn := fake.groupRolesReturnsOnCall[len(fake.groupRolesArgsForCall)]
+	fake.groupRolesArgsForCall = append(fake.groupRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GroupRolesStub
+	fakeReturns := fake.groupRolesReturns
+	fake.recordInvocation("GroupRoles", []interface{}{arg1, arg2})
+	fake.groupRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) GroupRolesCallCount() int {
+	fake.groupRolesMutex.RLock()
+	defer fake.groupRolesMutex.RUnlock()
+	return len(fake.groupRolesArgsForCall)
+}
+
+func (fake *FakeRBACRepository) GroupRolesCalls(stub func(context.Context, string) ([]internal.Roles, error)) {
+	fake.groupRolesMutex.Lock()
+	defer fake.groupRolesMutex.Unlock()
+	fake.GroupRolesStub = stub
+}
+
+func (fake *FakeRBACRepository) GroupRolesArgsForCall(i int) (context.Context, string) {
+	fake.groupRolesMutex.RLock()
+	defer fake.groupRolesMutex.RUnlock()
+	argsForCall := fake.groupRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) GroupRolesReturns(result1 []internal.Roles, result2 error) {
+	fake.groupRolesMutex.Lock()
+	defer fake.groupRolesMutex.Unlock()
+	fake.GroupRolesStub = nil
+	fake.groupRolesReturns = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) GroupRolesReturnsOnCall(i int, result1 []internal.Roles, result2 error) {
+	fake.groupRolesMutex.Lock()
+	defer fake.groupRolesMutex.Unlock()
+	fake.GroupRolesStub = nil
+	if fake.groupRolesReturnsOnCall == nil {
+		fake.groupRolesReturnsOnCall = make(map[int]struct {
+			result1 []internal.Roles
+			result2 error
+		})
+	}
+	fake.groupRolesReturnsOnCall[i] = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) Groups(arg1 context.Context) ([]internal.Group, error) {
+	fake.groupsMutex.Lock()
+	ret, specificReturn := fake.groupsReturnsOnCall[len(fake.groupsArgsForCall)]
+	fake.groupsArgsForCall = append(fake.groupsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GroupsStub
+	fakeReturns := fake.groupsReturns
+	fake.recordInvocation("Groups", []interface{}{arg1})
+	fake.groupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) GroupsCallCount() int {
+	fake.groupsMutex.RLock()
+	defer fake.groupsMutex.RUnlock()
+	return len(fake.groupsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) GroupsCalls(stub func(context.Context) ([]internal.Group, error)) {
+	fake.groupsMutex.Lock()
+	defer fake.groupsMutex.Unlock()
+	fake.GroupsStub = stub
+}
+
+func (fake *FakeRBACRepository) GroupsArgsForCall(i int) context.Context {
+	fake.groupsMutex.RLock()
+	defer fake.groupsMutex.RUnlock()
+	argsForCall := fake.groupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACRepository) GroupsReturns(result1 []internal.Group, result2 error) {
+	fake.groupsMutex.Lock()
+	defer fake.groupsMutex.Unlock()
+	fake.GroupsStub = nil
+	fake.groupsReturns = struct {
+		result1 []internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) GroupsReturnsOnCall(i int, result1 []internal.Group, result2 error) {
+	fake.groupsMutex.Lock()
+	defer fake.groupsMutex.Unlock()
+	fake.GroupsStub = nil
+	if fake.groupsReturnsOnCall == nil {
+		fake.groupsReturnsOnCall = make(map[int]struct {
+			result1 []internal.Group
+			result2 error
+		})
+	}
+	fake.groupsReturnsOnCall[i] = struct {
+		result1 []internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) HelpText(arg1 context.Context, arg2 string) (internal.HelpText, error) {
+	fake.helpTextMutex.Lock()
+	ret, specificReturn := fake.helpTextReturnsOnCall[len(fake.helpTextArgsForCall)]
+	fake.helpTextArgsForCall = append(fake.helpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.HelpTextStub
+	fakeReturns := fake.helpTextReturns
+	fake.recordInvocation("HelpText", []interface{}{arg1, arg2})
+	fake.helpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) HelpTextCallCount() int {
+	fake.helpTextMutex.RLock()
+	defer fake.helpTextMutex.RUnlock()
+	return len(fake.helpTextArgsForCall)
+}
+
+func (fake *FakeRBACRepository) HelpTextCalls(stub func(context.Context, string) (internal.HelpText, error)) {
+	fake.helpTextMutex.Lock()
+	defer fake.helpTextMutex.Unlock()
+	fake.HelpTextStub = stub
+}
+
+func (fake *FakeRBACRepository) HelpTextArgsForCall(i int) (context.Context, string) {
+	fake.helpTextMutex.RLock()
+	defer fake.helpTextMutex.RUnlock()
+	argsForCall := fake.helpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) HelpTextReturns(result1 internal.HelpText, result2 error) {
+	fake.helpTextMutex.Lock()
+	defer fake.helpTextMutex.Unlock()
+	fake.HelpTextStub = nil
+	fake.helpTextReturns = struct {
+		result1 internal.HelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) HelpTextReturnsOnCall(i int, result1 internal.HelpText, result2 error) {
+	fake.helpTextMutex.Lock()
+	defer fake.helpTextMutex.Unlock()
+	fake.HelpTextStub = nil
+	if fake.helpTextReturnsOnCall == nil {
+		fake.helpTextReturnsOnCall = make(map[int]struct {
+			result1 internal.HelpText
+			result2 error
+		})
+	}
+	fake.helpTextReturnsOnCall[i] = struct {
+		result1 internal.HelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDs(arg1 context.Context, arg2 string) ([]string, error) {
+	fake.inheritedRoleIDsMutex.Lock()
+	ret, specificReturn := fake.inheritedRoleIDsReturnsOnCall[len(fake.inheritedRoleIDsArgsForCall)]
+	fake.inheritedRoleIDsArgsForCall = append(fake.inheritedRoleIDsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.InheritedRoleIDsStub
+	fakeReturns := fake.inheritedRoleIDsReturns
+	fake.recordInvocation("InheritedRoleIDs", []interface{}{arg1, arg2})
+	fake.inheritedRoleIDsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsCallCount() int {
+	fake.inheritedRoleIDsMutex.RLock()
+	defer fake.inheritedRoleIDsMutex.RUnlock()
+	return len(fake.inheritedRoleIDsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsCalls(stub func(context.Context, string) ([]string, error)) {
+	fake.inheritedRoleIDsMutex.Lock()
+	defer fake.inheritedRoleIDsMutex.Unlock()
+	fake.InheritedRoleIDsStub = stub
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsArgsForCall(i int) (context.Context, string) {
+	fake.inheritedRoleIDsMutex.RLock()
+	defer fake.inheritedRoleIDsMutex.RUnlock()
+	argsForCall := fake.inheritedRoleIDsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsReturns(result1 []string, result2 error) {
+	fake.inheritedRoleIDsMutex.Lock()
+	defer fake.inheritedRoleIDsMutex.Unlock()
+	fake.InheritedRoleIDsStub = nil
+	fake.inheritedRoleIDsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.inheritedRoleIDsMutex.Lock()
+	defer fake.inheritedRoleIDsMutex.Unlock()
+	fake.InheritedRoleIDsStub = nil
+	if fake.inheritedRoleIDsReturnsOnCall == nil {
+		fake.inheritedRoleIDsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.inheritedRoleIDsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsOf(arg1 context.Context, arg2 []string) ([]string, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.inheritedRoleIDsOfMutex.Lock()
+	ret, specificReturn := fake.inheritedRoleIDsOfReturnsOnCall[len(fake.inheritedRoleIDsOfArgsForCall)]
+	fake.inheritedRoleIDsOfArgsForCall = append(fake.inheritedRoleIDsOfArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.InheritedRoleIDsOfStub
+	fakeReturns := fake.inheritedRoleIDsOfReturns
+	fake.recordInvocation("InheritedRoleIDsOf", []interface{}{arg1, arg2Copy})
+	fake.inheritedRoleIDsOfMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsOfCallCount() int {
+	fake.inheritedRoleIDsOfMutex.RLock()
+	defer fake.inheritedRoleIDsOfMutex.RUnlock()
+	return len(fake.inheritedRoleIDsOfArgsForCall)
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsOfCalls(stub func(context.Context, []string) ([]string, error)) {
+	fake.inheritedRoleIDsOfMutex.Lock()
+	defer fake.inheritedRoleIDsOfMutex.Unlock()
+	fake.InheritedRoleIDsOfStub = stub
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsOfArgsForCall(i int) (context.Context, []string) {
+	fake.inheritedRoleIDsOfMutex.RLock()
+	defer fake.inheritedRoleIDsOfMutex.RUnlock()
+	argsForCall := fake.inheritedRoleIDsOfArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsOfReturns(result1 []string, result2 error) {
+	fake.inheritedRoleIDsOfMutex.Lock()
+	defer fake.inheritedRoleIDsOfMutex.Unlock()
+	fake.InheritedRoleIDsOfStub = nil
+	fake.inheritedRoleIDsOfReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) InheritedRoleIDsOfReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.inheritedRoleIDsOfMutex.Lock()
+	defer fake.inheritedRoleIDsOfMutex.Unlock()
+	fake.InheritedRoleIDsOfStub = nil
+	if fake.inheritedRoleIDsOfReturnsOnCall == nil {
+		fake.inheritedRoleIDsOfReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.inheritedRoleIDsOfReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) InheritedRoles(arg1 context.Context, arg2 string) ([]internal.Roles, error) {
+	fake.inheritedRolesMutex.Lock()
+	ret, specificReturn := fake.inheritedRolesReturnsOnCall[len(fake.inheritedRolesArgsForCall)]
+	fake.inheritedRolesArgsForCall = append(fake.inheritedRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.InheritedRolesStub
+	fakeReturns := fake.inheritedRolesReturns
+	fake.recordInvocation("InheritedRoles", []interface{}{arg1, arg2})
+	fake.inheritedRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) InheritedRolesCallCount() int {
+	fake.inheritedRolesMutex.RLock()
+	defer fake.inheritedRolesMutex.RUnlock()
+	return len(fake.inheritedRolesArgsForCall)
+}
+
+func (fake *FakeRBACRepository) InheritedRolesCalls(stub func(context.Context, string) ([]internal.Roles, error)) {
+	fake.inheritedRolesMutex.Lock()
+	defer fake.inheritedRolesMutex.Unlock()
+	fake.InheritedRolesStub = stub
+}
+
+func (fake *FakeRBACRepository) InheritedRolesArgsForCall(i int) (context.Context, string) {
+	fake.inheritedRolesMutex.RLock()
+	defer fake.inheritedRolesMutex.RUnlock()
+	argsForCall := fake.inheritedRolesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) InheritedRolesReturns(result1 []internal.Roles, result2 error) {
+	fake.inheritedRolesMutex.Lock()
+	defer fake.inheritedRolesMutex.Unlock()
+	fake.InheritedRolesStub = nil
+	fake.inheritedRolesReturns = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) InheritedRolesReturnsOnCall(i int, result1 []internal.Roles, result2 error) {
+	fake.inheritedRolesMutex.Lock()
+	defer fake.inheritedRolesMutex.Unlock()
+	fake.InheritedRolesStub = nil
+	if fake.inheritedRolesReturnsOnCall == nil {
+		fake.inheritedRolesReturnsOnCall = make(map[int]struct {
+			result1 []internal.Roles
+			result2 error
+		})
+	}
+	fake.inheritedRolesReturnsOnCall[i] = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) IsEmergencyRole(arg1 context.Context, arg2 string) (bool, error) {
+	fake.isEmergencyRoleMutex.Lock()
+	ret, specificReturn := fake.isEmergencyRoleReturnsOnCall[len(fake.isEmergencyRoleArgsForCall)]
+	fake.isEmergencyRoleArgsForCall = append(fake.isEmergencyRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IsEmergencyRoleStub
+	fakeReturns := fake.isEmergencyRoleReturns
+	fake.recordInvocation("IsEmergencyRole", []interface{}{arg1, arg2})
+	fake.isEmergencyRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) IsEmergencyRoleCallCount() int {
+	fake.isEmergencyRoleMutex.RLock()
+	defer fake.isEmergencyRoleMutex.RUnlock()
+	return len(fake.isEmergencyRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) IsEmergencyRoleCalls(stub func(context.Context, string) (bool, error)) {
+	fake.isEmergencyRoleMutex.Lock()
+	defer fake.isEmergencyRoleMutex.Unlock()
+	fake.IsEmergencyRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) IsEmergencyRoleArgsForCall(i int) (context.Context, string) {
+	fake.isEmergencyRoleMutex.RLock()
+	defer fake.isEmergencyRoleMutex.RUnlock()
+	argsForCall := fake.isEmergencyRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) IsEmergencyRoleReturns(result1 bool, result2 error) {
+	fake.isEmergencyRoleMutex.Lock()
+	defer fake.isEmergencyRoleMutex.Unlock()
+	fake.IsEmergencyRoleStub = nil
+	fake.isEmergencyRoleReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) IsEmergencyRoleReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isEmergencyRoleMutex.Lock()
+	defer fake.isEmergencyRoleMutex.Unlock()
+	fake.IsEmergencyRoleStub = nil
+	if fake.isEmergencyRoleReturnsOnCall == nil {
+		fake.isEmergencyRoleReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isEmergencyRoleReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) IsMFARequired(arg1 context.Context, arg2 string) (bool, error) {
+	fake.isMFARequiredMutex.Lock()
+	ret, specificReturn := fake.isMFARequiredReturnsOnCall[len(fake.isMFARequiredArgsForCall)]
+	fake.isMFARequiredArgsForCall = append(fake.isMFARequiredArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IsMFARequiredStub
+	fakeReturns := fake.isMFARequiredReturns
+	fake.recordInvocation("IsMFARequired", []interface{}{arg1, arg2})
+	fake.isMFARequiredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) IsMFARequiredCallCount() int {
+	fake.isMFARequiredMutex.RLock()
+	defer fake.isMFARequiredMutex.RUnlock()
+	return len(fake.isMFARequiredArgsForCall)
+}
+
+func (fake *FakeRBACRepository) IsMFARequiredCalls(stub func(context.Context, string) (bool, error)) {
+	fake.isMFARequiredMutex.Lock()
+	defer fake.isMFARequiredMutex.Unlock()
+	fake.IsMFARequiredStub = stub
+}
+
+func (fake *FakeRBACRepository) IsMFARequiredArgsForCall(i int) (context.Context, string) {
+	fake.isMFARequiredMutex.RLock()
+	defer fake.isMFARequiredMutex.RUnlock()
+	argsForCall := fake.isMFARequiredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) IsMFARequiredReturns(result1 bool, result2 error) {
+	fake.isMFARequiredMutex.Lock()
+	defer fake.isMFARequiredMutex.Unlock()
+	fake.IsMFARequiredStub = nil
+	fake.isMFARequiredReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) IsMFARequiredReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isMFARequiredMutex.Lock()
+	defer fake.isMFARequiredMutex.Unlock()
+	fake.IsMFARequiredStub = nil
+	if fake.isMFARequiredReturnsOnCall == nil {
+		fake.isMFARequiredReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isMFARequiredReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) Login(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.loginMutex.Lock()
+	ret, specificReturn := fake.loginReturnsOnCall[len(fake.loginArgsForCall)]
+	fake.loginArgsForCall = append(fake.loginArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.LoginStub
+	fakeReturns := fake.loginReturns
+	fake.recordInvocation("Login", []interface{}{arg1, arg2, arg3})
+	fake.loginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) LoginCallCount() int {
+	fake.loginMutex.RLock()
+	defer fake.loginMutex.RUnlock()
+	return len(fake.loginArgsForCall)
+}
+
+func (fake *FakeRBACRepository) LoginCalls(stub func(context.Context, string, string) error) {
+	fake.loginMutex.Lock()
+	defer fake.loginMutex.Unlock()
+	fake.LoginStub = stub
+}
+
+func (fake *FakeRBACRepository) LoginArgsForCall(i int) (context.Context, string, string) {
+	fake.loginMutex.RLock()
+	defer fake.loginMutex.RUnlock()
+	argsForCall := fake.loginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) LoginReturns(result1 error) {
+	fake.loginMutex.Lock()
+	defer fake.loginMutex.Unlock()
+	fake.LoginStub = nil
+	fake.loginReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) LoginReturnsOnCall(i int, result1 error) {
+	fake.loginMutex.Lock()
+	defer fake.loginMutex.Unlock()
+	fake.LoginStub = nil
+	if fake.loginReturnsOnCall == nil {
+		fake.loginReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.loginReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) MFA(arg1 context.Context, arg2 string) (internal.MFA, error) {
+	fake.mFAMutex.Lock()
+	ret, specificReturn := fake.mFAReturnsOnCall[len(fake.mFAArgsForCall)]
+	fake.mFAArgsForCall = append(fake.mFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.MFAStub
+	fakeReturns := fake.mFAReturns
+	fake.recordInvocation("MFA", []interface{}{arg1, arg2})
+	fake.mFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) MFACallCount() int {
+	fake.mFAMutex.RLock()
+	defer fake.mFAMutex.RUnlock()
+	return len(fake.mFAArgsForCall)
+}
+
+func (fake *FakeRBACRepository) MFACalls(stub func(context.Context, string) (internal.MFA, error)) {
+	fake.mFAMutex.Lock()
+	defer fake.mFAMutex.Unlock()
+	fake.MFAStub = stub
+}
+
+func (fake *FakeRBACRepository) MFAArgsForCall(i int) (context.Context, string) {
+	fake.mFAMutex.RLock()
+	defer fake.mFAMutex.RUnlock()
+	argsForCall := fake.mFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) MFAReturns(result1 internal.MFA, result2 error) {
+	fake.mFAMutex.Lock()
+	defer fake.mFAMutex.Unlock()
+	fake.MFAStub = nil
+	fake.mFAReturns = struct {
+		result1 internal.MFA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) MFAReturnsOnCall(i int, result1 internal.MFA, result2 error) {
+	fake.mFAMutex.Lock()
+	defer fake.mFAMutex.Unlock()
+	fake.MFAStub = nil
+	if fake.mFAReturnsOnCall == nil {
+		fake.mFAReturnsOnCall = make(map[int]struct {
+			result1 internal.MFA
+			result2 error
+		})
+	}
+	fake.mFAReturnsOnCall[i] = struct {
+		result1 internal.MFA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) MemberGroups(arg1 context.Context, arg2 string) ([]internal.Group, error) {
+	fake.memberGroupsMutex.Lock()
+	ret, specificReturn := fake.memberGroupsReturnsOnCall[len(fake.memberGroupsArgsForCall)]
+	fake.memberGroupsArgsForCall = append(fake.memberGroupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.MemberGroupsStub
+	fakeReturns := fake.memberGroupsReturns
+	fake.recordInvocation("MemberGroups", []interface{}{arg1, arg2})
+	fake.memberGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) MemberGroupsCallCount() int {
+	fake.memberGroupsMutex.RLock()
+	defer fake.memberGroupsMutex.RUnlock()
+	return len(fake.memberGroupsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) MemberGroupsCalls(stub func(context.Context, string) ([]internal.Group, error)) {
+	fake.memberGroupsMutex.Lock()
+	defer fake.memberGroupsMutex.Unlock()
+	fake.MemberGroupsStub = stub
+}
+
+func (fake *FakeRBACRepository) MemberGroupsArgsForCall(i int) (context.Context, string) {
+	fake.memberGroupsMutex.RLock()
+	defer fake.memberGroupsMutex.RUnlock()
+	argsForCall := fake.memberGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) MemberGroupsReturns(result1 []internal.Group, result2 error) {
+	fake.memberGroupsMutex.Lock()
+	defer fake.memberGroupsMutex.Unlock()
+	fake.MemberGroupsStub = nil
+	fake.memberGroupsReturns = struct {
+		result1 []internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) MemberGroupsReturnsOnCall(i int, result1 []internal.Group, result2 error) {
+	fake.memberGroupsMutex.Lock()
+	defer fake.memberGroupsMutex.Unlock()
+	fake.MemberGroupsStub = nil
+	if fake.memberGroupsReturnsOnCall == nil {
+		fake.memberGroupsReturnsOnCall = make(map[int]struct {
+			result1 []internal.Group
+			result2 error
+		})
+	}
+	fake.memberGroupsReturnsOnCall[i] = struct {
+		result1 []internal.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) Menu(arg1 context.Context, arg2 string) (internal.Menu, error) {
+	fake.menuMutex.Lock()
+	ret, specificReturn := fake.menuReturnsOnCall[len(fake.menuArgsForCall)]
+	fake.menuArgsForCall = append(fake.menuArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.MenuStub
+	fakeReturns := fake.menuReturns
+	fake.recordInvocation("Menu", []interface{}{arg1, arg2})
+	fake.menuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) MenuCallCount() int {
+	fake.menuMutex.RLock()
+	defer fake.menuMutex.RUnlock()
+	return len(fake.menuArgsForCall)
+}
+
+func (fake *FakeRBACRepository) MenuCalls(stub func(context.Context, string) (internal.Menu, error)) {
+	fake.menuMutex.Lock()
+	defer fake.menuMutex.Unlock()
+	fake.MenuStub = stub
+}
+
+func (fake *FakeRBACRepository) MenuArgsForCall(i int) (context.Context, string) {
+	fake.menuMutex.RLock()
+	defer fake.menuMutex.RUnlock()
+	argsForCall := fake.menuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) MenuReturns(result1 internal.Menu, result2 error) {
+	fake.menuMutex.Lock()
+	defer fake.menuMutex.Unlock()
+	fake.MenuStub = nil
+	fake.menuReturns = struct {
+		result1 internal.Menu
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) MenuReturnsOnCall(i int, result1 internal.Menu, result2 error) {
+	fake.menuMutex.Lock()
+	defer fake.menuMutex.Unlock()
+	fake.MenuStub = nil
+	if fake.menuReturnsOnCall == nil {
+		fake.menuReturnsOnCall = make(map[int]struct {
+			result1 internal.Menu
+			result2 error
+		})
+	}
+	fake.menuReturnsOnCall[i] = struct {
+		result1 internal.Menu
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) Navigation(arg1 context.Context, arg2 string) (internal.Navigation, error) {
+	fake.navigationMutex.Lock()
+	ret, specificReturn := fake.navigationReturnsOnCall[len(fake.navigationArgsForCall)]
+	fake.navigationArgsForCall = append(fake.navigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.NavigationStub
+	fakeReturns := fake.navigationReturns
+	fake.recordInvocation("Navigation", []interface{}{arg1, arg2})
+	fake.navigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) NavigationCallCount() int {
+	fake.navigationMutex.RLock()
+	defer fake.navigationMutex.RUnlock()
+	return len(fake.navigationArgsForCall)
+}
+
+func (fake *FakeRBACRepository) NavigationCalls(stub func(context.Context, string) (internal.Navigation, error)) {
+	fake.navigationMutex.Lock()
+	defer fake.navigationMutex.Unlock()
+	fake.NavigationStub = stub
+}
+
+func (fake *FakeRBACRepository) NavigationArgsForCall(i int) (context.Context, string) {
+	fake.navigationMutex.RLock()
+	defer fake.navigationMutex.RUnlock()
+	argsForCall := fake.navigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) NavigationReturns(result1 internal.Navigation, result2 error) {
+	fake.navigationMutex.Lock()
+	defer fake.navigationMutex.Unlock()
+	fake.NavigationStub = nil
+	fake.navigationReturns = struct {
+		result1 internal.Navigation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) NavigationReturnsOnCall(i int, result1 internal.Navigation, result2 error) {
+	fake.navigationMutex.Lock()
+	defer fake.navigationMutex.Unlock()
+	fake.NavigationStub = nil
+	if fake.navigationReturnsOnCall == nil {
+		fake.navigationReturnsOnCall = make(map[int]struct {
+			result1 internal.Navigation
+			result2 error
+		})
+	}
+	fake.navigationReturnsOnCall[i] = struct {
+		result1 internal.Navigation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RefreshTokenByHash(arg1 context.Context, arg2 string) (internal.RefreshToken, error) {
+	fake.refreshTokenByHashMutex.Lock()
+	ret, specificReturn := fake.refreshTokenByHashReturnsOnCall[len(fake.refreshTokenByHashArgsForCall)]
+	fake.refreshTokenByHashArgsForCall = append(fake.refreshTokenByHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RefreshTokenByHashStub
+	fakeReturns := fake.refreshTokenByHashReturns
+	fake.recordInvocation("RefreshTokenByHash", []interface{}{arg1, arg2})
+	fake.refreshTokenByHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) RefreshTokenByHashCallCount() int {
+	fake.refreshTokenByHashMutex.RLock()
+	defer fake.refreshTokenByHashMutex.RUnlock()
+	return len(fake.refreshTokenByHashArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RefreshTokenByHashCalls(stub func(context.Context, string) (internal.RefreshToken, error)) {
+	fake.refreshTokenByHashMutex.Lock()
+	defer fake.refreshTokenByHashMutex.Unlock()
+	fake.RefreshTokenByHashStub = stub
+}
+
+func (fake *FakeRBACRepository) RefreshTokenByHashArgsForCall(i int) (context.Context, string) {
+	fake.refreshTokenByHashMutex.RLock()
+	defer fake.refreshTokenByHashMutex.RUnlock()
+	argsForCall := fake.refreshTokenByHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) RefreshTokenByHashReturns(result1 internal.RefreshToken, result2 error) {
+	fake.refreshTokenByHashMutex.Lock()
+	defer fake.refreshTokenByHashMutex.Unlock()
+	fake.RefreshTokenByHashStub = nil
+	fake.refreshTokenByHashReturns = struct {
+		result1 internal.RefreshToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RefreshTokenByHashReturnsOnCall(i int, result1 internal.RefreshToken, result2 error) {
+	fake.refreshTokenByHashMutex.Lock()
+	defer fake.refreshTokenByHashMutex.Unlock()
+	fake.RefreshTokenByHashStub = nil
+	if fake.refreshTokenByHashReturnsOnCall == nil {
+		fake.refreshTokenByHashReturnsOnCall = make(map[int]struct {
+			result1 internal.RefreshToken
+			result2 error
+		})
+	}
+	fake.refreshTokenByHashReturnsOnCall[i] = struct {
+		result1 internal.RefreshToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RemoveAccountTenant(arg1 context.Context, arg2 string, arg3 string) ([]string, error) {
+	fake.removeAccountTenantMutex.Lock()
+	ret, specificReturn := fake.removeAccountTenantReturnsOnCall[len(fake.removeAccountTenantArgsForCall)]
+	fake.removeAccountTenantArgsForCall = append(fake.removeAccountTenantArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveAccountTenantStub
+	fakeReturns := fake.removeAccountTenantReturns
+	fake.recordInvocation("RemoveAccountTenant", []interface{}{arg1, arg2, arg3})
+	fake.removeAccountTenantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) RemoveAccountTenantCallCount() int {
+	fake.removeAccountTenantMutex.RLock()
+	defer fake.removeAccountTenantMutex.RUnlock()
+	return len(fake.removeAccountTenantArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RemoveAccountTenantCalls(stub func(context.Context, string, string) ([]string, error)) {
+	fake.removeAccountTenantMutex.Lock()
+	defer fake.removeAccountTenantMutex.Unlock()
+	fake.RemoveAccountTenantStub = stub
+}
+
+func (fake *FakeRBACRepository) RemoveAccountTenantArgsForCall(i int) (context.Context, string, string) {
+	fake.removeAccountTenantMutex.RLock()
+	defer fake.removeAccountTenantMutex.RUnlock()
+	argsForCall := fake.removeAccountTenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) RemoveAccountTenantReturns(result1 []string, result2 error) {
+	fake.removeAccountTenantMutex.Lock()
+	defer fake.removeAccountTenantMutex.Unlock()
+	fake.RemoveAccountTenantStub = nil
+	fake.removeAccountTenantReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RemoveAccountTenantReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.removeAccountTenantMutex.Lock()
+	defer fake.removeAccountTenantMutex.Unlock()
+	fake.RemoveAccountTenantStub = nil
+	if fake.removeAccountTenantReturnsOnCall == nil {
+		fake.removeAccountTenantReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.removeAccountTenantReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RemoveGroupMember(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.removeGroupMemberMutex.Lock()
+	ret, specificReturn := fake.removeGroupMemberReturnsOnCall[len(fake.removeGroupMemberArgsForCall)]
+	fake.removeGroupMemberArgsForCall = append(fake.removeGroupMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveGroupMemberStub
+	fakeReturns := fake.removeGroupMemberReturns
+	fake.recordInvocation("RemoveGroupMember", []interface{}{arg1, arg2, arg3})
+	fake.removeGroupMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) RemoveGroupMemberCallCount() int {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return len(fake.removeGroupMemberArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RemoveGroupMemberCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = stub
+}
+
+func (fake *FakeRBACRepository) RemoveGroupMemberArgsForCall(i int) (context.Context, string, string) {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	argsForCall := fake.removeGroupMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) RemoveGroupMemberReturns(result1 string, result2 error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = nil
+	fake.removeGroupMemberReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RemoveGroupMemberReturnsOnCall(i int, result1 string, result2 error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = nil
+	if fake.removeGroupMemberReturnsOnCall == nil {
+		fake.removeGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.removeGroupMemberReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RemoveGroupRole(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.removeGroupRoleMutex.Lock()
+	ret, specificReturn := fake.removeGroupRoleReturnsOnCall[len(fake.removeGroupRoleArgsForCall)]
+	fake.removeGroupRoleArgsForCall = append(fake.removeGroupRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveGroupRoleStub
+	fakeReturns := fake.removeGroupRoleReturns
+	fake.recordInvocation("RemoveGroupRole", []interface{}{arg1, arg2, arg3})
+	fake.removeGroupRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) RemoveGroupRoleCallCount() int {
+	fake.removeGroupRoleMutex.RLock()
+	defer fake.removeGroupRoleMutex.RUnlock()
+	return len(fake.removeGroupRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RemoveGroupRoleCalls(stub func(context.Context, string, string) error) {
+	fake.removeGroupRoleMutex.Lock()
+	defer fake.removeGroupRoleMutex.Unlock()
+	fake.RemoveGroupRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) RemoveGroupRoleArgsForCall(i int) (context.Context, string, string) {
+	fake.removeGroupRoleMutex.RLock()
+	defer fake.removeGroupRoleMutex.RUnlock()
+	argsForCall := fake.removeGroupRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) RemoveGroupRoleReturns(result1 error) {
+	fake.removeGroupRoleMutex.Lock()
+	defer fake.removeGroupRoleMutex.Unlock()
+	fake.RemoveGroupRoleStub = nil
+	fake.removeGroupRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RemoveGroupRoleReturnsOnCall(i int, result1 error) {
+	fake.removeGroupRoleMutex.Lock()
+	defer fake.removeGroupRoleMutex.Unlock()
+	fake.RemoveGroupRoleStub = nil
+	if fake.removeGroupRoleReturnsOnCall == nil {
+		fake.removeGroupRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeGroupRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RemoveMemberGroup(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.removeMemberGroupMutex.Lock()
+	ret, specificReturn := fake.removeMemberGroupReturnsOnCall[len(fake.removeMemberGroupArgsForCall)]
+	fake.removeMemberGroupArgsForCall = append(fake.removeMemberGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveMemberGroupStub
+	fakeReturns := fake.removeMemberGroupReturns
+	fake.recordInvocation("RemoveMemberGroup", []interface{}{arg1, arg2, arg3})
+	fake.removeMemberGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) RemoveMemberGroupCallCount() int {
+	fake.removeMemberGroupMutex.RLock()
+	defer fake.removeMemberGroupMutex.RUnlock()
+	return len(fake.removeMemberGroupArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RemoveMemberGroupCalls(stub func(context.Context, string, string) error) {
+	fake.removeMemberGroupMutex.Lock()
+	defer fake.removeMemberGroupMutex.Unlock()
+	fake.RemoveMemberGroupStub = stub
+}
+
+func (fake *FakeRBACRepository) RemoveMemberGroupArgsForCall(i int) (context.Context, string, string) {
+	fake.removeMemberGroupMutex.RLock()
+	defer fake.removeMemberGroupMutex.RUnlock()
+	argsForCall := fake.removeMemberGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) RemoveMemberGroupReturns(result1 error) {
+	fake.removeMemberGroupMutex.Lock()
+	defer fake.removeMemberGroupMutex.Unlock()
+	fake.RemoveMemberGroupStub = nil
+	fake.removeMemberGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RemoveMemberGroupReturnsOnCall(i int, result1 error) {
+	fake.removeMemberGroupMutex.Lock()
+	defer fake.removeMemberGroupMutex.Unlock()
+	fake.RemoveMemberGroupStub = nil
+	if fake.removeMemberGroupReturnsOnCall == nil {
+		fake.removeMemberGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeMemberGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RemoveRoleApprover(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.removeRoleApproverMutex.Lock()
+	ret, specificReturn := fake.removeRoleApproverReturnsOnCall[len(fake.removeRoleApproverArgsForCall)]
+	fake.removeRoleApproverArgsForCall = append(fake.removeRoleApproverArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveRoleApproverStub
+	fakeReturns := fake.removeRoleApproverReturns
+	fake.recordInvocation("RemoveRoleApprover", []interface{}{arg1, arg2, arg3})
+	fake.removeRoleApproverMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) RemoveRoleApproverCallCount() int {
+	fake.removeRoleApproverMutex.RLock()
+	defer fake.removeRoleApproverMutex.RUnlock()
+	return len(fake.removeRoleApproverArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RemoveRoleApproverCalls(stub func(context.Context, string, string) error) {
+	fake.removeRoleApproverMutex.Lock()
+	defer fake.removeRoleApproverMutex.Unlock()
+	fake.RemoveRoleApproverStub = stub
+}
+
+func (fake *FakeRBACRepository) RemoveRoleApproverArgsForCall(i int) (context.Context, string, string) {
+	fake.removeRoleApproverMutex.RLock()
+	defer fake.removeRoleApproverMutex.RUnlock()
+	argsForCall := fake.removeRoleApproverArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) RemoveRoleApproverReturns(result1 error) {
+	fake.removeRoleApproverMutex.Lock()
+	defer fake.removeRoleApproverMutex.Unlock()
+	fake.RemoveRoleApproverStub = nil
+	fake.removeRoleApproverReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RemoveRoleApproverReturnsOnCall(i int, result1 error) {
+	fake.removeRoleApproverMutex.Lock()
+	defer fake.removeRoleApproverMutex.Unlock()
+	fake.RemoveRoleApproverStub = nil
+	if fake.removeRoleApproverReturnsOnCall == nil {
+		fake.removeRoleApproverReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeRoleApproverReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) ResetPassword(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.resetPasswordMutex.Lock()
+	ret, specificReturn := fake.resetPasswordReturnsOnCall[len(fake.resetPasswordArgsForCall)]
+	fake.resetPasswordArgsForCall = append(fake.resetPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResetPasswordStub
+	fakeReturns := fake.resetPasswordReturns
+	fake.recordInvocation("ResetPassword", []interface{}{arg1, arg2, arg3})
+	fake.resetPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) ResetPasswordCallCount() int {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	return len(fake.resetPasswordArgsForCall)
+}
+
+func (fake *FakeRBACRepository) ResetPasswordCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = stub
+}
+
+func (fake *FakeRBACRepository) ResetPasswordArgsForCall(i int) (context.Context, string, string) {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	argsForCall := fake.resetPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) ResetPasswordReturns(result1 string, result2 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	fake.resetPasswordReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) ResetPasswordReturnsOnCall(i int, result1 string, result2 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	if fake.resetPasswordReturnsOnCall == nil {
+		fake.resetPasswordReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.resetPasswordReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RestrictedGrants(arg1 context.Context, arg2 string, arg3 []string) ([]internal.RestrictedGrant, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.restrictedGrantsMutex.Lock()
+	ret, specificReturn := fake.restrictedGrantsReturnsOnCall[len(fake.restrictedGrantsArgsForCall)]
+	fake.restrictedGrantsArgsForCall = append(fake.restrictedGrantsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.RestrictedGrantsStub
+	fakeReturns := fake.restrictedGrantsReturns
+	fake.recordInvocation("RestrictedGrants", []interface{}{arg1, arg2, arg3Copy})
+	fake.restrictedGrantsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) RestrictedGrantsCallCount() int {
+	fake.restrictedGrantsMutex.RLock()
+	defer fake.restrictedGrantsMutex.RUnlock()
+	return len(fake.restrictedGrantsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RestrictedGrantsCalls(stub func(context.Context, string, []string) ([]internal.RestrictedGrant, error)) {
+	fake.restrictedGrantsMutex.Lock()
+	defer fake.restrictedGrantsMutex.Unlock()
+	fake.RestrictedGrantsStub = stub
+}
+
+func (fake *FakeRBACRepository) RestrictedGrantsArgsForCall(i int) (context.Context, string, []string) {
+	fake.restrictedGrantsMutex.RLock()
+	defer fake.restrictedGrantsMutex.RUnlock()
+	argsForCall := fake.restrictedGrantsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) RestrictedGrantsReturns(result1 []internal.RestrictedGrant, result2 error) {
+	fake.restrictedGrantsMutex.Lock()
+	defer fake.restrictedGrantsMutex.Unlock()
+	fake.RestrictedGrantsStub = nil
+	fake.restrictedGrantsReturns = struct {
+		result1 []internal.RestrictedGrant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RestrictedGrantsReturnsOnCall(i int, result1 []internal.RestrictedGrant, result2 error) {
+	fake.restrictedGrantsMutex.Lock()
+	defer fake.restrictedGrantsMutex.Unlock()
+	fake.RestrictedGrantsStub = nil
+	if fake.restrictedGrantsReturnsOnCall == nil {
+		fake.restrictedGrantsReturnsOnCall = make(map[int]struct {
+			result1 []internal.RestrictedGrant
+			result2 error
+		})
+	}
+	fake.restrictedGrantsReturnsOnCall[i] = struct {
+		result1 []internal.RestrictedGrant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RevokeAPIKey(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.revokeAPIKeyMutex.Lock()
+	ret, specificReturn := fake.revokeAPIKeyReturnsOnCall[len(fake.revokeAPIKeyArgsForCall)]
+	fake.revokeAPIKeyArgsForCall = append(fake.revokeAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RevokeAPIKeyStub
+	fakeReturns := fake.revokeAPIKeyReturns
+	fake.recordInvocation("RevokeAPIKey", []interface{}{arg1, arg2, arg3})
+	fake.revokeAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) RevokeAPIKeyCallCount() int {
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	return len(fake.revokeAPIKeyArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RevokeAPIKeyCalls(stub func(context.Context, string, string) error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = stub
+}
+
+func (fake *FakeRBACRepository) RevokeAPIKeyArgsForCall(i int) (context.Context, string, string) {
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	argsForCall := fake.revokeAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) RevokeAPIKeyReturns(result1 error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = nil
+	fake.revokeAPIKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RevokeAPIKeyReturnsOnCall(i int, result1 error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = nil
+	if fake.revokeAPIKeyReturnsOnCall == nil {
+		fake.revokeAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeAPIKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RevokeAccountRefreshTokens(arg1 context.Context, arg2 string) error {
+	fake.revokeAccountRefreshTokensMutex.Lock()
+	ret, specificReturn := fake.revokeAccountRefreshTokensReturnsOnCall[len(fake.revokeAccountRefreshTokensArgsForCall)]
+	fake.revokeAccountRefreshTokensArgsForCall = append(fake.revokeAccountRefreshTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RevokeAccountRefreshTokensStub
+	fakeReturns := fake.revokeAccountRefreshTokensReturns
+	fake.recordInvocation("RevokeAccountRefreshTokens", []interface{}{arg1, arg2})
+	fake.revokeAccountRefreshTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) RevokeAccountRefreshTokensCallCount() int {
+	fake.revokeAccountRefreshTokensMutex.RLock()
+	defer fake.revokeAccountRefreshTokensMutex.RUnlock()
+	return len(fake.revokeAccountRefreshTokensArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RevokeAccountRefreshTokensCalls(stub func(context.Context, string) error) {
+	fake.revokeAccountRefreshTokensMutex.Lock()
+	defer fake.revokeAccountRefreshTokensMutex.Unlock()
+	fake.RevokeAccountRefreshTokensStub = stub
+}
+
+func (fake *FakeRBACRepository) RevokeAccountRefreshTokensArgsForCall(i int) (context.Context, string) {
+	fake.revokeAccountRefreshTokensMutex.RLock()
+	defer fake.revokeAccountRefreshTokensMutex.RUnlock()
+	argsForCall := fake.revokeAccountRefreshTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) RevokeAccountRefreshTokensReturns(result1 error) {
+	fake.revokeAccountRefreshTokensMutex.Lock()
+	defer fake.revokeAccountRefreshTokensMutex.Unlock()
+	fake.RevokeAccountRefreshTokensStub = nil
+	fake.revokeAccountRefreshTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RevokeAccountRefreshTokensReturnsOnCall(i int, result1 error) {
+	fake.revokeAccountRefreshTokensMutex.Lock()
+	defer fake.revokeAccountRefreshTokensMutex.Unlock()
+	fake.RevokeAccountRefreshTokensStub = nil
+	if fake.revokeAccountRefreshTokensReturnsOnCall == nil {
+		fake.revokeAccountRefreshTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeAccountRefreshTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RevokeRefreshTokenFamily(arg1 context.Context, arg2 string) error {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	ret, specificReturn := fake.revokeRefreshTokenFamilyReturnsOnCall[len(fake.revokeRefreshTokenFamilyArgsForCall)]
+	fake.revokeRefreshTokenFamilyArgsForCall = append(fake.revokeRefreshTokenFamilyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RevokeRefreshTokenFamilyStub
+	fakeReturns := fake.revokeRefreshTokenFamilyReturns
+	fake.recordInvocation("RevokeRefreshTokenFamily", []interface{}{arg1, arg2})
+	fake.revokeRefreshTokenFamilyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) RevokeRefreshTokenFamilyCallCount() int {
+	fake.revokeRefreshTokenFamilyMutex.RLock()
+	defer fake.revokeRefreshTokenFamilyMutex.RUnlock()
+	return len(fake.revokeRefreshTokenFamilyArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RevokeRefreshTokenFamilyCalls(stub func(context.Context, string) error) {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	defer fake.revokeRefreshTokenFamilyMutex.Unlock()
+	fake.RevokeRefreshTokenFamilyStub = stub
+}
+
+func (fake *FakeRBACRepository) RevokeRefreshTokenFamilyArgsForCall(i int) (context.Context, string) {
+	fake.revokeRefreshTokenFamilyMutex.RLock()
+	defer fake.revokeRefreshTokenFamilyMutex.RUnlock()
+	argsForCall := fake.revokeRefreshTokenFamilyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) RevokeRefreshTokenFamilyReturns(result1 error) {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	defer fake.revokeRefreshTokenFamilyMutex.Unlock()
+	fake.RevokeRefreshTokenFamilyStub = nil
+	fake.revokeRefreshTokenFamilyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) RevokeRefreshTokenFamilyReturnsOnCall(i int, result1 error) {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	defer fake.revokeRefreshTokenFamilyMutex.Unlock()
+	fake.RevokeRefreshTokenFamilyStub = nil
+	if fake.revokeRefreshTokenFamilyReturnsOnCall == nil {
+		fake.revokeRefreshTokenFamilyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeRefreshTokenFamilyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) Role(arg1 context.Context, arg2 string) (internal.Roles, error) {
+	fake.roleMutex.Lock()
+	ret, specificReturn := fake.roleReturnsOnCall[len(fake.roleArgsForCall)]
+	fake.roleArgsForCall = append(fake.roleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RoleStub
+	fakeReturns := fake.roleReturns
+	fake.recordInvocation("Role", []interface{}{arg1, arg2})
+	fake.roleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) RoleCallCount() int {
+	fake.roleMutex.RLock()
+	defer fake.roleMutex.RUnlock()
+	return len(fake.roleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RoleCalls(stub func(context.Context, string) (internal.Roles, error)) {
+	fake.roleMutex.Lock()
+	defer fake.roleMutex.Unlock()
+	fake.RoleStub = stub
+}
+
+func (fake *FakeRBACRepository) RoleArgsForCall(i int) (context.Context, string) {
+	fake.roleMutex.RLock()
+	defer fake.roleMutex.RUnlock()
+	argsForCall := fake.roleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) RoleReturns(result1 internal.Roles, result2 error) {
+	fake.roleMutex.Lock()
+	defer fake.roleMutex.Unlock()
+	fake.RoleStub = nil
+	fake.roleReturns = struct {
+		result1 internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RoleReturnsOnCall(i int, result1 internal.Roles, result2 error) {
+	fake.roleMutex.Lock()
+	defer fake.roleMutex.Unlock()
+	fake.RoleStub = nil
+	if fake.roleReturnsOnCall == nil {
+		fake.roleReturnsOnCall = make(map[int]struct {
+			result1 internal.Roles
+			result2 error
+		})
+	}
+	fake.roleReturnsOnCall[i] = struct {
+		result1 internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RoleApprovers(arg1 context.Context, arg2 string) ([]internal.Roles, error) {
+	fake.roleApproversMutex.Lock()
+	ret, specificReturn := fake.roleApproversReturnsOnCall[len(fake.roleApproversArgsForCall)]
+	fake.roleApproversArgsForCall = append(fake.roleApproversArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RoleApproversStub
+	fakeReturns := fake.roleApproversReturns
+	fake.recordInvocation("RoleApprovers", []interface{}{arg1, arg2})
+	fake.roleApproversMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) RoleApproversCallCount() int {
+	fake.roleApproversMutex.RLock()
+	defer fake.roleApproversMutex.RUnlock()
+	return len(fake.roleApproversArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RoleApproversCalls(stub func(context.Context, string) ([]internal.Roles, error)) {
+	fake.roleApproversMutex.Lock()
+	defer fake.roleApproversMutex.Unlock()
+	fake.RoleApproversStub = stub
+}
+
+func (fake *FakeRBACRepository) RoleApproversArgsForCall(i int) (context.Context, string) {
+	fake.roleApproversMutex.RLock()
+	defer fake.roleApproversMutex.RUnlock()
+	argsForCall := fake.roleApproversArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) RoleApproversReturns(result1 []internal.Roles, result2 error) {
+	fake.roleApproversMutex.Lock()
+	defer fake.roleApproversMutex.Unlock()
+	fake.RoleApproversStub = nil
+	fake.roleApproversReturns = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RoleApproversReturnsOnCall(i int, result1 []internal.Roles, result2 error) {
+	fake.roleApproversMutex.Lock()
+	defer fake.roleApproversMutex.Unlock()
+	fake.RoleApproversStub = nil
+	if fake.roleApproversReturnsOnCall == nil {
+		fake.roleApproversReturnsOnCall = make(map[int]struct {
+			result1 []internal.Roles
+			result2 error
+		})
+	}
+	fake.roleApproversReturnsOnCall[i] = struct {
+		result1 []internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RoleConflicts(arg1 context.Context) ([]internal.RoleConflict, error) {
+	fake.roleConflictsMutex.Lock()
+	ret, specificReturn := fake.roleConflictsReturnsOnCall[len(fake.roleConflictsArgsForCall)]
+	fake.roleConflictsArgsForCall = append(fake.roleConflictsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.RoleConflictsStub
+	fakeReturns := fake.roleConflictsReturns
+	fake.recordInvocation("RoleConflicts", []interface{}{arg1})
+	fake.roleConflictsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) RoleConflictsCallCount() int {
+	fake.roleConflictsMutex.RLock()
+	defer fake.roleConflictsMutex.RUnlock()
+	return len(fake.roleConflictsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RoleConflictsCalls(stub func(context.Context) ([]internal.RoleConflict, error)) {
+	fake.roleConflictsMutex.Lock()
+	defer fake.roleConflictsMutex.Unlock()
+	fake.RoleConflictsStub = stub
+}
+
+func (fake *FakeRBACRepository) RoleConflictsArgsForCall(i int) context.Context {
+	fake.roleConflictsMutex.RLock()
+	defer fake.roleConflictsMutex.RUnlock()
+	argsForCall := fake.roleConflictsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACRepository) RoleConflictsReturns(result1 []internal.RoleConflict, result2 error) {
+	fake.roleConflictsMutex.Lock()
+	defer fake.roleConflictsMutex.Unlock()
+	fake.RoleConflictsStub = nil
+	fake.roleConflictsReturns = struct {
+		result1 []internal.RoleConflict
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RoleConflictsReturnsOnCall(i int, result1 []internal.RoleConflict, result2 error) {
+	fake.roleConflictsMutex.Lock()
+	defer fake.roleConflictsMutex.Unlock()
+	fake.RoleConflictsStub = nil
+	if fake.roleConflictsReturnsOnCall == nil {
+		fake.roleConflictsReturnsOnCall = make(map[int]struct {
+			result1 []internal.RoleConflict
+			result2 error
+		})
+	}
+	fake.roleConflictsReturnsOnCall[i] = struct {
+		result1 []internal.RoleConflict
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RoleTask(arg1 context.Context, arg2 string) (internal.RoleTasks, error) {
+	fake.roleTaskMutex.Lock()
+	ret, specificReturn := fake.roleTaskReturnsOnCall[len(fake.roleTaskArgsForCall)]
+	fake.roleTaskArgsForCall = append(fake.roleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RoleTaskStub
+	fakeReturns := fake.roleTaskReturns
+	fake.recordInvocation("RoleTask", []interface{}{arg1, arg2})
+	fake.roleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) RoleTaskCallCount() int {
+	fake.roleTaskMutex.RLock()
+	defer fake.roleTaskMutex.RUnlock()
+	return len(fake.roleTaskArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RoleTaskCalls(stub func(context.Context, string) (internal.RoleTasks, error)) {
+	fake.roleTaskMutex.Lock()
+	defer fake.roleTaskMutex.Unlock()
+	fake.RoleTaskStub = stub
+}
+
+func (fake *FakeRBACRepository) RoleTaskArgsForCall(i int) (context.Context, string) {
+	fake.roleTaskMutex.RLock()
+	defer fake.roleTaskMutex.RUnlock()
+	argsForCall := fake.roleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) RoleTaskReturns(result1 internal.RoleTasks, result2 error) {
+	fake.roleTaskMutex.Lock()
+	defer fake.roleTaskMutex.Unlock()
+	fake.RoleTaskStub = nil
+	fake.roleTaskReturns = struct {
+		result1 internal.RoleTasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RoleTaskReturnsOnCall(i int, result1 internal.RoleTasks, result2 error) {
+	fake.roleTaskMutex.Lock()
+	defer fake.roleTaskMutex.Unlock()
+	fake.RoleTaskStub = nil
+	if fake.roleTaskReturnsOnCall == nil {
+		fake.roleTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.RoleTasks
+			result2 error
+		})
+	}
+	fake.roleTaskReturnsOnCall[i] = struct {
+		result1 internal.RoleTasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RotateRefreshToken(arg1 context.Context, arg2 internal.RefreshToken, arg3 string, arg4 time.Time) (string, error) {
+	fake.rotateRefreshTokenMutex.Lock()
+	ret, specificReturn := fake.rotateRefreshTokenReturnsOnCall[len(fake.rotateRefreshTokenArgsForCall)]
+	fake.rotateRefreshTokenArgsForCall = append(fake.rotateRefreshTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.RefreshToken
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RotateRefreshTokenStub
+	fakeReturns := fake.rotateRefreshTokenReturns
+	fake.recordInvocation("RotateRefreshToken", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rotateRefreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) RotateRefreshTokenCallCount() int {
+	fake.rotateRefreshTokenMutex.RLock()
+	defer fake.rotateRefreshTokenMutex.RUnlock()
+	return len(fake.rotateRefreshTokenArgsForCall)
+}
+
+func (fake *FakeRBACRepository) RotateRefreshTokenCalls(stub func(context.Context, internal.RefreshToken, string, time.Time) (string, error)) {
+	fake.rotateRefreshTokenMutex.Lock()
+	defer fake.rotateRefreshTokenMutex.Unlock()
+	fake.RotateRefreshTokenStub = stub
+}
+
+func (fake *FakeRBACRepository) RotateRefreshTokenArgsForCall(i int) (context.Context, internal.RefreshToken, string, time.Time) {
+	fake.rotateRefreshTokenMutex.RLock()
+	defer fake.rotateRefreshTokenMutex.RUnlock()
+	argsForCall := fake.rotateRefreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACRepository) RotateRefreshTokenReturns(result1 string, result2 error) {
+	fake.rotateRefreshTokenMutex.Lock()
+	defer fake.rotateRefreshTokenMutex.Unlock()
+	fake.RotateRefreshTokenStub = nil
+	fake.rotateRefreshTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) RotateRefreshTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.rotateRefreshTokenMutex.Lock()
+	defer fake.rotateRefreshTokenMutex.Unlock()
+	fake.RotateRefreshTokenStub = nil
+	if fake.rotateRefreshTokenReturnsOnCall == nil {
+		fake.rotateRefreshTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.rotateRefreshTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) SetActiveTenant(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.setActiveTenantMutex.Lock()
+	ret, specificReturn := fake.setActiveTenantReturnsOnCall[len(fake.setActiveTenantArgsForCall)]
+	fake.setActiveTenantArgsForCall = append(fake.setActiveTenantArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SetActiveTenantStub
+	fakeReturns := fake.setActiveTenantReturns
+	fake.recordInvocation("SetActiveTenant", []interface{}{arg1, arg2, arg3})
+	fake.setActiveTenantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) SetActiveTenantCallCount() int {
+	fake.setActiveTenantMutex.RLock()
+	defer fake.setActiveTenantMutex.RUnlock()
+	return len(fake.setActiveTenantArgsForCall)
+}
+
+func (fake *FakeRBACRepository) SetActiveTenantCalls(stub func(context.Context, string, string) error) {
+	fake.setActiveTenantMutex.Lock()
+	defer fake.setActiveTenantMutex.Unlock()
+	fake.SetActiveTenantStub = stub
+}
+
+func (fake *FakeRBACRepository) SetActiveTenantArgsForCall(i int) (context.Context, string, string) {
+	fake.setActiveTenantMutex.RLock()
+	defer fake.setActiveTenantMutex.RUnlock()
+	argsForCall := fake.setActiveTenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) SetActiveTenantReturns(result1 error) {
+	fake.setActiveTenantMutex.Lock()
+	defer fake.setActiveTenantMutex.Unlock()
+	fake.SetActiveTenantStub = nil
+	fake.setActiveTenantReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) SetActiveTenantReturnsOnCall(i int, result1 error) {
+	fake.setActiveTenantMutex.Lock()
+	defer fake.setActiveTenantMutex.Unlock()
+	fake.SetActiveTenantStub = nil
+	if fake.setActiveTenantReturnsOnCall == nil {
+		fake.setActiveTenantReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setActiveTenantReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) SetEmergencyRole(arg1 context.Context, arg2 string, arg3 bool) error {
+	fake.setEmergencyRoleMutex.Lock()
+	ret, specificReturn := fake.setEmergencyRoleReturnsOnCall[len(fake.setEmergencyRoleArgsForCall)]
+	fake.setEmergencyRoleArgsForCall = append(fake.setEmergencyRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.SetEmergencyRoleStub
+	fakeReturns := fake.setEmergencyRoleReturns
+	fake.recordInvocation("SetEmergencyRole", []interface{}{arg1, arg2, arg3})
+	fake.setEmergencyRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) SetEmergencyRoleCallCount() int {
+	fake.setEmergencyRoleMutex.RLock()
+	defer fake.setEmergencyRoleMutex.RUnlock()
+	return len(fake.setEmergencyRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) SetEmergencyRoleCalls(stub func(context.Context, string, bool) error) {
+	fake.setEmergencyRoleMutex.Lock()
+	defer fake.setEmergencyRoleMutex.Unlock()
+	fake.SetEmergencyRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) SetEmergencyRoleArgsForCall(i int) (context.Context, string, bool) {
+	fake.setEmergencyRoleMutex.RLock()
+	defer fake.setEmergencyRoleMutex.RUnlock()
+	argsForCall := fake.setEmergencyRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) SetEmergencyRoleReturns(result1 error) {
+	fake.setEmergencyRoleMutex.Lock()
+	defer fake.setEmergencyRoleMutex.Unlock()
+	fake.SetEmergencyRoleStub = nil
+	fake.setEmergencyRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) SetEmergencyRoleReturnsOnCall(i int, result1 error) {
+	fake.setEmergencyRoleMutex.Lock()
+	defer fake.setEmergencyRoleMutex.Unlock()
+	fake.SetEmergencyRoleStub = nil
+	if fake.setEmergencyRoleReturnsOnCall == nil {
+		fake.setEmergencyRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setEmergencyRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) SetRoleMFARequired(arg1 context.Context, arg2 string, arg3 bool) error {
+	fake.setRoleMFARequiredMutex.Lock()
+	ret, specificReturn := fake.setRoleMFARequiredReturnsOnCall[len(fake.setRoleMFARequiredArgsForCall)]
+	fake.setRoleMFARequiredArgsForCall = append(fake.setRoleMFARequiredArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.SetRoleMFARequiredStub
+	fakeReturns := fake.setRoleMFARequiredReturns
+	fake.recordInvocation("SetRoleMFARequired", []interface{}{arg1, arg2, arg3})
+	fake.setRoleMFARequiredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) SetRoleMFARequiredCallCount() int {
+	fake.setRoleMFARequiredMutex.RLock()
+	defer fake.setRoleMFARequiredMutex.RUnlock()
+	return len(fake.setRoleMFARequiredArgsForCall)
+}
+
+func (fake *FakeRBACRepository) SetRoleMFARequiredCalls(stub func(context.Context, string, bool) error) {
+	fake.setRoleMFARequiredMutex.Lock()
+	defer fake.setRoleMFARequiredMutex.Unlock()
+	fake.SetRoleMFARequiredStub = stub
+}
+
+func (fake *FakeRBACRepository) SetRoleMFARequiredArgsForCall(i int) (context.Context, string, bool) {
+	fake.setRoleMFARequiredMutex.RLock()
+	defer fake.setRoleMFARequiredMutex.RUnlock()
+	argsForCall := fake.setRoleMFARequiredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) SetRoleMFARequiredReturns(result1 error) {
+	fake.setRoleMFARequiredMutex.Lock()
+	defer fake.setRoleMFARequiredMutex.Unlock()
+	fake.SetRoleMFARequiredStub = nil
+	fake.setRoleMFARequiredReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) SetRoleMFARequiredReturnsOnCall(i int, result1 error) {
+	fake.setRoleMFARequiredMutex.Lock()
+	defer fake.setRoleMFARequiredMutex.Unlock()
+	fake.SetRoleMFARequiredStub = nil
+	if fake.setRoleMFARequiredReturnsOnCall == nil {
+		fake.setRoleMFARequiredReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRoleMFARequiredReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) Task(arg1 context.Context, arg2 string) (internal.Tasks, error) {
+	fake.taskMutex.Lock()
+	ret, specificReturn := fake.taskReturnsOnCall[len(fake.taskArgsForCall)]
+	fake.taskArgsForCall = append(fake.taskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TaskStub
+	fakeReturns := fake.taskReturns
+	fake.recordInvocation("Task", []interface{}{arg1, arg2})
+	fake.taskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) TaskCallCount() int {
+	fake.taskMutex.RLock()
+	defer fake.taskMutex.RUnlock()
+	return len(fake.taskArgsForCall)
+}
+
+func (fake *FakeRBACRepository) TaskCalls(stub func(context.Context, string) (internal.Tasks, error)) {
+	fake.taskMutex.Lock()
+	defer fake.taskMutex.Unlock()
+	fake.TaskStub = stub
+}
+
+func (fake *FakeRBACRepository) TaskArgsForCall(i int) (context.Context, string) {
+	fake.taskMutex.RLock()
+	defer fake.taskMutex.RUnlock()
+	argsForCall := fake.taskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) TaskReturns(result1 internal.Tasks, result2 error) {
+	fake.taskMutex.Lock()
+	defer fake.taskMutex.Unlock()
+	fake.TaskStub = nil
+	fake.taskReturns = struct {
+		result1 internal.Tasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) TaskReturnsOnCall(i int, result1 internal.Tasks, result2 error) {
+	fake.taskMutex.Lock()
+	defer fake.taskMutex.Unlock()
+	fake.TaskStub = nil
+	if fake.taskReturnsOnCall == nil {
+		fake.taskReturnsOnCall = make(map[int]struct {
+			result1 internal.Tasks
+			result2 error
+		})
+	}
+	fake.taskReturnsOnCall[i] = struct {
+		result1 internal.Tasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) TaskHolders(arg1 context.Context, arg2 string) (internal.TaskHolders, error) {
+	fake.taskHoldersMutex.Lock()
+	ret, specificReturn := fake.taskHoldersReturnsOnCall[len(fake.taskHoldersArgsForCall)]
+	fake.taskHoldersArgsForCall = append(fake.taskHoldersArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TaskHoldersStub
+	fakeReturns := fake.taskHoldersReturns
+	fake.recordInvocation("TaskHolders", []interface{}{arg1, arg2})
+	fake.taskHoldersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) TaskHoldersCallCount() int {
+	fake.taskHoldersMutex.RLock()
+	defer fake.taskHoldersMutex.RUnlock()
+	return len(fake.taskHoldersArgsForCall)
+}
+
+func (fake *FakeRBACRepository) TaskHoldersCalls(stub func(context.Context, string) (internal.TaskHolders, error)) {
+	fake.taskHoldersMutex.Lock()
+	defer fake.taskHoldersMutex.Unlock()
+	fake.TaskHoldersStub = stub
+}
+
+func (fake *FakeRBACRepository) TaskHoldersArgsForCall(i int) (context.Context, string) {
+	fake.taskHoldersMutex.RLock()
+	defer fake.taskHoldersMutex.RUnlock()
+	argsForCall := fake.taskHoldersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) TaskHoldersReturns(result1 internal.TaskHolders, result2 error) {
+	fake.taskHoldersMutex.Lock()
+	defer fake.taskHoldersMutex.Unlock()
+	fake.TaskHoldersStub = nil
+	fake.taskHoldersReturns = struct {
+		result1 internal.TaskHolders
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) TaskHoldersReturnsOnCall(i int, result1 internal.TaskHolders, result2 error) {
+	fake.taskHoldersMutex.Lock()
+	defer fake.taskHoldersMutex.Unlock()
+	fake.TaskHoldersStub = nil
+	if fake.taskHoldersReturnsOnCall == nil {
+		fake.taskHoldersReturnsOnCall = make(map[int]struct {
+			result1 internal.TaskHolders
+			result2 error
+		})
+	}
+	fake.taskHoldersReturnsOnCall[i] = struct {
+		result1 internal.TaskHolders
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) Tenant(arg1 context.Context, arg2 string) (internal.Tenant, error) {
+	fake.tenantMutex.Lock()
+	ret, specificReturn := fake.tenantReturnsOnCall[len(fake.tenantArgsForCall)]
+	fake.tenantArgsForCall = append(fake.tenantArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TenantStub
+	fakeReturns := fake.tenantReturns
+	fake.recordInvocation("Tenant", []interface{}{arg1, arg2})
+	fake.tenantMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) TenantCallCount() int {
+	fake.tenantMutex.RLock()
+	defer fake.tenantMutex.RUnlock()
+	return len(fake.tenantArgsForCall)
+}
+
+func (fake *FakeRBACRepository) TenantCalls(stub func(context.Context, string) (internal.Tenant, error)) {
+	fake.tenantMutex.Lock()
+	defer fake.tenantMutex.Unlock()
+	fake.TenantStub = stub
+}
+
+func (fake *FakeRBACRepository) TenantArgsForCall(i int) (context.Context, string) {
+	fake.tenantMutex.RLock()
+	defer fake.tenantMutex.RUnlock()
+	argsForCall := fake.tenantArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) TenantReturns(result1 internal.Tenant, result2 error) {
+	fake.tenantMutex.Lock()
+	defer fake.tenantMutex.Unlock()
+	fake.TenantStub = nil
+	fake.tenantReturns = struct {
+		result1 internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) TenantReturnsOnCall(i int, result1 internal.Tenant, result2 error) {
+	fake.tenantMutex.Lock()
+	defer fake.tenantMutex.Unlock()
+	fake.TenantStub = nil
+	if fake.tenantReturnsOnCall == nil {
+		fake.tenantReturnsOnCall = make(map[int]struct {
+			result1 internal.Tenant
+			result2 error
+		})
+	}
+	fake.tenantReturnsOnCall[i] = struct {
+		result1 internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) Tenants(arg1 context.Context) ([]internal.Tenant, error) {
+	fake.tenantsMutex.Lock()
+	ret, specificReturn := fake.tenantsReturnsOnCall[len(fake.tenantsArgsForCall)]
+	fake.tenantsArgsForCall = append(fake.tenantsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.TenantsStub
+	fakeReturns := fake.tenantsReturns
+	fake.recordInvocation("Tenants", []interface{}{arg1})
+	fake.tenantsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACRepository) TenantsCallCount() int {
+	fake.tenantsMutex.RLock()
+	defer fake.tenantsMutex.RUnlock()
+	return len(fake.tenantsArgsForCall)
+}
+
+func (fake *FakeRBACRepository) TenantsCalls(stub func(context.Context) ([]internal.Tenant, error)) {
+	fake.tenantsMutex.Lock()
+	defer fake.tenantsMutex.Unlock()
+	fake.TenantsStub = stub
+}
+
+func (fake *FakeRBACRepository) TenantsArgsForCall(i int) context.Context {
+	fake.tenantsMutex.RLock()
+	defer fake.tenantsMutex.RUnlock()
+	argsForCall := fake.tenantsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRBACRepository) TenantsReturns(result1 []internal.Tenant, result2 error) {
+	fake.tenantsMutex.Lock()
+	defer fake.tenantsMutex.Unlock()
+	fake.TenantsStub = nil
+	fake.tenantsReturns = struct {
+		result1 []internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) TenantsReturnsOnCall(i int, result1 []internal.Tenant, result2 error) {
+	fake.tenantsMutex.Lock()
+	defer fake.tenantsMutex.Unlock()
+	fake.TenantsStub = nil
+	if fake.tenantsReturnsOnCall == nil {
+		fake.tenantsReturnsOnCall = make(map[int]struct {
+			result1 []internal.Tenant
+			result2 error
+		})
+	}
+	fake.tenantsReturnsOnCall[i] = struct {
+		result1 []internal.Tenant
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACRepository) TouchAPIKey(arg1 context.Context, arg2 string) error {
+	fake.touchAPIKeyMutex.Lock()
+	ret, specificReturn := fake.touchAPIKeyReturnsOnCall[len(fake.touchAPIKeyArgsForCall)]
+	fake.touchAPIKeyArgsForCall = append(fake.touchAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TouchAPIKeyStub
+	fakeReturns := fake.touchAPIKeyReturns
+	fake.recordInvocation("TouchAPIKey", []interface{}{arg1, arg2})
+	fake.touchAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) TouchAPIKeyCallCount() int {
+	fake.touchAPIKeyMutex.RLock()
+	defer fake.touchAPIKeyMutex.RUnlock()
+	return len(fake.touchAPIKeyArgsForCall)
+}
+
+func (fake *FakeRBACRepository) TouchAPIKeyCalls(stub func(context.Context, string) error) {
+	fake.touchAPIKeyMutex.Lock()
+	defer fake.touchAPIKeyMutex.Unlock()
+	fake.TouchAPIKeyStub = stub
+}
+
+func (fake *FakeRBACRepository) TouchAPIKeyArgsForCall(i int) (context.Context, string) {
+	fake.touchAPIKeyMutex.RLock()
+	defer fake.touchAPIKeyMutex.RUnlock()
+	argsForCall := fake.touchAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) TouchAPIKeyReturns(result1 error) {
+	fake.touchAPIKeyMutex.Lock()
+	defer fake.touchAPIKeyMutex.Unlock()
+	fake.TouchAPIKeyStub = nil
+	fake.touchAPIKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) TouchAPIKeyReturnsOnCall(i int, result1 error) {
+	fake.touchAPIKeyMutex.Lock()
+	defer fake.touchAPIKeyMutex.Unlock()
+	fake.TouchAPIKeyStub = nil
+	if fake.touchAPIKeyReturnsOnCall == nil {
+		fake.touchAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.touchAPIKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UnblockAccount(arg1 context.Context, arg2 string) error {
+	fake.unblockAccountMutex.Lock()
+	ret, specificReturn := fake.unblockAccountReturnsOnCall[len(fake.unblockAccountArgsForCall)]
+	fake.unblockAccountArgsForCall = append(fake.unblockAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UnblockAccountStub
+	fakeReturns := fake.unblockAccountReturns
+	fake.recordInvocation("UnblockAccount", []interface{}{arg1, arg2})
+	fake.unblockAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UnblockAccountCallCount() int {
+	fake.unblockAccountMutex.RLock()
+	defer fake.unblockAccountMutex.RUnlock()
+	return len(fake.unblockAccountArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UnblockAccountCalls(stub func(context.Context, string) error) {
+	fake.unblockAccountMutex.Lock()
+	defer fake.unblockAccountMutex.Unlock()
+	fake.UnblockAccountStub = stub
+}
+
+func (fake *FakeRBACRepository) UnblockAccountArgsForCall(i int) (context.Context, string) {
+	fake.unblockAccountMutex.RLock()
+	defer fake.unblockAccountMutex.RUnlock()
+	argsForCall := fake.unblockAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) UnblockAccountReturns(result1 error) {
+	fake.unblockAccountMutex.Lock()
+	defer fake.unblockAccountMutex.Unlock()
+	fake.UnblockAccountStub = nil
+	fake.unblockAccountReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UnblockAccountReturnsOnCall(i int, result1 error) {
+	fake.unblockAccountMutex.Lock()
+	defer fake.unblockAccountMutex.Unlock()
+	fake.UnblockAccountStub = nil
+	if fake.unblockAccountReturnsOnCall == nil {
+		fake.unblockAccountReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unblockAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateAccountRole(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.updateAccountRoleMutex.Lock()
+	ret, specificReturn := fake.updateAccountRoleReturnsOnCall[len(fake.updateAccountRoleArgsForCall)]
+	fake.updateAccountRoleArgsForCall = append(fake.updateAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateAccountRoleStub
+	fakeReturns := fake.updateAccountRoleReturns
+	fake.recordInvocation("UpdateAccountRole", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateAccountRoleCallCount() int {
+	fake.updateAccountRoleMutex.RLock()
+	defer fake.updateAccountRoleMutex.RUnlock()
+	return len(fake.updateAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateAccountRoleCalls(stub func(context.Context, string, string, string) error) {
+	fake.updateAccountRoleMutex.Lock()
+	defer fake.updateAccountRoleMutex.Unlock()
+	fake.UpdateAccountRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateAccountRoleArgsForCall(i int) (context.Context, string, string, string) {
+	fake.updateAccountRoleMutex.RLock()
+	defer fake.updateAccountRoleMutex.RUnlock()
+	argsForCall := fake.updateAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACRepository) UpdateAccountRoleReturns(result1 error) {
+	fake.updateAccountRoleMutex.Lock()
+	defer fake.updateAccountRoleMutex.Unlock()
+	fake.UpdateAccountRoleStub = nil
+	fake.updateAccountRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateAccountRoleReturnsOnCall(i int, result1 error) {
+	fake.updateAccountRoleMutex.Lock()
+	defer fake.updateAccountRoleMutex.Unlock()
+	fake.UpdateAccountRoleStub = nil
+	if fake.updateAccountRoleReturnsOnCall == nil {
+		fake.updateAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateAccountRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateExclusiveRoleSet(arg1 context.Context, arg2 internal.ExclusiveRoleSet) error {
+	fake.updateExclusiveRoleSetMutex.Lock()
+	ret, specificReturn := fake.updateExclusiveRoleSetReturnsOnCall[len(fake.updateExclusiveRoleSetArgsForCall)]
+	fake.updateExclusiveRoleSetArgsForCall = append(fake.updateExclusiveRoleSetArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ExclusiveRoleSet
+	}{arg1, arg2})
+	stub := fake.UpdateExclusiveRoleSetStub
+	fakeReturns := fake.updateExclusiveRoleSetReturns
+	fake.recordInvocation("UpdateExclusiveRoleSet", []interface{}{arg1, arg2})
+	fake.updateExclusiveRoleSetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateExclusiveRoleSetCallCount() int {
+	fake.updateExclusiveRoleSetMutex.RLock()
+	defer fake.updateExclusiveRoleSetMutex.RUnlock()
+	return len(fake.updateExclusiveRoleSetArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateExclusiveRoleSetCalls(stub func(context.Context, internal.ExclusiveRoleSet) error) {
+	fake.updateExclusiveRoleSetMutex.Lock()
+	defer fake.updateExclusiveRoleSetMutex.Unlock()
+	fake.UpdateExclusiveRoleSetStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateExclusiveRoleSetArgsForCall(i int) (context.Context, internal.ExclusiveRoleSet) {
+	fake.updateExclusiveRoleSetMutex.RLock()
+	defer fake.updateExclusiveRoleSetMutex.RUnlock()
+	argsForCall := fake.updateExclusiveRoleSetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) UpdateExclusiveRoleSetReturns(result1 error) {
+	fake.updateExclusiveRoleSetMutex.Lock()
+	defer fake.updateExclusiveRoleSetMutex.Unlock()
+	fake.UpdateExclusiveRoleSetStub = nil
+	fake.updateExclusiveRoleSetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateExclusiveRoleSetReturnsOnCall(i int, result1 error) {
+	fake.updateExclusiveRoleSetMutex.Lock()
+	defer fake.updateExclusiveRoleSetMutex.Unlock()
+	fake.UpdateExclusiveRoleSetStub = nil
+	if fake.updateExclusiveRoleSetReturnsOnCall == nil {
+		fake.updateExclusiveRoleSetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateExclusiveRoleSetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateGroup(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.updateGroupMutex.Lock()
+	ret, specificReturn := fake.updateGroupReturnsOnCall[len(fake.updateGroupArgsForCall)]
+	fake.updateGroupArgsForCall = append(fake.updateGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateGroupStub
+	fakeReturns := fake.updateGroupReturns
+	fake.recordInvocation("UpdateGroup", []interface{}{arg1, arg2, arg3})
+	fake.updateGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateGroupCallCount() int {
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
+	return len(fake.updateGroupArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateGroupCalls(stub func(context.Context, string, string) error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateGroupArgsForCall(i int) (context.Context, string, string) {
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
+	argsForCall := fake.updateGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) UpdateGroupReturns(result1 error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = nil
+	fake.updateGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateGroupReturnsOnCall(i int, result1 error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = nil
+	if fake.updateGroupReturnsOnCall == nil {
+		fake.updateGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateHelpText(arg1 context.Context, arg2 internal.HelpText) error {
+	fake.updateHelpTextMutex.Lock()
+	ret, specificReturn := fake.updateHelpTextReturnsOnCall[len(fake.updateHelpTextArgsForCall)]
+	fake.updateHelpTextArgsForCall = append(fake.updateHelpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.HelpText
+	}{arg1, arg2})
+	stub := fake.UpdateHelpTextStub
+	fakeReturns := fake.updateHelpTextReturns
+	fake.recordInvocation("UpdateHelpText", []interface{}{arg1, arg2})
+	fake.updateHelpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateHelpTextCallCount() int {
+	fake.updateHelpTextMutex.RLock()
+	defer fake.updateHelpTextMutex.RUnlock()
+	return len(fake.updateHelpTextArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateHelpTextCalls(stub func(context.Context, internal.HelpText) error) {
+	fake.updateHelpTextMutex.Lock()
+	defer fake.updateHelpTextMutex.Unlock()
+	fake.UpdateHelpTextStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateHelpTextArgsForCall(i int) (context.Context, internal.HelpText) {
+	fake.updateHelpTextMutex.RLock()
+	defer fake.updateHelpTextMutex.RUnlock()
+	argsForCall := fake.updateHelpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) UpdateHelpTextReturns(result1 error) {
+	fake.updateHelpTextMutex.Lock()
+	defer fake.updateHelpTextMutex.Unlock()
+	fake.UpdateHelpTextStub = nil
+	fake.updateHelpTextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateHelpTextReturnsOnCall(i int, result1 error) {
+	fake.updateHelpTextMutex.Lock()
+	defer fake.updateHelpTextMutex.Unlock()
+	fake.UpdateHelpTextStub = nil
+	if fake.updateHelpTextReturnsOnCall == nil {
+		fake.updateHelpTextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateHelpTextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateMenu(arg1 context.Context, arg2 internal.Menu) error {
+	fake.updateMenuMutex.Lock()
+	ret, specificReturn := fake.updateMenuReturnsOnCall[len(fake.updateMenuArgsForCall)]
+	fake.updateMenuArgsForCall = append(fake.updateMenuArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Menu
+	}{arg1, arg2})
+	stub := fake.UpdateMenuStub
+	fakeReturns := fake.updateMenuReturns
+	fake.recordInvocation("UpdateMenu", []interface{}{arg1, arg2})
+	fake.updateMenuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateMenuCallCount() int {
+	fake.updateMenuMutex.RLock()
+	defer fake.updateMenuMutex.RUnlock()
+	return len(fake.updateMenuArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateMenuCalls(stub func(context.Context, internal.Menu) error) {
+	fake.updateMenuMutex.Lock()
+	defer fake.updateMenuMutex.Unlock()
+	fake.UpdateMenuStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateMenuArgsForCall(i int) (context.Context, internal.Menu) {
+	fake.updateMenuMutex.RLock()
+	defer fake.updateMenuMutex.RUnlock()
+	argsForCall := fake.updateMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) UpdateMenuReturns(result1 error) {
+	fake.updateMenuMutex.Lock()
+	defer fake.updateMenuMutex.Unlock()
+	fake.UpdateMenuStub = nil
+	fake.updateMenuReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateMenuReturnsOnCall(i int, result1 error) {
+	fake.updateMenuMutex.Lock()
+	defer fake.updateMenuMutex.Unlock()
+	fake.UpdateMenuStub = nil
+	if fake.updateMenuReturnsOnCall == nil {
+		fake.updateMenuReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateMenuReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateNavigation(arg1 context.Context, arg2 internal.Navigation) error {
+	fake.updateNavigationMutex.Lock()
+	ret, specificReturn := fake.updateNavigationReturnsOnCall[len(fake.updateNavigationArgsForCall)]
+	fake.updateNavigationArgsForCall = append(fake.updateNavigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Navigation
+	}{arg1, arg2})
+	stub := fake.UpdateNavigationStub
+	fakeReturns := fake.updateNavigationReturns
+	fake.recordInvocation("UpdateNavigation", []interface{}{arg1, arg2})
+	fake.updateNavigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateNavigationCallCount() int {
+	fake.updateNavigationMutex.RLock()
+	defer fake.updateNavigationMutex.RUnlock()
+	return len(fake.updateNavigationArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateNavigationCalls(stub func(context.Context, internal.Navigation) error) {
+	fake.updateNavigationMutex.Lock()
+	defer fake.updateNavigationMutex.Unlock()
+	fake.UpdateNavigationStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateNavigationArgsForCall(i int) (context.Context, internal.Navigation) {
+	fake.updateNavigationMutex.RLock()
+	defer fake.updateNavigationMutex.RUnlock()
+	argsForCall := fake.updateNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) UpdateNavigationReturns(result1 error) {
+	fake.updateNavigationMutex.Lock()
+	defer fake.updateNavigationMutex.Unlock()
+	fake.UpdateNavigationStub = nil
+	fake.updateNavigationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateNavigationReturnsOnCall(i int, result1 error) {
+	fake.updateNavigationMutex.Lock()
+	defer fake.updateNavigationMutex.Unlock()
+	fake.UpdateNavigationStub = nil
+	if fake.updateNavigationReturnsOnCall == nil {
+		fake.updateNavigationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateNavigationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateProfile(arg1 context.Context, arg2 internal.Profile) error {
+	fake.updateProfileMutex.Lock()
+	ret, specificReturn := fake.updateProfileReturnsOnCall[len(fake.updateProfileArgsForCall)]
+	fake.updateProfileArgsForCall = append(fake.updateProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}{arg1, arg2})
+	stub := fake.UpdateProfileStub
+	fakeReturns := fake.updateProfileReturns
+	fake.recordInvocation("UpdateProfile", []interface{}{arg1, arg2})
+	fake.updateProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateProfileCallCount() int {
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	return len(fake.updateProfileArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateProfileCalls(stub func(context.Context, internal.Profile) error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateProfileArgsForCall(i int) (context.Context, internal.Profile) {
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	argsForCall := fake.updateProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACRepository) UpdateProfileReturns(result1 error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = nil
+	fake.updateProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateProfileReturnsOnCall(i int, result1 error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = nil
+	if fake.updateProfileReturnsOnCall == nil {
+		fake.updateProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateRole(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.updateRoleMutex.Lock()
+	ret, specificReturn := fake.updateRoleReturnsOnCall[len(fake.updateRoleArgsForCall)]
+	fake.updateRoleArgsForCall = append(fake.updateRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateRoleStub
+	fakeReturns := fake.updateRoleReturns
+	fake.recordInvocation("UpdateRole", []interface{}{arg1, arg2, arg3})
+	fake.updateRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateRoleCallCount() int {
+	fake.updateRoleMutex.RLock()
+	defer fake.updateRoleMutex.RUnlock()
+	return len(fake.updateRoleArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateRoleCalls(stub func(context.Context, string, string) error) {
+	fake.updateRoleMutex.Lock()
+	defer fake.updateRoleMutex.Unlock()
+	fake.UpdateRoleStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateRoleArgsForCall(i int) (context.Context, string, string) {
+	fake.updateRoleMutex.RLock()
+	defer fake.updateRoleMutex.RUnlock()
+	argsForCall := fake.updateRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) UpdateRoleReturns(result1 error) {
+	fake.updateRoleMutex.Lock()
+	defer fake.updateRoleMutex.Unlock()
+	fake.UpdateRoleStub = nil
+	fake.updateRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateRoleReturnsOnCall(i int, result1 error) {
+	fake.updateRoleMutex.Lock()
+	defer fake.updateRoleMutex.Unlock()
+	fake.UpdateRoleStub = nil
+	if fake.updateRoleReturnsOnCall == nil {
+		fake.updateRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateRoleTask(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.updateRoleTaskMutex.Lock()
+	ret, specificReturn := fake.updateRoleTaskReturnsOnCall[len(fake.updateRoleTaskArgsForCall)]
+	fake.updateRoleTaskArgsForCall = append(fake.updateRoleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateRoleTaskStub
+	fakeReturns := fake.updateRoleTaskReturns
+	fake.recordInvocation("UpdateRoleTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateRoleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateRoleTaskCallCount() int {
+	fake.updateRoleTaskMutex.RLock()
+	defer fake.updateRoleTaskMutex.RUnlock()
+	return len(fake.updateRoleTaskArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateRoleTaskCalls(stub func(context.Context, string, string, string) error) {
+	fake.updateRoleTaskMutex.Lock()
+	defer fake.updateRoleTaskMutex.Unlock()
+	fake.UpdateRoleTaskStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateRoleTaskArgsForCall(i int) (context.Context, string, string, string) {
+	fake.updateRoleTaskMutex.RLock()
+	defer fake.updateRoleTaskMutex.RUnlock()
+	argsForCall := fake.updateRoleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRBACRepository) UpdateRoleTaskReturns(result1 error) {
+	fake.updateRoleTaskMutex.Lock()
+	defer fake.updateRoleTaskMutex.Unlock()
+	fake.UpdateRoleTaskStub = nil
+	fake.updateRoleTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateRoleTaskReturnsOnCall(i int, result1 error) {
+	fake.updateRoleTaskMutex.Lock()
+	defer fake.updateRoleTaskMutex.Unlock()
+	fake.UpdateRoleTaskStub = nil
+	if fake.updateRoleTaskReturnsOnCall == nil {
+		fake.updateRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateRoleTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateTask(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.updateTaskMutex.Lock()
+	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
+	fake.updateTaskArgsForCall = append(fake.updateTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateTaskStub
+	fakeReturns := fake.updateTaskReturns
+	fake.recordInvocation("UpdateTask", []interface{}{arg1, arg2, arg3})
+	fake.updateTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UpdateTaskCallCount() int {
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	return len(fake.updateTaskArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UpdateTaskCalls(stub func(context.Context, string, string) error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = stub
+}
+
+func (fake *FakeRBACRepository) UpdateTaskArgsForCall(i int) (context.Context, string, string) {
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	argsForCall := fake.updateTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) UpdateTaskReturns(result1 error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = nil
+	fake.updateTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UpdateTaskReturnsOnCall(i int, result1 error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = nil
+	if fake.updateTaskReturnsOnCall == nil {
+		fake.updateTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UseMFARecoveryCode(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.useMFARecoveryCodeMutex.Lock()
+	ret, specificReturn := fake.useMFARecoveryCodeReturnsOnCall[len(fake.useMFARecoveryCodeArgsForCall)]
+	fake.useMFARecoveryCodeArgsForCall = append(fake.useMFARecoveryCodeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UseMFARecoveryCodeStub
+	fakeReturns := fake.useMFARecoveryCodeReturns
+	fake.recordInvocation("UseMFARecoveryCode", []interface{}{arg1, arg2, arg3})
+	fake.useMFARecoveryCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UseMFARecoveryCodeCallCount() int {
+	fake.useMFARecoveryCodeMutex.RLock()
+	defer fake.useMFARecoveryCodeMutex.RUnlock()
+	return len(fake.useMFARecoveryCodeArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UseMFARecoveryCodeCalls(stub func(context.Context, string, string) error) {
+	fake.useMFARecoveryCodeMutex.Lock()
+	defer fake.useMFARecoveryCodeMutex.Unlock()
+	fake.UseMFARecoveryCodeStub = stub
+}
+
+func (fake *FakeRBACRepository) UseMFARecoveryCodeArgsForCall(i int) (context.Context, string, string) {
+	fake.useMFARecoveryCodeMutex.RLock()
+	defer fake.useMFARecoveryCodeMutex.RUnlock()
+	argsForCall := fake.useMFARecoveryCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) UseMFARecoveryCodeReturns(result1 error) {
+	fake.useMFARecoveryCodeMutex.Lock()
+	defer fake.useMFARecoveryCodeMutex.Unlock()
+	fake.UseMFARecoveryCodeStub = nil
+	fake.useMFARecoveryCodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UseMFARecoveryCodeReturnsOnCall(i int, result1 error) {
+	fake.useMFARecoveryCodeMutex.Lock()
+	defer fake.useMFARecoveryCodeMutex.Unlock()
+	fake.UseMFARecoveryCodeStub = nil
+	if fake.useMFARecoveryCodeReturnsOnCall == nil {
+		fake.useMFARecoveryCodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.useMFARecoveryCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UseMFAStep(arg1 context.Context, arg2 string, arg3 int64) error {
+	fake.useMFAStepMutex.Lock()
+	ret, specificReturn := fake.useMFAStepReturnsOnCall[len(fake.useMFAStepArgsForCall)]
+	fake.useMFAStepArgsForCall = append(fake.useMFAStepArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int64
+	}{arg1, arg2, arg3})
+	stub := fake.UseMFAStepStub
+	fakeReturns := fake.useMFAStepReturns
+	fake.recordInvocation("UseMFAStep", []interface{}{arg1, arg2, arg3})
+	fake.useMFAStepMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACRepository) UseMFAStepCallCount() int {
+	fake.useMFAStepMutex.RLock()
+	defer fake.useMFAStepMutex.RUnlock()
+	return len(fake.useMFAStepArgsForCall)
+}
+
+func (fake *FakeRBACRepository) UseMFAStepCalls(stub func(context.Context, string, int64) error) {
+	fake.useMFAStepMutex.Lock()
+	defer fake.useMFAStepMutex.Unlock()
+	fake.UseMFAStepStub = stub
+}
+
+func (fake *FakeRBACRepository) UseMFAStepArgsForCall(i int) (context.Context, string, int64) {
+	fake.useMFAStepMutex.RLock()
+	defer fake.useMFAStepMutex.RUnlock()
+	argsForCall := fake.useMFAStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRBACRepository) UseMFAStepReturns(result1 error) {
+	fake.useMFAStepMutex.Lock()
+	defer fake.useMFAStepMutex.Unlock()
+	fake.UseMFAStepStub = nil
+	fake.useMFAStepReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) UseMFAStepReturnsOnCall(i int, result1 error) {
+	fake.useMFAStepMutex.Lock()
+	defer fake.useMFAStepMutex.Unlock()
+	fake.UseMFAStepStub = nil
+	if fake.useMFAStepReturnsOnCall == nil {
+		fake.useMFAStepReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.useMFAStepReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.aPIKeyByHashMutex.RLock()
+	defer fake.aPIKeyByHashMutex.RUnlock()
+	fake.aPIKeysByAccountMutex.RLock()
+	defer fake.aPIKeysByAccountMutex.RUnlock()
+	fake.accessRequestMutex.RLock()
+	defer fake.accessRequestMutex.RUnlock()
+	fake.accessRequestsMutex.RLock()
+	defer fake.accessRequestsMutex.RUnlock()
+	fake.accountMutex.RLock()
+	defer fake.accountMutex.RUnlock()
+	fake.accountAccessRequestsMutex.RLock()
+	defer fake.accountAccessRequestsMutex.RUnlock()
+	fake.accountByIDMutex.RLock()
+	defer fake.accountByIDMutex.RUnlock()
+	fake.accountRoleMutex.RLock()
+	defer fake.accountRoleMutex.RUnlock()
+	fake.accountRolesByAccountMutex.RLock()
+	defer fake.accountRolesByAccountMutex.RUnlock()
+	fake.accountTenantsMutex.RLock()
+	defer fake.accountTenantsMutex.RUnlock()
+	fake.addAccountTenantMutex.RLock()
+	defer fake.addAccountTenantMutex.RUnlock()
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	fake.addGroupRoleMutex.RLock()
+	defer fake.addGroupRoleMutex.RUnlock()
+	fake.addMemberGroupMutex.RLock()
+	defer fake.addMemberGroupMutex.RUnlock()
+	fake.addRoleApproverMutex.RLock()
+	defer fake.addRoleApproverMutex.RUnlock()
+	fake.applyPolicyMutex.RLock()
+	defer fake.applyPolicyMutex.RUnlock()
+	fake.approveAccessRequestMutex.RLock()
+	defer fake.approveAccessRequestMutex.RUnlock()
+	fake.blockAccountMutex.RLock()
+	defer fake.blockAccountMutex.RUnlock()
+	fake.breakGlassActivationMutex.RLock()
+	defer fake.breakGlassActivationMutex.RUnlock()
+	fake.breakGlassActivationsMutex.RLock()
+	defer fake.breakGlassActivationsMutex.RUnlock()
+	fake.changePasswordMutex.RLock()
+	defer fake.changePasswordMutex.RUnlock()
+	fake.confirmMFAMutex.RLock()
+	defer fake.confirmMFAMutex.RUnlock()
+	fake.countStartedAccountRolesMutex.RLock()
+	defer fake.countStartedAccountRolesMutex.RUnlock()
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	fake.createAccessRequestMutex.RLock()
+	defer fake.createAccessRequestMutex.RUnlock()
+	fake.createAccountMutex.RLock()
+	defer fake.createAccountMutex.RUnlock()
+	fake.createAccountRoleMutex.RLock()
+	defer fake.createAccountRoleMutex.RUnlock()
+	fake.createBreakGlassActivationMutex.RLock()
+	defer fake.createBreakGlassActivationMutex.RUnlock()
+	fake.createExclusiveRoleSetMutex.RLock()
+	defer fake.createExclusiveRoleSetMutex.RUnlock()
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
+	fake.createHelpTextMutex.RLock()
+	defer fake.createHelpTextMutex.RUnlock()
+	fake.createMenuMutex.RLock()
+	defer fake.createMenuMutex.RUnlock()
+	fake.createNavigationMutex.RLock()
+	defer fake.createNavigationMutex.RUnlock()
+	fake.createPasswordResetTokenMutex.RLock()
+	defer fake.createPasswordResetTokenMutex.RUnlock()
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	fake.createRoleMutex.RLock()
+	defer fake.createRoleMutex.RUnlock()
+	fake.createRoleInheritanceMutex.RLock()
+	defer fake.createRoleInheritanceMutex.RUnlock()
+	fake.createRoleTasksMutex.RLock()
+	defer fake.createRoleTasksMutex.RUnlock()
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	fake.createTenantMutex.RLock()
+	defer fake.createTenantMutex.RUnlock()
+	fake.decideAccessRequestMutex.RLock()
+	defer fake.decideAccessRequestMutex.RUnlock()
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	fake.deleteAccountRoleMutex.RLock()
+	defer fake.deleteAccountRoleMutex.RUnlock()
+	fake.deleteExclusiveRoleSetMutex.RLock()
+	defer fake.deleteExclusiveRoleSetMutex.RUnlock()
+	fake.deleteExpiredAccountRolesMutex.RLock()
+	defer fake.deleteExpiredAccountRolesMutex.RUnlock()
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
+	fake.deleteHelpTextMutex.RLock()
+	defer fake.deleteHelpTextMutex.RUnlock()
+	fake.deleteMFAMutex.RLock()
+	defer fake.deleteMFAMutex.RUnlock()
+	fake.deleteMenuMutex.RLock()
+	defer fake.deleteMenuMutex.RUnlock()
+	fake.deleteNavigationMutex.RLock()
+	defer fake.deleteNavigationMutex.RUnlock()
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	fake.deleteRoleInheritanceMutex.RLock()
+	defer fake.deleteRoleInheritanceMutex.RUnlock()
+	fake.deleteRoleTaskMutex.RLock()
+	defer fake.deleteRoleTaskMutex.RUnlock()
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	fake.emergencyRolesMutex.RLock()
+	defer fake.emergencyRolesMutex.RUnlock()
+	fake.enrollMFAMutex.RLock()
+	defer fake.enrollMFAMutex.RUnlock()
+	fake.exclusiveRoleSetMutex.RLock()
+	defer fake.exclusiveRoleSetMutex.RUnlock()
+	fake.exclusiveRoleSetsMutex.RLock()
+	defer fake.exclusiveRoleSetsMutex.RUnlock()
+	fake.exportPolicyMutex.RLock()
+	defer fake.exportPolicyMutex.RUnlock()
+	fake.groupMutex.RLock()
+	defer fake.groupMutex.RUnlock()
+	fake.groupMembersMutex.RLock()
+	defer fake.groupMembersMutex.RUnlock()
+	fake.groupRoleIDsMutex.RLock()
+	defer fake.groupRoleIDsMutex.RUnlock()
+	fake.groupRolesMutex.RLock()
+	defer fake.groupRolesMutex.RUnlock()
+	fake.groupsMutex.RLock()
+	defer fake.groupsMutex.RUnlock()
+	fake.helpTextMutex.RLock()
+	defer fake.helpTextMutex.RUnlock()
+	fake.inheritedRoleIDsMutex.RLock()
+	defer fake.inheritedRoleIDsMutex.RUnlock()
+	fake.inheritedRoleIDsOfMutex.RLock()
+	defer fake.inheritedRoleIDsOfMutex.RUnlock()
+	fake.inheritedRolesMutex.RLock()
+	defer fake.inheritedRolesMutex.RUnlock()
+	fake.isEmergencyRoleMutex.RLock()
+	defer fake.isEmergencyRoleMutex.RUnlock()
+	fake.isMFARequiredMutex.RLock()
+	defer fake.isMFARequiredMutex.RUnlock()
+	fake.loginMutex.RLock()
+	defer fake.loginMutex.RUnlock()
+	fake.mFAMutex.RLock()
+	defer fake.mFAMutex.RUnlock()
+	fake.memberGroupsMutex.RLock()
+	defer fake.memberGroupsMutex.RUnlock()
+	fake.menuMutex.RLock()
+	defer fake.menuMutex.RUnlock()
+	fake.navigationMutex.RLock()
+	defer fake.navigationMutex.RUnlock()
+	fake.refreshTokenByHashMutex.RLock()
+	defer fake.refreshTokenByHashMutex.RUnlock()
+	fake.removeAccountTenantMutex.RLock()
+	defer fake.removeAccountTenantMutex.RUnlock()
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	fake.removeGroupRoleMutex.RLock()
+	defer fake.removeGroupRoleMutex.RUnlock()
+	fake.removeMemberGroupMutex.RLock()
+	defer fake.removeMemberGroupMutex.RUnlock()
+	fake.removeRoleApproverMutex.RLock()
+	defer fake.removeRoleApproverMutex.RUnlock()
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	fake.restrictedGrantsMutex.RLock()
+	defer fake.restrictedGrantsMutex.RUnlock()
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	fake.revokeAccountRefreshTokensMutex.RLock()
+	defer fake.revokeAccountRefreshTokensMutex.RUnlock()
+	fake.revokeRefreshTokenFamilyMutex.RLock()
+	defer fake.revokeRefreshTokenFamilyMutex.RUnlock()
+	fake.roleMutex.RLock()
+	defer fake.roleMutex.RUnlock()
+	fake.roleApproversMutex.RLock()
+	defer fake.roleApproversMutex.RUnlock()
+	fake.roleConflictsMutex.RLock()
+	defer fake.roleConflictsMutex.RUnlock()
+	fake.roleTaskMutex.RLock()
+	defer fake.roleTaskMutex.RUnlock()
+	fake.rotateRefreshTokenMutex.RLock()
+	defer fake.rotateRefreshTokenMutex.RUnlock()
+	fake.setActiveTenantMutex.RLock()
+	defer fake.setActiveTenantMutex.RUnlock()
+	fake.setEmergencyRoleMutex.RLock()
+	defer fake.setEmergencyRoleMutex.RUnlock()
+	fake.setRoleMFARequiredMutex.RLock()
+	defer fake.setRoleMFARequiredMutex.RUnlock()
+	fake.taskMutex.RLock()
+	defer fake.taskMutex.RUnlock()
+	fake.taskHoldersMutex.RLock()
+	defer fake.taskHoldersMutex.RUnlock()
+	fake.tenantMutex.RLock()
+	defer fake.tenantMutex.RUnlock()
+	fake.tenantsMutex.RLock()
+	defer fake.tenantsMutex.RUnlock()
+	fake.touchAPIKeyMutex.RLock()
+	defer fake.touchAPIKeyMutex.RUnlock()
+	fake.unblockAccountMutex.RLock()
+	defer fake.unblockAccountMutex.RUnlock()
+	fake.updateAccountRoleMutex.RLock()
+	defer fake.updateAccountRoleMutex.RUnlock()
+	fake.updateExclusiveRoleSetMutex.RLock()
+	defer fake.updateExclusiveRoleSetMutex.RUnlock()
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
+	fake.updateHelpTextMutex.RLock()
+	defer fake.updateHelpTextMutex.RUnlock()
+	fake.updateMenuMutex.RLock()
+	defer fake.updateMenuMutex.RUnlock()
+	fake.updateNavigationMutex.RLock()
+	defer fake.updateNavigationMutex.RUnlock()
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	fake.updateRoleMutex.RLock()
+	defer fake.updateRoleMutex.RUnlock()
+	fake.updateRoleTaskMutex.RLock()
+	defer fake.updateRoleTaskMutex.RUnlock()
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	fake.useMFARecoveryCodeMutex.RLock()
+	defer fake.useMFARecoveryCodeMutex.RUnlock()
+	fake.useMFAStepMutex.RLock()
+	defer fake.useMFAStepMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRBACRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.RBACRepository = new(FakeRBACRepository)
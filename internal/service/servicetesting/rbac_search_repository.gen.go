@@ -0,0 +1,3812 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicetesting
+
+import (
+	"rbac/internal"
+	"rbac/internal/service"
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+type FakeRBACSearchRepository struct {
+	DeleteAccountStub        func(context.Context, string) error
+	deleteAccountMutex       sync.RWMutex
+	deleteAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteAccountReturns struct {
+		result1 error
+	}
+	deleteAccountReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteAccountRoleStub        func(context.Context, string) error
+	deleteAccountRoleMutex       sync.RWMutex
+	deleteAccountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteAccountRoleReturns struct {
+		result1 error
+	}
+	deleteAccountRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteHelpTextStub        func(context.Context, string) error
+	deleteHelpTextMutex       sync.RWMutex
+	deleteHelpTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteHelpTextReturns struct {
+		result1 error
+	}
+	deleteHelpTextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteMenuStub        func(context.Context, string) error
+	deleteMenuMutex       sync.RWMutex
+	deleteMenuArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteMenuReturns struct {
+		result1 error
+	}
+	deleteMenuReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteNavigationStub        func(context.Context, string) error
+	deleteNavigationMutex       sync.RWMutex
+	deleteNavigationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteNavigationReturns struct {
+		result1 error
+	}
+	deleteNavigationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteProfileStub        func(context.Context, string) error
+	deleteProfileMutex       sync.RWMutex
+	deleteProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteProfileReturns struct {
+		result1 error
+	}
+	deleteProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRoleStub        func(context.Context, string) error
+	deleteRoleMutex       sync.RWMutex
+	deleteRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteRoleReturns struct {
+		result1 error
+	}
+	deleteRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRoleTaskStub        func(context.Context, string) error
+	deleteRoleTaskMutex       sync.RWMutex
+	deleteRoleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteRoleTaskReturns struct {
+		result1 error
+	}
+	deleteRoleTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteTaskStub        func(context.Context, string) error
+	deleteTaskMutex       sync.RWMutex
+	deleteTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteTaskReturns struct {
+		result1 error
+	}
+	deleteTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAccountStub        func(context.Context, string) (internal.Account, error)
+	getAccountMutex       sync.RWMutex
+	getAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAccountReturns struct {
+		result1 internal.Account
+		result2 error
+	}
+	getAccountReturnsOnCall map[int]struct {
+		result1 internal.Account
+		result2 error
+	}
+	GetAccountByIdStub        func(context.Context, string) (internal.Account, error)
+	getAccountByIdMutex       sync.RWMutex
+	getAccountByIdArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAccountByIdReturns struct {
+		result1 internal.Account
+		result2 error
+	}
+	getAccountByIdReturnsOnCall map[int]struct {
+		result1 internal.Account
+		result2 error
+	}
+	GetAccountRoleStub        func(context.Context, string) (internal.AccountRoles, error)
+	getAccountRoleMutex       sync.RWMutex
+	getAccountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAccountRoleReturns struct {
+		result1 internal.AccountRoles
+		result2 error
+	}
+	getAccountRoleReturnsOnCall map[int]struct {
+		result1 internal.AccountRoles
+		result2 error
+	}
+	GetAccountRoleByAccountStub        func(context.Context, string) (internal.AccountRoleByAccountResult, error)
+	getAccountRoleByAccountMutex       sync.RWMutex
+	getAccountRoleByAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAccountRoleByAccountReturns struct {
+		result1 internal.AccountRoleByAccountResult
+		result2 error
+	}
+	getAccountRoleByAccountReturnsOnCall map[int]struct {
+		result1 internal.AccountRoleByAccountResult
+		result2 error
+	}
+	GetAccountRoleByRoleStub        func(context.Context, string) (internal.AccountRoleByRoleResult, error)
+	getAccountRoleByRoleMutex       sync.RWMutex
+	getAccountRoleByRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAccountRoleByRoleReturns struct {
+		result1 internal.AccountRoleByRoleResult
+		result2 error
+	}
+	getAccountRoleByRoleReturnsOnCall map[int]struct {
+		result1 internal.AccountRoleByRoleResult
+		result2 error
+	}
+	GetHelpTextStub        func(context.Context, string) (internal.HelpText, error)
+	getHelpTextMutex       sync.RWMutex
+	getHelpTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getHelpTextReturns struct {
+		result1 internal.HelpText
+		result2 error
+	}
+	getHelpTextReturnsOnCall map[int]struct {
+		result1 internal.HelpText
+		result2 error
+	}
+	GetHelpTextByTaskStub        func(context.Context, string) (internal.HelpText, error)
+	getHelpTextByTaskMutex       sync.RWMutex
+	getHelpTextByTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getHelpTextByTaskReturns struct {
+		result1 internal.HelpText
+		result2 error
+	}
+	getHelpTextByTaskReturnsOnCall map[int]struct {
+		result1 internal.HelpText
+		result2 error
+	}
+	GetMenuStub        func(context.Context, string) (internal.Menu, error)
+	getMenuMutex       sync.RWMutex
+	getMenuArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getMenuReturns struct {
+		result1 internal.Menu
+		result2 error
+	}
+	getMenuReturnsOnCall map[int]struct {
+		result1 internal.Menu
+		result2 error
+	}
+	GetMenuByTaskStub        func(context.Context, string) ([]internal.Menu, error)
+	getMenuByTaskMutex       sync.RWMutex
+	getMenuByTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getMenuByTaskReturns struct {
+		result1 []internal.Menu
+		result2 error
+	}
+	getMenuByTaskReturnsOnCall map[int]struct {
+		result1 []internal.Menu
+		result2 error
+	}
+	GetNavigationStub        func(context.Context, string) (internal.Navigation, error)
+	getNavigationMutex       sync.RWMutex
+	getNavigationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNavigationReturns struct {
+		result1 internal.Navigation
+		result2 error
+	}
+	getNavigationReturnsOnCall map[int]struct {
+		result1 internal.Navigation
+		result2 error
+	}
+	GetNavigationByTaskStub        func(context.Context, string) ([]internal.Navigation, error)
+	getNavigationByTaskMutex       sync.RWMutex
+	getNavigationByTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNavigationByTaskReturns struct {
+		result1 []internal.Navigation
+		result2 error
+	}
+	getNavigationByTaskReturnsOnCall map[int]struct {
+		result1 []internal.Navigation
+		result2 error
+	}
+	GetProfileStub        func(context.Context, string) (internal.Profile, error)
+	getProfileMutex       sync.RWMutex
+	getProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getProfileReturns struct {
+		result1 internal.Profile
+		result2 error
+	}
+	getProfileReturnsOnCall map[int]struct {
+		result1 internal.Profile
+		result2 error
+	}
+	GetRoleStub        func(context.Context, string) (internal.Roles, error)
+	getRoleMutex       sync.RWMutex
+	getRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getRoleReturns struct {
+		result1 internal.Roles
+		result2 error
+	}
+	getRoleReturnsOnCall map[int]struct {
+		result1 internal.Roles
+		result2 error
+	}
+	GetRoleTaskStub        func(context.Context, string) (internal.RoleTasks, error)
+	getRoleTaskMutex       sync.RWMutex
+	getRoleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getRoleTaskReturns struct {
+		result1 internal.RoleTasks
+		result2 error
+	}
+	getRoleTaskReturnsOnCall map[int]struct {
+		result1 internal.RoleTasks
+		result2 error
+	}
+	GetRoleTaskByRoleStub        func(context.Context, string) (internal.RoleTaskByRole, error)
+	getRoleTaskByRoleMutex       sync.RWMutex
+	getRoleTaskByRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getRoleTaskByRoleReturns struct {
+		result1 internal.RoleTaskByRole
+		result2 error
+	}
+	getRoleTaskByRoleReturnsOnCall map[int]struct {
+		result1 internal.RoleTaskByRole
+		result2 error
+	}
+	GetRoleTaskByTaskStub        func(context.Context, string) (internal.RoleTaskByTask, error)
+	getRoleTaskByTaskMutex       sync.RWMutex
+	getRoleTaskByTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getRoleTaskByTaskReturns struct {
+		result1 internal.RoleTaskByTask
+		result2 error
+	}
+	getRoleTaskByTaskReturnsOnCall map[int]struct {
+		result1 internal.RoleTaskByTask
+		result2 error
+	}
+	GetTaskStub        func(context.Context, string) (internal.Tasks, error)
+	getTaskMutex       sync.RWMutex
+	getTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getTaskReturns struct {
+		result1 internal.Tasks
+		result2 error
+	}
+	getTaskReturnsOnCall map[int]struct {
+		result1 internal.Tasks
+		result2 error
+	}
+	IndexAccountStub        func(context.Context, internal.Account) error
+	indexAccountMutex       sync.RWMutex
+	indexAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}
+	indexAccountReturns struct {
+		result1 error
+	}
+	indexAccountReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IndexAccountRoleStub        func(context.Context, internal.AccountRoles) error
+	indexAccountRoleMutex       sync.RWMutex
+	indexAccountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}
+	indexAccountRoleReturns struct {
+		result1 error
+	}
+	indexAccountRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IndexHelpTextStub        func(context.Context, internal.HelpText) error
+	indexHelpTextMutex       sync.RWMutex
+	indexHelpTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.HelpText
+	}
+	indexHelpTextReturns struct {
+		result1 error
+	}
+	indexHelpTextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IndexMenuStub        func(context.Context, internal.Menu) error
+	indexMenuMutex       sync.RWMutex
+	indexMenuArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Menu
+	}
+	indexMenuReturns struct {
+		result1 error
+	}
+	indexMenuReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IndexNavigationStub        func(context.Context, internal.Navigation) error
+	indexNavigationMutex       sync.RWMutex
+	indexNavigationArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Navigation
+	}
+	indexNavigationReturns struct {
+		result1 error
+	}
+	indexNavigationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IndexProfileStub        func(context.Context, internal.Profile) error
+	indexProfileMutex       sync.RWMutex
+	indexProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}
+	indexProfileReturns struct {
+		result1 error
+	}
+	indexProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IndexRoleStub        func(context.Context, internal.Roles) error
+	indexRoleMutex       sync.RWMutex
+	indexRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Roles
+	}
+	indexRoleReturns struct {
+		result1 error
+	}
+	indexRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IndexRoleTaskStub        func(context.Context, internal.RoleTasks) error
+	indexRoleTaskMutex       sync.RWMutex
+	indexRoleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
+	}
+	indexRoleTaskReturns struct {
+		result1 error
+	}
+	indexRoleTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IndexTaskStub        func(context.Context, internal.Tasks) error
+	indexTaskMutex       sync.RWMutex
+	indexTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Tasks
+	}
+	indexTaskReturns struct {
+		result1 error
+	}
+	indexTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListAccountStub        func(context.Context, internal.ListArgs) (internal.ListAccount, error)
+	listAccountMutex       sync.RWMutex
+	listAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}
+	listAccountReturns struct {
+		result1 internal.ListAccount
+		result2 error
+	}
+	listAccountReturnsOnCall map[int]struct {
+		result1 internal.ListAccount
+		result2 error
+	}
+	ListAccountRoleStub        func(context.Context, internal.ListArgs) (internal.ListAccountRole, error)
+	listAccountRoleMutex       sync.RWMutex
+	listAccountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}
+	listAccountRoleReturns struct {
+		result1 internal.ListAccountRole
+		result2 error
+	}
+	listAccountRoleReturnsOnCall map[int]struct {
+		result1 internal.ListAccountRole
+		result2 error
+	}
+	ListHelpTextStub        func(context.Context, internal.ListArgs) (internal.ListHelpText, error)
+	listHelpTextMutex       sync.RWMutex
+	listHelpTextArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}
+	listHelpTextReturns struct {
+		result1 internal.ListHelpText
+		result2 error
+	}
+	listHelpTextReturnsOnCall map[int]struct {
+		result1 internal.ListHelpText
+		result2 error
+	}
+	ListMenuStub        func(context.Context, internal.ListArgs) (internal.ListMenu, error)
+	listMenuMutex       sync.RWMutex
+	listMenuArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}
+	listMenuReturns struct {
+		result1 internal.ListMenu
+		result2 error
+	}
+	listMenuReturnsOnCall map[int]struct {
+		result1 internal.ListMenu
+		result2 error
+	}
+	ListNavigationStub        func(context.Context, internal.ListArgs) (internal.ListNavigation, error)
+	listNavigationMutex       sync.RWMutex
+	listNavigationArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}
+	listNavigationReturns struct {
+		result1 internal.ListNavigation
+		result2 error
+	}
+	listNavigationReturnsOnCall map[int]struct {
+		result1 internal.ListNavigation
+		result2 error
+	}
+	ListRoleStub        func(context.Context, internal.ListArgs) (internal.ListRole, error)
+	listRoleMutex       sync.RWMutex
+	listRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}
+	listRoleReturns struct {
+		result1 internal.ListRole
+		result2 error
+	}
+	listRoleReturnsOnCall map[int]struct {
+		result1 internal.ListRole
+		result2 error
+	}
+	ListRoleTaskStub        func(context.Context, internal.ListArgs) (internal.ListRoleTask, error)
+	listRoleTaskMutex       sync.RWMutex
+	listRoleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}
+	listRoleTaskReturns struct {
+		result1 internal.ListRoleTask
+		result2 error
+	}
+	listRoleTaskReturnsOnCall map[int]struct {
+		result1 internal.ListRoleTask
+		result2 error
+	}
+	ListTaskStub        func(context.Context, internal.ListArgs) (internal.ListTask, error)
+	listTaskMutex       sync.RWMutex
+	listTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}
+	listTaskReturns struct {
+		result1 internal.ListTask
+		result2 error
+	}
+	listTaskReturnsOnCall map[int]struct {
+		result1 internal.ListTask
+		result2 error
+	}
+	UpdateAccountRoleStub        func(context.Context, internal.AccountRoles) error
+	updateAccountRoleMutex       sync.RWMutex
+	updateAccountRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}
+	updateAccountRoleReturns struct {
+		result1 error
+	}
+	updateAccountRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateProfileStub        func(context.Context, internal.Profile) error
+	updateProfileMutex       sync.RWMutex
+	updateProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}
+	updateProfileReturns struct {
+		result1 error
+	}
+	updateProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateRoleStub        func(context.Context, internal.Roles) error
+	updateRoleMutex       sync.RWMutex
+	updateRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Roles
+	}
+	updateRoleReturns struct {
+		result1 error
+	}
+	updateRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateRoleTaskStub        func(context.Context, internal.RoleTasks) error
+	updateRoleTaskMutex       sync.RWMutex
+	updateRoleTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
+	}
+	updateRoleTaskReturns struct {
+		result1 error
+	}
+	updateRoleTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateTaskStub        func(context.Context, internal.Tasks) error
+	updateTaskMutex       sync.RWMutex
+	updateTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Tasks
+	}
+	updateTaskReturns struct {
+		result1 error
+	}
+	updateTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccount(arg1 context.Context, arg2 string) error {
+	fake.deleteAccountMutex.Lock()
+	ret, specificReturn := fake.deleteAccountReturnsOnCall[len(fake.deleteAccountArgsForCall)]
+	fake.deleteAccountArgsForCall = append(fake.deleteAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAccountStub
+	fakeReturns := fake.deleteAccountReturns
+	fake.recordInvocation("DeleteAccount", []interface{}{arg1, arg2})
+	fake.deleteAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountCallCount() int {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	return len(fake.deleteAccountArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountCalls(stub func(context.Context, string) error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountArgsForCall(i int) (context.Context, string) {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	argsForCall := fake.deleteAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountReturns(result1 error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = nil
+	fake.deleteAccountReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountReturnsOnCall(i int, result1 error) {
+	fake.deleteAccountMutex.Lock()
+	defer fake.deleteAccountMutex.Unlock()
+	fake.DeleteAccountStub = nil
+	if fake.deleteAccountReturnsOnCall == nil {
+		fake.deleteAccountReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountRole(arg1 context.Context, arg2 string) error {
+	fake.deleteAccountRoleMutex.Lock()
+	ret, specificReturn := fake.deleteAccountRoleReturnsOnCall[len(fake.deleteAccountRoleArgsForCall)]
+	fake.deleteAccountRoleArgsForCall = append(fake.deleteAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteAccountRoleStub
+	fakeReturns := fake.deleteAccountRoleReturns
+	fake.recordInvocation("DeleteAccountRole", []interface{}{arg1, arg2})
+	fake.deleteAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountRoleCallCount() int {
+	fake.deleteAccountRoleMutex.RLock()
+	defer fake.deleteAccountRoleMutex.RUnlock()
+	return len(fake.deleteAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountRoleCalls(stub func(context.Context, string) error) {
+	fake.deleteAccountRoleMutex.Lock()
+	defer fake.deleteAccountRoleMutex.Unlock()
+	fake.DeleteAccountRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountRoleArgsForCall(i int) (context.Context, string) {
+	fake.deleteAccountRoleMutex.RLock()
+	defer fake.deleteAccountRoleMutex.RUnlock()
+	argsForCall := fake.deleteAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountRoleReturns(result1 error) {
+	fake.deleteAccountRoleMutex.Lock()
+	defer fake.deleteAccountRoleMutex.Unlock()
+	fake.DeleteAccountRoleStub = nil
+	fake.deleteAccountRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteAccountRoleReturnsOnCall(i int, result1 error) {
+	fake.deleteAccountRoleMutex.Lock()
+	defer fake.deleteAccountRoleMutex.Unlock()
+	fake.DeleteAccountRoleStub = nil
+	if fake.deleteAccountRoleReturnsOnCall == nil {
+		fake.deleteAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccountRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteHelpText(arg1 context.Context, arg2 string) error {
+	fake.deleteHelpTextMutex.Lock()
+	ret, specificReturn := fake.deleteHelpTextReturnsOnCall[len(fake.deleteHelpTextArgsForCall)]
+	fake.deleteHelpTextArgsForCall = append(fake.deleteHelpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteHelpTextStub
+	fakeReturns := fake.deleteHelpTextReturns
+	fake.recordInvocation("DeleteHelpText", []interface{}{arg1, arg2})
+	fake.deleteHelpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) DeleteHelpTextCallCount() int {
+	fake.deleteHelpTextMutex.RLock()
+	defer fake.deleteHelpTextMutex.RUnlock()
+	return len(fake.deleteHelpTextArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) DeleteHelpTextCalls(stub func(context.Context, string) error) {
+	fake.deleteHelpTextMutex.Lock()
+	defer fake.deleteHelpTextMutex.Unlock()
+	fake.DeleteHelpTextStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) DeleteHelpTextArgsForCall(i int) (context.Context, string) {
+	fake.deleteHelpTextMutex.RLock()
+	defer fake.deleteHelpTextMutex.RUnlock()
+	argsForCall := fake.deleteHelpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) DeleteHelpTextReturns(result1 error) {
+	fake.deleteHelpTextMutex.Lock()
+	defer fake.deleteHelpTextMutex.Unlock()
+	fake.DeleteHelpTextStub = nil
+	fake.deleteHelpTextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteHelpTextReturnsOnCall(i int, result1 error) {
+	fake.deleteHelpTextMutex.Lock()
+	defer fake.deleteHelpTextMutex.Unlock()
+	fake.DeleteHelpTextStub = nil
+	if fake.deleteHelpTextReturnsOnCall == nil {
+		fake.deleteHelpTextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteHelpTextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteMenu(arg1 context.Context, arg2 string) error {
+	fake.deleteMenuMutex.Lock()
+	ret, specificReturn := fake.deleteMenuReturnsOnCall[len(fake.deleteMenuArgsForCall)]
+	fake.deleteMenuArgsForCall = append(fake.deleteMenuArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteMenuStub
+	fakeReturns := fake.deleteMenuReturns
+	fake.recordInvocation("DeleteMenu", []interface{}{arg1, arg2})
+	fake.deleteMenuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) DeleteMenuCallCount() int {
+	fake.deleteMenuMutex.RLock()
+	defer fake.deleteMenuMutex.RUnlock()
+	return len(fake.deleteMenuArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) DeleteMenuCalls(stub func(context.Context, string) error) {
+	fake.deleteMenuMutex.Lock()
+	defer fake.deleteMenuMutex.Unlock()
+	fake.DeleteMenuStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) DeleteMenuArgsForCall(i int) (context.Context, string) {
+	fake.deleteMenuMutex.RLock()
+	defer fake.deleteMenuMutex.RUnlock()
+	argsForCall := fake.deleteMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) DeleteMenuReturns(result1 error) {
+	fake.deleteMenuMutex.Lock()
+	defer fake.deleteMenuMutex.Unlock()
+	fake.DeleteMenuStub = nil
+	fake.deleteMenuReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteMenuReturnsOnCall(i int, result1 error) {
+	fake.deleteMenuMutex.Lock()
+	defer fake.deleteMenuMutex.Unlock()
+	fake.DeleteMenuStub = nil
+	if fake.deleteMenuReturnsOnCall == nil {
+		fake.deleteMenuReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteMenuReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteNavigation(arg1 context.Context, arg2 string) error {
+	fake.deleteNavigationMutex.Lock()
+	ret, specificReturn := fake.deleteNavigationReturnsOnCall[len(fake.deleteNavigationArgsForCall)]
+	fake.deleteNavigationArgsForCall = append(fake.deleteNavigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteNavigationStub
+	fakeReturns := fake.deleteNavigationReturns
+	fake.recordInvocation("DeleteNavigation", []interface{}{arg1, arg2})
+	fake.deleteNavigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) DeleteNavigationCallCount() int {
+	fake.deleteNavigationMutex.RLock()
+	defer fake.deleteNavigationMutex.RUnlock()
+	return len(fake.deleteNavigationArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) DeleteNavigationCalls(stub func(context.Context, string) error) {
+	fake.deleteNavigationMutex.Lock()
+	defer fake.deleteNavigationMutex.Unlock()
+	fake.DeleteNavigationStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) DeleteNavigationArgsForCall(i int) (context.Context, string) {
+	fake.deleteNavigationMutex.RLock()
+	defer fake.deleteNavigationMutex.RUnlock()
+	argsForCall := fake.deleteNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) DeleteNavigationReturns(result1 error) {
+	fake.deleteNavigationMutex.Lock()
+	defer fake.deleteNavigationMutex.Unlock()
+	fake.DeleteNavigationStub = nil
+	fake.deleteNavigationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteNavigationReturnsOnCall(i int, result1 error) {
+	fake.deleteNavigationMutex.Lock()
+	defer fake.deleteNavigationMutex.Unlock()
+	fake.DeleteNavigationStub = nil
+	if fake.deleteNavigationReturnsOnCall == nil {
+		fake.deleteNavigationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteNavigationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteProfile(arg1 context.Context, arg2 string) error {
+	fake.deleteProfileMutex.Lock()
+	ret, specificReturn := fake.deleteProfileReturnsOnCall[len(fake.deleteProfileArgsForCall)]
+	fake.deleteProfileArgsForCall = append(fake.deleteProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteProfileStub
+	fakeReturns := fake.deleteProfileReturns
+	fake.recordInvocation("DeleteProfile", []interface{}{arg1, arg2})
+	fake.deleteProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) DeleteProfileCallCount() int {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return len(fake.deleteProfileArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) DeleteProfileCalls(stub func(context.Context, string) error) {
+	fake.deleteProfileMutex.Lock()
+	defer fake.deleteProfileMutex.Unlock()
+	fake.DeleteProfileStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) DeleteProfileArgsForCall(i int) (context.Context, string) {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	argsForCall := fake.deleteProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) DeleteProfileReturns(result1 error) {
+	fake.deleteProfileMutex.Lock()
+	defer fake.deleteProfileMutex.Unlock()
+	fake.DeleteProfileStub = nil
+	fake.deleteProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteProfileReturnsOnCall(i int, result1 error) {
+	fake.deleteProfileMutex.Lock()
+	defer fake.deleteProfileMutex.Unlock()
+	fake.DeleteProfileStub = nil
+	if fake.deleteProfileReturnsOnCall == nil {
+		fake.deleteProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRole(arg1 context.Context, arg2 string) error {
+	fake.deleteRoleMutex.Lock()
+	ret, specificReturn := fake.deleteRoleReturnsOnCall[len(fake.deleteRoleArgsForCall)]
+	fake.deleteRoleArgsForCall = append(fake.deleteRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteRoleStub
+	fakeReturns := fake.deleteRoleReturns
+	fake.recordInvocation("DeleteRole", []interface{}{arg1, arg2})
+	fake.deleteRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleCallCount() int {
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	return len(fake.deleteRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleCalls(stub func(context.Context, string) error) {
+	fake.deleteRoleMutex.Lock()
+	defer fake.deleteRoleMutex.Unlock()
+	fake.DeleteRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleArgsForCall(i int) (context.Context, string) {
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	argsForCall := fake.deleteRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleReturns(result1 error) {
+	fake.deleteRoleMutex.Lock()
+	defer fake.deleteRoleMutex.Unlock()
+	fake.DeleteRoleStub = nil
+	fake.deleteRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleReturnsOnCall(i int, result1 error) {
+	fake.deleteRoleMutex.Lock()
+	defer fake.deleteRoleMutex.Unlock()
+	fake.DeleteRoleStub = nil
+	if fake.deleteRoleReturnsOnCall == nil {
+		fake.deleteRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleTask(arg1 context.Context, arg2 string) error {
+	fake.deleteRoleTaskMutex.Lock()
+	ret, specificReturn := fake.deleteRoleTaskReturnsOnCall[len(fake.deleteRoleTaskArgsForCall)]
+	fake.deleteRoleTaskArgsForCall = append(fake.deleteRoleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteRoleTaskStub
+	fakeReturns := fake.deleteRoleTaskReturns
+	fake.recordInvocation("DeleteRoleTask", []interface{}{arg1, arg2})
+	fake.deleteRoleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleTaskCallCount() int {
+	fake.deleteRoleTaskMutex.RLock()
+	defer fake.deleteRoleTaskMutex.RUnlock()
+	return len(fake.deleteRoleTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleTaskCalls(stub func(context.Context, string) error) {
+	fake.deleteRoleTaskMutex.Lock()
+	defer fake.deleteRoleTaskMutex.Unlock()
+	fake.DeleteRoleTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleTaskArgsForCall(i int) (context.Context, string) {
+	fake.deleteRoleTaskMutex.RLock()
+	defer fake.deleteRoleTaskMutex.RUnlock()
+	argsForCall := fake.deleteRoleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleTaskReturns(result1 error) {
+	fake.deleteRoleTaskMutex.Lock()
+	defer fake.deleteRoleTaskMutex.Unlock()
+	fake.DeleteRoleTaskStub = nil
+	fake.deleteRoleTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteRoleTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteRoleTaskMutex.Lock()
+	defer fake.deleteRoleTaskMutex.Unlock()
+	fake.DeleteRoleTaskStub = nil
+	if fake.deleteRoleTaskReturnsOnCall == nil {
+		fake.deleteRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRoleTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteTask(arg1 context.Context, arg2 string) error {
+	fake.deleteTaskMutex.Lock()
+	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
+	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteTaskStub
+	fakeReturns := fake.deleteTaskReturns
+	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2})
+	fake.deleteTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) DeleteTaskCallCount() int {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	return len(fake.deleteTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) DeleteTaskCalls(stub func(context.Context, string) error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) DeleteTaskArgsForCall(i int) (context.Context, string) {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	argsForCall := fake.deleteTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) DeleteTaskReturns(result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	fake.deleteTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) DeleteTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	if fake.deleteTaskReturnsOnCall == nil {
+		fake.deleteTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccount(arg1 context.Context, arg2 string) (internal.Account, error) {
+	fake.getAccountMutex.Lock()
+	ret, specificReturn := fake.getAccountReturnsOnCall[len(fake.getAccountArgsForCall)]
+	fake.getAccountArgsForCall = append(fake.getAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAccountStub
+	fakeReturns := fake.getAccountReturns
+	fake.recordInvocation("GetAccount", []interface{}{arg1, arg2})
+	fake.getAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountCallCount() int {
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	return len(fake.getAccountArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountCalls(stub func(context.Context, string) (internal.Account, error)) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountArgsForCall(i int) (context.Context, string) {
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	argsForCall := fake.getAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountReturns(result1 internal.Account, result2 error) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = nil
+	fake.getAccountReturns = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountReturnsOnCall(i int, result1 internal.Account, result2 error) {
+	fake.getAccountMutex.Lock()
+	defer fake.getAccountMutex.Unlock()
+	fake.GetAccountStub = nil
+	if fake.getAccountReturnsOnCall == nil {
+		fake.getAccountReturnsOnCall = make(map[int]struct {
+			result1 internal.Account
+			result2 error
+		})
+	}
+	fake.getAccountReturnsOnCall[i] = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountById(arg1 context.Context, arg2 string) (internal.Account, error) {
+	fake.getAccountByIdMutex.Lock()
+	ret, specificReturn := fake.getAccountByIdReturnsOnCall[len(fake.getAccountByIdArgsForCall)]
+	fake.getAccountByIdArgsForCall = append(fake.getAccountByIdArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAccountByIdStub
+	fakeReturns := fake.getAccountByIdReturns
+	fake.recordInvocation("GetAccountById", []interface{}{arg1, arg2})
+	fake.getAccountByIdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountByIdCallCount() int {
+	fake.getAccountByIdMutex.RLock()
+	defer fake.getAccountByIdMutex.RUnlock()
+	return len(fake.getAccountByIdArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountByIdCalls(stub func(context.Context, string) (internal.Account, error)) {
+	fake.getAccountByIdMutex.Lock()
+	defer fake.getAccountByIdMutex.Unlock()
+	fake.GetAccountByIdStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountByIdArgsForCall(i int) (context.Context, string) {
+	fake.getAccountByIdMutex.RLock()
+	defer fake.getAccountByIdMutex.RUnlock()
+	argsForCall := fake.getAccountByIdArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountByIdReturns(result1 internal.Account, result2 error) {
+	fake.getAccountByIdMutex.Lock()
+	defer fake.getAccountByIdMutex.Unlock()
+	fake.GetAccountByIdStub = nil
+	fake.getAccountByIdReturns = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountByIdReturnsOnCall(i int, result1 internal.Account, result2 error) {
+	fake.getAccountByIdMutex.Lock()
+	defer fake.getAccountByIdMutex.Unlock()
+	fake.GetAccountByIdStub = nil
+	if fake.getAccountByIdReturnsOnCall == nil {
+		fake.getAccountByIdReturnsOnCall = make(map[int]struct {
+			result1 internal.Account
+			result2 error
+		})
+	}
+	fake.getAccountByIdReturnsOnCall[i] = struct {
+		result1 internal.Account
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRole(arg1 context.Context, arg2 string) (internal.AccountRoles, error) {
+	fake.getAccountRoleMutex.Lock()
+	ret, specificReturn := fake.getAccountRoleReturnsOnCall[len(fake.getAccountRoleArgsForCall)]
+	fake.getAccountRoleArgsForCall = append(fake.getAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAccountRoleStub
+	fakeReturns := fake.getAccountRoleReturns
+	fake.recordInvocation("GetAccountRole", []interface{}{arg1, arg2})
+	fake.getAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleCallCount() int {
+	fake.getAccountRoleMutex.RLock()
+	defer fake.getAccountRoleMutex.RUnlock()
+	return len(fake.getAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleCalls(stub func(context.Context, string) (internal.AccountRoles, error)) {
+	fake.getAccountRoleMutex.Lock()
+	defer fake.getAccountRoleMutex.Unlock()
+	fake.GetAccountRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleArgsForCall(i int) (context.Context, string) {
+	fake.getAccountRoleMutex.RLock()
+	defer fake.getAccountRoleMutex.RUnlock()
+	argsForCall := fake.getAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleReturns(result1 internal.AccountRoles, result2 error) {
+	fake.getAccountRoleMutex.Lock()
+	defer fake.getAccountRoleMutex.Unlock()
+	fake.GetAccountRoleStub = nil
+	fake.getAccountRoleReturns = struct {
+		result1 internal.AccountRoles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleReturnsOnCall(i int, result1 internal.AccountRoles, result2 error) {
+	fake.getAccountRoleMutex.Lock()
+	defer fake.getAccountRoleMutex.Unlock()
+	fake.GetAccountRoleStub = nil
+	if fake.getAccountRoleReturnsOnCall == nil {
+		fake.getAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.AccountRoles
+			result2 error
+		})
+	}
+	fake.getAccountRoleReturnsOnCall[i] = struct {
+		result1 internal.AccountRoles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByAccount(arg1 context.Context, arg2 string) (internal.AccountRoleByAccountResult, error) {
+	fake.getAccountRoleByAccountMutex.Lock()
+	ret, specificReturn := fake.getAccountRoleByAccountReturnsOnCall[len(fake.getAccountRoleByAccountArgsForCall)]
+	fake.getAccountRoleByAccountArgsForCall = append(fake.getAccountRoleByAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAccountRoleByAccountStub
+	fakeReturns := fake.getAccountRoleByAccountReturns
+	fake.recordInvocation("GetAccountRoleByAccount", []interface{}{arg1, arg2})
+	fake.getAccountRoleByAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByAccountCallCount() int {
+	fake.getAccountRoleByAccountMutex.RLock()
+	defer fake.getAccountRoleByAccountMutex.RUnlock()
+	return len(fake.getAccountRoleByAccountArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByAccountCalls(stub func(context.Context, string) (internal.AccountRoleByAccountResult, error)) {
+	fake.getAccountRoleByAccountMutex.Lock()
+	defer fake.getAccountRoleByAccountMutex.Unlock()
+	fake.GetAccountRoleByAccountStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByAccountArgsForCall(i int) (context.Context, string) {
+	fake.getAccountRoleByAccountMutex.RLock()
+	defer fake.getAccountRoleByAccountMutex.RUnlock()
+	argsForCall := fake.getAccountRoleByAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByAccountReturns(result1 internal.AccountRoleByAccountResult, result2 error) {
+	fake.getAccountRoleByAccountMutex.Lock()
+	defer fake.getAccountRoleByAccountMutex.Unlock()
+	fake.GetAccountRoleByAccountStub = nil
+	fake.getAccountRoleByAccountReturns = struct {
+		result1 internal.AccountRoleByAccountResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByAccountReturnsOnCall(i int, result1 internal.AccountRoleByAccountResult, result2 error) {
+	fake.getAccountRoleByAccountMutex.Lock()
+	defer fake.getAccountRoleByAccountMutex.Unlock()
+	fake.GetAccountRoleByAccountStub = nil
+	if fake.getAccountRoleByAccountReturnsOnCall == nil {
+		fake.getAccountRoleByAccountReturnsOnCall = make(map[int]struct {
+			result1 internal.AccountRoleByAccountResult
+			result2 error
+		})
+	}
+	fake.getAccountRoleByAccountReturnsOnCall[i] = struct {
+		result1 internal.AccountRoleByAccountResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByRole(arg1 context.Context, arg2 string) (internal.AccountRoleByRoleResult, error) {
+	fake.getAccountRoleByRoleMutex.Lock()
+	ret, specificReturn := fake.getAccountRoleByRoleReturnsOnCall[len(fake.getAccountRoleByRoleArgsForCall)]
+	fake.getAccountRoleByRoleArgsForCall = append(fake.getAccountRoleByRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAccountRoleByRoleStub
+	fakeReturns := fake.getAccountRoleByRoleReturns
+	fake.recordInvocation("GetAccountRoleByRole", []interface{}{arg1, arg2})
+	fake.getAccountRoleByRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByRoleCallCount() int {
+	fake.getAccountRoleByRoleMutex.RLock()
+	defer fake.getAccountRoleByRoleMutex.RUnlock()
+	return len(fake.getAccountRoleByRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByRoleCalls(stub func(context.Context, string) (internal.AccountRoleByRoleResult, error)) {
+	fake.getAccountRoleByRoleMutex.Lock()
+	defer fake.getAccountRoleByRoleMutex.Unlock()
+	fake.GetAccountRoleByRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByRoleArgsForCall(i int) (context.Context, string) {
+	fake.getAccountRoleByRoleMutex.RLock()
+	defer fake.getAccountRoleByRoleMutex.RUnlock()
+	argsForCall := fake.getAccountRoleByRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByRoleReturns(result1 internal.AccountRoleByRoleResult, result2 error) {
+	fake.getAccountRoleByRoleMutex.Lock()
+	defer fake.getAccountRoleByRoleMutex.Unlock()
+	fake.GetAccountRoleByRoleStub = nil
+	fake.getAccountRoleByRoleReturns = struct {
+		result1 internal.AccountRoleByRoleResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetAccountRoleByRoleReturnsOnCall(i int, result1 internal.AccountRoleByRoleResult, result2 error) {
+	fake.getAccountRoleByRoleMutex.Lock()
+	defer fake.getAccountRoleByRoleMutex.Unlock()
+	fake.GetAccountRoleByRoleStub = nil
+	if fake.getAccountRoleByRoleReturnsOnCall == nil {
+		fake.getAccountRoleByRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.AccountRoleByRoleResult
+			result2 error
+		})
+	}
+	fake.getAccountRoleByRoleReturnsOnCall[i] = struct {
+		result1 internal.AccountRoleByRoleResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpText(arg1 context.Context, arg2 string) (internal.HelpText, error) {
+	fake.getHelpTextMutex.Lock()
+	ret, specificReturn := fake.getHelpTextReturnsOnCall[len(fake.getHelpTextArgsForCall)]
+	fake.getHelpTextArgsForCall = append(fake.getHelpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetHelpTextStub
+	fakeReturns := fake.getHelpTextReturns
+	fake.recordInvocation("GetHelpText", []interface{}{arg1, arg2})
+	fake.getHelpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextCallCount() int {
+	fake.getHelpTextMutex.RLock()
+	defer fake.getHelpTextMutex.RUnlock()
+	return len(fake.getHelpTextArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextCalls(stub func(context.Context, string) (internal.HelpText, error)) {
+	fake.getHelpTextMutex.Lock()
+	defer fake.getHelpTextMutex.Unlock()
+	fake.GetHelpTextStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextArgsForCall(i int) (context.Context, string) {
+	fake.getHelpTextMutex.RLock()
+	defer fake.getHelpTextMutex.RUnlock()
+	argsForCall := fake.getHelpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextReturns(result1 internal.HelpText, result2 error) {
+	fake.getHelpTextMutex.Lock()
+	defer fake.getHelpTextMutex.Unlock()
+	fake.GetHelpTextStub = nil
+	fake.getHelpTextReturns = struct {
+		result1 internal.HelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextReturnsOnCall(i int, result1 internal.HelpText, result2 error) {
+	fake.getHelpTextMutex.Lock()
+	defer fake.getHelpTextMutex.Unlock()
+	fake.GetHelpTextStub = nil
+	if fake.getHelpTextReturnsOnCall == nil {
+		fake.getHelpTextReturnsOnCall = make(map[int]struct {
+			result1 internal.HelpText
+			result2 error
+		})
+	}
+	fake.getHelpTextReturnsOnCall[i] = struct {
+		result1 internal.HelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextByTask(arg1 context.Context, arg2 string) (internal.HelpText, error) {
+	fake.getHelpTextByTaskMutex.Lock()
+	ret, specificReturn := fake.getHelpTextByTaskReturnsOnCall[len(fake.getHelpTextByTaskArgsForCall)]
+	fake.getHelpTextByTaskArgsForCall = append(fake.getHelpTextByTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetHelpTextByTaskStub
+	fakeReturns := fake.getHelpTextByTaskReturns
+	fake.recordInvocation("GetHelpTextByTask", []interface{}{arg1, arg2})
+	fake.getHelpTextByTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextByTaskCallCount() int {
+	fake.getHelpTextByTaskMutex.RLock()
+	defer fake.getHelpTextByTaskMutex.RUnlock()
+	return len(fake.getHelpTextByTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextByTaskCalls(stub func(context.Context, string) (internal.HelpText, error)) {
+	fake.getHelpTextByTaskMutex.Lock()
+	defer fake.getHelpTextByTaskMutex.Unlock()
+	fake.GetHelpTextByTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextByTaskArgsForCall(i int) (context.Context, string) {
+	fake.getHelpTextByTaskMutex.RLock()
+	defer fake.getHelpTextByTaskMutex.RUnlock()
+	argsForCall := fake.getHelpTextByTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextByTaskReturns(result1 internal.HelpText, result2 error) {
+	fake.getHelpTextByTaskMutex.Lock()
+	defer fake.getHelpTextByTaskMutex.Unlock()
+	fake.GetHelpTextByTaskStub = nil
+	fake.getHelpTextByTaskReturns = struct {
+		result1 internal.HelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetHelpTextByTaskReturnsOnCall(i int, result1 internal.HelpText, result2 error) {
+	fake.getHelpTextByTaskMutex.Lock()
+	defer fake.getHelpTextByTaskMutex.Unlock()
+	fake.GetHelpTextByTaskStub = nil
+	if fake.getHelpTextByTaskReturnsOnCall == nil {
+		fake.getHelpTextByTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.HelpText
+			result2 error
+		})
+	}
+	fake.getHelpTextByTaskReturnsOnCall[i] = struct {
+		result1 internal.HelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetMenu(arg1 context.Context, arg2 string) (internal.Menu, error) {
+	fake.getMenuMutex.Lock()
+	ret, specificReturn := fake.getMenuReturnsOnCall[len(fake.getMenuArgsForCall)]
+	fake.getMenuArgsForCall = append(fake.getMenuArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetMenuStub
+	fakeReturns := fake.getMenuReturns
+	fake.recordInvocation("GetMenu", []interface{}{arg1, arg2})
+	fake.getMenuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuCallCount() int {
+	fake.getMenuMutex.RLock()
+	defer fake.getMenuMutex.RUnlock()
+	return len(fake.getMenuArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuCalls(stub func(context.Context, string) (internal.Menu, error)) {
+	fake.getMenuMutex.Lock()
+	defer fake.getMenuMutex.Unlock()
+	fake.GetMenuStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuArgsForCall(i int) (context.Context, string) {
+	fake.getMenuMutex.RLock()
+	defer fake.getMenuMutex.RUnlock()
+	argsForCall := fake.getMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuReturns(result1 internal.Menu, result2 error) {
+	fake.getMenuMutex.Lock()
+	defer fake.getMenuMutex.Unlock()
+	fake.GetMenuStub = nil
+	fake.getMenuReturns = struct {
+		result1 internal.Menu
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuReturnsOnCall(i int, result1 internal.Menu, result2 error) {
+	fake.getMenuMutex.Lock()
+	defer fake.getMenuMutex.Unlock()
+	fake.GetMenuStub = nil
+	if fake.getMenuReturnsOnCall == nil {
+		fake.getMenuReturnsOnCall = make(map[int]struct {
+			result1 internal.Menu
+			result2 error
+		})
+	}
+	fake.getMenuReturnsOnCall[i] = struct {
+		result1 internal.Menu
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuByTask(arg1 context.Context, arg2 string) ([]internal.Menu, error) {
+	fake.getMenuByTaskMutex.Lock()
+	ret, specificReturn := fake.getMenuByTaskReturnsOnCall[len(fake.getMenuByTaskArgsForCall)]
+	fake.getMenuByTaskArgsForCall = append(fake.getMenuByTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetMenuByTaskStub
+	fakeReturns := fake.getMenuByTaskReturns
+	fake.recordInvocation("GetMenuByTask", []interface{}{arg1, arg2})
+	fake.getMenuByTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuByTaskCallCount() int {
+	fake.getMenuByTaskMutex.RLock()
+	defer fake.getMenuByTaskMutex.RUnlock()
+	return len(fake.getMenuByTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuByTaskCalls(stub func(context.Context, string) ([]internal.Menu, error)) {
+	fake.getMenuByTaskMutex.Lock()
+	defer fake.getMenuByTaskMutex.Unlock()
+	fake.GetMenuByTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuByTaskArgsForCall(i int) (context.Context, string) {
+	fake.getMenuByTaskMutex.RLock()
+	defer fake.getMenuByTaskMutex.RUnlock()
+	argsForCall := fake.getMenuByTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuByTaskReturns(result1 []internal.Menu, result2 error) {
+	fake.getMenuByTaskMutex.Lock()
+	defer fake.getMenuByTaskMutex.Unlock()
+	fake.GetMenuByTaskStub = nil
+	fake.getMenuByTaskReturns = struct {
+		result1 []internal.Menu
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetMenuByTaskReturnsOnCall(i int, result1 []internal.Menu, result2 error) {
+	fake.getMenuByTaskMutex.Lock()
+	defer fake.getMenuByTaskMutex.Unlock()
+	fake.GetMenuByTaskStub = nil
+	if fake.getMenuByTaskReturnsOnCall == nil {
+		fake.getMenuByTaskReturnsOnCall = make(map[int]struct {
+			result1 []internal.Menu
+			result2 error
+		})
+	}
+	fake.getMenuByTaskReturnsOnCall[i] = struct {
+		result1 []internal.Menu
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigation(arg1 context.Context, arg2 string) (internal.Navigation, error) {
+	fake.getNavigationMutex.Lock()
+	ret, specificReturn := fake.getNavigationReturnsOnCall[len(fake.getNavigationArgsForCall)]
+	fake.getNavigationArgsForCall = append(fake.getNavigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNavigationStub
+	fakeReturns := fake.getNavigationReturns
+	fake.recordInvocation("GetNavigation", []interface{}{arg1, arg2})
+	fake.getNavigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationCallCount() int {
+	fake.getNavigationMutex.RLock()
+	defer fake.getNavigationMutex.RUnlock()
+	return len(fake.getNavigationArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationCalls(stub func(context.Context, string) (internal.Navigation, error)) {
+	fake.getNavigationMutex.Lock()
+	defer fake.getNavigationMutex.Unlock()
+	fake.GetNavigationStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationArgsForCall(i int) (context.Context, string) {
+	fake.getNavigationMutex.RLock()
+	defer fake.getNavigationMutex.RUnlock()
+	argsForCall := fake.getNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationReturns(result1 internal.Navigation, result2 error) {
+	fake.getNavigationMutex.Lock()
+	defer fake.getNavigationMutex.Unlock()
+	fake.GetNavigationStub = nil
+	fake.getNavigationReturns = struct {
+		result1 internal.Navigation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationReturnsOnCall(i int, result1 internal.Navigation, result2 error) {
+	fake.getNavigationMutex.Lock()
+	defer fake.getNavigationMutex.Unlock()
+	fake.GetNavigationStub = nil
+	if fake.getNavigationReturnsOnCall == nil {
+		fake.getNavigationReturnsOnCall = make(map[int]struct {
+			result1 internal.Navigation
+			result2 error
+		})
+	}
+	fake.getNavigationReturnsOnCall[i] = struct {
+		result1 internal.Navigation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationByTask(arg1 context.Context, arg2 string) ([]internal.Navigation, error) {
+	fake.getNavigationByTaskMutex.Lock()
+	ret, specificReturn := fake.getNavigationByTaskReturnsOnCall[len(fake.getNavigationByTaskArgsForCall)]
+	fake.getNavigationByTaskArgsForCall = append(fake.getNavigationByTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNavigationByTaskStub
+	fakeReturns := fake.getNavigationByTaskReturns
+	fake.recordInvocation("GetNavigationByTask", []interface{}{arg1, arg2})
+	fake.getNavigationByTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationByTaskCallCount() int {
+	fake.getNavigationByTaskMutex.RLock()
+	defer fake.getNavigationByTaskMutex.RUnlock()
+	return len(fake.getNavigationByTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationByTaskCalls(stub func(context.Context, string) ([]internal.Navigation, error)) {
+	fake.getNavigationByTaskMutex.Lock()
+	defer fake.getNavigationByTaskMutex.Unlock()
+	fake.GetNavigationByTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationByTaskArgsForCall(i int) (context.Context, string) {
+	fake.getNavigationByTaskMutex.RLock()
+	defer fake.getNavigationByTaskMutex.RUnlock()
+	argsForCall := fake.getNavigationByTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationByTaskReturns(result1 []internal.Navigation, result2 error) {
+	fake.getNavigationByTaskMutex.Lock()
+	defer fake.getNavigationByTaskMutex.Unlock()
+	fake.GetNavigationByTaskStub = nil
+	fake.getNavigationByTaskReturns = struct {
+		result1 []internal.Navigation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetNavigationByTaskReturnsOnCall(i int, result1 []internal.Navigation, result2 error) {
+	fake.getNavigationByTaskMutex.Lock()
+	defer fake.getNavigationByTaskMutex.Unlock()
+	fake.GetNavigationByTaskStub = nil
+	if fake.getNavigationByTaskReturnsOnCall == nil {
+		fake.getNavigationByTaskReturnsOnCall = make(map[int]struct {
+			result1 []internal.Navigation
+			result2 error
+		})
+	}
+	fake.getNavigationByTaskReturnsOnCall[i] = struct {
+		result1 []internal.Navigation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetProfile(arg1 context.Context, arg2 string) (internal.Profile, error) {
+	fake.getProfileMutex.Lock()
+	ret, specificReturn := fake.getProfileReturnsOnCall[len(fake.getProfileArgsForCall)]
+	fake.getProfileArgsForCall = append(fake.getProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetProfileStub
+	fakeReturns := fake.getProfileReturns
+	fake.recordInvocation("GetProfile", []interface{}{arg1, arg2})
+	fake.getProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetProfileCallCount() int {
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
+	return len(fake.getProfileArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetProfileCalls(stub func(context.Context, string) (internal.Profile, error)) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetProfileArgsForCall(i int) (context.Context, string) {
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
+	argsForCall := fake.getProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetProfileReturns(result1 internal.Profile, result2 error) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = nil
+	fake.getProfileReturns = struct {
+		result1 internal.Profile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetProfileReturnsOnCall(i int, result1 internal.Profile, result2 error) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = nil
+	if fake.getProfileReturnsOnCall == nil {
+		fake.getProfileReturnsOnCall = make(map[int]struct {
+			result1 internal.Profile
+			result2 error
+		})
+	}
+	fake.getProfileReturnsOnCall[i] = struct {
+		result1 internal.Profile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetRole(arg1 context.Context, arg2 string) (internal.Roles, error) {
+	fake.getRoleMutex.Lock()
+	ret, specificReturn := fake.getRoleReturnsOnCall[len(fake.getRoleArgsForCall)]
+	fake.getRoleArgsForCall = append(fake.getRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetRoleStub
+	fakeReturns := fake.getRoleReturns
+	fake.recordInvocation("GetRole", []interface{}{arg1, arg2})
+	fake.getRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleCallCount() int {
+	fake.getRoleMutex.RLock()
+	defer fake.getRoleMutex.RUnlock()
+	return len(fake.getRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleCalls(stub func(context.Context, string) (internal.Roles, error)) {
+	fake.getRoleMutex.Lock()
+	defer fake.getRoleMutex.Unlock()
+	fake.GetRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleArgsForCall(i int) (context.Context, string) {
+	fake.getRoleMutex.RLock()
+	defer fake.getRoleMutex.RUnlock()
+	argsForCall := fake.getRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleReturns(result1 internal.Roles, result2 error) {
+	fake.getRoleMutex.Lock()
+	defer fake.getRoleMutex.Unlock()
+	fake.GetRoleStub = nil
+	fake.getRoleReturns = struct {
+		result1 internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleReturnsOnCall(i int, result1 internal.Roles, result2 error) {
+	fake.getRoleMutex.Lock()
+	defer fake.getRoleMutex.Unlock()
+	fake.GetRoleStub = nil
+	if fake.getRoleReturnsOnCall == nil {
+		fake.getRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.Roles
+			result2 error
+		})
+	}
+	fake.getRoleReturnsOnCall[i] = struct {
+		result1 internal.Roles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTask(arg1 context.Context, arg2 string) (internal.RoleTasks, error) {
+	fake.getRoleTaskMutex.Lock()
+	ret, specificReturn := fake.getRoleTaskReturnsOnCall[len(fake.getRoleTaskArgsForCall)]
+	fake.getRoleTaskArgsForCall = append(fake.getRoleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetRoleTaskStub
+	fakeReturns := fake.getRoleTaskReturns
+	fake.recordInvocation("GetRoleTask", []interface{}{arg1, arg2})
+	fake.getRoleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskCallCount() int {
+	fake.getRoleTaskMutex.RLock()
+	defer fake.getRoleTaskMutex.RUnlock()
+	return len(fake.getRoleTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskCalls(stub func(context.Context, string) (internal.RoleTasks, error)) {
+	fake.getRoleTaskMutex.Lock()
+	defer fake.getRoleTaskMutex.Unlock()
+	fake.GetRoleTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskArgsForCall(i int) (context.Context, string) {
+	fake.getRoleTaskMutex.RLock()
+	defer fake.getRoleTaskMutex.RUnlock()
+	argsForCall := fake.getRoleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskReturns(result1 internal.RoleTasks, result2 error) {
+	fake.getRoleTaskMutex.Lock()
+	defer fake.getRoleTaskMutex.Unlock()
+	fake.GetRoleTaskStub = nil
+	fake.getRoleTaskReturns = struct {
+		result1 internal.RoleTasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskReturnsOnCall(i int, result1 internal.RoleTasks, result2 error) {
+	fake.getRoleTaskMutex.Lock()
+	defer fake.getRoleTaskMutex.Unlock()
+	fake.GetRoleTaskStub = nil
+	if fake.getRoleTaskReturnsOnCall == nil {
+		fake.getRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.RoleTasks
+			result2 error
+		})
+	}
+	fake.getRoleTaskReturnsOnCall[i] = struct {
+		result1 internal.RoleTasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByRole(arg1 context.Context, arg2 string) (internal.RoleTaskByRole, error) {
+	fake.getRoleTaskByRoleMutex.Lock()
+	ret, specificReturn := fake.getRoleTaskByRoleReturnsOnCall[len(fake.getRoleTaskByRoleArgsForCall)]
+	fake.getRoleTaskByRoleArgsForCall = append(fake.getRoleTaskByRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetRoleTaskByRoleStub
+	fakeReturns := fake.getRoleTaskByRoleReturns
+	fake.recordInvocation("GetRoleTaskByRole", []interface{}{arg1, arg2})
+	fake.getRoleTaskByRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByRoleCallCount() int {
+	fake.getRoleTaskByRoleMutex.RLock()
+	defer fake.getRoleTaskByRoleMutex.RUnlock()
+	return len(fake.getRoleTaskByRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByRoleCalls(stub func(context.Context, string) (internal.RoleTaskByRole, error)) {
+	fake.getRoleTaskByRoleMutex.Lock()
+	defer fake.getRoleTaskByRoleMutex.Unlock()
+	fake.GetRoleTaskByRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByRoleArgsForCall(i int) (context.Context, string) {
+	fake.getRoleTaskByRoleMutex.RLock()
+	defer fake.getRoleTaskByRoleMutex.RUnlock()
+	argsForCall := fake.getRoleTaskByRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByRoleReturns(result1 internal.RoleTaskByRole, result2 error) {
+	fake.getRoleTaskByRoleMutex.Lock()
+	defer fake.getRoleTaskByRoleMutex.Unlock()
+	fake.GetRoleTaskByRoleStub = nil
+	fake.getRoleTaskByRoleReturns = struct {
+		result1 internal.RoleTaskByRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByRoleReturnsOnCall(i int, result1 internal.RoleTaskByRole, result2 error) {
+	fake.getRoleTaskByRoleMutex.Lock()
+	defer fake.getRoleTaskByRoleMutex.Unlock()
+	fake.GetRoleTaskByRoleStub = nil
+	if fake.getRoleTaskByRoleReturnsOnCall == nil {
+		fake.getRoleTaskByRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.RoleTaskByRole
+			result2 error
+		})
+	}
+	fake.getRoleTaskByRoleReturnsOnCall[i] = struct {
+		result1 internal.RoleTaskByRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByTask(arg1 context.Context, arg2 string) (internal.RoleTaskByTask, error) {
+	fake.getRoleTaskByTaskMutex.Lock()
+	ret, specificReturn := fake.getRoleTaskByTaskReturnsOnCall[len(fake.getRoleTaskByTaskArgsForCall)]
+	fake.getRoleTaskByTaskArgsForCall = append(fake.getRoleTaskByTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetRoleTaskByTaskStub
+	fakeReturns := fake.getRoleTaskByTaskReturns
+	fake.recordInvocation("GetRoleTaskByTask", []interface{}{arg1, arg2})
+	fake.getRoleTaskByTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByTaskCallCount() int {
+	fake.getRoleTaskByTaskMutex.RLock()
+	defer fake.getRoleTaskByTaskMutex.RUnlock()
+	return len(fake.getRoleTaskByTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByTaskCalls(stub func(context.Context, string) (internal.RoleTaskByTask, error)) {
+	fake.getRoleTaskByTaskMutex.Lock()
+	defer fake.getRoleTaskByTaskMutex.Unlock()
+	fake.GetRoleTaskByTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByTaskArgsForCall(i int) (context.Context, string) {
+	fake.getRoleTaskByTaskMutex.RLock()
+	defer fake.getRoleTaskByTaskMutex.RUnlock()
+	argsForCall := fake.getRoleTaskByTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByTaskReturns(result1 internal.RoleTaskByTask, result2 error) {
+	fake.getRoleTaskByTaskMutex.Lock()
+	defer fake.getRoleTaskByTaskMutex.Unlock()
+	fake.GetRoleTaskByTaskStub = nil
+	fake.getRoleTaskByTaskReturns = struct {
+		result1 internal.RoleTaskByTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetRoleTaskByTaskReturnsOnCall(i int, result1 internal.RoleTaskByTask, result2 error) {
+	fake.getRoleTaskByTaskMutex.Lock()
+	defer fake.getRoleTaskByTaskMutex.Unlock()
+	fake.GetRoleTaskByTaskStub = nil
+	if fake.getRoleTaskByTaskReturnsOnCall == nil {
+		fake.getRoleTaskByTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.RoleTaskByTask
+			result2 error
+		})
+	}
+	fake.getRoleTaskByTaskReturnsOnCall[i] = struct {
+		result1 internal.RoleTaskByTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetTask(arg1 context.Context, arg2 string) (internal.Tasks, error) {
+	fake.getTaskMutex.Lock()
+	ret, specificReturn := fake.getTaskReturnsOnCall[len(fake.getTaskArgsForCall)]
+	fake.getTaskArgsForCall = append(fake.getTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetTaskStub
+	fakeReturns := fake.getTaskReturns
+	fake.recordInvocation("GetTask", []interface{}{arg1, arg2})
+	fake.getTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) GetTaskCallCount() int {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return len(fake.getTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) GetTaskCalls(stub func(context.Context, string) (internal.Tasks, error)) {
+	fake.getTaskMutex.Lock()
+	defer fake.getTaskMutex.Unlock()
+	fake.GetTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) GetTaskArgsForCall(i int) (context.Context, string) {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	argsForCall := fake.getTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) GetTaskReturns(result1 internal.Tasks, result2 error) {
+	fake.getTaskMutex.Lock()
+	defer fake.getTaskMutex.Unlock()
+	fake.GetTaskStub = nil
+	fake.getTaskReturns = struct {
+		result1 internal.Tasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) GetTaskReturnsOnCall(i int, result1 internal.Tasks, result2 error) {
+	fake.getTaskMutex.Lock()
+	defer fake.getTaskMutex.Unlock()
+	fake.GetTaskStub = nil
+	if fake.getTaskReturnsOnCall == nil {
+		fake.getTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.Tasks
+			result2 error
+		})
+	}
+	fake.getTaskReturnsOnCall[i] = struct {
+		result1 internal.Tasks
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccount(arg1 context.Context, arg2 internal.Account) error {
+	fake.indexAccountMutex.Lock()
+	ret, specificReturn := fake.indexAccountReturnsOnCall[len(fake.indexAccountArgsForCall)]
+	fake.indexAccountArgsForCall = append(fake.indexAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Account
+	}{arg1, arg2})
+	stub := fake.IndexAccountStub
+	fakeReturns := fake.indexAccountReturns
+	fake.recordInvocation("IndexAccount", []interface{}{arg1, arg2})
+	fake.indexAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountCallCount() int {
+	fake.indexAccountMutex.RLock()
+	defer fake.indexAccountMutex.RUnlock()
+	return len(fake.indexAccountArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountCalls(stub func(context.Context, internal.Account) error) {
+	fake.indexAccountMutex.Lock()
+	defer fake.indexAccountMutex.Unlock()
+	fake.IndexAccountStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountArgsForCall(i int) (context.Context, internal.Account) {
+	fake.indexAccountMutex.RLock()
+	defer fake.indexAccountMutex.RUnlock()
+	argsForCall := fake.indexAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountReturns(result1 error) {
+	fake.indexAccountMutex.Lock()
+	defer fake.indexAccountMutex.Unlock()
+	fake.IndexAccountStub = nil
+	fake.indexAccountReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountReturnsOnCall(i int, result1 error) {
+	fake.indexAccountMutex.Lock()
+	defer fake.indexAccountMutex.Unlock()
+	fake.IndexAccountStub = nil
+	if fake.indexAccountReturnsOnCall == nil {
+		fake.indexAccountReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountRole(arg1 context.Context, arg2 internal.AccountRoles) error {
+	fake.indexAccountRoleMutex.Lock()
+	ret, specificReturn := fake.indexAccountRoleReturnsOnCall[len(fake.indexAccountRoleArgsForCall)]
+	fake.indexAccountRoleArgsForCall = append(fake.indexAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}{arg1, arg2})
+	stub := fake.IndexAccountRoleStub
+	fakeReturns := fake.indexAccountRoleReturns
+	fake.recordInvocation("IndexAccountRole", []interface{}{arg1, arg2})
+	fake.indexAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountRoleCallCount() int {
+	fake.indexAccountRoleMutex.RLock()
+	defer fake.indexAccountRoleMutex.RUnlock()
+	return len(fake.indexAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountRoleCalls(stub func(context.Context, internal.AccountRoles) error) {
+	fake.indexAccountRoleMutex.Lock()
+	defer fake.indexAccountRoleMutex.Unlock()
+	fake.IndexAccountRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountRoleArgsForCall(i int) (context.Context, internal.AccountRoles) {
+	fake.indexAccountRoleMutex.RLock()
+	defer fake.indexAccountRoleMutex.RUnlock()
+	argsForCall := fake.indexAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountRoleReturns(result1 error) {
+	fake.indexAccountRoleMutex.Lock()
+	defer fake.indexAccountRoleMutex.Unlock()
+	fake.IndexAccountRoleStub = nil
+	fake.indexAccountRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexAccountRoleReturnsOnCall(i int, result1 error) {
+	fake.indexAccountRoleMutex.Lock()
+	defer fake.indexAccountRoleMutex.Unlock()
+	fake.IndexAccountRoleStub = nil
+	if fake.indexAccountRoleReturnsOnCall == nil {
+		fake.indexAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexAccountRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexHelpText(arg1 context.Context, arg2 internal.HelpText) error {
+	fake.indexHelpTextMutex.Lock()
+	ret, specificReturn := fake.indexHelpTextReturnsOnCall[len(fake.indexHelpTextArgsForCall)]
+	fake.indexHelpTextArgsForCall = append(fake.indexHelpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.HelpText
+	}{arg1, arg2})
+	stub := fake.IndexHelpTextStub
+	fakeReturns := fake.indexHelpTextReturns
+	fake.recordInvocation("IndexHelpText", []interface{}{arg1, arg2})
+	fake.indexHelpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) IndexHelpTextCallCount() int {
+	fake.indexHelpTextMutex.RLock()
+	defer fake.indexHelpTextMutex.RUnlock()
+	return len(fake.indexHelpTextArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) IndexHelpTextCalls(stub func(context.Context, internal.HelpText) error) {
+	fake.indexHelpTextMutex.Lock()
+	defer fake.indexHelpTextMutex.Unlock()
+	fake.IndexHelpTextStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) IndexHelpTextArgsForCall(i int) (context.Context, internal.HelpText) {
+	fake.indexHelpTextMutex.RLock()
+	defer fake.indexHelpTextMutex.RUnlock()
+	argsForCall := fake.indexHelpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) IndexHelpTextReturns(result1 error) {
+	fake.indexHelpTextMutex.Lock()
+	defer fake.indexHelpTextMutex.Unlock()
+	fake.IndexHelpTextStub = nil
+	fake.indexHelpTextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexHelpTextReturnsOnCall(i int, result1 error) {
+	fake.indexHelpTextMutex.Lock()
+	defer fake.indexHelpTextMutex.Unlock()
+	fake.IndexHelpTextStub = nil
+	if fake.indexHelpTextReturnsOnCall == nil {
+		fake.indexHelpTextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexHelpTextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexMenu(arg1 context.Context, arg2 internal.Menu) error {
+	fake.indexMenuMutex.Lock()
+	ret, specificReturn := fake.indexMenuReturnsOnCall[len(fake.indexMenuArgsForCall)]
+	fake.indexMenuArgsForCall = append(fake.indexMenuArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Menu
+	}{arg1, arg2})
+	stub := fake.IndexMenuStub
+	fakeReturns := fake.indexMenuReturns
+	fake.recordInvocation("IndexMenu", []interface{}{arg1, arg2})
+	fake.indexMenuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) IndexMenuCallCount() int {
+	fake.indexMenuMutex.RLock()
+	defer fake.indexMenuMutex.RUnlock()
+	return len(fake.indexMenuArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) IndexMenuCalls(stub func(context.Context, internal.Menu) error) {
+	fake.indexMenuMutex.Lock()
+	defer fake.indexMenuMutex.Unlock()
+	fake.IndexMenuStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) IndexMenuArgsForCall(i int) (context.Context, internal.Menu) {
+	fake.indexMenuMutex.RLock()
+	defer fake.indexMenuMutex.RUnlock()
+	argsForCall := fake.indexMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) IndexMenuReturns(result1 error) {
+	fake.indexMenuMutex.Lock()
+	defer fake.indexMenuMutex.Unlock()
+	fake.IndexMenuStub = nil
+	fake.indexMenuReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexMenuReturnsOnCall(i int, result1 error) {
+	fake.indexMenuMutex.Lock()
+	defer fake.indexMenuMutex.Unlock()
+	fake.IndexMenuStub = nil
+	if fake.indexMenuReturnsOnCall == nil {
+		fake.indexMenuReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexMenuReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexNavigation(arg1 context.Context, arg2 internal.Navigation) error {
+	fake.indexNavigationMutex.Lock()
+	ret, specificReturn := fake.indexNavigationReturnsOnCall[len(fake.indexNavigationArgsForCall)]
+	fake.indexNavigationArgsForCall = append(fake.indexNavigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Navigation
+	}{arg1, arg2})
+	stub := fake.IndexNavigationStub
+	fakeReturns := fake.indexNavigationReturns
+	fake.recordInvocation("IndexNavigation", []interface{}{arg1, arg2})
+	fake.indexNavigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) IndexNavigationCallCount() int {
+	fake.indexNavigationMutex.RLock()
+	defer fake.indexNavigationMutex.RUnlock()
+	return len(fake.indexNavigationArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) IndexNavigationCalls(stub func(context.Context, internal.Navigation) error) {
+	fake.indexNavigationMutex.Lock()
+	defer fake.indexNavigationMutex.Unlock()
+	fake.IndexNavigationStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) IndexNavigationArgsForCall(i int) (context.Context, internal.Navigation) {
+	fake.indexNavigationMutex.RLock()
+	defer fake.indexNavigationMutex.RUnlock()
+	argsForCall := fake.indexNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) IndexNavigationReturns(result1 error) {
+	fake.indexNavigationMutex.Lock()
+	defer fake.indexNavigationMutex.Unlock()
+	fake.IndexNavigationStub = nil
+	fake.indexNavigationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexNavigationReturnsOnCall(i int, result1 error) {
+	fake.indexNavigationMutex.Lock()
+	defer fake.indexNavigationMutex.Unlock()
+	fake.IndexNavigationStub = nil
+	if fake.indexNavigationReturnsOnCall == nil {
+		fake.indexNavigationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexNavigationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexProfile(arg1 context.Context, arg2 internal.Profile) error {
+	fake.indexProfileMutex.Lock()
+	ret, specificReturn := fake.indexProfileReturnsOnCall[len(fake.indexProfileArgsForCall)]
+	fake.indexProfileArgsForCall = append(fake.indexProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}{arg1, arg2})
+	stub := fake.IndexProfileStub
+	fakeReturns := fake.indexProfileReturns
+	fake.recordInvocation("IndexProfile", []interface{}{arg1, arg2})
+	fake.indexProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) IndexProfileCallCount() int {
+	fake.indexProfileMutex.RLock()
+	defer fake.indexProfileMutex.RUnlock()
+	return len(fake.indexProfileArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) IndexProfileCalls(stub func(context.Context, internal.Profile) error) {
+	fake.indexProfileMutex.Lock()
+	defer fake.indexProfileMutex.Unlock()
+	fake.IndexProfileStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) IndexProfileArgsForCall(i int) (context.Context, internal.Profile) {
+	fake.indexProfileMutex.RLock()
+	defer fake.indexProfileMutex.RUnlock()
+	argsForCall := fake.indexProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) IndexProfileReturns(result1 error) {
+	fake.indexProfileMutex.Lock()
+	defer fake.indexProfileMutex.Unlock()
+	fake.IndexProfileStub = nil
+	fake.indexProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexProfileReturnsOnCall(i int, result1 error) {
+	fake.indexProfileMutex.Lock()
+	defer fake.indexProfileMutex.Unlock()
+	fake.IndexProfileStub = nil
+	if fake.indexProfileReturnsOnCall == nil {
+		fake.indexProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexRole(arg1 context.Context, arg2 internal.Roles) error {
+	fake.indexRoleMutex.Lock()
+	ret, specificReturn := fake.indexRoleReturnsOnCall[len(fake.indexRoleArgsForCall)]
+	fake.indexRoleArgsForCall = append(fake.indexRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Roles
+	}{arg1, arg2})
+	stub := fake.IndexRoleStub
+	fakeReturns := fake.indexRoleReturns
+	fake.recordInvocation("IndexRole", []interface{}{arg1, arg2})
+	fake.indexRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleCallCount() int {
+	fake.indexRoleMutex.RLock()
+	defer fake.indexRoleMutex.RUnlock()
+	return len(fake.indexRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleCalls(stub func(context.Context, internal.Roles) error) {
+	fake.indexRoleMutex.Lock()
+	defer fake.indexRoleMutex.Unlock()
+	fake.IndexRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleArgsForCall(i int) (context.Context, internal.Roles) {
+	fake.indexRoleMutex.RLock()
+	defer fake.indexRoleMutex.RUnlock()
+	argsForCall := fake.indexRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleReturns(result1 error) {
+	fake.indexRoleMutex.Lock()
+	defer fake.indexRoleMutex.Unlock()
+	fake.IndexRoleStub = nil
+	fake.indexRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleReturnsOnCall(i int, result1 error) {
+	fake.indexRoleMutex.Lock()
+	defer fake.indexRoleMutex.Unlock()
+	fake.IndexRoleStub = nil
+	if fake.indexRoleReturnsOnCall == nil {
+		fake.indexRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleTask(arg1 context.Context, arg2 internal.RoleTasks) error {
+	fake.indexRoleTaskMutex.Lock()
+	ret, specificReturn := fake.indexRoleTaskReturnsOnCall[len(fake.indexRoleTaskArgsForCall)]
+	fake.indexRoleTaskArgsForCall = append(fake.indexRoleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
+	}{arg1, arg2})
+	stub := fake.IndexRoleTaskStub
+	fakeReturns := fake.indexRoleTaskReturns
+	fake.recordInvocation("IndexRoleTask", []interface{}{arg1, arg2})
+	fake.indexRoleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleTaskCallCount() int {
+	fake.indexRoleTaskMutex.RLock()
+	defer fake.indexRoleTaskMutex.RUnlock()
+	return len(fake.indexRoleTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleTaskCalls(stub func(context.Context, internal.RoleTasks) error) {
+	fake.indexRoleTaskMutex.Lock()
+	defer fake.indexRoleTaskMutex.Unlock()
+	fake.IndexRoleTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleTaskArgsForCall(i int) (context.Context, internal.RoleTasks) {
+	fake.indexRoleTaskMutex.RLock()
+	defer fake.indexRoleTaskMutex.RUnlock()
+	argsForCall := fake.indexRoleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleTaskReturns(result1 error) {
+	fake.indexRoleTaskMutex.Lock()
+	defer fake.indexRoleTaskMutex.Unlock()
+	fake.IndexRoleTaskStub = nil
+	fake.indexRoleTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexRoleTaskReturnsOnCall(i int, result1 error) {
+	fake.indexRoleTaskMutex.Lock()
+	defer fake.indexRoleTaskMutex.Unlock()
+	fake.IndexRoleTaskStub = nil
+	if fake.indexRoleTaskReturnsOnCall == nil {
+		fake.indexRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexRoleTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexTask(arg1 context.Context, arg2 internal.Tasks) error {
+	fake.indexTaskMutex.Lock()
+	ret, specificReturn := fake.indexTaskReturnsOnCall[len(fake.indexTaskArgsForCall)]
+	fake.indexTaskArgsForCall = append(fake.indexTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Tasks
+	}{arg1, arg2})
+	stub := fake.IndexTaskStub
+	fakeReturns := fake.indexTaskReturns
+	fake.recordInvocation("IndexTask", []interface{}{arg1, arg2})
+	fake.indexTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) IndexTaskCallCount() int {
+	fake.indexTaskMutex.RLock()
+	defer fake.indexTaskMutex.RUnlock()
+	return len(fake.indexTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) IndexTaskCalls(stub func(context.Context, internal.Tasks) error) {
+	fake.indexTaskMutex.Lock()
+	defer fake.indexTaskMutex.Unlock()
+	fake.IndexTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) IndexTaskArgsForCall(i int) (context.Context, internal.Tasks) {
+	fake.indexTaskMutex.RLock()
+	defer fake.indexTaskMutex.RUnlock()
+	argsForCall := fake.indexTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) IndexTaskReturns(result1 error) {
+	fake.indexTaskMutex.Lock()
+	defer fake.indexTaskMutex.Unlock()
+	fake.IndexTaskStub = nil
+	fake.indexTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) IndexTaskReturnsOnCall(i int, result1 error) {
+	fake.indexTaskMutex.Lock()
+	defer fake.indexTaskMutex.Unlock()
+	fake.IndexTaskStub = nil
+	if fake.indexTaskReturnsOnCall == nil {
+		fake.indexTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) ListAccount(arg1 context.Context, arg2 internal.ListArgs) (internal.ListAccount, error) {
+	fake.listAccountMutex.Lock()
+	ret, specificReturn := fake.listAccountReturnsOnCall[len(fake.listAccountArgsForCall)]
+	fake.listAccountArgsForCall = append(fake.listAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListAccountStub
+	fakeReturns := fake.listAccountReturns
+	fake.recordInvocation("ListAccount", []interface{}{arg1, arg2})
+	fake.listAccountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountCallCount() int {
+	fake.listAccountMutex.RLock()
+	defer fake.listAccountMutex.RUnlock()
+	return len(fake.listAccountArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountCalls(stub func(context.Context, internal.ListArgs) (internal.ListAccount, error)) {
+	fake.listAccountMutex.Lock()
+	defer fake.listAccountMutex.Unlock()
+	fake.ListAccountStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listAccountMutex.RLock()
+	defer fake.listAccountMutex.RUnlock()
+	argsForCall := fake.listAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountReturns(result1 internal.ListAccount, result2 error) {
+	fake.listAccountMutex.Lock()
+	defer fake.listAccountMutex.Unlock()
+	fake.ListAccountStub = nil
+	fake.listAccountReturns = struct {
+		result1 internal.ListAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountReturnsOnCall(i int, result1 internal.ListAccount, result2 error) {
+	fake.listAccountMutex.Lock()
+	defer fake.listAccountMutex.Unlock()
+	fake.ListAccountStub = nil
+	if fake.listAccountReturnsOnCall == nil {
+		fake.listAccountReturnsOnCall = make(map[int]struct {
+			result1 internal.ListAccount
+			result2 error
+		})
+	}
+	fake.listAccountReturnsOnCall[i] = struct {
+		result1 internal.ListAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountRole(arg1 context.Context, arg2 internal.ListArgs) (internal.ListAccountRole, error) {
+	fake.listAccountRoleMutex.Lock()
+	ret, specificReturn := fake.listAccountRoleReturnsOnCall[len(fake.listAccountRoleArgsForCall)]
+	fake.listAccountRoleArgsForCall = append(fake.listAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListAccountRoleStub
+	fakeReturns := fake.listAccountRoleReturns
+	fake.recordInvocation("ListAccountRole", []interface{}{arg1, arg2})
+	fake.listAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountRoleCallCount() int {
+	fake.listAccountRoleMutex.RLock()
+	defer fake.listAccountRoleMutex.RUnlock()
+	return len(fake.listAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountRoleCalls(stub func(context.Context, internal.ListArgs) (internal.ListAccountRole, error)) {
+	fake.listAccountRoleMutex.Lock()
+	defer fake.listAccountRoleMutex.Unlock()
+	fake.ListAccountRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountRoleArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listAccountRoleMutex.RLock()
+	defer fake.listAccountRoleMutex.RUnlock()
+	argsForCall := fake.listAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountRoleReturns(result1 internal.ListAccountRole, result2 error) {
+	fake.listAccountRoleMutex.Lock()
+	defer fake.listAccountRoleMutex.Unlock()
+	fake.ListAccountRoleStub = nil
+	fake.listAccountRoleReturns = struct {
+		result1 internal.ListAccountRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListAccountRoleReturnsOnCall(i int, result1 internal.ListAccountRole, result2 error) {
+	fake.listAccountRoleMutex.Lock()
+	defer fake.listAccountRoleMutex.Unlock()
+	fake.ListAccountRoleStub = nil
+	if fake.listAccountRoleReturnsOnCall == nil {
+		fake.listAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.ListAccountRole
+			result2 error
+		})
+	}
+	fake.listAccountRoleReturnsOnCall[i] = struct {
+		result1 internal.ListAccountRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListHelpText(arg1 context.Context, arg2 internal.ListArgs) (internal.ListHelpText, error) {
+	fake.listHelpTextMutex.Lock()
+	ret, specificReturn := fake.listHelpTextReturnsOnCall[len(fake.listHelpTextArgsForCall)]
+	fake.listHelpTextArgsForCall = append(fake.listHelpTextArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListHelpTextStub
+	fakeReturns := fake.listHelpTextReturns
+	fake.recordInvocation("ListHelpText", []interface{}{arg1, arg2})
+	fake.listHelpTextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) ListHelpTextCallCount() int {
+	fake.listHelpTextMutex.RLock()
+	defer fake.listHelpTextMutex.RUnlock()
+	return len(fake.listHelpTextArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) ListHelpTextCalls(stub func(context.Context, internal.ListArgs) (internal.ListHelpText, error)) {
+	fake.listHelpTextMutex.Lock()
+	defer fake.listHelpTextMutex.Unlock()
+	fake.ListHelpTextStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) ListHelpTextArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listHelpTextMutex.RLock()
+	defer fake.listHelpTextMutex.RUnlock()
+	argsForCall := fake.listHelpTextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) ListHelpTextReturns(result1 internal.ListHelpText, result2 error) {
+	fake.listHelpTextMutex.Lock()
+	defer fake.listHelpTextMutex.Unlock()
+	fake.ListHelpTextStub = nil
+	fake.listHelpTextReturns = struct {
+		result1 internal.ListHelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListHelpTextReturnsOnCall(i int, result1 internal.ListHelpText, result2 error) {
+	fake.listHelpTextMutex.Lock()
+	defer fake.listHelpTextMutex.Unlock()
+	fake.ListHelpTextStub = nil
+	if fake.listHelpTextReturnsOnCall == nil {
+		fake.listHelpTextReturnsOnCall = make(map[int]struct {
+			result1 internal.ListHelpText
+			result2 error
+		})
+	}
+	fake.listHelpTextReturnsOnCall[i] = struct {
+		result1 internal.ListHelpText
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListMenu(arg1 context.Context, arg2 internal.ListArgs) (internal.ListMenu, error) {
+	fake.listMenuMutex.Lock()
+	ret, specificReturn := fake.listMenuReturnsOnCall[len(fake.listMenuArgsForCall)]
+	fake.listMenuArgsForCall = append(fake.listMenuArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListMenuStub
+	fakeReturns := fake.listMenuReturns
+	fake.recordInvocation("ListMenu", []interface{}{arg1, arg2})
+	fake.listMenuMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) ListMenuCallCount() int {
+	fake.listMenuMutex.RLock()
+	defer fake.listMenuMutex.RUnlock()
+	return len(fake.listMenuArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) ListMenuCalls(stub func(context.Context, internal.ListArgs) (internal.ListMenu, error)) {
+	fake.listMenuMutex.Lock()
+	defer fake.listMenuMutex.Unlock()
+	fake.ListMenuStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) ListMenuArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listMenuMutex.RLock()
+	defer fake.listMenuMutex.RUnlock()
+	argsForCall := fake.listMenuArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) ListMenuReturns(result1 internal.ListMenu, result2 error) {
+	fake.listMenuMutex.Lock()
+	defer fake.listMenuMutex.Unlock()
+	fake.ListMenuStub = nil
+	fake.listMenuReturns = struct {
+		result1 internal.ListMenu
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListMenuReturnsOnCall(i int, result1 internal.ListMenu, result2 error) {
+	fake.listMenuMutex.Lock()
+	defer fake.listMenuMutex.Unlock()
+	fake.ListMenuStub = nil
+	if fake.listMenuReturnsOnCall == nil {
+		fake.listMenuReturnsOnCall = make(map[int]struct {
+			result1 internal.ListMenu
+			result2 error
+		})
+	}
+	fake.listMenuReturnsOnCall[i] = struct {
+		result1 internal.ListMenu
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListNavigation(arg1 context.Context, arg2 internal.ListArgs) (internal.ListNavigation, error) {
+	fake.listNavigationMutex.Lock()
+	ret, specificReturn := fake.listNavigationReturnsOnCall[len(fake.listNavigationArgsForCall)]
+	fake.listNavigationArgsForCall = append(fake.listNavigationArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListNavigationStub
+	fakeReturns := fake.listNavigationReturns
+	fake.recordInvocation("ListNavigation", []interface{}{arg1, arg2})
+	fake.listNavigationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) ListNavigationCallCount() int {
+	fake.listNavigationMutex.RLock()
+	defer fake.listNavigationMutex.RUnlock()
+	return len(fake.listNavigationArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) ListNavigationCalls(stub func(context.Context, internal.ListArgs) (internal.ListNavigation, error)) {
+	fake.listNavigationMutex.Lock()
+	defer fake.listNavigationMutex.Unlock()
+	fake.ListNavigationStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) ListNavigationArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listNavigationMutex.RLock()
+	defer fake.listNavigationMutex.RUnlock()
+	argsForCall := fake.listNavigationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) ListNavigationReturns(result1 internal.ListNavigation, result2 error) {
+	fake.listNavigationMutex.Lock()
+	defer fake.listNavigationMutex.Unlock()
+	fake.ListNavigationStub = nil
+	fake.listNavigationReturns = struct {
+		result1 internal.ListNavigation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListNavigationReturnsOnCall(i int, result1 internal.ListNavigation, result2 error) {
+	fake.listNavigationMutex.Lock()
+	defer fake.listNavigationMutex.Unlock()
+	fake.ListNavigationStub = nil
+	if fake.listNavigationReturnsOnCall == nil {
+		fake.listNavigationReturnsOnCall = make(map[int]struct {
+			result1 internal.ListNavigation
+			result2 error
+		})
+	}
+	fake.listNavigationReturnsOnCall[i] = struct {
+		result1 internal.ListNavigation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListRole(arg1 context.Context, arg2 internal.ListArgs) (internal.ListRole, error) {
+	fake.listRoleMutex.Lock()
+	ret, specificReturn := fake.listRoleReturnsOnCall[len(fake.listRoleArgsForCall)]
+	fake.listRoleArgsForCall = append(fake.listRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListRoleStub
+	fakeReturns := fake.listRoleReturns
+	fake.recordInvocation("ListRole", []interface{}{arg1, arg2})
+	fake.listRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleCallCount() int {
+	fake.listRoleMutex.RLock()
+	defer fake.listRoleMutex.RUnlock()
+	return len(fake.listRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleCalls(stub func(context.Context, internal.ListArgs) (internal.ListRole, error)) {
+	fake.listRoleMutex.Lock()
+	defer fake.listRoleMutex.Unlock()
+	fake.ListRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listRoleMutex.RLock()
+	defer fake.listRoleMutex.RUnlock()
+	argsForCall := fake.listRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleReturns(result1 internal.ListRole, result2 error) {
+	fake.listRoleMutex.Lock()
+	defer fake.listRoleMutex.Unlock()
+	fake.ListRoleStub = nil
+	fake.listRoleReturns = struct {
+		result1 internal.ListRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleReturnsOnCall(i int, result1 internal.ListRole, result2 error) {
+	fake.listRoleMutex.Lock()
+	defer fake.listRoleMutex.Unlock()
+	fake.ListRoleStub = nil
+	if fake.listRoleReturnsOnCall == nil {
+		fake.listRoleReturnsOnCall = make(map[int]struct {
+			result1 internal.ListRole
+			result2 error
+		})
+	}
+	fake.listRoleReturnsOnCall[i] = struct {
+		result1 internal.ListRole
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleTask(arg1 context.Context, arg2 internal.ListArgs) (internal.ListRoleTask, error) {
+	fake.listRoleTaskMutex.Lock()
+	ret, specificReturn := fake.listRoleTaskReturnsOnCall[len(fake.listRoleTaskArgsForCall)]
+	fake.listRoleTaskArgsForCall = append(fake.listRoleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListRoleTaskStub
+	fakeReturns := fake.listRoleTaskReturns
+	fake.recordInvocation("ListRoleTask", []interface{}{arg1, arg2})
+	fake.listRoleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleTaskCallCount() int {
+	fake.listRoleTaskMutex.RLock()
+	defer fake.listRoleTaskMutex.RUnlock()
+	return len(fake.listRoleTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleTaskCalls(stub func(context.Context, internal.ListArgs) (internal.ListRoleTask, error)) {
+	fake.listRoleTaskMutex.Lock()
+	defer fake.listRoleTaskMutex.Unlock()
+	fake.ListRoleTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleTaskArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listRoleTaskMutex.RLock()
+	defer fake.listRoleTaskMutex.RUnlock()
+	argsForCall := fake.listRoleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleTaskReturns(result1 internal.ListRoleTask, result2 error) {
+	fake.listRoleTaskMutex.Lock()
+	defer fake.listRoleTaskMutex.Unlock()
+	fake.ListRoleTaskStub = nil
+	fake.listRoleTaskReturns = struct {
+		result1 internal.ListRoleTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListRoleTaskReturnsOnCall(i int, result1 internal.ListRoleTask, result2 error) {
+	fake.listRoleTaskMutex.Lock()
+	defer fake.listRoleTaskMutex.Unlock()
+	fake.ListRoleTaskStub = nil
+	if fake.listRoleTaskReturnsOnCall == nil {
+		fake.listRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.ListRoleTask
+			result2 error
+		})
+	}
+	fake.listRoleTaskReturnsOnCall[i] = struct {
+		result1 internal.ListRoleTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListTask(arg1 context.Context, arg2 internal.ListArgs) (internal.ListTask, error) {
+	fake.listTaskMutex.Lock()
+	ret, specificReturn := fake.listTaskReturnsOnCall[len(fake.listTaskArgsForCall)]
+	fake.listTaskArgsForCall = append(fake.listTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListArgs
+	}{arg1, arg2})
+	stub := fake.ListTaskStub
+	fakeReturns := fake.listTaskReturns
+	fake.recordInvocation("ListTask", []interface{}{arg1, arg2})
+	fake.listTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRBACSearchRepository) ListTaskCallCount() int {
+	fake.listTaskMutex.RLock()
+	defer fake.listTaskMutex.RUnlock()
+	return len(fake.listTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) ListTaskCalls(stub func(context.Context, internal.ListArgs) (internal.ListTask, error)) {
+	fake.listTaskMutex.Lock()
+	defer fake.listTaskMutex.Unlock()
+	fake.ListTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) ListTaskArgsForCall(i int) (context.Context, internal.ListArgs) {
+	fake.listTaskMutex.RLock()
+	defer fake.listTaskMutex.RUnlock()
+	argsForCall := fake.listTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) ListTaskReturns(result1 internal.ListTask, result2 error) {
+	fake.listTaskMutex.Lock()
+	defer fake.listTaskMutex.Unlock()
+	fake.ListTaskStub = nil
+	fake.listTaskReturns = struct {
+		result1 internal.ListTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) ListTaskReturnsOnCall(i int, result1 internal.ListTask, result2 error) {
+	fake.listTaskMutex.Lock()
+	defer fake.listTaskMutex.Unlock()
+	fake.ListTaskStub = nil
+	if fake.listTaskReturnsOnCall == nil {
+		fake.listTaskReturnsOnCall = make(map[int]struct {
+			result1 internal.ListTask
+			result2 error
+		})
+	}
+	fake.listTaskReturnsOnCall[i] = struct {
+		result1 internal.ListTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateAccountRole(arg1 context.Context, arg2 internal.AccountRoles) error {
+	fake.updateAccountRoleMutex.Lock()
+	ret, specificReturn := fake.updateAccountRoleReturnsOnCall[len(fake.updateAccountRoleArgsForCall)]
+	fake.updateAccountRoleArgsForCall = append(fake.updateAccountRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.AccountRoles
+	}{arg1, arg2})
+	stub := fake.UpdateAccountRoleStub
+	fakeReturns := fake.updateAccountRoleReturns
+	fake.recordInvocation("UpdateAccountRole", []interface{}{arg1, arg2})
+	fake.updateAccountRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) UpdateAccountRoleCallCount() int {
+	fake.updateAccountRoleMutex.RLock()
+	defer fake.updateAccountRoleMutex.RUnlock()
+	return len(fake.updateAccountRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) UpdateAccountRoleCalls(stub func(context.Context, internal.AccountRoles) error) {
+	fake.updateAccountRoleMutex.Lock()
+	defer fake.updateAccountRoleMutex.Unlock()
+	fake.UpdateAccountRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) UpdateAccountRoleArgsForCall(i int) (context.Context, internal.AccountRoles) {
+	fake.updateAccountRoleMutex.RLock()
+	defer fake.updateAccountRoleMutex.RUnlock()
+	argsForCall := fake.updateAccountRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) UpdateAccountRoleReturns(result1 error) {
+	fake.updateAccountRoleMutex.Lock()
+	defer fake.updateAccountRoleMutex.Unlock()
+	fake.UpdateAccountRoleStub = nil
+	fake.updateAccountRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateAccountRoleReturnsOnCall(i int, result1 error) {
+	fake.updateAccountRoleMutex.Lock()
+	defer fake.updateAccountRoleMutex.Unlock()
+	fake.UpdateAccountRoleStub = nil
+	if fake.updateAccountRoleReturnsOnCall == nil {
+		fake.updateAccountRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateAccountRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateProfile(arg1 context.Context, arg2 internal.Profile) error {
+	fake.updateProfileMutex.Lock()
+	ret, specificReturn := fake.updateProfileReturnsOnCall[len(fake.updateProfileArgsForCall)]
+	fake.updateProfileArgsForCall = append(fake.updateProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Profile
+	}{arg1, arg2})
+	stub := fake.UpdateProfileStub
+	fakeReturns := fake.updateProfileReturns
+	fake.recordInvocation("UpdateProfile", []interface{}{arg1, arg2})
+	fake.updateProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) UpdateProfileCallCount() int {
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	return len(fake.updateProfileArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) UpdateProfileCalls(stub func(context.Context, internal.Profile) error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) UpdateProfileArgsForCall(i int) (context.Context, internal.Profile) {
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	argsForCall := fake.updateProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) UpdateProfileReturns(result1 error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = nil
+	fake.updateProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateProfileReturnsOnCall(i int, result1 error) {
+	fake.updateProfileMutex.Lock()
+	defer fake.updateProfileMutex.Unlock()
+	fake.UpdateProfileStub = nil
+	if fake.updateProfileReturnsOnCall == nil {
+		fake.updateProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRole(arg1 context.Context, arg2 internal.Roles) error {
+	fake.updateRoleMutex.Lock()
+	ret, specificReturn := fake.updateRoleReturnsOnCall[len(fake.updateRoleArgsForCall)]
+	fake.updateRoleArgsForCall = append(fake.updateRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Roles
+	}{arg1, arg2})
+	stub := fake.UpdateRoleStub
+	fakeReturns := fake.updateRoleReturns
+	fake.recordInvocation("UpdateRole", []interface{}{arg1, arg2})
+	fake.updateRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleCallCount() int {
+	fake.updateRoleMutex.RLock()
+	defer fake.updateRoleMutex.RUnlock()
+	return len(fake.updateRoleArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleCalls(stub func(context.Context, internal.Roles) error) {
+	fake.updateRoleMutex.Lock()
+	defer fake.updateRoleMutex.Unlock()
+	fake.UpdateRoleStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleArgsForCall(i int) (context.Context, internal.Roles) {
+	fake.updateRoleMutex.RLock()
+	defer fake.updateRoleMutex.RUnlock()
+	argsForCall := fake.updateRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleReturns(result1 error) {
+	fake.updateRoleMutex.Lock()
+	defer fake.updateRoleMutex.Unlock()
+	fake.UpdateRoleStub = nil
+	fake.updateRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleReturnsOnCall(i int, result1 error) {
+	fake.updateRoleMutex.Lock()
+	defer fake.updateRoleMutex.Unlock()
+	fake.UpdateRoleStub = nil
+	if fake.updateRoleReturnsOnCall == nil {
+		fake.updateRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleTask(arg1 context.Context, arg2 internal.RoleTasks) error {
+	fake.updateRoleTaskMutex.Lock()
+	ret, specificReturn := fake.updateRoleTaskReturnsOnCall[len(fake.updateRoleTaskArgsForCall)]
+	fake.updateRoleTaskArgsForCall = append(fake.updateRoleTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.RoleTasks
+	}{arg1, arg2})
+	stub := fake.UpdateRoleTaskStub
+	fakeReturns := fake.updateRoleTaskReturns
+	fake.recordInvocation("UpdateRoleTask", []interface{}{arg1, arg2})
+	fake.updateRoleTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleTaskCallCount() int {
+	fake.updateRoleTaskMutex.RLock()
+	defer fake.updateRoleTaskMutex.RUnlock()
+	return len(fake.updateRoleTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleTaskCalls(stub func(context.Context, internal.RoleTasks) error) {
+	fake.updateRoleTaskMutex.Lock()
+	defer fake.updateRoleTaskMutex.Unlock()
+	fake.UpdateRoleTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleTaskArgsForCall(i int) (context.Context, internal.RoleTasks) {
+	fake.updateRoleTaskMutex.RLock()
+	defer fake.updateRoleTaskMutex.RUnlock()
+	argsForCall := fake.updateRoleTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleTaskReturns(result1 error) {
+	fake.updateRoleTaskMutex.Lock()
+	defer fake.updateRoleTaskMutex.Unlock()
+	fake.UpdateRoleTaskStub = nil
+	fake.updateRoleTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateRoleTaskReturnsOnCall(i int, result1 error) {
+	fake.updateRoleTaskMutex.Lock()
+	defer fake.updateRoleTaskMutex.Unlock()
+	fake.UpdateRoleTaskStub = nil
+	if fake.updateRoleTaskReturnsOnCall == nil {
+		fake.updateRoleTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateRoleTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateTask(arg1 context.Context, arg2 internal.Tasks) error {
+	fake.updateTaskMutex.Lock()
+	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
+	fake.updateTaskArgsForCall = append(fake.updateTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Tasks
+	}{arg1, arg2})
+	stub := fake.UpdateTaskStub
+	fakeReturns := fake.updateTaskReturns
+	fake.recordInvocation("UpdateTask", []interface{}{arg1, arg2})
+	fake.updateTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRBACSearchRepository) UpdateTaskCallCount() int {
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	return len(fake.updateTaskArgsForCall)
+}
+
+func (fake *FakeRBACSearchRepository) UpdateTaskCalls(stub func(context.Context, internal.Tasks) error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = stub
+}
+
+func (fake *FakeRBACSearchRepository) UpdateTaskArgsForCall(i int) (context.Context, internal.Tasks) {
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	argsForCall := fake.updateTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRBACSearchRepository) UpdateTaskReturns(result1 error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = nil
+	fake.updateTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) UpdateTaskReturnsOnCall(i int, result1 error) {
+	fake.updateTaskMutex.Lock()
+	defer fake.updateTaskMutex.Unlock()
+	fake.UpdateTaskStub = nil
+	if fake.updateTaskReturnsOnCall == nil {
+		fake.updateTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRBACSearchRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	fake.deleteAccountRoleMutex.RLock()
+	defer fake.deleteAccountRoleMutex.RUnlock()
+	fake.deleteHelpTextMutex.RLock()
+	defer fake.deleteHelpTextMutex.RUnlock()
+	fake.deleteMenuMutex.RLock()
+	defer fake.deleteMenuMutex.RUnlock()
+	fake.deleteNavigationMutex.RLock()
+	defer fake.deleteNavigationMutex.RUnlock()
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	fake.deleteRoleMutex.RLock()
+	defer fake.deleteRoleMutex.RUnlock()
+	fake.deleteRoleTaskMutex.RLock()
+	defer fake.deleteRoleTaskMutex.RUnlock()
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	fake.getAccountMutex.RLock()
+	defer fake.getAccountMutex.RUnlock()
+	fake.getAccountByIdMutex.RLock()
+	defer fake.getAccountByIdMutex.RUnlock()
+	fake.getAccountRoleMutex.RLock()
+	defer fake.getAccountRoleMutex.RUnlock()
+	fake.getAccountRoleByAccountMutex.RLock()
+	defer fake.getAccountRoleByAccountMutex.RUnlock()
+	fake.getAccountRoleByRoleMutex.RLock()
+	defer fake.getAccountRoleByRoleMutex.RUnlock()
+	fake.getHelpTextMutex.RLock()
+	defer fake.getHelpTextMutex.RUnlock()
+	fake.getHelpTextByTaskMutex.RLock()
+	defer fake.getHelpTextByTaskMutex.RUnlock()
+	fake.getMenuMutex.RLock()
+	defer fake.getMenuMutex.RUnlock()
+	fake.getMenuByTaskMutex.RLock()
+	defer fake.getMenuByTaskMutex.RUnlock()
+	fake.getNavigationMutex.RLock()
+	defer fake.getNavigationMutex.RUnlock()
+	fake.getNavigationByTaskMutex.RLock()
+	defer fake.getNavigationByTaskMutex.RUnlock()
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
+	fake.getRoleMutex.RLock()
+	defer fake.getRoleMutex.RUnlock()
+	fake.getRoleTaskMutex.RLock()
+	defer fake.getRoleTaskMutex.RUnlock()
+	fake.getRoleTaskByRoleMutex.RLock()
+	defer fake.getRoleTaskByRoleMutex.RUnlock()
+	fake.getRoleTaskByTaskMutex.RLock()
+	defer fake.getRoleTaskByTaskMutex.RUnlock()
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	fake.indexAccountMutex.RLock()
+	defer fake.indexAccountMutex.RUnlock()
+	fake.indexAccountRoleMutex.RLock()
+	defer fake.indexAccountRoleMutex.RUnlock()
+	fake.indexHelpTextMutex.RLock()
+	defer fake.indexHelpTextMutex.RUnlock()
+	fake.indexMenuMutex.RLock()
+	defer fake.indexMenuMutex.RUnlock()
+	fake.indexNavigationMutex.RLock()
+	defer fake.indexNavigationMutex.RUnlock()
+	fake.indexProfileMutex.RLock()
+	defer fake.indexProfileMutex.RUnlock()
+	fake.indexRoleMutex.RLock()
+	defer fake.indexRoleMutex.RUnlock()
+	fake.indexRoleTaskMutex.RLock()
+	defer fake.indexRoleTaskMutex.RUnlock()
+	fake.indexTaskMutex.RLock()
+	defer fake.indexTaskMutex.RUnlock()
+	fake.listAccountMutex.RLock()
+	defer fake.listAccountMutex.RUnlock()
+	fake.listAccountRoleMutex.RLock()
+	defer fake.listAccountRoleMutex.RUnlock()
+	fake.listHelpTextMutex.RLock()
+	defer fake.listHelpTextMutex.RUnlock()
+	fake.listMenuMutex.RLock()
+	defer fake.listMenuMutex.RUnlock()
+	fake.listNavigationMutex.RLock()
+	defer fake.listNavigationMutex.RUnlock()
+	fake.listRoleMutex.RLock()
+	defer fake.listRoleMutex.RUnlock()
+	fake.listRoleTaskMutex.RLock()
+	defer fake.listRoleTaskMutex.RUnlock()
+	fake.listTaskMutex.RLock()
+	defer fake.listTaskMutex.RUnlock()
+	fake.updateAccountRoleMutex.RLock()
+	defer fake.updateAccountRoleMutex.RUnlock()
+	fake.updateProfileMutex.RLock()
+	defer fake.updateProfileMutex.RUnlock()
+	fake.updateRoleMutex.RLock()
+	defer fake.updateRoleMutex.RUnlock()
+	fake.updateRoleTaskMutex.RLock()
+	defer fake.updateRoleTaskMutex.RUnlock()
+	fake.updateTaskMutex.RLock()
+	defer fake.updateTaskMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRBACSearchRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.RBACSearchRepository = new(FakeRBACSearchRepository)
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal/tokenmaker"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// IsTokenRevoked returns true when the token was revoked explicitly, or when it was issued before every
+// session of its account was revoked. Tokens carry their issue time in seconds, so the revocation is
+// compared in seconds too, a token issued in the second of the revocation is kept.
+func (r *RBAC) IsTokenRevoked(ctx context.Context, payload *tokenmaker.Payload) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Session.IsTokenRevoked")
+	defer span.End()
+	revoked, err := r.sessions.IsTokenRevoked(ctx, payload.ID.String())
+	if err != nil {
+		return false, fmt.Errorf("sessions: %w", err)
+	}
+	if revoked {
+		return true, nil
+	}
+	revokedAt, err := r.sessions.AccountTokensRevokedAt(ctx, payload.Username)
+	if err != nil {
+		return false, fmt.Errorf("sessions: %w", err)
+	}
+	return payload.IssuedAt.Truncate(time.Second).Before(revokedAt.Truncate(time.Second)), nil
+}
+
+// RevokeSessions revokes every access and refresh token issued to the account so far.
+func (r *RBAC) RevokeSessions(ctx context.Context, username string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Session.RevokeSessions")
+	defer span.End()
+	err := r.sessions.RevokeAccountTokens(ctx, username, time.Now())
+	if err != nil {
+		return fmt.Errorf("sessions: %w", err)
+	}
+	err = r.repo.RevokeAccountRefreshTokens(ctx, username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package service_test
+
+import (
+	"context"
+	"rbac/internal/service"
+	"rbac/internal/tokenmaker"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRBAC_IsTokenRevoked(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	now := time.Unix(time.Now().Unix(), 0)
+	require.NoError(t, f.sessions.RevokeAccountTokens(ctx, "alice", now.Add(500*time.Millisecond)))
+
+	// iat is in seconds, a token issued right after the revocation has the second of the revocation
+
+	revoked, err := svc.IsTokenRevoked(ctx, &tokenmaker.Payload{Username: "alice", IssuedAt: now})
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	revoked, err = svc.IsTokenRevoked(ctx, &tokenmaker.Payload{Username: "alice", IssuedAt: now.Add(-time.Second)})
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	revoked, err = svc.IsTokenRevoked(ctx, &tokenmaker.Payload{Username: "bob", IssuedAt: now.Add(-time.Second)})
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
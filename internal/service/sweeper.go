@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SweepAccountRoles deletes the account roles that expired, publishing their deletion, and returns the time
+// the sweep ran at, to be given as since to the next one. When account roles expired or started after since,
+// tokens carrying permission claims are refused so they get issued again with the current roles.
+func (r *RBAC) SweepAccountRoles(ctx context.Context, since time.Time) (time.Time, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "AccountRole.Sweep")
+	defer span.End()
+	now := time.Now()
+	ids, err := r.repo.DeleteExpiredAccountRoles(ctx, now)
+	if err != nil {
+		return since, fmt.Errorf("repo: %w", err)
+	}
+	for _, id := range ids {
+		_ = r.msgBroker.AccountRoleDeleted(ctx, id)
+	}
+	started, err := r.repo.CountStartedAccountRoles(ctx, since, now)
+	if err != nil {
+		return since, fmt.Errorf("repo: %w", err)
+	}
+	if len(ids) > 0 || started > 0 {
+		if err := r.permissionsChanged(ctx); err != nil {
+			return since, err
+		}
+	}
+	return now, nil
+}
@@ -0,0 +1,63 @@
+package service_test
+
+import (
+	"context"
+	"rbac/internal/service"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRBAC_SweepAccountRoles(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	f.repo.DeleteExpiredAccountRolesReturns([]string{"ar1", "ar2"}, nil)
+
+	since := time.Now().Add(-time.Minute)
+	next, err := svc.SweepAccountRoles(ctx, since)
+	require.NoError(t, err)
+	require.True(t, next.After(since))
+
+	_, now := f.repo.DeleteExpiredAccountRolesArgsForCall(0)
+	require.Equal(t, now, next)
+	_, from, to := f.repo.CountStartedAccountRolesArgsForCall(0)
+	require.Equal(t, since, from)
+	require.Equal(t, now, to)
+
+	require.Equal(t, 2, f.msgBroker.AccountRoleDeletedCallCount())
+	_, id := f.msgBroker.AccountRoleDeletedArgsForCall(0)
+	require.Equal(t, "ar1", id)
+	_, id = f.msgBroker.AccountRoleDeletedArgsForCall(1)
+	require.Equal(t, "ar2", id)
+
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+}
+
+func TestRBAC_SweepAccountRoles_Started(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	f.repo.CountStartedAccountRolesReturns(1, nil)
+
+	_, err := svc.SweepAccountRoles(ctx, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	require.Equal(t, 0, f.msgBroker.AccountRoleDeletedCallCount())
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+}
+
+func TestRBAC_SweepAccountRoles_Unchanged(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+
+	_, err := svc.SweepAccountRoles(ctx, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), version)
+}
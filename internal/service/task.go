@@ -57,6 +57,9 @@ func (r *RBAC) UpdateTask(ctx context.Context, task internal.Tasks) error {
 		return fmt.Errorf("repo: %w", err)
 	}
 	_ = r.msgBroker.TaskUpdated(ctx, rt)
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
 	return err
 }
 func (r *RBAC) DeleteTask(ctx context.Context, id string) error {
@@ -67,6 +70,9 @@ func (r *RBAC) DeleteTask(ctx context.Context, id string) error {
 		return fmt.Errorf("repo: %w", err)
 	}
 	_ = r.msgBroker.TaskDeleted(ctx, id)
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
 	return err
 }
 
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"rbac/internal"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CreateTenant creates the tenant with a copy of the tasks of the tenant the request acts in, the account
+// creating it joins it holding an ADMIN role granted the tasks the account is granted in the tenant it acts
+// in, it can then switch to the new tenant and set it up. Nothing is created unless all of it is.
+func (r *RBAC) CreateTenant(ctx context.Context, tenant internal.Tenant, username string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.Create")
+	defer span.End()
+	if err := tenant.Validate(); err != nil {
+		return "", err
+	}
+	_, tasks, _, err := r.accountTasks(ctx, username)
+	if err != nil {
+		return "", err
+	}
+	catalogue, err := r.repo.ExportPolicy(ctx)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	// roles can be inherited along several paths, grant each task once
+	admin := internal.PolicyRole{Role: "ADMIN"}
+	seen := map[string]bool{}
+	for _, task := range tasks {
+		if seen[task.Task] {
+			continue
+		}
+		seen[task.Task] = true
+		admin.Grants = append(admin.Grants, internal.PolicyGrant{Task: task.Task})
+	}
+	policy := internal.Policy{Tasks: catalogue.Tasks, Roles: []internal.PolicyRole{admin}}
+	if err := policy.Validate(); err != nil {
+		return "", err
+	}
+	id, changes, arid, err := r.repo.CreateTenant(ctx, tenant.Name, username, policy, admin.Role)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	tctx := internal.NewTenantContext(ctx, id)
+	acc, err := r.repo.Account(tctx, username)
+	if err != nil {
+		return id, fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.AccountUpdated(tctx, acc)
+	var errs policyErrors
+	for _, change := range changes {
+		if err := r.policyChanged(tctx, change); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", change, err))
+		}
+	}
+	// the tenant is created whether its changes were published or not
+	if err := r.accountRoleCreated(tctx, arid); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return id, errs
+	}
+	return id, nil
+}
+
+func (r *RBAC) Tenants(ctx context.Context) ([]internal.Tenant, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.Tenants")
+	defer span.End()
+	tenants, err := r.repo.Tenants(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return tenants, nil
+}
+
+// AccountTenants returns the tenants of the account, the one it acts in first.
+func (r *RBAC) AccountTenants(ctx context.Context, username string) ([]internal.Tenant, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.AccountTenants")
+	defer span.End()
+	tenants, err := r.repo.AccountTenants(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("repo: %w", err)
+	}
+	return tenants, nil
+}
+
+// ActiveTenant returns the tenant the account acts in.
+func (r *RBAC) ActiveTenant(ctx context.Context, username string) (internal.Tenant, error) {
+	tenants, err := r.AccountTenants(ctx, username)
+	if err != nil {
+		return internal.Tenant{}, err
+	}
+	if len(tenants) == 0 {
+		return internal.Tenant{}, internal.NewErrorf(internal.ErrorCodeUnauthorized, "account doesn't belong to any tenant")
+	}
+	return tenants[0], nil
+}
+
+// checkTenant refuses changes to another tenant than the one the request acts in.
+func checkTenant(ctx context.Context, tenantId string) error {
+	if current := internal.TenantFromContext(ctx); current != "" && current != tenantId {
+		return internal.NewErrorf(internal.ErrorCodeForbidden, "only the tenant the request acts in can be changed")
+	}
+	return nil
+}
+
+// AddAccountTenant adds the account to the tenant the request acts in, the account is published again so
+// it can be found in the tenant.
+func (r *RBAC) AddAccountTenant(ctx context.Context, username string, tenantId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.AddAccount")
+	defer span.End()
+	if err := checkTenant(ctx, tenantId); err != nil {
+		return err
+	}
+	err := r.repo.AddAccountTenant(ctx, username, tenantId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	acc, err := r.repo.Account(ctx, username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.AccountUpdated(ctx, acc)
+	return nil
+}
+
+// RemoveAccountTenant removes the account from the tenant the request acts in along with its account roles
+// in the tenant. The access tokens of the account are revoked, they could still name the tenant.
+func (r *RBAC) RemoveAccountTenant(ctx context.Context, username string, tenantId string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.RemoveAccount")
+	defer span.End()
+	if err := checkTenant(ctx, tenantId); err != nil {
+		return err
+	}
+	ids, err := r.repo.RemoveAccountTenant(ctx, username, tenantId)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	for _, id := range ids {
+		_ = r.msgBroker.AccountRoleDeleted(ctx, id)
+	}
+	// the account isn't part of the tenant anymore, read it without tenant
+	acc, err := r.repo.Account(internal.NewTenantContext(ctx, ""), username)
+	if err != nil {
+		return fmt.Errorf("repo: %w", err)
+	}
+	_ = r.msgBroker.AccountUpdated(ctx, acc)
+	if err := r.sessions.RevokeAccountTokens(ctx, username, time.Now()); err != nil {
+		return fmt.Errorf("sessions: %w", err)
+	}
+	if err := r.permissionsChanged(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SwitchTenant makes the account act in the tenant, which it must belong to, and issues an access token for
+// it.
+func (r *RBAC) SwitchTenant(ctx context.Context, username string, tenantId string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Tenant.Switch")
+	defer span.End()
+	err := r.repo.SetActiveTenant(ctx, username, tenantId)
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	return r.CreateToken(ctx, username)
+}
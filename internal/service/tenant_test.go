@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"context"
+	"rbac/internal"
+	"rbac/internal/service"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRBAC_CreateTenant(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	withRoles(f, map[string]internal.RoleTaskByRole{
+		"editor": {Tasks: []internal.Tasks{{Task: "document.read"}, {Task: "document.write"}}},
+		"viewer": {Tasks: []internal.Tasks{{Task: "document.read"}}},
+	})
+	f.repo.ExportPolicyReturns(internal.Policy{Tasks: []internal.PolicyTask{{Task: "document.read"}, {Task: "document.write"}}}, nil)
+	f.repo.CreateTenantReturns("t2", []internal.PolicyChange{
+		{Action: internal.POLICY_CREATE, Kind: internal.POLICY_ROLE, Role: "ADMIN", Id: "r1"},
+	}, "ar1", nil)
+	f.repo.AccountRoleReturns(internal.AccountRoles{Id: "ar1", Role: internal.Roles{Id: "r1", TenantId: "t2"}}, nil)
+
+	id, err := svc.CreateTenant(ctx, internal.Tenant{Name: "acme"}, "admin")
+	require.NoError(t, err)
+	require.Equal(t, "t2", id)
+
+	// the tenant is set up in a single repository call
+	_, name, username, policy, admin := f.repo.CreateTenantArgsForCall(0)
+	require.Equal(t, "acme", name)
+	require.Equal(t, "admin", username)
+	require.Equal(t, "ADMIN", admin)
+	require.Len(t, policy.Tasks, 2)
+	require.Len(t, policy.Roles, 1)
+	require.ElementsMatch(t, []internal.PolicyGrant{{Task: "document.read"}, {Task: "document.write"}}, policy.Roles[0].Grants)
+
+	_, rid := f.repo.RoleArgsForCall(0)
+	require.Equal(t, "r1", rid)
+	require.Equal(t, 1, f.msgBroker.RoleCreatedCallCount())
+	ctx, ar := f.msgBroker.AccountRoleCreatedArgsForCall(0)
+	require.Equal(t, "ar1", ar.Id)
+	require.Equal(t, "t2", internal.TenantFromContext(ctx))
+	require.Equal(t, 1, f.msgBroker.AccountUpdatedCallCount())
+}
+
+func TestRBAC_CreateTenant_Failed(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	withRoles(f, map[string]internal.RoleTaskByRole{
+		"editor": {Tasks: []internal.Tasks{{Task: "document.read"}}},
+	})
+	f.repo.ExportPolicyReturns(internal.Policy{Tasks: []internal.PolicyTask{{Task: "document.read"}}}, nil)
+	f.repo.CreateTenantReturns("", nil, "", internal.NewErrorf(internal.ErrorCodeUnknown, "role ADMIN not found in the tenant"))
+
+	_, err := svc.CreateTenant(ctx, internal.Tenant{Name: "acme"}, "admin")
+	requireErrorCode(t, err, internal.ErrorCodeUnknown)
+	require.Equal(t, 1, f.repo.CreateTenantCallCount())
+
+	require.Equal(t, 0, f.msgBroker.AccountUpdatedCallCount())
+	require.Equal(t, 0, f.msgBroker.AccountRoleCreatedCallCount())
+	version, err := f.sessions.PermissionVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), version)
+}
@@ -1,10 +1,208 @@
 package service
 
-import "rbac/internal/tokenmaker"
+import (
+	"errors"
+	"fmt"
+	"rbac/internal"
+	"rbac/internal/tokenmaker"
+	"strings"
+	"time"
 
-func (a *RBAC) CreateToken(username string) (string, error) {
-	return a.token.CreateToken(username)
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+// roleIDsCacheExpiration bounds how long cached role ids are kept, versions make them stale before.
+const roleIDsCacheExpiration = 10 * time.Minute
+
+// CreateToken issues an access token for the tenant the account acts in, carrying the roles and tasks of the
+// account in it as claims when TokenPermissions is enabled.
+func (a *RBAC) CreateToken(ctx context.Context, username string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Token.CreateToken")
+	defer span.End()
+	tenant, err := a.ActiveTenant(ctx, username)
+	if err != nil {
+		return "", err
+	}
+	ctx = internal.NewTenantContext(ctx, tenant.Id)
+	claims := tokenmaker.Claims{Tenant: tenant.Id}
+	if !a.conf.TokenPermissions {
+		return a.token.CreateTokenWithClaims(username, claims)
+	}
+	// read the version first, a change happening meanwhile leaves the token stale instead of wrong
+	version, err := a.sessions.PermissionVersion(ctx)
+	if err != nil {
+		return "", fmt.Errorf("sessions: %w", err)
+	}
+	roles, tasks, denied, err := a.accountPermissions(ctx, username)
+	if err != nil {
+		if !isErrorCode(err, internal.ErrorCodeNotFound) && !strings.Contains(err.Error(), "404") {
+			return "", err
+		}
+		// accounts without roles yet aren't indexed, the token still works and requests fall back to IsAllowed
+		return a.token.CreateTokenWithClaims(username, claims)
+	}
+	claims.Permissions = tokenmaker.NewPermissions(version, roles, tasks, denied)
+	return a.token.CreateTokenWithClaims(username, claims)
 }
 func (a *RBAC) VerifyToken(token string) (*tokenmaker.Payload, error) {
 	return a.token.VerifyToken(token)
 }
+
+// IsPermissionStale returns true when the token carries permission claims issued before the last change of
+// role tasks or account roles, the token must then be issued again.
+func (a *RBAC) IsPermissionStale(ctx context.Context, payload *tokenmaker.Payload) (bool, error) {
+	if !payload.HasPermissions() {
+		return false, nil
+	}
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Token.IsPermissionStale")
+	defer span.End()
+	version, err := a.sessions.PermissionVersion(ctx)
+	if err != nil {
+		return false, fmt.Errorf("sessions: %w", err)
+	}
+	return payload.Permissions.Version != version, nil
+}
+
+// permissionsChanged bumps the permission version stamp, making every token with permission claims stale.
+func (a *RBAC) permissionsChanged(ctx context.Context) error {
+	_, err := a.sessions.IncrementPermissionVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("sessions: %w", err)
+	}
+	return nil
+}
+
+// cachedRoleIDs returns the role ids cached under the key, load computes them on a miss. Keys are scoped to
+// the permission version, every change of roles, their inheritance, groups or assignments bumps it so that
+// an entry never outlives the permissions it was computed from.
+func (a *RBAC) cachedRoleIDs(ctx context.Context, key string, load func() ([]string, error)) ([]string, error) {
+	version, err := a.sessions.PermissionVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sessions: %w", err)
+	}
+	key = fmt.Sprintf("%d_%s", version, key)
+	ids, ok, err := a.sessions.CachedRoleIDs(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("sessions: %w", err)
+	}
+	if ok {
+		internal.RecordLookup(ctx, "CachedRoleIDs", key, internal.SOURCE_CACHE)
+		return ids, nil
+	}
+	ids, err = load()
+	if err != nil {
+		return nil, err
+	}
+	// a failure to cache only costs the next lookup
+	_ = a.sessions.CacheRoleIDs(ctx, key, ids, roleIDsCacheExpiration)
+	return ids, nil
+}
+
+// PublicKeys returns the keys verifying the access tokens, none are returned when tokens are signed
+// with a symmetric key.
+func (a *RBAC) PublicKeys() []tokenmaker.JWK {
+	provider, ok := a.token.(tokenmaker.PublicKeyProvider)
+	if !ok {
+		return []tokenmaker.JWK{}
+	}
+	return provider.PublicKeys()
+}
+
+// TokenKeyRing is implemented by token makers whose signing keys can be rotated.
+type TokenKeyRing interface {
+	Keys() []tokenmaker.KeyInfo
+	Reload() error
+}
+
+// TokenKeys describes the keys signing and verifying the access tokens.
+func (a *RBAC) TokenKeys(ctx context.Context) ([]tokenmaker.KeyInfo, error) {
+	kr, ok := a.token.(TokenKeyRing)
+	if !ok {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "token keys can't be rotated")
+	}
+	return kr.Keys(), nil
+}
+
+// ReloadTokenKeys reads the token keys from the configuration again, keys are rotated by first adding the
+// new key as verify only, then making it active once every instance reloaded it and finally retiring the
+// old key when the tokens it signed expired.
+func (a *RBAC) ReloadTokenKeys(ctx context.Context) ([]tokenmaker.KeyInfo, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Token.ReloadTokenKeys")
+	defer span.End()
+	kr, ok := a.token.(TokenKeyRing)
+	if !ok {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "token keys can't be rotated")
+	}
+	if err := kr.Reload(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "reload token keys")
+	}
+	return kr.Keys(), nil
+}
+
+// CreateRefreshToken issues a refresh token starting a new token family.
+func (a *RBAC) CreateRefreshToken(ctx context.Context, username string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Token.CreateRefreshToken")
+	defer span.End()
+	refreshToken, err := tokenmaker.NewOpaqueToken()
+	if err != nil {
+		return "", fmt.Errorf("new refresh token: %w", err)
+	}
+	_, err = a.repo.CreateRefreshToken(ctx, username, tokenmaker.HashOpaqueToken(refreshToken), time.Now().Add(a.conf.RefreshTokenExpiration))
+	if err != nil {
+		return "", fmt.Errorf("repo: %w", err)
+	}
+	return refreshToken, nil
+}
+
+// RefreshToken exchanges a refresh token for a new access token and a new refresh token, the one used
+// is revoked. Presenting a revoked refresh token revokes the whole family, logging out every session
+// derived from the original login.
+func (a *RBAC) RefreshToken(ctx context.Context, refreshToken string) (string, string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Token.RefreshToken")
+	defer span.End()
+	rt, err := a.repo.RefreshTokenByHash(ctx, tokenmaker.HashOpaqueToken(refreshToken))
+	if err != nil {
+		var ierr *internal.Error
+		if errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound {
+			return "", "", internal.WrapErrorf(err, internal.ErrorCodeUnauthorized, "invalid refresh token")
+		}
+		return "", "", fmt.Errorf("repo: %w", err)
+	}
+	if rt.IsRevoked() {
+		if err := a.repo.RevokeRefreshTokenFamily(ctx, rt.FamilyId); err != nil {
+			return "", "", fmt.Errorf("repo: %w", err)
+		}
+		return "", "", internal.NewErrorf(internal.ErrorCodeUnauthorized, "refresh token reuse detected")
+	}
+	if rt.IsExpired() {
+		return "", "", internal.NewErrorf(internal.ErrorCodeUnauthorized, "refresh token has expired")
+	}
+	acc, err := a.repo.Account(ctx, rt.Username)
+	if err != nil {
+		return "", "", fmt.Errorf("repo: %w", err)
+	}
+	if acc.IsBlocked {
+		return "", "", internal.NewErrorf(internal.ErrorCodeUnauthorized, "account is blocked")
+	}
+	next, err := tokenmaker.NewOpaqueToken()
+	if err != nil {
+		return "", "", fmt.Errorf("new refresh token: %w", err)
+	}
+	_, err = a.repo.RotateRefreshToken(ctx, rt, tokenmaker.HashOpaqueToken(next), time.Now().Add(a.conf.RefreshTokenExpiration))
+	if err != nil {
+		var ierr *internal.Error
+		if errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeUnauthorized {
+			// lost a race against another rotation of the same token, treat it as reuse
+			if err := a.repo.RevokeRefreshTokenFamily(ctx, rt.FamilyId); err != nil {
+				return "", "", fmt.Errorf("repo: %w", err)
+			}
+		}
+		return "", "", fmt.Errorf("repo: %w", err)
+	}
+	access, err := a.CreateToken(ctx, rt.Username)
+	if err != nil {
+		return "", "", fmt.Errorf("create token: %w", err)
+	}
+	return access, next, nil
+}
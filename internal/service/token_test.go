@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"rbac/internal"
+	"rbac/internal/service"
+	"rbac/internal/tokenmaker"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRBAC_RefreshToken(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{RefreshTokenExpiration: time.Hour})
+	f.repo.RefreshTokenByHashReturns(internal.RefreshToken{
+		Id:        "rt1",
+		Username:  "admin",
+		FamilyId:  "family",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	f.repo.AccountReturns(internal.Account{UserName: "admin"}, nil)
+	f.repo.AccountTenantsReturns([]internal.Tenant{{Id: "t1"}}, nil)
+
+	access, next, err := svc.RefreshToken(ctx, "refresh")
+	require.NoError(t, err)
+	require.NotEmpty(t, access)
+	require.NotEmpty(t, next)
+	require.NotEqual(t, "refresh", next)
+
+	_, hash := f.repo.RefreshTokenByHashArgsForCall(0)
+	require.Equal(t, tokenmaker.HashOpaqueToken("refresh"), hash)
+	require.Equal(t, 1, f.repo.RotateRefreshTokenCallCount())
+	_, rt, hash, _ := f.repo.RotateRefreshTokenArgsForCall(0)
+	require.Equal(t, "rt1", rt.Id)
+	require.Equal(t, tokenmaker.HashOpaqueToken(next), hash)
+	require.Equal(t, 0, f.repo.RevokeRefreshTokenFamilyCallCount())
+}
+
+func TestRBAC_RefreshToken_Reuse(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{RefreshTokenExpiration: time.Hour})
+	f.repo.RefreshTokenByHashReturns(internal.RefreshToken{
+		Id:        "rt1",
+		Username:  "admin",
+		FamilyId:  "family",
+		ExpiresAt: time.Now().Add(time.Hour),
+		RevokedAt: time.Now().Add(-time.Minute),
+	}, nil)
+
+	_, _, err := svc.RefreshToken(ctx, "refresh")
+	requireErrorCode(t, err, internal.ErrorCodeUnauthorized)
+
+	require.Equal(t, 1, f.repo.RevokeRefreshTokenFamilyCallCount())
+	_, family := f.repo.RevokeRefreshTokenFamilyArgsForCall(0)
+	require.Equal(t, "family", family)
+	require.Equal(t, 0, f.repo.RotateRefreshTokenCallCount())
+}
+
+func TestRBAC_RefreshToken_ConcurrentRotation(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{RefreshTokenExpiration: time.Hour})
+	f.repo.RefreshTokenByHashReturns(internal.RefreshToken{
+		Id:        "rt1",
+		Username:  "admin",
+		FamilyId:  "family",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	f.repo.AccountReturns(internal.Account{UserName: "admin"}, nil)
+	f.repo.RotateRefreshTokenReturns("", internal.NewErrorf(internal.ErrorCodeUnauthorized, "refresh token was already rotated"))
+
+	_, _, err := svc.RefreshToken(ctx, "refresh")
+	requireErrorCode(t, err, internal.ErrorCodeUnauthorized)
+
+	require.Equal(t, 1, f.repo.RevokeRefreshTokenFamilyCallCount())
+	_, family := f.repo.RevokeRefreshTokenFamilyArgsForCall(0)
+	require.Equal(t, "family", family)
+}
+
+func TestRBAC_RefreshToken_Expired(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{RefreshTokenExpiration: time.Hour})
+	f.repo.RefreshTokenByHashReturns(internal.RefreshToken{
+		Id:        "rt1",
+		Username:  "admin",
+		FamilyId:  "family",
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}, nil)
+
+	_, _, err := svc.RefreshToken(ctx, "refresh")
+	requireErrorCode(t, err, internal.ErrorCodeUnauthorized)
+
+	require.Equal(t, 0, f.repo.RevokeRefreshTokenFamilyCallCount())
+	require.Equal(t, 0, f.repo.RotateRefreshTokenCallCount())
+}
+
+func TestRBAC_RefreshToken_Unknown(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newRBAC(t, service.Config{})
+	f.repo.RefreshTokenByHashReturns(internal.RefreshToken{}, internal.NewErrorf(internal.ErrorCodeNotFound, "refresh token not found"))
+
+	_, _, err := svc.RefreshToken(ctx, "refresh")
+	requireErrorCode(t, err, internal.ErrorCodeUnauthorized)
+}
+
+func TestRBAC_CreateToken_Permissions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("without roles", func(t *testing.T) {
+		svc, f := newRBAC(t, service.Config{TokenPermissions: true})
+		f.repo.AccountTenantsReturns([]internal.Tenant{{Id: "t1"}}, nil)
+		f.search.GetAccountRoleByAccountReturns(internal.AccountRoleByAccountResult{}, internal.NewErrorf(internal.ErrorCodeUnknown, "GetRequest.Do 404"))
+
+		token, err := svc.CreateToken(ctx, "admin")
+		require.NoError(t, err)
+		payload, err := svc.VerifyToken(token)
+		require.NoError(t, err)
+		require.False(t, payload.HasPermissions())
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		svc, f := newRBAC(t, service.Config{TokenPermissions: true})
+		f.repo.AccountTenantsReturns([]internal.Tenant{{Id: "t1"}}, nil)
+		f.search.GetAccountRoleByAccountReturns(internal.AccountRoleByAccountResult{}, errors.New("connection refused"))
+
+		_, err := svc.CreateToken(ctx, "admin")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"context"
+	"time"
+)
+
+// DEFAULT_TENANT is the name of the tenant holding the accounts, roles and tasks created before tenants
+// existed, records created without a tenant belong to it.
+const DEFAULT_TENANT = "default"
+
+// Tenant partitions accounts, roles and tasks, accounts belong to one or more tenants and act in one of them
+// at a time, roles, tasks, their help texts, menus and navigations belong to a single tenant.
+type Tenant struct {
+	Id        string
+	Name      string
+	CreatedAt time.Time
+}
+
+func (t *Tenant) Validate() error {
+	if t.Name == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "tenant name is required")
+	}
+	return nil
+}
+
+type tenantKey struct{}
+
+// NewTenantContext returns a copy of ctx carrying the id of the tenant the request acts in.
+func NewTenantContext(ctx context.Context, tenantId string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantId)
+}
+
+// TenantFromContext returns the id of the tenant the request acts in. It is empty for work not done on
+// behalf of an account, e.g. seeding, indexing or sweeping, which is not partitioned by tenant.
+func TenantFromContext(ctx context.Context) string {
+	tenantId, _ := ctx.Value(tenantKey{}).(string)
+	return tenantId
+}
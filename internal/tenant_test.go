@@ -0,0 +1,23 @@
+package internal_test
+
+import (
+	"context"
+	"rbac/internal"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantContext(t *testing.T) {
+	ctx := context.Background()
+
+	require.Equal(t, "", internal.TenantFromContext(ctx))
+	ctx = internal.NewTenantContext(ctx, "tenant1")
+	require.Equal(t, "tenant1", internal.TenantFromContext(ctx))
+	require.Equal(t, "", internal.TenantFromContext(internal.NewTenantContext(ctx, "")))
+}
+
+func TestTenant_Validate(t *testing.T) {
+	require.NoError(t, (&internal.Tenant{Name: "acme"}).Validate())
+	require.Error(t, (&internal.Tenant{}).Validate())
+}
@@ -0,0 +1,16 @@
+package tokenmaker
+
+import "context"
+
+type payloadKey struct{}
+
+// NewContext returns a copy of ctx carrying the verified payload of the request.
+func NewContext(ctx context.Context, payload *Payload) context.Context {
+	return context.WithValue(ctx, payloadKey{}, payload)
+}
+
+// FromContext returns the verified payload of the request, if any.
+func FromContext(ctx context.Context) (*Payload, bool) {
+	payload, ok := ctx.Value(payloadKey{}).(*Payload)
+	return payload, ok
+}
@@ -0,0 +1,125 @@
+package tokenmaker
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// JWK is a public key in the JSON Web Key format (RFC 7517), only the members needed for verifying
+// signatures are set.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// PublicKeyProvider is implemented by token makers signing with an asymmetric key, their tokens can be
+// verified by other services using the published public keys.
+type PublicKeyProvider interface {
+	PublicKeys() []JWK
+}
+
+// NewJWK returns the JWK of an RSA, ECDSA P-256 or Ed25519 public key, the key id is its RFC 7638 thumbprint.
+func NewJWK(publicKey crypto.PublicKey, alg string) (JWK, error) {
+	var jwk JWK
+	switch pub := publicKey.(type) {
+	case *rsa.PublicKey:
+		jwk = JWK{
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return JWK{}, errors.New("unsupported curve: only P-256 is supported")
+		}
+		jwk = JWK{
+			Kty: "EC",
+			Crv: "P-256",
+			X:   base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, 32))),
+			Y:   base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, 32))),
+		}
+	case ed25519.PublicKey:
+		jwk = JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(pub),
+		}
+	default:
+		return JWK{}, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	kid, err := jwk.Thumbprint()
+	if err != nil {
+		return JWK{}, err
+	}
+	jwk.Use = "sig"
+	jwk.Alg = alg
+	jwk.Kid = kid
+	return jwk, nil
+}
+
+// Thumbprint returns the RFC 7638 thumbprint of the key, base64url encoded.
+func (k JWK) Thumbprint() (string, error) {
+	// the required members in lexicographic order, encoding/json sorts map keys
+	members := map[string]string{"kty": k.Kty}
+	switch k.Kty {
+	case "RSA":
+		members["e"] = k.E
+		members["n"] = k.N
+	case "EC":
+		members["crv"] = k.Crv
+		members["x"] = k.X
+		members["y"] = k.Y
+	case "OKP":
+		members["crv"] = k.Crv
+		members["x"] = k.X
+	default:
+		return "", fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+	b, err := json.Marshal(members)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// ParsePrivateKey parses a PEM encoded PKCS #8, PKCS #1 (RSA) or SEC 1 (EC) private key.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("x509.ParsePKCS8PrivateKey: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
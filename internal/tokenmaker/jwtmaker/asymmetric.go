@@ -0,0 +1,88 @@
+package jwtmaker
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"rbac/internal/tokenmaker"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const minRSAKeySize = 2048
+
+// AsymmetricJWTMaker signs tokens with a private key, RS256, ES256 or EdDSA is used depending on its type.
+type AsymmetricJWTMaker struct {
+	method     jwt.SigningMethod
+	key        crypto.Signer
+	publicKey  crypto.PublicKey
+	jwk        tokenmaker.JWK
+	registered RegisteredClaims
+	duration   time.Duration
+}
+
+// NewAsymmetricJWTMaker returns a maker setting kid as the kid header of its tokens, the RFC 7638 thumbprint
+// of the public key is used when kid is empty. DEFAULT_ISSUER is used for the empty registered claims.
+func NewAsymmetricJWTMaker(kid string, key crypto.Signer, registered RegisteredClaims, duration time.Duration) (tokenmaker.TokenMaker, error) {
+	var method jwt.SigningMethod
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSAKeySize {
+			return nil, fmt.Errorf("invalid key size: must be at least %d bits", minRSAKeySize)
+		}
+		method = jwt.SigningMethodRS256
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, errors.New("invalid key: ES256 requires a P-256 key")
+		}
+		method = jwt.SigningMethodES256
+	case ed25519.PrivateKey:
+		method = SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	jwk, err := tokenmaker.NewJWK(key.Public(), method.Alg())
+	if err != nil {
+		return nil, fmt.Errorf("tokenmaker.NewJWK: %w", err)
+	}
+	if kid != "" {
+		jwk.Kid = kid
+	}
+	return &AsymmetricJWTMaker{
+		method:     method,
+		key:        key,
+		publicKey:  key.Public(),
+		jwk:        jwk,
+		registered: registered.withDefaults(),
+		duration:   duration,
+	}, nil
+}
+
+func (maker *AsymmetricJWTMaker) CreateToken(username string) (string, error) {
+	return maker.CreateTokenWithClaims(username, tokenmaker.Claims{})
+}
+
+func (maker *AsymmetricJWTMaker) CreateTokenWithClaims(username string, claims tokenmaker.Claims) (string, error) {
+	return sign(maker.method, maker.jwk.Kid, maker.key, username, claims, maker.registered, maker.duration)
+}
+
+func (maker *AsymmetricJWTMaker) VerifyToken(token string) (*tokenmaker.Payload, error) {
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		// the algorithm is pinned to the one of the key, never trust the header
+		if token.Method.Alg() != maker.method.Alg() {
+			return nil, tokenmaker.ErrInvalidToken
+		}
+		return maker.publicKey, nil
+	}
+	return verify(token, keyFunc, maker.registered)
+}
+
+// PublicKeys returns the key used for verifying the tokens.
+func (maker *AsymmetricJWTMaker) PublicKeys() []tokenmaker.JWK {
+	return []tokenmaker.JWK{maker.jwk}
+}
@@ -0,0 +1,85 @@
+package jwtmaker_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"rbac/internal/tokenmaker"
+	"rbac/internal/tokenmaker/jwtmaker"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/require"
+)
+
+func newKeys(t *testing.T) map[string]crypto.Signer {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return map[string]crypto.Signer{
+		"RS256": rsaKey,
+		"ES256": ecKey,
+		"EdDSA": edKey,
+	}
+}
+
+func TestAsymmetricJWTMaker(t *testing.T) {
+	for alg, key := range newKeys(t) {
+		maker, err := jwtmaker.NewAsymmetricJWTMaker("", key, jwtmaker.RegisteredClaims{}, time.Minute)
+		require.NoError(t, err)
+
+		username := tokenmaker.RandomOwner()
+		token, err := maker.CreateToken(username)
+		require.NoError(t, err)
+
+		parsed, _, err := new(jwt.Parser).ParseUnverified(token, &tokenmaker.Payload{})
+		require.NoError(t, err)
+		require.Equal(t, alg, parsed.Method.Alg())
+
+		keys := maker.(tokenmaker.PublicKeyProvider).PublicKeys()
+		require.Len(t, keys, 1)
+		require.Equal(t, alg, keys[0].Alg)
+		require.Equal(t, keys[0].Kid, parsed.Header["kid"])
+
+		payload, err := maker.VerifyToken(token)
+		require.NoError(t, err)
+		require.Equal(t, username, payload.Username)
+	}
+}
+
+func TestExpiredAsymmetricJWTToken(t *testing.T) {
+	for _, key := range newKeys(t) {
+		maker, err := jwtmaker.NewAsymmetricJWTMaker("", key, jwtmaker.RegisteredClaims{}, -time.Minute)
+		require.NoError(t, err)
+		token, err := maker.CreateToken(tokenmaker.RandomOwner())
+		require.NoError(t, err)
+
+		payload, err := maker.VerifyToken(token)
+		require.EqualError(t, err, tokenmaker.ErrExpiredToken.Error())
+		require.Nil(t, payload)
+	}
+}
+
+func TestInvalidAsymmetricJWTTokenAlgHS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	maker, err := jwtmaker.NewAsymmetricJWTMaker("", key, jwtmaker.RegisteredClaims{}, time.Minute)
+	require.NoError(t, err)
+
+	// a token signed with HS256 using the public key as secret must be refused
+	payload, err := tokenmaker.NewPayload(tokenmaker.RandomOwner(), time.Minute)
+	require.NoError(t, err)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, payload).SignedString(key.PublicKey.N.Bytes())
+	require.NoError(t, err)
+
+	payload, err = maker.VerifyToken(token)
+	require.EqualError(t, err, tokenmaker.ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
@@ -0,0 +1,108 @@
+package jwtmaker
+
+import (
+	"errors"
+	"rbac/internal/tokenmaker"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+)
+
+// DEFAULT_ISSUER is the iss and aud of the tokens of makers created without RegisteredClaims.
+const DEFAULT_ISSUER = "rbac"
+
+// RegisteredClaims are the iss and aud claims set on the tokens, tokens carrying other values are rejected.
+type RegisteredClaims struct {
+	Issuer   string
+	Audience string
+}
+
+// withDefaults returns the claims with DEFAULT_ISSUER in place of the empty values.
+func (rc RegisteredClaims) withDefaults() RegisteredClaims {
+	if rc.Issuer == "" {
+		rc.Issuer = DEFAULT_ISSUER
+	}
+	if rc.Audience == "" {
+		rc.Audience = DEFAULT_ISSUER
+	}
+	return rc
+}
+
+// claims is the JWT form of a payload, it uses the registered claims of RFC 7519 so that any JWT library
+// can validate the tokens, the tenant and the permissions are private claims.
+type claims struct {
+	ID          string                  `json:"jti"`
+	Subject     string                  `json:"sub"`
+	Issuer      string                  `json:"iss"`
+	Audience    string                  `json:"aud"`
+	IssuedAt    int64                   `json:"iat"`
+	ExpiresAt   int64                   `json:"exp"`
+	Tenant      string                  `json:"tenant,omitempty"`
+	Permissions *tokenmaker.Permissions `json:"perms,omitempty"`
+}
+
+func newClaims(payload *tokenmaker.Payload, rc RegisteredClaims) *claims {
+	return &claims{
+		ID:          payload.ID.String(),
+		Subject:     payload.Username,
+		Issuer:      rc.Issuer,
+		Audience:    rc.Audience,
+		IssuedAt:    payload.IssuedAt.Unix(),
+		ExpiresAt:   payload.ExpiredAt.Unix(),
+		Tenant:      payload.Tenant,
+		Permissions: payload.Permissions,
+	}
+}
+
+func (c *claims) Valid() error {
+	if time.Now().Unix() >= c.ExpiresAt {
+		return tokenmaker.ErrExpiredToken
+	}
+	return nil
+}
+
+func (c *claims) payload() (*tokenmaker.Payload, error) {
+	id, err := uuid.Parse(c.ID)
+	if err != nil {
+		return nil, tokenmaker.ErrInvalidToken
+	}
+	return &tokenmaker.Payload{
+		ID:          id,
+		Username:    c.Subject,
+		IssuedAt:    time.Unix(c.IssuedAt, 0),
+		ExpiredAt:   time.Unix(c.ExpiresAt, 0),
+		Tenant:      c.Tenant,
+		Permissions: c.Permissions,
+	}, nil
+}
+
+// sign returns the token of a new payload signed with the key.
+func sign(method jwt.SigningMethod, kid string, key interface{}, username string, extra tokenmaker.Claims, rc RegisteredClaims, duration time.Duration) (string, error) {
+	payload, err := tokenmaker.NewPayloadWithClaims(username, extra, duration)
+	if err != nil {
+		return "", err
+	}
+	jwtToken := jwt.NewWithClaims(method, newClaims(payload, rc))
+	if kid != "" {
+		jwtToken.Header["kid"] = kid
+	}
+	return jwtToken.SignedString(key)
+}
+
+// verify parses the token and checks its signature, expiry, issuer and audience.
+func verify(token string, keyFunc jwt.Keyfunc, rc RegisteredClaims) (*tokenmaker.Payload, error) {
+	jwtToken, err := jwt.ParseWithClaims(token, &claims{}, keyFunc)
+	if err != nil {
+		verr, ok := err.(*jwt.ValidationError)
+		if ok && errors.Is(verr.Inner, tokenmaker.ErrExpiredToken) {
+			return nil, tokenmaker.ErrExpiredToken
+		}
+		return nil, tokenmaker.ErrInvalidToken
+	}
+	c, ok := jwtToken.Claims.(*claims)
+	if !ok || c.Issuer != rc.Issuer || c.Audience != rc.Audience {
+		return nil, tokenmaker.ErrInvalidToken
+	}
+	return c.payload()
+}
@@ -1,7 +1,6 @@
 package jwtmaker
 
 import (
-	"errors"
 	"fmt"
 	"rbac/internal/tokenmaker"
 	"time"
@@ -12,27 +11,36 @@ import (
 const minSecretKeySize = 32
 
 type JWTMaker struct {
-	secretKey string
-	duration  time.Duration
+	kid        string
+	secretKey  string
+	registered RegisteredClaims
+	duration   time.Duration
 }
 
 func NewJWTMaker(secretKey string, duration time.Duration) (tokenmaker.TokenMaker, error) {
+	return NewJWTMakerWithKeyID("", secretKey, RegisteredClaims{}, duration)
+}
+
+// NewJWTMakerWithKeyID returns a maker setting the kid header of its tokens, used for rotating keys, and the
+// iss and aud claims, DEFAULT_ISSUER is used for the empty ones.
+func NewJWTMakerWithKeyID(kid string, secretKey string, registered RegisteredClaims, duration time.Duration) (tokenmaker.TokenMaker, error) {
 	if len(secretKey) < minSecretKeySize {
 		return nil, fmt.Errorf("invalid key size: must be at lease %d characters", minSecretKeySize)
 	}
 	return &JWTMaker{
-		secretKey: secretKey,
-		duration:  duration,
+		kid:        kid,
+		secretKey:  secretKey,
+		registered: registered.withDefaults(),
+		duration:   duration,
 	}, nil
 }
 
 func (maker *JWTMaker) CreateToken(username string) (string, error) {
-	payload, err := tokenmaker.NewPayload(username, maker.duration)
-	if err != nil {
-		return "", err
-	}
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
-	return jwtToken.SignedString([]byte(maker.secretKey))
+	return maker.CreateTokenWithClaims(username, tokenmaker.Claims{})
+}
+
+func (maker *JWTMaker) CreateTokenWithClaims(username string, claims tokenmaker.Claims) (string, error) {
+	return sign(jwt.SigningMethodHS256, maker.kid, []byte(maker.secretKey), username, claims, maker.registered, maker.duration)
 }
 func (maker *JWTMaker) VerifyToken(token string) (*tokenmaker.Payload, error) {
 	keyFunc := func(token *jwt.Token) (interface{}, error) {
@@ -42,17 +50,5 @@ func (maker *JWTMaker) VerifyToken(token string) (*tokenmaker.Payload, error) {
 		}
 		return []byte(maker.secretKey), nil
 	}
-	jwtToken, err := jwt.ParseWithClaims(token, &tokenmaker.Payload{}, keyFunc)
-	if err != nil {
-		verr, ok := err.(*jwt.ValidationError)
-		if ok && errors.Is(verr.Inner, tokenmaker.ErrExpiredToken) {
-			return nil, tokenmaker.ErrExpiredToken
-		}
-		return nil, tokenmaker.ErrInvalidToken
-	}
-	payload, ok := jwtToken.Claims.(*tokenmaker.Payload)
-	if !ok {
-		return nil, tokenmaker.ErrInvalidToken
-	}
-	return payload, nil
+	return verify(token, keyFunc, maker.registered)
 }
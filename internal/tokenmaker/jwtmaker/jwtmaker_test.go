@@ -63,3 +63,45 @@ func TestInvalidJWTTokenAlgNone(t *testing.T) {
 	require.EqualError(t, err, tokenmaker.ErrInvalidToken.Error())
 	require.Nil(t, payload)
 }
+
+func TestJWTMakerPermissions(t *testing.T) {
+	maker, err := jwtmaker.NewJWTMaker(tokenmaker.RandomString(32), time.Minute)
+	require.NoError(t, err)
+
+	permissions := tokenmaker.NewPermissions(7, []string{"role1"}, []string{"VIEW_ROLE"}, nil)
+	token, err := maker.CreateTokenWithClaims(tokenmaker.RandomOwner(), tokenmaker.Claims{Tenant: "tenant1", Permissions: permissions})
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, "tenant1", payload.Tenant)
+	require.Equal(t, permissions, payload.Permissions)
+}
+
+func TestJWTMakerRegisteredClaims(t *testing.T) {
+	key := tokenmaker.RandomString(32)
+	maker, err := jwtmaker.NewJWTMakerWithKeyID("", key, jwtmaker.RegisteredClaims{Issuer: "issuer", Audience: "audience"}, time.Minute)
+	require.NoError(t, err)
+
+	username := tokenmaker.RandomOwner()
+	token, err := maker.CreateToken(username)
+	require.NoError(t, err)
+
+	claims := jwt.MapClaims{}
+	_, _, err = new(jwt.Parser).ParseUnverified(token, claims)
+	require.NoError(t, err)
+	require.Equal(t, username, claims["sub"])
+	require.Equal(t, "issuer", claims["iss"])
+	require.Equal(t, "audience", claims["aud"])
+	require.NotZero(t, claims["iat"])
+	require.NotZero(t, claims["exp"])
+	require.NotEmpty(t, claims["jti"])
+	require.NotContains(t, claims, "issued_at")
+	require.NotContains(t, claims, "expired_at")
+
+	other, err := jwtmaker.NewJWTMakerWithKeyID("", key, jwtmaker.RegisteredClaims{Issuer: "issuer", Audience: "other"}, time.Minute)
+	require.NoError(t, err)
+	payload, err := other.VerifyToken(token)
+	require.EqualError(t, err, tokenmaker.ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
@@ -0,0 +1,196 @@
+package tokenmaker
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// KeyStatus tells what a signing key can be used for.
+type KeyStatus string
+
+const (
+	// KeyStatusActive keys sign new tokens and verify them, a key ring has exactly one.
+	KeyStatusActive KeyStatus = "active"
+	// KeyStatusVerify keys only verify tokens, used before activating a new key and after replacing the old one.
+	KeyStatusVerify KeyStatus = "verify"
+	// KeyStatusRetired keys are ignored, tokens signed with them are refused.
+	KeyStatusRetired KeyStatus = "retired"
+)
+
+// Key is a token maker using one signing key.
+type Key struct {
+	ID     string
+	Status KeyStatus
+	Maker  TokenMaker
+}
+
+// KeyInfo describes a key of a key ring without exposing it.
+type KeyInfo struct {
+	ID     string
+	Status KeyStatus
+}
+
+// KeyLoader returns the keys of a key ring, it is called again when the key ring is reloaded.
+type KeyLoader func() ([]Key, error)
+
+// Footer is the PASETO footer holding the id of the key used for a token.
+type Footer struct {
+	Kid string `json:"kid"`
+}
+
+// NewFooter returns the encoded footer for kid, nil when kid is empty so no footer is added.
+func NewFooter(kid string) []byte {
+	if kid == "" {
+		return nil
+	}
+	b, _ := json.Marshal(Footer{Kid: kid})
+	return b
+}
+
+// KeyID returns the id of the key used for signing a JWT or a PASETO token without verifying it, an empty
+// id is returned for tokens without one.
+func KeyID(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	var encoded string
+	switch {
+	case strings.HasPrefix(token, "v2.") || strings.HasPrefix(token, "v4."):
+		if len(parts) != 4 {
+			return "", nil
+		}
+		encoded = parts[3]
+	case len(parts) == 3:
+		encoded = parts[0]
+	default:
+		return "", ErrInvalidToken
+	}
+	b, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	var footer Footer
+	if err := json.Unmarshal(b, &footer); err != nil {
+		return "", ErrInvalidToken
+	}
+	return footer.Kid, nil
+}
+
+// KeyRing signs tokens with its active key and verifies them with the key named by their kid, keys can be
+// replaced without restarting by reloading the key ring.
+type KeyRing struct {
+	load   KeyLoader
+	mu     sync.RWMutex
+	active Key
+	keys   map[string]Key
+}
+
+func NewKeyRing(load KeyLoader) (*KeyRing, error) {
+	kr := &KeyRing{
+		load: load,
+	}
+	if err := kr.Reload(); err != nil {
+		return nil, err
+	}
+	return kr, nil
+}
+
+// Reload loads the keys again, the key ring is left untouched when they are invalid.
+func (kr *KeyRing) Reload() error {
+	keys, err := kr.load()
+	if err != nil {
+		return fmt.Errorf("load keys: %w", err)
+	}
+	var active Key
+	byID := make(map[string]Key)
+	for _, k := range keys {
+		if _, ok := byID[k.ID]; ok {
+			return fmt.Errorf("duplicate key id %q", k.ID)
+		}
+		switch k.Status {
+		case KeyStatusActive:
+			if active.Maker != nil {
+				return errors.New("more than one active key")
+			}
+			active = k
+		case KeyStatusVerify, KeyStatusRetired:
+		default:
+			return fmt.Errorf("invalid status %q for key %q", k.Status, k.ID)
+		}
+		byID[k.ID] = k
+	}
+	if active.Maker == nil {
+		return errors.New("no active key")
+	}
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	kr.active = active
+	kr.keys = byID
+	return nil
+}
+
+func (kr *KeyRing) CreateToken(username string) (string, error) {
+	return kr.CreateTokenWithClaims(username, Claims{})
+}
+
+func (kr *KeyRing) CreateTokenWithClaims(username string, claims Claims) (string, error) {
+	kr.mu.RLock()
+	active := kr.active
+	kr.mu.RUnlock()
+	return active.Maker.CreateTokenWithClaims(username, claims)
+}
+
+// VerifyToken verifies the token with the key named by its kid, tokens without one are verified with the
+// active key.
+func (kr *KeyRing) VerifyToken(token string) (*Payload, error) {
+	kid, err := KeyID(token)
+	if err != nil {
+		return nil, err
+	}
+	kr.mu.RLock()
+	key, ok := kr.keys[kid]
+	if kid == "" {
+		key, ok = kr.active, true
+	}
+	kr.mu.RUnlock()
+	if !ok || key.Status == KeyStatusRetired {
+		return nil, ErrInvalidToken
+	}
+	return key.Maker.VerifyToken(token)
+}
+
+// PublicKeys returns the public keys of the keys that aren't retired.
+func (kr *KeyRing) PublicKeys() []JWK {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	res := []JWK{}
+	for _, k := range kr.keys {
+		if k.Status == KeyStatusRetired {
+			continue
+		}
+		if provider, ok := k.Maker.(PublicKeyProvider); ok {
+			res = append(res, provider.PublicKeys()...)
+		}
+	}
+	return res
+}
+
+// Keys describes the keys of the key ring, the active key first.
+func (kr *KeyRing) Keys() []KeyInfo {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	res := []KeyInfo{{ID: kr.active.ID, Status: kr.active.Status}}
+	for _, k := range kr.keys {
+		if k.ID == kr.active.ID {
+			continue
+		}
+		res = append(res, KeyInfo{ID: k.ID, Status: k.Status})
+	}
+	sort.Slice(res[1:], func(i, j int) bool {
+		return res[i+1].ID < res[j+1].ID
+	})
+	return res
+}
@@ -0,0 +1,96 @@
+package tokenmaker_test
+
+import (
+	"rbac/internal/tokenmaker"
+	"rbac/internal/tokenmaker/pasetomaker"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyRingRotation(t *testing.T) {
+	newKey := func(id string, secret string, status tokenmaker.KeyStatus) tokenmaker.Key {
+		maker, err := pasetomaker.NewPasetoMakerWithKeyID(id, secret, time.Minute)
+		require.NoError(t, err)
+		return tokenmaker.Key{ID: id, Status: status, Maker: maker}
+	}
+	secret1 := tokenmaker.RandomString(32)
+	secret2 := tokenmaker.RandomString(32)
+
+	keys := []tokenmaker.Key{
+		newKey("k1", secret1, tokenmaker.KeyStatusActive),
+		newKey("k2", secret2, tokenmaker.KeyStatusVerify),
+	}
+	kr, err := tokenmaker.NewKeyRing(func() ([]tokenmaker.Key, error) {
+		return keys, nil
+	})
+	require.NoError(t, err)
+
+	oldToken, err := kr.CreateToken("user")
+	require.NoError(t, err)
+	kid, err := tokenmaker.KeyID(oldToken)
+	require.NoError(t, err)
+	require.Equal(t, "k1", kid)
+
+	// activate k2, tokens signed by k1 keep working
+	keys = []tokenmaker.Key{
+		newKey("k1", secret1, tokenmaker.KeyStatusVerify),
+		newKey("k2", secret2, tokenmaker.KeyStatusActive),
+	}
+	require.NoError(t, kr.Reload())
+	require.Equal(t, []tokenmaker.KeyInfo{
+		{ID: "k2", Status: tokenmaker.KeyStatusActive},
+		{ID: "k1", Status: tokenmaker.KeyStatusVerify},
+	}, kr.Keys())
+
+	newToken, err := kr.CreateToken("user")
+	require.NoError(t, err)
+	kid, err = tokenmaker.KeyID(newToken)
+	require.NoError(t, err)
+	require.Equal(t, "k2", kid)
+
+	payload, err := kr.VerifyToken(oldToken)
+	require.NoError(t, err)
+	require.Equal(t, "user", payload.Username)
+
+	// invalid key sets are refused and the key ring is left untouched
+	keys = []tokenmaker.Key{
+		newKey("k1", secret1, tokenmaker.KeyStatusActive),
+		newKey("k2", secret2, tokenmaker.KeyStatusActive),
+	}
+	require.Error(t, kr.Reload())
+	_, err = kr.VerifyToken(newToken)
+	require.NoError(t, err)
+
+	// retire k1, its tokens are refused
+	keys = []tokenmaker.Key{
+		newKey("k1", secret1, tokenmaker.KeyStatusRetired),
+		newKey("k2", secret2, tokenmaker.KeyStatusActive),
+	}
+	require.NoError(t, kr.Reload())
+	_, err = kr.VerifyToken(oldToken)
+	require.EqualError(t, err, tokenmaker.ErrInvalidToken.Error())
+	_, err = kr.VerifyToken(newToken)
+	require.NoError(t, err)
+}
+
+func TestKeyRingTokenWithoutKeyID(t *testing.T) {
+	secret := tokenmaker.RandomString(32)
+	legacy, err := pasetomaker.NewPasetoMaker(secret, time.Minute)
+	require.NoError(t, err)
+	token, err := legacy.CreateToken("user")
+	require.NoError(t, err)
+
+	maker, err := pasetomaker.NewPasetoMakerWithKeyID("k1", secret, time.Minute)
+	require.NoError(t, err)
+	kr, err := tokenmaker.NewKeyRing(func() ([]tokenmaker.Key, error) {
+		return []tokenmaker.Key{{ID: "k1", Status: tokenmaker.KeyStatusActive, Maker: maker}}, nil
+	})
+	require.NoError(t, err)
+
+	// tokens issued before keys had ids are verified with the active key
+	payload, err := kr.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, "user", payload.Username)
+}
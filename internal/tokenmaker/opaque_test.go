@@ -0,0 +1,23 @@
+package tokenmaker_test
+
+import (
+	"rbac/internal/tokenmaker"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpaqueToken(t *testing.T) {
+	token, err := tokenmaker.NewOpaqueToken()
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	other, err := tokenmaker.NewOpaqueToken()
+	require.NoError(t, err)
+	require.NotEqual(t, token, other)
+
+	hash := tokenmaker.HashOpaqueToken(token)
+	require.Len(t, hash, 64)
+	require.Equal(t, hash, tokenmaker.HashOpaqueToken(token))
+	require.NotEqual(t, hash, tokenmaker.HashOpaqueToken(other))
+}
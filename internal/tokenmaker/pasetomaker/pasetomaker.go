@@ -10,16 +10,23 @@ import (
 )
 
 type PasetoMaker struct {
+	kid          string
 	paseto       *paseto.V2
 	symmetricKey []byte
 	duration     time.Duration
 }
 
 func NewPasetoMaker(symmetricKey string, duration time.Duration) (tokenmaker.TokenMaker, error) {
+	return NewPasetoMakerWithKeyID("", symmetricKey, duration)
+}
+
+// NewPasetoMakerWithKeyID returns a maker setting the kid in the footer of its tokens, used for rotating keys.
+func NewPasetoMakerWithKeyID(kid string, symmetricKey string, duration time.Duration) (tokenmaker.TokenMaker, error) {
 	if len(symmetricKey) != chacha20poly1305.KeySize {
 		return nil, fmt.Errorf("invalid key size: must be exactly %d characters", chacha20poly1305.KeySize)
 	}
 	maker := &PasetoMaker{
+		kid:          kid,
 		paseto:       paseto.NewV2(),
 		symmetricKey: []byte(symmetricKey),
 		duration:     duration,
@@ -29,11 +36,15 @@ func NewPasetoMaker(symmetricKey string, duration time.Duration) (tokenmaker.Tok
 }
 
 func (maker *PasetoMaker) CreateToken(username string) (string, error) {
-	payload, err := tokenmaker.NewPayload(username, maker.duration)
+	return maker.CreateTokenWithClaims(username, tokenmaker.Claims{})
+}
+
+func (maker *PasetoMaker) CreateTokenWithClaims(username string, claims tokenmaker.Claims) (string, error) {
+	payload, err := tokenmaker.NewPayloadWithClaims(username, claims, maker.duration)
 	if err != nil {
 		return "", err
 	}
-	return maker.paseto.Encrypt(maker.symmetricKey, payload, nil)
+	return maker.paseto.Encrypt(maker.symmetricKey, payload, tokenmaker.NewFooter(maker.kid))
 }
 func (maker *PasetoMaker) VerifyToken(token string) (*tokenmaker.Payload, error) {
 	payload := &tokenmaker.Payload{}
@@ -47,3 +47,29 @@ func TestExpiredPasetoToken(t *testing.T) {
 	require.EqualError(t, err, tokenmaker.ErrExpiredToken.Error())
 	require.Nil(t, payload)
 }
+
+func TestPasetoMakerPermissions(t *testing.T) {
+	maker, err := pasetomaker.NewPasetoMaker(tokenmaker.RandomString(32), time.Minute)
+	require.NoError(t, err)
+
+	permissions := tokenmaker.NewPermissions(3, []string{"role2", "role1"}, []string{"VIEW_ROLE", "CREATE_ROLE", "VIEW_ROLE", "DELETE_ROLE"}, []string{"DELETE_ROLE"})
+	token, err := maker.CreateTokenWithClaims(tokenmaker.RandomOwner(), tokenmaker.Claims{Tenant: "tenant1", Permissions: permissions})
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, "tenant1", payload.Tenant)
+	require.True(t, payload.HasPermissions())
+	require.Equal(t, int64(3), payload.Permissions.Version)
+	require.Equal(t, []string{"role1", "role2"}, payload.Permissions.Roles)
+	require.Equal(t, []string{"CREATE_ROLE", "VIEW_ROLE"}, payload.Permissions.Tasks)
+	require.True(t, payload.Permissions.Can("VIEW_ROLE"))
+	require.False(t, payload.Permissions.Can("DELETE_ROLE"))
+	require.True(t, payload.Permissions.Denies("DELETE_ROLE"))
+
+	token, err = maker.CreateToken(tokenmaker.RandomOwner())
+	require.NoError(t, err)
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.False(t, payload.HasPermissions())
+}
@@ -0,0 +1,104 @@
+package pasetomaker
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"rbac/internal/tokenmaker"
+	"time"
+
+	"github.com/o1egl/paseto"
+)
+
+const (
+	// V2Public signs tokens with the v2.public protocol.
+	V2Public = "v2.public"
+	// V4Public signs tokens with the v4.public protocol.
+	V4Public = "v4.public"
+)
+
+// PublicPasetoMaker signs tokens with an Ed25519 private key using the v2.public or v4.public protocol.
+type PublicPasetoMaker struct {
+	version    string
+	paseto     *paseto.V2
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+	jwk        tokenmaker.JWK
+	duration   time.Duration
+}
+
+// NewPublicPasetoMaker returns a maker setting kid in the footer of its tokens, the RFC 7638 thumbprint of
+// the public key is used when kid is empty.
+func NewPublicPasetoMaker(version string, kid string, key crypto.Signer, duration time.Duration) (tokenmaker.TokenMaker, error) {
+	if version != V2Public && version != V4Public {
+		return nil, fmt.Errorf("unsupported paseto version %s", version)
+	}
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid key: %s requires an Ed25519 key", version)
+	}
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	jwk, err := tokenmaker.NewJWK(publicKey, version)
+	if err != nil {
+		return nil, fmt.Errorf("tokenmaker.NewJWK: %w", err)
+	}
+	if kid != "" {
+		jwk.Kid = kid
+	}
+	return &PublicPasetoMaker{
+		version:    version,
+		paseto:     paseto.NewV2(),
+		privateKey: privateKey,
+		publicKey:  publicKey,
+		jwk:        jwk,
+		duration:   duration,
+	}, nil
+}
+
+func (maker *PublicPasetoMaker) CreateToken(username string) (string, error) {
+	return maker.CreateTokenWithClaims(username, tokenmaker.Claims{})
+}
+
+func (maker *PublicPasetoMaker) CreateTokenWithClaims(username string, claims tokenmaker.Claims) (string, error) {
+	payload, err := tokenmaker.NewPayloadWithClaims(username, claims, maker.duration)
+	if err != nil {
+		return "", err
+	}
+	if maker.version == V2Public {
+		return maker.paseto.Sign(maker.privateKey, payload, tokenmaker.NewFooter(maker.jwk.Kid))
+	}
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	return signV4(maker.privateKey, message, tokenmaker.NewFooter(maker.jwk.Kid)), nil
+}
+
+func (maker *PublicPasetoMaker) VerifyToken(token string) (*tokenmaker.Payload, error) {
+	payload := &tokenmaker.Payload{}
+	if maker.version == V2Public {
+		err := maker.paseto.Verify(token, maker.publicKey, payload, nil)
+		if err != nil {
+			return nil, tokenmaker.ErrInvalidToken
+		}
+	} else {
+		message, _, err := verifyV4(maker.publicKey, token)
+		if err != nil {
+			return nil, tokenmaker.ErrInvalidToken
+		}
+		if err := json.Unmarshal(message, payload); err != nil {
+			return nil, tokenmaker.ErrInvalidToken
+		}
+	}
+	err := payload.Valid()
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// PublicKeys returns the key used for verifying the tokens.
+func (maker *PublicPasetoMaker) PublicKeys() []tokenmaker.JWK {
+	return []tokenmaker.JWK{maker.jwk}
+}
@@ -0,0 +1,59 @@
+package pasetomaker_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"rbac/internal/tokenmaker"
+	"rbac/internal/tokenmaker/pasetomaker"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicPasetoMaker(t *testing.T) {
+	for _, version := range []string{pasetomaker.V2Public, pasetomaker.V4Public} {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		maker, err := pasetomaker.NewPublicPasetoMaker(version, "", key, time.Minute)
+		require.NoError(t, err)
+
+		username := tokenmaker.RandomOwner()
+		token, err := maker.CreateToken(username)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(token, version+"."))
+
+		payload, err := maker.VerifyToken(token)
+		require.NoError(t, err)
+		require.Equal(t, username, payload.Username)
+
+		keys := maker.(tokenmaker.PublicKeyProvider).PublicKeys()
+		require.Len(t, keys, 1)
+		require.Equal(t, "OKP", keys[0].Kty)
+
+		// tokens signed by another key are refused
+		_, other, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		otherMaker, err := pasetomaker.NewPublicPasetoMaker(version, "", other, time.Minute)
+		require.NoError(t, err)
+		payload, err = otherMaker.VerifyToken(token)
+		require.EqualError(t, err, tokenmaker.ErrInvalidToken.Error())
+		require.Nil(t, payload)
+	}
+}
+
+func TestExpiredPublicPasetoToken(t *testing.T) {
+	for _, version := range []string{pasetomaker.V2Public, pasetomaker.V4Public} {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		maker, err := pasetomaker.NewPublicPasetoMaker(version, "", key, -time.Minute)
+		require.NoError(t, err)
+		token, err := maker.CreateToken(tokenmaker.RandomOwner())
+		require.NoError(t, err)
+
+		payload, err := maker.VerifyToken(token)
+		require.EqualError(t, err, tokenmaker.ErrExpiredToken.Error())
+		require.Nil(t, payload)
+	}
+}
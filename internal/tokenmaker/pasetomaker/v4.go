@@ -0,0 +1,68 @@
+package pasetomaker
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+const headerV4Public = "v4.public."
+
+var errInvalidV4Token = errors.New("invalid v4.public token")
+
+// signV4 returns a v4.public token, github.com/o1egl/paseto only implements the v1 and v2 protocols.
+func signV4(key ed25519.PrivateKey, message []byte, footer []byte) string {
+	sig := ed25519.Sign(key, preAuthEncode([]byte(headerV4Public), message, footer, nil))
+	token := headerV4Public + base64.RawURLEncoding.EncodeToString(append(append([]byte{}, message...), sig...))
+	if len(footer) > 0 {
+		token += "." + base64.RawURLEncoding.EncodeToString(footer)
+	}
+	return token
+}
+
+// verifyV4 checks the signature of a v4.public token and returns its message and footer.
+func verifyV4(key ed25519.PublicKey, token string) ([]byte, []byte, error) {
+	if !strings.HasPrefix(token, headerV4Public) {
+		return nil, nil, errInvalidV4Token
+	}
+	parts := strings.Split(token[len(headerV4Public):], ".")
+	if len(parts) > 2 {
+		return nil, nil, errInvalidV4Token
+	}
+	body, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || len(body) < ed25519.SignatureSize {
+		return nil, nil, errInvalidV4Token
+	}
+	var footer []byte
+	if len(parts) == 2 {
+		footer, err = base64.RawURLEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, nil, errInvalidV4Token
+		}
+	}
+	message := body[:len(body)-ed25519.SignatureSize]
+	sig := body[len(body)-ed25519.SignatureSize:]
+	if !ed25519.Verify(key, preAuthEncode([]byte(headerV4Public), message, footer, nil), sig) {
+		return nil, nil, errInvalidV4Token
+	}
+	return message, footer, nil
+}
+
+// preAuthEncode implements PAE from the PASETO specification.
+func preAuthEncode(pieces ...[]byte) []byte {
+	var buf bytes.Buffer
+	le64 := func(n int) {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, uint64(n)&(1<<63-1))
+		buf.Write(b)
+	}
+	le64(len(pieces))
+	for _, p := range pieces {
+		le64(len(p))
+		buf.Write(p)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,26 @@
+package pasetomaker
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestV4PublicVector(t *testing.T) {
+	// test vector 4-S-1 from the PASETO specification
+	seed, err := hex.DecodeString("b4cbfb43df4ce210727d953e4a713307fa19bb7d9f85041438d9e11b942a3774")
+	require.NoError(t, err)
+	key := ed25519.NewKeyFromSeed(seed)
+	message := []byte(`{"data":"this is a signed message","exp":"2022-01-01T00:00:00+00:00"}`)
+	expected := "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9bg_XBBzds8lTZShVlwwKSgeKpLT3yukTw6JUz3W4h_ExsQV-P0V54zemZDcAxFaSeef1QlXEFtkqxT1ciiQEDA"
+
+	token := signV4(key, message, nil)
+	require.Equal(t, expected, token)
+
+	got, footer, err := verifyV4(key.Public().(ed25519.PublicKey), token)
+	require.NoError(t, err)
+	require.Equal(t, message, got)
+	require.Empty(t, footer)
+}
@@ -2,6 +2,7 @@ package tokenmaker
 
 import (
 	"errors"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,6 +10,8 @@ import (
 
 type TokenMaker interface {
 	CreateToken(username string) (string, error)
+	// CreateTokenWithClaims embeds the claims in the token, empty claims behave like CreateToken.
+	CreateTokenWithClaims(username string, claims Claims) (string, error)
 	VerifyToken(token string) (*Payload, error)
 }
 type Account struct {
@@ -31,6 +34,75 @@ type Payload struct {
 	Username  string    `json:"username"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
+	// Tenant is the id of the tenant the account acts in.
+	Tenant string `json:"tenant,omitempty"`
+	// Permissions is only set on tokens issued with permission claims.
+	Permissions *Permissions `json:"perms,omitempty"`
+}
+
+// Claims are the optional claims of a token.
+type Claims struct {
+	Tenant      string
+	Permissions *Permissions
+}
+
+// Permissions are the roles and tasks of the account when the token was issued, Version is the permission
+// version stamp at that time, a token carrying an older one must be issued again.
+type Permissions struct {
+	Version int64    `json:"v"`
+	Roles   []string `json:"r"`
+	Tasks   []string `json:"t"`
+	// Denied are the tasks denied by one of the roles, they override any grant of the task.
+	Denied []string `json:"d,omitempty"`
+}
+
+// NewPermissions returns the permissions with the roles and tasks sorted and without duplicates, denied
+// tasks are removed from the granted ones.
+func NewPermissions(version int64, roles []string, tasks []string, denied []string) *Permissions {
+	p := &Permissions{
+		Version: version,
+		Roles:   compact(roles),
+		Denied:  compact(denied),
+	}
+	p.Tasks = []string{}
+	for _, task := range compact(tasks) {
+		if !contains(p.Denied, task) {
+			p.Tasks = append(p.Tasks, task)
+		}
+	}
+	if len(p.Denied) == 0 {
+		p.Denied = nil
+	}
+	return p
+}
+
+// Can returns true when the task is one of the permissions.
+func (p *Permissions) Can(task string) bool {
+	return contains(p.Tasks, task)
+}
+
+// Denies returns true when the task is explicitly denied.
+func (p *Permissions) Denies(task string) bool {
+	return contains(p.Denied, task)
+}
+
+func contains(sorted []string, value string) bool {
+	i := sort.SearchStrings(sorted, value)
+	return i < len(sorted) && sorted[i] == value
+}
+
+func compact(values []string) []string {
+	sorted := make([]string, len(values))
+	copy(sorted, values)
+	sort.Strings(sorted)
+	res := make([]string, 0, len(sorted))
+	for i, v := range sorted {
+		if i > 0 && v == sorted[i-1] {
+			continue
+		}
+		res = append(res, v)
+	}
+	return res
 }
 
 func NewPayload(username string, duration time.Duration) (*Payload, error) {
@@ -46,6 +118,22 @@ func NewPayload(username string, duration time.Duration) (*Payload, error) {
 	}
 	return payload, nil
 }
+
+// NewPayloadWithClaims returns a payload carrying the claims.
+func NewPayloadWithClaims(username string, claims Claims, duration time.Duration) (*Payload, error) {
+	payload, err := NewPayload(username, duration)
+	if err != nil {
+		return nil, err
+	}
+	payload.Tenant = claims.Tenant
+	payload.Permissions = claims.Permissions
+	return payload, nil
+}
+
+// HasPermissions returns true when the token was issued with permission claims.
+func (payload *Payload) HasPermissions() bool {
+	return payload.Permissions != nil
+}
 func (payload *Payload) Valid() error {
 	if time.Now().After(payload.ExpiredAt) {
 		return ErrExpiredToken
@@ -0,0 +1,58 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"rbac/internal/totp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 secret used by the test vectors of RFC 6238.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(tt.unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, tt.code, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := totp.NewSecret()
+	require.NoError(t, err)
+	now := time.Now()
+
+	code, err := totp.Code(secret, totp.Step(now.Add(-30*time.Second)))
+	require.NoError(t, err)
+	step, ok, err := totp.Validate(secret, code, now)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, totp.Step(now)-1, step)
+
+	code, err = totp.Code(secret, totp.Step(now.Add(-2*time.Minute)))
+	require.NoError(t, err)
+	_, ok, err = totp.Validate(secret, code, now)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := totp.ProvisioningURI("rbac", "admin", "SECRET")
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/rbac:admin?"))
+	require.Contains(t, uri, "secret=SECRET")
+	require.Contains(t, uri, "issuer=rbac")
+}
@@ -0,0 +1,26 @@
+package internal
+
+import "time"
+
+// Validity bounds when an account role applies, From is inclusive and Until exclusive. Zero times leave the
+// corresponding side unbounded.
+type Validity struct {
+	From  time.Time
+	Until time.Time
+}
+
+// IsActive returns true when the validity covers at.
+func (v Validity) IsActive(at time.Time) bool {
+	if !v.From.IsZero() && at.Before(v.From) {
+		return false
+	}
+	return v.Until.IsZero() || at.Before(v.Until)
+}
+
+// Validate checks the validity ends after it starts.
+func (v Validity) Validate() error {
+	if !v.From.IsZero() && !v.Until.IsZero() && !v.From.Before(v.Until) {
+		return NewErrorf(ErrorCodeInvalidArgument, "valid until must be after valid from")
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package internal_test
+
+import (
+	"rbac/internal"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidity_IsActive(t *testing.T) {
+	now := time.Now()
+
+	require.True(t, internal.Validity{}.IsActive(now))
+	require.True(t, internal.Validity{From: now}.IsActive(now))
+	require.False(t, internal.Validity{From: now.Add(time.Hour)}.IsActive(now))
+	require.False(t, internal.Validity{Until: now}.IsActive(now))
+	require.True(t, internal.Validity{From: now.Add(-time.Hour), Until: now.Add(time.Hour)}.IsActive(now))
+}
+
+func TestValidity_Validate(t *testing.T) {
+	now := time.Now()
+
+	require.NoError(t, internal.Validity{}.Validate())
+	require.NoError(t, internal.Validity{Until: now}.Validate())
+	require.NoError(t, internal.Validity{From: now, Until: now.Add(time.Hour)}.Validate())
+	require.Error(t, internal.Validity{From: now, Until: now}.Validate())
+}